@@ -2629,6 +2629,44 @@ func (c *MockHumanUserRepositorySetLastSuccessfulTOTPCheckCall) DoAndReturn(f fu
 	return c
 }
 
+// SetLockedUntil mocks base method.
+func (m *MockHumanUserRepository) SetLockedUntil(lockedUntil *time.Time) database.Change {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLockedUntil", lockedUntil)
+	ret0, _ := ret[0].(database.Change)
+	return ret0
+}
+
+// SetLockedUntil indicates an expected call of SetLockedUntil.
+func (mr *MockHumanUserRepositoryMockRecorder) SetLockedUntil(lockedUntil any) *MockHumanUserRepositorySetLockedUntilCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLockedUntil", reflect.TypeOf((*MockHumanUserRepository)(nil).SetLockedUntil), lockedUntil)
+	return &MockHumanUserRepositorySetLockedUntilCall{Call: call}
+}
+
+// MockHumanUserRepositorySetLockedUntilCall wrap *gomock.Call
+type MockHumanUserRepositorySetLockedUntilCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockHumanUserRepositorySetLockedUntilCall) Return(arg0 database.Change) *MockHumanUserRepositorySetLockedUntilCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockHumanUserRepositorySetLockedUntilCall) Do(f func(*time.Time) database.Change) *MockHumanUserRepositorySetLockedUntilCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockHumanUserRepositorySetLockedUntilCall) DoAndReturn(f func(*time.Time) database.Change) *MockHumanUserRepositorySetLockedUntilCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetMetadata mocks base method.
 func (m *MockHumanUserRepository) SetMetadata(metadata ...*domain.Metadata) database.Change {
 	m.ctrl.T.Helper()
@@ -727,6 +727,44 @@ func (c *MockMachineUserRepositorySetDescriptionCall) DoAndReturn(f func(string)
 	return c
 }
 
+// SetLockedUntil mocks base method.
+func (m *MockMachineUserRepository) SetLockedUntil(lockedUntil *time.Time) database.Change {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLockedUntil", lockedUntil)
+	ret0, _ := ret[0].(database.Change)
+	return ret0
+}
+
+// SetLockedUntil indicates an expected call of SetLockedUntil.
+func (mr *MockMachineUserRepositoryMockRecorder) SetLockedUntil(lockedUntil any) *MockMachineUserRepositorySetLockedUntilCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLockedUntil", reflect.TypeOf((*MockMachineUserRepository)(nil).SetLockedUntil), lockedUntil)
+	return &MockMachineUserRepositorySetLockedUntilCall{Call: call}
+}
+
+// MockMachineUserRepositorySetLockedUntilCall wrap *gomock.Call
+type MockMachineUserRepositorySetLockedUntilCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachineUserRepositorySetLockedUntilCall) Return(arg0 database.Change) *MockMachineUserRepositorySetLockedUntilCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachineUserRepositorySetLockedUntilCall) Do(f func(*time.Time) database.Change) *MockMachineUserRepositorySetLockedUntilCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachineUserRepositorySetLockedUntilCall) DoAndReturn(f func(*time.Time) database.Change) *MockMachineUserRepositorySetLockedUntilCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetMetadata mocks base method.
 func (m *MockMachineUserRepository) SetMetadata(metadata ...*domain.Metadata) database.Change {
 	m.ctrl.T.Helper()
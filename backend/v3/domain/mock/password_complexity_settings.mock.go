@@ -551,6 +551,44 @@ func (c *MockPasswordComplexitySettingsRepositorySetCall) DoAndReturn(f func(con
 	return c
 }
 
+// SetCheckBreached mocks base method.
+func (m *MockPasswordComplexitySettingsRepository) SetCheckBreached(value bool) json.JsonUpdate {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCheckBreached", value)
+	ret0, _ := ret[0].(json.JsonUpdate)
+	return ret0
+}
+
+// SetCheckBreached indicates an expected call of SetCheckBreached.
+func (mr *MockPasswordComplexitySettingsRepositoryMockRecorder) SetCheckBreached(value any) *MockPasswordComplexitySettingsRepositorySetCheckBreachedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCheckBreached", reflect.TypeOf((*MockPasswordComplexitySettingsRepository)(nil).SetCheckBreached), value)
+	return &MockPasswordComplexitySettingsRepositorySetCheckBreachedCall{Call: call}
+}
+
+// MockPasswordComplexitySettingsRepositorySetCheckBreachedCall wrap *gomock.Call
+type MockPasswordComplexitySettingsRepositorySetCheckBreachedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPasswordComplexitySettingsRepositorySetCheckBreachedCall) Return(arg0 json.JsonUpdate) *MockPasswordComplexitySettingsRepositorySetCheckBreachedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPasswordComplexitySettingsRepositorySetCheckBreachedCall) Do(f func(bool) json.JsonUpdate) *MockPasswordComplexitySettingsRepositorySetCheckBreachedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPasswordComplexitySettingsRepositorySetCheckBreachedCall) DoAndReturn(f func(bool) json.JsonUpdate) *MockPasswordComplexitySettingsRepositorySetCheckBreachedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetHasLowercase mocks base method.
 func (m *MockPasswordComplexitySettingsRepository) SetHasLowercase(value bool) json.JsonUpdate {
 	m.ctrl.T.Helper()
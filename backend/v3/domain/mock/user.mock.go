@@ -740,6 +740,44 @@ func (c *MockUserRepositoryRemoveMetadataCall) DoAndReturn(f func(database.Condi
 	return c
 }
 
+// SetLockedUntil mocks base method.
+func (m *MockUserRepository) SetLockedUntil(lockedUntil *time.Time) database.Change {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLockedUntil", lockedUntil)
+	ret0, _ := ret[0].(database.Change)
+	return ret0
+}
+
+// SetLockedUntil indicates an expected call of SetLockedUntil.
+func (mr *MockUserRepositoryMockRecorder) SetLockedUntil(lockedUntil any) *MockUserRepositorySetLockedUntilCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLockedUntil", reflect.TypeOf((*MockUserRepository)(nil).SetLockedUntil), lockedUntil)
+	return &MockUserRepositorySetLockedUntilCall{Call: call}
+}
+
+// MockUserRepositorySetLockedUntilCall wrap *gomock.Call
+type MockUserRepositorySetLockedUntilCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUserRepositorySetLockedUntilCall) Return(arg0 database.Change) *MockUserRepositorySetLockedUntilCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUserRepositorySetLockedUntilCall) Do(f func(*time.Time) database.Change) *MockUserRepositorySetLockedUntilCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUserRepositorySetLockedUntilCall) DoAndReturn(f func(*time.Time) database.Change) *MockUserRepositorySetLockedUntilCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetMetadata mocks base method.
 func (m *MockUserRepository) SetMetadata(metadata ...*domain.Metadata) database.Change {
 	m.ctrl.T.Helper()
@@ -40,6 +40,10 @@ type PasswordCheckCommand struct {
 
 	// IsUserLocked indicates if the user has been locked (i.e. lockout policy check failed)
 	IsUserLocked bool
+	// UserLockedUntil is the time the user is automatically unlocked, if locked by the check
+	UserLockedUntil *time.Time
+	// IsUserUnlocked indicates if the user has been unlocked, because the lock expired
+	IsUserUnlocked bool
 }
 
 // NewPasswordCheckCommand initializes a new [PasswordCheckCommand]
@@ -81,18 +85,21 @@ func (p *PasswordCheckCommand) Events(ctx context.Context, opts *InvokeOpts) ([]
 		return nil, nil
 	}
 
-	toReturn := make([]eventstore.Command, 1, 3)
+	toReturn := make([]eventstore.Command, 0, 4)
 	userAgg := &user.NewAggregate(p.FetchedUser.ID, p.FetchedUser.OrganizationID).Aggregate
 
+	if p.IsUserUnlocked {
+		toReturn = append(toReturn, user.NewUserUnlockedEvent(ctx, userAgg))
+	}
 	if p.IsValidationSuccessful {
-		toReturn[0] = user.NewHumanPasswordCheckSucceededEvent(ctx, userAgg, nil)
+		toReturn = append(toReturn, user.NewHumanPasswordCheckSucceededEvent(ctx, userAgg, nil))
 		if p.UpdatedHashedPsw != "" {
 			toReturn = append(toReturn, user.NewHumanPasswordHashUpdatedEvent(ctx, userAgg, p.UpdatedHashedPsw))
 		}
 	} else {
-		toReturn[0] = user.NewHumanPasswordCheckFailedEvent(ctx, userAgg, nil)
+		toReturn = append(toReturn, user.NewHumanPasswordCheckFailedEvent(ctx, userAgg, nil))
 		if p.IsUserLocked {
-			toReturn = append(toReturn, user.NewUserLockedEvent(ctx, userAgg))
+			toReturn = append(toReturn, user.NewUserLockedEvent(ctx, userAgg, p.UserLockedUntil))
 		}
 	}
 
@@ -110,6 +117,12 @@ func (p *PasswordCheckCommand) Execute(ctx context.Context, opts *InvokeOpts) (e
 	humanRepo := opts.userRepo.Human()
 	sessionRepo := opts.sessionRepo
 
+	// the lock of the user expired (see [PasswordCheckCommand.Validate]), so the user is unlocked
+	if p.FetchedUser.State == UserStateLocked {
+		p.FetchedUser.unlock()
+		p.IsUserUnlocked = true
+	}
+
 	updatedHash, verifyErr := p.VerifierFn(p.FetchedUser.Human.Password.Hash, p.CheckPassword.Password)
 	pswCheckType, err := p.GetPasswordCheckAndError(verifyErr)
 	changes, changesErr := p.GetPasswordCheckChanges(ctx, opts, humanRepo, updatedHash, pswCheckType)
@@ -128,6 +141,12 @@ func (p *PasswordCheckCommand) Execute(ctx context.Context, opts *InvokeOpts) (e
 		}
 	}()
 
+	if p.IsUserUnlocked {
+		if txErr = unlockUser(ctx, tx, humanRepo, p.InstanceID, p.FetchedUser.ID); txErr != nil {
+			return txErr
+		}
+	}
+
 	updateCount, updateErr := humanRepo.Update(
 		ctx,
 		tx,
@@ -204,7 +223,8 @@ func (p *PasswordCheckCommand) GetPasswordCheckChanges(ctx context.Context, opts
 			lockoutPolicy.MaxPasswordAttempts != nil && *lockoutPolicy.MaxPasswordAttempts > 0 &&
 			uint64(p.FetchedUser.Human.Password.FailedAttempts+1) >= *lockoutPolicy.MaxPasswordAttempts {
 
-			dbUpdates = append(dbUpdates, humanRepo.SetState(UserStateLocked))
+			p.UserLockedUntil = lockoutPolicy.LockedUntil(ct.FailedAt)
+			dbUpdates = append(dbUpdates, humanRepo.SetState(UserStateLocked), humanRepo.SetLockedUntil(p.UserLockedUntil))
 			p.IsUserLocked = true
 		}
 	}
@@ -277,7 +297,7 @@ func (p *PasswordCheckCommand) Validate(ctx context.Context, opts *InvokeOpts) (
 	}
 	human := user.Human
 
-	if user.State == UserStateLocked {
+	if user.IsLocked(time.Now()) {
 		return zerrors.ThrowPreconditionFailedf(
 			NewPasswordVerificationError(user.Human.Password.FailedAttempts),
 			"DOM-D804Sj",
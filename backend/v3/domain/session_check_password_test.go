@@ -256,6 +256,49 @@ func TestPasswordCheckCommand_Validate(t *testing.T) {
 			},
 			expectedError: zerrors.ThrowPreconditionFailed(domain.NewPasswordVerificationError(5), "DOM-D804Sj", "Errors.User.Locked"),
 		},
+		{
+			testName: "when lock of user expired should return no error",
+			cmd:      domain.NewPasswordCheckCommand("session-1", "instance-1", nil, nil, &domain.CheckPasswordType{Password: "test-password"}),
+			sessionRepo: func(ctrl *gomock.Controller) domain.SessionRepository {
+				repo := domainmock.NewSessionRepo(ctrl)
+				idCondition := repo.IDCondition("session-1")
+				repo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), dbmock.QueryOptions(
+						database.WithCondition(
+							idCondition,
+						),
+					)).
+					Times(1).
+					Return(&domain.Session{
+						ID:     "session-1",
+						UserID: "user-1",
+					}, nil)
+				return repo
+			},
+			userRepo: func(ctrl *gomock.Controller) domain.UserRepository {
+				repo := domainmock.NewUserRepo(ctrl)
+				idCondition := repo.IDCondition("user-1")
+				repo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), dbmock.QueryOptions(
+						database.WithCondition(
+							idCondition,
+						),
+					)).
+					Times(1).
+					Return(&domain.User{
+						ID:          "user-1",
+						State:       domain.UserStateLocked,
+						LockedUntil: gu.Ptr(time.Now().Add(-time.Minute)),
+						Human: &domain.HumanUser{
+							Password: domain.HumanPassword{
+								Hash:           "hashed-password",
+								FailedAttempts: 5,
+							},
+						},
+					}, nil)
+				return repo
+			},
+		},
 		{
 			testName: "when user password is not set should return precondition failed error",
 			cmd:      domain.NewPasswordCheckCommand("session-1", "instance-1", nil, nil, &domain.CheckPasswordType{Password: "test-password"}),
@@ -453,6 +496,7 @@ func TestPasswordCheckCommand_GetPasswordCheckAndError(t *testing.T) {
 func TestPasswordCheckCommand_GetPasswordCheckChanges(t *testing.T) {
 	t.Parallel()
 	listErr := errors.New("list error")
+	failedAt := time.Now()
 
 	tt := []struct {
 		testName    string
@@ -462,10 +506,11 @@ func TestPasswordCheckCommand_GetPasswordCheckChanges(t *testing.T) {
 		checkType   domain.VerificationType
 		cmd         *domain.PasswordCheckCommand
 
-		expectedChanges    int
-		expectedError      error
-		expectedHashedPsw  string
-		expectedUserLocked bool
+		expectedChanges         int
+		expectedError           error
+		expectedHashedPsw       string
+		expectedUserLocked      bool
+		expectedUserLockedUntil *time.Time
 	}{
 		{
 			testName: "when check type succeeded with empty hash should return only check password change",
@@ -663,9 +708,60 @@ func TestPasswordCheckCommand_GetPasswordCheckChanges(t *testing.T) {
 					Human:          &domain.HumanUser{Password: domain.HumanPassword{FailedAttempts: 2}},
 				},
 			},
-			expectedChanges:    2,
+			expectedChanges:    3,
 			expectedUserLocked: true,
 		},
+		{
+			testName: "when check type failed and lockout policy has lockout duration should lock user until duration passed",
+			humanRepo: func(ctrl *gomock.Controller, checkTime time.Time) domain.HumanUserRepository {
+				repo := domainmock.NewHumanRepo(ctrl)
+				return repo
+			},
+			lockoutRepo: func(ctrl *gomock.Controller) domain.LockoutSettingsRepository {
+				repo := domainmock.NewLockoutSettingsRepo(ctrl)
+
+				instanceAndOrg := database.And(repo.InstanceIDCondition("instance-1"), repo.OrganizationIDCondition(gu.Ptr("org-1")))
+				orgNullOrEmpty := database.Or(repo.OrganizationIDCondition(nil), repo.OrganizationIDCondition(gu.Ptr("")))
+				onlyInstance := database.And(repo.InstanceIDCondition("instance-1"), orgNullOrEmpty)
+				orCondition := database.Or(instanceAndOrg, onlyInstance)
+
+				setting := &domain.LockoutSettings{
+					Settings: domain.Settings{},
+					LockoutSettingsAttributes: domain.LockoutSettingsAttributes{
+						MaxPasswordAttempts: gu.Ptr(uint64(1)),
+						LockoutDuration:     gu.Ptr(time.Hour),
+					},
+				}
+
+				repo.EXPECT().
+					List(gomock.Any(), gomock.Any(),
+						dbmock.QueryOptions(database.WithCondition(orCondition)),
+						dbmock.QueryOptions(database.WithOrderByAscending(repo.OrganizationIDColumn(), repo.InstanceIDColumn())),
+						dbmock.QueryOptions(database.WithLimit(1)),
+					).Times(1).
+					Return([]*domain.LockoutSettings{
+						setting,
+					}, nil)
+
+				return repo
+			},
+			updatedHash: "",
+			checkType:   &domain.VerificationTypeFailed{FailedAt: failedAt},
+			cmd: &domain.PasswordCheckCommand{
+				CheckTime:     failedAt,
+				SessionID:     "session-1",
+				InstanceID:    "instance-1",
+				VerifierFn:    func(_, _ string) (_ string, _ error) { return "", nil },
+				CheckPassword: &domain.CheckPasswordType{Password: "test"},
+				FetchedUser: domain.User{
+					OrganizationID: "org-1",
+					Human:          &domain.HumanUser{Password: domain.HumanPassword{FailedAttempts: 2}},
+				},
+			},
+			expectedChanges:         3,
+			expectedUserLocked:      true,
+			expectedUserLockedUntil: gu.Ptr(failedAt.Add(time.Hour)),
+		},
 	}
 
 	for _, tc := range tt {
@@ -691,6 +787,7 @@ func TestPasswordCheckCommand_GetPasswordCheckChanges(t *testing.T) {
 			assert.ErrorIs(t, err, tc.expectedError)
 			assert.Equal(t, tc.expectedHashedPsw, tc.cmd.UpdatedHashedPsw)
 			assert.Equal(t, tc.expectedUserLocked, tc.cmd.IsUserLocked)
+			assert.Equal(t, tc.expectedUserLockedUntil, tc.cmd.UserLockedUntil)
 			assert.Len(t, changes, tc.expectedChanges)
 		})
 	}
@@ -715,6 +812,7 @@ func TestPasswordCheckCommand_Execute(t *testing.T) {
 		expectedValidated         bool
 		expectedValidationSuccess bool
 		expectedUserLocked        bool
+		expectedUserUnlocked      bool
 	}{
 		{
 			testName:      "when checkPassword is nil should return no error",
@@ -1022,6 +1120,57 @@ func TestPasswordCheckCommand_Execute(t *testing.T) {
 			expectedValidationSuccess: false,
 			expectedUserLocked:        true,
 		},
+		{
+			testName: "when lock of user expired should unlock user and check password",
+			cmd: &domain.PasswordCheckCommand{
+				CheckPassword: &domain.CheckPasswordType{Password: "test-password"},
+				SessionID:     "session-1",
+				InstanceID:    "instance-1",
+				VerifierFn:    func(password string, hash string) (string, error) { return "", nil },
+				FetchedUser: domain.User{
+					ID:             "user-1",
+					OrganizationID: "org-1",
+					State:          domain.UserStateLocked,
+					LockedUntil:    gu.Ptr(time.Now().Add(-time.Minute)),
+					Human: &domain.HumanUser{Password: domain.HumanPassword{
+						Hash:           "hashed-password",
+						FailedAttempts: 5,
+					}},
+				},
+			},
+			humanRepo: func(ctrl *gomock.Controller) domain.HumanUserRepository {
+				repo := domainmock.NewHumanRepo(ctrl)
+				unlockChanges := database.Changes{
+					repo.SetState(domain.UserStateActive),
+					repo.SetLockedUntil(nil),
+					repo.ResetPasswordFailedAttempts(),
+					repo.ResetTOTPFailedAttempts(),
+					repo.ResetRecoveryCodeFailedAttempts(),
+				}
+				repo.EXPECT().
+					Update(gomock.Any(), gomock.Any(), repo.PrimaryKeyCondition("instance-1", "user-1"), unlockChanges).
+					Times(1).
+					Return(int64(1), nil)
+				repo.EXPECT().
+					Update(gomock.Any(), gomock.Any(), repo.IDCondition("user-1"), gomock.Any()).
+					Times(1).
+					Return(int64(1), nil)
+				return repo
+			},
+			sessionRepo: func(ctrl *gomock.Controller) domain.SessionRepository {
+				repo := domainmock.NewSessionRepo(ctrl)
+				idCondition := repo.IDCondition("session-1")
+				sessionFactorChange := repo.SetFactor(&domain.SessionFactorPassword{LastVerifiedAt: time.Now()})
+				repo.EXPECT().
+					Update(gomock.Any(), gomock.Any(), idCondition, sessionFactorChange).
+					Times(1).
+					Return(int64(1), nil)
+				return repo
+			},
+			expectedValidated:         true,
+			expectedValidationSuccess: true,
+			expectedUserUnlocked:      true,
+		},
 	}
 
 	for _, tc := range tt {
@@ -1062,6 +1211,7 @@ func TestPasswordCheckCommand_Execute(t *testing.T) {
 				assert.Equal(t, tc.expectedValidated, tc.cmd.IsValidated)
 				assert.Equal(t, tc.expectedValidationSuccess, tc.cmd.IsValidationSuccessful)
 				assert.Equal(t, tc.expectedUserLocked, tc.cmd.IsUserLocked)
+				assert.Equal(t, tc.expectedUserUnlocked, tc.cmd.IsUserUnlocked)
 				assert.Equal(t, tc.expectTarpitCalled, tarpitCalled)
 			}
 		})
@@ -1167,7 +1317,7 @@ func TestPasswordCheckCommand_Events(t *testing.T) {
 			},
 			expectedEventTypes: []eventstore.Command{
 				user.NewHumanPasswordCheckFailedEvent(t.Context(), &userAgg, nil),
-				user.NewUserLockedEvent(t.Context(), &userAgg),
+				user.NewUserLockedEvent(t.Context(), &userAgg, nil),
 				session.NewPasswordCheckedEvent(t.Context(), &sessAgg, time.Now())},
 		},
 	}
@@ -31,6 +31,8 @@ type RecoveryCodeCheckCommand struct {
 
 	checkSucceeded     bool
 	userLocked         bool
+	userLockedUntil    *time.Time
+	userUnlocked       bool
 	checkedAt          time.Time
 	hashedRecoveryCode string
 }
@@ -108,7 +110,7 @@ func (rc *RecoveryCodeCheckCommand) Validate(ctx context.Context, opts *InvokeOp
 	}
 
 	// check user state
-	if retrievedUser.State == UserStateLocked {
+	if retrievedUser.IsLocked(time.Now()) {
 		return zerrors.ThrowPreconditionFailed(nil, "DOM-47H1Ii", "Errors.User.Locked")
 	}
 
@@ -130,6 +132,15 @@ func (rc *RecoveryCodeCheckCommand) Execute(ctx context.Context, opts *InvokeOpt
 		return nil
 	}
 
+	// the lock of the user expired (see [RecoveryCodeCheckCommand.Validate]), so the user is unlocked
+	if rc.user.State == UserStateLocked {
+		if err := unlockUser(ctx, opts.DB(), opts.userRepo.Human(), rc.InstanceID, rc.user.ID); err != nil {
+			return err
+		}
+		rc.user.unlock()
+		rc.userUnlocked = true
+	}
+
 	hashedRecoveryCode, checkErr := validateRecoveryCode(rc.CheckRecoveryCode.RecoveryCode, rc.user.Human.RecoveryCodes.Codes, rc.verify)
 	if checkErr != nil {
 		err = rc.handleRecoveryCodeCheckFailed(ctx, opts)
@@ -147,8 +158,17 @@ func (rc *RecoveryCodeCheckCommand) Events(ctx context.Context, _ *InvokeOpts) (
 		return nil, nil
 	}
 
+	events := make([]eventstore.Command, 0, 3)
+	if rc.userUnlocked {
+		events = append(events,
+			user.NewUserUnlockedEvent(
+				ctx,
+				&user.NewAggregate(rc.user.ID, rc.user.OrganizationID).Aggregate,
+			),
+		)
+	}
+
 	if !rc.checkSucceeded {
-		events := make([]eventstore.Command, 0, 2)
 		events = append(events,
 			user.NewHumanRecoveryCodeCheckFailedEvent(
 				ctx,
@@ -161,13 +181,14 @@ func (rc *RecoveryCodeCheckCommand) Events(ctx context.Context, _ *InvokeOpts) (
 				user.NewUserLockedEvent(
 					ctx,
 					&user.NewAggregate(rc.user.ID, rc.user.OrganizationID).Aggregate,
+					rc.userLockedUntil,
 				),
 			)
 		}
 		return events, nil
 	}
 
-	return []eventstore.Command{
+	return append(events,
 		user.NewHumanRecoveryCodeCheckSucceededEvent(
 			ctx,
 			&user.NewAggregate(rc.user.ID, rc.user.OrganizationID).Aggregate,
@@ -179,7 +200,7 @@ func (rc *RecoveryCodeCheckCommand) Events(ctx context.Context, _ *InvokeOpts) (
 			&session.NewAggregate(rc.SessionID, rc.InstanceID).Aggregate,
 			rc.checkedAt,
 		),
-	}, nil
+	), nil
 }
 
 // RequiresTransaction implements [Transactional].
@@ -198,7 +219,7 @@ func (rc *RecoveryCodeCheckCommand) handleRecoveryCodeCheckFailed(ctx context.Co
 
 	// update user state and recovery_code_failed_attempts
 	humanRepo := opts.userRepo.Human()
-	userUpdates := make([]database.Change, 0, 2)
+	userUpdates := make([]database.Change, 0, 3)
 
 	// update recovery_code_failed_attempts for the user
 	userUpdates = append(userUpdates, humanRepo.IncrementRecoveryCodeFailedAttempts())
@@ -208,7 +229,8 @@ func (rc *RecoveryCodeCheckCommand) handleRecoveryCodeCheckFailed(ctx context.Co
 		lockoutPolicy.MaxOTPAttempts != nil &&
 		*lockoutPolicy.MaxOTPAttempts > 0 &&
 		(uint64(rc.user.Human.RecoveryCodes.FailedAttempts)+1 >= *lockoutPolicy.MaxOTPAttempts) {
-		userUpdates = append(userUpdates, humanRepo.SetState(UserStateLocked))
+		rc.userLockedUntil = lockoutPolicy.LockedUntil(checkTime)
+		userUpdates = append(userUpdates, humanRepo.SetState(UserStateLocked), humanRepo.SetLockedUntil(rc.userLockedUntil))
 		rc.userLocked = true
 	}
 
@@ -178,6 +178,31 @@ func TestRecoveryCodeCheckCommand_Validate(t *testing.T) {
 			},
 			wantErr: zerrors.ThrowPreconditionFailed(nil, "DOM-47H1Ii", "Errors.User.Locked"),
 		},
+		{
+			name:       "user lock expired - lock not enforced",
+			sessionID:  "session-1",
+			instanceID: "instance-1",
+			check:      &domain.CheckTypeRecoveryCode{RecoveryCode: "test-code"},
+			sessionRepo: func(ctrl *gomock.Controller) domain.SessionRepository {
+				sessionRepo := domainmock.NewSessionRepo(ctrl)
+				primaryKeyCondition := sessionRepo.PrimaryKeyCondition("instance-1", "session-1")
+				sessionRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), dbmock.QueryOptions(database.WithCondition(primaryKeyCondition))).
+					Times(1).
+					Return(&domain.Session{UserID: "user-1"}, nil)
+				return sessionRepo
+			},
+			userRepo: func(ctrl *gomock.Controller) domain.UserRepository {
+				userRepo := domainmock.NewUserRepo(ctrl)
+				userIDCondition := userRepo.IDCondition("user-1")
+				userRepo.EXPECT().
+					Get(gomock.Any(), gomock.Any(), dbmock.QueryOptions(database.WithCondition(userIDCondition))).
+					Times(1).
+					Return(&domain.User{State: domain.UserStateLocked, LockedUntil: gu.Ptr(time.Now().Add(-time.Minute))}, nil)
+				return userRepo
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "DOM-tzN2a1", "Errors.User.MFA.RecoveryCodes.NotReady"),
+		},
 		{
 			name:       "user does not have recovery codes - not a human user",
 			sessionID:  "session-1",
@@ -613,7 +638,7 @@ func TestRecoveryCodeCheckCommand_Execute(t *testing.T) {
 				// set up expectation to update user in Execute()
 				humanRepo := domainmock.NewHumanRepo(ctrl)
 				updateHumanUserSucceededExpectation(userRepo, humanRepo, humanRepo.IncrementRecoveryCodeFailedAttempts(),
-					humanRepo.SetState(domain.UserStateLocked))
+					humanRepo.SetState(domain.UserStateLocked), humanRepo.SetLockedUntil(nil))
 
 				return userRepo
 			},
@@ -1107,6 +1132,7 @@ func TestRecoveryCodeCheckCommand_Events(t *testing.T) {
 					humanRepo,
 					humanRepo.IncrementRecoveryCodeFailedAttempts(),
 					humanRepo.SetState(domain.UserStateLocked),
+					humanRepo.SetLockedUntil(nil),
 				)
 
 				return userRepo
@@ -1126,6 +1152,7 @@ func TestRecoveryCodeCheckCommand_Events(t *testing.T) {
 				user.NewUserLockedEvent(
 					t.Context(),
 					&user.NewAggregate("user-1", "org-1").Aggregate,
+					nil,
 				),
 			},
 			wantErr: zerrors.ThrowInvalidArgument(nil, "DOM-845kaq", "Errors.User.MFA.RecoveryCodes.InvalidCode"),
@@ -1313,11 +1340,11 @@ func updateHumanUserFailedExpectation(ctrl *gomock.Controller, userRepo *domainm
 	humanRepo := domainmock.NewHumanRepo(ctrl)
 	primaryKeyCondition := humanRepo.PrimaryKeyCondition("instance-1", "user-1")
 
-	userUpdates := make([]database.Change, 0, 2)
+	userUpdates := make([]database.Change, 0, 3)
 	userUpdates = append(userUpdates, humanRepo.IncrementRecoveryCodeFailedAttempts())
 
 	if lock {
-		userUpdates = append(userUpdates, humanRepo.SetState(domain.UserStateLocked))
+		userUpdates = append(userUpdates, humanRepo.SetState(domain.UserStateLocked), humanRepo.SetLockedUntil(nil))
 	}
 	userRepo.EXPECT().Human().Times(1).Return(humanRepo)
 
@@ -31,6 +31,8 @@ type TOTPCheckCommand struct {
 	// For Events()
 	IsCheckSuccessful bool
 	IsUserLocked      bool
+	UserLockedUntil   *time.Time
+	IsUserUnlocked    bool
 	CheckedAt         time.Time
 }
 
@@ -82,16 +84,19 @@ func (t *TOTPCheckCommand) Events(ctx context.Context, opts *InvokeOpts) ([]even
 		return nil, nil
 	}
 
-	events := make([]eventstore.Command, 1, 2)
+	events := make([]eventstore.Command, 0, 3)
 	userAgg := &user.NewAggregate(t.FetchedUser.ID, t.FetchedUser.OrganizationID).Aggregate
+	if t.IsUserUnlocked {
+		events = append(events, user.NewUserUnlockedEvent(ctx, userAgg))
+	}
 	if t.IsCheckSuccessful {
-		events[0] = user.NewHumanOTPCheckSucceededEvent(ctx, userAgg, nil)
+		events = append(events, user.NewHumanOTPCheckSucceededEvent(ctx, userAgg, nil))
 		return append(events, session.NewTOTPCheckedEvent(ctx, &session.NewAggregate(t.SessionID, t.InstanceID).Aggregate, t.CheckedAt)), nil
 	}
-	events[0] = user.NewHumanOTPCheckFailedEvent(ctx, userAgg, nil)
+	events = append(events, user.NewHumanOTPCheckFailedEvent(ctx, userAgg, nil))
 
 	if t.IsUserLocked {
-		events = append(events, user.NewUserLockedEvent(ctx, userAgg))
+		events = append(events, user.NewUserLockedEvent(ctx, userAgg, t.UserLockedUntil))
 	}
 
 	return events, nil
@@ -106,6 +111,15 @@ func (t *TOTPCheckCommand) Execute(ctx context.Context, opts *InvokeOpts) (err e
 	sessionRepo := opts.sessionRepo
 	humanRepo := opts.userRepo.Human()
 
+	// the lock of the user expired (see [TOTPCheckCommand.Validate]), so the user is unlocked
+	if t.FetchedUser.State == UserStateLocked {
+		if err := unlockUser(ctx, opts.DB(), humanRepo, t.InstanceID, t.FetchedUser.ID); err != nil {
+			return err
+		}
+		t.FetchedUser.unlock()
+		t.IsUserUnlocked = true
+	}
+
 	verifyErr := t.verifyTOTP(t.FetchedUser.Human.TOTP.Secret)
 
 	t.CheckedAt = time.Now()
@@ -130,7 +144,7 @@ func (t *TOTPCheckCommand) Execute(ctx context.Context, opts *InvokeOpts) (err e
 		return nil
 	}
 
-	changes := make(database.Changes, 1, 2)
+	changes := make(database.Changes, 1, 3)
 	changes[0] = humanRepo.IncrementTOTPFailedAttempts()
 
 	policy, err := GetLockoutPolicy(ctx, opts.DB(), opts.lockoutSettingRepo, t.InstanceID, t.FetchedUser.OrganizationID)
@@ -141,7 +155,8 @@ func (t *TOTPCheckCommand) Execute(ctx context.Context, opts *InvokeOpts) (err e
 	if policy != nil &&
 		policy.MaxOTPAttempts != nil && *policy.MaxOTPAttempts > 0 &&
 		uint64(t.FetchedUser.Human.TOTP.FailedAttempts)+1 >= *policy.MaxOTPAttempts {
-		changes = append(changes, humanRepo.SetState(UserStateLocked))
+		t.UserLockedUntil = policy.LockedUntil(t.CheckedAt)
+		changes = append(changes, humanRepo.SetState(UserStateLocked), humanRepo.SetLockedUntil(t.UserLockedUntil))
 		t.IsUserLocked = true
 	}
 
@@ -228,7 +243,7 @@ func (t *TOTPCheckCommand) Validate(ctx context.Context, opts *InvokeOpts) (err
 		return zerrors.ThrowPreconditionFailed(nil, "DOM-0g4ZAU", "Errors.User.MFA.OTP.NotReady")
 	}
 
-	if user.State == UserStateLocked {
+	if user.IsLocked(time.Now()) {
 		return zerrors.ThrowPreconditionFailed(nil, "DOM-gM4SUh", "Errors.User.Locked")
 	}
 
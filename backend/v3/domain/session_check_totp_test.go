@@ -269,6 +269,39 @@ func TestTOTPCheckCommand_Validate(t *testing.T) {
 			},
 			expectedError: zerrors.ThrowPreconditionFailed(nil, "DOM-gM4SUh", "Errors.User.Locked"),
 		},
+		{
+			testName: "when lock of user expired should return no error and set user",
+			cmd:      &domain.TOTPCheckCommand{SessionID: "session-1", InstanceID: "instance-1", CheckTOTP: &domain.CheckTOTPType{Code: "123456"}},
+			sessionRepo: func(ctrl *gomock.Controller) domain.SessionRepository {
+				repo := domainmock.NewSessionRepo(ctrl)
+				idCondition := repo.PrimaryKeyCondition("instance-1", "session-1")
+				repo.EXPECT().
+					Get(gomock.Any(), gomock.Any(),
+						dbmock.QueryOptions(database.WithCondition(idCondition))).
+					Return(&domain.Session{UserID: "user-1"}, nil)
+				return repo
+			},
+			userRepo: func(ctrl *gomock.Controller) domain.UserRepository {
+				repo := domainmock.NewUserRepo(ctrl)
+				idCondition := repo.PrimaryKeyCondition("instance-1", "user-1")
+				repo.EXPECT().
+					Get(gomock.Any(), gomock.Any(),
+						dbmock.QueryOptions(database.WithCondition(idCondition))).
+					Return(&domain.User{
+						ID:          "user-1",
+						State:       domain.UserStateLocked,
+						LockedUntil: &now,
+						Human:       &domain.HumanUser{TOTP: &domain.HumanTOTP{Secret: &crypto.CryptoValue{}, VerifiedAt: now}},
+					}, nil)
+				return repo
+			},
+			expectedUser: domain.User{
+				ID:          "user-1",
+				State:       domain.UserStateLocked,
+				LockedUntil: &now,
+				Human:       &domain.HumanUser{TOTP: &domain.HumanTOTP{Secret: &crypto.CryptoValue{}, VerifiedAt: now}},
+			},
+		},
 		{
 			testName: "when all validations pass should return no error and set user",
 			cmd:      &domain.TOTPCheckCommand{SessionID: "session-1", InstanceID: "instance-1", CheckTOTP: &domain.CheckTOTPType{Code: "123456"}},
@@ -757,6 +790,7 @@ func TestTOTPCheckCommand_Execute(t *testing.T) {
 				changes := database.Changes{
 					repo.IncrementTOTPFailedAttempts(),
 					repo.SetState(domain.UserStateLocked),
+					repo.SetLockedUntil(nil),
 				}
 				repo.EXPECT().
 					Update(gomock.Any(), gomock.Any(),
@@ -906,7 +940,24 @@ func TestTOTPCheckCommand_Events(t *testing.T) {
 			},
 			expectedEvents: []eventstore.Command{
 				user.NewHumanOTPCheckFailedEvent(t.Context(), &userAgg, nil),
-				user.NewUserLockedEvent(t.Context(), &userAgg),
+				user.NewUserLockedEvent(t.Context(), &userAgg, nil),
+			},
+		},
+		{
+			testName: "when lock of user expired should emit user unlocked event before check events",
+			cmd: &domain.TOTPCheckCommand{
+				CheckTOTP:         &domain.CheckTOTPType{},
+				SessionID:         "session-1",
+				InstanceID:        "instance-1",
+				FetchedUser:       domain.User{ID: "user-1", OrganizationID: "org-1"},
+				IsUserUnlocked:    true,
+				IsCheckSuccessful: true,
+				CheckedAt:         time.Now(),
+			},
+			expectedEvents: []eventstore.Command{
+				user.NewUserUnlockedEvent(t.Context(), &userAgg),
+				user.NewHumanOTPCheckSucceededEvent(t.Context(), &userAgg, nil),
+				session.NewTOTPCheckedEvent(t.Context(), &sessionAgg, time.Now()),
 			},
 		},
 	}
@@ -50,3 +50,19 @@ func listLockoutSettingCondition(repo LockoutSettingsRepository, instanceID, org
 
 	return database.WithCondition(database.Or(instanceAndOrg, onlyInstance))
 }
+
+// unlockUser unlocks a user whose lock expired (see [User.IsLocked]).
+// The failed attempts of the checks are counted from the start again.
+func unlockUser(ctx context.Context, db database.QueryExecutor, humanRepo HumanUserRepository, instanceID, userID string) error {
+	rowCount, err := humanRepo.Update(ctx, db,
+		humanRepo.PrimaryKeyCondition(instanceID, userID),
+		database.Changes{
+			humanRepo.SetState(UserStateActive),
+			humanRepo.SetLockedUntil(nil),
+			humanRepo.ResetPasswordFailedAttempts(),
+			humanRepo.ResetTOTPFailedAttempts(),
+			humanRepo.ResetRecoveryCodeFailedAttempts(),
+		},
+	)
+	return handleUpdateError(err, 1, rowCount, "DOM-Ul8kxq", "user")
+}
@@ -249,6 +249,7 @@ type passwordComplexitySettingsJSONChanges interface {
 	SetHasUppercase(value bool) db_json.JsonUpdate
 	SetHasNumber(value bool) db_json.JsonUpdate
 	SetHasSymbol(value bool) db_json.JsonUpdate
+	SetCheckBreached(value bool) db_json.JsonUpdate
 }
 
 type PasswordComplexitySettings struct {
@@ -257,11 +258,12 @@ type PasswordComplexitySettings struct {
 }
 
 type PasswordComplexitySettingsAttributes struct {
-	MinLength    *uint64 `json:"minLength,omitempty"`
-	HasLowercase *bool   `json:"hasLowercase,omitempty"`
-	HasUppercase *bool   `json:"hasUppercase,omitempty"`
-	HasNumber    *bool   `json:"hasNumber,omitempty"`
-	HasSymbol    *bool   `json:"hasSymbol,omitempty"`
+	MinLength     *uint64 `json:"minLength,omitempty"`
+	HasLowercase  *bool   `json:"hasLowercase,omitempty"`
+	HasUppercase  *bool   `json:"hasUppercase,omitempty"`
+	HasNumber     *bool   `json:"hasNumber,omitempty"`
+	HasSymbol     *bool   `json:"hasSymbol,omitempty"`
+	CheckBreached *bool   `json:"checkBreached,omitempty"`
 }
 
 //go:generate mockgen -typed -package domainmock -destination ./mock/password_complexity_settings.mock.go . PasswordComplexitySettingsRepository
@@ -307,9 +309,21 @@ type LockoutSettings struct {
 }
 
 type LockoutSettingsAttributes struct {
-	MaxPasswordAttempts *uint64 `json:"maxPasswordAttempts,omitempty"`
-	MaxOTPAttempts      *uint64 `json:"maxOtpAttempts,omitempty"`
-	ShowLockOutFailures *bool   `json:"showLockOutFailures,omitempty"`
+	MaxPasswordAttempts *uint64        `json:"maxPasswordAttempts,omitempty"`
+	MaxOTPAttempts      *uint64        `json:"maxOtpAttempts,omitempty"`
+	ShowLockOutFailures *bool          `json:"showLockOutFailures,omitempty"`
+	LockoutDuration     *time.Duration `json:"lockoutDuration,omitempty"`
+	FailedAttemptDelay  *time.Duration `json:"failedAttemptDelay,omitempty"`
+}
+
+// LockedUntil returns the time a user locked at the provided time is automatically unlocked
+// or nil if the lock does not expire.
+func (s *LockoutSettings) LockedUntil(lockedAt time.Time) *time.Time {
+	if s == nil || s.LockoutDuration == nil || *s.LockoutDuration <= 0 {
+		return nil
+	}
+	until := lockedAt.Add(*s.LockoutDuration)
+	return &until
 }
 
 //go:generate mockgen -typed -package domainmock -destination ./mock/lockout_settings.mock.go . LockoutSettingsRepository
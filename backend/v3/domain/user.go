@@ -6,6 +6,7 @@ import (
 	"golang.org/x/text/language"
 
 	"github.com/zitadel/zitadel/internal/crypto"
+	old_domain "github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -16,6 +17,7 @@ type User struct {
 	Username       string      `json:"username,omitempty" db:"username"`
 	LoginNames     []LoginName `json:"loginNames,omitempty" db:"login_names"`
 	State          UserState   `json:"state,omitempty" db:"state"`
+	LockedUntil    *time.Time  `json:"lockedUntil,omitzero" db:"locked_until"`
 	CreatedAt      time.Time   `json:"createdAt,omitzero" db:"created_at"`
 	UpdatedAt      time.Time   `json:"updatedAt,omitzero" db:"updated_at"`
 
@@ -24,6 +26,27 @@ type User struct {
 	Metadata []*Metadata  `json:"metadata,omitempty" db:"metadata"`
 }
 
+// IsLocked returns true if the user is locked and the lock did not expire at the provided time.
+func (u *User) IsLocked(now time.Time) bool {
+	return u.State == UserStateLocked && !old_domain.IsLockExpired(u.LockedUntil, now)
+}
+
+// unlock resets the lock and the failed attempts of the checks as done by [unlockUser].
+func (u *User) unlock() {
+	u.State = UserStateActive
+	u.LockedUntil = nil
+	if u.Human == nil {
+		return
+	}
+	u.Human.Password.FailedAttempts = 0
+	if u.Human.TOTP != nil {
+		u.Human.TOTP.FailedAttempts = 0
+	}
+	if u.Human.RecoveryCodes != nil {
+		u.Human.RecoveryCodes.FailedAttempts = 0
+	}
+}
+
 type LoginName struct {
 	LoginName   string `json:"loginName,omitempty" db:"-"`
 	IsPreferred bool   `json:"isPreferred,omitempty" db:"-"`
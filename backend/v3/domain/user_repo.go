@@ -53,6 +53,9 @@ type userChanges interface {
 	// SetState sets the state field
 	// [UserStateUnspecified] is not allowed and will result in no change
 	SetState(state UserState) database.Change
+	// SetLockedUntil sets the time a locked user is automatically unlocked
+	// nil removes the time, so the user stays locked until unlocked explicitly
+	SetLockedUntil(lockedUntil *time.Time) database.Change
 	// SetUpdatedAt sets the updated at field
 	// This is used to replay events
 	SetUpdatedAt(updatedAt time.Time) database.Change
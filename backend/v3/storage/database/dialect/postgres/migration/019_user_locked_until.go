@@ -0,0 +1,16 @@
+package migration
+
+import (
+	_ "embed"
+)
+
+var (
+	//go:embed 019_user_locked_until/up.sql
+	up019UserLockedUntil string
+	//go:embed 019_user_locked_until/down.sql
+	down019UserLockedUntil string
+)
+
+func init() {
+	registerSQLMigration(19, up019UserLockedUntil, down019UserLockedUntil)
+}
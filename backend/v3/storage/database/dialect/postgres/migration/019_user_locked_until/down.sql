@@ -0,0 +1 @@
+ALTER TABLE zitadel.users DROP COLUMN IF EXISTS locked_until;
@@ -0,0 +1 @@
+ALTER TABLE zitadel.users ADD COLUMN IF NOT EXISTS locked_until TIMESTAMPTZ;
@@ -699,6 +699,9 @@ func (s passwordComplexitySettings) SetSettingFields(value domain.PasswordComple
 	if value.HasSymbol != nil {
 		changes = append(changes, s.SetHasSymbol(*value.HasSymbol))
 	}
+	if value.CheckBreached != nil {
+		changes = append(changes, s.SetCheckBreached(*value.CheckBreached))
+	}
 	return db_json.NewJsonChanges(s.SettingsColumn(), changes...)
 }
 
@@ -722,6 +725,10 @@ func (passwordComplexitySettings) SetHasSymbol(value bool) db_json.JsonUpdate {
 	return db_json.NewFieldChange([]string{"hasSymbol"}, value)
 }
 
+func (passwordComplexitySettings) SetCheckBreached(value bool) db_json.JsonUpdate {
+	return db_json.NewFieldChange([]string{"checkBreached"}, value)
+}
+
 func PasswordComplexitySettingsRepository() domain.PasswordComplexitySettingsRepository {
 	return &passwordComplexitySettings{
 		settings{},
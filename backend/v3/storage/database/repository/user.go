@@ -69,7 +69,7 @@ func (u user) Delete(ctx context.Context, client database.QueryExecutor, conditi
 }
 
 var queryUserStmt = "SELECT users.instance_id, users.organization_id, users.id, users.username" +
-	", users.state, users.created_at, users.updated_at" +
+	", users.state, users.locked_until, users.created_at, users.updated_at" +
 	// metadata
 	`, jsonb_agg(DISTINCT jsonb_build_object('instanceId', user_metadata.instance_id, 'key', user_metadata.key, 'value', encode(user_metadata.value, 'base64'), 'createdAt', user_metadata.created_at, 'updatedAt', user_metadata.updated_at)) FILTER (WHERE user_metadata.user_id IS NOT NULL) AS metadata` +
 	// login names
@@ -211,6 +211,11 @@ func (u user) SetState(state domain.UserState) database.Change {
 	return database.NewChange(u.StateColumn(), state)
 }
 
+// SetLockedUntil implements [domain.UserRepository].
+func (u user) SetLockedUntil(lockedUntil *time.Time) database.Change {
+	return database.NewChangePtr(u.lockedUntilColumn(), lockedUntil)
+}
+
 // SetUpdatedAt implements [domain.UserRepository].
 func (u user) SetUpdatedAt(updatedAt time.Time) database.Change {
 	return database.NewChange(u.updatedAtColumn(), updatedAt)
@@ -344,6 +349,10 @@ func (u user) InstanceIDColumn() database.Column {
 	return database.NewColumn(u.unqualifiedTableName(), "instance_id")
 }
 
+func (u user) lockedUntilColumn() database.Column {
+	return database.NewColumn(u.unqualifiedTableName(), "locked_until")
+}
+
 func (u user) updatedAtColumn() database.Column {
 	return database.NewColumn(u.unqualifiedTableName(), "updated_at")
 }
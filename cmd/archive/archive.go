@@ -0,0 +1,100 @@
+package archive
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore/archive"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	flagInstance = "instance"
+	flagFrom     = "from"
+	flagTo       = "to"
+)
+
+type Config struct {
+	Database database.Config
+	Archive  archive.Config
+}
+
+func New() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "archive",
+		Short: "manage archived events",
+	}
+	cmd.AddCommand(newRestore())
+	return cmd
+}
+
+func newRestore() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore --instance <instanceID> [--from <time>] [--to <time>]",
+		Short: "restore archived events",
+		Long: `pushes the archived events of an instance back into the eventstore
+the events are read from the configured archive sink (Archive.Sink)
+events already present in the eventstore are skipped
+reduce the archive horizon of the instance first, otherwise the events are archived again
+Requirements:
+- postgreSQL`,
+		Example: `restore --instance 123
+restore --instance 123 --from 2024-01-01T00:00:00Z --to 2024-02-01T00:00:00Z`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			defer func() {
+				if err != nil {
+					slog.Error("zitadel archive restore command failed", "err", err)
+				}
+			}()
+
+			instanceID, _ := cmd.Flags().GetString(flagInstance)
+			from, err := timeFlag(cmd, flagFrom, time.Time{})
+			if err != nil {
+				return err
+			}
+			to, err := timeFlag(cmd, flagTo, time.Now())
+			if err != nil {
+				return err
+			}
+			config := new(Config)
+			if err := viper.Unmarshal(config); err != nil {
+				return err
+			}
+			client, err := database.Connect(config.Database, false)
+			if err != nil {
+				return err
+			}
+			archiver, err := archive.NewArchiver(client, &config.Archive)
+			if err != nil {
+				return err
+			}
+			restored, err := archiver.Restore(cmd.Context(), instanceID, from, to)
+			if err != nil {
+				return err
+			}
+			slog.Info("archived events restored", "instanceID", instanceID, "events", restored)
+			return nil
+		},
+	}
+	cmd.Flags().String(flagInstance, "", "id of the instance to restore the events of")
+	cmd.Flags().String(flagFrom, "", "restore events created at or after, RFC 3339 formatted (default: all)")
+	cmd.Flags().String(flagTo, "", "restore events created before, RFC 3339 formatted (default: now)")
+	_ = cmd.MarkFlagRequired(flagInstance)
+	return cmd
+}
+
+func timeFlag(cmd *cobra.Command, name string, defaultValue time.Time) (time.Time, error) {
+	value, _ := cmd.Flags().GetString(name)
+	if value == "" {
+		return defaultValue, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, zerrors.ThrowInvalidArgumentf(err, "ARCHI-Jw2ol", "invalid time for %s", name)
+	}
+	return t, nil
+}
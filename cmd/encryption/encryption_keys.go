@@ -37,6 +37,29 @@ type EncryptionKeyConfig struct {
 	UserAgentCookieKeyID string
 }
 
+// KeyIDs returns the IDs of all keys used by the configuration for encryption or decryption.
+func (c *EncryptionKeyConfig) KeyIDs() []string {
+	keyIDs := []string{c.CSRFCookieKeyID, c.UserAgentCookieKeyID}
+	for _, config := range []*crypto.KeyConfig{
+		c.DomainVerification,
+		c.IDPConfig,
+		c.OIDC,
+		c.SAML,
+		c.OTP,
+		c.SMS,
+		c.SMTP,
+		c.User,
+		c.Target,
+	} {
+		if config == nil {
+			continue
+		}
+		keyIDs = append(keyIDs, config.EncryptionKeyID)
+		keyIDs = append(keyIDs, config.DecryptionKeyIDs...)
+	}
+	return keyIDs
+}
+
 type EncryptionKeys struct {
 	DomainVerification crypto.EncryptionAlgorithm
 	IDPConfig          crypto.EncryptionAlgorithm
@@ -10,6 +10,7 @@ import (
 	"github.com/spf13/viper"
 	"sigs.k8s.io/yaml"
 
+	"github.com/zitadel/zitadel/cmd/encryption"
 	"github.com/zitadel/zitadel/internal/crypto"
 	cryptoDB "github.com/zitadel/zitadel/internal/crypto/database"
 	"github.com/zitadel/zitadel/internal/database"
@@ -21,7 +22,8 @@ const (
 )
 
 type Config struct {
-	Database database.Config
+	Database       database.Config
+	EncryptionKeys *encryption.EncryptionKeyConfig
 }
 
 func New() *cobra.Command {
@@ -30,7 +32,7 @@ func New() *cobra.Command {
 		Short: "manage encryption keys",
 	}
 	AddMasterKeyFlag(cmd)
-	cmd.AddCommand(newKey())
+	cmd.AddCommand(newKey(), newRotate(), newRetire())
 	return cmd
 }
 
@@ -129,7 +131,7 @@ func openFile(fileName string) (io.Reader, error) {
 	return file, nil
 }
 
-func keyStorage(config database.Config, masterKey string) (crypto.KeyStorage, error) {
+func keyStorage(config database.Config, masterKey string) (*cryptoDB.Database, error) {
 	db, err := database.Connect(config, false)
 	if err != nil {
 		return nil, err
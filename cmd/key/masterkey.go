@@ -5,6 +5,9 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/zitadel/zitadel/internal/crypto/keyprovider"
 )
 
 const (
@@ -28,7 +31,25 @@ func AddMasterKeyFlag(cmd *cobra.Command) {
 	cmd.PersistentFlags().Bool(flagMasterKeyEnv, false, "read masterkey for en/decryption keys from environment variable (ZITADEL_MASTERKEY)")
 }
 
+// MasterKey returns the master key provided by file, argument or environment variable.
+// If a KeyProvider with a MasterKeyID is configured, the provided value is unwrapped by the provider.
 func MasterKey(cmd *cobra.Command) (string, error) {
+	masterKey, err := providedMasterKey(cmd)
+	if err != nil {
+		return "", err
+	}
+	return unwrapMasterKey(cmd, masterKey)
+}
+
+func unwrapMasterKey(cmd *cobra.Command, masterKey string) (string, error) {
+	config := new(keyprovider.Config)
+	if err := viper.UnmarshalKey("KeyProvider", config); err != nil {
+		return "", err
+	}
+	return keyprovider.UnwrapMasterKey(cmd.Context(), config, masterKey)
+}
+
+func providedMasterKey(cmd *cobra.Command) (string, error) {
 	masterKeyFile, _ := cmd.Flags().GetString(flagMasterKey)
 	masterKeyFromArg, _ := cmd.Flags().GetString(flagMasterKeyArg)
 	masterKeyFromEnv, _ := cmd.Flags().GetBool(flagMasterKeyEnv)
@@ -0,0 +1,232 @@
+package key
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"os"
+	"slices"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/zitadel/zitadel/cmd/encryption"
+	"github.com/zitadel/zitadel/internal/crypto"
+	cryptoDB "github.com/zitadel/zitadel/internal/crypto/database"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	flagNewMasterKey    = "newMasterkeyFile"
+	flagNewMasterKeyArg = "newMasterkey"
+)
+
+func newRotate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate [keyID]... [--newMasterkeyFile file | --newMasterkey key]",
+		Short: "rotate encryption keys and / or the master key",
+		Long: `creates a new random encryption key for every provided key ID (encrypted by the master key)
+and / or re-encrypts all encryption keys with the new master key
+To rotate an encryption key, set the new key ID as EncryptionKeyID of the purpose (e.g. EncryptionKeys.SMTP)
+and add the previous key ID to its DecryptionKeyIDs.
+The SMTP secrets are then re-encrypted by the KeyRotation job, afterwards the previous SMTP key can be retired.
+The secrets of the other purposes are not re-encrypted, their previous keys must stay DecryptionKeyIDs.
+After the master key is rotated, ZITADEL must be started with the new master key.
+If a KeyProvider with a MasterKeyID is configured, the new master key must be wrapped by it as well.
+Requirements:
+- postgreSQL`,
+		Example: `rotate smtpKey2
+rotate --newMasterkeyFile masterkey2
+rotate smtpKey2 --newMasterkey 0123456789abcdef0123456789abcdef`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			defer func() {
+				if err != nil {
+					slog.Error("zitadel keys rotate command failed", "err", err)
+				}
+			}()
+
+			newMasterKey, err := newMasterKey(cmd)
+			if err != nil {
+				return err
+			}
+			if len(args) == 0 && newMasterKey == "" {
+				return zerrors.ThrowInvalidArgument(nil, "KEY-Rt4mb", "provide the key IDs to create and / or a new master key")
+			}
+			config := new(Config)
+			if err := viper.Unmarshal(config); err != nil {
+				return err
+			}
+			masterKey, err := MasterKey(cmd)
+			if err != nil {
+				return err
+			}
+			storage, err := keyStorage(config.Database, masterKey)
+			if err != nil {
+				return err
+			}
+			if newMasterKey != "" {
+				if err = storage.RotateMasterKey(cmd.Context(), newMasterKey); err != nil {
+					return err
+				}
+				slog.Info("master key rotated, use the new master key to start ZITADEL")
+			}
+			if len(args) == 0 {
+				return nil
+			}
+			keys := make([]*crypto.Key, len(args))
+			for i, id := range args {
+				if keys[i], err = crypto.NewKey(id); err != nil {
+					return err
+				}
+			}
+			if err = storage.CreateKeys(cmd.Context(), keys...); err != nil {
+				return err
+			}
+			slog.Info("encryption keys created, set them as EncryptionKeyID and add the previous keys to the DecryptionKeyIDs", "keyIDs", args)
+			return nil
+		},
+	}
+	cmd.Flags().String(flagNewMasterKey, "", "path to the new masterkey")
+	cmd.Flags().String(flagNewMasterKeyArg, "", "new masterkey as argument")
+	return cmd
+}
+
+func newRetire() *cobra.Command {
+	return &cobra.Command{
+		Use:   "retire keyID...",
+		Short: "retire encryption keys no longer in use",
+		Long: `deletes the provided encryption keys after verifying
+that they are no longer used by the configuration (EncryptionKeys)
+and that no secret is still encrypted with them
+Only the SMTP secrets are re-encrypted by the KeyRotation job,
+keys which ever encrypted a secret of another purpose can't be retired.
+Make sure the KeyRotation job re-encrypted the secrets of all instances before retiring a key.
+Values still encrypted with a retired key can't be decrypted anymore.
+Requirements:
+- postgreSQL`,
+		Example: `retire smtpKey`,
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			defer func() {
+				if err != nil {
+					slog.Error("zitadel keys retire command failed", "err", err)
+				}
+			}()
+
+			config := new(Config)
+			if err := viper.Unmarshal(config); err != nil {
+				return err
+			}
+			if err = verifyNotConfigured(config.EncryptionKeys, args); err != nil {
+				return err
+			}
+			masterKey, err := MasterKey(cmd)
+			if err != nil {
+				return err
+			}
+			db, err := database.Connect(config.Database, false)
+			if err != nil {
+				return err
+			}
+			for _, keyID := range args {
+				if err = verifyNoSecretsEncrypted(cmd.Context(), db, keyID); err != nil {
+					return err
+				}
+			}
+			storage, err := cryptoDB.NewKeyStorage(db, masterKey)
+			if err != nil {
+				return err
+			}
+			if err = storage.DeleteKeys(cmd.Context(), args...); err != nil {
+				return err
+			}
+			slog.Info("encryption keys retired", "keyIDs", args)
+			return nil
+		},
+	}
+}
+
+func newMasterKey(cmd *cobra.Command) (string, error) {
+	newMasterKeyFile, _ := cmd.Flags().GetString(flagNewMasterKey)
+	newMasterKeyFromArg, _ := cmd.Flags().GetString(flagNewMasterKeyArg)
+	if newMasterKeyFile != "" && newMasterKeyFromArg != "" {
+		return "", zerrors.ThrowInvalidArgument(nil, "KEY-Nm2kp", "new masterkey must either be provided by file path or value")
+	}
+	newMasterKey := newMasterKeyFromArg
+	if newMasterKeyFile != "" {
+		data, err := os.ReadFile(newMasterKeyFile)
+		if err != nil {
+			return "", zerrors.ThrowInternalf(err, "KEY-Nm3kp", "failed to open file: %s", newMasterKeyFile)
+		}
+		newMasterKey = string(data)
+	}
+	if newMasterKey == "" {
+		return "", nil
+	}
+	return unwrapMasterKey(cmd, newMasterKey)
+}
+
+// verifyNotConfigured checks that none of the keys is still used to encrypt or decrypt.
+func verifyNotConfigured(config *encryption.EncryptionKeyConfig, keyIDs []string) error {
+	if config == nil {
+		return nil
+	}
+	configured := config.KeyIDs()
+	for _, keyID := range keyIDs {
+		if slices.Contains(configured, keyID) {
+			return zerrors.ThrowPreconditionFailedf(nil, "KEY-Cf8wq", "key %s is still used by the EncryptionKeys configuration", keyID)
+		}
+	}
+	return nil
+}
+
+// keyUsageEventsTable contains the current and the archived events.
+const keyUsageEventsTable = "(SELECT event_type, payload FROM eventstore.events2" +
+	" UNION ALL SELECT event_type, payload FROM eventstore.events2_archive) AS events"
+
+// verifyNoSecretsEncrypted checks that no smtp secret in the projections is encrypted with the key
+// and that no secret of another purpose, which are not re-encrypted, was ever encrypted with it.
+func verifyNoSecretsEncrypted(ctx context.Context, db *database.DB, keyID string) error {
+	keyIDOf := func(column string) string {
+		return column + "->>'KeyID' = ?"
+	}
+	queries := []sq.SelectBuilder{
+		sq.Select("count(*)").From(projection.SMTPConfigTable).
+			Where(sq.Or{
+				sq.Expr(keyIDOf(projection.SMTPConfigSMTPColumnPlainAuthPassword), keyID),
+				sq.Expr(keyIDOf(projection.SMTPConfigSMTPColumnXOAuth2AuthClientCredentialsClientSecret), keyID),
+			}),
+		sq.Select("count(*)").From(projection.SMTPConfigHTTPTable).
+			Where(sq.Expr(keyIDOf(projection.SMTPConfigHTTPColumnSigningKey), keyID)),
+		sq.Select("count(*)").From(projection.SMTPConfigAPITable).
+			Where(sq.Expr(keyIDOf(projection.SMTPConfigAPIColumnAPIKey), keyID)),
+		// only the smtp secrets are re-encrypted,
+		// so no other event (including the archived ones) may contain a secret encrypted with the key
+		sq.Select("count(*)").From(keyUsageEventsTable).
+			Where(sq.And{
+				sq.NotLike{"event_type": instance.AggregateType + ".smtp.config.%"},
+				sq.Expr("jsonb_path_exists(payload, '$.**.KeyID ?? (@ == $key)', jsonb_build_object('key', ?::TEXT))", keyID),
+			}),
+	}
+	for _, query := range queries {
+		stmt, args, err := query.PlaceholderFormat(sq.Dollar).ToSql()
+		if err != nil {
+			return zerrors.ThrowInternal(err, "KEY-Vq2lr", "unable to verify key usage")
+		}
+		var count uint64
+		err = db.QueryRowContext(ctx, func(row *sql.Row) error {
+			return row.Scan(&count)
+		}, stmt, args...)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "KEY-Vq3lr", "unable to verify key usage")
+		}
+		if count > 0 {
+			return zerrors.ThrowPreconditionFailedf(nil, "KEY-Vq4lr", "%d secrets are still encrypted with key %s, only keys of SMTP secrets can be retired", count, keyID)
+		}
+	}
+	return nil
+}
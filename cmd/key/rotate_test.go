@@ -0,0 +1,159 @@
+package key
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/cmd/encryption"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func Test_verifyNotConfigured(t *testing.T) {
+	config := &encryption.EncryptionKeyConfig{
+		SMTP: &crypto.KeyConfig{
+			EncryptionKeyID:  "smtpKey2",
+			DecryptionKeyIDs: []string{"smtpKey1"},
+		},
+		CSRFCookieKeyID: "csrfCookieKey",
+	}
+	tests := []struct {
+		name   string
+		config *encryption.EncryptionKeyConfig
+		keyIDs []string
+		err    func(error) bool
+	}{
+		{
+			"no config",
+			nil,
+			[]string{"smtpKey"},
+			nil,
+		},
+		{
+			"encryption key, error",
+			config,
+			[]string{"smtpKey", "smtpKey2"},
+			zerrors.IsPreconditionFailed,
+		},
+		{
+			"decryption key, error",
+			config,
+			[]string{"smtpKey1"},
+			zerrors.IsPreconditionFailed,
+		},
+		{
+			"cookie key, error",
+			config,
+			[]string{"csrfCookieKey"},
+			zerrors.IsPreconditionFailed,
+		},
+		{
+			"not configured, ok",
+			config,
+			[]string{"smtpKey"},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verifyNotConfigured(tt.config, tt.keyIDs)
+			if tt.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, tt.err(err), "got wrong err: %v", err)
+		})
+	}
+}
+
+func Test_verifyNoSecretsEncrypted(t *testing.T) {
+	const (
+		smtpQuery   = `SELECT count(*) FROM projections.smtp_configs6_smtp WHERE (password->>'KeyID' = $1 OR xoauth2auth_client_credentials_client_secret->>'KeyID' = $2)`
+		httpQuery   = `SELECT count(*) FROM projections.smtp_configs6_http WHERE signing_key->>'KeyID' = $1`
+		apiQuery    = `SELECT count(*) FROM projections.smtp_configs6_api WHERE api_key->>'KeyID' = $1`
+		eventsQuery = `SELECT count(*) FROM (SELECT event_type, payload FROM eventstore.events2 UNION ALL SELECT event_type, payload FROM eventstore.events2_archive) AS events` +
+			` WHERE (event_type NOT LIKE $1 AND jsonb_path_exists(payload, '$.**.KeyID ? (@ == $key)', jsonb_build_object('key', $2::TEXT)))`
+	)
+	expectCount := func(query string, count uint64, args ...driver.Value) func(sqlmock.Sqlmock) {
+		return func(m sqlmock.Sqlmock) {
+			m.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(args...).
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(count))
+		}
+	}
+	tests := []struct {
+		name         string
+		expectations []func(sqlmock.Sqlmock)
+		err          func(error) bool
+	}{
+		{
+			"query fails, error",
+			[]func(sqlmock.Sqlmock){
+				func(m sqlmock.Sqlmock) {
+					m.ExpectQuery(regexp.QuoteMeta(smtpQuery)).WillReturnError(sql.ErrConnDone)
+				},
+			},
+			zerrors.IsInternal,
+		},
+		{
+			"smtp secret encrypted, error",
+			[]func(sqlmock.Sqlmock){
+				expectCount(smtpQuery, 1, "smtpKey", "smtpKey"),
+			},
+			zerrors.IsPreconditionFailed,
+		},
+		{
+			"api key encrypted, error",
+			[]func(sqlmock.Sqlmock){
+				expectCount(smtpQuery, 0, "smtpKey", "smtpKey"),
+				expectCount(httpQuery, 0, "smtpKey"),
+				expectCount(apiQuery, 2, "smtpKey"),
+			},
+			zerrors.IsPreconditionFailed,
+		},
+		{
+			"secret of other purpose encrypted, error",
+			[]func(sqlmock.Sqlmock){
+				expectCount(smtpQuery, 0, "smtpKey", "smtpKey"),
+				expectCount(httpQuery, 0, "smtpKey"),
+				expectCount(apiQuery, 0, "smtpKey"),
+				expectCount(eventsQuery, 3, "instance.smtp.config.%", "smtpKey"),
+			},
+			zerrors.IsPreconditionFailed,
+		},
+		{
+			"no secrets encrypted, ok",
+			[]func(sqlmock.Sqlmock){
+				expectCount(smtpQuery, 0, "smtpKey", "smtpKey"),
+				expectCount(httpQuery, 0, "smtpKey"),
+				expectCount(apiQuery, 0, "smtpKey"),
+				expectCount(eventsQuery, 0, "instance.smtp.config.%", "smtpKey"),
+			},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("unable to create sql mock: %v", err)
+			}
+			for _, expectation := range tt.expectations {
+				expectation(mock)
+			}
+			err = verifyNoSecretsEncrypted(context.Background(), &database.DB{DB: client}, "smtpKey")
+			if tt.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.True(t, tt.err(err), "got wrong err: %v", err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -178,6 +178,7 @@ func projections(
 		config.SystemAPIUsers,
 		false,
 		config.DefaultInstance.SecretGenerators.ToMap(),
+		nil,
 	)
 	logging.OnError(ctx, err).Fatal("unable to start queries")
 
@@ -235,6 +236,7 @@ func projections(
 		config.Projections.Customizations["notificationsquotas"],
 		config.Projections.Customizations["backchannel"],
 		config.Projections.Customizations["telemetry"],
+		config.Projections.Customizations["ciba"],
 		config.Notifications,
 		config.OIDC.BackChannelLogoutConfig(),
 		*config.Telemetry,
@@ -249,6 +251,7 @@ func projections(
 		keys.User,
 		keys.SMTP,
 		keys.SMS,
+		keys.OIDC,
 		nil,
 		httpClient,
 	)
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 76.sql
+	addTargetClientCertificate string
+)
+
+type Targets2AddClientCertificate struct {
+	dbClient *database.DB
+}
+
+func (mig *Targets2AddClientCertificate) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addTargetClientCertificate)
+	return err
+}
+
+func (mig *Targets2AddClientCertificate) String() string {
+	return "76_targets2_add_client_certificate"
+}
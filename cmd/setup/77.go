@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 77.sql
+	addTargetRetryPolicy string
+)
+
+type Targets2AddRetryPolicy struct {
+	dbClient *database.DB
+}
+
+func (mig *Targets2AddRetryPolicy) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addTargetRetryPolicy)
+	return err
+}
+
+func (mig *Targets2AddRetryPolicy) String() string {
+	return "77_targets2_add_retry_policy"
+}
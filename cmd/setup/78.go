@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 78.sql
+	createTargetCallsTable string
+)
+
+type LogstoreTargetCalls struct {
+	dbClient *database.DB
+}
+
+func (mig *LogstoreTargetCalls) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, createTargetCallsTable)
+	return err
+}
+
+func (mig *LogstoreTargetCalls) String() string {
+	return "78_logstore_target_calls"
+}
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS logstore.target_calls (
+    log_date TIMESTAMPTZ NOT NULL
+    , instance_id TEXT NOT NULL
+    , target_id TEXT NOT NULL
+    , execution_id TEXT NOT NULL
+    , status_code INT NOT NULL DEFAULT 0
+    , took BIGINT NOT NULL DEFAULT 0
+    , response_body TEXT NOT NULL DEFAULT ''
+    , error TEXT NOT NULL DEFAULT ''
+    , interrupted BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX IF NOT EXISTS target_calls_target_date_desc ON logstore.target_calls (instance_id, target_id, log_date DESC);
+CREATE INDEX IF NOT EXISTS target_calls_log_date ON logstore.target_calls (log_date);
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 79.sql
+	addOIDCDPoPRequired string
+)
+
+type Apps7OIDCConfigsAddDPoPRequired struct {
+	dbClient *database.DB
+}
+
+func (mig *Apps7OIDCConfigsAddDPoPRequired) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addOIDCDPoPRequired)
+	return err
+}
+
+func (mig *Apps7OIDCConfigsAddDPoPRequired) String() string {
+	return "79_apps7_oidc_configs_add_dpop_required"
+}
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 80.sql
+	addOIDCPARRequired string
+)
+
+type Apps7OIDCConfigsAddPARRequired struct {
+	dbClient *database.DB
+}
+
+func (mig *Apps7OIDCConfigsAddPARRequired) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addOIDCPARRequired)
+	return err
+}
+
+func (mig *Apps7OIDCConfigsAddPARRequired) String() string {
+	return "80_apps7_oidc_configs_add_par_required"
+}
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 81.sql
+	addAppsTLSClientAuth string
+)
+
+type Apps7AddTLSClientAuth struct {
+	dbClient *database.DB
+}
+
+func (mig *Apps7AddTLSClientAuth) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addAppsTLSClientAuth)
+	return err
+}
+
+func (mig *Apps7AddTLSClientAuth) String() string {
+	return "81_apps7_add_tls_client_auth"
+}
@@ -0,0 +1,4 @@
+ALTER TABLE IF EXISTS projections.apps7_oidc_configs ADD COLUMN IF NOT EXISTS tls_client_auth_subject_dn TEXT DEFAULT '';
+ALTER TABLE IF EXISTS projections.apps7_oidc_configs ADD COLUMN IF NOT EXISTS tls_client_auth_san TEXT DEFAULT '';
+ALTER TABLE IF EXISTS projections.apps7_api_configs ADD COLUMN IF NOT EXISTS tls_client_auth_subject_dn TEXT DEFAULT '';
+ALTER TABLE IF EXISTS projections.apps7_api_configs ADD COLUMN IF NOT EXISTS tls_client_auth_san TEXT DEFAULT '';
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 82.sql
+	addOIDCBackChannelClientNotificationURI string
+)
+
+type Apps7OIDCConfigsAddBackChannelClientNotificationURI struct {
+	dbClient *database.DB
+}
+
+func (mig *Apps7OIDCConfigsAddBackChannelClientNotificationURI) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addOIDCBackChannelClientNotificationURI)
+	return err
+}
+
+func (mig *Apps7OIDCConfigsAddBackChannelClientNotificationURI) String() string {
+	return "82_apps7_oidc_configs_add_back_channel_client_notification_uri"
+}
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 83.sql
+	addAuthRequestAuthorizationDetails string
+)
+
+type AuthRequestsAddAuthorizationDetails struct {
+	dbClient *database.DB
+}
+
+func (mig *AuthRequestsAddAuthorizationDetails) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addAuthRequestAuthorizationDetails)
+	return err
+}
+
+func (mig *AuthRequestsAddAuthorizationDetails) String() string {
+	return "83_auth_requests_add_authorization_details"
+}
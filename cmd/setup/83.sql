@@ -0,0 +1 @@
+ALTER TABLE IF EXISTS projections.auth_requests ADD COLUMN IF NOT EXISTS authorization_details JSONB;
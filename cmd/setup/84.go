@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 84.sql
+	addAuthRequestConsentRequired string
+)
+
+type AuthRequestsAddConsentRequired struct {
+	dbClient *database.DB
+}
+
+func (mig *AuthRequestsAddConsentRequired) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addAuthRequestConsentRequired)
+	return err
+}
+
+func (mig *AuthRequestsAddConsentRequired) String() string {
+	return "84_auth_requests_add_consent_required"
+}
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 85.sql
+	addUserLockedUntil string
+)
+
+type UsersAddLockedUntil struct {
+	dbClient *database.DB
+}
+
+func (mig *UsersAddLockedUntil) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addUserLockedUntil)
+	return err
+}
+
+func (mig *UsersAddLockedUntil) String() string {
+	return "85_users_add_locked_until"
+}
@@ -0,0 +1 @@
+ALTER TABLE IF EXISTS projections.users14 ADD COLUMN IF NOT EXISTS locked_until TIMESTAMPTZ;
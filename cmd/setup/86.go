@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 86.sql
+	addLockoutPolicyDurations string
+)
+
+type LockoutPoliciesAddDurations struct {
+	dbClient *database.DB
+}
+
+func (mig *LockoutPoliciesAddDurations) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addLockoutPolicyDurations)
+	return err
+}
+
+func (mig *LockoutPoliciesAddDurations) String() string {
+	return "86_lockout_policies_add_durations"
+}
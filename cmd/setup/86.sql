@@ -0,0 +1,2 @@
+ALTER TABLE IF EXISTS projections.lockout_policies3 ADD COLUMN IF NOT EXISTS lockout_duration BIGINT DEFAULT 0;
+ALTER TABLE IF EXISTS projections.lockout_policies3 ADD COLUMN IF NOT EXISTS failed_attempt_delay BIGINT DEFAULT 0;
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 87.sql
+	addPasswordComplexityCheckBreached string
+)
+
+type PasswordComplexityPoliciesAddCheckBreached struct {
+	dbClient *database.DB
+}
+
+func (mig *PasswordComplexityPoliciesAddCheckBreached) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addPasswordComplexityCheckBreached)
+	return err
+}
+
+func (mig *PasswordComplexityPoliciesAddCheckBreached) String() string {
+	return "87_password_complexity_policies_add_check_breached"
+}
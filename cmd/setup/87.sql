@@ -0,0 +1 @@
+ALTER TABLE IF EXISTS projections.password_complexity_policies2 ADD COLUMN IF NOT EXISTS check_breached BOOLEAN DEFAULT FALSE;
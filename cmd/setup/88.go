@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 88.sql
+	addSAMLResponseSettings string
+)
+
+type SAMLConfigsAddResponseSettings struct {
+	dbClient *database.DB
+}
+
+func (mig *SAMLConfigsAddResponseSettings) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addSAMLResponseSettings)
+	return err
+}
+
+func (mig *SAMLConfigsAddResponseSettings) String() string {
+	return "88_saml_configs_add_response_settings"
+}
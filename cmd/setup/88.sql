@@ -0,0 +1,6 @@
+ALTER TABLE IF EXISTS projections.apps7_saml_configs ADD COLUMN IF NOT EXISTS idp_initiated_enabled BOOLEAN DEFAULT FALSE;
+ALTER TABLE IF EXISTS projections.apps7_saml_configs ADD COLUMN IF NOT EXISTS idp_initiated_relay_state TEXT DEFAULT '';
+ALTER TABLE IF EXISTS projections.apps7_saml_configs ADD COLUMN IF NOT EXISTS encrypt_assertion BOOLEAN DEFAULT FALSE;
+ALTER TABLE IF EXISTS projections.apps7_saml_configs ADD COLUMN IF NOT EXISTS name_id_format SMALLINT DEFAULT 1;
+ALTER TABLE IF EXISTS projections.apps7_saml_configs ADD COLUMN IF NOT EXISTS name_id_source SMALLINT DEFAULT 0;
+ALTER TABLE IF EXISTS projections.apps7_saml_configs ADD COLUMN IF NOT EXISTS signature_algorithm SMALLINT DEFAULT 0;
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 89.sql
+	addLimitsRateLimits string
+)
+
+type LimitsAddRateLimits struct {
+	dbClient *database.DB
+}
+
+func (mig *LimitsAddRateLimits) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addLimitsRateLimits)
+	return err
+}
+
+func (mig *LimitsAddRateLimits) String() string {
+	return "89_limits_add_rate_limits"
+}
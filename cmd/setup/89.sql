@@ -0,0 +1 @@
+ALTER TABLE IF EXISTS projections.limits ADD COLUMN IF NOT EXISTS rate_limits JSONB;
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 90.sql
+	createEventsArchive string
+)
+
+type EventstoreArchive struct {
+	dbClient *database.DB
+}
+
+func (mig *EventstoreArchive) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, createEventsArchive)
+	return err
+}
+
+func (mig *EventstoreArchive) String() string {
+	return "90_eventstore_archive"
+}
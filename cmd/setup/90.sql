@@ -0,0 +1,38 @@
+-- archived events have the same columns as the events, partitions per month are created by the archiver
+CREATE TABLE IF NOT EXISTS eventstore.events2_archive (
+    instance_id TEXT NOT NULL
+    , aggregate_type TEXT NOT NULL
+    , aggregate_id TEXT NOT NULL
+
+    , event_type TEXT NOT NULL
+    , "sequence" BIGINT NOT NULL
+    , revision SMALLINT NOT NULL
+    , created_at TIMESTAMPTZ NOT NULL
+    , payload JSONB
+    , creator TEXT NOT NULL
+    , "owner" TEXT NOT NULL
+
+    , "position" DECIMAL NOT NULL
+    , in_tx_order INTEGER NOT NULL
+) PARTITION BY RANGE (created_at);
+
+CREATE INDEX IF NOT EXISTS es_archive_aggregate ON eventstore.events2_archive (instance_id, aggregate_type, aggregate_id, "sequence");
+CREATE INDEX IF NOT EXISTS es_archive_audit ON eventstore.events2_archive (instance_id, created_at);
+
+-- snapshots of the reduced state of write models, events of an aggregate are only archived up to its oldest snapshot
+CREATE TABLE IF NOT EXISTS eventstore.snapshots (
+    instance_id TEXT NOT NULL
+    , aggregate_type TEXT NOT NULL
+    , aggregate_id TEXT NOT NULL
+    , write_model TEXT NOT NULL
+
+    , "version" TEXT NOT NULL
+    , "sequence" BIGINT NOT NULL
+    , "position" DECIMAL NOT NULL
+    , payload JSONB NOT NULL
+    , created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+
+    , PRIMARY KEY (instance_id, aggregate_type, aggregate_id, write_model)
+);
+
+ALTER TABLE IF EXISTS projections.limits ADD COLUMN IF NOT EXISTS archive_horizon INTERVAL;
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 91.sql
+	addOIDCConsentRequired string
+)
+
+type Apps7OIDCConfigsAddConsentRequired struct {
+	dbClient *database.DB
+}
+
+func (mig *Apps7OIDCConfigsAddConsentRequired) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addOIDCConsentRequired)
+	return err
+}
+
+func (mig *Apps7OIDCConfigsAddConsentRequired) String() string {
+	return "91_apps7_oidc_configs_add_consent_required"
+}
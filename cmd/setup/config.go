@@ -195,6 +195,22 @@ type Steps struct {
 	s73FixUserGrantRoles                    *FixUserGrantRoles
 	s74Apps7OIDCConfigsAddRegistrationToken *Apps7OIDCConfigsAddRegistrationToken
 	s75Apps7OIDCConfigsAddAppLinkConfig     *Apps7OIDCConfigsAddAppLinkConfig
+	s76Targets2AddClientCertificate         *Targets2AddClientCertificate
+	s77Targets2AddRetryPolicy               *Targets2AddRetryPolicy
+	s78LogstoreTargetCalls                  *LogstoreTargetCalls
+	s79Apps7OIDCConfigsAddDPoPRequired      *Apps7OIDCConfigsAddDPoPRequired
+	s80Apps7OIDCConfigsAddPARRequired       *Apps7OIDCConfigsAddPARRequired
+	s81Apps7AddTLSClientAuth                *Apps7AddTLSClientAuth
+	s82Apps7OIDCConfigsAddCIBANotification  *Apps7OIDCConfigsAddBackChannelClientNotificationURI
+	s83AuthRequestsAddAuthorizationDetails  *AuthRequestsAddAuthorizationDetails
+	s84AuthRequestsAddConsentRequired       *AuthRequestsAddConsentRequired
+	s85UsersAddLockedUntil                  *UsersAddLockedUntil
+	s86LockoutPoliciesAddDurations          *LockoutPoliciesAddDurations
+	s87PasswordComplexityAddCheckBreached   *PasswordComplexityPoliciesAddCheckBreached
+	s88SAMLConfigsAddResponseSettings       *SAMLConfigsAddResponseSettings
+	s89LimitsAddRateLimits                  *LimitsAddRateLimits
+	s90EventstoreArchive                    *EventstoreArchive
+	s91Apps7OIDCConfigsAddConsentRequired   *Apps7OIDCConfigsAddConsentRequired
 	RelationalTables                        *TransactionalTables
 }
 
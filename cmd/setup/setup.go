@@ -258,6 +258,22 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 	steps.s73FixUserGrantRoles = &FixUserGrantRoles{eventstore: eventstoreClient}
 	steps.s74Apps7OIDCConfigsAddRegistrationToken = &Apps7OIDCConfigsAddRegistrationToken{dbClient: dbClient}
 	steps.s75Apps7OIDCConfigsAddAppLinkConfig = &Apps7OIDCConfigsAddAppLinkConfig{dbClient: dbClient}
+	steps.s76Targets2AddClientCertificate = &Targets2AddClientCertificate{dbClient: dbClient}
+	steps.s77Targets2AddRetryPolicy = &Targets2AddRetryPolicy{dbClient: dbClient}
+	steps.s78LogstoreTargetCalls = &LogstoreTargetCalls{dbClient: dbClient}
+	steps.s79Apps7OIDCConfigsAddDPoPRequired = &Apps7OIDCConfigsAddDPoPRequired{dbClient: dbClient}
+	steps.s80Apps7OIDCConfigsAddPARRequired = &Apps7OIDCConfigsAddPARRequired{dbClient: dbClient}
+	steps.s81Apps7AddTLSClientAuth = &Apps7AddTLSClientAuth{dbClient: dbClient}
+	steps.s82Apps7OIDCConfigsAddCIBANotification = &Apps7OIDCConfigsAddBackChannelClientNotificationURI{dbClient: dbClient}
+	steps.s83AuthRequestsAddAuthorizationDetails = &AuthRequestsAddAuthorizationDetails{dbClient: dbClient}
+	steps.s84AuthRequestsAddConsentRequired = &AuthRequestsAddConsentRequired{dbClient: dbClient}
+	steps.s85UsersAddLockedUntil = &UsersAddLockedUntil{dbClient: dbClient}
+	steps.s86LockoutPoliciesAddDurations = &LockoutPoliciesAddDurations{dbClient: dbClient}
+	steps.s87PasswordComplexityAddCheckBreached = &PasswordComplexityPoliciesAddCheckBreached{dbClient: dbClient}
+	steps.s88SAMLConfigsAddResponseSettings = &SAMLConfigsAddResponseSettings{dbClient: dbClient}
+	steps.s89LimitsAddRateLimits = &LimitsAddRateLimits{dbClient: dbClient}
+	steps.s90EventstoreArchive = &EventstoreArchive{dbClient: dbClient}
+	steps.s91Apps7OIDCConfigsAddConsentRequired = &Apps7OIDCConfigsAddConsentRequired{dbClient: dbClient}
 
 	err = projection.Create(ctx, dbClient, eventstoreClient, config.Projections, nil, nil, nil)
 	if err != nil {
@@ -392,6 +408,22 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 		steps.s73FixUserGrantRoles,
 		steps.s74Apps7OIDCConfigsAddRegistrationToken,
 		steps.s75Apps7OIDCConfigsAddAppLinkConfig,
+		steps.s76Targets2AddClientCertificate,
+		steps.s77Targets2AddRetryPolicy,
+		steps.s78LogstoreTargetCalls,
+		steps.s79Apps7OIDCConfigsAddDPoPRequired,
+		steps.s80Apps7OIDCConfigsAddPARRequired,
+		steps.s81Apps7AddTLSClientAuth,
+		steps.s82Apps7OIDCConfigsAddCIBANotification,
+		steps.s83AuthRequestsAddAuthorizationDetails,
+		steps.s84AuthRequestsAddConsentRequired,
+		steps.s85UsersAddLockedUntil,
+		steps.s86LockoutPoliciesAddDurations,
+		steps.s87PasswordComplexityAddCheckBreached,
+		steps.s88SAMLConfigsAddResponseSettings,
+		steps.s89LimitsAddRateLimits,
+		steps.s90EventstoreArchive,
+		steps.s91Apps7OIDCConfigsAddConsentRequired,
 	} {
 		setupErr = executeMigration(ctx, eventstoreClient, step, "migration failed")
 		if setupErr != nil {
@@ -548,6 +580,7 @@ func startCommandsQueries(
 		nil, // not needed for projections
 		false,
 		config.DefaultInstance.SecretGenerators.ToMap(),
+		nil,
 	)
 	logging.OnError(ctx, err).Fatal("unable to start queries")
 
@@ -38,6 +38,8 @@ import (
 	"github.com/zitadel/zitadel/internal/api"
 	"github.com/zitadel/zitadel/internal/api/assets"
 	internal_authz "github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/dpop"
+	"github.com/zitadel/zitadel/internal/api/email_feedback"
 	action_v2 "github.com/zitadel/zitadel/internal/api/grpc/action/v2"
 	action_v2_beta "github.com/zitadel/zitadel/internal/api/grpc/action/v2beta"
 	"github.com/zitadel/zitadel/internal/api/grpc/admin"
@@ -95,25 +97,32 @@ import (
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/crypto"
 	cryptoDB "github.com/zitadel/zitadel/internal/crypto/database"
+	"github.com/zitadel/zitadel/internal/crypto/keyprovider"
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/domain/cibapoll"
 	"github.com/zitadel/zitadel/internal/domain/federatedlogout"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/archive"
 	old_es "github.com/zitadel/zitadel/internal/eventstore/repository/sql"
 	new_es "github.com/zitadel/zitadel/internal/eventstore/v3"
 	"github.com/zitadel/zitadel/internal/execution"
 	"github.com/zitadel/zitadel/internal/i18n"
 	"github.com/zitadel/zitadel/internal/id"
 	"github.com/zitadel/zitadel/internal/integration/sink"
+	"github.com/zitadel/zitadel/internal/keyrotation"
 	"github.com/zitadel/zitadel/internal/logstore"
 	"github.com/zitadel/zitadel/internal/logstore/emitters/access"
 	emit_execution "github.com/zitadel/zitadel/internal/logstore/emitters/execution"
 	emit_stdout "github.com/zitadel/zitadel/internal/logstore/emitters/stdout"
+	emit_target "github.com/zitadel/zitadel/internal/logstore/emitters/target"
 	"github.com/zitadel/zitadel/internal/logstore/record"
 	"github.com/zitadel/zitadel/internal/net"
 	"github.com/zitadel/zitadel/internal/notification"
+	"github.com/zitadel/zitadel/internal/provisioning"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/queue"
+	"github.com/zitadel/zitadel/internal/ratelimit"
 	"github.com/zitadel/zitadel/internal/serviceping"
 	"github.com/zitadel/zitadel/internal/static"
 	es_v4 "github.com/zitadel/zitadel/internal/v2/eventstore"
@@ -202,7 +211,11 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 
 	config.Eventstore.Pusher = new_es.NewEventstore(dbClient, new_es.WithExecutionQueueOption(q))
 	config.Eventstore.Searcher = new_es.NewEventstore(dbClient, new_es.WithExecutionQueueOption(q))
-	config.Eventstore.Querier = old_es.NewPostgres(dbClient)
+	querier := old_es.NewPostgres(dbClient)
+	config.Eventstore.Querier = querier
+	config.Eventstore.SnapshotStore = querier
+	// write models must read the archived events as long as events are archived
+	config.Eventstore.IncludeArchived = config.Archive != nil && config.Archive.Enabled
 	eventstoreClient := eventstore.NewEventstore(config.Eventstore)
 	eventstoreV4 := es_v4.NewEventstoreFromOne(es_v4_pg.New(dbClient, &es_v4_pg.Config{
 		MaxRetries: config.Eventstore.MaxRetries,
@@ -222,6 +235,14 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 	if err != nil {
 		return fmt.Errorf("unable to start caches: %w", err)
 	}
+	keyProvider, err := keyprovider.NewProvider(config.KeyProvider)
+	if err != nil {
+		return fmt.Errorf("cannot start key provider: %w", err)
+	}
+	externalSigningKey, err := keyprovider.NewSigningKey(ctx, keyProvider, config.KeyProvider)
+	if err != nil {
+		return fmt.Errorf("cannot load signing key of key provider: %w", err)
+	}
 
 	queries, err := query.StartQueries(
 		ctx,
@@ -250,6 +271,7 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 		config.SystemAPIUsers,
 		true,
 		config.DefaultInstance.SecretGenerators.ToMap(),
+		externalSigningKey,
 	)
 	if err != nil {
 		return fmt.Errorf("cannot start queries: %w", err)
@@ -328,12 +350,25 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 	actionsLogstoreSvc := logstore.New(queries, actionsExecutionDBEmitter, actionsExecutionStdoutEmitter)
 	actions.SetLogstoreService(actionsLogstoreSvc)
 
+	targetCallStdoutEmitter, err := logstore.NewEmitter(ctx, clock, &logstore.EmitterConfig{Enabled: config.LogStore.Target.Stdout.Enabled}, emit_stdout.NewStdoutEmitter[*record.TargetCallLog]())
+	if err != nil {
+		return err
+	}
+
+	targetCallDBEmitter, err := logstore.NewEmitter(ctx, clock, &config.LogStore.Target.Database.EmitterConfig, emit_target.NewDatabaseLogStorage(dbClient))
+	if err != nil {
+		return err
+	}
+
+	execution.SetLogstoreService(logstore.New[*record.TargetCallLog](queries, nil, targetCallDBEmitter, targetCallStdoutEmitter))
+
 	notification.Register(
 		ctx,
 		config.Projections.Customizations["notifications"],
 		config.Projections.Customizations["notificationsquotas"],
 		config.Projections.Customizations["backchannel"],
 		config.Projections.Customizations["telemetry"],
+		config.Projections.Customizations["ciba"],
 		config.Notifications,
 		config.OIDC.BackChannelLogoutConfig(),
 		*config.Telemetry,
@@ -348,6 +383,7 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 		keys.User,
 		keys.SMTP,
 		keys.SMS,
+		keys.OIDC,
 		q,
 		httpClient,
 	)
@@ -355,7 +391,9 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 
 	execution.Register(
 		ctx,
+		config.Projections.Customizations["execution_redrives"],
 		config.Executions,
+		commands,
 		httpClient,
 		q,
 		keys.Target,
@@ -363,10 +401,26 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 	)
 	execution.Start(ctx)
 
+	provisioning.Register(
+		ctx,
+		config.Projections.Customizations["provisioning"],
+		config.Provisioning,
+		commands,
+		queries,
+		eventstoreClient,
+		q,
+		httpClient,
+	)
+	provisioning.Start(ctx)
+
 	// the service ping and it's workers need to be registered before starting the queue
 	if err := serviceping.Register(ctx, q, queries, eventstoreClient, config.ServicePing); err != nil {
 		return err
 	}
+	keyrotation.Register(ctx, q, commands, queries, config.KeyRotation)
+	if err = archive.Register(ctx, q, queries, dbClient, config.Archive); err != nil {
+		return err
+	}
 
 	if err = q.Start(ctx); err != nil {
 		return err
@@ -376,6 +430,12 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 	if err = serviceping.Start(ctx, config.ServicePing, q); err != nil {
 		return err
 	}
+	if err = keyrotation.Start(ctx, config.KeyRotation, q); err != nil {
+		return err
+	}
+	if err = archive.Start(ctx, config.Archive, q); err != nil {
+		return err
+	}
 
 	router := mux.NewRouter()
 	tlsConfig, err := config.TLS.Config()
@@ -462,7 +522,12 @@ func startAPIs(
 		return nil, err
 	}
 	accessTokenVerifer := internal_authz.StartAccessTokenVerifierFromRepo(repo)
-	verifier := internal_authz.StartAPITokenVerifier(repo, accessTokenVerifer, systemTokenVerifier)
+	usedDPoPProofs, err := connector.StartCache[dpop.Index, string, *dpop.UsedProof](ctx, []dpop.Index{dpop.IndexKey}, cache.PurposeDPoPProof, cacheConnectors.Config.DPoPProofs, cacheConnectors)
+	if err != nil {
+		return nil, err
+	}
+	dpopVerifier := dpop.NewVerifier(usedDPoPProofs)
+	verifier := internal_authz.StartAPITokenVerifier(repo, accessTokenVerifer, systemTokenVerifier, dpopVerifier)
 	tlsConfig, err := config.TLS.Config()
 	if err != nil {
 		return nil, err
@@ -485,6 +550,14 @@ func startAPIs(
 	)
 	limitingAccessInterceptor := middleware.NewAccessInterceptor(accessSvc, exhaustedCookieHandler, &config.Quotas.Access.AccessConfig)
 	translator := i18n.NewZitadelTranslator(language.English)
+	rateLimitBuckets, err := connector.StartCache[ratelimit.Index, string, *ratelimit.Bucket](ctx, []ratelimit.Index{ratelimit.IndexKey}, cache.PurposeRateLimit, cacheConnectors.Config.RateLimits, cacheConnectors)
+	if err != nil {
+		return nil, err
+	}
+	rateLimiter, err := ratelimit.NewLimiter(config.RateLimits, rateLimitBuckets)
+	if err != nil {
+		return nil, err
+	}
 	apis, err := api.New(
 		ctx,
 		config.Port,
@@ -497,6 +570,7 @@ func startAPIs(
 		config.ExternalDomain,
 		append(config.InstanceHostHeaders, config.PublicHostHeaders...),
 		limitingAccessInterceptor,
+		rateLimiter,
 		keys.Target,
 		translator,
 		config.Instrumentation.Trace.TrustRemoteSpans,
@@ -576,7 +650,7 @@ func startAPIs(
 	if err := apis.RegisterService(ctx, action_v2_beta.CreateServer(config.SystemDefaults, commands, queries, domain.AllActionFunctions, apis.ListGrpcMethods, apis.ListGrpcServices)); err != nil {
 		return nil, err
 	}
-	if err := apis.RegisterService(ctx, action_v2.CreateServer(config.SystemDefaults, commands, queries, domain.AllActionFunctions, apis.ListGrpcMethods, apis.ListGrpcServices)); err != nil {
+	if err := apis.RegisterService(ctx, action_v2.CreateServer(config.SystemDefaults, commands, queries, domain.AllActionFunctions, apis.ListGrpcMethods, apis.ListGrpcServices, keys.Target, queries.GetActiveSigningWebKey, httpClient)); err != nil {
 		return nil, err
 	}
 	if err := apis.RegisterService(ctx, project_v2beta.CreateServer(config.SystemDefaults, commands, queries, permissionCheck)); err != nil {
@@ -645,6 +719,7 @@ func startAPIs(
 	}
 
 	apis.RegisterHandlerOnPrefix(idp.HandlerPrefix, idp.NewHandler(commands, queries, keys.IDPConfig, instanceInterceptor.Handler, federatedLogoutsCache))
+	apis.RegisterHandlerOnPrefix(email_feedback.HandlerPrefix, email_feedback.NewHandler(commands, queries, httpClient, instanceInterceptor.Handler))
 
 	userAgentInterceptor, err := middleware.NewUserAgentHandler(config.UserAgentCookie, keys.UserAgentCookieKey, id.SonyFlakeGenerator(), config.ExternalSecure, login.EndpointResources, login.EndpointExternalLoginCallbackFormPost, login.EndpointSAMLACS)
 	if err != nil {
@@ -668,6 +743,10 @@ func startAPIs(
 	}
 	apis.RegisterHandlerOnPrefix(openapi.HandlerPrefix, openAPIHandler)
 
+	cibaPollsCache, err := connector.StartCache[cibapoll.Index, string, *cibapoll.Poll](ctx, []cibapoll.Index{cibapoll.IndexAuthReqID}, cache.PurposeCIBAPoll, cacheConnectors.Config.CIBAPolls, cacheConnectors)
+	if err != nil {
+		return nil, err
+	}
 	oidcServer, err := oidc.NewServer(
 		ctx,
 		config.OIDC,
@@ -683,9 +762,12 @@ func startAPIs(
 		userAgentInterceptor,
 		instanceInterceptor.Handler,
 		limitingAccessInterceptor,
+		rateLimiter,
 		config.Log.Slog(),
 		config.SystemDefaults.SecretHasher,
 		federatedLogoutsCache,
+		dpopVerifier,
+		cibaPollsCache,
 		httpClient,
 	)
 	if err != nil {
@@ -748,6 +830,7 @@ func startAPIs(
 		instanceInterceptor.Handler,
 		assetsCache.Handler,
 		limitingAccessInterceptor.WithRedirect(managementConsolePath).Handle,
+		rateLimiter,
 		keys.User,
 		keys.IDPConfig,
 		keys.CSRFCookieKey,
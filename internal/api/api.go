@@ -28,6 +28,7 @@ import (
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/i18n"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/ratelimit"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
 	instance_pb "github.com/zitadel/zitadel/pkg/grpc/instance/v2"
@@ -49,6 +50,7 @@ type API struct {
 	grpcGateway       *server.Gateway
 	healthServer      *health.Server
 	accessInterceptor *http_mw.AccessInterceptor
+	rateLimiter       *ratelimit.Limiter
 	queries           *query.Queries
 	authConfig        authz.Config
 	systemAuthZ       authz.Config
@@ -109,6 +111,7 @@ func New(
 	externalDomain string,
 	hostHeaders []string,
 	accessInterceptor *http_mw.AccessInterceptor,
+	rateLimiter *ratelimit.Limiter,
 	targetEncryptionAlgorithm crypto.EncryptionAlgorithm,
 	translator *i18n.Translator,
 	trustRemoteSpans bool,
@@ -122,6 +125,7 @@ func New(
 		router:                    router,
 		queries:                   queries,
 		accessInterceptor:         accessInterceptor,
+		rateLimiter:               rateLimiter,
 		hostHeaders:               hostHeaders,
 		authConfig:                authZ,
 		systemAuthZ:               systemAuthz,
@@ -131,7 +135,7 @@ func New(
 		httpClient:                httpClient,
 	}
 
-	api.grpcServer = server.CreateServer(api.verifier, systemAuthz, authZ, queries, externalDomain, tlsConfig, accessInterceptor.AccessService(), targetEncryptionAlgorithm, api.translator, httpClient)
+	api.grpcServer = server.CreateServer(api.verifier, systemAuthz, authZ, queries, externalDomain, tlsConfig, accessInterceptor.AccessService(), rateLimiter, targetEncryptionAlgorithm, api.translator, httpClient)
 	api.grpcGateway, err = server.CreateGateway(ctx, port, hostHeaders, accessInterceptor, tlsConfig)
 	if err != nil {
 		return nil, err
@@ -220,6 +224,7 @@ func (a *API) registerConnectServer(service server.ConnectServer) {
 		connect_middleware.AccessStorageInterceptor(a.accessInterceptor.AccessService()),
 		connect_middleware.ErrorHandler(),
 		connect_middleware.LimitsInterceptor(system_pb.SystemService_ServiceDesc.ServiceName),
+		connect_middleware.RateLimitInterceptor(a.rateLimiter, a.rateLimitSessionUser, system_pb.SystemService_ServiceDesc.ServiceName),
 		connect_middleware.AuthorizationInterceptor(a.verifier, a.systemAuthZ, a.authConfig),
 		connect_middleware.TranslationHandler(),
 		connect_middleware.QuotaExhaustedInterceptor(a.accessInterceptor.AccessService(), system_pb.SystemService_ServiceDesc.ServiceName),
@@ -237,6 +242,15 @@ func (a *API) registerConnectServer(service server.ConnectServer) {
 	a.RegisterHandlerPrefixes(http_mw.CORSInterceptor(handler), prefix)
 }
 
+// rateLimitSessionUser returns the user of the session, so the session checks are rate limited by user.
+func (a *API) rateLimitSessionUser(ctx context.Context, sessionID string) string {
+	session, err := a.queries.SessionByID(ctx, false, sessionID, "", nil)
+	if err != nil {
+		return ""
+	}
+	return session.UserFactor.UserID
+}
+
 // HandleFunc allows registering a [http.HandlerFunc] on an exact
 // path, instead of prefix like RegisterHandlerOnPrefix.
 func (a *API) HandleFunc(path string, f http.HandlerFunc) {
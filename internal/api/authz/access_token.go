@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 const (
@@ -40,6 +41,39 @@ func (a *AccessTokenVerifierFromRepo) VerifyAccessToken(ctx context.Context, tok
 	return userID, clientID, agentID, prefLang, resourceOwner, err
 }
 
+type dpopRequest struct {
+	proof  string
+	method string
+	uri    string
+}
+
+// WithDPoPRequest sets the DPoP proof of the request and the HTTP method and URI the proof must be issued for.
+func WithDPoPRequest(ctx context.Context, proof, method, uri string) context.Context {
+	return context.WithValue(ctx, dpopRequestKey, &dpopRequest{proof: proof, method: method, uri: uri})
+}
+
+// DPoPJKTFromCtx returns the thumbprint of the key of the verified DPoP proof of the request.
+// An empty string is returned if the request used the bearer scheme.
+func DPoPJKTFromCtx(ctx context.Context) string {
+	jkt, _ := ctx.Value(dpopJKTKey).(string)
+	return jkt
+}
+
+// verifyDPoPProof verifies the DPoP proof of a request using the DPoP scheme (RFC 9449, section 7)
+// and sets the thumbprint of its key to the context,
+// so the verifier of the access token can check that the token is bound to that key.
+func verifyDPoPProof(ctx context.Context, t APITokenVerifier, accessToken string) (context.Context, error) {
+	req, ok := ctx.Value(dpopRequestKey).(*dpopRequest)
+	if !ok || req.proof == "" {
+		return ctx, zerrors.ThrowUnauthenticated(nil, "AUTH-Ohg4u", "Errors.Token.DPoPProofInvalid")
+	}
+	proof, err := t.VerifyDPoPProof(ctx, req.proof, req.method, req.uri, accessToken)
+	if err != nil {
+		return ctx, err
+	}
+	return context.WithValue(ctx, dpopJKTKey, proof.JKT), nil
+}
+
 type client struct {
 	name string
 }
@@ -64,3 +64,52 @@ func Test_extractBearerToken(t *testing.T) {
 		})
 	}
 }
+
+func Test_extractAccessToken(t *testing.T) {
+	tests := []struct {
+		name       string
+		token      string
+		wantToken  string
+		wantIsDPoP bool
+		wantErr    bool
+	}{
+		{
+			name:    "no auth header set",
+			token:   "",
+			wantErr: true,
+		},
+		{
+			name:    "dpop scheme without token",
+			token:   "DPoP ",
+			wantErr: true,
+		},
+		{
+			name:      "bearer scheme",
+			token:     "Bearer AUTH",
+			wantToken: "AUTH",
+		},
+		{
+			name:       "dpop scheme",
+			token:      "DPoP AUTH",
+			wantToken:  "AUTH",
+			wantIsDPoP: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token, isDPoP, err := extractAccessToken(tt.token)
+			if tt.wantErr {
+				if !zerrors.IsUnauthenticated(err) {
+					t.Errorf("got wrong err: %v ", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("got wrong result, should not get err: actual: %v ", err)
+			}
+			if token != tt.wantToken || isDPoP != tt.wantIsDPoP {
+				t.Errorf("got wrong result: %s %v", token, isDPoP)
+			}
+		})
+	}
+}
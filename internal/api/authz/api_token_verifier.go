@@ -4,6 +4,7 @@ import (
 	"context"
 	"sync"
 
+	"github.com/zitadel/zitadel/internal/api/dpop"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 )
 
@@ -17,24 +18,31 @@ type APITokenVerifier interface {
 	ExistsOrg(ctx context.Context, id, domain string) (orgID string, err error)
 	CheckOrgActive(ctx context.Context, orgID string) error
 	SearchMyMemberships(ctx context.Context, orgID string, shouldTriggerBulk bool) (_ []*Membership, err error)
+	VerifyDPoPProof(ctx context.Context, proof, method, uri, accessToken string) (*dpop.Proof, error)
 }
 
 type ApiTokenVerifier struct {
 	AccessTokenVerifier
 	SystemTokenVerifier
-	authZRepo   authZRepo
-	clients     sync.Map
-	authMethods MethodMapping
+	authZRepo    authZRepo
+	clients      sync.Map
+	authMethods  MethodMapping
+	dpopVerifier *dpop.Verifier
 }
 
-func StartAPITokenVerifier(authZRepo authZRepo, accessTokenVerifier AccessTokenVerifier, systemTokenVerifier SystemTokenVerifier) *ApiTokenVerifier {
+func StartAPITokenVerifier(authZRepo authZRepo, accessTokenVerifier AccessTokenVerifier, systemTokenVerifier SystemTokenVerifier, dpopVerifier *dpop.Verifier) *ApiTokenVerifier {
 	return &ApiTokenVerifier{
 		authZRepo:           authZRepo,
 		SystemTokenVerifier: systemTokenVerifier,
 		AccessTokenVerifier: accessTokenVerifier,
+		dpopVerifier:        dpopVerifier,
 	}
 }
 
+func (v *ApiTokenVerifier) VerifyDPoPProof(ctx context.Context, proof, method, uri, accessToken string) (*dpop.Proof, error) {
+	return v.dpopVerifier.Verify(ctx, proof, method, uri, accessToken)
+}
+
 func (v *ApiTokenVerifier) RegisterServer(appName, methodPrefix string, mappings MethodMapping) {
 	v.clients.Store(methodPrefix, &client{name: appName})
 	if v.authMethods == nil {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/zitadel/logging"
 
+	"github.com/zitadel/zitadel/internal/api/dpop"
 	"github.com/zitadel/zitadel/internal/api/grpc"
 	http_util "github.com/zitadel/zitadel/internal/api/http"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
@@ -22,6 +23,8 @@ const (
 	dataKey               key = 2
 	allPermissionsKey     key = 3
 	instanceKey           key = 4
+	dpopRequestKey        key = 5
+	dpopJKTKey            key = 6
 )
 
 type CtxData struct {
@@ -102,10 +105,16 @@ func (s SystemTokenVerifierFunc) VerifySystemToken(ctx context.Context, token st
 func VerifyTokenAndCreateCtxData(ctx context.Context, token, orgID, orgDomain string, t APITokenVerifier, systemRoleMap []RoleMapping) (_ CtxData, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
-	tokenWOBearer, err := extractBearerToken(token)
+	tokenWOBearer, isDPoP, err := extractAccessToken(token)
 	if err != nil {
 		return CtxData{}, err
 	}
+	if isDPoP {
+		ctx, err = verifyDPoPProof(ctx, t, tokenWOBearer)
+		if err != nil {
+			return CtxData{}, err
+		}
+	}
 	userID, clientID, agentID, prefLang, resourceOwner, err := t.VerifyAccessToken(ctx, tokenWOBearer)
 	var sysMemberships Memberships
 	if err != nil && !zerrors.IsUnauthenticated(err) {
@@ -203,3 +212,13 @@ func extractBearerToken(token string) (part string, err error) {
 	}
 	return parts[1], nil
 }
+
+// extractAccessToken returns the access token of an authorization header
+// using either the bearer or the DPoP scheme.
+func extractAccessToken(token string) (part string, isDPoP bool, err error) {
+	if part, ok := strings.CutPrefix(token, dpop.AuthPrefix); ok && part != "" {
+		return part, true, nil
+	}
+	part, err = extractBearerToken(token)
+	return part, false, err
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/zitadel/zitadel/internal/api/dpop"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -24,6 +25,10 @@ func (m *tokenVerifierMock) CheckAuthMethod(string) (Option, bool) {
 	return Option{}, false
 }
 
+func (m *tokenVerifierMock) VerifyDPoPProof(context.Context, string, string, string, string) (*dpop.Proof, error) {
+	return nil, nil
+}
+
 func (m *tokenVerifierMock) SearchMyMemberships(context.Context, string, bool) ([]*Membership, error) {
 	return nil, nil
 }
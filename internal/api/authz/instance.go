@@ -9,6 +9,7 @@ import (
 	"github.com/zitadel/zitadel/backend/v3/instrumentation"
 	"github.com/zitadel/zitadel/internal/execution/target"
 	"github.com/zitadel/zitadel/internal/feature"
+	"github.com/zitadel/zitadel/internal/ratelimit"
 )
 
 var (
@@ -34,6 +35,10 @@ type Instance interface {
 	AllowUnauthenticatedDynamicClientRegistration() bool
 	Block() *bool
 	AuditLogRetention() *time.Duration
+	// RateLimits returns the rate limits set for the instance or nil to use the defaults.
+	RateLimits() *ratelimit.Limits
+	// ArchiveHorizon returns the age after which events are archived or nil to use the default.
+	ArchiveHorizon() *time.Duration
 	Features() feature.Features
 	ExecutionRouter() target.Router
 }
@@ -68,6 +73,14 @@ func (i *instance) AuditLogRetention() *time.Duration {
 	return nil
 }
 
+func (i *instance) RateLimits() *ratelimit.Limits {
+	return nil
+}
+
+func (i *instance) ArchiveHorizon() *time.Duration {
+	return nil
+}
+
 func (i *instance) InstanceID() string {
 	return i.id
 }
@@ -10,6 +10,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/execution/target"
 	"github.com/zitadel/zitadel/internal/feature"
+	"github.com/zitadel/zitadel/internal/ratelimit"
 )
 
 func Test_Instance(t *testing.T) {
@@ -91,6 +92,14 @@ func (m *mockInstance) Block() *bool {
 	panic("shouldn't be called here")
 }
 
+func (m *mockInstance) RateLimits() *ratelimit.Limits {
+	panic("shouldn't be called here")
+}
+
+func (m *mockInstance) ArchiveHorizon() *time.Duration {
+	panic("shouldn't be called here")
+}
+
 func (m *mockInstance) AuditLogRetention() *time.Duration {
 	panic("shouldn't be called here")
 }
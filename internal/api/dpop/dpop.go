@@ -0,0 +1,198 @@
+// Package dpop implements the verification of DPoP proofs,
+// which are used to bind tokens to the key of a client (RFC 9449).
+package dpop
+
+import (
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+
+	"github.com/zitadel/zitadel/internal/cache"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	// HeaderName is the HTTP header containing the proof.
+	HeaderName = "DPoP"
+	// TokenType is returned as token_type of bound tokens and used as authorization scheme.
+	TokenType = "DPoP"
+	// AuthPrefix is the prefix of the authorization header of requests with a bound access token.
+	AuthPrefix = TokenType + " "
+	// ErrorTypeInvalidProof is returned as OAuth error if the proof is invalid.
+	ErrorTypeInvalidProof = "invalid_dpop_proof"
+	// ConfirmationClaim contains the thumbprint of the key a token is bound to.
+	ConfirmationClaim = "cnf"
+
+	proofType = "dpop+jwt"
+
+	// proofLifetime limits the time a proof can be used after it was issued.
+	// The IDs of used proofs must be cached at least for the lifetime and the clock skew to reject replayed proofs.
+	proofLifetime = time.Minute
+	// clockSkew allows proofs of clients with a clock slightly ahead.
+	clockSkew = 10 * time.Second
+)
+
+// signatureAlgorithms are the asymmetric algorithms allowed to sign proofs.
+var signatureAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+// Proof is a verified DPoP proof.
+type Proof struct {
+	// JKT is the base64url encoded SHA-256 thumbprint of the public key of the proof.
+	JKT      string
+	ID       string
+	IssuedAt time.Time
+}
+
+type claims struct {
+	ID              string `json:"jti"`
+	Method          string `json:"htm"`
+	URI             string `json:"htu"`
+	IssuedAt        int64  `json:"iat"`
+	AccessTokenHash string `json:"ath,omitempty"`
+}
+
+type Index int
+
+const (
+	IndexUnspecified Index = iota
+	IndexKey
+)
+
+// UsedProof is cached for every verified proof to reject proofs, which are used more than once.
+type UsedProof struct {
+	JKT string
+	ID  string
+}
+
+// Keys implements cache.Entry
+func (p *UsedProof) Keys(i Index) []string {
+	if i == IndexKey {
+		return []string{Key(p.JKT, p.ID)}
+	}
+	return nil
+}
+
+// Key returns the cache key of a proof, the jti only has to be unique per key of the client.
+func Key(jkt, id string) string {
+	return jkt + "-" + id
+}
+
+// Verifier verifies DPoP proofs and rejects replayed proofs using the IDs of the used proofs stored in the cache.
+// A shared cache connector (postgres or redis) is required to detect proofs replayed to another container.
+type Verifier struct {
+	usedProofs cache.Cache[Index, string, *UsedProof]
+}
+
+func NewVerifier(usedProofs cache.Cache[Index, string, *UsedProof]) *Verifier {
+	return &Verifier{usedProofs: usedProofs}
+}
+
+// Verify checks the proof JWT for a request with the passed HTTP method and URI (RFC 9449, section 4.3)
+// and that the proof was not used before (RFC 9449, section 11.1).
+// If the request contains an access token, the proof must contain its hash.
+func (v *Verifier) Verify(ctx context.Context, proof, method, uri, accessToken string) (*Proof, error) {
+	p, err := verify(proof, method, uri, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := v.usedProofs.Get(ctx, IndexKey, Key(p.JKT, p.ID)); ok {
+		return nil, zerrors.ThrowUnauthenticated(nil, "DPOP-Rie4o", "Errors.Token.DPoPProofInvalid")
+	}
+	v.usedProofs.Set(ctx, &UsedProof{JKT: p.JKT, ID: p.ID})
+	return p, nil
+}
+
+// verify checks the proof JWT for a request with the passed HTTP method and URI (RFC 9449, section 4.3).
+// If the request contains an access token, the proof must contain its hash.
+func verify(proof, method, uri, accessToken string) (_ *Proof, err error) {
+	sig, err := jose.ParseSignedCompact(proof, signatureAlgorithms)
+	if err != nil {
+		return nil, zerrors.ThrowUnauthenticated(err, "DPOP-Aeb3k", "Errors.Token.DPoPProofInvalid")
+	}
+	if len(sig.Signatures) != 1 {
+		return nil, zerrors.ThrowUnauthenticated(nil, "DPOP-Ohx8u", "Errors.Token.DPoPProofInvalid")
+	}
+	header := sig.Signatures[0].Protected
+	if typ, _ := header.ExtraHeaders[jose.HeaderType].(string); typ != proofType {
+		return nil, zerrors.ThrowUnauthenticated(nil, "DPOP-Ieth3", "Errors.Token.DPoPProofInvalid")
+	}
+	key := header.JSONWebKey
+	if key == nil || !key.IsPublic() || !key.Valid() {
+		return nil, zerrors.ThrowUnauthenticated(nil, "DPOP-quo6E", "Errors.Token.DPoPProofInvalid")
+	}
+	payload, err := sig.Verify(key)
+	if err != nil {
+		return nil, zerrors.ThrowUnauthenticated(err, "DPOP-Gei1a", "Errors.Token.DPoPProofInvalid")
+	}
+	c := new(claims)
+	if err = json.Unmarshal(payload, c); err != nil {
+		return nil, zerrors.ThrowUnauthenticated(err, "DPOP-Uth5o", "Errors.Token.DPoPProofInvalid")
+	}
+	if c.ID == "" || c.Method != method || !uriMatches(c.URI, uri) {
+		return nil, zerrors.ThrowUnauthenticated(nil, "DPOP-eeY7a", "Errors.Token.DPoPProofInvalid")
+	}
+	issuedAt := time.Unix(c.IssuedAt, 0)
+	now := time.Now()
+	if issuedAt.After(now.Add(clockSkew)) || issuedAt.Before(now.Add(-proofLifetime)) {
+		return nil, zerrors.ThrowUnauthenticated(nil, "DPOP-Ahz4e", "Errors.Token.DPoPProofInvalid")
+	}
+	if accessToken != "" && c.AccessTokenHash != AccessTokenHash(accessToken) {
+		return nil, zerrors.ThrowUnauthenticated(nil, "DPOP-Vo8ie", "Errors.Token.DPoPProofInvalid")
+	}
+	jkt, err := Thumbprint(key)
+	if err != nil {
+		return nil, zerrors.ThrowUnauthenticated(err, "DPOP-ooV3e", "Errors.Token.DPoPProofInvalid")
+	}
+	return &Proof{
+		JKT:      jkt,
+		ID:       c.ID,
+		IssuedAt: issuedAt,
+	}, nil
+}
+
+// Thumbprint returns the base64url encoded SHA-256 JWK thumbprint (RFC 7638) of the key.
+func Thumbprint(key *jose.JSONWebKey) (string, error) {
+	thumbprint, err := key.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(thumbprint), nil
+}
+
+// AccessTokenHash returns the value of the ath claim for the access token.
+func AccessTokenHash(accessToken string) string {
+	hash := sha256.Sum256([]byte(accessToken))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// Confirmation returns the value of the [ConfirmationClaim] for a token bound to the key with the thumbprint.
+func Confirmation(jkt string) map[string]any {
+	return map[string]any{"jkt": jkt}
+}
+
+// uriMatches compares the htu claim to the URI of the request, ignoring query and fragment (RFC 9449, section 4.3).
+func uriMatches(claim, uri string) bool {
+	claimURI, err := url.Parse(claim)
+	if err != nil {
+		return false
+	}
+	requestURI, err := url.Parse(uri)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(claimURI.Scheme, requestURI.Scheme) &&
+		strings.EqualFold(claimURI.Host, requestURI.Host) &&
+		strings.TrimSuffix(claimURI.Path, "/") == strings.TrimSuffix(requestURI.Path, "/")
+}
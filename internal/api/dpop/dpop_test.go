@@ -0,0 +1,221 @@
+package dpop
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/cache"
+	"github.com/zitadel/zitadel/internal/cache/connector/gomap"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	testMethod = "POST"
+	testURI    = "https://issuer.example.com/oauth/v2/token"
+)
+
+func newTestKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return key
+}
+
+func newTestProof(t *testing.T, key *ecdsa.PrivateKey, typ string, claims map[string]any) string {
+	opts := new(jose.SignerOptions).WithType(jose.ContentType(typ))
+	opts.EmbedJWK = true
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: key}, opts)
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	sig, err := signer.Sign(payload)
+	require.NoError(t, err)
+	proof, err := sig.CompactSerialize()
+	require.NoError(t, err)
+	return proof
+}
+
+func validClaims() map[string]any {
+	return map[string]any{
+		"jti": "id",
+		"htm": testMethod,
+		"htu": testURI,
+		"iat": time.Now().Unix(),
+	}
+}
+
+func withClaim(key string, value any) map[string]any {
+	claims := validClaims()
+	claims[key] = value
+	return claims
+}
+
+func TestVerify(t *testing.T) {
+	key := newTestKey(t)
+	jkt, err := Thumbprint(&jose.JSONWebKey{Key: key.Public()})
+	require.NoError(t, err)
+
+	type args struct {
+		proof       string
+		method      string
+		uri         string
+		accessToken string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantJKT string
+		wantErr bool
+	}{
+		{
+			name: "malformed proof",
+			args: args{
+				proof:  "invalid",
+				method: testMethod,
+				uri:    testURI,
+			},
+			wantErr: true,
+		},
+		{
+			name: "wrong type",
+			args: args{
+				proof:  newTestProof(t, key, "JWT", validClaims()),
+				method: testMethod,
+				uri:    testURI,
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing id",
+			args: args{
+				proof:  newTestProof(t, key, proofType, withClaim("jti", "")),
+				method: testMethod,
+				uri:    testURI,
+			},
+			wantErr: true,
+		},
+		{
+			name: "wrong method",
+			args: args{
+				proof:  newTestProof(t, key, proofType, validClaims()),
+				method: "GET",
+				uri:    testURI,
+			},
+			wantErr: true,
+		},
+		{
+			name: "wrong uri",
+			args: args{
+				proof:  newTestProof(t, key, proofType, validClaims()),
+				method: testMethod,
+				uri:    "https://issuer.example.com/oauth/v2/introspect",
+			},
+			wantErr: true,
+		},
+		{
+			name: "expired",
+			args: args{
+				proof:  newTestProof(t, key, proofType, withClaim("iat", time.Now().Add(-2*time.Minute).Unix())),
+				method: testMethod,
+				uri:    testURI,
+			},
+			wantErr: true,
+		},
+		{
+			name: "issued in the future",
+			args: args{
+				proof:  newTestProof(t, key, proofType, withClaim("iat", time.Now().Add(time.Minute).Unix())),
+				method: testMethod,
+				uri:    testURI,
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing access token hash",
+			args: args{
+				proof:       newTestProof(t, key, proofType, validClaims()),
+				method:      testMethod,
+				uri:         testURI,
+				accessToken: "token",
+			},
+			wantErr: true,
+		},
+		{
+			name: "wrong access token hash",
+			args: args{
+				proof:       newTestProof(t, key, proofType, withClaim("ath", AccessTokenHash("other"))),
+				method:      testMethod,
+				uri:         testURI,
+				accessToken: "token",
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid",
+			args: args{
+				proof:  newTestProof(t, key, proofType, validClaims()),
+				method: testMethod,
+				uri:    testURI,
+			},
+			wantJKT: jkt,
+		},
+		{
+			name: "valid, uri with query",
+			args: args{
+				proof:  newTestProof(t, key, proofType, validClaims()),
+				method: testMethod,
+				uri:    testURI + "?foo=bar",
+			},
+			wantJKT: jkt,
+		},
+		{
+			name: "valid, with access token",
+			args: args{
+				proof:       newTestProof(t, key, proofType, withClaim("ath", AccessTokenHash("token"))),
+				method:      testMethod,
+				uri:         testURI,
+				accessToken: "token",
+			},
+			wantJKT: jkt,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := verify(tt.args.proof, tt.args.method, tt.args.uri, tt.args.accessToken)
+			if tt.wantErr {
+				assert.True(t, zerrors.IsUnauthenticated(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantJKT, got.JKT)
+			assert.Equal(t, "id", got.ID)
+		})
+	}
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	ctx := context.Background()
+	usedProofs := gomap.NewCache[Index, string, *UsedProof](ctx, []Index{IndexKey}, cache.Config{MaxAge: proofLifetime + clockSkew})
+	verifier := NewVerifier(usedProofs)
+	key := newTestKey(t)
+	proof := newTestProof(t, key, proofType, validClaims())
+
+	_, err := verifier.Verify(ctx, proof, testMethod, testURI, "")
+	require.NoError(t, err)
+
+	// a proof with the same jti is rejected, even if it is signed again
+	_, err = verifier.Verify(ctx, newTestProof(t, key, proofType, validClaims()), testMethod, testURI, "")
+	assert.True(t, zerrors.IsUnauthenticated(err), err)
+
+	// the same jti is allowed for other keys
+	_, err = verifier.Verify(ctx, newTestProof(t, newTestKey(t), proofType, validClaims()), testMethod, testURI, "")
+	assert.NoError(t, err)
+}
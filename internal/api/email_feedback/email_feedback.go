@@ -0,0 +1,165 @@
+package email_feedback
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	HandlerPrefix = "/email/feedback"
+
+	varProviderID = "providerid"
+	feedbackPath  = "/{" + varProviderID + ":[0-9]+}"
+
+	// maxBodySize limits the size of the feedback payload, provider notifications are small
+	maxBodySize = 1 << 20
+)
+
+type Commands interface {
+	VerifySMTPConfigFeedbackKey(ctx context.Context, resourceOwner, id, key string) (domain.EmailAPIProviderType, error)
+	HumanEmailUndeliverable(ctx context.Context, orgID, userID string, undeliverable *command.EmailUndeliverable) (*domain.ObjectDetails, error)
+}
+
+type Queries interface {
+	SearchUsers(ctx context.Context, queries *query.UserSearchQueries, permissionCheck domain.PermissionCheck) (*query.Users, error)
+}
+
+type Handler struct {
+	commands   Commands
+	queries    Queries
+	httpClient *http.Client
+}
+
+// NewHandler returns the handler receiving bounce and complaint notifications of the email providers.
+// The providers authenticate with HTTP basic auth, using the feedback key of the provider as password.
+func NewHandler(
+	commands Commands,
+	queries Queries,
+	httpClient *http.Client,
+	instanceInterceptor func(next http.Handler) http.Handler,
+) http.Handler {
+	h := &Handler{
+		commands:   commands,
+		queries:    queries,
+		httpClient: httpClient,
+	}
+
+	router := mux.NewRouter()
+	router.Use(instanceInterceptor)
+	router.HandleFunc(feedbackPath, h.handleFeedback).Methods(http.MethodPost)
+	return router
+}
+
+func (h *Handler) handleFeedback(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	providerID := mux.Vars(r)[varProviderID]
+
+	_, key, ok := r.BasicAuth()
+	if !ok {
+		w.Header().Set("WWW-Authenticate", `Basic realm="email feedback"`)
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+	provider, err := h.commands.VerifySMTPConfigFeedbackKey(ctx, authz.GetInstance(ctx).InstanceID(), providerID, key)
+	if err != nil {
+		w.Header().Set("WWW-Authenticate", `Basic realm="email feedback"`)
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+	feedbacks, confirmation, err := parseFeedback(provider, body)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+	if confirmation != nil {
+		if err = h.confirmSubscription(ctx, confirmation); err != nil {
+			logging.WithFields("provider", providerID).WithError(err).Warn("unable to confirm sns subscription")
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	for _, feedback := range feedbacks {
+		if err = h.markUndeliverable(ctx, providerID, feedback); err != nil {
+			logging.WithFields("provider", providerID).WithError(err).Error("unable to mark email undeliverable")
+			// let the provider retry the notification
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// markUndeliverable marks the email of all users of the instance with the reported address as undeliverable.
+// Users, whose address was changed in the meantime, are ignored.
+func (h *Handler) markUndeliverable(ctx context.Context, providerID string, feedback *feedback) error {
+	if feedback.Email == "" {
+		return nil
+	}
+	emailQuery, err := query.NewUserEmailSearchQuery(feedback.Email, query.TextEqualsIgnoreCase)
+	if err != nil {
+		return err
+	}
+	users, err := h.queries.SearchUsers(ctx, &query.UserSearchQueries{Queries: []query.SearchQuery{emailQuery}}, nil)
+	if err != nil {
+		return err
+	}
+	for _, user := range users.Users {
+		_, err = h.commands.HumanEmailUndeliverable(ctx, user.ResourceOwner, user.ID, &command.EmailUndeliverable{
+			Email:       domain.EmailAddress(feedback.Email),
+			Reason:      feedback.Reason,
+			Description: feedback.Description,
+			ProviderID:  providerID,
+			DeliveryID:  feedback.DeliveryID,
+		})
+		if err != nil && !zerrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// confirmSubscription confirms the subscription of the endpoint to an Amazon SNS topic.
+// Only URLs of Amazon SNS are called.
+func (h *Handler) confirmSubscription(ctx context.Context, confirmation *subscriptionConfirmation) error {
+	subscribeURL, err := url.Parse(confirmation.SubscribeURL)
+	if err != nil {
+		return err
+	}
+	if subscribeURL.Scheme != "https" || !strings.HasPrefix(subscribeURL.Hostname(), "sns.") || !strings.HasSuffix(subscribeURL.Hostname(), ".amazonaws.com") {
+		return zerrors.ThrowInvalidArgument(nil, "EMAIL-Fb1cf", "Errors.SMTPConfig.FeedbackInvalid")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, subscribeURL.String(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		logging.WithFields("status", resp.StatusCode).Warn("sns subscription confirmation failed")
+		return zerrors.ThrowInternal(nil, "EMAIL-Fb2cf", "Errors.Internal")
+	}
+	return nil
+}
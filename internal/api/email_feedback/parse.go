@@ -0,0 +1,249 @@
+package email_feedback
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// feedback is a single recipient reported as undeliverable by the email provider.
+type feedback struct {
+	Email       string
+	Reason      domain.EmailUndeliverableReason
+	Description string
+	DeliveryID  string
+}
+
+// subscriptionConfirmation is returned by [parseSES] if Amazon SNS requires to confirm the subscription of the endpoint.
+type subscriptionConfirmation struct {
+	SubscribeURL string
+}
+
+// parseFeedback parses the body of the request depending on the type of the email provider.
+// Only permanent failures (hard bounces) and complaints are returned,
+// temporary failures will be retried by the provider and are ignored.
+func parseFeedback(provider domain.EmailAPIProviderType, body []byte) ([]*feedback, *subscriptionConfirmation, error) {
+	switch provider {
+	case domain.EmailAPIProviderTypeSES:
+		return parseSES(body)
+	case domain.EmailAPIProviderTypeSendGrid:
+		feedbacks, err := parseSendGrid(body)
+		return feedbacks, nil, err
+	case domain.EmailAPIProviderTypeMailgun:
+		feedbacks, err := parseMailgun(body)
+		return feedbacks, nil, err
+	case domain.EmailAPIProviderTypeUnspecified:
+		feedbacks, err := parseGeneric(body)
+		return feedbacks, nil, err
+	default:
+		return nil, nil, zerrors.ThrowInvalidArgument(nil, "EMAIL-Fb4pr", "Errors.SMTPConfig.FeedbackInvalid")
+	}
+}
+
+type snsEnvelope struct {
+	Type         string `json:"Type"`
+	Message      string `json:"Message"`
+	SubscribeURL string `json:"SubscribeURL"`
+}
+
+type sesNotification struct {
+	NotificationType string `json:"notificationType"`
+	Mail             struct {
+		MessageID string `json:"messageId"`
+	} `json:"mail"`
+	Bounce struct {
+		BounceType        string `json:"bounceType"`
+		BounceSubType     string `json:"bounceSubType"`
+		BouncedRecipients []struct {
+			EmailAddress   string `json:"emailAddress"`
+			DiagnosticCode string `json:"diagnosticCode"`
+		} `json:"bouncedRecipients"`
+	} `json:"bounce"`
+	Complaint struct {
+		ComplaintFeedbackType string `json:"complaintFeedbackType"`
+		ComplainedRecipients  []struct {
+			EmailAddress string `json:"emailAddress"`
+		} `json:"complainedRecipients"`
+	} `json:"complaint"`
+}
+
+// parseSES parses the notifications of Amazon SES, which are delivered through an Amazon SNS topic.
+func parseSES(body []byte) ([]*feedback, *subscriptionConfirmation, error) {
+	envelope := new(snsEnvelope)
+	if err := json.Unmarshal(body, envelope); err != nil {
+		return nil, nil, zerrors.ThrowInvalidArgument(err, "EMAIL-Fb5sn", "Errors.SMTPConfig.FeedbackInvalid")
+	}
+	switch envelope.Type {
+	case "SubscriptionConfirmation":
+		return nil, &subscriptionConfirmation{SubscribeURL: envelope.SubscribeURL}, nil
+	case "Notification":
+		// handled below
+	default:
+		return nil, nil, nil
+	}
+	notification := new(sesNotification)
+	if err := json.Unmarshal([]byte(envelope.Message), notification); err != nil {
+		return nil, nil, zerrors.ThrowInvalidArgument(err, "EMAIL-Fb6sn", "Errors.SMTPConfig.FeedbackInvalid")
+	}
+	var feedbacks []*feedback
+	switch notification.NotificationType {
+	case "Bounce":
+		if notification.Bounce.BounceType != "Permanent" {
+			return nil, nil, nil
+		}
+		for _, recipient := range notification.Bounce.BouncedRecipients {
+			description := recipient.DiagnosticCode
+			if description == "" {
+				description = notification.Bounce.BounceSubType
+			}
+			feedbacks = append(feedbacks, &feedback{
+				Email:       recipient.EmailAddress,
+				Reason:      domain.EmailUndeliverableReasonBounce,
+				Description: description,
+				DeliveryID:  notification.Mail.MessageID,
+			})
+		}
+	case "Complaint":
+		for _, recipient := range notification.Complaint.ComplainedRecipients {
+			feedbacks = append(feedbacks, &feedback{
+				Email:       recipient.EmailAddress,
+				Reason:      domain.EmailUndeliverableReasonComplaint,
+				Description: notification.Complaint.ComplaintFeedbackType,
+				DeliveryID:  notification.Mail.MessageID,
+			})
+		}
+	}
+	return feedbacks, nil, nil
+}
+
+type sendGridEvent struct {
+	Email       string `json:"email"`
+	Event       string `json:"event"`
+	Type        string `json:"type"`
+	Reason      string `json:"reason"`
+	SGMessageID string `json:"sg_message_id"`
+}
+
+// parseSendGrid parses the events of the SendGrid Event Webhook.
+func parseSendGrid(body []byte) ([]*feedback, error) {
+	var events []*sendGridEvent
+	if err := json.Unmarshal(body, &events); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "EMAIL-Fb7sg", "Errors.SMTPConfig.FeedbackInvalid")
+	}
+	var feedbacks []*feedback
+	for _, event := range events {
+		switch event.Event {
+		case "bounce":
+			// blocked messages are temporary failures
+			if event.Type == "blocked" {
+				continue
+			}
+			feedbacks = append(feedbacks, &feedback{
+				Email:       event.Email,
+				Reason:      domain.EmailUndeliverableReasonBounce,
+				Description: event.Reason,
+				DeliveryID:  sendGridMessageID(event.SGMessageID),
+			})
+		case "spamreport":
+			feedbacks = append(feedbacks, &feedback{
+				Email:       event.Email,
+				Reason:      domain.EmailUndeliverableReasonComplaint,
+				Description: event.Event,
+				DeliveryID:  sendGridMessageID(event.SGMessageID),
+			})
+		}
+	}
+	return feedbacks, nil
+}
+
+// sendGridMessageID returns the message id as returned by the SendGrid API on sending.
+// The sg_message_id of the events is suffixed with filter information: `<message id>.filter...`.
+func sendGridMessageID(id string) string {
+	messageID, _, _ := strings.Cut(id, ".")
+	return messageID
+}
+
+type mailgunWebhook struct {
+	EventData struct {
+		Event          string `json:"event"`
+		Severity       string `json:"severity"`
+		Recipient      string `json:"recipient"`
+		DeliveryStatus struct {
+			Message     string `json:"message"`
+			Description string `json:"description"`
+		} `json:"delivery-status"`
+		Message struct {
+			Headers struct {
+				MessageID string `json:"message-id"`
+			} `json:"headers"`
+		} `json:"message"`
+	} `json:"event-data"`
+}
+
+// parseMailgun parses the events of the Mailgun webhooks.
+func parseMailgun(body []byte) ([]*feedback, error) {
+	webhook := new(mailgunWebhook)
+	if err := json.Unmarshal(body, webhook); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "EMAIL-Fb8mg", "Errors.SMTPConfig.FeedbackInvalid")
+	}
+	event := webhook.EventData
+	switch event.Event {
+	case "failed":
+		if event.Severity != "permanent" {
+			return nil, nil
+		}
+		description := event.DeliveryStatus.Description
+		if description == "" {
+			description = event.DeliveryStatus.Message
+		}
+		return []*feedback{{
+			Email:       event.Recipient,
+			Reason:      domain.EmailUndeliverableReasonBounce,
+			Description: description,
+			DeliveryID:  event.Message.Headers.MessageID,
+		}}, nil
+	case "complained":
+		return []*feedback{{
+			Email:       event.Recipient,
+			Reason:      domain.EmailUndeliverableReasonComplaint,
+			Description: event.Event,
+			DeliveryID:  event.Message.Headers.MessageID,
+		}}, nil
+	}
+	return nil, nil
+}
+
+type genericFeedback struct {
+	Email       string `json:"email"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+	DeliveryID  string `json:"deliveryId"`
+}
+
+// parseGeneric parses the feedback for SMTP and HTTP providers,
+// which is expected to be sent by the operator of the provider in the following form:
+//
+//	{"email": "user@example.com", "type": "bounce", "description": "mailbox does not exist", "deliveryId": "<id>"}
+func parseGeneric(body []byte) ([]*feedback, error) {
+	generic := new(genericFeedback)
+	if err := json.Unmarshal(body, generic); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "EMAIL-Fb9gn", "Errors.SMTPConfig.FeedbackInvalid")
+	}
+	var reason domain.EmailUndeliverableReason
+	switch generic.Type {
+	case "bounce":
+		reason = domain.EmailUndeliverableReasonBounce
+	case "complaint":
+		reason = domain.EmailUndeliverableReasonComplaint
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "EMAIL-Fb0gn", "Errors.SMTPConfig.FeedbackInvalid")
+	}
+	return []*feedback{{
+		Email:       generic.Email,
+		Reason:      reason,
+		Description: generic.Description,
+		DeliveryID:  generic.DeliveryID,
+	}}, nil
+}
@@ -0,0 +1,207 @@
+package email_feedback
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func Test_parseFeedback(t *testing.T) {
+	type args struct {
+		provider domain.EmailAPIProviderType
+		body     string
+	}
+	type want struct {
+		feedbacks    []*feedback
+		confirmation *subscriptionConfirmation
+		err          func(error) bool
+	}
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "ses, subscription confirmation",
+			args: args{
+				provider: domain.EmailAPIProviderTypeSES,
+				body:     `{"Type": "SubscriptionConfirmation", "SubscribeURL": "https://sns.eu-central-1.amazonaws.com/?Action=ConfirmSubscription"}`,
+			},
+			want: want{
+				confirmation: &subscriptionConfirmation{
+					SubscribeURL: "https://sns.eu-central-1.amazonaws.com/?Action=ConfirmSubscription",
+				},
+			},
+		},
+		{
+			name: "ses, permanent bounce",
+			args: args{
+				provider: domain.EmailAPIProviderTypeSES,
+				body:     `{"Type": "Notification", "Message": "{\"notificationType\": \"Bounce\", \"mail\": {\"messageId\": \"message-id\"}, \"bounce\": {\"bounceType\": \"Permanent\", \"bounceSubType\": \"General\", \"bouncedRecipients\": [{\"emailAddress\": \"user@example.com\", \"diagnosticCode\": \"smtp; 550 5.1.1 user unknown\"}]}}"}`,
+			},
+			want: want{
+				feedbacks: []*feedback{
+					{
+						Email:       "user@example.com",
+						Reason:      domain.EmailUndeliverableReasonBounce,
+						Description: "smtp; 550 5.1.1 user unknown",
+						DeliveryID:  "message-id",
+					},
+				},
+			},
+		},
+		{
+			name: "ses, transient bounce, ignored",
+			args: args{
+				provider: domain.EmailAPIProviderTypeSES,
+				body:     `{"Type": "Notification", "Message": "{\"notificationType\": \"Bounce\", \"bounce\": {\"bounceType\": \"Transient\", \"bouncedRecipients\": [{\"emailAddress\": \"user@example.com\"}]}}"}`,
+			},
+			want: want{},
+		},
+		{
+			name: "ses, complaint",
+			args: args{
+				provider: domain.EmailAPIProviderTypeSES,
+				body:     `{"Type": "Notification", "Message": "{\"notificationType\": \"Complaint\", \"mail\": {\"messageId\": \"message-id\"}, \"complaint\": {\"complaintFeedbackType\": \"abuse\", \"complainedRecipients\": [{\"emailAddress\": \"user@example.com\"}]}}"}`,
+			},
+			want: want{
+				feedbacks: []*feedback{
+					{
+						Email:       "user@example.com",
+						Reason:      domain.EmailUndeliverableReasonComplaint,
+						Description: "abuse",
+						DeliveryID:  "message-id",
+					},
+				},
+			},
+		},
+		{
+			name: "ses, invalid message",
+			args: args{
+				provider: domain.EmailAPIProviderTypeSES,
+				body:     `{"Type": "Notification", "Message": "invalid"}`,
+			},
+			want: want{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "sendgrid, events",
+			args: args{
+				provider: domain.EmailAPIProviderTypeSendGrid,
+				body:     `[{"email": "bounce@example.com", "event": "bounce", "type": "bounce", "reason": "550 5.1.1 user unknown", "sg_message_id": "message-id.filter0001"}, {"email": "blocked@example.com", "event": "bounce", "type": "blocked"}, {"email": "delivered@example.com", "event": "delivered"}, {"email": "spam@example.com", "event": "spamreport", "sg_message_id": "message-id.filter0002"}]`,
+			},
+			want: want{
+				feedbacks: []*feedback{
+					{
+						Email:       "bounce@example.com",
+						Reason:      domain.EmailUndeliverableReasonBounce,
+						Description: "550 5.1.1 user unknown",
+						DeliveryID:  "message-id",
+					},
+					{
+						Email:       "spam@example.com",
+						Reason:      domain.EmailUndeliverableReasonComplaint,
+						Description: "spamreport",
+						DeliveryID:  "message-id",
+					},
+				},
+			},
+		},
+		{
+			name: "sendgrid, invalid",
+			args: args{
+				provider: domain.EmailAPIProviderTypeSendGrid,
+				body:     `{"event": "bounce"}`,
+			},
+			want: want{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "mailgun, permanent failure",
+			args: args{
+				provider: domain.EmailAPIProviderTypeMailgun,
+				body:     `{"signature": {}, "event-data": {"event": "failed", "severity": "permanent", "recipient": "user@example.com", "delivery-status": {"message": "user unknown", "description": "mailbox does not exist"}, "message": {"headers": {"message-id": "message-id"}}}}`,
+			},
+			want: want{
+				feedbacks: []*feedback{
+					{
+						Email:       "user@example.com",
+						Reason:      domain.EmailUndeliverableReasonBounce,
+						Description: "mailbox does not exist",
+						DeliveryID:  "message-id",
+					},
+				},
+			},
+		},
+		{
+			name: "mailgun, temporary failure, ignored",
+			args: args{
+				provider: domain.EmailAPIProviderTypeMailgun,
+				body:     `{"event-data": {"event": "failed", "severity": "temporary", "recipient": "user@example.com"}}`,
+			},
+			want: want{},
+		},
+		{
+			name: "mailgun, complaint",
+			args: args{
+				provider: domain.EmailAPIProviderTypeMailgun,
+				body:     `{"event-data": {"event": "complained", "recipient": "user@example.com", "message": {"headers": {"message-id": "message-id"}}}}`,
+			},
+			want: want{
+				feedbacks: []*feedback{
+					{
+						Email:       "user@example.com",
+						Reason:      domain.EmailUndeliverableReasonComplaint,
+						Description: "complained",
+						DeliveryID:  "message-id",
+					},
+				},
+			},
+		},
+		{
+			name: "generic, bounce",
+			args: args{
+				provider: domain.EmailAPIProviderTypeUnspecified,
+				body:     `{"email": "user@example.com", "type": "bounce", "description": "mailbox does not exist", "deliveryId": "delivery-id"}`,
+			},
+			want: want{
+				feedbacks: []*feedback{
+					{
+						Email:       "user@example.com",
+						Reason:      domain.EmailUndeliverableReasonBounce,
+						Description: "mailbox does not exist",
+						DeliveryID:  "delivery-id",
+					},
+				},
+			},
+		},
+		{
+			name: "generic, unknown type",
+			args: args{
+				provider: domain.EmailAPIProviderTypeUnspecified,
+				body:     `{"email": "user@example.com", "type": "delivered"}`,
+			},
+			want: want{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feedbacks, confirmation, err := parseFeedback(tt.args.provider, []byte(tt.args.body))
+			if tt.want.err != nil {
+				assert.True(t, tt.want.err(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.feedbacks, feedbacks)
+			assert.Equal(t, tt.want.confirmation, confirmation)
+		})
+	}
+}
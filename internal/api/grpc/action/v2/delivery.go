@@ -0,0 +1,183 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/filter/v2"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	"github.com/zitadel/zitadel/pkg/grpc/action/v2"
+)
+
+func (s *Server) ListFailedDeliveries(ctx context.Context, req *connect.Request[action.ListFailedDeliveriesRequest]) (*connect.Response[action.ListFailedDeliveriesResponse], error) {
+	queries, err := s.listFailedDeliveriesRequestToModel(req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.query.SearchTargetDeliveries(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	deliveries := make([]*action.TargetDelivery, len(resp.TargetDeliveries))
+	for i, delivery := range resp.TargetDeliveries {
+		deliveries[i] = targetDeliveryToPb(delivery)
+	}
+	return connect.NewResponse(&action.ListFailedDeliveriesResponse{
+		Deliveries: deliveries,
+		Pagination: filter.QueryToPaginationPb(queries.SearchRequest, resp.SearchResponse),
+	}), nil
+}
+
+func (s *Server) GetFailedDelivery(ctx context.Context, req *connect.Request[action.GetFailedDeliveryRequest]) (*connect.Response[action.GetFailedDeliveryResponse], error) {
+	resp, err := s.query.GetTargetDeliveryByID(ctx, strings.TrimSpace(req.Msg.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	delivery := targetDeliveryToPb(resp)
+	delivery.Payload, err = payloadToPb(resp.Payload())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&action.GetFailedDeliveryResponse{
+		Delivery: delivery,
+	}), nil
+}
+
+func (s *Server) RedriveFailedDelivery(ctx context.Context, req *connect.Request[action.RedriveFailedDeliveryRequest]) (*connect.Response[action.RedriveFailedDeliveryResponse], error) {
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	redrivenAt, err := s.command.RedriveTargetDelivery(ctx, strings.TrimSpace(req.Msg.GetId()), instanceID)
+	if err != nil {
+		return nil, err
+	}
+	var redriveDate *timestamppb.Timestamp
+	if !redrivenAt.IsZero() {
+		redriveDate = timestamppb.New(redrivenAt)
+	}
+	return connect.NewResponse(&action.RedriveFailedDeliveryResponse{
+		RedriveDate: redriveDate,
+	}), nil
+}
+
+func targetDeliveryToPb(d *query.TargetDelivery) *action.TargetDelivery {
+	delivery := &action.TargetDelivery{
+		Id:            d.ID,
+		TargetId:      d.TargetID,
+		EventType:     d.EventType,
+		AggregateType: d.AggregateType,
+		AggregateId:   d.AggregateID,
+		LastError:     d.LastError,
+		Attempts:      uint32(d.Attempts),
+		State:         targetDeliveryStateToPb(d.State),
+	}
+	if !d.EventDate.IsZero() {
+		delivery.ChangeDate = timestamppb.New(d.EventDate)
+	}
+	if !d.CreationDate.IsZero() {
+		delivery.CreationDate = timestamppb.New(d.CreationDate)
+	}
+	return delivery
+}
+
+func payloadToPb(payload []byte) (*structpb.Struct, error) {
+	if len(payload) == 0 {
+		return nil, nil
+	}
+	fields := make(map[string]any)
+	if err := json.Unmarshal(payload, &fields); err != nil {
+		return nil, zerrors.ThrowInternal(err, "GRPC-Dl5vP1", "Errors.Internal")
+	}
+	return structpb.NewStruct(fields)
+}
+
+func targetDeliveryStateToPb(state domain.TargetDeliveryState) action.TargetDeliveryState {
+	switch state {
+	case domain.TargetDeliveryStateUnspecified:
+		return action.TargetDeliveryState_TARGET_DELIVERY_STATE_UNSPECIFIED
+	case domain.TargetDeliveryStateFailed:
+		return action.TargetDeliveryState_TARGET_DELIVERY_STATE_FAILED
+	case domain.TargetDeliveryStateRedriving:
+		return action.TargetDeliveryState_TARGET_DELIVERY_STATE_REDRIVING
+	case domain.TargetDeliveryStateDelivered:
+		return action.TargetDeliveryState_TARGET_DELIVERY_STATE_DELIVERED
+	default:
+		return action.TargetDeliveryState_TARGET_DELIVERY_STATE_UNSPECIFIED
+	}
+}
+
+func targetDeliveryStateToDomain(state action.TargetDeliveryState) domain.TargetDeliveryState {
+	switch state {
+	case action.TargetDeliveryState_TARGET_DELIVERY_STATE_UNSPECIFIED:
+		return domain.TargetDeliveryStateUnspecified
+	case action.TargetDeliveryState_TARGET_DELIVERY_STATE_FAILED:
+		return domain.TargetDeliveryStateFailed
+	case action.TargetDeliveryState_TARGET_DELIVERY_STATE_REDRIVING:
+		return domain.TargetDeliveryStateRedriving
+	case action.TargetDeliveryState_TARGET_DELIVERY_STATE_DELIVERED:
+		return domain.TargetDeliveryStateDelivered
+	default:
+		return domain.TargetDeliveryStateUnspecified
+	}
+}
+
+func (s *Server) listFailedDeliveriesRequestToModel(req *action.ListFailedDeliveriesRequest) (*query.TargetDeliverySearchQueries, error) {
+	offset, limit, asc, err := filter.PaginationPbToQuery(s.systemDefaults, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	queries := make([]query.SearchQuery, len(req.GetFilters()))
+	for i, f := range req.GetFilters() {
+		queries[i], err = targetDeliveryFilterToQuery(f)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &query.TargetDeliverySearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: targetDeliveryFieldNameToSortingColumn(req.SortingColumn),
+		},
+		Queries: queries,
+	}, nil
+}
+
+func targetDeliveryFilterToQuery(f *action.TargetDeliverySearchFilter) (query.SearchQuery, error) {
+	switch q := f.Filter.(type) {
+	case *action.TargetDeliverySearchFilter_TargetFilter:
+		return query.NewTargetDeliveryTargetIDSearchQuery(q.TargetFilter.GetTargetId())
+	case *action.TargetDeliverySearchFilter_EventTypeFilter:
+		return query.NewTargetDeliveryEventTypeSearchQuery(filter.TextMethodPbToQuery(q.EventTypeFilter.GetMethod()), q.EventTypeFilter.GetEventType())
+	case *action.TargetDeliverySearchFilter_StateFilter:
+		return query.NewTargetDeliveryStateSearchQuery(targetDeliveryStateToDomain(q.StateFilter.GetState()))
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "GRPC-vR9nC", "List.Query.Invalid")
+	}
+}
+
+// targetDeliveryFieldNameToSortingColumn defaults to the creation date because this ensures deterministic pagination
+func targetDeliveryFieldNameToSortingColumn(field *action.TargetDeliveryFieldName) query.Column {
+	if field == nil {
+		return query.TargetDeliveryColumnCreationDate
+	}
+	switch *field {
+	case action.TargetDeliveryFieldName_TARGET_DELIVERY_FIELD_NAME_UNSPECIFIED:
+		return query.TargetDeliveryColumnCreationDate
+	case action.TargetDeliveryFieldName_TARGET_DELIVERY_FIELD_NAME_CREATION_DATE:
+		return query.TargetDeliveryColumnCreationDate
+	case action.TargetDeliveryFieldName_TARGET_DELIVERY_FIELD_NAME_CHANGE_DATE:
+		return query.TargetDeliveryColumnChangeDate
+	case action.TargetDeliveryFieldName_TARGET_DELIVERY_FIELD_NAME_EVENT_TYPE:
+		return query.TargetDeliveryColumnEventType
+	default:
+		return query.TargetDeliveryColumnCreationDate
+	}
+}
@@ -22,6 +22,7 @@ func (s *Server) SetExecution(ctx context.Context, req *connect.Request[action.S
 	}
 	set := &command.SetExecution{
 		Targets: targets,
+		Filter:  req.Msg.GetFilter(),
 	}
 	var err error
 	var details *domain.ObjectDetails
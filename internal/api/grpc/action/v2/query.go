@@ -105,6 +105,7 @@ func targetToPb(t *query.Target) *action.Target {
 		Endpoint:    t.Endpoint,
 		SigningKey:  t.SigningKey,
 		PayloadType: payloadTypeToPb(t.PayloadType),
+		RetryPolicy: retryPolicyToPb(t.RetryPolicy),
 	}
 	switch t.TargetType {
 	case target_domain.TargetTypeWebhook:
@@ -113,6 +114,8 @@ func targetToPb(t *query.Target) *action.Target {
 		target.TargetType = &action.Target_RestCall{RestCall: &action.RESTCall{InterruptOnError: t.InterruptOnError}}
 	case target_domain.TargetTypeAsync:
 		target.TargetType = &action.Target_RestAsync{RestAsync: &action.RESTAsync{}}
+	case target_domain.TargetTypeGRPC:
+		target.TargetType = &action.Target_Grpc{Grpc: &action.GRPCTarget{InterruptOnError: t.InterruptOnError}}
 	default:
 		target.TargetType = nil
 	}
@@ -126,6 +129,17 @@ func targetToPb(t *query.Target) *action.Target {
 	return target
 }
 
+func retryPolicyToPb(policy *target_domain.RetryPolicy) *action.RetryPolicy {
+	if policy == nil {
+		return nil
+	}
+	return &action.RetryPolicy{
+		MaxRetries:     uint32(policy.MaxRetries),
+		InitialBackoff: durationpb.New(policy.InitialBackoff),
+		MaxBackoff:     durationpb.New(policy.MaxBackoff),
+	}
+}
+
 func payloadTypeToPb(payloadType target_domain.PayloadType) action.PayloadType {
 	switch payloadType {
 	case target_domain.PayloadTypeUnspecified:
@@ -366,6 +380,7 @@ func executionToPb(e *query.Execution) *action.Execution {
 	exec := &action.Execution{
 		Condition: executionIDToCondition(e.ID),
 		Targets:   targets,
+		Filter:    e.Filter,
 	}
 	if !e.EventDate.IsZero() {
 		exec.ChangeDate = timestamppb.New(e.EventDate)
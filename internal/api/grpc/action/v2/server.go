@@ -10,6 +10,8 @@ import (
 	"github.com/zitadel/zitadel/internal/api/grpc/server"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/config/systemdefaults"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/execution"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/pkg/grpc/action/v2"
 	"github.com/zitadel/zitadel/pkg/grpc/action/v2/actionconnect"
@@ -24,6 +26,10 @@ type Server struct {
 	ListActionFunctions func() []string
 	ListGRPCMethods     func() []string
 	ListGRPCServices    func() []string
+
+	targetEncAlg     crypto.EncryptionAlgorithm
+	activeSigningKey execution.GetActiveSigningWebKey
+	httpClient       *http.Client
 }
 
 type Config struct{}
@@ -35,6 +41,9 @@ func CreateServer(
 	listActionFunctions func() []string,
 	listGRPCMethods func() []string,
 	listGRPCServices func() []string,
+	targetEncAlg crypto.EncryptionAlgorithm,
+	activeSigningKey execution.GetActiveSigningWebKey,
+	httpClient *http.Client,
 ) *Server {
 	return &Server{
 		systemDefaults:      systemDefaults,
@@ -43,6 +52,9 @@ func CreateServer(
 		ListActionFunctions: listActionFunctions,
 		ListGRPCMethods:     listGRPCMethods,
 		ListGRPCServices:    listGRPCServices,
+		targetEncAlg:        targetEncAlg,
+		activeSigningKey:    activeSigningKey,
+		httpClient:          httpClient,
 	}
 }
 
@@ -134,14 +134,31 @@ func createTargetToCommand(req *action.CreateTargetRequest) *command.AddTarget {
 		interruptOnError = t.RestCall.InterruptOnError
 	case *action.CreateTargetRequest_RestAsync:
 		targetType = target_domain.TargetTypeAsync
+	case *action.CreateTargetRequest_Grpc:
+		targetType = target_domain.TargetTypeGRPC
+		interruptOnError = t.Grpc.InterruptOnError
 	}
 	return &command.AddTarget{
-		Name:             req.GetName(),
-		TargetType:       targetType,
-		Endpoint:         req.GetEndpoint(),
-		Timeout:          req.GetTimeout().AsDuration(),
-		InterruptOnError: interruptOnError,
-		PayloadType:      payloadTypeToDomain(req.GetPayloadType()),
+		Name:              req.GetName(),
+		TargetType:        targetType,
+		Endpoint:          req.GetEndpoint(),
+		Timeout:           req.GetTimeout().AsDuration(),
+		InterruptOnError:  interruptOnError,
+		PayloadType:       payloadTypeToDomain(req.GetPayloadType()),
+		ClientCertificate: req.GetClientCertificate().GetCertificate(),
+		ClientKey:         req.GetClientCertificate().GetPrivateKey(),
+		RetryPolicy:       retryPolicyToDomain(req.GetRetryPolicy()),
+	}
+}
+
+func retryPolicyToDomain(policy *action.RetryPolicy) *target_domain.RetryPolicy {
+	if policy == nil {
+		return nil
+	}
+	return &target_domain.RetryPolicy{
+		MaxRetries:     uint8(policy.GetMaxRetries()),
+		InitialBackoff: policy.GetInitialBackoff().AsDuration(),
+		MaxBackoff:     policy.GetMaxBackoff().AsDuration(),
 	}
 }
 
@@ -187,10 +204,20 @@ func updateTargetToCommand(req *action.UpdateTargetRequest) *command.ChangeTarge
 		case *action.UpdateTargetRequest_RestAsync:
 			target.TargetType = gu.Ptr(target_domain.TargetTypeAsync)
 			target.InterruptOnError = gu.Ptr(false)
+		case *action.UpdateTargetRequest_Grpc:
+			target.TargetType = gu.Ptr(target_domain.TargetTypeGRPC)
+			target.InterruptOnError = gu.Ptr(t.Grpc.InterruptOnError)
 		}
 	}
+	if req.ClientCertificate != nil {
+		target.ClientCertificate = req.GetClientCertificate().GetCertificate()
+		target.ClientKey = req.GetClientCertificate().GetPrivateKey()
+	}
 	if req.Timeout != nil {
 		target.Timeout = gu.Ptr(req.GetTimeout().AsDuration())
 	}
+	if req.RetryPolicy != nil {
+		target.RetryPolicy = retryPolicyToDomain(req.GetRetryPolicy())
+	}
 	return target
 }
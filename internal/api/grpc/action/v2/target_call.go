@@ -0,0 +1,110 @@
+package action
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/filter/v2"
+	"github.com/zitadel/zitadel/internal/execution"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	"github.com/zitadel/zitadel/pkg/grpc/action/v2"
+)
+
+func (s *Server) ListTargetCalls(ctx context.Context, req *connect.Request[action.ListTargetCallsRequest]) (*connect.Response[action.ListTargetCallsResponse], error) {
+	queries, err := s.listTargetCallsRequestToModel(req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.query.SearchTargetCalls(ctx, strings.TrimSpace(req.Msg.GetTargetId()), queries)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&action.ListTargetCallsResponse{
+		Calls:      targetCallsToPb(resp.TargetCalls),
+		Pagination: filter.QueryToPaginationPb(queries.SearchRequest, resp.SearchResponse),
+	}), nil
+}
+
+func (s *Server) TestTarget(ctx context.Context, req *connect.Request[action.TestTargetRequest]) (*connect.Response[action.TestTargetResponse], error) {
+	target, err := s.query.GetExecutionTargetByID(ctx, strings.TrimSpace(req.Msg.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	target.ExecutionID, err = conditionToID(req.Msg.GetCondition())
+	if err != nil {
+		return nil, err
+	}
+	info, err := execution.TestContextInfo(ctx, target.ExecutionID, req.Msg.GetPayload().AsMap())
+	if err != nil {
+		return nil, err
+	}
+	exchange := execution.TestTarget(ctx, *target, info, s.targetEncAlg, s.activeSigningKey, s.httpClient)
+	resp := &action.TestTargetResponse{
+		RequestBody:  exchange.Request,
+		StatusCode:   uint32(exchange.StatusCode),
+		ResponseBody: exchange.Response,
+		Duration:     durationpb.New(exchange.Took),
+		Interrupted:  exchange.Interrupted,
+	}
+	if exchange.Err != nil {
+		resp.Error = exchange.Err.Error()
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func targetCallsToPb(calls []*query.TargetCall) []*action.TargetCall {
+	c := make([]*action.TargetCall, len(calls))
+	for i, call := range calls {
+		c[i] = &action.TargetCall{
+			CallDate:     timestamppb.New(call.LogDate),
+			ExecutionId:  call.ExecutionID,
+			StatusCode:   uint32(call.StatusCode),
+			Duration:     durationpb.New(call.Took),
+			ResponseBody: call.ResponseBody,
+			Error:        call.Error,
+			Interrupted:  call.Interrupted,
+		}
+	}
+	return c
+}
+
+func (s *Server) listTargetCallsRequestToModel(req *action.ListTargetCallsRequest) (*query.TargetCallSearchQueries, error) {
+	offset, limit, asc, err := filter.PaginationPbToQuery(s.systemDefaults, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	queries := make([]query.SearchQuery, len(req.GetFilters()))
+	for i, f := range req.GetFilters() {
+		queries[i], err = targetCallFilterToQuery(f)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &query.TargetCallSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: query.TargetCallColumnLogDate,
+		},
+		Queries: queries,
+	}, nil
+}
+
+func targetCallFilterToQuery(f *action.TargetCallSearchFilter) (query.SearchQuery, error) {
+	switch q := f.Filter.(type) {
+	case *action.TargetCallSearchFilter_ExecutionIdFilter:
+		return query.NewTargetCallExecutionIDSearchQuery(q.ExecutionIdFilter.GetExecutionId())
+	case *action.TargetCallSearchFilter_InterruptedFilter:
+		return query.NewTargetCallInterruptedSearchQuery(q.InterruptedFilter)
+	case *action.TargetCallSearchFilter_CallDateFilter:
+		return query.NewTargetCallLogDateSearchQuery(q.CallDateFilter.GetTimestamp().AsTime(), filter.TimestampMethodPbToQuery(q.CallDateFilter.GetMethod()))
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "GRPC-vR9nC", "List.Query.Invalid")
+	}
+}
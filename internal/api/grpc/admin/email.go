@@ -72,6 +72,27 @@ func (s *Server) UpdateEmailProviderHTTP(ctx context.Context, req *admin_pb.Upda
 	}, nil
 }
 
+func (s *Server) AddEmailProviderAPI(ctx context.Context, req *admin_pb.AddEmailProviderAPIRequest) (*admin_pb.AddEmailProviderAPIResponse, error) {
+	config := addEmailProviderAPIToConfig(ctx, req)
+	if err := s.command.AddSMTPConfigAPI(ctx, config); err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddEmailProviderAPIResponse{
+		Details: object.DomainToChangeDetailsPb(config.Details),
+		Id:      config.ID,
+	}, nil
+}
+
+func (s *Server) UpdateEmailProviderAPI(ctx context.Context, req *admin_pb.UpdateEmailProviderAPIRequest) (*admin_pb.UpdateEmailProviderAPIResponse, error) {
+	config := updateEmailProviderAPIToConfig(ctx, req)
+	if err := s.command.ChangeSMTPConfigAPI(ctx, config); err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateEmailProviderAPIResponse{
+		Details: object.DomainToChangeDetailsPb(config.Details),
+	}, nil
+}
+
 func (s *Server) RemoveEmailProvider(ctx context.Context, req *admin_pb.RemoveEmailProviderRequest) (*admin_pb.RemoveEmailProviderResponse, error) {
 	details, err := s.command.RemoveSMTPConfig(ctx, authz.GetInstance(ctx).InstanceID(), req.Id)
 	if err != nil {
@@ -117,6 +138,17 @@ func (s *Server) ActivateEmailProvider(ctx context.Context, req *admin_pb.Activa
 	}, nil
 }
 
+func (s *Server) GenerateEmailProviderFeedbackKey(ctx context.Context, req *admin_pb.GenerateEmailProviderFeedbackKeyRequest) (*admin_pb.GenerateEmailProviderFeedbackKeyResponse, error) {
+	key, result, err := s.command.GenerateSMTPConfigFeedbackKey(ctx, authz.GetInstance(ctx).InstanceID(), req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GenerateEmailProviderFeedbackKeyResponse{
+		Details:     object.DomainToChangeDetailsPb(result),
+		FeedbackKey: key,
+	}, nil
+}
+
 func (s *Server) DeactivateEmailProvider(ctx context.Context, req *admin_pb.DeactivateEmailProviderRequest) (*admin_pb.DeactivateEmailProviderResponse, error) {
 	result, err := s.command.DeactivateSMTPConfig(ctx, authz.GetInstance(ctx).InstanceID(), req.Id)
 	if err != nil {
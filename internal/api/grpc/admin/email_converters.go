@@ -62,9 +62,56 @@ func emailProviderToPb(config *query.SMTPConfig) settings_pb.EmailConfig {
 	if config.HTTPConfig != nil {
 		return httpToPb(config.HTTPConfig)
 	}
+	if config.APIConfig != nil {
+		return emailAPIToPb(config.APIConfig)
+	}
 	return nil
 }
 
+func emailAPIToPb(config *query.EmailAPI) *settings_pb.EmailProvider_Api {
+	return &settings_pb.EmailProvider_Api{
+		Api: &settings_pb.EmailProviderAPI{
+			Provider:       emailAPIProviderToPb(config.Provider),
+			SenderAddress:  config.SenderAddress,
+			SenderName:     config.SenderName,
+			ReplyToAddress: config.ReplyToAddress,
+			Region:         config.Region,
+			Domain:         config.Domain,
+			AccessKeyId:    config.AccessKeyID,
+		},
+	}
+}
+
+func emailAPIProviderToPb(provider domain.EmailAPIProviderType) settings_pb.EmailAPIProvider {
+	switch provider {
+	case domain.EmailAPIProviderTypeSES:
+		return settings_pb.EmailAPIProvider_EMAIL_API_PROVIDER_SES
+	case domain.EmailAPIProviderTypeSendGrid:
+		return settings_pb.EmailAPIProvider_EMAIL_API_PROVIDER_SENDGRID
+	case domain.EmailAPIProviderTypeMailgun:
+		return settings_pb.EmailAPIProvider_EMAIL_API_PROVIDER_MAILGUN
+	case domain.EmailAPIProviderTypeUnspecified:
+		return settings_pb.EmailAPIProvider_EMAIL_API_PROVIDER_UNSPECIFIED
+	default:
+		return settings_pb.EmailAPIProvider_EMAIL_API_PROVIDER_UNSPECIFIED
+	}
+}
+
+func emailAPIProviderToDomain(provider settings_pb.EmailAPIProvider) domain.EmailAPIProviderType {
+	switch provider {
+	case settings_pb.EmailAPIProvider_EMAIL_API_PROVIDER_SES:
+		return domain.EmailAPIProviderTypeSES
+	case settings_pb.EmailAPIProvider_EMAIL_API_PROVIDER_SENDGRID:
+		return domain.EmailAPIProviderTypeSendGrid
+	case settings_pb.EmailAPIProvider_EMAIL_API_PROVIDER_MAILGUN:
+		return domain.EmailAPIProviderTypeMailgun
+	case settings_pb.EmailAPIProvider_EMAIL_API_PROVIDER_UNSPECIFIED:
+		return domain.EmailAPIProviderTypeUnspecified
+	default:
+		return domain.EmailAPIProviderTypeUnspecified
+	}
+}
+
 func httpToPb(http *query.HTTP) *settings_pb.EmailProvider_Http {
 	return &settings_pb.EmailProvider_Http{
 		Http: &settings_pb.EmailProviderHTTP{
@@ -228,6 +275,36 @@ func updateEmailProviderHTTPToConfig(ctx context.Context, req *admin_pb.UpdateEm
 	}
 }
 
+func addEmailProviderAPIToConfig(ctx context.Context, req *admin_pb.AddEmailProviderAPIRequest) *command.AddSMTPConfigAPI {
+	return &command.AddSMTPConfigAPI{
+		ResourceOwner:  authz.GetInstance(ctx).InstanceID(),
+		Description:    req.Description,
+		Provider:       emailAPIProviderToDomain(req.Provider),
+		From:           req.SenderAddress,
+		FromName:       req.SenderName,
+		ReplyToAddress: req.ReplyToAddress,
+		Region:         req.Region,
+		Domain:         req.Domain,
+		AccessKeyID:    req.AccessKeyId,
+		APIKey:         req.ApiKey,
+	}
+}
+
+func updateEmailProviderAPIToConfig(ctx context.Context, req *admin_pb.UpdateEmailProviderAPIRequest) *command.ChangeSMTPConfigAPI {
+	return &command.ChangeSMTPConfigAPI{
+		ResourceOwner:  authz.GetInstance(ctx).InstanceID(),
+		ID:             req.Id,
+		Description:    req.Description,
+		From:           req.SenderAddress,
+		FromName:       req.SenderName,
+		ReplyToAddress: req.ReplyToAddress,
+		Region:         req.Region,
+		Domain:         req.Domain,
+		AccessKeyID:    req.AccessKeyId,
+		APIKey:         req.ApiKey,
+	}
+}
+
 func testEmailProviderSMTPToConfig(req *admin_pb.TestEmailProviderSMTPRequest) *smtp.Config {
 	cfg := &smtp.Config{
 		Tls:      req.Tls,
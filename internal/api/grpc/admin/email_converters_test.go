@@ -354,6 +354,47 @@ func Test_httpToPb(t *testing.T) {
 	}
 }
 
+func Test_emailAPIToPb(t *testing.T) {
+	type args struct {
+		req *query.EmailAPI
+	}
+	tests := []struct {
+		name string
+		args args
+		res  *settings_pb.EmailProvider_Api
+	}{
+		{
+			name: "all fields filled",
+			args: args{
+				req: &query.EmailAPI{
+					Provider:       domain.EmailAPIProviderTypeSES,
+					SenderAddress:  "from",
+					SenderName:     "name",
+					ReplyToAddress: "replyto",
+					Region:         "eu-central-1",
+					AccessKeyID:    "accesskey",
+				},
+			},
+			res: &settings_pb.EmailProvider_Api{
+				Api: &settings_pb.EmailProviderAPI{
+					Provider:       settings_pb.EmailAPIProvider_EMAIL_API_PROVIDER_SES,
+					SenderAddress:  "from",
+					SenderName:     "name",
+					ReplyToAddress: "replyto",
+					Region:         "eu-central-1",
+					AccessKeyId:    "accesskey",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := emailAPIToPb(tt.args.req)
+			assert.Equal(t, tt.res, got)
+		})
+	}
+}
+
 func Test_smtpToPb(t *testing.T) {
 	type args struct {
 		req *query.SMTP
@@ -796,6 +837,98 @@ func Test_updateEmailProviderHTTPToConfig(t *testing.T) {
 	}
 }
 
+func Test_addEmailProviderAPIToConfig(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *admin_pb.AddEmailProviderAPIRequest
+	}
+	tests := []struct {
+		name string
+		args args
+		res  *command.AddSMTPConfigAPI
+	}{
+		{
+			name: "all fields filled",
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instance"),
+				req: &admin_pb.AddEmailProviderAPIRequest{
+					Provider:       settings_pb.EmailAPIProvider_EMAIL_API_PROVIDER_MAILGUN,
+					SenderAddress:  "from",
+					SenderName:     "name",
+					ReplyToAddress: "replyto",
+					Description:    "description",
+					Region:         "eu",
+					Domain:         "mg.example.com",
+					ApiKey:         "key",
+				},
+			},
+			res: &command.AddSMTPConfigAPI{
+				ResourceOwner:  "instance",
+				Description:    "description",
+				Provider:       domain.EmailAPIProviderTypeMailgun,
+				From:           "from",
+				FromName:       "name",
+				ReplyToAddress: "replyto",
+				Region:         "eu",
+				Domain:         "mg.example.com",
+				APIKey:         "key",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := addEmailProviderAPIToConfig(tt.args.ctx, tt.args.req)
+			assert.Equal(t, tt.res, got)
+		})
+	}
+}
+
+func Test_updateEmailProviderAPIToConfig(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *admin_pb.UpdateEmailProviderAPIRequest
+	}
+	tests := []struct {
+		name string
+		args args
+		res  *command.ChangeSMTPConfigAPI
+	}{
+		{
+			name: "all fields filled",
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instance"),
+				req: &admin_pb.UpdateEmailProviderAPIRequest{
+					Id:             "id",
+					SenderAddress:  "from",
+					SenderName:     "name",
+					ReplyToAddress: "replyto",
+					Description:    "description",
+					Region:         "eu-central-1",
+					AccessKeyId:    "accesskey",
+					ApiKey:         "key",
+				},
+			},
+			res: &command.ChangeSMTPConfigAPI{
+				ResourceOwner:  "instance",
+				ID:             "id",
+				Description:    "description",
+				From:           "from",
+				FromName:       "name",
+				ReplyToAddress: "replyto",
+				Region:         "eu-central-1",
+				AccessKeyID:    "accesskey",
+				APIKey:         "key",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := updateEmailProviderAPIToConfig(tt.args.ctx, tt.args.req)
+			assert.Equal(t, tt.res, got)
+		})
+	}
+}
+
 func Test_testEmailProviderSMTPToConfig(t *testing.T) {
 	type args struct {
 		ctx context.Context
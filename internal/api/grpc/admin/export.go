@@ -501,6 +501,8 @@ func (s *Server) getLockoutPolicy(ctx context.Context, orgID string) (_ *managem
 		return &management_pb.AddCustomLockoutPolicyRequest{
 			MaxPasswordAttempts: uint32(queriedLockout.MaxPasswordAttempts),
 			MaxOtpAttempts:      uint32(queriedLockout.MaxOTPAttempts),
+			LockoutDuration:     durationpb.New(time.Duration(queriedLockout.LockoutDuration)),
+			FailedAttemptDelay:  durationpb.New(time.Duration(queriedLockout.FailedAttemptDelay)),
 		}, nil
 	}
 	return nil, nil
@@ -516,11 +518,12 @@ func (s *Server) getPasswordComplexityPolicy(ctx context.Context, orgID string)
 	}
 	if !queriedPasswordComplexity.IsDefault {
 		return &management_pb.AddCustomPasswordComplexityPolicyRequest{
-			MinLength:    queriedPasswordComplexity.MinLength,
-			HasUppercase: queriedPasswordComplexity.HasUppercase,
-			HasLowercase: queriedPasswordComplexity.HasLowercase,
-			HasNumber:    queriedPasswordComplexity.HasNumber,
-			HasSymbol:    queriedPasswordComplexity.HasSymbol,
+			MinLength:     queriedPasswordComplexity.MinLength,
+			HasUppercase:  queriedPasswordComplexity.HasUppercase,
+			HasLowercase:  queriedPasswordComplexity.HasLowercase,
+			HasNumber:     queriedPasswordComplexity.HasNumber,
+			HasSymbol:     queriedPasswordComplexity.HasSymbol,
+			CheckBreached: queriedPasswordComplexity.CheckBreached,
 		}, nil
 	}
 	return nil, nil
@@ -9,5 +9,7 @@ func UpdateLockoutPolicyToDomain(p *admin.UpdateLockoutPolicyRequest) *domain.Lo
 	return &domain.LockoutPolicy{
 		MaxPasswordAttempts: uint64(p.MaxPasswordAttempts),
 		MaxOTPAttempts:      uint64(p.MaxOtpAttempts),
+		LockoutDuration:     p.GetLockoutDuration().AsDuration(),
+		FailedAttemptDelay:  p.GetFailedAttemptDelay().AsDuration(),
 	}
 }
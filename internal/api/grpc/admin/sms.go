@@ -88,6 +88,68 @@ func (s *Server) UpdateSMSProviderHTTP(ctx context.Context, req *admin_pb.Update
 	}, nil
 }
 
+func (s *Server) AddSMSProviderVonage(ctx context.Context, req *admin_pb.AddSMSProviderVonageRequest) (*admin_pb.AddSMSProviderVonageResponse, error) {
+	smsConfig := addSMSConfigVonageToConfig(ctx, req)
+	if err := s.command.AddSMSConfigVonage(ctx, smsConfig); err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddSMSProviderVonageResponse{
+		Details: object.DomainToAddDetailsPb(smsConfig.Details),
+		Id:      smsConfig.ID,
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderVonage(ctx context.Context, req *admin_pb.UpdateSMSProviderVonageRequest) (*admin_pb.UpdateSMSProviderVonageResponse, error) {
+	smsConfig := updateSMSConfigVonageToConfig(ctx, req)
+	if err := s.command.ChangeSMSConfigVonage(ctx, smsConfig); err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderVonageResponse{
+		Details: object.DomainToChangeDetailsPb(smsConfig.Details),
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderVonageSecret(ctx context.Context, req *admin_pb.UpdateSMSProviderVonageSecretRequest) (*admin_pb.UpdateSMSProviderVonageSecretResponse, error) {
+	result, err := s.command.ChangeSMSConfigVonageSecret(ctx, authz.GetInstance(ctx).InstanceID(), req.Id, req.ApiSecret)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderVonageSecretResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
+func (s *Server) AddSMSProviderSNS(ctx context.Context, req *admin_pb.AddSMSProviderSNSRequest) (*admin_pb.AddSMSProviderSNSResponse, error) {
+	smsConfig := addSMSConfigSNSToConfig(ctx, req)
+	if err := s.command.AddSMSConfigSNS(ctx, smsConfig); err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddSMSProviderSNSResponse{
+		Details: object.DomainToAddDetailsPb(smsConfig.Details),
+		Id:      smsConfig.ID,
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderSNS(ctx context.Context, req *admin_pb.UpdateSMSProviderSNSRequest) (*admin_pb.UpdateSMSProviderSNSResponse, error) {
+	smsConfig := updateSMSConfigSNSToConfig(ctx, req)
+	if err := s.command.ChangeSMSConfigSNS(ctx, smsConfig); err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderSNSResponse{
+		Details: object.DomainToChangeDetailsPb(smsConfig.Details),
+	}, nil
+}
+
+func (s *Server) UpdateSMSProviderSNSSecret(ctx context.Context, req *admin_pb.UpdateSMSProviderSNSSecretRequest) (*admin_pb.UpdateSMSProviderSNSSecretResponse, error) {
+	result, err := s.command.ChangeSMSConfigSNSSecret(ctx, authz.GetInstance(ctx).InstanceID(), req.Id, req.SecretAccessKey)
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateSMSProviderSNSSecretResponse{
+		Details: object.DomainToChangeDetailsPb(result),
+	}, nil
+}
+
 func (s *Server) ActivateSMSProvider(ctx context.Context, req *admin_pb.ActivateSMSProviderRequest) (*admin_pb.ActivateSMSProviderResponse, error) {
 	result, err := s.command.ActivateSMSConfig(ctx, authz.GetInstance(ctx).InstanceID(), req.Id)
 	if err != nil {
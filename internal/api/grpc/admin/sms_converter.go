@@ -50,6 +50,12 @@ func SMSConfigToPb(config *query.SMSConfig) settings_pb.SMSConfig {
 	if config.HTTPConfig != nil {
 		return HTTPConfigToPb(config.HTTPConfig)
 	}
+	if config.VonageConfig != nil {
+		return VonageConfigToPb(config.VonageConfig)
+	}
+	if config.SNSConfig != nil {
+		return SNSConfigToPb(config.SNSConfig)
+	}
 	return nil
 }
 
@@ -72,6 +78,25 @@ func TwilioConfigToPb(twilio *query.Twilio) *settings_pb.SMSProvider_Twilio {
 	}
 }
 
+func VonageConfigToPb(vonage *query.Vonage) *settings_pb.SMSProvider_Vonage {
+	return &settings_pb.SMSProvider_Vonage{
+		Vonage: &settings_pb.VonageConfig{
+			ApiKey:       vonage.APIKey,
+			SenderNumber: vonage.SenderNumber,
+		},
+	}
+}
+
+func SNSConfigToPb(sns *query.SNS) *settings_pb.SMSProvider_Sns {
+	return &settings_pb.SMSProvider_Sns{
+		Sns: &settings_pb.SNSConfig{
+			AccessKeyId: sns.AccessKeyID,
+			Region:      sns.Region,
+			SenderId:    sns.SenderID,
+		},
+	}
+}
+
 func smsStateToPb(state domain.SMSConfigState) settings_pb.SMSProviderConfigState {
 	switch state {
 	case domain.SMSConfigStateUnspecified, domain.SMSConfigStateRemoved:
@@ -126,3 +151,45 @@ func updateSMSConfigHTTPToConfig(ctx context.Context, req *admin_pb.UpdateSMSPro
 		ExpirationSigningKey: expirationSigningKey,
 	}
 }
+
+func addSMSConfigVonageToConfig(ctx context.Context, req *admin_pb.AddSMSProviderVonageRequest) *command.AddSMSVonage {
+	return &command.AddSMSVonage{
+		ResourceOwner: authz.GetInstance(ctx).InstanceID(),
+		Description:   req.GetDescription(),
+		APIKey:        req.GetApiKey(),
+		APISecret:     req.GetApiSecret(),
+		SenderNumber:  req.GetSenderNumber(),
+	}
+}
+
+func updateSMSConfigVonageToConfig(ctx context.Context, req *admin_pb.UpdateSMSProviderVonageRequest) *command.ChangeSMSVonage {
+	return &command.ChangeSMSVonage{
+		ResourceOwner: authz.GetInstance(ctx).InstanceID(),
+		ID:            req.Id,
+		Description:   gu.Ptr(req.Description),
+		APIKey:        gu.Ptr(req.ApiKey),
+		SenderNumber:  gu.Ptr(req.SenderNumber),
+	}
+}
+
+func addSMSConfigSNSToConfig(ctx context.Context, req *admin_pb.AddSMSProviderSNSRequest) *command.AddSMSSNS {
+	return &command.AddSMSSNS{
+		ResourceOwner:   authz.GetInstance(ctx).InstanceID(),
+		Description:     req.GetDescription(),
+		AccessKeyID:     req.GetAccessKeyId(),
+		SecretAccessKey: req.GetSecretAccessKey(),
+		Region:          req.GetRegion(),
+		SenderID:        req.GetSenderId(),
+	}
+}
+
+func updateSMSConfigSNSToConfig(ctx context.Context, req *admin_pb.UpdateSMSProviderSNSRequest) *command.ChangeSMSSNS {
+	return &command.ChangeSMSSNS{
+		ResourceOwner: authz.GetInstance(ctx).InstanceID(),
+		ID:            req.Id,
+		Description:   gu.Ptr(req.Description),
+		AccessKeyID:   gu.Ptr(req.AccessKeyId),
+		Region:        gu.Ptr(req.Region),
+		SenderID:      gu.Ptr(req.SenderId),
+	}
+}
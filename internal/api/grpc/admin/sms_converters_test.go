@@ -518,3 +518,149 @@ func Test_updateSMSConfigHTTPToConfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_VonageConfigToPb(t *testing.T) {
+	type args struct {
+		req *query.Vonage
+	}
+	tests := []struct {
+		name string
+		args args
+		res  *settings_pb.SMSProvider_Vonage
+	}{
+		{
+			name: "all fields filled",
+			args: args{
+				req: &query.Vonage{
+					APIKey:       "key",
+					SenderNumber: "sender",
+				},
+			},
+			res: &settings_pb.SMSProvider_Vonage{
+				Vonage: &settings_pb.VonageConfig{
+					ApiKey:       "key",
+					SenderNumber: "sender",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := VonageConfigToPb(tt.args.req)
+			assert.Equal(t, tt.res, got)
+		})
+	}
+}
+
+func Test_SNSConfigToPb(t *testing.T) {
+	type args struct {
+		req *query.SNS
+	}
+	tests := []struct {
+		name string
+		args args
+		res  *settings_pb.SMSProvider_Sns
+	}{
+		{
+			name: "all fields filled",
+			args: args{
+				req: &query.SNS{
+					AccessKeyID: "key",
+					Region:      "eu-central-1",
+					SenderID:    "sender",
+				},
+			},
+			res: &settings_pb.SMSProvider_Sns{
+				Sns: &settings_pb.SNSConfig{
+					AccessKeyId: "key",
+					Region:      "eu-central-1",
+					SenderId:    "sender",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SNSConfigToPb(tt.args.req)
+			assert.Equal(t, tt.res, got)
+		})
+	}
+}
+
+func Test_addSMSConfigVonageToConfig(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *admin_pb.AddSMSProviderVonageRequest
+	}
+	tests := []struct {
+		name string
+		args args
+		res  *command.AddSMSVonage
+	}{
+		{
+			name: "all fields filled",
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instance"),
+				req: &admin_pb.AddSMSProviderVonageRequest{
+					ApiKey:       "key",
+					ApiSecret:    "secret",
+					SenderNumber: "sender",
+					Description:  "description",
+				},
+			},
+			res: &command.AddSMSVonage{
+				ResourceOwner: "instance",
+				Description:   "description",
+				APIKey:        "key",
+				APISecret:     "secret",
+				SenderNumber:  "sender",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := addSMSConfigVonageToConfig(tt.args.ctx, tt.args.req)
+			assert.Equal(t, tt.res, got)
+		})
+	}
+}
+
+func Test_updateSMSConfigSNSToConfig(t *testing.T) {
+	type args struct {
+		ctx context.Context
+		req *admin_pb.UpdateSMSProviderSNSRequest
+	}
+	tests := []struct {
+		name string
+		args args
+		res  *command.ChangeSMSSNS
+	}{
+		{
+			name: "all fields filled",
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instance"),
+				req: &admin_pb.UpdateSMSProviderSNSRequest{
+					Id:          "id",
+					AccessKeyId: "key",
+					Region:      "eu-central-1",
+					SenderId:    "sender",
+					Description: "description",
+				},
+			},
+			res: &command.ChangeSMSSNS{
+				ResourceOwner: "instance",
+				ID:            "id",
+				Description:   gu.Ptr("description"),
+				AccessKeyID:   gu.Ptr("key"),
+				Region:        gu.Ptr("eu-central-1"),
+				SenderID:      gu.Ptr("sender"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := updateSMSConfigSNSToConfig(tt.args.ctx, tt.args.req)
+			assert.Equal(t, tt.res, got)
+		})
+	}
+}
@@ -109,6 +109,8 @@ func oidcGrantTypesToDomain(grantTypes []app.OIDCGrantType) []domain.OIDCGrantTy
 			oidcGrantTypes[i] = domain.OIDCGrantTypeDeviceCode
 		case app.OIDCGrantType_OIDC_GRANT_TYPE_TOKEN_EXCHANGE:
 			oidcGrantTypes[i] = domain.OIDCGrantTypeTokenExchange
+		case app.OIDCGrantType_OIDC_GRANT_TYPE_CIBA:
+			oidcGrantTypes[i] = domain.OIDCGrantTypeCIBA
 		}
 	}
 	return oidcGrantTypes
@@ -247,6 +249,8 @@ func oidcGrantTypesFromModel(grantTypes []domain.OIDCGrantType) []app.OIDCGrantT
 			oidcGrantTypes[i] = app.OIDCGrantType_OIDC_GRANT_TYPE_DEVICE_CODE
 		case domain.OIDCGrantTypeTokenExchange:
 			oidcGrantTypes[i] = app.OIDCGrantType_OIDC_GRANT_TYPE_TOKEN_EXCHANGE
+		case domain.OIDCGrantTypeCIBA:
+			oidcGrantTypes[i] = app.OIDCGrantType_OIDC_GRANT_TYPE_CIBA
 		}
 	}
 	return oidcGrantTypes
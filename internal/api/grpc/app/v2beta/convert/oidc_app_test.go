@@ -271,6 +271,7 @@ func TestOIDCGrantTypesToDomain(t *testing.T) {
 				app.OIDCGrantType_OIDC_GRANT_TYPE_REFRESH_TOKEN,
 				app.OIDCGrantType_OIDC_GRANT_TYPE_DEVICE_CODE,
 				app.OIDCGrantType_OIDC_GRANT_TYPE_TOKEN_EXCHANGE,
+				app.OIDCGrantType_OIDC_GRANT_TYPE_CIBA,
 			},
 			expectedGrants: []domain.OIDCGrantType{
 				domain.OIDCGrantTypeAuthorizationCode,
@@ -278,6 +279,7 @@ func TestOIDCGrantTypesToDomain(t *testing.T) {
 				domain.OIDCGrantTypeRefreshToken,
 				domain.OIDCGrantTypeDeviceCode,
 				domain.OIDCGrantTypeTokenExchange,
+				domain.OIDCGrantTypeCIBA,
 			},
 		},
 		{
@@ -596,6 +598,7 @@ func TestOIDCGrantTypesFromModel(t *testing.T) {
 				domain.OIDCGrantTypeRefreshToken,
 				domain.OIDCGrantTypeDeviceCode,
 				domain.OIDCGrantTypeTokenExchange,
+				domain.OIDCGrantTypeCIBA,
 			},
 			expected: []app.OIDCGrantType{
 				app.OIDCGrantType_OIDC_GRANT_TYPE_AUTHORIZATION_CODE,
@@ -603,6 +606,7 @@ func TestOIDCGrantTypesFromModel(t *testing.T) {
 				app.OIDCGrantType_OIDC_GRANT_TYPE_REFRESH_TOKEN,
 				app.OIDCGrantType_OIDC_GRANT_TYPE_DEVICE_CODE,
 				app.OIDCGrantType_OIDC_GRANT_TYPE_TOKEN_EXCHANGE,
+				app.OIDCGrantType_OIDC_GRANT_TYPE_CIBA,
 			},
 		},
 		{
@@ -12,9 +12,11 @@ func CreateAPIApplicationRequestToDomain(name, projectID, appID string, app *app
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: projectID,
 		},
-		AppName:        name,
-		AppID:          appID,
-		AuthMethodType: apiAuthMethodTypeToDomain(app.GetAuthMethodType()),
+		AppName:                name,
+		AppID:                  appID,
+		AuthMethodType:         apiAuthMethodTypeToDomain(app.GetAuthMethodType()),
+		TLSClientAuthSubjectDN: app.GetTlsClientAuthSubjectDn(),
+		TLSClientAuthSAN:       app.GetTlsClientAuthSan(),
 	}
 }
 
@@ -23,16 +25,20 @@ func UpdateAPIApplicationConfigurationRequestToDomain(appID, projectID string, a
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: projectID,
 		},
-		AppID:          appID,
-		AuthMethodType: apiAuthMethodTypeToDomain(app.GetAuthMethodType()),
+		AppID:                  appID,
+		AuthMethodType:         apiAuthMethodTypeToDomain(app.GetAuthMethodType()),
+		TLSClientAuthSubjectDN: app.GetTlsClientAuthSubjectDn(),
+		TLSClientAuthSAN:       app.GetTlsClientAuthSan(),
 	}
 }
 
 func appAPIConfigToPb(apiApp *query.APIApp) application.IsApplicationConfiguration {
 	return &application.Application_ApiConfiguration{
 		ApiConfiguration: &application.APIConfiguration{
-			ClientId:       apiApp.ClientID,
-			AuthMethodType: apiAuthMethodTypeToPb(apiApp.AuthMethodType),
+			ClientId:               apiApp.ClientID,
+			AuthMethodType:         apiAuthMethodTypeToPb(apiApp.AuthMethodType),
+			TlsClientAuthSubjectDn: apiApp.TLSClientAuthSubjectDN,
+			TlsClientAuthSan:       apiApp.TLSClientAuthSAN,
 		},
 	}
 }
@@ -43,6 +49,10 @@ func apiAuthMethodTypeToDomain(authType application.APIAuthMethodType) domain.AP
 		return domain.APIAuthMethodTypeBasic
 	case application.APIAuthMethodType_API_AUTH_METHOD_TYPE_PRIVATE_KEY_JWT:
 		return domain.APIAuthMethodTypePrivateKeyJWT
+	case application.APIAuthMethodType_API_AUTH_METHOD_TYPE_TLS_CLIENT_AUTH:
+		return domain.APIAuthMethodTypeTLSClientAuth
+	case application.APIAuthMethodType_API_AUTH_METHOD_TYPE_SELF_SIGNED_TLS_CLIENT_AUTH:
+		return domain.APIAuthMethodTypeSelfSignedTLSClientAuth
 	default:
 		return domain.APIAuthMethodTypeBasic
 	}
@@ -54,6 +64,10 @@ func apiAuthMethodTypeToPb(methodType domain.APIAuthMethodType) application.APIA
 		return application.APIAuthMethodType_API_AUTH_METHOD_TYPE_BASIC
 	case domain.APIAuthMethodTypePrivateKeyJWT:
 		return application.APIAuthMethodType_API_AUTH_METHOD_TYPE_PRIVATE_KEY_JWT
+	case domain.APIAuthMethodTypeTLSClientAuth:
+		return application.APIAuthMethodType_API_AUTH_METHOD_TYPE_TLS_CLIENT_AUTH
+	case domain.APIAuthMethodTypeSelfSignedTLSClientAuth:
+		return application.APIAuthMethodType_API_AUTH_METHOD_TYPE_SELF_SIGNED_TLS_CLIENT_AUTH
 	default:
 		return application.APIAuthMethodType_API_AUTH_METHOD_TYPE_BASIC
 	}
@@ -98,6 +98,21 @@ func TestUpdateAPIApplicationConfigurationRequestToDomain(t *testing.T) {
 				AuthMethodType: domain.APIAuthMethodTypePrivateKeyJWT,
 			},
 		},
+		{
+			name:      "tls client auth",
+			appID:     "application-3",
+			projectID: "proj-3",
+			req: &application.UpdateAPIApplicationConfigurationRequest{
+				AuthMethodType:         application.APIAuthMethodType_API_AUTH_METHOD_TYPE_TLS_CLIENT_AUTH,
+				TlsClientAuthSubjectDn: "CN=client,O=ZITADEL",
+			},
+			want: &domain.APIApp{
+				ObjectRoot:             models.ObjectRoot{AggregateID: "proj-3"},
+				AppID:                  "application-3",
+				AuthMethodType:         domain.APIAuthMethodTypeTLSClientAuth,
+				TLSClientAuthSubjectDN: "CN=client,O=ZITADEL",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -130,6 +145,11 @@ func Test_apiAuthMethodTypeToPb(t *testing.T) {
 			methodType:     domain.APIAuthMethodTypePrivateKeyJWT,
 			expectedResult: application.APIAuthMethodType_API_AUTH_METHOD_TYPE_PRIVATE_KEY_JWT,
 		},
+		{
+			name:           "self signed tls client auth",
+			methodType:     domain.APIAuthMethodTypeSelfSignedTLSClientAuth,
+			expectedResult: application.APIAuthMethodType_API_AUTH_METHOD_TYPE_SELF_SIGNED_TLS_CLIENT_AUTH,
+		},
 		{
 			name:           "unknown auth method defaults to basic",
 			expectedResult: application.APIAuthMethodType_API_AUTH_METHOD_TYPE_BASIC,
@@ -21,30 +21,36 @@ func CreateOIDCAppRequestToDomain(name, appID, projectID string, req *applicatio
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: projectID,
 		},
-		AppID:                         appID,
-		AppName:                       name,
-		OIDCVersion:                   gu.Ptr(domain.OIDCVersionV1),
-		RedirectUris:                  req.GetRedirectUris(),
-		ResponseTypes:                 oidcResponseTypesToDomain(req.GetResponseTypes()),
-		GrantTypes:                    oidcGrantTypesToDomain(req.GetGrantTypes()),
-		ApplicationType:               gu.Ptr(oidcApplicationTypeToDomain(req.GetApplicationType())),
-		AuthMethodType:                gu.Ptr(oidcAuthMethodTypeToDomain(req.GetAuthMethodType())),
-		PostLogoutRedirectUris:        req.GetPostLogoutRedirectUris(),
-		DevMode:                       &req.DevelopmentMode,
-		AccessTokenType:               gu.Ptr(oidcTokenTypeToDomain(req.GetAccessTokenType())),
-		AccessTokenRoleAssertion:      gu.Ptr(req.GetAccessTokenRoleAssertion()),
-		IDTokenRoleAssertion:          gu.Ptr(req.GetIdTokenRoleAssertion()),
-		IDTokenUserinfoAssertion:      gu.Ptr(req.GetIdTokenUserinfoAssertion()),
-		ClockSkew:                     gu.Ptr(req.GetClockSkew().AsDuration()),
-		AdditionalOrigins:             req.GetAdditionalOrigins(),
-		SkipNativeAppSuccessPage:      gu.Ptr(req.GetSkipNativeAppSuccessPage()),
-		BackChannelLogoutURI:          gu.Ptr(req.GetBackChannelLogoutUri()),
-		LoginVersion:                  loginVersion,
-		LoginBaseURI:                  loginBaseURI,
-		IOSTeamID:                     iosTeamID,
-		IOSBundleID:                   iosBundleID,
-		AndroidPackageName:            androidPackageName,
-		AndroidSHA256CertFingerprints: androidFingerprints,
+		AppID:                            appID,
+		AppName:                          name,
+		OIDCVersion:                      gu.Ptr(domain.OIDCVersionV1),
+		RedirectUris:                     req.GetRedirectUris(),
+		ResponseTypes:                    oidcResponseTypesToDomain(req.GetResponseTypes()),
+		GrantTypes:                       oidcGrantTypesToDomain(req.GetGrantTypes()),
+		ApplicationType:                  gu.Ptr(oidcApplicationTypeToDomain(req.GetApplicationType())),
+		AuthMethodType:                   gu.Ptr(oidcAuthMethodTypeToDomain(req.GetAuthMethodType())),
+		PostLogoutRedirectUris:           req.GetPostLogoutRedirectUris(),
+		DevMode:                          &req.DevelopmentMode,
+		AccessTokenType:                  gu.Ptr(oidcTokenTypeToDomain(req.GetAccessTokenType())),
+		AccessTokenRoleAssertion:         gu.Ptr(req.GetAccessTokenRoleAssertion()),
+		IDTokenRoleAssertion:             gu.Ptr(req.GetIdTokenRoleAssertion()),
+		IDTokenUserinfoAssertion:         gu.Ptr(req.GetIdTokenUserinfoAssertion()),
+		ClockSkew:                        gu.Ptr(req.GetClockSkew().AsDuration()),
+		AdditionalOrigins:                req.GetAdditionalOrigins(),
+		SkipNativeAppSuccessPage:         gu.Ptr(req.GetSkipNativeAppSuccessPage()),
+		BackChannelLogoutURI:             gu.Ptr(req.GetBackChannelLogoutUri()),
+		LoginVersion:                     loginVersion,
+		LoginBaseURI:                     loginBaseURI,
+		IOSTeamID:                        iosTeamID,
+		IOSBundleID:                      iosBundleID,
+		AndroidPackageName:               androidPackageName,
+		AndroidSHA256CertFingerprints:    androidFingerprints,
+		DPoPRequired:                     gu.Ptr(req.GetDpopRequired()),
+		PARRequired:                      gu.Ptr(req.GetParRequired()),
+		TLSClientAuthSubjectDN:           gu.Ptr(req.GetTlsClientAuthSubjectDn()),
+		TLSClientAuthSAN:                 gu.Ptr(req.GetTlsClientAuthSan()),
+		BackChannelClientNotificationURI: gu.Ptr(req.GetBackChannelClientNotificationUri()),
+		ConsentRequired:                  gu.Ptr(req.GetConsentRequired()),
 	}, nil
 }
 
@@ -60,28 +66,34 @@ func UpdateOIDCAppConfigRequestToDomain(appID, projectID string, app *applicatio
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: projectID,
 		},
-		AppID:                         appID,
-		RedirectUris:                  app.RedirectUris,
-		ResponseTypes:                 oidcResponseTypesToDomain(app.ResponseTypes),
-		GrantTypes:                    oidcGrantTypesToDomain(app.GrantTypes),
-		ApplicationType:               oidcApplicationTypeToDomainPtr(app.ApplicationType),
-		AuthMethodType:                oidcAuthMethodTypeToDomainPtr(app.AuthMethodType),
-		PostLogoutRedirectUris:        app.PostLogoutRedirectUris,
-		DevMode:                       app.DevelopmentMode,
-		AccessTokenType:               oidcTokenTypeToDomainPtr(app.AccessTokenType),
-		AccessTokenRoleAssertion:      app.AccessTokenRoleAssertion,
-		IDTokenRoleAssertion:          app.IdTokenRoleAssertion,
-		IDTokenUserinfoAssertion:      app.IdTokenUserinfoAssertion,
-		ClockSkew:                     gu.Ptr(app.GetClockSkew().AsDuration()),
-		AdditionalOrigins:             app.AdditionalOrigins,
-		SkipNativeAppSuccessPage:      app.SkipNativeAppSuccessPage,
-		BackChannelLogoutURI:          app.BackChannelLogoutUri,
-		LoginVersion:                  loginVersion,
-		LoginBaseURI:                  loginBaseURI,
-		IOSTeamID:                     iosTeamID,
-		IOSBundleID:                   iosBundleID,
-		AndroidPackageName:            androidPackageName,
-		AndroidSHA256CertFingerprints: androidFingerprints,
+		AppID:                            appID,
+		RedirectUris:                     app.RedirectUris,
+		ResponseTypes:                    oidcResponseTypesToDomain(app.ResponseTypes),
+		GrantTypes:                       oidcGrantTypesToDomain(app.GrantTypes),
+		ApplicationType:                  oidcApplicationTypeToDomainPtr(app.ApplicationType),
+		AuthMethodType:                   oidcAuthMethodTypeToDomainPtr(app.AuthMethodType),
+		PostLogoutRedirectUris:           app.PostLogoutRedirectUris,
+		DevMode:                          app.DevelopmentMode,
+		AccessTokenType:                  oidcTokenTypeToDomainPtr(app.AccessTokenType),
+		AccessTokenRoleAssertion:         app.AccessTokenRoleAssertion,
+		IDTokenRoleAssertion:             app.IdTokenRoleAssertion,
+		IDTokenUserinfoAssertion:         app.IdTokenUserinfoAssertion,
+		ClockSkew:                        gu.Ptr(app.GetClockSkew().AsDuration()),
+		AdditionalOrigins:                app.AdditionalOrigins,
+		SkipNativeAppSuccessPage:         app.SkipNativeAppSuccessPage,
+		BackChannelLogoutURI:             app.BackChannelLogoutUri,
+		LoginVersion:                     loginVersion,
+		LoginBaseURI:                     loginBaseURI,
+		IOSTeamID:                        iosTeamID,
+		IOSBundleID:                      iosBundleID,
+		AndroidPackageName:               androidPackageName,
+		AndroidSHA256CertFingerprints:    androidFingerprints,
+		DPoPRequired:                     app.DpopRequired,
+		PARRequired:                      app.ParRequired,
+		TLSClientAuthSubjectDN:           app.TlsClientAuthSubjectDn,
+		TLSClientAuthSAN:                 app.TlsClientAuthSan,
+		BackChannelClientNotificationURI: app.BackChannelClientNotificationUri,
+		ConsentRequired:                  app.ConsentRequired,
 	}, nil
 }
 
@@ -122,6 +134,8 @@ func oidcGrantTypesToDomain(grantTypes []application.OIDCGrantType) []domain.OID
 			oidcGrantTypes[i] = domain.OIDCGrantTypeDeviceCode
 		case application.OIDCGrantType_OIDC_GRANT_TYPE_TOKEN_EXCHANGE:
 			oidcGrantTypes[i] = domain.OIDCGrantTypeTokenExchange
+		case application.OIDCGrantType_OIDC_GRANT_TYPE_CIBA:
+			oidcGrantTypes[i] = domain.OIDCGrantTypeCIBA
 		}
 	}
 	return oidcGrantTypes
@@ -168,6 +182,10 @@ func oidcAuthMethodTypeToDomain(authType application.OIDCAuthMethodType) domain.
 		return domain.OIDCAuthMethodTypeNone
 	case application.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_PRIVATE_KEY_JWT:
 		return domain.OIDCAuthMethodTypePrivateKeyJWT
+	case application.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_TLS_CLIENT_AUTH:
+		return domain.OIDCAuthMethodTypeTLSClientAuth
+	case application.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_SELF_SIGNED_TLS_CLIENT_AUTH:
+		return domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth
 	default:
 		return domain.OIDCAuthMethodTypeBasic
 	}
@@ -205,29 +223,35 @@ func ComplianceProblemsToLocalizedMessages(complianceProblems []string) []*appli
 func appOIDCConfigToPb(oidcApp *query.OIDCApp) *application.Application_OidcConfiguration {
 	return &application.Application_OidcConfiguration{
 		OidcConfiguration: &application.OIDCConfiguration{
-			RedirectUris:             oidcApp.RedirectURIs,
-			ResponseTypes:            oidcResponseTypesFromModel(oidcApp.ResponseTypes),
-			GrantTypes:               oidcGrantTypesFromModel(oidcApp.GrantTypes),
-			ApplicationType:          oidcApplicationTypeToPb(oidcApp.AppType),
-			ClientId:                 oidcApp.ClientID,
-			AuthMethodType:           oidcAuthMethodTypeToPb(oidcApp.AuthMethodType),
-			PostLogoutRedirectUris:   oidcApp.PostLogoutRedirectURIs,
-			Version:                  application.OIDCVersion_OIDC_VERSION_1_0,
-			NonCompliant:             len(oidcApp.ComplianceProblems) != 0,
-			ComplianceProblems:       ComplianceProblemsToLocalizedMessages(oidcApp.ComplianceProblems),
-			DevelopmentMode:          oidcApp.IsDevMode,
-			AccessTokenType:          oidcTokenTypeToPb(oidcApp.AccessTokenType),
-			AccessTokenRoleAssertion: oidcApp.AssertAccessTokenRole,
-			IdTokenRoleAssertion:     oidcApp.AssertIDTokenRole,
-			IdTokenUserinfoAssertion: oidcApp.AssertIDTokenUserinfo,
-			ClockSkew:                durationpb.New(oidcApp.ClockSkew),
-			AdditionalOrigins:        oidcApp.AdditionalOrigins,
-			AllowedOrigins:           oidcApp.AllowedOrigins,
-			SkipNativeAppSuccessPage: oidcApp.SkipNativeAppSuccessPage,
-			BackChannelLogoutUri:     oidcApp.BackChannelLogoutURI,
-			LoginVersion:             loginVersionToPb(oidcApp.LoginVersion, oidcApp.LoginBaseURI),
-			Ios:                      iosAppLinkConfigToPb(oidcApp.IOSTeamID, oidcApp.IOSBundleID),
-			Android:                  androidAppLinkConfigToPb(oidcApp.AndroidPackageName, oidcApp.AndroidSHA256CertFingerprints),
+			RedirectUris:                     oidcApp.RedirectURIs,
+			ResponseTypes:                    oidcResponseTypesFromModel(oidcApp.ResponseTypes),
+			GrantTypes:                       oidcGrantTypesFromModel(oidcApp.GrantTypes),
+			ApplicationType:                  oidcApplicationTypeToPb(oidcApp.AppType),
+			ClientId:                         oidcApp.ClientID,
+			AuthMethodType:                   oidcAuthMethodTypeToPb(oidcApp.AuthMethodType),
+			PostLogoutRedirectUris:           oidcApp.PostLogoutRedirectURIs,
+			Version:                          application.OIDCVersion_OIDC_VERSION_1_0,
+			NonCompliant:                     len(oidcApp.ComplianceProblems) != 0,
+			ComplianceProblems:               ComplianceProblemsToLocalizedMessages(oidcApp.ComplianceProblems),
+			DevelopmentMode:                  oidcApp.IsDevMode,
+			AccessTokenType:                  oidcTokenTypeToPb(oidcApp.AccessTokenType),
+			AccessTokenRoleAssertion:         oidcApp.AssertAccessTokenRole,
+			IdTokenRoleAssertion:             oidcApp.AssertIDTokenRole,
+			IdTokenUserinfoAssertion:         oidcApp.AssertIDTokenUserinfo,
+			ClockSkew:                        durationpb.New(oidcApp.ClockSkew),
+			AdditionalOrigins:                oidcApp.AdditionalOrigins,
+			AllowedOrigins:                   oidcApp.AllowedOrigins,
+			SkipNativeAppSuccessPage:         oidcApp.SkipNativeAppSuccessPage,
+			BackChannelLogoutUri:             oidcApp.BackChannelLogoutURI,
+			LoginVersion:                     loginVersionToPb(oidcApp.LoginVersion, oidcApp.LoginBaseURI),
+			Ios:                              iosAppLinkConfigToPb(oidcApp.IOSTeamID, oidcApp.IOSBundleID),
+			Android:                          androidAppLinkConfigToPb(oidcApp.AndroidPackageName, oidcApp.AndroidSHA256CertFingerprints),
+			DpopRequired:                     oidcApp.DPoPRequired,
+			ParRequired:                      oidcApp.PARRequired,
+			TlsClientAuthSubjectDn:           oidcApp.TLSClientAuthSubjectDN,
+			TlsClientAuthSan:                 oidcApp.TLSClientAuthSAN,
+			BackChannelClientNotificationUri: oidcApp.BackChannelClientNotificationURI,
+			ConsentRequired:                  oidcApp.ConsentRequired,
 		},
 	}
 }
@@ -301,6 +325,8 @@ func oidcGrantTypesFromModel(grantTypes []domain.OIDCGrantType) []application.OI
 			oidcGrantTypes[i] = application.OIDCGrantType_OIDC_GRANT_TYPE_DEVICE_CODE
 		case domain.OIDCGrantTypeTokenExchange:
 			oidcGrantTypes[i] = application.OIDCGrantType_OIDC_GRANT_TYPE_TOKEN_EXCHANGE
+		case domain.OIDCGrantTypeCIBA:
+			oidcGrantTypes[i] = application.OIDCGrantType_OIDC_GRANT_TYPE_CIBA
 		}
 	}
 	return oidcGrantTypes
@@ -329,6 +355,10 @@ func oidcAuthMethodTypeToPb(authType domain.OIDCAuthMethodType) application.OIDC
 		return application.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_NONE
 	case domain.OIDCAuthMethodTypePrivateKeyJWT:
 		return application.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_PRIVATE_KEY_JWT
+	case domain.OIDCAuthMethodTypeTLSClientAuth:
+		return application.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_TLS_CLIENT_AUTH
+	case domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth:
+		return application.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_SELF_SIGNED_TLS_CLIENT_AUTH
 	default:
 		return application.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_BASIC
 	}
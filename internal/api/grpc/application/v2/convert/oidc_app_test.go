@@ -74,33 +74,45 @@ func TestCreateOIDCAppRequestToDomain(t *testing.T) {
 					PackageName:            "com.example.app",
 					Sha256CertFingerprints: []string{"AA:BB:CC"},
 				},
+				DpopRequired:                     true,
+				ParRequired:                      true,
+				TlsClientAuthSubjectDn:           "CN=client,O=ZITADEL",
+				TlsClientAuthSan:                 "client.example.com",
+				BackChannelClientNotificationUri: "https://ciba",
+				ConsentRequired:                  true,
 			},
 			expectedModel: &domain.OIDCApp{
-				ObjectRoot:                    models.ObjectRoot{AggregateID: "project1"},
-				AppName:                       "all fields set",
-				AppID:                         "app1",
-				OIDCVersion:                   gu.Ptr(domain.OIDCVersionV1),
-				RedirectUris:                  []string{"https://redirect"},
-				ResponseTypes:                 []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
-				GrantTypes:                    []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
-				ApplicationType:               gu.Ptr(domain.OIDCApplicationTypeWeb),
-				AuthMethodType:                gu.Ptr(domain.OIDCAuthMethodTypeBasic),
-				PostLogoutRedirectUris:        []string{"https://logout"},
-				DevMode:                       gu.Ptr(true),
-				AccessTokenType:               gu.Ptr(domain.OIDCTokenTypeBearer),
-				AccessTokenRoleAssertion:      gu.Ptr(true),
-				IDTokenRoleAssertion:          gu.Ptr(true),
-				IDTokenUserinfoAssertion:      gu.Ptr(true),
-				ClockSkew:                     gu.Ptr(5 * time.Second),
-				AdditionalOrigins:             []string{"https://origin"},
-				SkipNativeAppSuccessPage:      gu.Ptr(true),
-				BackChannelLogoutURI:          gu.Ptr("https://backchannel"),
-				LoginVersion:                  gu.Ptr(domain.LoginVersion2),
-				LoginBaseURI:                  gu.Ptr("https://login"),
-				IOSTeamID:                     gu.Ptr("TEAMID"),
-				IOSBundleID:                   gu.Ptr("com.example.app"),
-				AndroidPackageName:            gu.Ptr("com.example.app"),
-				AndroidSHA256CertFingerprints: []string{"AA:BB:CC"},
+				ObjectRoot:                       models.ObjectRoot{AggregateID: "project1"},
+				AppName:                          "all fields set",
+				AppID:                            "app1",
+				OIDCVersion:                      gu.Ptr(domain.OIDCVersionV1),
+				RedirectUris:                     []string{"https://redirect"},
+				ResponseTypes:                    []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+				GrantTypes:                       []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+				ApplicationType:                  gu.Ptr(domain.OIDCApplicationTypeWeb),
+				AuthMethodType:                   gu.Ptr(domain.OIDCAuthMethodTypeBasic),
+				PostLogoutRedirectUris:           []string{"https://logout"},
+				DevMode:                          gu.Ptr(true),
+				AccessTokenType:                  gu.Ptr(domain.OIDCTokenTypeBearer),
+				AccessTokenRoleAssertion:         gu.Ptr(true),
+				IDTokenRoleAssertion:             gu.Ptr(true),
+				IDTokenUserinfoAssertion:         gu.Ptr(true),
+				ClockSkew:                        gu.Ptr(5 * time.Second),
+				AdditionalOrigins:                []string{"https://origin"},
+				SkipNativeAppSuccessPage:         gu.Ptr(true),
+				BackChannelLogoutURI:             gu.Ptr("https://backchannel"),
+				LoginVersion:                     gu.Ptr(domain.LoginVersion2),
+				LoginBaseURI:                     gu.Ptr("https://login"),
+				IOSTeamID:                        gu.Ptr("TEAMID"),
+				IOSBundleID:                      gu.Ptr("com.example.app"),
+				AndroidPackageName:               gu.Ptr("com.example.app"),
+				AndroidSHA256CertFingerprints:    []string{"AA:BB:CC"},
+				DPoPRequired:                     gu.Ptr(true),
+				PARRequired:                      gu.Ptr(true),
+				TLSClientAuthSubjectDN:           gu.Ptr("CN=client,O=ZITADEL"),
+				TLSClientAuthSAN:                 gu.Ptr("client.example.com"),
+				BackChannelClientNotificationURI: gu.Ptr("https://ciba"),
+				ConsentRequired:                  gu.Ptr(true),
 			},
 		},
 	}
@@ -348,6 +360,7 @@ func TestOIDCGrantTypesToDomain(t *testing.T) {
 				application.OIDCGrantType_OIDC_GRANT_TYPE_REFRESH_TOKEN,
 				application.OIDCGrantType_OIDC_GRANT_TYPE_DEVICE_CODE,
 				application.OIDCGrantType_OIDC_GRANT_TYPE_TOKEN_EXCHANGE,
+				application.OIDCGrantType_OIDC_GRANT_TYPE_CIBA,
 			},
 			expectedGrants: []domain.OIDCGrantType{
 				domain.OIDCGrantTypeAuthorizationCode,
@@ -355,6 +368,7 @@ func TestOIDCGrantTypesToDomain(t *testing.T) {
 				domain.OIDCGrantTypeRefreshToken,
 				domain.OIDCGrantTypeDeviceCode,
 				domain.OIDCGrantTypeTokenExchange,
+				domain.OIDCGrantTypeCIBA,
 			},
 		},
 		{
@@ -451,6 +465,16 @@ func TestOIDCAuthMethodTypeToDomain(t *testing.T) {
 			authType:         application.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_PRIVATE_KEY_JWT,
 			expectedResponse: domain.OIDCAuthMethodTypePrivateKeyJWT,
 		},
+		{
+			name:             "tls client auth type",
+			authType:         application.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_TLS_CLIENT_AUTH,
+			expectedResponse: domain.OIDCAuthMethodTypeTLSClientAuth,
+		},
+		{
+			name:             "self signed tls client auth type",
+			authType:         application.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_SELF_SIGNED_TLS_CLIENT_AUTH,
+			expectedResponse: domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth,
+		},
 		{
 			name:             "unspecified auth type defaults to basic",
 			expectedResponse: domain.OIDCAuthMethodTypeBasic,
@@ -685,6 +709,7 @@ func TestOIDCGrantTypesFromModel(t *testing.T) {
 				domain.OIDCGrantTypeRefreshToken,
 				domain.OIDCGrantTypeDeviceCode,
 				domain.OIDCGrantTypeTokenExchange,
+				domain.OIDCGrantTypeCIBA,
 			},
 			expected: []application.OIDCGrantType{
 				application.OIDCGrantType_OIDC_GRANT_TYPE_AUTHORIZATION_CODE,
@@ -692,6 +717,7 @@ func TestOIDCGrantTypesFromModel(t *testing.T) {
 				application.OIDCGrantType_OIDC_GRANT_TYPE_REFRESH_TOKEN,
 				application.OIDCGrantType_OIDC_GRANT_TYPE_DEVICE_CODE,
 				application.OIDCGrantType_OIDC_GRANT_TYPE_TOKEN_EXCHANGE,
+				application.OIDCGrantType_OIDC_GRANT_TYPE_CIBA,
 			},
 		},
 		{
@@ -789,6 +815,16 @@ func TestOIDCAuthMethodTypeToPb(t *testing.T) {
 			authType: domain.OIDCAuthMethodTypePrivateKeyJWT,
 			expected: application.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_PRIVATE_KEY_JWT,
 		},
+		{
+			name:     "tls client auth type",
+			authType: domain.OIDCAuthMethodTypeTLSClientAuth,
+			expected: application.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_TLS_CLIENT_AUTH,
+		},
+		{
+			name:     "self signed tls client auth type",
+			authType: domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth,
+			expected: application.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_SELF_SIGNED_TLS_CLIENT_AUTH,
+		},
 		{
 			name:     "unknown auth type defaults to basic",
 			authType: domain.OIDCAuthMethodType(999),
@@ -0,0 +1,148 @@
+package convert
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/filter/v2"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/config/systemdefaults"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	"github.com/zitadel/zitadel/pkg/grpc/application/v2"
+)
+
+func CreateProvisioningTargetRequestToCommand(req *application.CreateProvisioningTargetRequest) *command.AddProvisioningTarget {
+	return &command.AddProvisioningTarget{
+		Name:      strings.TrimSpace(req.GetName()),
+		ProjectID: strings.TrimSpace(req.GetProjectId()),
+		AppID:     strings.TrimSpace(req.GetApplicationId()),
+		Endpoint:  strings.TrimSpace(req.GetEndpoint()),
+		Token:     req.GetToken(),
+	}
+}
+
+func UpdateProvisioningTargetRequestToCommand(req *application.UpdateProvisioningTargetRequest) *command.ChangeProvisioningTarget {
+	return &command.ChangeProvisioningTarget{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID: strings.TrimSpace(req.GetProvisioningTargetId()),
+		},
+		Name:     req.Name,
+		Endpoint: req.Endpoint,
+		Token:    req.Token,
+	}
+}
+
+func ProvisioningTargetToPb(target *query.ProvisioningTarget) *application.ProvisioningTarget {
+	if target == nil {
+		return &application.ProvisioningTarget{}
+	}
+
+	return &application.ProvisioningTarget{
+		ProvisioningTargetId: target.ID,
+		CreationDate:         timestamppb.New(target.CreationDate),
+		ChangeDate:           timestamppb.New(target.EventDate),
+		Name:                 target.Name,
+		ProjectId:            target.ProjectID,
+		ApplicationId:        target.AppID,
+		Endpoint:             target.Endpoint,
+		SyncStatus:           provisioningStatusToPb(target.SyncStatus),
+		SyncDate:             syncDateToPb(target),
+		LastError:            target.LastError,
+		LastErrorUserId:      target.LastErrorUserID,
+	}
+}
+
+func syncDateToPb(target *query.ProvisioningTarget) *timestamppb.Timestamp {
+	if target.SyncDate.IsZero() {
+		return nil
+	}
+	return timestamppb.New(target.SyncDate)
+}
+
+func ProvisioningTargetsToPb(targets []*query.ProvisioningTarget) []*application.ProvisioningTarget {
+	pbTargets := make([]*application.ProvisioningTarget, len(targets))
+
+	for i, target := range targets {
+		pbTargets[i] = ProvisioningTargetToPb(target)
+	}
+
+	return pbTargets
+}
+
+func provisioningStatusToPb(status domain.ProvisioningStatus) application.ProvisioningTargetSyncStatus {
+	switch status {
+	case domain.ProvisioningStatusSynced:
+		return application.ProvisioningTargetSyncStatus_PROVISIONING_TARGET_SYNC_STATUS_SYNCED
+	case domain.ProvisioningStatusFailed:
+		return application.ProvisioningTargetSyncStatus_PROVISIONING_TARGET_SYNC_STATUS_FAILED
+	case domain.ProvisioningStatusUnspecified:
+		fallthrough
+	default:
+		return application.ProvisioningTargetSyncStatus_PROVISIONING_TARGET_SYNC_STATUS_UNSPECIFIED
+	}
+}
+
+func ListProvisioningTargetsRequestToModel(sysDefaults systemdefaults.SystemDefaults, req *application.ListProvisioningTargetsRequest) (*query.ProvisioningTargetSearchQueries, error) {
+	offset, limit, asc, err := filter.PaginationPbToQuery(sysDefaults, req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+
+	queries, err := provisioningTargetQueriesToModel(req.GetFilters())
+	if err != nil {
+		return nil, err
+	}
+	return &query.ProvisioningTargetSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: provisioningTargetSortingToColumn(req.GetSortingColumn()),
+		},
+
+		Queries: queries,
+	}, nil
+}
+
+func provisioningTargetSortingToColumn(sortingCriteria application.ProvisioningTargetSorting) query.Column {
+	switch sortingCriteria {
+	case application.ProvisioningTargetSorting_PROVISIONING_TARGET_SORT_BY_CHANGE_DATE:
+		return query.ProvisioningTargetColumnChangeDate
+	case application.ProvisioningTargetSorting_PROVISIONING_TARGET_SORT_BY_CREATION_DATE:
+		return query.ProvisioningTargetColumnCreationDate
+	case application.ProvisioningTargetSorting_PROVISIONING_TARGET_SORT_BY_NAME:
+		return query.ProvisioningTargetColumnName
+	case application.ProvisioningTargetSorting_PROVISIONING_TARGET_SORT_BY_ID:
+		fallthrough
+	default:
+		return query.ProvisioningTargetColumnID
+	}
+}
+
+func provisioningTargetQueriesToModel(filters []*application.ProvisioningTargetSearchFilter) (queries []query.SearchQuery, err error) {
+	queries = make([]query.SearchQuery, len(filters))
+	for i, f := range filters {
+		queries[i], err = provisioningTargetFilterToQuery(f)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return queries, nil
+}
+
+func provisioningTargetFilterToQuery(targetFilter *application.ProvisioningTargetSearchFilter) (query.SearchQuery, error) {
+	switch q := targetFilter.GetFilter().(type) {
+	case *application.ProvisioningTargetSearchFilter_ApplicationIdFilter:
+		return query.NewProvisioningTargetAppIDSearchQuery(strings.TrimSpace(q.ApplicationIdFilter.GetApplicationId()))
+	case *application.ProvisioningTargetSearchFilter_ProjectIdFilter:
+		return query.NewProvisioningTargetProjectIDSearchQuery(strings.TrimSpace(q.ProjectIdFilter.GetProjectId()))
+	case *application.ProvisioningTargetSearchFilter_NameFilter:
+		return query.NewProvisioningTargetNameSearchQuery(filter.TextMethodPbToQuery(q.NameFilter.GetMethod()), q.NameFilter.GetName())
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "CONV-Pv3nRq", "List.Query.Invalid")
+	}
+}
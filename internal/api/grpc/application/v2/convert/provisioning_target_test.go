@@ -0,0 +1,145 @@
+package convert
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/application/v2"
+)
+
+func TestProvisioningTargetToPb(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	tt := []struct {
+		name     string
+		target   *query.ProvisioningTarget
+		expected *application.ProvisioningTarget
+	}{
+		{
+			name:     "nil target",
+			expected: &application.ProvisioningTarget{},
+		},
+		{
+			name: "never synced",
+			target: &query.ProvisioningTarget{
+				ObjectDetails: domain.ObjectDetails{
+					ID:           "target1",
+					CreationDate: now,
+					EventDate:    now,
+				},
+				Name:      "name",
+				ProjectID: "project1",
+				AppID:     "app1",
+				Endpoint:  "https://example.com/scim/v2",
+			},
+			expected: &application.ProvisioningTarget{
+				ProvisioningTargetId: "target1",
+				CreationDate:         timestamppb.New(now),
+				ChangeDate:           timestamppb.New(now),
+				Name:                 "name",
+				ProjectId:            "project1",
+				ApplicationId:        "app1",
+				Endpoint:             "https://example.com/scim/v2",
+				SyncStatus:           application.ProvisioningTargetSyncStatus_PROVISIONING_TARGET_SYNC_STATUS_UNSPECIFIED,
+			},
+		},
+		{
+			name: "failed",
+			target: &query.ProvisioningTarget{
+				ObjectDetails: domain.ObjectDetails{
+					ID:           "target1",
+					CreationDate: now,
+					EventDate:    now,
+				},
+				Name:            "name",
+				ProjectID:       "project1",
+				AppID:           "app1",
+				Endpoint:        "https://example.com/scim/v2",
+				SyncStatus:      domain.ProvisioningStatusFailed,
+				SyncDate:        now,
+				LastError:       "unavailable",
+				LastErrorUserID: "user1",
+			},
+			expected: &application.ProvisioningTarget{
+				ProvisioningTargetId: "target1",
+				CreationDate:         timestamppb.New(now),
+				ChangeDate:           timestamppb.New(now),
+				Name:                 "name",
+				ProjectId:            "project1",
+				ApplicationId:        "app1",
+				Endpoint:             "https://example.com/scim/v2",
+				SyncStatus:           application.ProvisioningTargetSyncStatus_PROVISIONING_TARGET_SYNC_STATUS_FAILED,
+				SyncDate:             timestamppb.New(now),
+				LastError:            "unavailable",
+				LastErrorUserId:      "user1",
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// When
+			result := ProvisioningTargetToPb(tc.target)
+
+			// Then
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestProvisioningTargetSortingToColumn(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		sorting  application.ProvisioningTargetSorting
+		expected query.Column
+	}{
+		{
+			name:     "sort by change date",
+			sorting:  application.ProvisioningTargetSorting_PROVISIONING_TARGET_SORT_BY_CHANGE_DATE,
+			expected: query.ProvisioningTargetColumnChangeDate,
+		},
+		{
+			name:     "sort by creation date",
+			sorting:  application.ProvisioningTargetSorting_PROVISIONING_TARGET_SORT_BY_CREATION_DATE,
+			expected: query.ProvisioningTargetColumnCreationDate,
+		},
+		{
+			name:     "sort by name",
+			sorting:  application.ProvisioningTargetSorting_PROVISIONING_TARGET_SORT_BY_NAME,
+			expected: query.ProvisioningTargetColumnName,
+		},
+		{
+			name:     "sort by ID",
+			sorting:  application.ProvisioningTargetSorting_PROVISIONING_TARGET_SORT_BY_ID,
+			expected: query.ProvisioningTargetColumnID,
+		},
+		{
+			name:     "unknown sorting defaults to ID",
+			sorting:  application.ProvisioningTargetSorting(99),
+			expected: query.ProvisioningTargetColumnID,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// When
+			result := provisioningTargetSortingToColumn(tc.sorting)
+
+			// Then
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
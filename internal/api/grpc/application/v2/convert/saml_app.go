@@ -18,11 +18,17 @@ func CreateSAMLAppRequestToDomain(name, projectID string, req *application.Creat
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: projectID,
 		},
-		AppName:      name,
-		Metadata:     req.GetMetadataXml(),
-		MetadataURL:  gu.Ptr(req.GetMetadataUrl()),
-		LoginVersion: loginVersion,
-		LoginBaseURI: loginBaseURI,
+		AppName:                name,
+		Metadata:               req.GetMetadataXml(),
+		MetadataURL:            gu.Ptr(req.GetMetadataUrl()),
+		LoginVersion:           loginVersion,
+		LoginBaseURI:           loginBaseURI,
+		IDPInitiatedEnabled:    gu.Ptr(req.GetIdpInitiatedEnabled()),
+		IDPInitiatedRelayState: gu.Ptr(req.GetIdpInitiatedRelayState()),
+		EncryptAssertion:       gu.Ptr(req.GetEncryptAssertion()),
+		NameIDFormat:           gu.Ptr(samlNameIDFormatToDomain(req.GetNameIdFormat())),
+		NameIDSource:           gu.Ptr(samlNameIDSourceToDomain(req.GetNameIdSource())),
+		SignatureAlgorithm:     gu.Ptr(samlSignatureAlgorithmToDomain(req.GetSignatureAlgorithm())),
 	}, nil
 }
 
@@ -33,7 +39,7 @@ func UpdateSAMLAppConfigRequestToDomain(appID, projectID string, app *applicatio
 	}
 
 	metasXML, metasURL := metasToDomain(app.GetMetadata())
-	return &domain.SAMLApp{
+	samlApp := &domain.SAMLApp{
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: projectID,
 		},
@@ -42,7 +48,24 @@ func UpdateSAMLAppConfigRequestToDomain(appID, projectID string, app *applicatio
 		MetadataURL:  metasURL,
 		LoginVersion: loginVersion,
 		LoginBaseURI: loginBaseURI,
-	}, nil
+	}
+	if app == nil {
+		return samlApp, nil
+	}
+	// optional fields: omit = no change
+	samlApp.IDPInitiatedEnabled = app.IdpInitiatedEnabled
+	samlApp.IDPInitiatedRelayState = app.IdpInitiatedRelayState
+	samlApp.EncryptAssertion = app.EncryptAssertion
+	if app.NameIdFormat != nil {
+		samlApp.NameIDFormat = gu.Ptr(samlNameIDFormatToDomain(*app.NameIdFormat))
+	}
+	if app.NameIdSource != nil {
+		samlApp.NameIDSource = gu.Ptr(samlNameIDSourceToDomain(*app.NameIdSource))
+	}
+	if app.SignatureAlgorithm != nil {
+		samlApp.SignatureAlgorithm = gu.Ptr(samlSignatureAlgorithmToDomain(*app.SignatureAlgorithm))
+	}
+	return samlApp, nil
 }
 
 func metasToDomain(metas application.MetaType) ([]byte, *string) {
@@ -69,9 +92,101 @@ func appSAMLConfigToPb(samlApp *query.SAMLApp) application.IsApplicationConfigur
 
 	return &application.Application_SamlConfiguration{
 		SamlConfiguration: &application.SAMLConfiguration{
-			MetadataXml:  samlApp.Metadata,
-			MetadataUrl:  samlApp.MetadataURL,
-			LoginVersion: loginVersionToPb(samlApp.LoginVersion, samlApp.LoginBaseURI),
+			MetadataXml:            samlApp.Metadata,
+			MetadataUrl:            samlApp.MetadataURL,
+			LoginVersion:           loginVersionToPb(samlApp.LoginVersion, samlApp.LoginBaseURI),
+			IdpInitiatedEnabled:    samlApp.IDPInitiatedEnabled,
+			IdpInitiatedRelayState: samlApp.IDPInitiatedRelayState,
+			EncryptAssertion:       samlApp.EncryptAssertion,
+			NameIdFormat:           samlNameIDFormatToPb(samlApp.NameIDFormat),
+			NameIdSource:           samlNameIDSourceToPb(samlApp.NameIDSource),
+			SignatureAlgorithm:     samlSignatureAlgorithmToPb(samlApp.SignatureAlgorithm),
 		},
 	}
 }
+
+func samlNameIDFormatToDomain(format application.SAMLNameIDFormat) domain.SAMLNameIDFormat {
+	switch format {
+	case application.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_UNSPECIFIED:
+		return domain.SAMLNameIDFormatUnspecified
+	case application.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_PERSISTENT:
+		return domain.SAMLNameIDFormatPersistent
+	case application.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_TRANSIENT:
+		return domain.SAMLNameIDFormatTransient
+	case application.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_EMAIL_ADDRESS:
+		fallthrough
+	default:
+		return domain.SAMLNameIDFormatEmailAddress
+	}
+}
+
+func samlNameIDFormatToPb(format domain.SAMLNameIDFormat) application.SAMLNameIDFormat {
+	switch format {
+	case domain.SAMLNameIDFormatUnspecified:
+		return application.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_UNSPECIFIED
+	case domain.SAMLNameIDFormatPersistent:
+		return application.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_PERSISTENT
+	case domain.SAMLNameIDFormatTransient:
+		return application.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_TRANSIENT
+	case domain.SAMLNameIDFormatEmailAddress:
+		fallthrough
+	default:
+		return application.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_EMAIL_ADDRESS
+	}
+}
+
+func samlNameIDSourceToDomain(source application.SAMLNameIDSource) domain.SAMLNameIDSource {
+	switch source {
+	case application.SAMLNameIDSource_SAML_NAME_ID_SOURCE_EMAIL:
+		return domain.SAMLNameIDSourceEmail
+	case application.SAMLNameIDSource_SAML_NAME_ID_SOURCE_USER_ID:
+		return domain.SAMLNameIDSourceUserID
+	case application.SAMLNameIDSource_SAML_NAME_ID_SOURCE_LOGIN_NAME:
+		fallthrough
+	default:
+		return domain.SAMLNameIDSourceLoginName
+	}
+}
+
+func samlNameIDSourceToPb(source domain.SAMLNameIDSource) application.SAMLNameIDSource {
+	switch source {
+	case domain.SAMLNameIDSourceEmail:
+		return application.SAMLNameIDSource_SAML_NAME_ID_SOURCE_EMAIL
+	case domain.SAMLNameIDSourceUserID:
+		return application.SAMLNameIDSource_SAML_NAME_ID_SOURCE_USER_ID
+	case domain.SAMLNameIDSourceLoginName:
+		fallthrough
+	default:
+		return application.SAMLNameIDSource_SAML_NAME_ID_SOURCE_LOGIN_NAME
+	}
+}
+
+func samlSignatureAlgorithmToDomain(algorithm application.SAMLSignatureAlgorithm) domain.SAMLSignatureAlgorithm {
+	switch algorithm {
+	case application.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA1:
+		return domain.SAMLSignatureAlgorithmRSASHA1
+	case application.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA256:
+		return domain.SAMLSignatureAlgorithmRSASHA256
+	case application.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA512:
+		return domain.SAMLSignatureAlgorithmRSASHA512
+	case application.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_UNSPECIFIED:
+		fallthrough
+	default:
+		return domain.SAMLSignatureAlgorithmUnspecified
+	}
+}
+
+func samlSignatureAlgorithmToPb(algorithm domain.SAMLSignatureAlgorithm) application.SAMLSignatureAlgorithm {
+	switch algorithm {
+	case domain.SAMLSignatureAlgorithmRSASHA1:
+		return application.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA1
+	case domain.SAMLSignatureAlgorithmRSASHA256:
+		return application.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA256
+	case domain.SAMLSignatureAlgorithmRSASHA512:
+		return application.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA512
+	case domain.SAMLSignatureAlgorithmUnspecified:
+		fallthrough
+	default:
+		return application.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_UNSPECIFIED
+	}
+}
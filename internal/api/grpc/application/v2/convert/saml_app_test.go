@@ -81,13 +81,50 @@ func TestCreateSAMLAppRequestToDomain(t *testing.T) {
 			},
 
 			expectedResponse: &domain.SAMLApp{
-				ObjectRoot:   models.ObjectRoot{AggregateID: "proj-1"},
-				AppName:      "test-application",
-				Metadata:     genMetaForValidRequest,
-				MetadataURL:  gu.Ptr(""),
-				LoginVersion: gu.Ptr(domain.LoginVersionUnspecified),
-				LoginBaseURI: gu.Ptr(""),
-				State:        0,
+				ObjectRoot:             models.ObjectRoot{AggregateID: "proj-1"},
+				AppName:                "test-application",
+				Metadata:               genMetaForValidRequest,
+				MetadataURL:            gu.Ptr(""),
+				LoginVersion:           gu.Ptr(domain.LoginVersionUnspecified),
+				LoginBaseURI:           gu.Ptr(""),
+				State:                  0,
+				IDPInitiatedEnabled:    gu.Ptr(false),
+				IDPInitiatedRelayState: gu.Ptr(""),
+				EncryptAssertion:       gu.Ptr(false),
+				NameIDFormat:           gu.Ptr(domain.SAMLNameIDFormatEmailAddress),
+				NameIDSource:           gu.Ptr(domain.SAMLNameIDSourceLoginName),
+				SignatureAlgorithm:     gu.Ptr(domain.SAMLSignatureAlgorithmUnspecified),
+			},
+		},
+		{
+			testName:  "valid request, idp initiated and response settings",
+			appName:   "test-application",
+			projectID: "proj-1",
+			req: &application.CreateSAMLApplicationRequest{
+				Metadata: &application.CreateSAMLApplicationRequest_MetadataXml{
+					MetadataXml: genMetaForValidRequest,
+				},
+				IdpInitiatedEnabled:    true,
+				IdpInitiatedRelayState: "https://example.com/home",
+				EncryptAssertion:       true,
+				NameIdFormat:           application.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_PERSISTENT,
+				NameIdSource:           application.SAMLNameIDSource_SAML_NAME_ID_SOURCE_USER_ID,
+				SignatureAlgorithm:     application.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA512,
+			},
+
+			expectedResponse: &domain.SAMLApp{
+				ObjectRoot:             models.ObjectRoot{AggregateID: "proj-1"},
+				AppName:                "test-application",
+				Metadata:               genMetaForValidRequest,
+				MetadataURL:            gu.Ptr(""),
+				LoginVersion:           gu.Ptr(domain.LoginVersionUnspecified),
+				LoginBaseURI:           gu.Ptr(""),
+				IDPInitiatedEnabled:    gu.Ptr(true),
+				IDPInitiatedRelayState: gu.Ptr("https://example.com/home"),
+				EncryptAssertion:       gu.Ptr(true),
+				NameIDFormat:           gu.Ptr(domain.SAMLNameIDFormatPersistent),
+				NameIDSource:           gu.Ptr(domain.SAMLNameIDSourceUserID),
+				SignatureAlgorithm:     gu.Ptr(domain.SAMLSignatureAlgorithmRSASHA512),
 			},
 		},
 		{
@@ -97,11 +134,17 @@ func TestCreateSAMLAppRequestToDomain(t *testing.T) {
 			req:       nil,
 
 			expectedResponse: &domain.SAMLApp{
-				AppName:      "test-application",
-				ObjectRoot:   models.ObjectRoot{AggregateID: "proj-1"},
-				MetadataURL:  gu.Ptr(""),
-				LoginVersion: gu.Ptr(domain.LoginVersionUnspecified),
-				LoginBaseURI: gu.Ptr(""),
+				AppName:                "test-application",
+				ObjectRoot:             models.ObjectRoot{AggregateID: "proj-1"},
+				MetadataURL:            gu.Ptr(""),
+				LoginVersion:           gu.Ptr(domain.LoginVersionUnspecified),
+				LoginBaseURI:           gu.Ptr(""),
+				IDPInitiatedEnabled:    gu.Ptr(false),
+				IDPInitiatedRelayState: gu.Ptr(""),
+				EncryptAssertion:       gu.Ptr(false),
+				NameIDFormat:           gu.Ptr(domain.SAMLNameIDFormatEmailAddress),
+				NameIDSource:           gu.Ptr(domain.SAMLNameIDSourceLoginName),
+				SignatureAlgorithm:     gu.Ptr(domain.SAMLSignatureAlgorithmUnspecified),
 			},
 		},
 	}
@@ -171,6 +214,25 @@ func TestUpdateSAMLAppConfigRequestToDomain(t *testing.T) {
 				LoginBaseURI: gu.Ptr(""),
 			},
 		},
+		{
+			testName:  "partial request, idp initiated and response settings",
+			appID:     "application-1",
+			projectID: "proj-1",
+			req: &application.UpdateSAMLApplicationConfigurationRequest{
+				IdpInitiatedEnabled: gu.Ptr(true),
+				NameIdFormat:        gu.Ptr(application.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_TRANSIENT),
+				SignatureAlgorithm:  gu.Ptr(application.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA256),
+			},
+			expectedResponse: &domain.SAMLApp{
+				ObjectRoot:          models.ObjectRoot{AggregateID: "proj-1"},
+				AppID:               "application-1",
+				LoginVersion:        gu.Ptr(domain.LoginVersionUnspecified),
+				LoginBaseURI:        gu.Ptr(""),
+				IDPInitiatedEnabled: gu.Ptr(true),
+				NameIDFormat:        gu.Ptr(domain.SAMLNameIDFormatTransient),
+				SignatureAlgorithm:  gu.Ptr(domain.SAMLSignatureAlgorithmRSASHA256),
+			},
+		},
 		{
 			testName:  "nil request",
 			appID:     "application-1",
@@ -213,9 +275,15 @@ func TestAppSAMLConfigToPb(t *testing.T) {
 		{
 			name: "valid conversion",
 			inputSAMLApp: &query.SAMLApp{
-				Metadata:     metadata,
-				LoginVersion: domain.LoginVersion2,
-				LoginBaseURI: gu.Ptr("https://example.com"),
+				Metadata:               metadata,
+				LoginVersion:           domain.LoginVersion2,
+				LoginBaseURI:           gu.Ptr("https://example.com"),
+				IDPInitiatedEnabled:    true,
+				IDPInitiatedRelayState: "https://example.com/home",
+				EncryptAssertion:       true,
+				NameIDFormat:           domain.SAMLNameIDFormatPersistent,
+				NameIDSource:           domain.SAMLNameIDSourceEmail,
+				SignatureAlgorithm:     domain.SAMLSignatureAlgorithmRSASHA1,
 			},
 			expectedPbApp: &application.Application_SamlConfiguration{
 				SamlConfiguration: &application.SAMLConfiguration{
@@ -225,6 +293,12 @@ func TestAppSAMLConfigToPb(t *testing.T) {
 							LoginV2: &application.LoginV2{BaseUri: gu.Ptr("https://example.com")},
 						},
 					},
+					IdpInitiatedEnabled:    true,
+					IdpInitiatedRelayState: "https://example.com/home",
+					EncryptAssertion:       true,
+					NameIdFormat:           application.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_PERSISTENT,
+					NameIdSource:           application.SAMLNameIDSource_SAML_NAME_ID_SOURCE_EMAIL,
+					SignatureAlgorithm:     application.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA1,
 				},
 			},
 		},
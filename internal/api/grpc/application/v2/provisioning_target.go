@@ -0,0 +1,86 @@
+package app
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/application/v2/convert"
+	"github.com/zitadel/zitadel/internal/api/grpc/filter/v2"
+	"github.com/zitadel/zitadel/pkg/grpc/application/v2"
+)
+
+func (s *Server) CreateProvisioningTarget(ctx context.Context, req *connect.Request[application.CreateProvisioningTargetRequest]) (*connect.Response[application.CreateProvisioningTargetResponse], error) {
+	details, err := s.command.AddProvisioningTarget(ctx, convert.CreateProvisioningTargetRequestToCommand(req.Msg))
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&application.CreateProvisioningTargetResponse{
+		ProvisioningTargetId: details.ID,
+		CreationDate:         timestamppb.New(details.EventDate),
+	}), nil
+}
+
+func (s *Server) UpdateProvisioningTarget(ctx context.Context, req *connect.Request[application.UpdateProvisioningTargetRequest]) (*connect.Response[application.UpdateProvisioningTargetResponse], error) {
+	details, err := s.command.ChangeProvisioningTarget(ctx, convert.UpdateProvisioningTargetRequestToCommand(req.Msg))
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&application.UpdateProvisioningTargetResponse{
+		ChangeDate: timestamppb.New(details.EventDate),
+	}), nil
+}
+
+func (s *Server) DeleteProvisioningTarget(ctx context.Context, req *connect.Request[application.DeleteProvisioningTargetRequest]) (*connect.Response[application.DeleteProvisioningTargetResponse], error) {
+	details, err := s.command.RemoveProvisioningTarget(ctx, strings.TrimSpace(req.Msg.GetProvisioningTargetId()), "")
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&application.DeleteProvisioningTargetResponse{
+		DeletionDate: timestamppb.New(details.EventDate),
+	}), nil
+}
+
+func (s *Server) ResyncProvisioningTarget(ctx context.Context, req *connect.Request[application.ResyncProvisioningTargetRequest]) (*connect.Response[application.ResyncProvisioningTargetResponse], error) {
+	details, err := s.command.ResyncProvisioningTarget(ctx, strings.TrimSpace(req.Msg.GetProvisioningTargetId()), "")
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&application.ResyncProvisioningTargetResponse{
+		ResyncDate: timestamppb.New(details.EventDate),
+	}), nil
+}
+
+func (s *Server) GetProvisioningTarget(ctx context.Context, req *connect.Request[application.GetProvisioningTargetRequest]) (*connect.Response[application.GetProvisioningTargetResponse], error) {
+	target, err := s.query.GetProvisioningTargetByIDWithPermission(ctx, strings.TrimSpace(req.Msg.GetProvisioningTargetId()), s.checkPermission)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&application.GetProvisioningTargetResponse{
+		ProvisioningTarget: convert.ProvisioningTargetToPb(target),
+	}), nil
+}
+
+func (s *Server) ListProvisioningTargets(ctx context.Context, req *connect.Request[application.ListProvisioningTargetsRequest]) (*connect.Response[application.ListProvisioningTargetsResponse], error) {
+	queries, err := convert.ListProvisioningTargetsRequestToModel(s.systemDefaults, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.query.SearchProvisioningTargets(ctx, queries, s.checkPermission)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&application.ListProvisioningTargetsResponse{
+		ProvisioningTargets: convert.ProvisioningTargetsToPb(res.ProvisioningTargets),
+		Pagination:          filter.QueryToPaginationPb(queries.SearchRequest, res.SearchResponse),
+	}), nil
+}
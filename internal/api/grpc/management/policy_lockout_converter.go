@@ -9,6 +9,8 @@ func AddLockoutPolicyToDomain(p *mgmt.AddCustomLockoutPolicyRequest) *domain.Loc
 	return &domain.LockoutPolicy{
 		MaxPasswordAttempts: uint64(p.MaxPasswordAttempts),
 		MaxOTPAttempts:      uint64(p.MaxOtpAttempts),
+		LockoutDuration:     p.GetLockoutDuration().AsDuration(),
+		FailedAttemptDelay:  p.GetFailedAttemptDelay().AsDuration(),
 	}
 }
 
@@ -16,5 +18,7 @@ func UpdateLockoutPolicyToDomain(p *mgmt.UpdateCustomLockoutPolicyRequest) *doma
 	return &domain.LockoutPolicy{
 		MaxPasswordAttempts: uint64(p.MaxPasswordAttempts),
 		MaxOTPAttempts:      uint64(p.MaxOtpAttempts),
+		LockoutDuration:     p.GetLockoutDuration().AsDuration(),
+		FailedAttemptDelay:  p.GetFailedAttemptDelay().AsDuration(),
 	}
 }
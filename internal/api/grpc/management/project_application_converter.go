@@ -53,29 +53,35 @@ func AddOIDCAppRequestToDomain(req *mgmt_pb.AddOIDCAppRequest) (*domain.OIDCApp,
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: req.ProjectId,
 		},
-		AppName:                       req.Name,
-		OIDCVersion:                   gu.Ptr(app_grpc.OIDCVersionToDomain(req.Version)),
-		RedirectUris:                  req.RedirectUris,
-		ResponseTypes:                 app_grpc.OIDCResponseTypesToDomain(req.ResponseTypes),
-		GrantTypes:                    app_grpc.OIDCGrantTypesToDomain(req.GrantTypes),
-		ApplicationType:               gu.Ptr(app_grpc.OIDCApplicationTypeToDomain(req.AppType)),
-		AuthMethodType:                gu.Ptr(app_grpc.OIDCAuthMethodTypeToDomain(req.AuthMethodType)),
-		PostLogoutRedirectUris:        req.PostLogoutRedirectUris,
-		DevMode:                       gu.Ptr(req.GetDevMode()),
-		AccessTokenType:               gu.Ptr(app_grpc.OIDCTokenTypeToDomain(req.AccessTokenType)),
-		AccessTokenRoleAssertion:      gu.Ptr(req.GetAccessTokenRoleAssertion()),
-		IDTokenRoleAssertion:          gu.Ptr(req.GetIdTokenRoleAssertion()),
-		IDTokenUserinfoAssertion:      gu.Ptr(req.GetIdTokenUserinfoAssertion()),
-		ClockSkew:                     gu.Ptr(req.GetClockSkew().AsDuration()),
-		AdditionalOrigins:             req.AdditionalOrigins,
-		SkipNativeAppSuccessPage:      gu.Ptr(req.GetSkipNativeAppSuccessPage()),
-		BackChannelLogoutURI:          gu.Ptr(req.GetBackChannelLogoutUri()),
-		LoginVersion:                  gu.Ptr(loginVersion),
-		LoginBaseURI:                  gu.Ptr(loginBaseURI),
-		IOSTeamID:                     iosTeamID,
-		IOSBundleID:                   iosBundleID,
-		AndroidPackageName:            androidPackageName,
-		AndroidSHA256CertFingerprints: androidFingerprints,
+		AppName:                          req.Name,
+		OIDCVersion:                      gu.Ptr(app_grpc.OIDCVersionToDomain(req.Version)),
+		RedirectUris:                     req.RedirectUris,
+		ResponseTypes:                    app_grpc.OIDCResponseTypesToDomain(req.ResponseTypes),
+		GrantTypes:                       app_grpc.OIDCGrantTypesToDomain(req.GrantTypes),
+		ApplicationType:                  gu.Ptr(app_grpc.OIDCApplicationTypeToDomain(req.AppType)),
+		AuthMethodType:                   gu.Ptr(app_grpc.OIDCAuthMethodTypeToDomain(req.AuthMethodType)),
+		PostLogoutRedirectUris:           req.PostLogoutRedirectUris,
+		DevMode:                          gu.Ptr(req.GetDevMode()),
+		AccessTokenType:                  gu.Ptr(app_grpc.OIDCTokenTypeToDomain(req.AccessTokenType)),
+		AccessTokenRoleAssertion:         gu.Ptr(req.GetAccessTokenRoleAssertion()),
+		IDTokenRoleAssertion:             gu.Ptr(req.GetIdTokenRoleAssertion()),
+		IDTokenUserinfoAssertion:         gu.Ptr(req.GetIdTokenUserinfoAssertion()),
+		ClockSkew:                        gu.Ptr(req.GetClockSkew().AsDuration()),
+		AdditionalOrigins:                req.AdditionalOrigins,
+		SkipNativeAppSuccessPage:         gu.Ptr(req.GetSkipNativeAppSuccessPage()),
+		BackChannelLogoutURI:             gu.Ptr(req.GetBackChannelLogoutUri()),
+		LoginVersion:                     gu.Ptr(loginVersion),
+		LoginBaseURI:                     gu.Ptr(loginBaseURI),
+		IOSTeamID:                        iosTeamID,
+		IOSBundleID:                      iosBundleID,
+		AndroidPackageName:               androidPackageName,
+		AndroidSHA256CertFingerprints:    androidFingerprints,
+		DPoPRequired:                     gu.Ptr(req.GetDpopRequired()),
+		PARRequired:                      gu.Ptr(req.GetParRequired()),
+		TLSClientAuthSubjectDN:           gu.Ptr(req.GetTlsClientAuthSubjectDn()),
+		TLSClientAuthSAN:                 gu.Ptr(req.GetTlsClientAuthSan()),
+		BackChannelClientNotificationURI: gu.Ptr(req.GetBackChannelClientNotificationUri()),
+		ConsentRequired:                  gu.Ptr(req.GetConsentRequired()),
 	}, nil
 }
 
@@ -88,11 +94,17 @@ func AddSAMLAppRequestToDomain(req *mgmt_pb.AddSAMLAppRequest) (*domain.SAMLApp,
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: req.ProjectId,
 		},
-		AppName:      req.Name,
-		Metadata:     req.GetMetadataXml(),
-		MetadataURL:  gu.Ptr(req.GetMetadataUrl()),
-		LoginVersion: gu.Ptr(loginVersion),
-		LoginBaseURI: gu.Ptr(loginBaseURI),
+		AppName:                req.Name,
+		Metadata:               req.GetMetadataXml(),
+		MetadataURL:            gu.Ptr(req.GetMetadataUrl()),
+		LoginVersion:           gu.Ptr(loginVersion),
+		LoginBaseURI:           gu.Ptr(loginBaseURI),
+		IDPInitiatedEnabled:    gu.Ptr(req.GetIdpInitiatedEnabled()),
+		IDPInitiatedRelayState: gu.Ptr(req.GetIdpInitiatedRelayState()),
+		EncryptAssertion:       gu.Ptr(req.GetEncryptAssertion()),
+		NameIDFormat:           gu.Ptr(app_grpc.SAMLNameIDFormatToDomain(req.GetNameIdFormat())),
+		NameIDSource:           gu.Ptr(app_grpc.SAMLNameIDSourceToDomain(req.GetNameIdSource())),
+		SignatureAlgorithm:     gu.Ptr(app_grpc.SAMLSignatureAlgorithmToDomain(req.GetSignatureAlgorithm())),
 	}, nil
 }
 
@@ -101,8 +113,10 @@ func AddAPIAppRequestToDomain(app *mgmt_pb.AddAPIAppRequest) *domain.APIApp {
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: app.ProjectId,
 		},
-		AppName:        app.Name,
-		AuthMethodType: app_grpc.APIAuthMethodTypeToDomain(app.AuthMethodType),
+		AppName:                app.Name,
+		AuthMethodType:         app_grpc.APIAuthMethodTypeToDomain(app.AuthMethodType),
+		TLSClientAuthSubjectDN: app.TlsClientAuthSubjectDn,
+		TLSClientAuthSAN:       app.TlsClientAuthSan,
 	}
 }
 
@@ -125,28 +139,34 @@ func UpdateOIDCAppConfigRequestToDomain(app *mgmt_pb.UpdateOIDCAppConfigRequest)
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: app.ProjectId,
 		},
-		AppID:                         app.AppId,
-		RedirectUris:                  app.RedirectUris,
-		ResponseTypes:                 app_grpc.OIDCResponseTypesToDomain(app.ResponseTypes),
-		GrantTypes:                    app_grpc.OIDCGrantTypesToDomain(app.GrantTypes),
-		ApplicationType:               gu.Ptr(app_grpc.OIDCApplicationTypeToDomain(app.AppType)),
-		AuthMethodType:                gu.Ptr(app_grpc.OIDCAuthMethodTypeToDomain(app.AuthMethodType)),
-		PostLogoutRedirectUris:        app.PostLogoutRedirectUris,
-		DevMode:                       gu.Ptr(app.GetDevMode()),
-		AccessTokenType:               gu.Ptr(app_grpc.OIDCTokenTypeToDomain(app.AccessTokenType)),
-		AccessTokenRoleAssertion:      gu.Ptr(app.GetAccessTokenRoleAssertion()),
-		IDTokenRoleAssertion:          gu.Ptr(app.GetIdTokenRoleAssertion()),
-		IDTokenUserinfoAssertion:      gu.Ptr(app.GetIdTokenUserinfoAssertion()),
-		ClockSkew:                     gu.Ptr(app.GetClockSkew().AsDuration()),
-		AdditionalOrigins:             app.AdditionalOrigins,
-		SkipNativeAppSuccessPage:      gu.Ptr(app.GetSkipNativeAppSuccessPage()),
-		BackChannelLogoutURI:          gu.Ptr(app.GetBackChannelLogoutUri()),
-		LoginVersion:                  gu.Ptr(loginVersion),
-		LoginBaseURI:                  gu.Ptr(loginBaseURI),
-		IOSTeamID:                     iosTeamID,
-		IOSBundleID:                   iosBundleID,
-		AndroidPackageName:            androidPackageName,
-		AndroidSHA256CertFingerprints: androidFingerprints,
+		AppID:                            app.AppId,
+		RedirectUris:                     app.RedirectUris,
+		ResponseTypes:                    app_grpc.OIDCResponseTypesToDomain(app.ResponseTypes),
+		GrantTypes:                       app_grpc.OIDCGrantTypesToDomain(app.GrantTypes),
+		ApplicationType:                  gu.Ptr(app_grpc.OIDCApplicationTypeToDomain(app.AppType)),
+		AuthMethodType:                   gu.Ptr(app_grpc.OIDCAuthMethodTypeToDomain(app.AuthMethodType)),
+		PostLogoutRedirectUris:           app.PostLogoutRedirectUris,
+		DevMode:                          gu.Ptr(app.GetDevMode()),
+		AccessTokenType:                  gu.Ptr(app_grpc.OIDCTokenTypeToDomain(app.AccessTokenType)),
+		AccessTokenRoleAssertion:         gu.Ptr(app.GetAccessTokenRoleAssertion()),
+		IDTokenRoleAssertion:             gu.Ptr(app.GetIdTokenRoleAssertion()),
+		IDTokenUserinfoAssertion:         gu.Ptr(app.GetIdTokenUserinfoAssertion()),
+		ClockSkew:                        gu.Ptr(app.GetClockSkew().AsDuration()),
+		AdditionalOrigins:                app.AdditionalOrigins,
+		SkipNativeAppSuccessPage:         gu.Ptr(app.GetSkipNativeAppSuccessPage()),
+		BackChannelLogoutURI:             gu.Ptr(app.GetBackChannelLogoutUri()),
+		LoginVersion:                     gu.Ptr(loginVersion),
+		LoginBaseURI:                     gu.Ptr(loginBaseURI),
+		IOSTeamID:                        iosTeamID,
+		IOSBundleID:                      iosBundleID,
+		AndroidPackageName:               androidPackageName,
+		AndroidSHA256CertFingerprints:    androidFingerprints,
+		DPoPRequired:                     gu.Ptr(app.GetDpopRequired()),
+		PARRequired:                      gu.Ptr(app.GetParRequired()),
+		TLSClientAuthSubjectDN:           gu.Ptr(app.GetTlsClientAuthSubjectDn()),
+		TLSClientAuthSAN:                 gu.Ptr(app.GetTlsClientAuthSan()),
+		BackChannelClientNotificationURI: gu.Ptr(app.GetBackChannelClientNotificationUri()),
+		ConsentRequired:                  gu.Ptr(app.GetConsentRequired()),
 	}, nil
 }
 
@@ -159,11 +179,17 @@ func UpdateSAMLAppConfigRequestToDomain(app *mgmt_pb.UpdateSAMLAppConfigRequest)
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: app.ProjectId,
 		},
-		AppID:        app.AppId,
-		Metadata:     app.GetMetadataXml(),
-		MetadataURL:  gu.Ptr(app.GetMetadataUrl()),
-		LoginVersion: gu.Ptr(loginVersion),
-		LoginBaseURI: gu.Ptr(loginBaseURI),
+		AppID:                  app.AppId,
+		Metadata:               app.GetMetadataXml(),
+		MetadataURL:            gu.Ptr(app.GetMetadataUrl()),
+		LoginVersion:           gu.Ptr(loginVersion),
+		LoginBaseURI:           gu.Ptr(loginBaseURI),
+		IDPInitiatedEnabled:    gu.Ptr(app.GetIdpInitiatedEnabled()),
+		IDPInitiatedRelayState: gu.Ptr(app.GetIdpInitiatedRelayState()),
+		EncryptAssertion:       gu.Ptr(app.GetEncryptAssertion()),
+		NameIDFormat:           gu.Ptr(app_grpc.SAMLNameIDFormatToDomain(app.GetNameIdFormat())),
+		NameIDSource:           gu.Ptr(app_grpc.SAMLNameIDSourceToDomain(app.GetNameIdSource())),
+		SignatureAlgorithm:     gu.Ptr(app_grpc.SAMLSignatureAlgorithmToDomain(app.GetSignatureAlgorithm())),
 	}, nil
 }
 
@@ -172,8 +198,10 @@ func UpdateAPIAppConfigRequestToDomain(app *mgmt_pb.UpdateAPIAppConfigRequest) *
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: app.ProjectId,
 		},
-		AppID:          app.AppId,
-		AuthMethodType: app_grpc.APIAuthMethodTypeToDomain(app.AuthMethodType),
+		AppID:                  app.AppId,
+		AuthMethodType:         app_grpc.APIAuthMethodTypeToDomain(app.AuthMethodType),
+		TLSClientAuthSubjectDN: app.TlsClientAuthSubjectDn,
+		TLSClientAuthSAN:       app.TlsClientAuthSan,
 	}
 }
 
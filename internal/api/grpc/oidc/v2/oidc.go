@@ -7,6 +7,7 @@ import (
 	"github.com/zitadel/logging"
 	"github.com/zitadel/oidc/v3/pkg/op"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
@@ -92,9 +93,39 @@ func authRequestToPb(a *query.AuthRequest) *oidc_pb.AuthRequest {
 	if a.MaxAge != nil {
 		pba.MaxAge = durationpb.New(*a.MaxAge)
 	}
+	pba.AuthorizationDetails = authorizationDetailsToPb(a.AuthorizationDetails)
+	pba.ConsentRequired = a.ConsentRequired
 	return pba
 }
 
+func authorizationDetailsToPb(details domain.AuthorizationDetails) []*structpb.Struct {
+	if len(details) == 0 {
+		return nil
+	}
+	out := make([]*structpb.Struct, 0, len(details))
+	for _, detail := range details {
+		// the details are decoded from JSON, so conversion only fails on corrupted data
+		pbDetail, err := structpb.NewStruct(detail)
+		if err != nil {
+			logging.WithError(err).Warn("unable to convert authorization detail")
+			continue
+		}
+		out = append(out, pbDetail)
+	}
+	return out
+}
+
+func authorizationDetailsToDomain(details []*structpb.Struct) domain.AuthorizationDetails {
+	if len(details) == 0 {
+		return nil
+	}
+	out := make(domain.AuthorizationDetails, len(details))
+	for i, detail := range details {
+		out[i] = detail.AsMap()
+	}
+	return out
+}
+
 func promptsToPb(promps []domain.Prompt) []oidc_pb.Prompt {
 	out := make([]oidc_pb.Prompt, len(promps))
 	for i, p := range promps {
@@ -153,7 +184,7 @@ func (s *Server) failAuthRequest(ctx context.Context, authRequestID string, ae *
 }
 
 func (s *Server) linkSessionToAuthRequest(ctx context.Context, authRequestID string, session *oidc_pb.Session) (*connect.Response[oidc_pb.CreateCallbackResponse], error) {
-	details, aar, err := s.command.LinkSessionToAuthRequest(ctx, authRequestID, session.GetSessionId(), session.GetSessionToken(), true, s.checkPermission)
+	details, aar, err := s.command.LinkSessionToAuthRequest(ctx, authRequestID, session.GetSessionId(), session.GetSessionToken(), true, s.checkPermission, session.GetGrantConsent(), authorizationDetailsToDomain(session.GetAuthorizationDetails()))
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,10 @@
 package policy
 
 import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
 	"github.com/zitadel/zitadel/internal/query"
 	policy_pb "github.com/zitadel/zitadel/pkg/grpc/policy"
@@ -11,6 +15,8 @@ func ModelLockoutPolicyToPb(policy *query.LockoutPolicy) *policy_pb.LockoutPolic
 		IsDefault:           policy.IsDefault,
 		MaxPasswordAttempts: policy.MaxPasswordAttempts,
 		MaxOtpAttempts:      policy.MaxOTPAttempts,
+		LockoutDuration:     durationpb.New(time.Duration(policy.LockoutDuration)),
+		FailedAttemptDelay:  durationpb.New(time.Duration(policy.FailedAttemptDelay)),
 		Details: object.ToViewDetailsPb(
 			policy.Sequence,
 			policy.CreationDate,
@@ -48,29 +48,35 @@ func AppConfigToPb(app *query.App) app_pb.AppConfig {
 func AppOIDCConfigToPb(app *query.OIDCApp) *app_pb.App_OidcConfig {
 	return &app_pb.App_OidcConfig{
 		OidcConfig: &app_pb.OIDCConfig{
-			RedirectUris:             app.RedirectURIs,
-			ResponseTypes:            OIDCResponseTypesFromModel(app.ResponseTypes),
-			GrantTypes:               OIDCGrantTypesFromModel(app.GrantTypes),
-			AppType:                  OIDCApplicationTypeToPb(app.AppType),
-			ClientId:                 app.ClientID,
-			AuthMethodType:           OIDCAuthMethodTypeToPb(app.AuthMethodType),
-			PostLogoutRedirectUris:   app.PostLogoutRedirectURIs,
-			Version:                  OIDCVersionToPb(domain.OIDCVersion(app.Version)),
-			NoneCompliant:            len(app.ComplianceProblems) != 0,
-			ComplianceProblems:       ComplianceProblemsToLocalizedMessages(app.ComplianceProblems),
-			DevMode:                  app.IsDevMode,
-			AccessTokenType:          oidcTokenTypeToPb(app.AccessTokenType),
-			AccessTokenRoleAssertion: app.AssertAccessTokenRole,
-			IdTokenRoleAssertion:     app.AssertIDTokenRole,
-			IdTokenUserinfoAssertion: app.AssertIDTokenUserinfo,
-			ClockSkew:                durationpb.New(app.ClockSkew),
-			AdditionalOrigins:        app.AdditionalOrigins,
-			AllowedOrigins:           app.AllowedOrigins,
-			SkipNativeAppSuccessPage: app.SkipNativeAppSuccessPage,
-			BackChannelLogoutUri:     app.BackChannelLogoutURI,
-			LoginVersion:             loginVersionToPb(app.LoginVersion, app.LoginBaseURI),
-			Ios:                      iosAppLinkConfigToPb(app.IOSTeamID, app.IOSBundleID),
-			Android:                  androidAppLinkConfigToPb(app.AndroidPackageName, app.AndroidSHA256CertFingerprints),
+			RedirectUris:                     app.RedirectURIs,
+			ResponseTypes:                    OIDCResponseTypesFromModel(app.ResponseTypes),
+			GrantTypes:                       OIDCGrantTypesFromModel(app.GrantTypes),
+			AppType:                          OIDCApplicationTypeToPb(app.AppType),
+			ClientId:                         app.ClientID,
+			AuthMethodType:                   OIDCAuthMethodTypeToPb(app.AuthMethodType),
+			PostLogoutRedirectUris:           app.PostLogoutRedirectURIs,
+			Version:                          OIDCVersionToPb(domain.OIDCVersion(app.Version)),
+			NoneCompliant:                    len(app.ComplianceProblems) != 0,
+			ComplianceProblems:               ComplianceProblemsToLocalizedMessages(app.ComplianceProblems),
+			DevMode:                          app.IsDevMode,
+			AccessTokenType:                  oidcTokenTypeToPb(app.AccessTokenType),
+			AccessTokenRoleAssertion:         app.AssertAccessTokenRole,
+			IdTokenRoleAssertion:             app.AssertIDTokenRole,
+			IdTokenUserinfoAssertion:         app.AssertIDTokenUserinfo,
+			ClockSkew:                        durationpb.New(app.ClockSkew),
+			AdditionalOrigins:                app.AdditionalOrigins,
+			AllowedOrigins:                   app.AllowedOrigins,
+			SkipNativeAppSuccessPage:         app.SkipNativeAppSuccessPage,
+			BackChannelLogoutUri:             app.BackChannelLogoutURI,
+			LoginVersion:                     loginVersionToPb(app.LoginVersion, app.LoginBaseURI),
+			Ios:                              iosAppLinkConfigToPb(app.IOSTeamID, app.IOSBundleID),
+			Android:                          androidAppLinkConfigToPb(app.AndroidPackageName, app.AndroidSHA256CertFingerprints),
+			DpopRequired:                     app.DPoPRequired,
+			ParRequired:                      app.PARRequired,
+			TlsClientAuthSubjectDn:           app.TLSClientAuthSubjectDN,
+			TlsClientAuthSan:                 app.TLSClientAuthSAN,
+			BackChannelClientNotificationUri: app.BackChannelClientNotificationURI,
+			ConsentRequired:                  app.ConsentRequired,
 		},
 	}
 }
@@ -91,8 +97,14 @@ func loginVersionToPb(version domain.LoginVersion, baseURI *string) *app_pb.Logi
 func AppSAMLConfigToPb(app *query.SAMLApp) app_pb.AppConfig {
 	return &app_pb.App_SamlConfig{
 		SamlConfig: &app_pb.SAMLConfig{
-			Metadata:     &app_pb.SAMLConfig_MetadataXml{MetadataXml: app.Metadata},
-			LoginVersion: loginVersionToPb(app.LoginVersion, app.LoginBaseURI),
+			Metadata:               &app_pb.SAMLConfig_MetadataXml{MetadataXml: app.Metadata},
+			LoginVersion:           loginVersionToPb(app.LoginVersion, app.LoginBaseURI),
+			IdpInitiatedEnabled:    app.IDPInitiatedEnabled,
+			IdpInitiatedRelayState: app.IDPInitiatedRelayState,
+			EncryptAssertion:       app.EncryptAssertion,
+			NameIdFormat:           SAMLNameIDFormatToPb(app.NameIDFormat),
+			NameIdSource:           SAMLNameIDSourceToPb(app.NameIDSource),
+			SignatureAlgorithm:     SAMLSignatureAlgorithmToPb(app.SignatureAlgorithm),
 		},
 	}
 }
@@ -100,8 +112,10 @@ func AppSAMLConfigToPb(app *query.SAMLApp) app_pb.AppConfig {
 func AppAPIConfigToPb(app *query.APIApp) app_pb.AppConfig {
 	return &app_pb.App_ApiConfig{
 		ApiConfig: &app_pb.APIConfig{
-			ClientId:       app.ClientID,
-			AuthMethodType: APIAuthMethodeTypeToPb(app.AuthMethodType),
+			ClientId:               app.ClientID,
+			AuthMethodType:         APIAuthMethodeTypeToPb(app.AuthMethodType),
+			TlsClientAuthSubjectDn: app.TLSClientAuthSubjectDN,
+			TlsClientAuthSan:       app.TLSClientAuthSAN,
 		},
 	}
 }
@@ -164,6 +178,8 @@ func OIDCGrantTypesFromModel(grantTypes []domain.OIDCGrantType) []app_pb.OIDCGra
 			oidcGrantTypes[i] = app_pb.OIDCGrantType_OIDC_GRANT_TYPE_DEVICE_CODE
 		case domain.OIDCGrantTypeTokenExchange:
 			oidcGrantTypes[i] = app_pb.OIDCGrantType_OIDC_GRANT_TYPE_TOKEN_EXCHANGE
+		case domain.OIDCGrantTypeCIBA:
+			oidcGrantTypes[i] = app_pb.OIDCGrantType_OIDC_GRANT_TYPE_CIBA
 		}
 	}
 	return oidcGrantTypes
@@ -186,6 +202,8 @@ func OIDCGrantTypesToDomain(grantTypes []app_pb.OIDCGrantType) []domain.OIDCGran
 			oidcGrantTypes[i] = domain.OIDCGrantTypeDeviceCode
 		case app_pb.OIDCGrantType_OIDC_GRANT_TYPE_TOKEN_EXCHANGE:
 			oidcGrantTypes[i] = domain.OIDCGrantTypeTokenExchange
+		case app_pb.OIDCGrantType_OIDC_GRANT_TYPE_CIBA:
+			oidcGrantTypes[i] = domain.OIDCGrantTypeCIBA
 		}
 	}
 	return oidcGrantTypes
@@ -226,6 +244,10 @@ func OIDCAuthMethodTypeToPb(authType domain.OIDCAuthMethodType) app_pb.OIDCAuthM
 		return app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_NONE
 	case domain.OIDCAuthMethodTypePrivateKeyJWT:
 		return app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_PRIVATE_KEY_JWT
+	case domain.OIDCAuthMethodTypeTLSClientAuth:
+		return app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_TLS_CLIENT_AUTH
+	case domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth:
+		return app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_SELF_SIGNED_TLS_CLIENT_AUTH
 	default:
 		return app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_BASIC
 	}
@@ -241,6 +263,10 @@ func OIDCAuthMethodTypeToDomain(authType app_pb.OIDCAuthMethodType) domain.OIDCA
 		return domain.OIDCAuthMethodTypeNone
 	case app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_PRIVATE_KEY_JWT:
 		return domain.OIDCAuthMethodTypePrivateKeyJWT
+	case app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_TLS_CLIENT_AUTH:
+		return domain.OIDCAuthMethodTypeTLSClientAuth
+	case app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_SELF_SIGNED_TLS_CLIENT_AUTH:
+		return domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth
 	default:
 		return domain.OIDCAuthMethodTypeBasic
 	}
@@ -299,6 +325,10 @@ func APIAuthMethodeTypeToPb(methodType domain.APIAuthMethodType) app_pb.APIAuthM
 		return app_pb.APIAuthMethodType_API_AUTH_METHOD_TYPE_BASIC
 	case domain.APIAuthMethodTypePrivateKeyJWT:
 		return app_pb.APIAuthMethodType_API_AUTH_METHOD_TYPE_PRIVATE_KEY_JWT
+	case domain.APIAuthMethodTypeTLSClientAuth:
+		return app_pb.APIAuthMethodType_API_AUTH_METHOD_TYPE_TLS_CLIENT_AUTH
+	case domain.APIAuthMethodTypeSelfSignedTLSClientAuth:
+		return app_pb.APIAuthMethodType_API_AUTH_METHOD_TYPE_SELF_SIGNED_TLS_CLIENT_AUTH
 	default:
 		return app_pb.APIAuthMethodType_API_AUTH_METHOD_TYPE_BASIC
 	}
@@ -310,11 +340,101 @@ func APIAuthMethodTypeToDomain(authType app_pb.APIAuthMethodType) domain.APIAuth
 		return domain.APIAuthMethodTypeBasic
 	case app_pb.APIAuthMethodType_API_AUTH_METHOD_TYPE_PRIVATE_KEY_JWT:
 		return domain.APIAuthMethodTypePrivateKeyJWT
+	case app_pb.APIAuthMethodType_API_AUTH_METHOD_TYPE_TLS_CLIENT_AUTH:
+		return domain.APIAuthMethodTypeTLSClientAuth
+	case app_pb.APIAuthMethodType_API_AUTH_METHOD_TYPE_SELF_SIGNED_TLS_CLIENT_AUTH:
+		return domain.APIAuthMethodTypeSelfSignedTLSClientAuth
 	default:
 		return domain.APIAuthMethodTypeBasic
 	}
 }
 
+func SAMLNameIDFormatToPb(format domain.SAMLNameIDFormat) app_pb.SAMLAppNameIDFormat {
+	switch format {
+	case domain.SAMLNameIDFormatUnspecified:
+		return app_pb.SAMLAppNameIDFormat_SAML_APP_NAME_ID_FORMAT_UNSPECIFIED
+	case domain.SAMLNameIDFormatPersistent:
+		return app_pb.SAMLAppNameIDFormat_SAML_APP_NAME_ID_FORMAT_PERSISTENT
+	case domain.SAMLNameIDFormatTransient:
+		return app_pb.SAMLAppNameIDFormat_SAML_APP_NAME_ID_FORMAT_TRANSIENT
+	case domain.SAMLNameIDFormatEmailAddress:
+		fallthrough
+	default:
+		return app_pb.SAMLAppNameIDFormat_SAML_APP_NAME_ID_FORMAT_EMAIL_ADDRESS
+	}
+}
+
+func SAMLNameIDFormatToDomain(format app_pb.SAMLAppNameIDFormat) domain.SAMLNameIDFormat {
+	switch format {
+	case app_pb.SAMLAppNameIDFormat_SAML_APP_NAME_ID_FORMAT_UNSPECIFIED:
+		return domain.SAMLNameIDFormatUnspecified
+	case app_pb.SAMLAppNameIDFormat_SAML_APP_NAME_ID_FORMAT_PERSISTENT:
+		return domain.SAMLNameIDFormatPersistent
+	case app_pb.SAMLAppNameIDFormat_SAML_APP_NAME_ID_FORMAT_TRANSIENT:
+		return domain.SAMLNameIDFormatTransient
+	case app_pb.SAMLAppNameIDFormat_SAML_APP_NAME_ID_FORMAT_EMAIL_ADDRESS:
+		fallthrough
+	default:
+		return domain.SAMLNameIDFormatEmailAddress
+	}
+}
+
+func SAMLNameIDSourceToPb(source domain.SAMLNameIDSource) app_pb.SAMLNameIDSource {
+	switch source {
+	case domain.SAMLNameIDSourceEmail:
+		return app_pb.SAMLNameIDSource_SAML_NAME_ID_SOURCE_EMAIL
+	case domain.SAMLNameIDSourceUserID:
+		return app_pb.SAMLNameIDSource_SAML_NAME_ID_SOURCE_USER_ID
+	case domain.SAMLNameIDSourceLoginName:
+		fallthrough
+	default:
+		return app_pb.SAMLNameIDSource_SAML_NAME_ID_SOURCE_LOGIN_NAME
+	}
+}
+
+func SAMLNameIDSourceToDomain(source app_pb.SAMLNameIDSource) domain.SAMLNameIDSource {
+	switch source {
+	case app_pb.SAMLNameIDSource_SAML_NAME_ID_SOURCE_EMAIL:
+		return domain.SAMLNameIDSourceEmail
+	case app_pb.SAMLNameIDSource_SAML_NAME_ID_SOURCE_USER_ID:
+		return domain.SAMLNameIDSourceUserID
+	case app_pb.SAMLNameIDSource_SAML_NAME_ID_SOURCE_LOGIN_NAME:
+		fallthrough
+	default:
+		return domain.SAMLNameIDSourceLoginName
+	}
+}
+
+func SAMLSignatureAlgorithmToPb(algorithm domain.SAMLSignatureAlgorithm) app_pb.SAMLSignatureAlgorithm {
+	switch algorithm {
+	case domain.SAMLSignatureAlgorithmRSASHA1:
+		return app_pb.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA1
+	case domain.SAMLSignatureAlgorithmRSASHA256:
+		return app_pb.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA256
+	case domain.SAMLSignatureAlgorithmRSASHA512:
+		return app_pb.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA512
+	case domain.SAMLSignatureAlgorithmUnspecified:
+		fallthrough
+	default:
+		return app_pb.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_UNSPECIFIED
+	}
+}
+
+func SAMLSignatureAlgorithmToDomain(algorithm app_pb.SAMLSignatureAlgorithm) domain.SAMLSignatureAlgorithm {
+	switch algorithm {
+	case app_pb.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA1:
+		return domain.SAMLSignatureAlgorithmRSASHA1
+	case app_pb.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA256:
+		return domain.SAMLSignatureAlgorithmRSASHA256
+	case app_pb.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA512:
+		return domain.SAMLSignatureAlgorithmRSASHA512
+	case app_pb.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_UNSPECIFIED:
+		fallthrough
+	default:
+		return domain.SAMLSignatureAlgorithmUnspecified
+	}
+}
+
 func AppQueriesToModel(queries []*app_pb.AppQuery) (q []query.SearchQuery, err error) {
 	q = make([]query.SearchQuery, len(queries))
 	for i, query := range queries {
@@ -0,0 +1,48 @@
+package project
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	project_pb "github.com/zitadel/zitadel/pkg/grpc/project/v2"
+)
+
+func (s *Server) AddProjectAuthorizationDetailType(ctx context.Context, req *connect.Request[project_pb.AddProjectAuthorizationDetailTypeRequest]) (*connect.Response[project_pb.AddProjectAuthorizationDetailTypeResponse], error) {
+	details, err := s.command.AddProjectAuthorizationDetailType(ctx, req.Msg.GetProjectId(), "", req.Msg.GetType())
+	if err != nil {
+		return nil, err
+	}
+	var creationDate *timestamppb.Timestamp
+	if !details.EventDate.IsZero() {
+		creationDate = timestamppb.New(details.EventDate)
+	}
+	return connect.NewResponse(&project_pb.AddProjectAuthorizationDetailTypeResponse{
+		CreationDate: creationDate,
+	}), nil
+}
+
+func (s *Server) RemoveProjectAuthorizationDetailType(ctx context.Context, req *connect.Request[project_pb.RemoveProjectAuthorizationDetailTypeRequest]) (*connect.Response[project_pb.RemoveProjectAuthorizationDetailTypeResponse], error) {
+	details, err := s.command.RemoveProjectAuthorizationDetailType(ctx, req.Msg.GetProjectId(), "", req.Msg.GetType())
+	if err != nil {
+		return nil, err
+	}
+	var removalDate *timestamppb.Timestamp
+	if !details.EventDate.IsZero() {
+		removalDate = timestamppb.New(details.EventDate)
+	}
+	return connect.NewResponse(&project_pb.RemoveProjectAuthorizationDetailTypeResponse{
+		RemovalDate: removalDate,
+	}), nil
+}
+
+func (s *Server) ListProjectAuthorizationDetailTypes(ctx context.Context, req *connect.Request[project_pb.ListProjectAuthorizationDetailTypesRequest]) (*connect.Response[project_pb.ListProjectAuthorizationDetailTypesResponse], error) {
+	types, err := s.query.ProjectAuthorizationDetailTypes(ctx, req.Msg.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&project_pb.ListProjectAuthorizationDetailTypesResponse{
+		Types: types,
+	}), nil
+}
@@ -33,6 +33,9 @@ func authorize(ctx context.Context, req connect.AnyRequest, handler connect.Unar
 		return nil, connect.NewError(connect.CodeUnauthenticated, errors.New("auth header missing"))
 	}
 
+	if proof := req.Header().Get(http.DPoP); proof != "" {
+		authCtx = authz.WithDPoPRequest(authCtx, proof, req.HTTPMethod(), http.DomainContext(authCtx).Origin()+req.Spec().Procedure)
+	}
 	orgID, orgDomain := orgIDAndDomainFromRequest(req)
 	ctxSetter, err := authz.CheckUserAuthorization(authCtx, req, authToken, orgID, orgDomain, verifier, systemUserPermissions.RolePermissionMappings, authConfig.RolePermissionMappings, authOpt, req.Spec().Procedure)
 	if err != nil {
@@ -92,7 +92,7 @@ func Test_authorize(t *testing.T) {
 				req:     &mockReq[struct{}]{procedure: "/no/token/needed"},
 				handler: emptyMockHandler(&connect.Response[struct{}]{}, authz.CtxData{}),
 				verifier: func() authz.APITokenVerifier {
-					verifier := authz.StartAPITokenVerifier(&authzRepoMock{}, accessTokenOK, systemTokenNOK)
+					verifier := authz.StartAPITokenVerifier(&authzRepoMock{}, accessTokenOK, systemTokenNOK, nil)
 					verifier.RegisterServer("need", "need", authz.MethodMapping{})
 					return verifier
 				},
@@ -109,7 +109,7 @@ func Test_authorize(t *testing.T) {
 				req:     &mockReq[struct{}]{procedure: "/need/authentication"},
 				handler: emptyMockHandler(&connect.Response[struct{}]{}, authz.CtxData{}),
 				verifier: func() authz.APITokenVerifier {
-					verifier := authz.StartAPITokenVerifier(&authzRepoMock{}, accessTokenOK, systemTokenNOK)
+					verifier := authz.StartAPITokenVerifier(&authzRepoMock{}, accessTokenOK, systemTokenNOK, nil)
 					verifier.RegisterServer("need", "need", authz.MethodMapping{"/need/authentication": authz.Option{Permission: "authenticated"}})
 					return verifier
 				},
@@ -127,7 +127,7 @@ func Test_authorize(t *testing.T) {
 				req:     &mockReq[struct{}]{procedure: "/need/authentication", header: http.Header{"Authorization": []string{"wrong"}}},
 				handler: emptyMockHandler(&connect.Response[struct{}]{}, authz.CtxData{}),
 				verifier: func() authz.APITokenVerifier {
-					verifier := authz.StartAPITokenVerifier(&authzRepoMock{}, accessTokenOK, systemTokenNOK)
+					verifier := authz.StartAPITokenVerifier(&authzRepoMock{}, accessTokenOK, systemTokenNOK, nil)
 					verifier.RegisterServer("need", "need", authz.MethodMapping{"/need/authentication": authz.Option{Permission: "authenticated"}})
 					return verifier
 				},
@@ -149,7 +149,7 @@ func Test_authorize(t *testing.T) {
 					ResourceOwner: "org1",
 				}),
 				verifier: func() authz.APITokenVerifier {
-					verifier := authz.StartAPITokenVerifier(&authzRepoMock{}, accessTokenOK, systemTokenNOK)
+					verifier := authz.StartAPITokenVerifier(&authzRepoMock{}, accessTokenOK, systemTokenNOK, nil)
 					verifier.RegisterServer("need", "need", authz.MethodMapping{"/need/authentication": authz.Option{Permission: "authenticated"}})
 					return verifier
 				},
@@ -175,7 +175,7 @@ func Test_authorize(t *testing.T) {
 					ResourceOwner: "org1",
 				}),
 				verifier: func() authz.APITokenVerifier {
-					verifier := authz.StartAPITokenVerifier(&authzRepoMock{}, accessTokenOK, systemTokenNOK)
+					verifier := authz.StartAPITokenVerifier(&authzRepoMock{}, accessTokenOK, systemTokenNOK, nil)
 					verifier.RegisterServer("need", "need", authz.MethodMapping{"/need/authentication": authz.Option{Permission: "authenticated"}})
 					return verifier
 				},
@@ -197,7 +197,7 @@ func Test_authorize(t *testing.T) {
 					ResourceOwner: "org1",
 				}),
 				verifier: func() authz.APITokenVerifier {
-					verifier := authz.StartAPITokenVerifier(&authzRepoMock{}, accessTokenOK, systemTokenNOK)
+					verifier := authz.StartAPITokenVerifier(&authzRepoMock{}, accessTokenOK, systemTokenNOK, nil)
 					verifier.RegisterServer("need", "need", authz.MethodMapping{"/need/authentication": authz.Option{Permission: "to.do.something"}})
 					return verifier
 				},
@@ -224,7 +224,7 @@ func Test_authorize(t *testing.T) {
 					ResourceOwner: "org1",
 				}),
 				verifier: func() authz.APITokenVerifier {
-					verifier := authz.StartAPITokenVerifier(&authzRepoMock{}, accessTokenOK, systemTokenNOK)
+					verifier := authz.StartAPITokenVerifier(&authzRepoMock{}, accessTokenOK, systemTokenNOK, nil)
 					verifier.RegisterServer("need", "need", authz.MethodMapping{"/need/authentication": authz.Option{Permission: "to.do.something"}})
 					return verifier
 				},
@@ -252,7 +252,7 @@ func Test_authorize(t *testing.T) {
 							MemberType: authz.MemberTypeSystem,
 							Roles:      []string{"A_SYSTEM_ROLE"},
 						}}, "systemuser", nil
-					}))
+					}), nil)
 					verifier.RegisterServer("need", "need", authz.MethodMapping{"/need/authentication": authz.Option{Permission: "to.do.something"}})
 					return verifier
 				},
@@ -290,7 +290,7 @@ func Test_authorize(t *testing.T) {
 							MemberType: authz.MemberTypeSystem,
 							Roles:      []string{"A_SYSTEM_ROLE"},
 						}}, "systemuser", nil
-					}))
+					}), nil)
 					verifier.RegisterServer("need", "need", authz.MethodMapping{"/need/authentication": authz.Option{Permission: "to.do.something"}})
 					return verifier
 				},
@@ -0,0 +1,106 @@
+package connect_middleware
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/gerrors"
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/ratelimit"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	"github.com/zitadel/zitadel/pkg/grpc/session/v2"
+	session_v2beta "github.com/zitadel/zitadel/pkg/grpc/session/v2beta"
+)
+
+// RateLimitSessionUser returns the user of the session.
+type RateLimitSessionUser func(ctx context.Context, sessionID string) string
+
+// RateLimitInterceptor limits the requests using the API limits of the instance.
+// The requests are counted by instance, IP and client, which is identified by the sent access token,
+// as the interceptor runs before the authorization, so unauthorized requests are limited as well.
+// The checks of the session service are counted by the checked user as well.
+// The IP is only read from the X-Forwarded-For header if the request was sent by a trusted proxy.
+// Limited requests are responded with a resource exhausted error and a Retry-After header.
+func RateLimitInterceptor(limiter *ratelimit.Limiter, sessionUser RateLimitSessionUser, ignoreService ...string) connect.UnaryInterceptorFunc {
+	for idx, service := range ignoreService {
+		if !strings.HasPrefix(service, "/") {
+			ignoreService[idx] = "/" + service
+		}
+	}
+	return func(handler connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if limiter == nil {
+				return handler(ctx, req)
+			}
+			for _, service := range ignoreService {
+				if strings.HasPrefix(req.Spec().Procedure, service) {
+					return handler(ctx, req)
+				}
+			}
+			interceptorCtx, span := tracing.NewServerInterceptorSpan(ctx)
+
+			instance := authz.GetInstance(ctx)
+			retryAfter, allowed := limiter.Allow(interceptorCtx, instance, ratelimit.EndpointAPI,
+				ratelimit.Key{Type: ratelimit.KeyTypeInstance, Value: instance.InstanceID()},
+				ratelimit.Key{Type: ratelimit.KeyTypeIP, Value: rateLimitClientIP(ctx, limiter)},
+				ratelimit.Key{Type: ratelimit.KeyTypeClient, Value: req.Header().Get(http_util.Authorization)},
+				ratelimit.Key{Type: ratelimit.KeyTypeUsername, Value: rateLimitCheckedUser(interceptorCtx, req, sessionUser)},
+			)
+			if !allowed {
+				// the error is converted here, as the Retry-After header can only be returned as metadata of the connect error
+				err := translateError(ctx, zerrors.ThrowResourceExhausted(nil, "RATEL-Fz6Qm", "Errors.Limits.RateLimited"), getTranslator(ctx))
+				span.EndWithError(err)
+				connectErr := new(connect.Error)
+				if errors.As(gerrors.ZITADELToConnectError(ctx, err), &connectErr) {
+					connectErr.Meta().Set(http_util.RetryAfter, ratelimit.RetryAfter(retryAfter))
+					return nil, connectErr
+				}
+				return nil, err
+			}
+			span.End()
+			return handler(ctx, req)
+		}
+	}
+}
+
+func rateLimitClientIP(ctx context.Context, limiter *ratelimit.Limiter) string {
+	headers, _ := http_util.HeadersFromCtx(ctx)
+	return limiter.ClientIP(http_util.RemoteAddrFromCtx(ctx), headers.Values(http_util.ForwardedFor))
+}
+
+// rateLimitCheckedUser returns the user whose credentials are checked by a request of the session service.
+// The user is either set in the checks or is the user of the updated session.
+func rateLimitCheckedUser(ctx context.Context, req connect.AnyRequest, sessionUser RateLimitSessionUser) string {
+	var (
+		sessionID string
+		user      string
+	)
+	switch msg := req.Any().(type) {
+	case *session.CreateSessionRequest:
+		user = cmp.Or(msg.GetChecks().GetUser().GetUserId(), msg.GetChecks().GetUser().GetLoginName())
+	case *session.SetSessionRequest:
+		if msg.GetChecks() == nil {
+			return ""
+		}
+		sessionID = msg.GetSessionId()
+		user = cmp.Or(msg.GetChecks().GetUser().GetUserId(), msg.GetChecks().GetUser().GetLoginName())
+	case *session_v2beta.CreateSessionRequest:
+		user = cmp.Or(msg.GetChecks().GetUser().GetUserId(), msg.GetChecks().GetUser().GetLoginName())
+	case *session_v2beta.SetSessionRequest:
+		if msg.GetChecks() == nil {
+			return ""
+		}
+		sessionID = msg.GetSessionId()
+		user = cmp.Or(msg.GetChecks().GetUser().GetUserId(), msg.GetChecks().GetUser().GetLoginName())
+	}
+	if user != "" || sessionID == "" || sessionUser == nil {
+		return user
+	}
+	return sessionUser(ctx, sessionID)
+}
@@ -0,0 +1,82 @@
+package connect_middleware
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/pkg/grpc/session/v2"
+	"github.com/zitadel/zitadel/pkg/grpc/user/v2"
+)
+
+func Test_rateLimitCheckedUser(t *testing.T) {
+	sessionUser := func(_ context.Context, sessionID string) string {
+		return "user-of-" + sessionID
+	}
+	tests := []struct {
+		name        string
+		req         connect.AnyRequest
+		sessionUser RateLimitSessionUser
+		want        string
+	}{
+		{
+			name: "other request",
+			req:  connect.NewRequest(&user.GetUserByIDRequest{UserId: "user1"}),
+			want: "",
+		},
+		{
+			name: "create session, user id",
+			req: connect.NewRequest(&session.CreateSessionRequest{
+				Checks: &session.Checks{
+					User: &session.CheckUser{Search: &session.CheckUser_UserId{UserId: "user1"}},
+				},
+			}),
+			want: "user1",
+		},
+		{
+			name: "create session, login name",
+			req: connect.NewRequest(&session.CreateSessionRequest{
+				Checks: &session.Checks{
+					User: &session.CheckUser{Search: &session.CheckUser_LoginName{LoginName: "mini@mouse.com"}},
+				},
+			}),
+			want: "mini@mouse.com",
+		},
+		{
+			name:        "set session without checks",
+			req:         connect.NewRequest(&session.SetSessionRequest{SessionId: "session1"}),
+			sessionUser: sessionUser,
+			want:        "",
+		},
+		{
+			name: "set session, user of session",
+			req: connect.NewRequest(&session.SetSessionRequest{
+				SessionId: "session1",
+				Checks: &session.Checks{
+					Password: &session.CheckPassword{Password: "password"},
+				},
+			}),
+			sessionUser: sessionUser,
+			want:        "user-of-session1",
+		},
+		{
+			name: "set session, user of checks",
+			req: connect.NewRequest(&session.SetSessionRequest{
+				SessionId: "session1",
+				Checks: &session.Checks{
+					User:     &session.CheckUser{Search: &session.CheckUser_UserId{UserId: "user1"}},
+					Password: &session.CheckPassword{Password: "password"},
+				},
+			}),
+			sessionUser: sessionUser,
+			want:        "user1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, rateLimitCheckedUser(context.Background(), tt.req, tt.sessionUser))
+		})
+	}
+}
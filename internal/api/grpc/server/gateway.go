@@ -16,6 +16,7 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
@@ -79,9 +80,24 @@ var (
 			runtime.WithForwardResponseOption(responseForwarder),
 			runtime.WithRoutingErrorHandler(httpErrorHandler),
 			runtime.WithErrorHandler(errorHandler),
+			runtime.WithMetadata(dpopMetadata),
 		}
 	}
 
+	// dpopMetadata passes the DPoP proof with the method and URI of the HTTP request,
+	// as the proof is issued for the original request and not the gRPC call.
+	dpopMetadata = func(_ context.Context, r *http.Request) metadata.MD {
+		proof := r.Header.Get(http_utils.DPoP)
+		if proof == "" {
+			return nil
+		}
+		return metadata.Pairs(
+			http_utils.DPoP, proof,
+			http_utils.DPoPMethod, r.Method,
+			http_utils.DPoPURI, http_utils.DomainContext(r.Context()).Origin()+r.URL.Path,
+		)
+	}
+
 	headerMatcher = func(hostHeaders []string) runtime.HeaderMatcherFunc {
 		customHeaders = slices.Compact(append(customHeaders, hostHeaders...))
 		return func(header string) (string, bool) {
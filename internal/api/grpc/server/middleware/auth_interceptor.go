@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	net_http "net/http"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -33,6 +34,7 @@ func authorize(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
 		return nil, status.Error(codes.Unauthenticated, "auth header missing")
 	}
 
+	authCtx = withDPoPRequest(authCtx, info.FullMethod)
 	orgID, orgDomain := orgIDAndDomainFromRequest(authCtx, req)
 	ctxSetter, err := authz.CheckUserAuthorization(authCtx, req, authToken, orgID, orgDomain, verifier, systemUserPermissions.RolePermissionMappings, authConfig.RolePermissionMappings, authOpt, info.FullMethod)
 	if err != nil {
@@ -42,6 +44,20 @@ func authorize(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
 	return handler(ctxSetter(ctx), req)
 }
 
+// withDPoPRequest sets the DPoP proof of the request and the HTTP method and URI it must be issued for.
+// Requests passed by the gateway are verified against the original HTTP request.
+func withDPoPRequest(ctx context.Context, fullMethod string) context.Context {
+	proof := grpc_util.GetHeader(ctx, http.DPoP)
+	if proof == "" {
+		return ctx
+	}
+	method, uri := grpc_util.GetHeader(ctx, http.DPoPMethod), grpc_util.GetHeader(ctx, http.DPoPURI)
+	if method == "" || uri == "" {
+		method, uri = net_http.MethodPost, http.DomainContext(ctx).Origin()+fullMethod
+	}
+	return authz.WithDPoPRequest(ctx, proof, method, uri)
+}
+
 func orgIDAndDomainFromRequest(ctx context.Context, req interface{}) (id, domain string) {
 	orgID := grpc_util.GetHeader(ctx, http.ZitadelOrgID)
 	oz, ok := req.(OrganizationFromRequest)
@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	grpc_util "github.com/zitadel/zitadel/internal/api/grpc"
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/ratelimit"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// RateLimitInterceptor limits the requests using the API limits of the instance.
+// The requests are counted by instance, IP and client, which is identified by the sent access token,
+// as the interceptor runs before the authorization, so unauthorized requests are limited as well.
+// The IP is only read from the X-Forwarded-For header if the request was sent by a trusted proxy.
+// Limited requests are responded with a (translated) resource exhausted error and a retry-after header.
+func RateLimitInterceptor(limiter *ratelimit.Limiter, ignoreService ...string) grpc.UnaryServerInterceptor {
+	for idx, service := range ignoreService {
+		if !strings.HasPrefix(service, "/") {
+			ignoreService[idx] = "/" + service
+		}
+	}
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if limiter == nil {
+			return handler(ctx, req)
+		}
+		for _, service := range ignoreService {
+			if strings.HasPrefix(info.FullMethod, service) {
+				return handler(ctx, req)
+			}
+		}
+		interceptorCtx, span := tracing.NewServerInterceptorSpan(ctx)
+
+		instance := authz.GetInstance(ctx)
+		retryAfter, allowed := limiter.Allow(interceptorCtx, instance, ratelimit.EndpointAPI, rateLimitKeys(ctx, limiter, instance.InstanceID())...)
+		if !allowed {
+			_ = grpc.SetHeader(ctx, metadata.Pairs(http_util.RetryAfter, ratelimit.RetryAfter(retryAfter)))
+			// the interceptor runs before the translation handler
+			err := translateError(ctx, zerrors.ThrowResourceExhausted(nil, "RATEL-Fz6Qm", "Errors.Limits.RateLimited"), getTranslator(ctx))
+			span.EndWithError(err)
+			return nil, err
+		}
+		span.End()
+		return handler(ctx, req)
+	}
+}
+
+func rateLimitKeys(ctx context.Context, limiter *ratelimit.Limiter, instanceID string) []ratelimit.Key {
+	headers, _ := http_util.HeadersFromCtx(ctx)
+	return []ratelimit.Key{
+		{Type: ratelimit.KeyTypeInstance, Value: instanceID},
+		{Type: ratelimit.KeyTypeIP, Value: limiter.ClientIP(http_util.RemoteAddrFromCtx(ctx), headers.Values(http_util.ForwardedFor))},
+		{Type: ratelimit.KeyTypeClient, Value: grpc_util.GetAuthorizationHeader(ctx)},
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/zitadel/zitadel/internal/logstore"
 	"github.com/zitadel/zitadel/internal/logstore/record"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/ratelimit"
 	system_pb "github.com/zitadel/zitadel/pkg/grpc/system"
 )
 
@@ -66,6 +67,7 @@ func CreateServer(
 	externalDomain string,
 	tlsConfig *tls.Config,
 	accessSvc *logstore.Service[*record.AccessLog],
+	rateLimiter *ratelimit.Limiter,
 	targetEncAlg crypto.EncryptionAlgorithm,
 	translator *i18n.Translator,
 	httpClient *http.Client,
@@ -83,6 +85,7 @@ func CreateServer(
 				middleware.AccessStorageInterceptor(accessSvc),
 				middleware.ErrorHandler(),
 				middleware.LimitsInterceptor(system_pb.SystemService_ServiceDesc.ServiceName),
+				middleware.RateLimitInterceptor(rateLimiter, system_pb.SystemService_ServiceDesc.ServiceName),
 				middleware.AuthorizationInterceptor(verifier, systemAuthz, authConfig),
 				middleware.TranslationHandler(),
 				middleware.QuotaExhaustedInterceptor(accessSvc, system_pb.SystemService_ServiceDesc.ServiceName),
@@ -0,0 +1,59 @@
+package session
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	"github.com/zitadel/zitadel/pkg/grpc/session/v2"
+)
+
+func (s *Server) GetCIBARequest(ctx context.Context, req *connect.Request[session.GetCIBARequestRequest]) (*connect.Response[session.GetCIBARequestResponse], error) {
+	cibaRequest, err := s.query.CIBARequestByID(ctx, req.Msg.GetCibaRequestId())
+	if err != nil {
+		return nil, err
+	}
+	if cibaRequest.State != domain.CIBARequestStateInitiated {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "SESSION-Ciu7r", "Errors.CIBARequest.AlreadyHandled")
+	}
+	return connect.NewResponse(&session.GetCIBARequestResponse{
+		CibaRequest: cibaRequestToPb(cibaRequest),
+	}), nil
+}
+
+func (s *Server) AuthorizeOrDenyCIBARequest(ctx context.Context, req *connect.Request[session.AuthorizeOrDenyCIBARequestRequest]) (*connect.Response[session.AuthorizeOrDenyCIBARequestResponse], error) {
+	var (
+		details *domain.ObjectDetails
+		err     error
+	)
+	switch req.Msg.GetDecision().(type) {
+	case *session.AuthorizeOrDenyCIBARequestRequest_Session:
+		details, err = s.command.ApproveCIBARequestWithSession(ctx, req.Msg.GetCibaRequestId(), req.Msg.GetSession().GetSessionId(), req.Msg.GetSession().GetSessionToken())
+	case *session.AuthorizeOrDenyCIBARequestRequest_Deny:
+		details, err = s.command.DenyCIBARequest(ctx, req.Msg.GetCibaRequestId())
+	default:
+		return nil, zerrors.ThrowUnimplementedf(nil, "SESSION-Ciu8d", "decision oneOf %T in method AuthorizeOrDenyCIBARequest not implemented", req.Msg.GetDecision())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&session.AuthorizeOrDenyCIBARequestResponse{
+		Details: object.DomainToDetailsPb(details),
+	}), nil
+}
+
+func cibaRequestToPb(r *query.CIBARequestReadModel) *session.CIBARequest {
+	return &session.CIBARequest{
+		Id:             r.AggregateID,
+		ClientId:       r.ClientID,
+		UserId:         r.UserID,
+		Scope:          r.Scopes,
+		BindingMessage: r.BindingMessage,
+		ExpirationDate: timestamppb.New(r.Expires),
+	}
+}
@@ -104,6 +104,7 @@ func passwordComplexitySettingsToPb(current *query.PasswordComplexityPolicy) *se
 		RequiresNumber:    current.HasNumber,
 		RequiresSymbol:    current.HasSymbol,
 		ResourceOwnerType: isDefaultToResourceOwnerTypePb(current.IsDefault),
+		CheckBreached:     current.CheckBreached,
 	}
 }
 
@@ -178,6 +179,8 @@ func lockoutSettingsToPb(current *query.LockoutPolicy) *settings.LockoutSettings
 		MaxPasswordAttempts: current.MaxPasswordAttempts,
 		MaxOtpAttempts:      current.MaxOTPAttempts,
 		ResourceOwnerType:   isDefaultToResourceOwnerTypePb(current.IsDefault),
+		LockoutDuration:     durationpb.New(time.Duration(current.LockoutDuration)),
+		FailedAttemptDelay:  durationpb.New(time.Duration(current.FailedAttemptDelay)),
 	}
 }
 
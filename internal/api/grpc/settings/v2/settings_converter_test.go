@@ -228,12 +228,13 @@ func Test_multiFactorTypeToPb(t *testing.T) {
 
 func Test_passwordComplexitySettingsToPb(t *testing.T) {
 	arg := &query.PasswordComplexityPolicy{
-		MinLength:    12,
-		HasUppercase: true,
-		HasLowercase: true,
-		HasNumber:    true,
-		HasSymbol:    true,
-		IsDefault:    true,
+		MinLength:     12,
+		HasUppercase:  true,
+		HasLowercase:  true,
+		HasNumber:     true,
+		HasSymbol:     true,
+		CheckBreached: true,
+		IsDefault:     true,
 	}
 	want := &settings.PasswordComplexitySettings{
 		MinLength:         12,
@@ -242,6 +243,7 @@ func Test_passwordComplexitySettingsToPb(t *testing.T) {
 		RequiresNumber:    true,
 		RequiresSymbol:    true,
 		ResourceOwnerType: settings.ResourceOwnerType_RESOURCE_OWNER_TYPE_INSTANCE,
+		CheckBreached:     true,
 	}
 
 	got := passwordComplexitySettingsToPb(arg)
@@ -378,12 +380,16 @@ func Test_lockoutSettingsToPb(t *testing.T) {
 	arg := &query.LockoutPolicy{
 		MaxPasswordAttempts: 22,
 		MaxOTPAttempts:      22,
+		LockoutDuration:     database.Duration(time.Hour),
+		FailedAttemptDelay:  database.Duration(time.Second),
 		IsDefault:           true,
 	}
 	want := &settings.LockoutSettings{
 		MaxPasswordAttempts: 22,
 		MaxOtpAttempts:      22,
 		ResourceOwnerType:   settings.ResourceOwnerType_RESOURCE_OWNER_TYPE_INSTANCE,
+		LockoutDuration:     durationpb.New(time.Hour),
+		FailedAttemptDelay:  durationpb.New(time.Second),
 	}
 	got := lockoutSettingsToPb(arg)
 	grpc.AllFieldsSet(t, got.ProtoReflect(), ignoreTypes...)
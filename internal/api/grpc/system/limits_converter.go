@@ -4,6 +4,7 @@ import (
 	"github.com/muhlemmer/gu"
 
 	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/ratelimit"
 	"github.com/zitadel/zitadel/pkg/grpc/system"
 )
 
@@ -13,9 +14,36 @@ func setInstanceLimitsPbToCommand(req *system.SetLimitsRequest) *command.SetLimi
 		setLimits.AuditLogRetention = gu.Ptr(req.AuditLogRetention.AsDuration())
 	}
 	setLimits.Block = req.Block
+	if req.ArchiveHorizon != nil {
+		setLimits.ArchiveHorizon = gu.Ptr(req.ArchiveHorizon.AsDuration())
+	}
+	if req.RateLimits != nil {
+		setLimits.RateLimits = &ratelimit.Limits{
+			Login: rateLimitPolicyPbToRateLimit(req.RateLimits.GetLogin()),
+			Token: rateLimitPolicyPbToRateLimit(req.RateLimits.GetToken()),
+			API:   rateLimitPolicyPbToRateLimit(req.RateLimits.GetApi()),
+		}
+	}
 	return setLimits
 }
 
+func rateLimitPolicyPbToRateLimit(policy *system.RateLimitPolicy) ratelimit.Policy {
+	return ratelimit.Policy{
+		Instance: rateLimitPbToRateLimit(policy.GetInstance()),
+		Client:   rateLimitPbToRateLimit(policy.GetClient()),
+		IP:       rateLimitPbToRateLimit(policy.GetIp()),
+		Username: rateLimitPbToRateLimit(policy.GetUsername()),
+	}
+}
+
+func rateLimitPbToRateLimit(limit *system.RateLimit) ratelimit.Limit {
+	return ratelimit.Limit{
+		Requests: limit.GetRequests(),
+		Period:   limit.GetPeriod().AsDuration(),
+		Burst:    limit.GetBurst(),
+	}
+}
+
 func bulkSetInstanceLimitsPbToCommand(req *system.BulkSetLimitsRequest) []*command.SetInstanceLimitsBulk {
 	cmds := make([]*command.SetInstanceLimitsBulk, len(req.Limits))
 	for i := range req.Limits {
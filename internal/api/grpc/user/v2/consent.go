@@ -0,0 +1,45 @@
+package user
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/user/v2"
+)
+
+func (s *Server) ListUserConsents(ctx context.Context, req *connect.Request[user.ListUserConsentsRequest]) (*connect.Response[user.ListUserConsentsResponse], error) {
+	consents, err := s.query.ListUserConsents(ctx, req.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&user.ListUserConsentsResponse{
+		Consents: userConsentsToPb(consents),
+	}), nil
+}
+
+func (s *Server) RevokeUserConsent(ctx context.Context, req *connect.Request[user.RevokeUserConsentRequest]) (*connect.Response[user.RevokeUserConsentResponse], error) {
+	details, err := s.command.RevokeUserConsent(ctx, req.Msg.GetUserId(), req.Msg.GetClientId())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&user.RevokeUserConsentResponse{
+		Details: object.DomainToDetailsPb(details),
+	}), nil
+}
+
+func userConsentsToPb(consents []*query.UserConsent) []*user.UserConsent {
+	pb := make([]*user.UserConsent, len(consents))
+	for i, consent := range consents {
+		pb[i] = &user.UserConsent{
+			ClientId:     consent.ClientID,
+			Scopes:       consent.Scope,
+			CreationDate: timestamppb.New(consent.CreationDate),
+			ChangeDate:   timestamppb.New(consent.ChangeDate),
+		}
+	}
+	return pb
+}
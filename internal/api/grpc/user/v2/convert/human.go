@@ -45,6 +45,32 @@ func humanToPb(userQ *query.Human, assetPrefix, owner string) *user.HumanUser {
 	}
 }
 
+func EmailUndeliverableToPb(undeliverable *query.EmailUndeliverable) *user.EmailUndeliverable {
+	if undeliverable == nil {
+		return nil
+	}
+	return &user.EmailUndeliverable{
+		Reason:       emailUndeliverableReasonToPb(undeliverable.Reason),
+		Description:  undeliverable.Description,
+		ProviderId:   undeliverable.ProviderID,
+		DeliveryId:   undeliverable.DeliveryID,
+		ReportedDate: timestamppb.New(undeliverable.ChangeDate),
+	}
+}
+
+func emailUndeliverableReasonToPb(reason domain.EmailUndeliverableReason) user.EmailUndeliverableReason {
+	switch reason {
+	case domain.EmailUndeliverableReasonBounce:
+		return user.EmailUndeliverableReason_EMAIL_UNDELIVERABLE_REASON_BOUNCE
+	case domain.EmailUndeliverableReasonComplaint:
+		return user.EmailUndeliverableReason_EMAIL_UNDELIVERABLE_REASON_COMPLAINT
+	case domain.EmailUndeliverableReasonUnspecified:
+		return user.EmailUndeliverableReason_EMAIL_UNDELIVERABLE_REASON_UNSPECIFIED
+	default:
+		return user.EmailUndeliverableReason_EMAIL_UNDELIVERABLE_REASON_UNSPECIFIED
+	}
+}
+
 func genderToPb(gender domain.Gender) user.Gender {
 	switch gender {
 	case domain.GenderDiverse:
@@ -177,3 +177,60 @@ func Test_humanToPb(t *testing.T) {
 		})
 	}
 }
+
+func Test_EmailUndeliverableToPb(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	tt := []struct {
+		name     string
+		input    *query.EmailUndeliverable
+		expected *user.EmailUndeliverable
+	}{
+		{
+			name: "bounce",
+			input: &query.EmailUndeliverable{
+				ChangeDate:  now,
+				Email:       "email@example.com",
+				Reason:      domain.EmailUndeliverableReasonBounce,
+				Description: "smtp; 550 5.1.1 user unknown",
+				ProviderID:  "provider-id",
+				DeliveryID:  "delivery-id",
+			},
+			expected: &user.EmailUndeliverable{
+				Reason:       user.EmailUndeliverableReason_EMAIL_UNDELIVERABLE_REASON_BOUNCE,
+				Description:  "smtp; 550 5.1.1 user unknown",
+				ProviderId:   "provider-id",
+				DeliveryId:   "delivery-id",
+				ReportedDate: timestamppb.New(now),
+			},
+		},
+		{
+			name: "complaint",
+			input: &query.EmailUndeliverable{
+				ChangeDate: now,
+				Email:      "email@example.com",
+				Reason:     domain.EmailUndeliverableReasonComplaint,
+				ProviderID: "provider-id",
+			},
+			expected: &user.EmailUndeliverable{
+				Reason:       user.EmailUndeliverableReason_EMAIL_UNDELIVERABLE_REASON_COMPLAINT,
+				ProviderId:   "provider-id",
+				ReportedDate: timestamppb.New(now),
+			},
+		},
+		{
+			name:     "nil input",
+			input:    nil,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := EmailUndeliverableToPb(tc.input)
+			require.Equal(t, tc.expected, got)
+		})
+	}
+}
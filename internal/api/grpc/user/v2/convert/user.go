@@ -1,6 +1,8 @@
 package convert
 
 import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query"
@@ -38,6 +40,11 @@ func UserToPb(userQ *query.User, assetPrefix string) *user.User {
 		return nil
 	}
 
+	var lockedUntil *timestamppb.Timestamp
+	if userQ.LockedUntil != nil {
+		lockedUntil = timestamppb.New(*userQ.LockedUntil)
+	}
+
 	return &user.User{
 		UserId: userQ.ID,
 		Details: object.DomainToDetailsPb(&domain.ObjectDetails{
@@ -51,6 +58,7 @@ func UserToPb(userQ *query.User, assetPrefix string) *user.User {
 		LoginNames:         userQ.LoginNames,
 		PreferredLoginName: userQ.PreferredLoginName,
 		Type:               userTypeToPb(userQ, assetPrefix),
+		LockedUntil:        lockedUntil,
 	}
 }
 
@@ -0,0 +1,144 @@
+package user
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/filter/v2"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	"github.com/zitadel/zitadel/pkg/grpc/user/v2"
+)
+
+func (s *Server) ListNotificationHistory(ctx context.Context, req *connect.Request[user.ListNotificationHistoryRequest]) (*connect.Response[user.ListNotificationHistoryResponse], error) {
+	queries, err := s.listNotificationHistoryRequestToModel(req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.query.SearchUserNotificationHistory(ctx, req.Msg.GetUserId(), queries, s.checkPermission)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&user.ListNotificationHistoryResponse{
+		Result:     sentNotificationsToPb(res.Notifications),
+		Pagination: filter.QueryToPaginationPb(queries.SearchRequest, res.SearchResponse),
+	}), nil
+}
+
+func (s *Server) listNotificationHistoryRequestToModel(req *user.ListNotificationHistoryRequest) (*query.NotificationHistorySearchQueries, error) {
+	offset, limit, asc, err := filter.PaginationPbToQuery(s.systemDefaults, req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+	queries, err := notificationFiltersToQuery(req.GetFilters())
+	if err != nil {
+		return nil, err
+	}
+	return &query.NotificationHistorySearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: query.NotificationHistoryColumnCreationDate,
+		},
+		Queries: queries,
+	}, nil
+}
+
+func notificationFiltersToQuery(filters []*user.NotificationSearchFilter) (_ []query.SearchQuery, err error) {
+	q := make([]query.SearchQuery, len(filters))
+	for i, filter := range filters {
+		q[i], err = notificationFilterToQuery(filter)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func notificationFilterToQuery(filter *user.NotificationSearchFilter) (query.SearchQuery, error) {
+	switch q := filter.GetFilter().(type) {
+	case *user.NotificationSearchFilter_ChannelFilter:
+		return query.NewNotificationHistoryNotificationTypeSearchQuery(notificationChannelToDomain(q.ChannelFilter.GetChannel()))
+	case *user.NotificationSearchFilter_StateFilter:
+		return query.NewNotificationHistoryStateSearchQuery(notificationStateToDomain(q.StateFilter.GetState()))
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "GRPC-Nh4st0", "List.Query.Invalid")
+	}
+}
+
+func sentNotificationsToPb(notifications []*query.SentNotification) []*user.SentNotification {
+	result := make([]*user.SentNotification, len(notifications))
+	for i, notification := range notifications {
+		result[i] = &user.SentNotification{
+			Id:                  notification.ID,
+			CreationDate:        timestamppb.New(notification.CreationDate),
+			ChangeDate:          timestamppb.New(notification.EventDate),
+			TriggeringEventType: notification.TriggeringEventType,
+			MessageType:         notification.MessageType,
+			Channel:             notificationChannelToPb(notification.NotificationType),
+			ProviderId:          notification.ProviderID,
+			DeliveryId:          notification.DeliveryID,
+			State:               notificationStateToPb(notification.State),
+			Attempts:            int32(notification.Attempts),
+			LastError:           notification.LastError,
+		}
+	}
+	return result
+}
+
+func notificationChannelToPb(notificationType domain.NotificationType) user.NotificationChannel {
+	switch notificationType {
+	case domain.NotificationTypeEmail:
+		return user.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL
+	case domain.NotificationTypeSms:
+		return user.NotificationChannel_NOTIFICATION_CHANNEL_SMS
+	default:
+		return user.NotificationChannel_NOTIFICATION_CHANNEL_UNSPECIFIED
+	}
+}
+
+func notificationChannelToDomain(channel user.NotificationChannel) domain.NotificationType {
+	switch channel {
+	case user.NotificationChannel_NOTIFICATION_CHANNEL_SMS:
+		return domain.NotificationTypeSms
+	case user.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL,
+		user.NotificationChannel_NOTIFICATION_CHANNEL_UNSPECIFIED:
+		return domain.NotificationTypeEmail
+	default:
+		return domain.NotificationTypeEmail
+	}
+}
+
+func notificationStateToPb(state domain.NotificationDeliveryState) user.NotificationState {
+	switch state {
+	case domain.NotificationDeliveryStateDelivered:
+		return user.NotificationState_NOTIFICATION_STATE_DELIVERED
+	case domain.NotificationDeliveryStateRetrying:
+		return user.NotificationState_NOTIFICATION_STATE_RETRYING
+	case domain.NotificationDeliveryStateFailed:
+		return user.NotificationState_NOTIFICATION_STATE_FAILED
+	case domain.NotificationDeliveryStateUnspecified:
+		return user.NotificationState_NOTIFICATION_STATE_UNSPECIFIED
+	default:
+		return user.NotificationState_NOTIFICATION_STATE_UNSPECIFIED
+	}
+}
+
+func notificationStateToDomain(state user.NotificationState) domain.NotificationDeliveryState {
+	switch state {
+	case user.NotificationState_NOTIFICATION_STATE_DELIVERED:
+		return domain.NotificationDeliveryStateDelivered
+	case user.NotificationState_NOTIFICATION_STATE_RETRYING:
+		return domain.NotificationDeliveryStateRetrying
+	case user.NotificationState_NOTIFICATION_STATE_FAILED:
+		return domain.NotificationDeliveryStateFailed
+	case user.NotificationState_NOTIFICATION_STATE_UNSPECIFIED:
+		return domain.NotificationDeliveryStateUnspecified
+	default:
+		return domain.NotificationDeliveryStateUnspecified
+	}
+}
@@ -2,12 +2,14 @@ package user
 
 import (
 	"context"
+	"strings"
 
 	"connectrpc.com/connect"
 
 	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
 	"github.com/zitadel/zitadel/internal/api/grpc/user/v2/convert"
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
 	"github.com/zitadel/zitadel/pkg/grpc/user/v2"
 )
 
@@ -16,6 +18,13 @@ func (s *Server) GetUserByID(ctx context.Context, req *connect.Request[user.GetU
 	if err != nil {
 		return nil, err
 	}
+	pbUser := convert.UserToPb(resp, s.assetAPIPrefix(ctx))
+	if email := pbUser.GetHuman().GetEmail(); email != nil {
+		email.Undeliverable, err = s.emailUndeliverable(ctx, resp.ID, email.GetEmail())
+		if err != nil {
+			return nil, err
+		}
+	}
 	return connect.NewResponse(&user.GetUserByIDResponse{
 		Details: object.DomainToDetailsPb(&domain.ObjectDetails{
 			Sequence:      resp.Sequence,
@@ -23,10 +32,26 @@ func (s *Server) GetUserByID(ctx context.Context, req *connect.Request[user.GetU
 			EventDate:     resp.ChangeDate,
 			ResourceOwner: resp.ResourceOwner,
 		}),
-		User: convert.UserToPb(resp, s.assetAPIPrefix(ctx)),
+		User: pbUser,
 	}), nil
 }
 
+// emailUndeliverable returns the report of the email provider, if the current email address of the user is undeliverable.
+func (s *Server) emailUndeliverable(ctx context.Context, userID, email string) (*user.EmailUndeliverable, error) {
+	undeliverable, err := s.query.EmailUndeliverableByUserID(ctx, userID)
+	if zerrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	// the projection might not yet reflect a changed email address
+	if !strings.EqualFold(string(undeliverable.Email), email) {
+		return nil, nil
+	}
+	return convert.EmailUndeliverableToPb(undeliverable), nil
+}
+
 func (s *Server) ListUsers(ctx context.Context, req *connect.Request[user.ListUsersRequest]) (*connect.Response[user.ListUsersResponse], error) {
 	queries, err := convert.ListUsersRequestToModel(req.Msg)
 	if err != nil {
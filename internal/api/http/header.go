@@ -19,6 +19,7 @@ const (
 	ContentLocation        = "content-location"
 	Expires                = "expires"
 	Location               = "location"
+	RetryAfter             = "retry-after"
 	Origin                 = "origin"
 	Pragma                 = "pragma"
 	UserAgentHeader        = "user-agent"
@@ -54,6 +55,13 @@ const (
 
 	ZitadelOrgID = "x-zitadel-orgid"
 
+	// DPoP contains the DPoP proof of a request (RFC 9449).
+	DPoP = "dpop"
+	// DPoPMethod and DPoPURI are set by the gateway to the HTTP method and URI of the original request,
+	// which the DPoP proof is issued for.
+	DPoPMethod = "x-dpop-method"
+	DPoPURI    = "x-dpop-uri"
+
 	OrgIdInPathVariableName = "orgId"
 	OrgIdInPathVariable     = "{" + OrgIdInPathVariableName + "}"
 )
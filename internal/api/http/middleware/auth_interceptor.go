@@ -77,6 +77,9 @@ func authorize(r *http.Request, verifier authz.APITokenVerifier, systemAuthConfi
 	if authToken == "" {
 		return nil, zerrors.ThrowUnauthenticated(nil, "AUT-1179", "auth header missing")
 	}
+	if proof := r.Header.Get(http_util.DPoP); proof != "" {
+		authCtx = authz.WithDPoPRequest(authCtx, proof, r.Method, http_util.DomainContext(authCtx).Origin()+r.URL.Path)
+	}
 
 	ctxSetter, err := authz.CheckUserAuthorization(authCtx, &httpReq{}, authToken, http_util.GetOrgID(r), "", verifier, systemAuthConfig.RolePermissionMappings, authConfig.RolePermissionMappings, authOpt, r.RequestURI)
 	if err != nil {
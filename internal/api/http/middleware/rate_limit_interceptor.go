@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/ratelimit"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+// rateLimitUsernameFields are the form fields of the login UI, which contain the login name of the user.
+var rateLimitUsernameFields = []string{"loginName", "ldapusername"}
+
+// RateLimitUser returns the user whose credentials are checked by the request,
+// e.g. the user of the auth request a password is submitted for.
+// The form of the request is already parsed.
+type RateLimitUser func(r *http.Request) string
+
+// RateLimitHandler limits the POST requests to the provided paths using the limits of the endpoint.
+// If no paths are provided, all POST requests are limited.
+// The requests are counted by instance, IP, client (client_id or basic auth username),
+// login name and the user returned by the optional user func.
+// The IP is only read from the X-Forwarded-For header if the request was sent by a trusted proxy.
+// Limited requests are responded with http.StatusTooManyRequests and a Retry-After header.
+// The handler must be called after the instance is set on the context.
+func RateLimitHandler(limiter *ratelimit.Limiter, endpoint ratelimit.Endpoint, user RateLimitUser, paths ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if limiter == nil {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost || (len(paths) > 0 && !slices.Contains(paths, r.URL.Path)) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			ctx, span := tracing.NewNamedSpan(r.Context(), "checkRateLimit")
+			instance := authz.GetInstance(ctx)
+			retryAfter, allowed := limiter.Allow(ctx, instance, endpoint, rateLimitKeys(r, limiter, user, instance.InstanceID())...)
+			span.End()
+			if !allowed {
+				w.Header().Set(http_utils.RetryAfter, ratelimit.RetryAfter(retryAfter))
+				http.Error(w, "Too many requests, please try again later.", http.StatusTooManyRequests)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func rateLimitKeys(r *http.Request, limiter *ratelimit.Limiter, user RateLimitUser, instanceID string) []ratelimit.Key {
+	keys := []ratelimit.Key{
+		{Type: ratelimit.KeyTypeInstance, Value: instanceID},
+		{Type: ratelimit.KeyTypeIP, Value: limiter.ClientIP(r.RemoteAddr, r.Header.Values(http_utils.ForwardedFor))},
+	}
+	// the body can only be read once, the handlers use the form parsed here
+	if err := r.ParseForm(); err != nil {
+		return keys
+	}
+	clientID := r.PostForm.Get("client_id")
+	if clientID == "" {
+		clientID, _, _ = r.BasicAuth()
+	}
+	keys = append(keys, ratelimit.Key{Type: ratelimit.KeyTypeClient, Value: clientID})
+	for _, field := range rateLimitUsernameFields {
+		if username := r.PostForm.Get(field); username != "" {
+			keys = append(keys, ratelimit.Key{Type: ratelimit.KeyTypeUsername, Value: username})
+		}
+	}
+	if user != nil {
+		keys = append(keys, ratelimit.Key{Type: ratelimit.KeyTypeUsername, Value: user(r)})
+	}
+	return keys
+}
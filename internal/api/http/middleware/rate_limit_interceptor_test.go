@@ -0,0 +1,151 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/cache"
+	"github.com/zitadel/zitadel/internal/cache/connector/gomap"
+	"github.com/zitadel/zitadel/internal/ratelimit"
+)
+
+func Test_RateLimitHandler(t *testing.T) {
+	limiter := func(policy ratelimit.Policy) *ratelimit.Limiter {
+		limiter, err := ratelimit.NewLimiter(
+			&ratelimit.Config{
+				Enabled:        true,
+				TrustedProxies: []string{"10.0.0.0/8"},
+				Defaults: ratelimit.Limits{
+					Token: policy,
+				},
+			},
+			gomap.NewCache[ratelimit.Index, string, *ratelimit.Bucket](context.Background(), []ratelimit.Index{ratelimit.IndexKey}, cache.Config{}),
+		)
+		require.NoError(t, err)
+		return limiter
+	}
+	clientLimit := ratelimit.Policy{Client: ratelimit.Limit{Requests: 1, Period: time.Minute}}
+	type request struct {
+		method       string
+		path         string
+		form         url.Values
+		basicAuth    string
+		remoteAddr   string
+		forwardedFor string
+		wantStatus   int
+	}
+	tests := []struct {
+		name     string
+		limiter  *ratelimit.Limiter
+		user     RateLimitUser
+		requests []request
+	}{
+		{
+			name:    "disabled",
+			limiter: nil,
+			requests: []request{
+				{method: http.MethodPost, path: "/oauth/v2/token", form: url.Values{"client_id": {"client"}}, wantStatus: http.StatusOK},
+				{method: http.MethodPost, path: "/oauth/v2/token", form: url.Values{"client_id": {"client"}}, wantStatus: http.StatusOK},
+			},
+		},
+		{
+			name:    "client id limited",
+			limiter: limiter(clientLimit),
+			requests: []request{
+				{method: http.MethodPost, path: "/oauth/v2/token", form: url.Values{"client_id": {"client"}}, wantStatus: http.StatusOK},
+				{method: http.MethodPost, path: "/oauth/v2/token", form: url.Values{"client_id": {"client"}}, wantStatus: http.StatusTooManyRequests},
+				{method: http.MethodPost, path: "/oauth/v2/token", form: url.Values{"client_id": {"other"}}, wantStatus: http.StatusOK},
+			},
+		},
+		{
+			name:    "basic auth limited",
+			limiter: limiter(clientLimit),
+			requests: []request{
+				{method: http.MethodPost, path: "/oauth/v2/token", basicAuth: "client", wantStatus: http.StatusOK},
+				{method: http.MethodPost, path: "/oauth/v2/token", basicAuth: "client", wantStatus: http.StatusTooManyRequests},
+			},
+		},
+		{
+			name:    "other paths and methods ignored",
+			limiter: limiter(clientLimit),
+			requests: []request{
+				{method: http.MethodPost, path: "/oauth/v2/token", form: url.Values{"client_id": {"client"}}, wantStatus: http.StatusOK},
+				{method: http.MethodPost, path: "/oauth/v2/introspect", form: url.Values{"client_id": {"client"}}, wantStatus: http.StatusOK},
+				{method: http.MethodGet, path: "/oauth/v2/token", wantStatus: http.StatusOK},
+			},
+		},
+		{
+			name:    "forwarded ip of untrusted remote ignored",
+			limiter: limiter(ratelimit.Policy{IP: ratelimit.Limit{Requests: 1, Period: time.Minute}}),
+			requests: []request{
+				{method: http.MethodPost, path: "/oauth/v2/token", remoteAddr: "1.2.3.4:1234", forwardedFor: "5.5.5.5", wantStatus: http.StatusOK},
+				{method: http.MethodPost, path: "/oauth/v2/token", remoteAddr: "1.2.3.4:1234", forwardedFor: "6.6.6.6", wantStatus: http.StatusTooManyRequests},
+			},
+		},
+		{
+			name:    "forwarded ip of trusted proxy used",
+			limiter: limiter(ratelimit.Policy{IP: ratelimit.Limit{Requests: 1, Period: time.Minute}}),
+			requests: []request{
+				{method: http.MethodPost, path: "/oauth/v2/token", remoteAddr: "10.0.0.1:1234", forwardedFor: "5.5.5.5", wantStatus: http.StatusOK},
+				{method: http.MethodPost, path: "/oauth/v2/token", remoteAddr: "10.0.0.1:1234", forwardedFor: "6.6.6.6", wantStatus: http.StatusOK},
+				{method: http.MethodPost, path: "/oauth/v2/token", remoteAddr: "10.0.0.2:1234", forwardedFor: "7.7.7.7, 5.5.5.5", wantStatus: http.StatusTooManyRequests},
+			},
+		},
+		{
+			name:    "user limited",
+			limiter: limiter(ratelimit.Policy{Username: ratelimit.Limit{Requests: 1, Period: time.Minute}}),
+			user: func(r *http.Request) string {
+				return "user-" + r.PostForm.Get("authRequestID")[:1]
+			},
+			requests: []request{
+				{method: http.MethodPost, path: "/oauth/v2/token", form: url.Values{"authRequestID": {"1a"}}, wantStatus: http.StatusOK},
+				{method: http.MethodPost, path: "/oauth/v2/token", form: url.Values{"authRequestID": {"1b"}}, wantStatus: http.StatusTooManyRequests},
+				{method: http.MethodPost, path: "/oauth/v2/token", form: url.Values{"authRequestID": {"2a"}}, wantStatus: http.StatusOK},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotClientID string
+			handler := RateLimitHandler(tt.limiter, ratelimit.EndpointToken, tt.user, "/oauth/v2/token")(
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					gotClientID = r.PostFormValue("client_id")
+				}),
+			)
+			for i, req := range tt.requests {
+				r := httptest.NewRequest(req.method, req.path, strings.NewReader(req.form.Encode()))
+				r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+				if req.basicAuth != "" {
+					r.SetBasicAuth(req.basicAuth, "secret")
+				}
+				if req.remoteAddr != "" {
+					r.RemoteAddr = req.remoteAddr
+				}
+				if req.forwardedFor != "" {
+					r.Header.Set("X-Forwarded-For", req.forwardedFor)
+				}
+				r = r.WithContext(authz.WithInstanceID(r.Context(), "instanceID"))
+				recorder := httptest.NewRecorder()
+				handler.ServeHTTP(recorder, r)
+				assert.Equal(t, req.wantStatus, recorder.Code, "request %d", i)
+				if req.wantStatus == http.StatusTooManyRequests {
+					assert.Equal(t, "60", recorder.Header().Get("Retry-After"), "request %d", i)
+					continue
+				}
+				// the form must still be available to the handler
+				if req.method == http.MethodPost {
+					assert.Equal(t, req.form.Get("client_id"), gotClientID, "request %d", i)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package http
+
+import (
+	"net/netip"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// TrustedProxies are the reverse proxies in front of ZITADEL,
+// whose forwarded headers (e.g. X-Forwarded-For or a client certificate) are trusted.
+type TrustedProxies []netip.Prefix
+
+// ParseTrustedProxies parses the addresses (IPs or CIDRs) of the trusted proxies.
+func ParseTrustedProxies(proxies []string) (TrustedProxies, error) {
+	prefixes := make(TrustedProxies, len(proxies))
+	for i, proxy := range proxies {
+		prefix, err := netip.ParsePrefix(proxy)
+		if err != nil {
+			addr, addrErr := netip.ParseAddr(proxy)
+			if addrErr != nil {
+				return nil, zerrors.ThrowInvalidArgumentf(err, "HTTP-Tp3xq", "invalid trusted proxy %s", proxy)
+			}
+			prefix = netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen())
+		}
+		prefixes[i] = prefix.Masked()
+	}
+	return prefixes, nil
+}
+
+// Contains reports whether the IP belongs to one of the trusted proxies.
+func (p TrustedProxies) Contains(ip netip.Addr) bool {
+	for _, proxy := range p {
+		if proxy.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseIP parses an IP with or without port, IPv4-mapped IPv6 addresses are returned as IPv4.
+func ParseIP(addr string) (netip.Addr, bool) {
+	if addrPort, err := netip.ParseAddrPort(addr); err == nil {
+		return addrPort.Addr().Unmap(), true
+	}
+	ip, err := netip.ParseAddr(addr)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return ip.Unmap(), true
+}
@@ -0,0 +1,156 @@
+package http
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestParseTrustedProxies(t *testing.T) {
+	tests := []struct {
+		name    string
+		proxies []string
+		want    TrustedProxies
+		wantErr func(error) bool
+	}{
+		{
+			name: "none",
+			want: TrustedProxies{},
+		},
+		{
+			name:    "ips and cidrs",
+			proxies: []string{"10.0.0.0/8", "192.168.1.1", "::1", "::ffff:172.16.0.1", "10.1.2.3/16"},
+			want: TrustedProxies{
+				netip.MustParsePrefix("10.0.0.0/8"),
+				netip.MustParsePrefix("192.168.1.1/32"),
+				netip.MustParsePrefix("::1/128"),
+				netip.MustParsePrefix("172.16.0.1/32"),
+				netip.MustParsePrefix("10.1.0.0/16"),
+			},
+		},
+		{
+			name:    "hostname",
+			proxies: []string{"10.0.0.0/8", "proxy.local"},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTrustedProxies(tt.proxies)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "got wrong err: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTrustedProxies_Contains(t *testing.T) {
+	proxies, err := ParseTrustedProxies([]string{"10.0.0.0/8", "192.168.1.1", "::1"})
+	require.NoError(t, err)
+	tests := []struct {
+		name    string
+		proxies TrustedProxies
+		addr    string
+		want    bool
+	}{
+		{
+			name:    "no proxies",
+			proxies: nil,
+			addr:    "10.1.2.3",
+			want:    false,
+		},
+		{
+			name:    "in cidr",
+			proxies: proxies,
+			addr:    "10.1.2.3:1234",
+			want:    true,
+		},
+		{
+			name:    "ip",
+			proxies: proxies,
+			addr:    "192.168.1.1",
+			want:    true,
+		},
+		{
+			name:    "ipv4-mapped ipv6",
+			proxies: proxies,
+			addr:    "[::ffff:10.1.2.3]:1234",
+			want:    true,
+		},
+		{
+			name:    "ipv6",
+			proxies: proxies,
+			addr:    "[::1]:1234",
+			want:    true,
+		},
+		{
+			name:    "untrusted",
+			proxies: proxies,
+			addr:    "192.168.1.2:1234",
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ip, ok := ParseIP(tt.addr)
+			require.True(t, ok)
+			assert.Equal(t, tt.want, tt.proxies.Contains(ip))
+		})
+	}
+}
+
+func TestParseIP(t *testing.T) {
+	tests := []struct {
+		name   string
+		addr   string
+		want   netip.Addr
+		wantOk bool
+	}{
+		{
+			name:   "ipv4",
+			addr:   "1.2.3.4",
+			want:   netip.MustParseAddr("1.2.3.4"),
+			wantOk: true,
+		},
+		{
+			name:   "ipv4 with port",
+			addr:   "1.2.3.4:1234",
+			want:   netip.MustParseAddr("1.2.3.4"),
+			wantOk: true,
+		},
+		{
+			name:   "ipv6 with port",
+			addr:   "[2001:db8::1]:1234",
+			want:   netip.MustParseAddr("2001:db8::1"),
+			wantOk: true,
+		},
+		{
+			name:   "ipv4-mapped ipv6",
+			addr:   "::ffff:1.2.3.4",
+			want:   netip.MustParseAddr("1.2.3.4"),
+			wantOk: true,
+		},
+		{
+			name: "hostname",
+			addr: "proxy.local:1234",
+		},
+		{
+			name: "empty",
+			addr: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParseIP(tt.addr)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,202 @@
+// Package mtls implements the handling of client certificates
+// for mutual-TLS client authentication and certificate-bound access tokens (RFC 8705).
+package mtls
+
+import (
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	zcrypto "github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	// ConfirmationMethod is the member of the cnf claim containing the thumbprint
+	// of the certificate a token is bound to (RFC 8705, section 3.1).
+	ConfirmationMethod = "x5t#S256"
+)
+
+// Thumbprint returns the base64url encoded SHA-256 thumbprint of the DER encoding of the certificate.
+func Thumbprint(cert *x509.Certificate) string {
+	hash := sha256.Sum256(cert.Raw)
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// CertificateReader reads the client certificate of requests
+// from the TLS connection or from the header set by a trusted proxy terminating TLS.
+type CertificateReader struct {
+	header         string
+	trustedProxies http_utils.TrustedProxies
+}
+
+// NewCertificateReader returns a reader for the client certificates.
+// The header is only read from requests sent by one of the trusted proxies (IPs or CIDRs),
+// so at least one is required if a header is passed.
+func NewCertificateReader(header string, trustedProxies []string) (*CertificateReader, error) {
+	if header != "" && len(trustedProxies) == 0 {
+		return nil, zerrors.ThrowInvalidArgument(nil, "MTLS-Aen5u", "trusted proxies are required for the certificate header")
+	}
+	prefixes, err := http_utils.ParseTrustedProxies(trustedProxies)
+	if err != nil {
+		return nil, err
+	}
+	return &CertificateReader{
+		header:         header,
+		trustedProxies: prefixes,
+	}, nil
+}
+
+// CertificateFromRequest returns the client certificate of the TLS connection of the request.
+// If the connection does not contain one and the request was sent by a trusted proxy,
+// the URL-escaped PEM encoded certificate forwarded by the proxy is read from the header.
+// The header is ignored otherwise, as it is set by the client.
+// Nil is returned if the request does not contain a certificate.
+func (c *CertificateReader) CertificateFromRequest(r *http.Request) (*x509.Certificate, error) {
+	if r.TLS != nil && len(r.TLS.PeerCertificates) > 0 {
+		return r.TLS.PeerCertificates[0], nil
+	}
+	if c == nil || c.header == "" || !c.trusted(r.RemoteAddr) {
+		return nil, nil
+	}
+	value := r.Header.Get(c.header)
+	if value == "" {
+		return nil, nil
+	}
+	unescaped, err := url.QueryUnescape(value)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "MTLS-Ohbi4", "Errors.Token.ClientCertificateInvalid")
+	}
+	block, _ := pem.Decode([]byte(unescaped))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "MTLS-Ahm3e", "Errors.Token.ClientCertificateInvalid")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "MTLS-iu2Ae", "Errors.Token.ClientCertificateInvalid")
+	}
+	return cert, nil
+}
+
+func (c *CertificateReader) trusted(remoteAddr string) bool {
+	ip, ok := http_utils.ParseIP(remoteAddr)
+	return ok && c.trustedProxies.Contains(ip)
+}
+
+type certificateKey struct{}
+
+// WithCertificate stores the client certificate of the request in the context.
+func WithCertificate(ctx context.Context, cert *x509.Certificate) context.Context {
+	return context.WithValue(ctx, certificateKey{}, cert)
+}
+
+// CertificateFromContext returns the client certificate stored by [WithCertificate] or nil.
+func CertificateFromContext(ctx context.Context) *x509.Certificate {
+	cert, _ := ctx.Value(certificateKey{}).(*x509.Certificate)
+	return cert
+}
+
+// LoadCertPool reads the PEM encoded certificates of the authorities
+// trusted to issue client certificates from the file.
+func LoadCertPool(path string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "MTLS-Sho0a", "no certificates found in CA file")
+	}
+	return pool, nil
+}
+
+// VerifyChain checks that the certificate was issued for client authentication
+// by one of the trusted authorities (RFC 8705, section 2.1).
+func VerifyChain(cert *x509.Certificate, roots *x509.CertPool) error {
+	if roots == nil {
+		return zerrors.ThrowPreconditionFailed(nil, "MTLS-eeL4u", "Errors.Token.ClientCertificateInvalid")
+	}
+	_, err := cert.Verify(x509.VerifyOptions{
+		Roots:     roots,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil {
+		return zerrors.ThrowUnauthenticated(err, "MTLS-Phai9", "Errors.Token.ClientCertificateInvalid")
+	}
+	return nil
+}
+
+// MatchesSubject checks the certificate against the subject distinguished name
+// or subject alternative name registered for the client (RFC 8705, section 2.1.2).
+// The alternative name is compared to the DNS names, URIs, IP addresses and email addresses of the certificate.
+func MatchesSubject(cert *x509.Certificate, subjectDN, san string) bool {
+	if subjectDN != "" {
+		return cert.Subject.String() == subjectDN
+	}
+	if san == "" {
+		return false
+	}
+	for _, name := range cert.DNSNames {
+		if strings.EqualFold(name, san) {
+			return true
+		}
+	}
+	for _, uri := range cert.URIs {
+		if uri.String() == san {
+			return true
+		}
+	}
+	for _, ip := range cert.IPAddresses {
+		if ip.String() == san {
+			return true
+		}
+	}
+	for _, email := range cert.EmailAddresses {
+		if strings.EqualFold(email, san) {
+			return true
+		}
+	}
+	return false
+}
+
+// VerifySelfSigned checks that the certificate is currently valid
+// and contains one of the PEM encoded public keys registered for the client (RFC 8705, section 2.2).
+func VerifySelfSigned(cert *x509.Certificate, publicKeys map[string][]byte) error {
+	now := time.Now()
+	if now.Before(cert.NotBefore) || now.After(cert.NotAfter) {
+		return zerrors.ThrowUnauthenticated(nil, "MTLS-Aeph6", "Errors.Token.ClientCertificateInvalid")
+	}
+	certKey, ok := cert.PublicKey.(interface{ Equal(x crypto.PublicKey) bool })
+	if !ok {
+		return zerrors.ThrowUnauthenticated(nil, "MTLS-ieY2o", "Errors.Token.ClientCertificateInvalid")
+	}
+	for _, encoded := range publicKeys {
+		key, err := zcrypto.BytesToPublicKey(encoded)
+		if err != nil {
+			continue
+		}
+		if certKey.Equal(key) {
+			return nil
+		}
+	}
+	return zerrors.ThrowUnauthenticated(nil, "MTLS-Ek5ai", "Errors.Token.ClientCertificateInvalid")
+}
+
+// Confirmation adds the thumbprint of the certificate a token is bound to
+// to the value of the cnf claim, which might already contain the thumbprint of a DPoP key.
+func Confirmation(cnf map[string]any, certThumbprint string) map[string]any {
+	if cnf == nil {
+		cnf = make(map[string]any, 1)
+	}
+	cnf[ConfirmationMethod] = certThumbprint
+	return cnf
+}
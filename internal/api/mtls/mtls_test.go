@@ -0,0 +1,239 @@
+package mtls
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func newTestKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return key
+}
+
+func newTestCertificate(t *testing.T, template *x509.Certificate, key *ecdsa.PrivateKey, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) *x509.Certificate {
+	template.SerialNumber = big.NewInt(time.Now().UnixNano())
+	if template.NotBefore.IsZero() {
+		template.NotBefore = time.Now().Add(-time.Hour)
+	}
+	if template.NotAfter.IsZero() {
+		template.NotAfter = time.Now().Add(time.Hour)
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, key.Public(), parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func newTestCA(t *testing.T) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key := newTestKey(t)
+	cert := newTestCertificate(t, &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "test ca"},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, key, nil, nil)
+	return cert, key
+}
+
+func pemEncode(blockType string, der []byte) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+}
+
+func TestNewCertificateReader(t *testing.T) {
+	_, err := NewCertificateReader("", nil)
+	assert.NoError(t, err)
+	_, err = NewCertificateReader("X-Client-Cert", []string{"10.0.0.0/8", "192.168.1.1", "::1"})
+	assert.NoError(t, err)
+	_, err = NewCertificateReader("X-Client-Cert", nil)
+	assert.True(t, zerrors.IsErrorInvalidArgument(err), "got wrong err: %v", err)
+	_, err = NewCertificateReader("X-Client-Cert", []string{"proxy.local"})
+	assert.True(t, zerrors.IsErrorInvalidArgument(err), "got wrong err: %v", err)
+}
+
+func TestCertificateReader_CertificateFromRequest(t *testing.T) {
+	cert := newTestCertificate(t, &x509.Certificate{Subject: pkix.Name{CommonName: "client"}}, newTestKey(t), nil, nil)
+	reader, err := NewCertificateReader("X-Client-Cert", []string{"10.0.0.0/8"})
+	require.NoError(t, err)
+	withoutHeader, err := NewCertificateReader("", nil)
+	require.NoError(t, err)
+	tests := []struct {
+		name    string
+		reader  *CertificateReader
+		request func() *http.Request
+		want    *x509.Certificate
+		wantErr error
+	}{
+		{
+			name:   "no certificate",
+			reader: reader,
+			request: func() *http.Request {
+				r := httptest.NewRequest(http.MethodPost, "/", nil)
+				r.RemoteAddr = "10.1.2.3:1234"
+				return r
+			},
+		},
+		{
+			name:   "tls connection",
+			reader: withoutHeader,
+			request: func() *http.Request {
+				r := httptest.NewRequest(http.MethodPost, "/", nil)
+				r.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}
+				return r
+			},
+			want: cert,
+		},
+		{
+			name:   "header not configured",
+			reader: withoutHeader,
+			request: func() *http.Request {
+				r := httptest.NewRequest(http.MethodPost, "/", nil)
+				r.RemoteAddr = "10.1.2.3:1234"
+				r.Header.Set("X-Client-Cert", url.QueryEscape(string(pemEncode("CERTIFICATE", cert.Raw))))
+				return r
+			},
+		},
+		{
+			name:   "header of untrusted remote ignored",
+			reader: reader,
+			request: func() *http.Request {
+				r := httptest.NewRequest(http.MethodPost, "/", nil)
+				r.RemoteAddr = "1.2.3.4:1234"
+				r.Header.Set("X-Client-Cert", url.QueryEscape(string(pemEncode("CERTIFICATE", cert.Raw))))
+				return r
+			},
+		},
+		{
+			name:   "header of trusted proxy",
+			reader: reader,
+			request: func() *http.Request {
+				r := httptest.NewRequest(http.MethodPost, "/", nil)
+				r.RemoteAddr = "[::ffff:10.1.2.3]:1234"
+				r.Header.Set("X-Client-Cert", url.QueryEscape(string(pemEncode("CERTIFICATE", cert.Raw))))
+				return r
+			},
+			want: cert,
+		},
+		{
+			name:   "header invalid",
+			reader: reader,
+			request: func() *http.Request {
+				r := httptest.NewRequest(http.MethodPost, "/", nil)
+				r.RemoteAddr = "10.1.2.3:1234"
+				r.Header.Set("X-Client-Cert", "invalid")
+				return r
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "MTLS-Ahm3e", "Errors.Token.ClientCertificateInvalid"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.reader.CertificateFromRequest(tt.request())
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestVerifyChain(t *testing.T) {
+	ca, caKey := newTestCA(t)
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+
+	client := newTestCertificate(t, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "client"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, newTestKey(t), ca, caKey)
+	server := newTestCertificate(t, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "server"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, newTestKey(t), ca, caKey)
+	selfSigned := newTestCertificate(t, &x509.Certificate{
+		Subject:     pkix.Name{CommonName: "client"},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, newTestKey(t), nil, nil)
+
+	assert.NoError(t, VerifyChain(client, roots))
+	assert.Error(t, VerifyChain(server, roots))
+	assert.Error(t, VerifyChain(selfSigned, roots))
+	assert.Error(t, VerifyChain(client, nil))
+}
+
+func TestMatchesSubject(t *testing.T) {
+	uri, err := url.Parse("spiffe://example.com/client")
+	require.NoError(t, err)
+	cert := newTestCertificate(t, &x509.Certificate{
+		Subject:        pkix.Name{CommonName: "client", Organization: []string{"ZITADEL"}},
+		DNSNames:       []string{"client.example.com"},
+		URIs:           []*url.URL{uri},
+		EmailAddresses: []string{"client@example.com"},
+	}, newTestKey(t), nil, nil)
+
+	tests := []struct {
+		name      string
+		subjectDN string
+		san       string
+		want      bool
+	}{
+		{"nothing registered", "", "", false},
+		{"subject dn", "CN=client,O=ZITADEL", "", true},
+		{"subject dn mismatch", "CN=other,O=ZITADEL", "", false},
+		{"san dns", "", "CLIENT.example.com", true},
+		{"san uri", "", "spiffe://example.com/client", true},
+		{"san email", "", "client@example.com", true},
+		{"san mismatch", "", "other.example.com", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MatchesSubject(cert, tt.subjectDN, tt.san))
+		})
+	}
+}
+
+func TestVerifySelfSigned(t *testing.T) {
+	key := newTestKey(t)
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	require.NoError(t, err)
+	otherDER, err := x509.MarshalPKIXPublicKey(newTestKey(t).Public())
+	require.NoError(t, err)
+	registered := map[string][]byte{
+		"other": pemEncode("PUBLIC KEY", otherDER),
+		"key":   pemEncode("PUBLIC KEY", der),
+	}
+
+	cert := newTestCertificate(t, &x509.Certificate{Subject: pkix.Name{CommonName: "client"}}, key, nil, nil)
+	expired := newTestCertificate(t, &x509.Certificate{
+		Subject:   pkix.Name{CommonName: "client"},
+		NotBefore: time.Now().Add(-2 * time.Hour),
+		NotAfter:  time.Now().Add(-time.Hour),
+	}, key, nil, nil)
+
+	assert.NoError(t, VerifySelfSigned(cert, registered))
+	assert.Error(t, VerifySelfSigned(expired, registered))
+	assert.Error(t, VerifySelfSigned(cert, map[string][]byte{"other": registered["other"]}))
+}
+
+func TestConfirmation(t *testing.T) {
+	assert.Equal(t, map[string]any{ConfirmationMethod: "thumbprint"}, Confirmation(nil, "thumbprint"))
+	assert.Equal(t, map[string]any{"jkt": "jkt", ConfirmationMethod: "thumbprint"}, Confirmation(map[string]any{"jkt": "jkt"}, "thumbprint"))
+}
@@ -34,6 +34,10 @@ type accessToken struct {
 	tokenExpiration   time.Time
 	isPAT             bool
 	actor             *domain.TokenActor
+	dpopJKT           string
+	certThumbprint    string
+	// authorizationDetails granted to the token (RFC 9396)
+	authorizationDetails domain.AuthorizationDetails
 }
 
 var ErrInvalidTokenFormat = errors.New("invalid token format")
@@ -99,19 +103,22 @@ func accessTokenV1(tokenID, subject string, token *model.TokenView) *accessToken
 
 func accessTokenV2(tokenID, subject string, token *query.OIDCSessionAccessTokenReadModel) *accessToken {
 	return &accessToken{
-		tokenID:           tokenID,
-		userID:            token.UserID,
-		resourceOwner:     token.ResourceOwner,
-		subject:           subject,
-		preferredLanguage: token.PreferredLanguage,
-		clientID:          token.ClientID,
-		audience:          token.Audience,
-		scope:             token.Scope,
-		authMethods:       token.AuthMethods,
-		authTime:          token.AuthTime,
-		tokenCreation:     token.AccessTokenCreation,
-		tokenExpiration:   token.AccessTokenExpiration,
-		actor:             token.Actor,
+		tokenID:              tokenID,
+		userID:               token.UserID,
+		resourceOwner:        token.ResourceOwner,
+		subject:              subject,
+		preferredLanguage:    token.PreferredLanguage,
+		clientID:             token.ClientID,
+		audience:             token.Audience,
+		scope:                token.Scope,
+		authMethods:          token.AuthMethods,
+		authTime:             token.AuthTime,
+		tokenCreation:        token.AccessTokenCreation,
+		tokenExpiration:      token.AccessTokenExpiration,
+		actor:                token.Actor,
+		dpopJKT:              token.DPoPJKT,
+		certThumbprint:       token.CertThumbprint,
+		authorizationDetails: token.AuthorizationDetails,
 	}
 }
 
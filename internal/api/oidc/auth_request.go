@@ -21,6 +21,7 @@ import (
 	http_utils "github.com/zitadel/zitadel/internal/api/http"
 	"github.com/zitadel/zitadel/internal/api/http/middleware"
 	"github.com/zitadel/zitadel/internal/api/oidc/sign"
+	"github.com/zitadel/zitadel/internal/api/saml"
 	"github.com/zitadel/zitadel/internal/api/ui/login"
 	"github.com/zitadel/zitadel/internal/auth/repository/eventsourcing/handler"
 	"github.com/zitadel/zitadel/internal/command"
@@ -110,22 +111,24 @@ func (o *OPStorage) createAuthRequestLoginClient(ctx context.Context, req *oidc.
 		return nil, err
 	}
 	authRequest := &command.AuthRequest{
-		LoginClient:      loginClient,
-		ClientID:         req.ClientID,
-		RedirectURI:      req.RedirectURI,
-		State:            req.State,
-		Nonce:            req.Nonce,
-		Scope:            scope,
-		Audience:         audience,
-		NeedRefreshToken: slices.Contains(scope, oidc.ScopeOfflineAccess),
-		ResponseType:     ResponseTypeToBusiness(req.ResponseType),
-		ResponseMode:     ResponseModeToBusiness(req.ResponseMode),
-		CodeChallenge:    CodeChallengeToBusiness(req.CodeChallenge, req.CodeChallengeMethod),
-		Prompt:           PromptToBusiness(req.Prompt),
-		UILocales:        UILocalesToBusiness(req.UILocales),
-		MaxAge:           MaxAgeToBusiness(req.MaxAge),
-		Issuer:           o.contextToIssuer(ctx),
-		OrganizationID:   orgID,
+		LoginClient:          loginClient,
+		ClientID:             req.ClientID,
+		RedirectURI:          req.RedirectURI,
+		State:                req.State,
+		Nonce:                req.Nonce,
+		Scope:                scope,
+		Audience:             audience,
+		NeedRefreshToken:     slices.Contains(scope, oidc.ScopeOfflineAccess),
+		ResponseType:         ResponseTypeToBusiness(req.ResponseType),
+		ResponseMode:         ResponseModeToBusiness(req.ResponseMode),
+		CodeChallenge:        CodeChallengeToBusiness(req.CodeChallenge, req.CodeChallengeMethod),
+		Prompt:               PromptToBusiness(req.Prompt),
+		UILocales:            UILocalesToBusiness(req.UILocales),
+		MaxAge:               MaxAgeToBusiness(req.MaxAge),
+		Issuer:               o.contextToIssuer(ctx),
+		OrganizationID:       orgID,
+		AuthorizationDetails: authorizationDetailsFromContext(ctx),
+		ConsentRequired:      consentRequiredFromContext(ctx),
 	}
 	if req.LoginHint != "" {
 		authRequest.LoginHint = &req.LoginHint
@@ -146,6 +149,10 @@ func (o *OPStorage) createAuthRequest(ctx context.Context, req *oidc.AuthRequest
 	if !ok {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "OIDC-sd436", "no user agent id")
 	}
+	// authorization details can only be shown to the user and granted by the v2 login
+	if len(authorizationDetailsFromContext(ctx)) > 0 {
+		return nil, invalidAuthorizationDetailsError().WithDescription("authorization_details require the login v2")
+	}
 	// we do not need to handle the orgID for the v1 login, since it handles it already
 	scope, audience, _, err := o.createAuthRequestScopeAndAudience(ctx, req.ClientID, req.Scopes)
 	if err != nil {
@@ -153,6 +160,7 @@ func (o *OPStorage) createAuthRequest(ctx context.Context, req *oidc.AuthRequest
 	}
 	req.Scopes = scope
 	authRequest := CreateAuthRequestToBusiness(ctx, req, userAgentID, userID, audience)
+	authRequest.ConsentRequired = consentRequiredFromContext(ctx)
 	resp, err := o.repo.CreateAuthRequest(ctx, authRequest)
 	if err != nil {
 		return nil, err
@@ -350,13 +358,29 @@ func (o *OPStorage) TerminateSessionFromRequest(ctx context.Context, endSessionR
 		); err != nil {
 			return "", err
 		}
-		return v2PostLogoutRedirectURI(endSessionRequest.RedirectURI), nil
+		return o.samlFrontChannelLogout(ctx, endSessionRequest.IDTokenHintClaims.SessionID, v2PostLogoutRedirectURI(endSessionRequest.RedirectURI)), nil
 	}
 	_, err = o.command.TerminateSessionWithoutTokenCheck(ctx, endSessionRequest.IDTokenHintClaims.SessionID)
 	if err != nil {
 		return "", err
 	}
-	return v2PostLogoutRedirectURI(endSessionRequest.RedirectURI), nil
+	return o.samlFrontChannelLogout(ctx, endSessionRequest.IDTokenHintClaims.SessionID, v2PostLogoutRedirectURI(endSessionRequest.RedirectURI)), nil
+}
+
+// samlFrontChannelLogout checks whether SAML service providers using a front channel binding participate in the terminated session.
+// If so, it starts their logout and returns the path notifying them through the user agent,
+// which is redirected to the post logout redirect URI afterwards.
+func (o *OPStorage) samlFrontChannelLogout(ctx context.Context, sessionID, postLogoutRedirectURI string) string {
+	logout, err := o.command.StartSAMLFrontChannelLogout(ctx, sessionID, postLogoutRedirectURI)
+	if err != nil {
+		logging.WithFields("instanceID", authz.GetInstance(ctx).InstanceID(), "sessionID", sessionID).
+			WithError(err).Error("error starting saml logout")
+		return postLogoutRedirectURI
+	}
+	if logout == nil {
+		return postLogoutRedirectURI
+	}
+	return saml.FrontChannelLogoutPath(sessionID)
 }
 
 // federatedLogout checks whether the session has an idp session linked and the IDP template is configured for federated logout.
@@ -654,6 +678,7 @@ func (s *Server) CreateTokenCallbackURL(ctx context.Context, req op.AuthRequest)
 		implicitFlowComplianceChecker(),
 		slices.Contains(client.GrantTypes(), oidc.GrantTypeRefreshToken),
 		client.client.BackChannelLogoutURI,
+		nil,
 	)
 	if err != nil {
 		return "", err
@@ -753,6 +778,7 @@ func (s *Server) authResponseToken(authReq *AuthRequest, authorizer op.Authorize
 		slices.Contains(scope, oidc.ScopeOfflineAccess),
 		authReq.SessionID,
 		authReq.oidc().ResponseType,
+		nil,
 	)
 	if err != nil {
 		op.AuthRequestError(w, r, authReq, err, authorizer)
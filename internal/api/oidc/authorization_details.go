@@ -0,0 +1,75 @@
+package oidc
+
+import (
+	"context"
+
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+const (
+	// authorizationDetailsParam is the parameter of the authorization, pushed authorization
+	// and token requests of Rich Authorization Requests (RFC 9396, section 2).
+	authorizationDetailsParam = "authorization_details"
+	// authorizationDetailsClaim is used in access tokens and introspection responses (RFC 9396, section 9).
+	authorizationDetailsClaim = "authorization_details"
+
+	errorTypeInvalidAuthorizationDetails = "invalid_authorization_details"
+)
+
+type authorizationDetailsKey struct{}
+
+// contextWithAuthorizationDetails passes the validated authorization details of the authorization request
+// on to the creation of the auth request, as the storage interface of the OIDC library does not allow it.
+func contextWithAuthorizationDetails(ctx context.Context, details domain.AuthorizationDetails) context.Context {
+	if len(details) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, authorizationDetailsKey{}, details)
+}
+
+func authorizationDetailsFromContext(ctx context.Context) domain.AuthorizationDetails {
+	details, _ := ctx.Value(authorizationDetailsKey{}).(domain.AuthorizationDetails)
+	return details
+}
+
+// parseAuthorizationDetails parses the authorization_details parameter and checks that each
+// requested type is registered on the project of the client (RFC 9396, section 5).
+func (s *Server) parseAuthorizationDetails(ctx context.Context, value string, client *Client) (domain.AuthorizationDetails, error) {
+	details, err := domain.ParseAuthorizationDetails(value)
+	if err != nil {
+		return nil, invalidAuthorizationDetailsError().WithDescription("authorization_details must be a JSON array of objects with a type").WithParent(err)
+	}
+	if len(details) == 0 {
+		return nil, nil
+	}
+	types, err := s.query.ProjectAuthorizationDetailTypes(ctx, client.client.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	if unsupported := details.UnsupportedType(types); unsupported != "" {
+		return nil, invalidAuthorizationDetailsError().WithDescription("authorization_details type %s is not supported by the client", unsupported)
+	}
+	return details, nil
+}
+
+func invalidAuthorizationDetailsError() *oidc.Error {
+	return &oidc.Error{
+		ErrorType:   errorTypeInvalidAuthorizationDetails,
+		Description: "the authorization_details are invalid",
+	}
+}
+
+// authorizationDetailsClaims adds the granted authorization details to the claims
+// of an access token or introspection response.
+func authorizationDetailsClaims(claims map[string]any, details domain.AuthorizationDetails) map[string]any {
+	if len(details) == 0 {
+		return claims
+	}
+	if claims == nil {
+		claims = make(map[string]any, 1)
+	}
+	claims[authorizationDetailsClaim] = details
+	return claims
+}
@@ -0,0 +1,320 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	httphelper "github.com/zitadel/oidc/v3/pkg/http"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/backend/v3/instrumentation/logging"
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/domain/cibapoll"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	CIBADefaultLifetime     = 5 * time.Minute
+	CIBADefaultPollInterval = 5 * time.Second
+
+	// grantTypeCIBA is the grant type used on the token endpoint to poll for the tokens
+	// of a backchannel authentication request (OpenID CIBA Core, section 10.1).
+	grantTypeCIBA oidc.GrantType = "urn:openid:params:grant-type:ciba"
+
+	cibaDeliveryModePoll = "poll"
+	cibaDeliveryModePing = "ping"
+
+	errorTypeUnknownUserID = "unknown_user_id"
+)
+
+// CIBAConfig configures the backchannel authentication requests (OpenID CIBA).
+type CIBAConfig struct {
+	// Lifetime is the maximum time the user has to approve a request.
+	// Clients can only shorten it through the requested_expiry parameter.
+	Lifetime time.Duration
+	// PollInterval is the minimum time clients in poll mode have to wait between token requests.
+	PollInterval time.Duration
+}
+
+func (c *CIBAConfig) lifetime() time.Duration {
+	if c == nil || c.Lifetime == 0 {
+		return CIBADefaultLifetime
+	}
+	return c.Lifetime
+}
+
+func (c *CIBAConfig) pollInterval() time.Duration {
+	if c == nil || c.PollInterval == 0 {
+		return CIBADefaultPollInterval
+	}
+	return c.PollInterval
+}
+
+type backchannelAuthenticationResponse struct {
+	AuthReqID string `json:"auth_req_id"`
+	ExpiresIn int64  `json:"expires_in"`
+	Interval  int64  `json:"interval,omitempty"`
+}
+
+// backchannelAuthentication handles requests to the backchannel authentication endpoint (OpenID CIBA Core, section 7).
+// The client is authenticated as on the token endpoint and the user is identified by the login_hint or id_token_hint.
+// The request is then pending until the user approves or denies it through the session API,
+// e.g. in a login app on the user's own device.
+func (s *Server) backchannelAuthentication(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.NewSpan(r.Context())
+	r = r.WithContext(ctx)
+	resp, err := s.backchannelAuthenticate(ctx, r)
+	span.EndWithError(err)
+	if err != nil {
+		op.WriteError(w, r, oidcError(ctx, err), logging.FromCtx(ctx))
+		return
+	}
+	httphelper.MarshalJSON(w, resp)
+}
+
+func (s *Server) backchannelAuthenticate(ctx context.Context, r *http.Request) (*backchannelAuthenticationResponse, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, oidc.ErrInvalidRequest().WithDescription("error parsing form").WithParent(err)
+	}
+	cc, err := formClientCredentials(r)
+	if err != nil {
+		return nil, err
+	}
+	verified, err := s.VerifyClient(ctx, &op.Request[op.ClientCredentials]{
+		Method: r.Method,
+		URL:    r.URL,
+		Header: r.Header,
+		Form:   r.PostForm,
+		Data:   cc,
+	})
+	if err != nil {
+		return nil, err
+	}
+	client, ok := verified.(*Client)
+	if !ok {
+		return nil, zerrors.ThrowInternal(nil, "OIDC-Ciu7c", "Errors.Internal")
+	}
+	if !slices.Contains(client.GrantTypes(), grantTypeCIBA) {
+		return nil, oidc.ErrUnauthorizedClient().WithDescription("the client is not allowed to use backchannel authentication")
+	}
+
+	scopes := strings.Fields(r.PostForm.Get("scope"))
+	if !slices.Contains(scopes, oidc.ScopeOpenID) {
+		return nil, oidc.ErrInvalidScope().WithDescription("the openid scope is required")
+	}
+	if r.PostForm.Get("login_hint_token") != "" {
+		return nil, oidc.ErrInvalidRequest().WithDescription("login_hint_token is not supported")
+	}
+	notificationToken := r.PostForm.Get("client_notification_token")
+	if client.client.BackChannelClientNotificationURI != "" && notificationToken == "" {
+		return nil, oidc.ErrInvalidRequest().WithDescription("client_notification_token is required for the ping mode")
+	}
+	lifetime, err := s.cibaRequestLifetime(r.PostForm.Get("requested_expiry"))
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.cibaUser(ctx, r.PostForm.Get("login_hint"), r.PostForm.Get("id_token_hint"))
+	if err != nil {
+		return nil, err
+	}
+
+	storage, ok := s.Provider().Storage().(*OPStorage)
+	if !ok {
+		return nil, zerrors.ThrowInternal(nil, "OIDC-Ciu8s", "Errors.Internal")
+	}
+	scopes, audience, orgID, err := storage.createAuthRequestScopeAndAudience(ctx, client.GetID(), scopes)
+	if err != nil {
+		return nil, err
+	}
+	if orgID != "" && orgID != user.ResourceOwner {
+		return nil, oidc.ErrAccessDenied().WithDescription("the user does not belong to the requested organization")
+	}
+
+	details, err := s.command.AddCIBARequest(ctx, &command.CIBARequest{
+		ClientID:          client.GetID(),
+		UserID:            user.ID,
+		UserOrgID:         user.ResourceOwner,
+		Scopes:            scopes,
+		Audience:          audience,
+		BindingMessage:    r.PostForm.Get("binding_message"),
+		Expires:           time.Now().Add(lifetime),
+		NeedRefreshToken:  slices.Contains(scopes, oidc.ScopeOfflineAccess),
+		NotificationURI:   client.client.BackChannelClientNotificationURI,
+		NotificationToken: notificationToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &backchannelAuthenticationResponse{
+		AuthReqID: details.ID,
+		ExpiresIn: int64(lifetime / time.Second),
+	}
+	if client.client.BackChannelClientNotificationURI == "" {
+		resp.Interval = int64(s.cibaConfig.pollInterval() / time.Second)
+	}
+	return resp, nil
+}
+
+// cibaRequestLifetime returns the configured lifetime,
+// unless the client requested a shorter one (OpenID CIBA Core, section 7.1).
+func (s *Server) cibaRequestLifetime(requestedExpiry string) (time.Duration, error) {
+	lifetime := s.cibaConfig.lifetime()
+	if requestedExpiry == "" {
+		return lifetime, nil
+	}
+	seconds, err := strconv.Atoi(requestedExpiry)
+	if err != nil || seconds <= 0 {
+		return 0, oidc.ErrInvalidRequest().WithDescription("invalid requested_expiry")
+	}
+	return min(lifetime, time.Duration(seconds)*time.Second), nil
+}
+
+// cibaUser identifies the active human user of a backchannel authentication request
+// by exactly one of the login_hint (login name) or id_token_hint (OpenID CIBA Core, section 7.1).
+func (s *Server) cibaUser(ctx context.Context, loginHint, idTokenHint string) (user *query.User, err error) {
+	switch {
+	case loginHint != "" && idTokenHint != "":
+		return nil, oidc.ErrInvalidRequest().WithDescription("only one of login_hint or id_token_hint must be provided")
+	case loginHint != "":
+		user, err = s.query.GetUserByLoginName(ctx, true, loginHint)
+	case idTokenHint != "":
+		var userID string
+		userID, err = op.ValidateAuthReqIDTokenHint(ctx, idTokenHint, s.Provider().IDTokenHintVerifier(ctx))
+		if err != nil {
+			return nil, oidc.ErrInvalidRequest().WithDescription("the id_token_hint is invalid").WithParent(err)
+		}
+		user, err = s.query.GetUserByID(ctx, true, userID)
+	default:
+		return nil, oidc.ErrInvalidRequest().WithDescription("login_hint or id_token_hint is required")
+	}
+	if zerrors.IsNotFound(err) {
+		return nil, unknownUserIDError().WithParent(err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if user.Human == nil || !user.State.IsEnabled() {
+		return nil, unknownUserIDError()
+	}
+	return user, nil
+}
+
+func unknownUserIDError() *oidc.Error {
+	return &oidc.Error{
+		ErrorType:   errorTypeUnknownUserID,
+		Description: "the user could not be identified by the provided hint",
+	}
+}
+
+// cibaTokenInterceptor handles token requests of the CIBA grant type (OpenID CIBA Core, section 10.1),
+// which are not supported by the OIDC library.
+// All other requests are passed on.
+func (s *Server) cibaTokenInterceptor(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != s.Endpoints().Token.Relative() ||
+			r.PostFormValue("grant_type") != string(grantTypeCIBA) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		ctx, span := tracing.NewSpan(r.Context())
+		r = r.WithContext(ctx)
+		resp, err := s.cibaToken(ctx, r)
+		span.EndWithError(err)
+		if err != nil {
+			op.WriteError(w, r, oidcError(ctx, err), logging.FromCtx(ctx))
+			return
+		}
+		httphelper.MarshalJSON(w, resp)
+	})
+}
+
+// cibaToken returns the tokens of an approved backchannel authentication request.
+// As with the device authorization, clients get the authorization_pending error while the user has not yet decided.
+func (s *Server) cibaToken(ctx context.Context, r *http.Request) (_ *oidc.AccessTokenResponse, err error) {
+	cc, err := formClientCredentials(r)
+	if err != nil {
+		return nil, err
+	}
+	verified, err := s.VerifyClient(ctx, &op.Request[op.ClientCredentials]{
+		Method: r.Method,
+		URL:    r.URL,
+		Header: r.Header,
+		Form:   r.PostForm,
+		Data:   cc,
+	})
+	if err != nil {
+		return nil, err
+	}
+	client, ok := verified.(*Client)
+	if !ok {
+		return nil, zerrors.ThrowInternal(nil, "OIDC-Ciu9c", "Errors.Internal")
+	}
+	if !slices.Contains(client.GrantTypes(), grantTypeCIBA) {
+		return nil, oidc.ErrUnauthorizedClient()
+	}
+	authReqID := r.PostForm.Get("auth_req_id")
+	if authReqID == "" {
+		return nil, oidc.ErrInvalidRequest().WithDescription("auth_req_id missing")
+	}
+	if client.client.BackChannelClientNotificationURI == "" && s.cibaPollTooFast(ctx, authReqID) {
+		return nil, oidc.ErrSlowDown()
+	}
+	jkt, err := s.verifyDPoPProof(ctx, r.Header, client.client.DPoPRequired)
+	if err != nil {
+		return nil, err
+	}
+	session, err := s.command.CreateOIDCSessionFromCIBARequest(ctx, authReqID, client.client.BackChannelLogoutURI, client.client.ClientID, tokenBinding(jkt, client))
+	if err == nil {
+		return s.accessTokenResponseFromSession(ctx, client, session, "", client.client.ProjectID, client.client.ProjectRoleAssertion, client.client.AccessTokenRoleAssertion, client.client.IDTokenRoleAssertion, client.client.IDTokenUserinfoAssertion)
+	}
+	if errors.Is(err, oidc.ErrInvalidClient()) {
+		return nil, err
+	}
+
+	var target command.CIBARequestStateError
+	if errors.As(err, &target) {
+		switch domain.CIBARequestState(target) {
+		case domain.CIBARequestStateInitiated:
+			return nil, oidc.ErrAuthorizationPending()
+		case domain.CIBARequestStateExpired:
+			return nil, oidc.ErrExpiredDeviceCode().WithDescription("The auth_req_id has expired.")
+		case domain.CIBARequestStateDenied:
+			return nil, oidc.ErrAccessDenied()
+		}
+	}
+	return nil, oidc.ErrInvalidGrant().WithParent(err).WithReturnParentToClient(authz.GetFeatures(ctx).DebugOIDCParentError)
+}
+
+// cibaPollTooFast stores the time of the token request for the auth_req_id
+// and reports if the previous request was less than the poll interval ago (OpenID CIBA Core, section 11).
+// Clients using the ping mode are not required to wait for the interval.
+func (s *Server) cibaPollTooFast(ctx context.Context, authReqID string) bool {
+	now := time.Now()
+	key := cibapoll.Key(authz.GetInstance(ctx).InstanceID(), authReqID)
+	last, ok := s.cibaPolls.Get(ctx, cibapoll.IndexAuthReqID, key)
+	s.cibaPolls.Set(ctx, &cibapoll.Poll{
+		InstanceID: authz.GetInstance(ctx).InstanceID(),
+		AuthReqID:  authReqID,
+		PolledAt:   now,
+	})
+	return ok && now.Sub(last.PolledAt) < s.cibaConfig.pollInterval()
+}
+
+// backchannelAuthEndpoint resolves the backchannel authentication endpoint (OpenID CIBA),
+// optionally overridden through the custom endpoint configuration.
+func backchannelAuthEndpoint(endpointConfig *EndpointConfig) *op.Endpoint {
+	if endpointConfig != nil && endpointConfig.BackchannelAuthentication != nil {
+		return op.NewEndpointWithURL(endpointConfig.BackchannelAuthentication.Path, endpointConfig.BackchannelAuthentication.URL)
+	}
+	return op.NewEndpoint("/oauth/v2/bc-authorize")
+}
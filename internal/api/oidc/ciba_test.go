@@ -0,0 +1,148 @@
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/cache"
+	"github.com/zitadel/zitadel/internal/cache/connector/gomap"
+	"github.com/zitadel/zitadel/internal/domain/cibapoll"
+)
+
+func TestServer_cibaRequestLifetime(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name            string
+		config          *CIBAConfig
+		requestedExpiry string
+		want            time.Duration
+		wantErr         bool
+	}{
+		{
+			name: "default",
+			want: CIBADefaultLifetime,
+		},
+		{
+			name:   "configured",
+			config: &CIBAConfig{Lifetime: time.Minute},
+			want:   time.Minute,
+		},
+		{
+			name:            "requested shorter",
+			requestedExpiry: "30",
+			want:            30 * time.Second,
+		},
+		{
+			name:            "requested longer, configured",
+			config:          &CIBAConfig{Lifetime: time.Minute},
+			requestedExpiry: "3600",
+			want:            time.Minute,
+		},
+		{
+			name:            "invalid",
+			requestedExpiry: "soon",
+			wantErr:         true,
+		},
+		{
+			name:            "not positive",
+			requestedExpiry: "0",
+			wantErr:         true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s := &Server{cibaConfig: tt.config}
+			got, err := s.cibaRequestLifetime(tt.requestedExpiry)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestServer_cibaTokenInterceptor_passThrough(t *testing.T) {
+	t.Parallel()
+	s := &Server{
+		LegacyServer: op.NewLegacyServer(
+			func() *op.Provider {
+				//nolint:staticcheck
+				provider, _ := op.NewForwardedOpenIDProvider("path", &op.Config{}, nil)
+				return provider
+			}(),
+			op.Endpoints{Token: op.NewEndpoint("/oauth/v2/token")},
+		),
+	}
+	tests := []struct {
+		name string
+		r    *http.Request
+	}{
+		{
+			name: "other grant type",
+			r: func() *http.Request {
+				r := httptest.NewRequest(http.MethodPost, "/oauth/v2/token", strings.NewReader(url.Values{"grant_type": {"authorization_code"}}.Encode()))
+				r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+				return r
+			}(),
+		},
+		{
+			name: "other endpoint",
+			r: func() *http.Request {
+				r := httptest.NewRequest(http.MethodPost, "/oauth/v2/introspect", strings.NewReader(url.Values{"grant_type": {string(grantTypeCIBA)}}.Encode()))
+				r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+				return r
+			}(),
+		},
+		{
+			name: "other method",
+			r:    httptest.NewRequest(http.MethodGet, "/oauth/v2/token?grant_type="+url.QueryEscape(string(grantTypeCIBA)), nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var called bool
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+			})
+			s.cibaTokenInterceptor(next).ServeHTTP(httptest.NewRecorder(), tt.r)
+			assert.True(t, called)
+		})
+	}
+}
+
+func Test_backchannelAuthEndpoint(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "/oauth/v2/bc-authorize", backchannelAuthEndpoint(nil).Relative())
+	assert.Equal(t, "/custom/ciba", backchannelAuthEndpoint(&EndpointConfig{
+		BackchannelAuthentication: &Endpoint{Path: "/custom/ciba"},
+	}).Relative())
+}
+
+func TestServer_cibaPollTooFast(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	s := &Server{
+		cibaConfig: &CIBAConfig{PollInterval: time.Hour},
+		cibaPolls:  gomap.NewCache[cibapoll.Index, string, *cibapoll.Poll](ctx, []cibapoll.Index{cibapoll.IndexAuthReqID}, cache.Config{MaxAge: time.Hour}),
+	}
+	assert.False(t, s.cibaPollTooFast(ctx, "authReqID"))
+	assert.True(t, s.cibaPollTooFast(ctx, "authReqID"))
+	// the interval is tracked per auth_req_id
+	assert.False(t, s.cibaPollTooFast(ctx, "otherAuthReqID"))
+
+	s.cibaConfig.PollInterval = time.Nanosecond
+	assert.False(t, s.cibaPollTooFast(ctx, "authReqID"))
+}
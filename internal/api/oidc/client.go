@@ -13,6 +13,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/actions"
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/mtls"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
@@ -198,7 +199,7 @@ func (s *Server) VerifyClient(ctx context.Context, r *op.Request[op.ClientCreden
 	if err != nil {
 		return nil, err
 	}
-	client, err := s.query.ActiveOIDCClientByID(ctx, clientID, assertion)
+	client, err := s.query.ActiveOIDCClientByID(ctx, clientID, assertion || mtls.CertificateFromContext(ctx) != nil)
 	if zerrors.IsNotFound(err) {
 		return nil, oidc.ErrInvalidClient().WithParent(err).WithReturnParentToClient(authz.GetFeatures(ctx).DebugOIDCParentError).WithDescription("no active client not found")
 	}
@@ -212,18 +213,25 @@ func (s *Server) VerifyClient(ctx context.Context, r *op.Request[op.ClientCreden
 		}
 	}
 
+	var certThumbprint string
 	switch client.AuthMethodType {
 	case domain.OIDCAuthMethodTypeBasic, domain.OIDCAuthMethodTypePost:
 		err = s.verifyClientSecret(ctx, client, r.Data.ClientSecret)
 	case domain.OIDCAuthMethodTypePrivateKeyJWT:
 		err = s.verifyClientAssertion(ctx, client, r.Data.ClientAssertion)
+	case domain.OIDCAuthMethodTypeTLSClientAuth:
+		certThumbprint, err = s.verifyClientCertificate(ctx, false, client.TLSClientAuthSubjectDN, client.TLSClientAuthSAN, nil)
+	case domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth:
+		certThumbprint, err = s.verifyClientCertificate(ctx, true, "", "", client.PublicKeys)
 	case domain.OIDCAuthMethodTypeNone:
 	}
 	if err != nil {
 		return nil, err
 	}
 
-	return ClientFromBusiness(client, s.defaultLoginURL, s.defaultLoginURLV2), nil
+	verified := ClientFromBusiness(client, s.defaultLoginURL, s.defaultLoginURLV2).(*Client)
+	verified.certThumbprint = certThumbprint
+	return verified, nil
 }
 
 func (s *Server) verifyClientAssertion(ctx context.Context, client *query.OIDCClient, assertion string) (err error) {
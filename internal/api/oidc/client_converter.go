@@ -24,6 +24,9 @@ type Client struct {
 	defaultLoginURL   string
 	defaultLoginURLV2 string
 	allowedScopes     []string
+	// certThumbprint is set if the client authenticated by a certificate,
+	// which the issued tokens are bound to.
+	certThumbprint string
 }
 
 func ClientFromBusiness(client *query.OIDCClient, defaultLoginURL, defaultLoginURLV2 string) op.Client {
@@ -167,6 +170,10 @@ func authMethodToOIDC(authType domain.OIDCAuthMethodType) oidc.AuthMethod {
 		return oidc.AuthMethodNone
 	case domain.OIDCAuthMethodTypePrivateKeyJWT:
 		return oidc.AuthMethodPrivateKeyJWT
+	case domain.OIDCAuthMethodTypeTLSClientAuth:
+		return authMethodTLSClientAuth
+	case domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth:
+		return authMethodSelfSignedTLSClientAuth
 	default:
 		return oidc.AuthMethodBasic
 	}
@@ -213,6 +220,8 @@ func grantTypeToOIDC(grantType domain.OIDCGrantType) oidc.GrantType {
 		return oidc.GrantTypeDeviceCode
 	case domain.OIDCGrantTypeTokenExchange:
 		return oidc.GrantTypeTokenExchange
+	case domain.OIDCGrantTypeCIBA:
+		return grantTypeCIBA
 	default:
 		return oidc.GrantTypeCode
 	}
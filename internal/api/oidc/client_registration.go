@@ -391,6 +391,8 @@ func registrationGrantTypesToDomain(grantTypes []string) ([]domain.OIDCGrantType
 			mapped = append(mapped, domain.OIDCGrantTypeDeviceCode)
 		case "urn:ietf:params:oauth:grant-type:token-exchange":
 			mapped = append(mapped, domain.OIDCGrantTypeTokenExchange)
+		case string(grantTypeCIBA):
+			mapped = append(mapped, domain.OIDCGrantTypeCIBA)
 		default:
 			return nil, newRegistrationError(registrationErrorInvalidClientMetadata, "grant_type "+grantType+" is not supported")
 		}
@@ -506,6 +508,10 @@ func authMethodToRegistration(authMethod domain.OIDCAuthMethodType) string {
 		return "none"
 	case domain.OIDCAuthMethodTypePrivateKeyJWT:
 		return "private_key_jwt"
+	case domain.OIDCAuthMethodTypeTLSClientAuth:
+		return string(authMethodTLSClientAuth)
+	case domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth:
+		return string(authMethodSelfSignedTLSClientAuth)
 	default:
 		return ""
 	}
@@ -525,6 +531,8 @@ func grantTypesToRegistration(grantTypes []domain.OIDCGrantType) []string {
 			mapped = append(mapped, "urn:ietf:params:oauth:grant-type:device_code")
 		case domain.OIDCGrantTypeTokenExchange:
 			mapped = append(mapped, "urn:ietf:params:oauth:grant-type:token-exchange")
+		case domain.OIDCGrantTypeCIBA:
+			mapped = append(mapped, string(grantTypeCIBA))
 		}
 	}
 	return mapped
@@ -0,0 +1,28 @@
+package oidc
+
+import (
+	"context"
+)
+
+type consentRequiredKey struct{}
+
+// consentRequired returns true for clients which require the consent of the user in their configuration
+// and for clients which were registered by themselves using the dynamic client registration.
+// Those are third-party clients, so the user has to consent to the requested scopes before any token is issued.
+func (c *Client) consentRequired() bool {
+	return c.client.ConsentRequired || c.client.RegistrationTokenHash != ""
+}
+
+// contextWithConsentRequired passes the consent requirement of the client on to the creation of the auth request,
+// as the storage interface of the OIDC library does not allow it.
+func contextWithConsentRequired(ctx context.Context, client *Client) context.Context {
+	if !client.consentRequired() {
+		return ctx
+	}
+	return context.WithValue(ctx, consentRequiredKey{}, true)
+}
+
+func consentRequiredFromContext(ctx context.Context) bool {
+	required, _ := ctx.Value(consentRequiredKey{}).(bool)
+	return required
+}
@@ -0,0 +1,99 @@
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/dpop"
+	"github.com/zitadel/zitadel/internal/command"
+)
+
+// verifyDPoPProof verifies the DPoP proof of a token request (RFC 9449, section 5)
+// and returns the thumbprint of its key.
+// An empty thumbprint is returned if the request does not contain a proof and none is required.
+func (s *Server) verifyDPoPProof(ctx context.Context, header http.Header, required bool) (string, error) {
+	proofs := header.Values(dpop.HeaderName)
+	if len(proofs) == 0 {
+		if required {
+			return "", oidc.ErrInvalidRequest().WithDescription("DPoP proof required")
+		}
+		return "", nil
+	}
+	if len(proofs) > 1 {
+		return "", invalidDPoPProofError().WithDescription("multiple DPoP proofs")
+	}
+	proof, err := s.dpopVerifier.Verify(ctx, proofs[0], http.MethodPost, s.Endpoints().Token.Absolute(op.IssuerFromContext(ctx)), "")
+	if err != nil {
+		return "", invalidDPoPProofError().WithParent(err).WithReturnParentToClient(authz.GetFeatures(ctx).DebugOIDCParentError)
+	}
+	return proof.JKT, nil
+}
+
+func invalidDPoPProofError() *oidc.Error {
+	return &oidc.Error{
+		ErrorType:   dpop.ErrorTypeInvalidProof,
+		Description: "invalid DPoP proof",
+	}
+}
+
+// tokenBinding returns the binding of the tokens to the DPoP key with the thumbprint
+// and to the certificate the client authenticated with (RFC 8705), if any.
+// Refresh tokens are only bound to the DPoP key for public clients (RFC 9449, section 5).
+// Refresh tokens of confidential clients are not bound,
+// as the client authentication already prevents their use by others
+// and the client may rotate its DPoP key independently of the refresh token.
+func tokenBinding(jkt string, client *Client) *command.TokenBinding {
+	var certThumbprint string
+	if client != nil {
+		certThumbprint = client.certThumbprint
+	}
+	if jkt == "" && certThumbprint == "" {
+		return nil
+	}
+	return &command.TokenBinding{
+		JKT:              jkt,
+		BindRefreshToken: jkt != "" && client != nil && client.AuthMethod() == oidc.AuthMethodNone,
+		CertThumbprint:   certThumbprint,
+	}
+}
+
+type dpopSchemeKey struct{}
+
+// dpopSchemeInterceptor passes access tokens sent with the DPoP authorization scheme (RFC 9449, section 7.1)
+// on as bearer tokens, as the OIDC library only reads the latter.
+// The use of the scheme is stored in the context and checked by [verifyDPoPAccessToken].
+func dpopSchemeInterceptor(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if token, ok := strings.CutPrefix(r.Header.Get("authorization"), dpop.AuthPrefix); ok {
+			r = r.Clone(context.WithValue(r.Context(), dpopSchemeKey{}, true))
+			r.Header.Set("authorization", oidc.PrefixBearer+token)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// verifyDPoPAccessToken checks that an access token bound to the key with the thumbprint
+// is sent with the DPoP authorization scheme and a proof of that key.
+// Unbound access tokens are not checked.
+func (s *Server) verifyDPoPAccessToken(ctx context.Context, header http.Header, method, uri, accessToken, jkt string) error {
+	if jkt == "" {
+		return nil
+	}
+	proofs := header.Values(dpop.HeaderName)
+	if scheme, _ := ctx.Value(dpopSchemeKey{}).(bool); !scheme || len(proofs) != 1 {
+		return op.NewStatusError(invalidDPoPProofError().WithDescription("DPoP bound access token requires the DPoP scheme and proof"), http.StatusUnauthorized)
+	}
+	proof, err := s.dpopVerifier.Verify(ctx, proofs[0], method, uri, accessToken)
+	if err != nil {
+		return op.NewStatusError(invalidDPoPProofError().WithParent(err).WithReturnParentToClient(authz.GetFeatures(ctx).DebugOIDCParentError), http.StatusUnauthorized)
+	}
+	if proof.JKT != jkt {
+		return op.NewStatusError(invalidDPoPProofError().WithDescription("DPoP proof key does not match the access token"), http.StatusUnauthorized)
+	}
+	return nil
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/zitadel/oidc/v3/pkg/op"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/dpop"
+	"github.com/zitadel/zitadel/internal/api/mtls"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
@@ -96,6 +98,7 @@ func (s *Server) Introspect(ctx context.Context, r *op.Request[op.IntrospectionR
 	if err = validateIntrospectionAudience(token.audience, client.clientID, client.projectID); err != nil {
 		return nil, err
 	}
+	ctx = contextWithAuthorizationDetails(ctx, token.authorizationDetails)
 	userInfo, err := s.userInfo(
 		token.userID,
 		token.scope,
@@ -123,6 +126,15 @@ func (s *Server) Introspect(ctx context.Context, r *op.Request[op.IntrospectionR
 		JWTID:                           token.tokenID,
 		Actor:                           actorDomainToClaims(token.actor),
 	}
+	if token.dpopJKT != "" {
+		introspectionResp.TokenType = dpop.TokenType
+	}
+	if cnf := confirmationClaim(token.dpopJKT, token.certThumbprint); cnf != nil {
+		introspectionResp.Claims = map[string]any{
+			dpop.ConfirmationClaim: cnf,
+		}
+	}
+	introspectionResp.Claims = authorizationDetailsClaims(introspectionResp.Claims, token.authorizationDetails)
 	introspectionResp.SetUserInfo(userInfo)
 	return op.NewResponse(introspectionResp), nil
 }
@@ -153,6 +165,12 @@ func (s *Server) introspectionClientAuth(ctx context.Context, cc *op.ClientCrede
 			return client.ClientID, client.ProjectID, client.ProjectRoleAssertion, nil
 
 		}
+		if client.UsesTLSClientAuth() || client.UsesSelfSignedTLSClientAuth() {
+			if _, err := s.verifyClientCertificate(ctx, client.UsesSelfSignedTLSClientAuth(), client.TLSClientAuthSubjectDN, client.TLSClientAuthSAN, client.PublicKeys); err != nil {
+				return "", "", false, oidc.ErrUnauthorizedClient().WithParent(err).WithReturnParentToClient(authz.GetFeatures(ctx).DebugOIDCParentError)
+			}
+			return client.ClientID, client.ProjectID, client.ProjectRoleAssertion, nil
+		}
 		if client.HashedSecret != "" {
 			if err := s.introspectionClientSecretAuth(ctx, client, cc.ClientSecret); err != nil {
 				return "", "", false, oidc.ErrUnauthorizedClient().WithParent(err).WithReturnParentToClient(authz.GetFeatures(ctx).DebugOIDCParentError)
@@ -205,7 +223,7 @@ func (s *Server) clientFromCredentials(ctx context.Context, cc *op.ClientCredent
 	if err != nil {
 		return nil, err
 	}
-	client, err = s.query.ActiveIntrospectionClientByID(ctx, clientID, assertion)
+	client, err = s.query.ActiveIntrospectionClientByID(ctx, clientID, assertion || mtls.CertificateFromContext(ctx) != nil)
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, oidc.ErrUnauthorizedClient().WithParent(err).WithReturnParentToClient(authz.GetFeatures(ctx).DebugOIDCParentError)
 	}
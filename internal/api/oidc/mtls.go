@@ -0,0 +1,150 @@
+package oidc
+
+import (
+	"context"
+	"crypto/x509"
+	"net/http"
+
+	httphelper "github.com/zitadel/oidc/v3/pkg/http"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/backend/v3/instrumentation/logging"
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/dpop"
+	"github.com/zitadel/zitadel/internal/api/mtls"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+const (
+	authMethodTLSClientAuth           oidc.AuthMethod = "tls_client_auth"
+	authMethodSelfSignedTLSClientAuth oidc.AuthMethod = "self_signed_tls_client_auth"
+
+	errorTypeInvalidToken = "invalid_token"
+)
+
+// clientCertificateInterceptor stores the client certificate of the request in the context (RFC 8705).
+// Introspection requests authenticated by the certificate only are handled here,
+// as the OIDC library rejects introspection requests without a secret or assertion.
+func (s *Server) clientCertificateInterceptor(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cert, err := s.clientCertificates.CertificateFromRequest(r)
+		if err != nil {
+			op.WriteError(w, r, oidc.ErrInvalidClient().WithParent(err).WithReturnParentToClient(authz.GetFeatures(r.Context()).DebugOIDCParentError).WithDescription("invalid client certificate"), logging.FromCtx(r.Context()))
+			return
+		}
+		if cert == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		r = r.WithContext(mtls.WithCertificate(r.Context(), cert))
+		if r.Method == http.MethodPost && r.URL.Path == s.Endpoints().Introspection.Relative() {
+			s.certificateIntrospection(w, r, next)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// certificateIntrospection handles introspection requests without a client secret or assertion.
+// All others are passed on to the OIDC library.
+func (s *Server) certificateIntrospection(w http.ResponseWriter, r *http.Request, next http.Handler) {
+	if err := r.ParseForm(); err != nil {
+		op.WriteError(w, r, oidc.ErrInvalidRequest().WithDescription("error parsing form").WithParent(err), logging.FromCtx(r.Context()))
+		return
+	}
+	cc, err := formClientCredentials(r)
+	if err != nil {
+		op.WriteError(w, r, err, logging.FromCtx(r.Context()))
+		return
+	}
+	if cc.ClientSecret != "" || cc.ClientAssertion != "" {
+		next.ServeHTTP(w, r)
+		return
+	}
+	request := new(oidc.IntrospectionRequest)
+	if err = s.Provider().Decoder().Decode(request, r.Form); err != nil {
+		op.WriteError(w, r, oidc.ErrInvalidRequest().WithDescription("error decoding form").WithParent(err), logging.FromCtx(r.Context()))
+		return
+	}
+	if request.Token == "" {
+		op.WriteError(w, r, oidc.ErrInvalidRequest().WithDescription("token missing"), logging.FromCtx(r.Context()))
+		return
+	}
+	resp, err := s.Introspect(r.Context(), &op.Request[op.IntrospectionRequest]{
+		Method:   r.Method,
+		URL:      r.URL,
+		Header:   r.Header,
+		Form:     r.Form,
+		PostForm: r.PostForm,
+		Data:     &op.IntrospectionRequest{ClientCredentials: cc, IntrospectionRequest: request},
+	})
+	if err != nil {
+		op.WriteError(w, r, err, logging.FromCtx(r.Context()))
+		return
+	}
+	httphelper.MarshalJSON(w, resp.Data)
+}
+
+// verifyClientCertificate authenticates a client by the certificate of the request,
+// which must either be issued by a trusted authority for the registered subject
+// or contain one of the registered keys, if selfSigned is set (RFC 8705, section 2).
+// The thumbprint of the certificate is returned to bind the issued tokens to it.
+func (s *Server) verifyClientCertificate(ctx context.Context, selfSigned bool, subjectDN, san string, publicKeys map[string][]byte) (_ string, err error) {
+	_, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	cert := mtls.CertificateFromContext(ctx)
+	if cert == nil {
+		return "", oidc.ErrInvalidClient().WithDescription("client certificate required")
+	}
+	if selfSigned {
+		err = mtls.VerifySelfSigned(cert, publicKeys)
+	} else {
+		err = s.verifyClientCertificateChain(cert, subjectDN, san)
+	}
+	if err != nil {
+		return "", oidc.ErrInvalidClient().WithParent(err).WithReturnParentToClient(authz.GetFeatures(ctx).DebugOIDCParentError).WithDescription("invalid client certificate")
+	}
+	return mtls.Thumbprint(cert), nil
+}
+
+func (s *Server) verifyClientCertificateChain(cert *x509.Certificate, subjectDN, san string) error {
+	if err := mtls.VerifyChain(cert, s.clientCAs); err != nil {
+		return err
+	}
+	if !mtls.MatchesSubject(cert, subjectDN, san) {
+		return oidc.ErrInvalidClient().WithDescription("client certificate does not match the registered subject")
+	}
+	return nil
+}
+
+// confirmationClaim returns the value of the cnf claim of a token
+// bound to a DPoP key and / or a client certificate, or nil if the token is not bound.
+func confirmationClaim(dpopJKT, certThumbprint string) map[string]any {
+	var cnf map[string]any
+	if dpopJKT != "" {
+		cnf = dpop.Confirmation(dpopJKT)
+	}
+	if certThumbprint != "" {
+		cnf = mtls.Confirmation(cnf, certThumbprint)
+	}
+	return cnf
+}
+
+// verifyCertificateBoundAccessToken checks that an access token bound to a certificate
+// is used over a connection with that certificate (RFC 8705, section 3).
+// Unbound access tokens are not checked.
+func verifyCertificateBoundAccessToken(ctx context.Context, certThumbprint string) error {
+	if certThumbprint == "" {
+		return nil
+	}
+	cert := mtls.CertificateFromContext(ctx)
+	if cert == nil || mtls.Thumbprint(cert) != certThumbprint {
+		return op.NewStatusError(&oidc.Error{
+			ErrorType:   errorTypeInvalidToken,
+			Description: "access token is bound to a different client certificate",
+		}, http.StatusUnauthorized)
+	}
+	return nil
+}
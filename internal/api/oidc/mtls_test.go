@@ -0,0 +1,73 @@
+package oidc
+
+import (
+	"context"
+	"crypto/x509"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/mtls"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func Test_confirmationClaim(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		dpopJKT        string
+		certThumbprint string
+		want           map[string]any
+	}{
+		{
+			name: "unbound",
+		},
+		{
+			name:    "dpop",
+			dpopJKT: "jkt",
+			want:    map[string]any{"jkt": "jkt"},
+		},
+		{
+			name:           "certificate",
+			certThumbprint: "x5t",
+			want:           map[string]any{mtls.ConfirmationMethod: "x5t"},
+		},
+		{
+			name:           "dpop and certificate",
+			dpopJKT:        "jkt",
+			certThumbprint: "x5t",
+			want:           map[string]any{"jkt": "jkt", mtls.ConfirmationMethod: "x5t"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, confirmationClaim(tt.dpopJKT, tt.certThumbprint))
+		})
+	}
+}
+
+func Test_tokenBinding(t *testing.T) {
+	t.Parallel()
+	publicClient := &Client{client: &query.OIDCClient{AuthMethodType: domain.OIDCAuthMethodTypeNone}}
+	certClient := &Client{client: &query.OIDCClient{AuthMethodType: domain.OIDCAuthMethodTypeTLSClientAuth}, certThumbprint: "x5t"}
+
+	assert.Nil(t, tokenBinding("", nil))
+	assert.Nil(t, tokenBinding("", publicClient))
+	assert.Equal(t, &command.TokenBinding{JKT: "jkt", BindRefreshToken: true}, tokenBinding("jkt", publicClient))
+	assert.Equal(t, &command.TokenBinding{CertThumbprint: "x5t"}, tokenBinding("", certClient))
+	assert.Equal(t, &command.TokenBinding{JKT: "jkt", CertThumbprint: "x5t"}, tokenBinding("jkt", certClient))
+}
+
+func Test_verifyCertificateBoundAccessToken(t *testing.T) {
+	t.Parallel()
+	cert := &x509.Certificate{Raw: []byte("certificate")}
+	ctx := mtls.WithCertificate(context.Background(), cert)
+
+	assert.NoError(t, verifyCertificateBoundAccessToken(context.Background(), ""))
+	assert.NoError(t, verifyCertificateBoundAccessToken(ctx, mtls.Thumbprint(cert)))
+	assert.Error(t, verifyCertificateBoundAccessToken(ctx, "other"))
+	assert.Error(t, verifyCertificateBoundAccessToken(context.Background(), mtls.Thumbprint(cert)))
+}
@@ -2,6 +2,7 @@ package oidc
 
 import (
 	"context"
+	"crypto/x509"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -13,17 +14,21 @@ import (
 
 	"github.com/zitadel/zitadel/backend/v3/instrumentation/metrics"
 	"github.com/zitadel/zitadel/internal/api/assets"
+	"github.com/zitadel/zitadel/internal/api/dpop"
 	http_utils "github.com/zitadel/zitadel/internal/api/http"
 	"github.com/zitadel/zitadel/internal/api/http/middleware"
+	"github.com/zitadel/zitadel/internal/api/mtls"
 	"github.com/zitadel/zitadel/internal/api/ui/login"
 	"github.com/zitadel/zitadel/internal/auth/repository"
 	"github.com/zitadel/zitadel/internal/cache"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain/cibapoll"
 	"github.com/zitadel/zitadel/internal/domain/federatedlogout"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/notification/handlers"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/ratelimit"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -40,11 +45,24 @@ type Config struct {
 	JWKSCacheControlMaxAge            time.Duration
 	CustomEndpoints                   *EndpointConfig
 	DeviceAuth                        *DeviceAuthorizationConfig
+	CIBA                              *CIBAConfig
 	DefaultLoginURLV2                 string
 	DefaultLogoutURLV2                string
 	PublicKeyCacheMaxAge              time.Duration
 	DefaultBackChannelLogoutLifetime  time.Duration
 	BackChannelLogout                 handlers.BackChannelLogoutWorkerConfig
+	MTLS                              MTLSConfig
+}
+
+// MTLSConfig configures mutual-TLS client authentication (RFC 8705).
+type MTLSConfig struct {
+	// CertificateHeader is the header a trusted proxy forwards the client certificate in.
+	CertificateHeader string
+	// TrustedProxies are the addresses (IPs or CIDRs) of the proxies terminating TLS in front of ZITADEL.
+	// The CertificateHeader is only read from requests sent by a trusted proxy.
+	TrustedProxies []string
+	// CAPath is the PEM file of the authorities trusted to issue client certificates.
+	CAPath string
 }
 
 // BackChannelLogoutConfig returns the BackChannelLogoutWorkerConfig and takes the deprecated TokenLifetime into account.
@@ -66,6 +84,10 @@ type EndpointConfig struct {
 	Keys          *Endpoint
 	DeviceAuth    *Endpoint
 	Registration  *Endpoint
+	// PushedAuthRequest is the pushed authorization request endpoint (RFC 9126).
+	PushedAuthRequest *Endpoint
+	// BackchannelAuthentication is the backchannel authentication endpoint (OpenID CIBA).
+	BackchannelAuthentication *Endpoint
 }
 
 type Endpoint struct {
@@ -126,9 +148,12 @@ func NewServer(
 	es *eventstore.Eventstore,
 	userAgentCookie, instanceHandler func(http.Handler) http.Handler,
 	accessHandler *middleware.AccessInterceptor,
+	rateLimiter *ratelimit.Limiter,
 	fallbackLogger *slog.Logger,
 	hashConfig crypto.HashConfig,
 	federatedLogoutCache cache.Cache[federatedlogout.Index, string, *federatedlogout.FederatedLogout],
+	dpopVerifier *dpop.Verifier,
+	cibaPolls cache.Cache[cibapoll.Index, string, *cibapoll.Poll],
 	httpClient *http.Client,
 ) (*Server, error) {
 	opConfig, err := createOPConfig(config, defaultLogoutRedirectURI, cryptoKey)
@@ -169,6 +194,17 @@ func NewServer(
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "OIDC-Aij4e", "cannot create secret hasher")
 	}
+	clientCertificates, err := mtls.NewCertificateReader(config.MTLS.CertificateHeader, config.MTLS.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+	var clientCAs *x509.CertPool
+	if config.MTLS.CAPath != "" {
+		clientCAs, err = mtls.LoadCertPool(config.MTLS.CAPath)
+		if err != nil {
+			return nil, zerrors.ThrowInternal(err, "OIDC-aiM7o", "cannot load client certificate authorities")
+		}
+	}
 	server := &Server{
 		LegacyServer: op.NewLegacyServer(&Provider{
 			Provider:          provider,
@@ -194,6 +230,13 @@ func NewServer(
 		assetAPIPrefix:             assets.AssetAPI(),
 		httpClient:                 httpClient,
 		registrationEndpoint:       registrationEndpoint(config.CustomEndpoints),
+		pushedAuthRequestEndpoint:  pushedAuthRequestEndpoint(config.CustomEndpoints),
+		backchannelAuthEndpoint:    backchannelAuthEndpoint(config.CustomEndpoints),
+		cibaConfig:                 config.CIBA,
+		cibaPolls:                  cibaPolls,
+		clientCertificates:         clientCertificates,
+		clientCAs:                  clientCAs,
+		dpopVerifier:               dpopVerifier,
 	}
 	metricTypes := []metrics.MetricType{metrics.MetricTypeRequestCount, metrics.MetricTypeStatusCode, metrics.MetricTypeTotalCount}
 
@@ -218,8 +261,12 @@ func NewServer(
 			userAgentCookie,
 			http_utils.CopyHeadersToContext,
 			accessHandler.HandleWithPublicAuthPathPrefixes(publicAuthPathPrefixes(config.CustomEndpoints)),
+			middleware.RateLimitHandler(rateLimiter, ratelimit.EndpointToken, nil, server.Endpoints().Token.Relative()),
 			middleware.ActivityHandler,
+			dpopSchemeInterceptor,
 			op.NewIssuerInterceptor(server.IssuerFromRequest).Handler,
+			server.clientCertificateInterceptor,
+			server.cibaTokenInterceptor,
 		),
 		op.WithSetRouter(func(r chi.Router) {
 			r.HandleFunc(server.Endpoints().Authorization.Relative()+authCallbackPathSuffix, server.authorizeCallbackHandler)
@@ -227,6 +274,8 @@ func NewServer(
 			r.Method(http.MethodGet, server.registrationEndpoint.Relative()+"/{client_id}", http.HandlerFunc(server.getDynamicClientRegistration))
 			r.Method(http.MethodPut, server.registrationEndpoint.Relative()+"/{client_id}", http.HandlerFunc(server.updateDynamicClientRegistration))
 			r.Method(http.MethodDelete, server.registrationEndpoint.Relative()+"/{client_id}", http.HandlerFunc(server.deleteDynamicClientRegistration))
+			r.Method(http.MethodPost, server.pushedAuthRequestEndpoint.Relative(), http.HandlerFunc(server.pushedAuthorizationRequest))
+			r.Method(http.MethodPost, server.backchannelAuthEndpoint.Relative(), http.HandlerFunc(server.backchannelAuthentication))
 		}),
 	)
 
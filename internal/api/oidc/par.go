@@ -0,0 +1,213 @@
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	httphelper "github.com/zitadel/oidc/v3/pkg/http"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/backend/v3/instrumentation/logging"
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	// pushedAuthRequestURIPrefix prefixes the ID of a pushed authorization request
+	// to build its request_uri (RFC 9126, section 2.2).
+	pushedAuthRequestURIPrefix = "urn:ietf:params:oauth:request_uri:"
+	// pushedAuthRequestLifetime is kept short, as the request_uri is only meant
+	// for the immediately following redirect to the authorization endpoint.
+	pushedAuthRequestLifetime = time.Minute
+
+	errorTypeInvalidRequestURI = "invalid_request_uri"
+)
+
+// pushedAuthRequestClientParams are removed from a pushed authorization request before it is stored,
+// so no client credentials are persisted.
+var pushedAuthRequestClientParams = []string{"client_secret", "client_assertion", "client_assertion_type"}
+
+type pushedAuthRequestResponse struct {
+	RequestURI string `json:"request_uri"`
+	ExpiresIn  int64  `json:"expires_in"`
+}
+
+// pushedAuthorizationRequest handles requests to the pushed authorization request endpoint (RFC 9126).
+// The client is authenticated as on the token endpoint and the request is validated
+// as on the authorization endpoint, before it is stored for a short time.
+// The returned request_uri is then passed to the authorization endpoint instead of the parameters.
+func (s *Server) pushedAuthorizationRequest(w http.ResponseWriter, r *http.Request) {
+	ctx, span := tracing.NewSpan(r.Context())
+	r = r.WithContext(ctx)
+	resp, err := s.pushAuthRequest(ctx, r)
+	span.EndWithError(err)
+	if err != nil {
+		op.WriteError(w, r, oidcError(ctx, err), logging.FromCtx(ctx))
+		return
+	}
+	httphelper.MarshalJSONWithStatus(w, resp, http.StatusCreated)
+}
+
+func (s *Server) pushAuthRequest(ctx context.Context, r *http.Request) (*pushedAuthRequestResponse, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, oidc.ErrInvalidRequest().WithDescription("error parsing form").WithParent(err)
+	}
+	cc, err := formClientCredentials(r)
+	if err != nil {
+		return nil, err
+	}
+	client, err := s.VerifyClient(ctx, &op.Request[op.ClientCredentials]{
+		Method: r.Method,
+		URL:    r.URL,
+		Header: r.Header,
+		Form:   r.PostForm,
+		Data:   cc,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if r.PostForm.Has("request_uri") {
+		return nil, oidc.ErrInvalidRequest().WithDescription("request_uri must not be pushed")
+	}
+	authReq := new(oidc.AuthRequest)
+	if err = s.Provider().Decoder().Decode(authReq, r.PostForm); err != nil {
+		return nil, oidc.ErrInvalidRequest().WithDescription("error decoding form").WithParent(err)
+	}
+	if authReq.ClientID != "" && authReq.ClientID != client.GetID() {
+		return nil, oidc.ErrInvalidRequest().WithDescription("client_id does not match the authenticated client")
+	}
+	authReq.ClientID = client.GetID()
+	if err = s.validatePushedAuthRequest(ctx, authReq, client); err != nil {
+		return nil, err
+	}
+	if zitadelClient, ok := client.(*Client); ok {
+		if _, err = s.parseAuthorizationDetails(ctx, r.PostForm.Get(authorizationDetailsParam), zitadelClient); err != nil {
+			return nil, err
+		}
+	}
+
+	parameters := make(url.Values, len(r.PostForm))
+	for key, values := range r.PostForm {
+		parameters[key] = values
+	}
+	for _, param := range pushedAuthRequestClientParams {
+		parameters.Del(param)
+	}
+	parameters.Set("client_id", client.GetID())
+	pushed, err := s.command.AddPushedAuthRequest(ctx, client.GetID(), parameters, time.Now().Add(pushedAuthRequestLifetime))
+	if err != nil {
+		return nil, err
+	}
+	return &pushedAuthRequestResponse{
+		RequestURI: pushedAuthRequestURIPrefix + pushed.ID,
+		ExpiresIn:  int64(pushedAuthRequestLifetime / time.Second),
+	}, nil
+}
+
+// formClientCredentials reads the client authentication of the request,
+// where basic auth takes precedence over the form, as on the token endpoint.
+func formClientCredentials(r *http.Request) (_ *op.ClientCredentials, err error) {
+	cc := &op.ClientCredentials{
+		ClientID:            r.PostForm.Get("client_id"),
+		ClientSecret:        r.PostForm.Get("client_secret"),
+		ClientAssertion:     r.PostForm.Get("client_assertion"),
+		ClientAssertionType: r.PostForm.Get("client_assertion_type"),
+	}
+	if clientID, clientSecret, ok := r.BasicAuth(); ok {
+		if cc.ClientID, err = url.QueryUnescape(clientID); err != nil {
+			return nil, oidc.ErrInvalidClient().WithDescription("invalid basic auth header").WithParent(err)
+		}
+		if cc.ClientSecret, err = url.QueryUnescape(clientSecret); err != nil {
+			return nil, oidc.ErrInvalidClient().WithDescription("invalid basic auth header").WithParent(err)
+		}
+	}
+	if cc.ClientID == "" && cc.ClientAssertion == "" {
+		return nil, oidc.ErrInvalidRequest().WithDescription("client_id or client_assertion must be provided")
+	}
+	if cc.ClientAssertion != "" && cc.ClientAssertionType != oidc.ClientAssertionTypeJWTAssertion {
+		return nil, oidc.ErrInvalidRequest().WithDescription("invalid client_assertion_type %s", cc.ClientAssertionType)
+	}
+	return cc, nil
+}
+
+// validatePushedAuthRequest validates the request as the authorization endpoint does,
+// so errors are returned to the client directly instead of after the redirect of the user.
+// The id_token_hint is not checked, as the authorization endpoint only ignores an invalid one.
+func (s *Server) validatePushedAuthRequest(ctx context.Context, authReq *oidc.AuthRequest, client op.Client) (err error) {
+	if authReq.RequestParam != "" {
+		if !s.Provider().RequestObjectSupported() {
+			return oidc.ErrRequestNotSupported()
+		}
+		if err = op.ParseRequestObject(ctx, authReq, s.Provider().Storage(), op.IssuerFromContext(ctx)); err != nil {
+			return err
+		}
+	}
+	if authReq.RedirectURI == "" {
+		return oidc.ErrInvalidRequest().WithDescription("redirect_uri is missing")
+	}
+	if _, err = op.ValidateAuthReqPrompt(authReq.Prompt, authReq.MaxAge); err != nil {
+		return err
+	}
+	if _, err = op.ValidateAuthReqScopes(client, authReq.Scopes); err != nil {
+		return err
+	}
+	if err = op.ValidateAuthReqRedirectURI(client, authReq.RedirectURI, authReq.ResponseType); err != nil {
+		return err
+	}
+	return op.ValidateAuthReqResponseType(client, authReq.ResponseType)
+}
+
+// resolvePushedAuthRequest replaces the parameters of an authorization request
+// referencing a pushed authorization request by its request_uri with the pushed ones (RFC 9126, section 4).
+// It returns whether the request was pushed.
+func (s *Server) resolvePushedAuthRequest(ctx context.Context, r *op.Request[oidc.AuthRequest]) (bool, error) {
+	requestURI := r.Form.Get("request_uri")
+	if requestURI == "" {
+		return false, nil
+	}
+	id, ok := strings.CutPrefix(requestURI, pushedAuthRequestURIPrefix)
+	if !ok || id == "" || r.Data.ClientID == "" {
+		return false, invalidRequestURIError()
+	}
+	parameters, err := s.command.UsePushedAuthRequest(ctx, id, r.Data.ClientID)
+	if zerrors.IsNotFound(err) || zerrors.IsPreconditionFailed(err) {
+		return false, invalidRequestURIError().WithParent(err).WithReturnParentToClient(authz.GetFeatures(ctx).DebugOIDCParentError)
+	}
+	if err != nil {
+		return false, oidcError(ctx, err)
+	}
+	authReq := new(oidc.AuthRequest)
+	if err = s.Provider().Decoder().Decode(authReq, parameters); err != nil {
+		return false, oidc.ErrServerError().WithParent(err)
+	}
+	r.Data = authReq
+	r.Form = parameters
+	return true, nil
+}
+
+func invalidRequestURIError() *oidc.Error {
+	return &oidc.Error{
+		ErrorType:   errorTypeInvalidRequestURI,
+		Description: "the request_uri is invalid, expired or was already used",
+	}
+}
+
+// pushedAuthRequestRequiredError is returned for authorization requests
+// of clients requiring pushed authorization requests which were passed directly.
+func pushedAuthRequestRequiredError() *oidc.Error {
+	return oidc.ErrInvalidRequest().WithDescription("the client requires pushed authorization requests")
+}
+
+// pushedAuthRequestEndpoint resolves the pushed authorization request endpoint (RFC 9126),
+// optionally overridden through the custom endpoint configuration.
+func pushedAuthRequestEndpoint(endpointConfig *EndpointConfig) *op.Endpoint {
+	if endpointConfig != nil && endpointConfig.PushedAuthRequest != nil {
+		return op.NewEndpointWithURL(endpointConfig.PushedAuthRequest.Path, endpointConfig.PushedAuthRequest.URL)
+	}
+	return op.NewEndpoint("/oauth/v2/par")
+}
@@ -0,0 +1,155 @@
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+)
+
+func Test_formClientCredentials(t *testing.T) {
+	t.Parallel()
+	newRequest := func(form url.Values, basicUser, basicPassword string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/oauth/v2/par", strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		if basicUser != "" {
+			r.SetBasicAuth(basicUser, basicPassword)
+		}
+		require.NoError(t, r.ParseForm())
+		return r
+	}
+	tests := []struct {
+		name    string
+		r       *http.Request
+		want    *op.ClientCredentials
+		wantErr bool
+	}{
+		{
+			name:    "missing client",
+			r:       newRequest(url.Values{"scope": {"openid"}}, "", ""),
+			wantErr: true,
+		},
+		{
+			name: "invalid assertion type",
+			r: newRequest(url.Values{
+				"client_assertion":      {"assertion"},
+				"client_assertion_type": {"other"},
+			}, "", ""),
+			wantErr: true,
+		},
+		{
+			name: "form",
+			r: newRequest(url.Values{
+				"client_id":     {"client"},
+				"client_secret": {"secret"},
+			}, "", ""),
+			want: &op.ClientCredentials{
+				ClientID:     "client",
+				ClientSecret: "secret",
+			},
+		},
+		{
+			name: "basic auth takes precedence",
+			r: newRequest(url.Values{
+				"client_id":     {"client"},
+				"client_secret": {"secret"},
+			}, "basic%3Aclient", "basic-secret"),
+			want: &op.ClientCredentials{
+				ClientID:     "basic:client",
+				ClientSecret: "basic-secret",
+			},
+		},
+		{
+			name: "assertion",
+			r: newRequest(url.Values{
+				"client_assertion":      {"assertion"},
+				"client_assertion_type": {oidc.ClientAssertionTypeJWTAssertion},
+			}, "", ""),
+			want: &op.ClientCredentials{
+				ClientAssertion:     "assertion",
+				ClientAssertionType: oidc.ClientAssertionTypeJWTAssertion,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := formClientCredentials(tt.r)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_Server_resolvePushedAuthRequest_invalidRequestURI(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		requestURI string
+		clientID   string
+		wantPushed bool
+		wantErr    bool
+	}{
+		{
+			name: "no request_uri",
+		},
+		{
+			name:       "other scheme",
+			requestURI: "https://example.com/request",
+			clientID:   "client",
+			wantErr:    true,
+		},
+		{
+			name:       "empty id",
+			requestURI: pushedAuthRequestURIPrefix,
+			clientID:   "client",
+			wantErr:    true,
+		},
+		{
+			name:       "missing client_id",
+			requestURI: pushedAuthRequestURIPrefix + "id",
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s := &Server{}
+			r := &op.Request[oidc.AuthRequest]{
+				Form: url.Values{},
+				Data: &oidc.AuthRequest{ClientID: tt.clientID},
+			}
+			if tt.requestURI != "" {
+				r.Form.Set("request_uri", tt.requestURI)
+			}
+			pushed, err := s.resolvePushedAuthRequest(context.Background(), r)
+			assert.Equal(t, tt.wantPushed, pushed)
+			if tt.wantErr {
+				var oidcErr *oidc.Error
+				require.ErrorAs(t, err, &oidcErr)
+				assert.Equal(t, errorTypeInvalidRequestURI, string(oidcErr.ErrorType))
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_pushedAuthRequestEndpoint(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "/oauth/v2/par", pushedAuthRequestEndpoint(nil).Relative())
+	assert.Equal(t, "/custom/par", pushedAuthRequestEndpoint(&EndpointConfig{
+		PushedAuthRequest: &Endpoint{Path: "/custom/par"},
+	}).Relative())
+}
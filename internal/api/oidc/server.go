@@ -2,6 +2,7 @@ package oidc
 
 import (
 	"context"
+	"crypto/x509"
 	"log/slog"
 	"net/http"
 	"time"
@@ -11,9 +12,13 @@ import (
 	"github.com/zitadel/oidc/v3/pkg/op"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/dpop"
+	"github.com/zitadel/zitadel/internal/api/mtls"
 	"github.com/zitadel/zitadel/internal/auth/repository"
+	"github.com/zitadel/zitadel/internal/cache"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain/cibapoll"
 	"github.com/zitadel/zitadel/internal/i18n"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
@@ -46,7 +51,17 @@ type Server struct {
 	assetAPIPrefix func(ctx context.Context) string
 	httpClient     *http.Client
 
-	registrationEndpoint *op.Endpoint
+	registrationEndpoint      *op.Endpoint
+	pushedAuthRequestEndpoint *op.Endpoint
+
+	backchannelAuthEndpoint *op.Endpoint
+	cibaConfig              *CIBAConfig
+	cibaPolls               cache.Cache[cibapoll.Index, string, *cibapoll.Poll]
+
+	clientCertificates *mtls.CertificateReader
+	clientCAs          *x509.CertPool
+
+	dpopVerifier *dpop.Verifier
 }
 
 func endpoints(endpointConfig *EndpointConfig) op.Endpoints {
@@ -134,7 +149,18 @@ func (s *Server) VerifyAuthRequest(ctx context.Context, r *op.Request[oidc.AuthR
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	return s.LegacyServer.VerifyAuthRequest(ctx, r)
+	pushed, err := s.resolvePushedAuthRequest(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	cr, err := s.LegacyServer.VerifyAuthRequest(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	if client, ok := cr.Client.(*Client); ok && client.client.PARRequired && !pushed {
+		return nil, pushedAuthRequestRequiredError()
+	}
+	return cr, nil
 }
 
 func (s *Server) Authorize(ctx context.Context, r *op.ClientRequest[oidc.AuthRequest]) (_ *op.Redirect, err error) {
@@ -147,6 +173,15 @@ func (s *Server) Authorize(ctx context.Context, r *op.ClientRequest[oidc.AuthReq
 	logging.WithFields("instanceID", authz.GetInstance(ctx).InstanceID()).
 		OnError(err).Error("invalid id_token_hint")
 
+	if client, ok := r.Client.(*Client); ok {
+		details, err := s.parseAuthorizationDetails(ctx, r.Form.Get(authorizationDetailsParam), client)
+		if err != nil {
+			return op.TryErrorRedirect(ctx, r.Data, err, s.Provider().Encoder(), s.Provider().Logger())
+		}
+		ctx = contextWithAuthorizationDetails(ctx, details)
+		ctx = contextWithConsentRequired(ctx, client)
+	}
+
 	req, err := s.Provider().Storage().CreateAuthRequest(ctx, r.Data, userID)
 	if err != nil {
 		return op.TryErrorRedirect(ctx, r.Data, oidc.DefaultToServerError(err, "unable to save auth request"), s.Provider().Encoder(), s.Provider().Logger())
@@ -175,7 +210,20 @@ func (s *Server) EndSession(ctx context.Context, r *op.Request[oidc.EndSessionRe
 	return s.LegacyServer.EndSession(ctx, r)
 }
 
-func (s *Server) createDiscoveryConfig(ctx context.Context, supportedUILocales oidc.Locales) *oidc.DiscoveryConfiguration {
+// discoveryConfiguration extends the discovery metadata of the OIDC library
+// with the pushed authorization request endpoint (RFC 9126, section 5),
+// the support of certificate-bound access tokens (RFC 8705, section 3.3)
+// and the backchannel authentication (OpenID CIBA Core, section 4).
+type discoveryConfiguration struct {
+	*oidc.DiscoveryConfiguration
+	PushedAuthorizationRequestEndpoint     string   `json:"pushed_authorization_request_endpoint,omitempty"`
+	TLSClientCertificateBoundAccessTokens  bool     `json:"tls_client_certificate_bound_access_tokens,omitempty"`
+	BackchannelAuthenticationEndpoint      string   `json:"backchannel_authentication_endpoint,omitempty"`
+	BackchannelTokenDeliveryModesSupported []string `json:"backchannel_token_delivery_modes_supported,omitempty"`
+	BackchannelUserCodeParameterSupported  bool     `json:"backchannel_user_code_parameter_supported"`
+}
+
+func (s *Server) createDiscoveryConfig(ctx context.Context, supportedUILocales oidc.Locales) *discoveryConfiguration {
 	issuer := op.IssuerFromContext(ctx)
 
 	// The registration endpoint is only advertised when dynamic client registration is
@@ -185,7 +233,7 @@ func (s *Server) createDiscoveryConfig(ctx context.Context, supportedUILocales o
 		registrationEndpoint = s.registrationEndpoint.Absolute(issuer)
 	}
 
-	return &oidc.DiscoveryConfiguration{
+	config := &oidc.DiscoveryConfiguration{
 		Issuer:                      issuer,
 		RegistrationEndpoint:        registrationEndpoint,
 		AuthorizationEndpoint:       s.Endpoints().Authorization.Absolute(issuer),
@@ -220,6 +268,25 @@ func (s *Server) createDiscoveryConfig(ctx context.Context, supportedUILocales o
 		BackChannelLogoutSupported:                         true,
 		BackChannelLogoutSessionSupported:                  true,
 	}
+	config.TokenEndpointAuthMethodsSupported = append(config.TokenEndpointAuthMethodsSupported, s.tlsClientAuthMethods()...)
+	config.IntrospectionEndpointAuthMethodsSupported = append(config.IntrospectionEndpointAuthMethodsSupported, s.tlsClientAuthMethods()...)
+	config.GrantTypesSupported = append(config.GrantTypesSupported, grantTypeCIBA)
+	return &discoveryConfiguration{
+		DiscoveryConfiguration:                 config,
+		PushedAuthorizationRequestEndpoint:     s.pushedAuthRequestEndpoint.Absolute(issuer),
+		TLSClientCertificateBoundAccessTokens:  true,
+		BackchannelAuthenticationEndpoint:      s.backchannelAuthEndpoint.Absolute(issuer),
+		BackchannelTokenDeliveryModesSupported: []string{cibaDeliveryModePoll, cibaDeliveryModePing},
+	}
+}
+
+// tlsClientAuthMethods returns the supported mutual-TLS client authentication methods (RFC 8705, section 2).
+// The tls_client_auth method requires trusted certificate authorities to be configured.
+func (s *Server) tlsClientAuthMethods() []oidc.AuthMethod {
+	if s.clientCAs == nil {
+		return []oidc.AuthMethod{authMethodSelfSignedTLSClientAuth}
+	}
+	return []oidc.AuthMethod{authMethodTLSClientAuth, authMethodSelfSignedTLSClientAuth}
 }
 
 func response(resp any, err error) (*op.Response, error) {
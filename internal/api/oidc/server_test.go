@@ -25,7 +25,7 @@ func TestServer_createDiscoveryConfig(t *testing.T) {
 		name   string
 		fields fields
 		args   args
-		want   *oidc.DiscoveryConfiguration
+		want   *discoveryConfiguration
 	}{
 		{
 			"config",
@@ -62,62 +62,70 @@ func TestServer_createDiscoveryConfig(t *testing.T) {
 				ctx:                op.ContextWithIssuer(context.Background(), "https://issuer.com"),
 				supportedUILocales: []language.Tag{language.English, language.German},
 			},
-			&oidc.DiscoveryConfiguration{
-				Issuer:                                             "https://issuer.com",
-				AuthorizationEndpoint:                              "https://issuer.com/auth",
-				TokenEndpoint:                                      "https://issuer.com/token",
-				IntrospectionEndpoint:                              "https://issuer.com/introspect",
-				UserinfoEndpoint:                                   "https://issuer.com/userinfo",
-				RevocationEndpoint:                                 "https://issuer.com/revoke",
-				EndSessionEndpoint:                                 "https://issuer.com/logout",
-				DeviceAuthorizationEndpoint:                        "https://issuer.com/device",
-				CheckSessionIframe:                                 "",
-				JwksURI:                                            "https://issuer.com/keys",
-				RegistrationEndpoint:                               "",
-				ScopesSupported:                                    []string{oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail, oidc.ScopePhone, oidc.ScopeAddress, oidc.ScopeOfflineAccess},
-				ResponseTypesSupported:                             []string{string(oidc.ResponseTypeCode), string(oidc.ResponseTypeIDTokenOnly), string(oidc.ResponseTypeIDToken)},
-				ResponseModesSupported:                             []string{string(oidc.ResponseModeQuery), string(oidc.ResponseModeFragment), string(oidc.ResponseModeFormPost)},
-				GrantTypesSupported:                                []oidc.GrantType{oidc.GrantTypeCode, oidc.GrantTypeImplicit, oidc.GrantTypeRefreshToken, oidc.GrantTypeBearer},
-				ACRValuesSupported:                                 nil,
-				SubjectTypesSupported:                              []string{"public"},
-				IDTokenSigningAlgValuesSupported:                   supportedWebKeyAlgs,
-				IDTokenEncryptionAlgValuesSupported:                nil,
-				IDTokenEncryptionEncValuesSupported:                nil,
-				UserinfoSigningAlgValuesSupported:                  nil,
-				UserinfoEncryptionAlgValuesSupported:               nil,
-				UserinfoEncryptionEncValuesSupported:               nil,
-				RequestObjectSigningAlgValuesSupported:             []string{"RS256"},
-				RequestObjectEncryptionAlgValuesSupported:          nil,
-				RequestObjectEncryptionEncValuesSupported:          nil,
-				TokenEndpointAuthMethodsSupported:                  []oidc.AuthMethod{oidc.AuthMethodNone, oidc.AuthMethodBasic, oidc.AuthMethodPost, oidc.AuthMethodPrivateKeyJWT},
-				TokenEndpointAuthSigningAlgValuesSupported:         []string{"RS256"},
-				RevocationEndpointAuthMethodsSupported:             []oidc.AuthMethod{oidc.AuthMethodNone, oidc.AuthMethodBasic, oidc.AuthMethodPost, oidc.AuthMethodPrivateKeyJWT},
-				RevocationEndpointAuthSigningAlgValuesSupported:    []string{"RS256"},
-				IntrospectionEndpointAuthMethodsSupported:          []oidc.AuthMethod{oidc.AuthMethodBasic, oidc.AuthMethodPrivateKeyJWT},
-				IntrospectionEndpointAuthSigningAlgValuesSupported: []string{"RS256"},
-				DisplayValuesSupported:                             nil,
-				ClaimTypesSupported:                                nil,
-				ClaimsSupported:                                    []string{"sub", "aud", "exp", "iat", "iss", "auth_time", "nonce", "acr", "amr", "c_hash", "at_hash", "act", "scopes", "client_id", "azp", "preferred_username", "name", "family_name", "given_name", "locale", "email", "email_verified", "phone_number", "phone_number_verified"},
-				ClaimsParameterSupported:                           false,
-				CodeChallengeMethodsSupported:                      []oidc.CodeChallengeMethod{"S256"},
-				ServiceDocumentation:                               "",
-				ClaimsLocalesSupported:                             nil,
-				UILocalesSupported:                                 []language.Tag{language.English, language.German},
-				RequestParameterSupported:                          true,
-				RequestURIParameterSupported:                       false,
-				RequireRequestURIRegistration:                      false,
-				OPPolicyURI:                                        "",
-				OPTermsOfServiceURI:                                "",
-				BackChannelLogoutSupported:                         true,
-				BackChannelLogoutSessionSupported:                  true,
+			&discoveryConfiguration{
+				DiscoveryConfiguration: &oidc.DiscoveryConfiguration{
+					Issuer:                                             "https://issuer.com",
+					AuthorizationEndpoint:                              "https://issuer.com/auth",
+					TokenEndpoint:                                      "https://issuer.com/token",
+					IntrospectionEndpoint:                              "https://issuer.com/introspect",
+					UserinfoEndpoint:                                   "https://issuer.com/userinfo",
+					RevocationEndpoint:                                 "https://issuer.com/revoke",
+					EndSessionEndpoint:                                 "https://issuer.com/logout",
+					DeviceAuthorizationEndpoint:                        "https://issuer.com/device",
+					CheckSessionIframe:                                 "",
+					JwksURI:                                            "https://issuer.com/keys",
+					RegistrationEndpoint:                               "",
+					ScopesSupported:                                    []string{oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail, oidc.ScopePhone, oidc.ScopeAddress, oidc.ScopeOfflineAccess},
+					ResponseTypesSupported:                             []string{string(oidc.ResponseTypeCode), string(oidc.ResponseTypeIDTokenOnly), string(oidc.ResponseTypeIDToken)},
+					ResponseModesSupported:                             []string{string(oidc.ResponseModeQuery), string(oidc.ResponseModeFragment), string(oidc.ResponseModeFormPost)},
+					GrantTypesSupported:                                []oidc.GrantType{oidc.GrantTypeCode, oidc.GrantTypeImplicit, oidc.GrantTypeRefreshToken, oidc.GrantTypeBearer, grantTypeCIBA},
+					ACRValuesSupported:                                 nil,
+					SubjectTypesSupported:                              []string{"public"},
+					IDTokenSigningAlgValuesSupported:                   supportedWebKeyAlgs,
+					IDTokenEncryptionAlgValuesSupported:                nil,
+					IDTokenEncryptionEncValuesSupported:                nil,
+					UserinfoSigningAlgValuesSupported:                  nil,
+					UserinfoEncryptionAlgValuesSupported:               nil,
+					UserinfoEncryptionEncValuesSupported:               nil,
+					RequestObjectSigningAlgValuesSupported:             []string{"RS256"},
+					RequestObjectEncryptionAlgValuesSupported:          nil,
+					RequestObjectEncryptionEncValuesSupported:          nil,
+					TokenEndpointAuthMethodsSupported:                  []oidc.AuthMethod{oidc.AuthMethodNone, oidc.AuthMethodBasic, oidc.AuthMethodPost, oidc.AuthMethodPrivateKeyJWT, authMethodSelfSignedTLSClientAuth},
+					TokenEndpointAuthSigningAlgValuesSupported:         []string{"RS256"},
+					RevocationEndpointAuthMethodsSupported:             []oidc.AuthMethod{oidc.AuthMethodNone, oidc.AuthMethodBasic, oidc.AuthMethodPost, oidc.AuthMethodPrivateKeyJWT},
+					RevocationEndpointAuthSigningAlgValuesSupported:    []string{"RS256"},
+					IntrospectionEndpointAuthMethodsSupported:          []oidc.AuthMethod{oidc.AuthMethodBasic, oidc.AuthMethodPrivateKeyJWT, authMethodSelfSignedTLSClientAuth},
+					IntrospectionEndpointAuthSigningAlgValuesSupported: []string{"RS256"},
+					DisplayValuesSupported:                             nil,
+					ClaimTypesSupported:                                nil,
+					ClaimsSupported:                                    []string{"sub", "aud", "exp", "iat", "iss", "auth_time", "nonce", "acr", "amr", "c_hash", "at_hash", "act", "scopes", "client_id", "azp", "preferred_username", "name", "family_name", "given_name", "locale", "email", "email_verified", "phone_number", "phone_number_verified"},
+					ClaimsParameterSupported:                           false,
+					CodeChallengeMethodsSupported:                      []oidc.CodeChallengeMethod{"S256"},
+					ServiceDocumentation:                               "",
+					ClaimsLocalesSupported:                             nil,
+					UILocalesSupported:                                 []language.Tag{language.English, language.German},
+					RequestParameterSupported:                          true,
+					RequestURIParameterSupported:                       false,
+					RequireRequestURIRegistration:                      false,
+					OPPolicyURI:                                        "",
+					OPTermsOfServiceURI:                                "",
+					BackChannelLogoutSupported:                         true,
+					BackChannelLogoutSessionSupported:                  true,
+				},
+				PushedAuthorizationRequestEndpoint:     "https://issuer.com/par",
+				TLSClientCertificateBoundAccessTokens:  true,
+				BackchannelAuthenticationEndpoint:      "https://issuer.com/bc-authorize",
+				BackchannelTokenDeliveryModesSupported: []string{"poll", "ping"},
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			s := &Server{
-				LegacyServer:        tt.fields.LegacyServer,
-				signingKeyAlgorithm: tt.fields.signingKeyAlgorithm,
+				LegacyServer:              tt.fields.LegacyServer,
+				signingKeyAlgorithm:       tt.fields.signingKeyAlgorithm,
+				pushedAuthRequestEndpoint: op.NewEndpoint("par"),
+				backchannelAuthEndpoint:   op.NewEndpoint("bc-authorize"),
 			}
 			assert.Equalf(t, tt.want, s.createDiscoveryConfig(tt.args.ctx, tt.args.supportedUILocales), "createDiscoveryConfig(%v)", tt.args.ctx)
 		})
@@ -134,7 +142,9 @@ func TestServer_createDiscoveryConfig_registrationEndpoint(t *testing.T) {
 			}(),
 			op.Endpoints{Authorization: op.NewEndpoint("auth")},
 		),
-		registrationEndpoint: op.NewEndpoint("register"),
+		registrationEndpoint:      op.NewEndpoint("register"),
+		pushedAuthRequestEndpoint: op.NewEndpoint("par"),
+		backchannelAuthEndpoint:   op.NewEndpoint("bc-authorize"),
 	}
 	tests := []struct {
 		name string
@@ -2,6 +2,7 @@ package oidc
 
 import (
 	"context"
+	"maps"
 	"slices"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/zitadel/oidc/v3/pkg/oidc"
 	"github.com/zitadel/oidc/v3/pkg/op"
 
+	"github.com/zitadel/zitadel/internal/api/dpop"
 	"github.com/zitadel/zitadel/internal/api/oidc/sign"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/domain"
@@ -28,6 +30,7 @@ for example the v2 code exchange and refresh token.
 */
 
 func (s *Server) accessTokenResponseFromSession(ctx context.Context, client op.Client, session *command.OIDCSession, state, projectID string, projectRoleAssertion, accessTokenRoleAssertion, idTokenRoleAssertion, userInfoAssertion bool) (_ *oidc.AccessTokenResponse, err error) {
+	ctx = contextWithAuthorizationDetails(ctx, session.AuthorizationDetails)
 	getUserInfo := s.getUserInfo(session.UserID, projectID, client.GetID(), projectRoleAssertion, userInfoAssertion, session.Scope)
 	getSigner := s.getSignerOnce()
 
@@ -37,6 +40,9 @@ func (s *Server) accessTokenResponseFromSession(ctx context.Context, client op.C
 		ExpiresIn:    timeToOIDCExpiresIn(session.Expiration),
 		State:        state,
 	}
+	if session.DPoPJKT != "" {
+		resp.TokenType = dpop.TokenType
+	}
 
 	// If the session does not have a token ID, it is an implicit ID-Token only response.
 	if session.TokenID != "" {
@@ -141,6 +147,18 @@ func (s *Server) createJWT(ctx context.Context, client op.Client, session *comma
 	)
 	claims.Actor = actorDomainToClaims(session.Actor)
 	claims.Claims = userInfo.Claims
+	cnf := confirmationClaim(session.DPoPJKT, session.CertThumbprint)
+	if cnf != nil || len(session.AuthorizationDetails) > 0 {
+		// the user info is cached and must not contain the confirmation or authorization details of the token
+		claims.Claims = maps.Clone(userInfo.Claims)
+		if claims.Claims == nil {
+			claims.Claims = make(map[string]any, 1)
+		}
+		if cnf != nil {
+			claims.Claims[dpop.ConfirmationClaim] = cnf
+		}
+		claims.Claims = authorizationDetailsClaims(claims.Claims, session.AuthorizationDetails)
+	}
 
 	return crypto.Sign(claims, signer)
 }
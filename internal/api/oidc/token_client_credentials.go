@@ -22,6 +22,10 @@ func (s *Server) ClientCredentialsExchange(ctx context.Context, r *op.ClientRequ
 	if !ok {
 		return nil, zerrors.ThrowInternal(nil, "OIDC-ga0EP", "Error.Internal")
 	}
+	jkt, err := s.verifyDPoPProof(ctx, r.Header, false)
+	if err != nil {
+		return nil, err
+	}
 	scope, err := op.ValidateAuthReqScopes(client, r.Data.Scope)
 	if err != nil {
 		return nil, err
@@ -48,6 +52,7 @@ func (s *Server) ClientCredentialsExchange(ctx context.Context, r *op.ClientRequ
 		false,
 		"",
 		domain.OIDCResponseTypeUnspecified,
+		tokenBinding(jkt, nil),
 	)
 	if err != nil {
 		return nil, err
@@ -31,6 +31,14 @@ func (s *Server) CodeExchange(ctx context.Context, r *op.ClientRequest[oidc.Acce
 	if err != nil {
 		return nil, zerrors.ThrowInvalidArgument(err, "OIDC-ahLi2", "Errors.User.Code.Invalid")
 	}
+	jkt, err := s.verifyDPoPProof(ctx, r.Header, client.client.DPoPRequired)
+	if err != nil {
+		return nil, err
+	}
+	authorizationDetails, err := domain.ParseAuthorizationDetails(r.Form.Get(authorizationDetailsParam))
+	if err != nil {
+		return nil, invalidAuthorizationDetailsError().WithParent(err)
+	}
 
 	var (
 		session *command.OIDCSession
@@ -39,12 +47,16 @@ func (s *Server) CodeExchange(ctx context.Context, r *op.ClientRequest[oidc.Acce
 		session, _, err = s.command.CreateOIDCSessionFromAuthRequest(
 			setContextUserSystem(ctx),
 			plainCode,
-			codeExchangeComplianceChecker(client, r.Data),
+			codeExchangeComplianceChecker(client, r.Data, authorizationDetails),
 			slices.Contains(client.GrantTypes(), oidc.GrantTypeRefreshToken),
 			client.client.BackChannelLogoutURI,
+			tokenBinding(jkt, client),
 		)
+	} else if len(authorizationDetails) > 0 {
+		// auth requests of the v1 login never grant authorization details
+		return nil, invalidAuthorizationDetailsError().WithDescription("authorization_details were not granted")
 	} else {
-		session, err = s.codeExchangeV1(ctx, client, r.Data, r.Data.Code)
+		session, err = s.codeExchangeV1(ctx, client, r.Data, r.Data.Code, jkt)
 	}
 	if err != nil {
 		return nil, err
@@ -53,7 +65,7 @@ func (s *Server) CodeExchange(ctx context.Context, r *op.ClientRequest[oidc.Acce
 }
 
 // codeExchangeV1 creates a v2 token from a v1 auth request.
-func (s *Server) codeExchangeV1(ctx context.Context, client *Client, req *oidc.AccessTokenRequest, code string) (session *command.OIDCSession, err error) {
+func (s *Server) codeExchangeV1(ctx context.Context, client *Client, req *oidc.AccessTokenRequest, code, dpopJKT string) (session *command.OIDCSession, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
@@ -93,6 +105,7 @@ func (s *Server) codeExchangeV1(ctx context.Context, client *Client, req *oidc.A
 		slices.Contains(scope, oidc.ScopeOfflineAccess),
 		authReq.SessionID,
 		authReq.oidc().ResponseType,
+		tokenBinding(dpopJKT, client),
 	)
 	if err != nil {
 		return nil, err
@@ -123,7 +136,10 @@ func (s *Server) getAuthRequestV1ByID(ctx context.Context, id string) (*AuthRequ
 	return AuthRequestFromBusiness(resp)
 }
 
-func codeExchangeComplianceChecker(client *Client, req *oidc.AccessTokenRequest) command.AuthRequestComplianceChecker {
+// codeExchangeComplianceChecker checks the token request against the auth request.
+// If the client passes authorization details, they must be part of the granted ones
+// and narrow down the authorization details of the issued tokens (RFC 9396, section 6.1).
+func codeExchangeComplianceChecker(client *Client, req *oidc.AccessTokenRequest, authorizationDetails domain.AuthorizationDetails) command.AuthRequestComplianceChecker {
 	return func(ctx context.Context, authReq *command.AuthRequestWriteModel) error {
 		if authReq.ClientID != client.client.ClientID {
 			return oidc.ErrInvalidClient().WithDescription("client_id does not correspond to the client_id in the authorization request")
@@ -140,6 +156,12 @@ func codeExchangeComplianceChecker(client *Client, req *oidc.AccessTokenRequest)
 		if err := authReq.CheckAuthenticated(); err != nil {
 			return err
 		}
+		if len(authorizationDetails) > 0 {
+			if !authReq.AuthorizationDetails.Contains(authorizationDetails) {
+				return invalidAuthorizationDetailsError().WithDescription("authorization_details exceed the granted ones")
+			}
+			authReq.AuthorizationDetails = authorizationDetails
+		}
 		return nil
 	}
 }
@@ -51,6 +51,10 @@ func (s *Server) tokenExchange(ctx context.Context, r *op.ClientRequest[oidc.Tok
 		// not supposed to happen, but just preventing a panic if it does.
 		return nil, zerrors.ThrowInternal(nil, "OIDC-eShi5", "Error.Internal")
 	}
+	// exchanged tokens are not bound to a DPoP key
+	if client.client.DPoPRequired {
+		return nil, oidc.ErrInvalidRequest().WithDescription("token exchange is not supported for clients requiring DPoP")
+	}
 
 	subjectToken, err := s.verifyExchangeToken(ctx, client, r.Data.SubjectToken, r.Data.SubjectTokenType, oidc.AllTokenTypes...)
 	if err != nil {
@@ -418,6 +422,7 @@ func (s *Server) createExchangeAccessToken(
 		slices.Contains(scope, oidc.ScopeOfflineAccess),
 		"",
 		domain.OIDCResponseTypeUnspecified,
+		nil,
 	)
 	if err != nil {
 		return "", "", "", 0, err
@@ -465,6 +470,7 @@ func (s *Server) createExchangeJWT(
 		slices.Contains(scope, oidc.ScopeOfflineAccess),
 		"",
 		domain.OIDCResponseTypeUnspecified,
+		nil,
 	)
 	if err != nil {
 		return "", "", 0, err
@@ -33,6 +33,10 @@ func (s *Server) JWTProfile(ctx context.Context, r *op.Request[oidc.JWTProfileGr
 		resourceOwner: user.ResourceOwner,
 		tokenType:     user.TokenType,
 	}
+	jkt, err := s.verifyDPoPProof(ctx, r.Header, false)
+	if err != nil {
+		return nil, err
+	}
 	scope, err := op.ValidateAuthReqScopes(client, r.Data.Scope)
 	if err != nil {
 		return nil, err
@@ -59,6 +63,7 @@ func (s *Server) JWTProfile(ctx context.Context, r *op.Request[oidc.JWTProfileGr
 		false,
 		"",
 		domain.OIDCResponseTypeUnspecified,
+		tokenBinding(jkt, nil),
 	)
 	if err != nil {
 		return nil, err
@@ -25,13 +25,25 @@ func (s *Server) RefreshToken(ctx context.Context, r *op.ClientRequest[oidc.Refr
 	if !ok {
 		return nil, zerrors.ThrowInternal(nil, "OIDC-ga0EP", "Error.Internal")
 	}
+	jkt, err := s.verifyDPoPProof(ctx, r.Header, client.client.DPoPRequired)
+	if err != nil {
+		return nil, err
+	}
+	authorizationDetails, err := domain.ParseAuthorizationDetails(r.Form.Get(authorizationDetailsParam))
+	if err != nil {
+		return nil, invalidAuthorizationDetailsError().WithParent(err)
+	}
 
-	session, err := s.command.ExchangeOIDCSessionRefreshAndAccessToken(ctx, r.Data.RefreshToken, r.Data.Scopes, client.client.ClientID, refreshTokenComplianceChecker())
+	session, err := s.command.ExchangeOIDCSessionRefreshAndAccessToken(ctx, r.Data.RefreshToken, r.Data.Scopes, client.client.ClientID, refreshTokenComplianceChecker(authorizationDetails), tokenBinding(jkt, client))
 	if err == nil {
 		return response(s.accessTokenResponseFromSession(ctx, client, session, "", client.client.ProjectID, client.client.ProjectRoleAssertion, client.client.AccessTokenRoleAssertion, client.client.IDTokenRoleAssertion, client.client.IDTokenUserinfoAssertion))
 	} else if errors.Is(err, zerrors.ThrowPreconditionFailed(nil, "OIDCS-JOI23", "Errors.OIDCSession.RefreshTokenInvalid")) {
+		if len(authorizationDetails) > 0 {
+			// refresh tokens of the v1 login never grant authorization details
+			return nil, invalidAuthorizationDetailsError().WithDescription("authorization_details were not granted")
+		}
 		// We try again for v1 tokens when we encountered specific parsing error
-		return s.refreshTokenV1(ctx, client, r)
+		return s.refreshTokenV1(ctx, client, r, jkt)
 	}
 	return nil, err
 }
@@ -41,7 +53,7 @@ func (s *Server) RefreshToken(ctx context.Context, r *op.ClientRequest[oidc.Refr
 // This "upgrades" existing v1 sessions to v2 session without requiring users to re-login.
 //
 // This function can be removed when we retire the v1 token repo.
-func (s *Server) refreshTokenV1(ctx context.Context, client *Client, r *op.ClientRequest[oidc.RefreshTokenRequest]) (_ *op.Response, err error) {
+func (s *Server) refreshTokenV1(ctx context.Context, client *Client, r *op.ClientRequest[oidc.RefreshTokenRequest], dpopJKT string) (_ *op.Response, err error) {
 	refreshToken, err := s.repo.RefreshTokenByToken(ctx, r.Data.RefreshToken)
 	if err != nil {
 		return nil, err
@@ -70,6 +82,7 @@ func (s *Server) refreshTokenV1(ctx context.Context, client *Client, r *op.Clien
 		true,
 		"",
 		domain.OIDCResponseTypeUnspecified,
+		tokenBinding(dpopJKT, client),
 	)
 	if err != nil {
 		return nil, err
@@ -85,11 +98,18 @@ func (s *Server) refreshTokenV1(ctx context.Context, client *Client, r *op.Clien
 }
 
 // refreshTokenComplianceChecker validates that the requested scope is a subset of the original auth request scope.
-func refreshTokenComplianceChecker() command.RefreshTokenComplianceChecker {
+// If authorization details are requested, they must be a subset of the granted ones and narrow them down for the new access token (RFC 9396, section 6.2).
+func refreshTokenComplianceChecker(authorizationDetails domain.AuthorizationDetails) command.RefreshTokenComplianceChecker {
 	return func(_ context.Context, model *command.OIDCSessionWriteModel, requestedScope []string, reqClientID string) ([]string, error) {
 		if model.ClientID != reqClientID {
 			return nil, oidc.ErrInvalidClient().WithDescription("client_id does not correspond to the client_id in the refresh token")
 		}
+		if len(authorizationDetails) > 0 {
+			if !model.AuthorizationDetails.Contains(authorizationDetails) {
+				return nil, invalidAuthorizationDetailsError().WithDescription("authorization_details exceed the granted ones")
+			}
+			model.AuthorizationDetails = authorizationDetails
+		}
 		return validateRefreshTokenScopes(model.Scope, requestedScope)
 	}
 }
@@ -39,6 +39,12 @@ func (s *Server) UserInfo(ctx context.Context, r *op.Request[oidc.UserInfoReques
 	if err != nil {
 		return nil, op.NewStatusError(oidc.ErrAccessDenied().WithDescription("access token invalid").WithParent(err).WithReturnParentToClient(authz.GetFeatures(ctx).DebugOIDCParentError), http.StatusUnauthorized)
 	}
+	if err = s.verifyDPoPAccessToken(ctx, r.Header, r.Method, s.Endpoints().Userinfo.Absolute(op.IssuerFromContext(ctx)), r.Data.AccessToken, token.dpopJKT); err != nil {
+		return nil, err
+	}
+	if err = verifyCertificateBoundAccessToken(ctx, token.certThumbprint); err != nil {
+		return nil, err
+	}
 
 	var (
 		projectID string
@@ -543,6 +549,8 @@ func (s *Server) runUserinfoExecutionTargets(ctx context.Context, qu *query.OIDC
 		Application:  &ContextInfoApplication{ClientID: clientID},
 		UserGrants:   qu.UserGrants,
 		Actor:        actor,
+		// set on token creation, so functions can check the authorization details granted to the token
+		AuthorizationDetails: authorizationDetailsFromContext(ctx),
 	}
 
 	resp, err := execution.CallTargets(ctx, executionTargets, info, s.targetEncryptionAlgorithm, s.query.GetActiveSigningWebKey, s.httpClient)
@@ -584,8 +592,11 @@ type ContextInfo struct {
 	UserGrants   []query.UserGrant       `json:"user_grants,omitempty"`
 	Application  *ContextInfoApplication `json:"application,omitempty"`
 	// Actor is only set when the token was obtained through token exchange / impersonation.
-	Actor    *domain.TokenActor   `json:"actor,omitempty"`
-	Response *ContextInfoResponse `json:"response,omitempty"`
+	Actor *domain.TokenActor `json:"actor,omitempty"`
+	// AuthorizationDetails granted to the token (RFC 9396),
+	// as approved in the login and possibly narrowed down on the token request.
+	AuthorizationDetails domain.AuthorizationDetails `json:"authorization_details,omitempty"`
+	Response             *ContextInfoResponse        `json:"response,omitempty"`
 }
 type ContextInfoApplication struct {
 	ClientID string `json:"client_id,omitempty"`
@@ -3,8 +3,12 @@ package saml
 import (
 	"context"
 	"encoding/base64"
+	"fmt"
+	"html/template"
+	"net/http"
 	"net/url"
 
+	"github.com/zitadel/logging"
 	"github.com/zitadel/saml/pkg/provider"
 	"github.com/zitadel/saml/pkg/provider/models"
 	"github.com/zitadel/saml/pkg/provider/xml"
@@ -14,6 +18,39 @@ import (
 	"github.com/zitadel/zitadel/internal/domain"
 )
 
+// postTemplate is the form of the provider, which automatically posts the response to the service provider.
+var postTemplate = template.Must(template.New("post").Parse(`<!DOCTYPE html PUBLIC "-//W3C//DTD XHTML 1.1//EN"
+"http://www.w3.org/TR/xhtml11/DTD/xhtml11.dtd">
+<html xmlns="http://www.w3.org/1999/xhtml" xml:lang="en">
+<body onload="document.getElementById('samlpost').submit()">
+<noscript>
+<p>
+<strong>Note:</strong> Since your browser does not support JavaScript,
+you must press the Continue button once to proceed.
+</p>
+</noscript>
+<form action="{{ .AssertionConsumerServiceURL }}" method="post" id="samlpost">
+<div>
+<input type="hidden" name="RelayState"
+value="{{ .RelayState }}"/>
+<input type="hidden" name="SAMLResponse"
+value="{{ .SAMLResponse }}"/>
+</div>
+<noscript>
+<div>
+<input type="submit" value="Continue"/>
+</div>
+</noscript>
+</form>
+</body>
+</html>`))
+
+type postForm struct {
+	RelayState                  string
+	SAMLResponse                string
+	AssertionConsumerServiceURL string
+}
+
 func (p *Provider) CreateErrorResponse(authReq models.AuthRequestInt, reason domain.SAMLErrorReason, description string) (string, string, error) {
 	resp := &provider.Response{
 		ProtocolBinding: authReq.GetBindingType(),
@@ -23,7 +60,11 @@ func (p *Provider) CreateErrorResponse(authReq models.AuthRequestInt, reason dom
 		Issuer:          authReq.GetDestination(),
 		Audience:        authReq.GetIssuer(),
 	}
-	return createResponse(p.AuthCallbackErrorResponse(resp, domain.SAMLErrorReasonToString(reason), description), authReq.GetBindingType(), authReq.GetAccessConsumerServiceURL(), resp.RelayState, resp.SigAlg, resp.Signature)
+	respData, err := xml.Marshal(p.AuthCallbackErrorResponse(resp, domain.SAMLErrorReasonToString(reason), description))
+	if err != nil {
+		return "", "", err
+	}
+	return createResponse(respData, authReq.GetBindingType(), authReq.GetAccessConsumerServiceURL(), resp.RelayState, resp.SigAlg, resp.Signature)
 }
 
 func (p *Provider) CreateResponse(ctx context.Context, authReq models.AuthRequestInt) (string, string, error) {
@@ -40,6 +81,10 @@ func (p *Provider) CreateResponse(ctx context.Context, authReq models.AuthReques
 	if err != nil {
 		return "", "", err
 	}
+	settings, respData, err := p.applyResponseSettings(ctx, samlResponse, resp, authReq.GetUserID())
+	if err != nil {
+		return "", "", err
+	}
 
 	if err := p.command.CreateSAMLSessionFromSAMLRequest(
 		setContextUserSystem(ctx),
@@ -47,19 +92,82 @@ func (p *Provider) CreateResponse(ctx context.Context, authReq models.AuthReques
 		samlComplianceChecker(),
 		samlResponse.Id,
 		p.Expiration(),
+		settings.logout(resp.Issuer, samlResponse),
 	); err != nil {
 		return "", "", err
 	}
 
-	return createResponse(samlResponse, authReq.GetBindingType(), authReq.GetAccessConsumerServiceURL(), resp.RelayState, resp.SigAlg, resp.Signature)
+	return createResponse(respData, authReq.GetBindingType(), authReq.GetAccessConsumerServiceURL(), resp.RelayState, resp.SigAlg, resp.Signature)
 }
 
-func createResponse(samlResponse interface{}, binding, acs, relayState, sigAlg, sig string) (string, string, error) {
-	respData, err := xml.Marshal(samlResponse)
+// callbackHandler replaces the login callback of the provider (login V1),
+// so that the settings of the service provider are applied to the response.
+func (p *Provider) callbackHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, fmt.Errorf("failed to parse form: %w", err).Error(), http.StatusBadRequest)
+		return
+	}
+	requestID := r.Form.Get("id")
+	if requestID == "" {
+		http.Error(w, "no requestID provided", http.StatusBadRequest)
+		return
+	}
+	authReq, err := p.storage.AuthRequestByID(ctx, requestID)
 	if err != nil {
-		return "", "", err
+		logging.WithError(err).Error("failed to get request")
+		http.Error(w, fmt.Errorf("failed to get request: %w", err).Error(), http.StatusInternalServerError)
+		return
+	}
+	entityID, err := p.storage.GetEntityIDByAppID(ctx, authReq.GetApplicationID())
+	if err != nil {
+		logging.WithError(err).Error("failed to get entityID")
+		http.Error(w, fmt.Errorf("failed to get entityID: %w", err).Error(), http.StatusInternalServerError)
+		return
+	}
+	resp := &provider.Response{
+		ProtocolBinding: authReq.GetBindingType(),
+		RelayState:      authReq.GetRelayState(),
+		AcsUrl:          authReq.GetAccessConsumerServiceURL(),
+		RequestID:       authReq.GetAuthRequestID(),
+		Audience:        entityID,
+		Issuer:          p.GetEntityID(ctx),
+	}
+
+	var respData []byte
+	samlResponse, err := p.AuthCallbackResponse(ctx, authReq, resp)
+	if err == nil {
+		_, respData, err = p.applyResponseSettings(ctx, samlResponse, resp, authReq.GetUserID())
+	}
+	if err != nil {
+		logging.WithError(err).Error("failed to create response")
+		respData, err = xml.Marshal(p.AuthCallbackErrorResponse(resp, provider.StatusCodeResponder, "failed to create response"))
+		if err != nil {
+			http.Error(w, fmt.Errorf("failed to send response: %w", err).Error(), http.StatusInternalServerError)
+			return
+		}
 	}
 
+	location, samlResponseData, err := createResponse(respData, resp.ProtocolBinding, resp.AcsUrl, resp.RelayState, resp.SigAlg, resp.Signature)
+	if err != nil {
+		http.Error(w, fmt.Errorf("failed to send response: %w", err).Error(), http.StatusInternalServerError)
+		return
+	}
+	if resp.ProtocolBinding == provider.RedirectBinding {
+		http.Redirect(w, r, location, http.StatusFound)
+		return
+	}
+	err = postTemplate.Execute(w, &postForm{
+		AssertionConsumerServiceURL: location,
+		RelayState:                  resp.RelayState,
+		SAMLResponse:                samlResponseData,
+	})
+	if err != nil {
+		http.Error(w, fmt.Errorf("failed to send response: %w", err).Error(), http.StatusInternalServerError)
+	}
+}
+
+func createResponse(respData []byte, binding, acs, relayState, sigAlg, sig string) (string, string, error) {
 	switch binding {
 	case provider.PostBinding:
 		return acs, base64.StdEncoding.EncodeToString(respData), nil
@@ -72,11 +180,15 @@ func createResponse(samlResponse interface{}, binding, acs, relayState, sigAlg,
 		if err != nil {
 			return "", "", err
 		}
+		// the values are escaped the same way as when they were signed (see [signRedirectResponse]),
+		// so the service provider can verify the signature over the received query
 		values := parsed.Query()
 		values.Add("SAMLResponse", string(respData))
-		values.Add("RelayState", relayState)
-		values.Add("SigAlg", sigAlg)
-		values.Add("Signature", sig)
+		for key, value := range map[string]string{"RelayState": relayState, "SigAlg": sigAlg, "Signature": sig} {
+			if value != "" {
+				values.Add(key, value)
+			}
+		}
 		parsed.RawQuery = values.Encode()
 		return parsed.String(), "", nil
 	}
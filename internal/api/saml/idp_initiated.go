@@ -0,0 +1,106 @@
+package saml
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/zitadel/logging"
+	"github.com/zitadel/saml/pkg/provider"
+	"github.com/zitadel/saml/pkg/provider/xml"
+	"github.com/zitadel/saml/pkg/provider/xml/saml"
+	"github.com/zitadel/saml/pkg/provider/xml/samlp"
+
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	IDPInitiatedEndpoint   = "/idp-initiated/"
+	idpInitiatedAppIDParam = "appID"
+	// idpInitiatedACSParam optionally selects one of the assertion consumer services of the metadata.
+	idpInitiatedACSParam = "acs"
+)
+
+// idpInitiatedHandler starts a login for the application without a preceding SAMLRequest of the service provider.
+// After the user is authenticated, an unsolicited response is sent to the assertion consumer service
+// of the service provider's metadata, preferring the POST binding.
+func (p *Provider) idpInitiatedHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	appID := mux.Vars(r)[idpInitiatedAppIDParam]
+
+	config, acsURL, binding, err := idpInitiatedServiceProvider(ctx, p.query.AppByID, appID, r.URL.Query().Get(idpInitiatedACSParam))
+	if err != nil {
+		status, _ := http_utils.ZitadelErrorToHTTPStatusCode(ctx, err)
+		http.Error(w, http.StatusText(status), status)
+		return
+	}
+
+	// the request has no ID, so the response won't contain an InResponseTo
+	request := &samlp.AuthnRequestType{
+		Issuer:      &saml.NameIDType{Text: config.EntityID},
+		Destination: ContextToIssuer(ctx) + p.ssoEndpoint,
+	}
+	authRequest, err := p.storage.CreateAuthRequest(ctx, request, acsURL, binding, config.IDPInitiatedRelayState, appID)
+	if err != nil {
+		logging.WithError(err).WithField("app", appID).Error("unable to create auth request for idp initiated login")
+		http.Error(w, "unable to start login", http.StatusInternalServerError)
+		return
+	}
+	sp, err := p.storage.GetEntityByID(ctx, config.EntityID)
+	if err != nil {
+		logging.WithError(err).WithField("app", appID).Error("unable to get service provider for idp initiated login")
+		http.Error(w, "unable to start login", http.StatusInternalServerError)
+		return
+	}
+	http.Redirect(w, r, sp.LoginURL(authRequest.GetID()), http.StatusFound)
+}
+
+// idpInitiatedServiceProvider returns the SAML configuration of the active application, which must allow IdP-initiated logins,
+// and the assertion consumer service the unsolicited response is sent to.
+// A requested assertion consumer service must be registered in the metadata of the service provider.
+func idpInitiatedServiceProvider(
+	ctx context.Context,
+	appByID func(ctx context.Context, appID string, activeOnly bool) (*query.App, error),
+	appID, requestedACS string,
+) (config *query.SAMLApp, acsURL, binding string, err error) {
+	app, err := appByID(ctx, appID, true)
+	if err != nil {
+		if zerrors.IsNotFound(err) {
+			return nil, "", "", zerrors.ThrowNotFound(err, "SAML-Ip1nf", "Errors.Project.App.NotFound")
+		}
+		return nil, "", "", err
+	}
+	if app.SAMLConfig == nil || !app.SAMLConfig.IDPInitiatedEnabled {
+		return nil, "", "", zerrors.ThrowNotFound(nil, "SAML-Ip2de", "Errors.Project.App.NotFound")
+	}
+	metadata, err := xml.ParseMetadataXmlIntoStruct(app.SAMLConfig.Metadata)
+	if err != nil || metadata.SPSSODescriptor == nil {
+		return nil, "", "", zerrors.ThrowInternal(err, "SAML-Ip3md", "Errors.Project.App.SAMLMetadataFormat")
+	}
+	if requestedACS == "" {
+		acsURL, binding = provider.GetAcsUrlAndBindingForResponse(metadata.SPSSODescriptor.AssertionConsumerService, provider.PostBinding, "", nil)
+		if acsURL == "" || !idpInitiatedBinding(binding) {
+			return nil, "", "", zerrors.ThrowPreconditionFailed(nil, "SAML-Ip4nb", "Errors.Project.App.SAMLConfigInvalid")
+		}
+		return app.SAMLConfig, acsURL, binding, nil
+	}
+	for _, acs := range metadata.SPSSODescriptor.AssertionConsumerService {
+		if acs.Location != requestedACS || !idpInitiatedBinding(acs.Binding) {
+			continue
+		}
+		acsURL, binding = acs.Location, acs.Binding
+		if binding == provider.PostBinding {
+			break
+		}
+	}
+	if acsURL == "" {
+		return nil, "", "", zerrors.ThrowInvalidArgument(nil, "SAML-Ip5ac", "Errors.Project.App.SAMLConfigInvalid")
+	}
+	return app.SAMLConfig, acsURL, binding, nil
+}
+
+func idpInitiatedBinding(binding string) bool {
+	return binding == provider.PostBinding || binding == provider.RedirectBinding
+}
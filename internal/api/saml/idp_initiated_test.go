@@ -0,0 +1,245 @@
+package saml
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/saml/pkg/provider"
+
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const idpInitiatedTestMetadata = `<?xml version="1.0"?>
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://sp.example.com/saml/metadata">
+  <md:SPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Artifact" Location="https://sp.example.com/saml/artifact" index="0"/>
+    <md:AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://sp.example.com/saml/acs" index="1"/>
+    <md:AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://sp.example.com/saml/redirect" index="2"/>
+    <md:AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://sp.example.com/saml/acs" index="3"/>
+    <md:AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://sp.example.com/saml/post" index="4"/>
+  </md:SPSSODescriptor>
+</md:EntityDescriptor>`
+
+const idpInitiatedTestArtifactMetadata = `<?xml version="1.0"?>
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="https://sp.example.com/saml/metadata">
+  <md:SPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Artifact" Location="https://sp.example.com/saml/artifact" index="0"/>
+  </md:SPSSODescriptor>
+</md:EntityDescriptor>`
+
+// appByIDFunc returns the app like [query.Queries.AppByID]:
+// inactive apps are not found, if only active apps are requested.
+func appByIDFunc(app *query.App, active bool, err error) func(context.Context, string, bool) (*query.App, error) {
+	return func(_ context.Context, appID string, activeOnly bool) (*query.App, error) {
+		if err != nil {
+			return nil, err
+		}
+		if app == nil || app.ID != appID || (activeOnly && !active) {
+			return nil, zerrors.ThrowNotFound(nil, "QUERY-ZTUbWg", "Errors.Project.App.NotExisting")
+		}
+		return app, nil
+	}
+}
+
+func samlTestApp(metadata string, idpInitiated bool) *query.App {
+	return &query.App{
+		ID: "appID",
+		SAMLConfig: &query.SAMLApp{
+			Metadata:               []byte(metadata),
+			EntityID:               "https://sp.example.com/saml/metadata",
+			IDPInitiatedEnabled:    idpInitiated,
+			IDPInitiatedRelayState: "https://sp.example.com/home",
+		},
+	}
+}
+
+func Test_idpInitiatedServiceProvider(t *testing.T) {
+	type args struct {
+		appByID      func(context.Context, string, bool) (*query.App, error)
+		appID        string
+		requestedACS string
+	}
+	type want struct {
+		acsURL  string
+		binding string
+		err     func(error) bool
+		status  int
+	}
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "unknown app, not found",
+			args: args{
+				appByID: appByIDFunc(samlTestApp(idpInitiatedTestMetadata, true), true, nil),
+				appID:   "unknown",
+			},
+			want: want{
+				err:    zerrors.IsNotFound,
+				status: http.StatusNotFound,
+			},
+		},
+		{
+			name: "query error, internal",
+			args: args{
+				appByID: appByIDFunc(nil, true, zerrors.ThrowInternal(nil, "QUERY-error", "Errors.Internal")),
+				appID:   "appID",
+			},
+			want: want{
+				err:    zerrors.IsInternal,
+				status: http.StatusInternalServerError,
+			},
+		},
+		{
+			name: "inactive app, not found",
+			args: args{
+				appByID: appByIDFunc(samlTestApp(idpInitiatedTestMetadata, true), false, nil),
+				appID:   "appID",
+			},
+			want: want{
+				err:    zerrors.IsNotFound,
+				status: http.StatusNotFound,
+			},
+		},
+		{
+			name: "no saml app, not found",
+			args: args{
+				appByID: appByIDFunc(&query.App{ID: "appID", OIDCConfig: &query.OIDCApp{}}, true, nil),
+				appID:   "appID",
+			},
+			want: want{
+				err:    zerrors.IsNotFound,
+				status: http.StatusNotFound,
+			},
+		},
+		{
+			name: "idp initiated login disabled, not found",
+			args: args{
+				appByID: appByIDFunc(samlTestApp(idpInitiatedTestMetadata, false), true, nil),
+				appID:   "appID",
+			},
+			want: want{
+				err:    zerrors.IsNotFound,
+				status: http.StatusNotFound,
+			},
+		},
+		{
+			name: "invalid metadata, internal",
+			args: args{
+				appByID: appByIDFunc(samlTestApp("<invalid", true), true, nil),
+				appID:   "appID",
+			},
+			want: want{
+				err:    zerrors.IsInternal,
+				status: http.StatusInternalServerError,
+			},
+		},
+		{
+			name: "no supported binding, precondition failed",
+			args: args{
+				appByID: appByIDFunc(samlTestApp(idpInitiatedTestArtifactMetadata, true), true, nil),
+				appID:   "appID",
+			},
+			want: want{
+				err:    zerrors.IsPreconditionFailed,
+				status: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "unregistered acs, invalid argument",
+			args: args{
+				appByID:      appByIDFunc(samlTestApp(idpInitiatedTestMetadata, true), true, nil),
+				appID:        "appID",
+				requestedACS: "https://attacker.example.com/saml/acs",
+			},
+			want: want{
+				err:    zerrors.IsErrorInvalidArgument,
+				status: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "acs with unsupported binding, invalid argument",
+			args: args{
+				appByID:      appByIDFunc(samlTestApp(idpInitiatedTestMetadata, true), true, nil),
+				appID:        "appID",
+				requestedACS: "https://sp.example.com/saml/artifact",
+			},
+			want: want{
+				err:    zerrors.IsErrorInvalidArgument,
+				status: http.StatusBadRequest,
+			},
+		},
+		{
+			name: "default acs, post binding",
+			args: args{
+				appByID: appByIDFunc(samlTestApp(idpInitiatedTestMetadata, true), true, nil),
+				appID:   "appID",
+			},
+			want: want{
+				acsURL:  "https://sp.example.com/saml/acs",
+				binding: provider.PostBinding,
+			},
+		},
+		{
+			name: "requested acs, post binding preferred",
+			args: args{
+				appByID:      appByIDFunc(samlTestApp(idpInitiatedTestMetadata, true), true, nil),
+				appID:        "appID",
+				requestedACS: "https://sp.example.com/saml/acs",
+			},
+			want: want{
+				acsURL:  "https://sp.example.com/saml/acs",
+				binding: provider.PostBinding,
+			},
+		},
+		{
+			name: "requested acs, redirect binding",
+			args: args{
+				appByID:      appByIDFunc(samlTestApp(idpInitiatedTestMetadata, true), true, nil),
+				appID:        "appID",
+				requestedACS: "https://sp.example.com/saml/redirect",
+			},
+			want: want{
+				acsURL:  "https://sp.example.com/saml/redirect",
+				binding: provider.RedirectBinding,
+			},
+		},
+		{
+			name: "requested acs, post binding",
+			args: args{
+				appByID:      appByIDFunc(samlTestApp(idpInitiatedTestMetadata, true), true, nil),
+				appID:        "appID",
+				requestedACS: "https://sp.example.com/saml/post",
+			},
+			want: want{
+				acsURL:  "https://sp.example.com/saml/post",
+				binding: provider.PostBinding,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			config, acsURL, binding, err := idpInitiatedServiceProvider(ctx, tt.args.appByID, tt.args.appID, tt.args.requestedACS)
+			if tt.want.err != nil {
+				require.Error(t, err)
+				assert.True(t, tt.want.err(err), "unexpected error: %v", err)
+				status, _ := http_utils.ZitadelErrorToHTTPStatusCode(ctx, err)
+				assert.Equal(t, tt.want.status, status)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "https://sp.example.com/saml/metadata", config.EntityID)
+			assert.Equal(t, "https://sp.example.com/home", config.IDPInitiatedRelayState)
+			assert.Equal(t, tt.want.acsURL, acsURL)
+			assert.Equal(t, tt.want.binding, binding)
+		})
+	}
+}
@@ -0,0 +1,328 @@
+package saml
+
+import (
+	"encoding/base64"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zitadel/logging"
+	"github.com/zitadel/saml/pkg/provider"
+	"github.com/zitadel/saml/pkg/provider/xml"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/saml/slo"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	// FrontChannelLogoutEndpoint starts notifying the participants of a session terminated by ZITADEL,
+	// which use a front channel binding.
+	FrontChannelLogoutEndpoint = "/logout/front-channel"
+	frontChannelLogoutParam    = "session_id"
+)
+
+// FrontChannelLogoutPath returns the path the user agent is sent to,
+// after [command.Commands.StartSAMLFrontChannelLogout] started the logout of the session.
+func FrontChannelLogoutPath(sessionID string) string {
+	return HandlerPrefix + FrontChannelLogoutEndpoint + "?" + frontChannelLogoutParam + "=" + url.QueryEscape(sessionID)
+}
+
+// logoutRequestIDPrefix prefixes the ID of the SAML session in the IDs of the LogoutRequests
+// sent through the user agent, so the returned LogoutResponse can be assigned to the participant.
+const logoutRequestIDPrefix = "_"
+
+// logoutPostTemplate is the form, which automatically posts the logout message to the service provider.
+var logoutPostTemplate = template.Must(template.New("logoutPost").Parse(`<!DOCTYPE html>
+<html>
+<body onload="document.getElementById('samlpost').submit()">
+<noscript>
+<p>
+<strong>Note:</strong> Since your browser does not support JavaScript,
+you must press the Continue button once to proceed.
+</p>
+</noscript>
+<form action="{{ .URL }}" method="post" id="samlpost">
+<input type="hidden" name="RelayState" value="{{ .RelayState }}"/>
+<input type="hidden" name="{{ .Parameter }}" value="{{ .Message }}"/>
+<noscript>
+<input type="submit" value="Continue"/>
+</noscript>
+</form>
+</body>
+</html>`))
+
+type logoutPostForm struct {
+	URL        string
+	RelayState string
+	Parameter  string
+	Message    string
+}
+
+// logoutHandler replaces the SingleLogoutService of the provider.
+// It accepts the LogoutRequests of the service providers participating in a session (redirect and POST binding)
+// and terminates the session, which notifies the participants supporting the SOAP binding in the background.
+// The other participants are notified one after another by sending the user agent to them,
+// their LogoutResponses are received on this endpoint as well.
+// After all of them responded, the LogoutResponse is sent to the initiating service provider.
+func (p *Provider) logoutHandler(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "failed to parse form", http.StatusBadRequest)
+		return
+	}
+	// messages of the redirect binding are always deflated
+	encoding := r.Form.Get("SAMLEncoding")
+	if encoding == "" && r.Method == http.MethodGet {
+		encoding = xml.EncodingDeflate
+	}
+	switch {
+	case r.Form.Get("SAMLRequest") != "":
+		p.handleLogoutRequest(w, r, encoding)
+	case r.Form.Get("SAMLResponse") != "":
+		p.handleLogoutResponse(w, r, encoding)
+	default:
+		http.Error(w, "no logout request or response provided", http.StatusBadRequest)
+	}
+}
+
+// frontChannelLogoutHandler notifies the participants of a session terminated by ZITADEL one after another.
+// After all of them responded, the user agent is sent to the post logout redirect URI.
+func (p *Provider) frontChannelLogoutHandler(w http.ResponseWriter, r *http.Request) {
+	logout, err := p.command.GetSAMLSessionLogout(r.Context(), r.URL.Query().Get(frontChannelLogoutParam))
+	// a logout initiated by a service provider must be continued by the LogoutResponses
+	if err != nil || logout.Initiator != nil {
+		http.Error(w, "unknown logout", http.StatusBadRequest)
+		return
+	}
+	p.continueLogout(w, r, logout)
+}
+
+func (p *Provider) handleLogoutRequest(w http.ResponseWriter, r *http.Request, encoding string) {
+	ctx := r.Context()
+	request, err := xml.DecodeLogoutRequest(encoding, r.Form.Get("SAMLRequest"))
+	if err != nil || request.Issuer == nil {
+		http.Error(w, "failed to decode logout request", http.StatusBadRequest)
+		return
+	}
+	// the SessionIndex is required, as it is only known to the service provider, which received the assertion
+	if len(request.SessionIndex) == 0 {
+		http.Error(w, "logout request without SessionIndex", http.StatusBadRequest)
+		return
+	}
+	if err = p.verifyLogoutMessage(r, "SAMLRequest", encoding, request.Issuer.Text); err != nil {
+		logging.WithError(err).WithField("entityID", request.Issuer.Text).Info("saml logout request rejected")
+		p.failLogout(w, r, request.Issuer.Text, request.Id, err)
+		return
+	}
+	logout, err := p.command.StartSAMLLogout(setContextUserSystem(ctx), request.Issuer.Text, request.SessionIndex[0], request.Id, r.Form.Get("RelayState"))
+	if err != nil {
+		logging.WithError(err).WithField("entityID", request.Issuer.Text).Info("saml logout request failed")
+		p.failLogout(w, r, request.Issuer.Text, request.Id, err)
+		return
+	}
+	p.continueLogout(w, r, logout)
+}
+
+func (p *Provider) handleLogoutResponse(w http.ResponseWriter, r *http.Request, encoding string) {
+	ctx := r.Context()
+	response, err := slo.DecodeResponse(encoding, r.Form.Get("SAMLResponse"))
+	if err != nil {
+		http.Error(w, "failed to decode logout response", http.StatusBadRequest)
+		return
+	}
+	sessionID := r.Form.Get("RelayState")
+	samlSessionID, ok := strings.CutPrefix(response.InResponseTo, logoutRequestIDPrefix)
+	if sessionID == "" || !ok {
+		http.Error(w, "unknown logout response", http.StatusBadRequest)
+		return
+	}
+	logout, err := p.command.GetSAMLSessionLogout(ctx, sessionID)
+	if err != nil {
+		http.Error(w, "unknown logout response", http.StatusBadRequest)
+		return
+	}
+	for _, participant := range logout.Pending {
+		if participant.SAMLSessionID != samlSessionID {
+			continue
+		}
+		if response.Issuer != nil && response.Issuer.Text != participant.EntityID {
+			http.Error(w, "unknown logout response", http.StatusBadRequest)
+			return
+		}
+		if err = p.verifyLogoutMessage(r, "SAMLResponse", encoding, participant.EntityID); err != nil {
+			logging.WithError(err).WithField("entityID", participant.EntityID).Info("saml logout response rejected")
+			http.Error(w, "invalid logout response", http.StatusForbidden)
+			return
+		}
+		// the logout continues, even if the service provider failed to terminate its session
+		logging.WithFields("entityID", participant.EntityID, "status", response.Status.StatusCode.Value).Debug("saml logout response received")
+		if err = p.command.SAMLLogoutSent(setContextUserSystem(ctx), sessionID, samlSessionID, authz.GetInstance(ctx).InstanceID()); err != nil {
+			http.Error(w, "failed to continue logout", http.StatusInternalServerError)
+			return
+		}
+		if logout, err = p.command.GetSAMLSessionLogout(ctx, sessionID); err != nil {
+			http.Error(w, "failed to continue logout", http.StatusInternalServerError)
+			return
+		}
+		break
+	}
+	p.continueLogout(w, r, logout)
+}
+
+// continueLogout sends a LogoutRequest to the next participant using a front channel binding.
+// If all of them are notified, the LogoutResponse is sent to the initiating service provider,
+// or the user agent is redirected to the post logout redirect URI if ZITADEL initiated the logout.
+func (p *Provider) continueLogout(w http.ResponseWriter, r *http.Request, logout *command.SAMLSessionLogout) {
+	for _, participant := range logout.Pending {
+		if !slo.IsFrontChannel(participant.Binding) {
+			continue
+		}
+		if err := p.sendLogoutRequest(w, r, logout.SessionID, participant); err != nil {
+			logging.WithError(err).WithField("entityID", participant.EntityID).Error("failed to send saml logout request")
+			http.Error(w, "failed to send logout request", http.StatusInternalServerError)
+		}
+		return
+	}
+	initiator := logout.Initiator
+	if initiator == nil {
+		http.Redirect(w, r, logout.PostLogoutRedirectURI, http.StatusFound)
+		return
+	}
+	err := p.sendLogoutResponse(w, r, initiator.EntityID, &slo.Response{
+		InResponseTo: logout.RequestID,
+		Issuer:       initiator.Issuer,
+		Status:       provider.StatusCodeSuccess,
+	}, logout.RelayState, initiator.SignatureAlgorithm)
+	if err != nil {
+		logging.WithError(err).WithField("entityID", initiator.EntityID).Error("failed to send saml logout response")
+		http.Error(w, "failed to send logout response", http.StatusInternalServerError)
+	}
+}
+
+// failLogout sends a LogoutResponse with the status matching the error to the service provider.
+func (p *Provider) failLogout(w http.ResponseWriter, r *http.Request, entityID, requestID string, err error) {
+	status := provider.StatusCodeResponder
+	if zerrors.IsNotFound(err) || zerrors.IsErrorInvalidArgument(err) || zerrors.IsPermissionDenied(err) {
+		status = provider.StatusCodeRequestDenied
+	}
+	err = p.sendLogoutResponse(w, r, entityID, &slo.Response{
+		InResponseTo: requestID,
+		Issuer:       p.GetEntityID(r.Context()),
+		Status:       status,
+	}, r.Form.Get("RelayState"), "")
+	if err != nil {
+		http.Error(w, "failed to send logout response", http.StatusBadRequest)
+	}
+}
+
+// verifyLogoutMessage verifies the signature of the logout message of the service provider
+// with the signing certificates of its metadata.
+// Messages of the redirect binding are received as query, the ones of the POST binding as form.
+func (p *Provider) verifyLogoutMessage(r *http.Request, parameter, encoding, entityID string) error {
+	sp, err := p.storage.GetEntityByID(r.Context(), entityID)
+	if err != nil {
+		return err
+	}
+	certs, err := slo.SigningCertificates(sp.Metadata)
+	if err != nil {
+		return err
+	}
+	if r.Method == http.MethodGet {
+		return slo.VerifyRedirect(r.URL.RawQuery, parameter, certs)
+	}
+	data, err := xml.InflateAndDecode(encoding, true, r.PostForm.Get(parameter))
+	if err != nil {
+		return zerrors.ThrowInvalidArgument(err, "SAML-Jd3wq", "invalid logout message")
+	}
+	return slo.VerifyPost(data, certs)
+}
+
+func (p *Provider) sendLogoutRequest(w http.ResponseWriter, r *http.Request, sessionID string, participant *command.SAMLLogoutParticipant) error {
+	signer, err := p.logoutSigner(r, participant.SignatureAlgorithm)
+	if err != nil {
+		return err
+	}
+	request := &slo.Request{
+		ID:           logoutRequestIDPrefix + participant.SAMLSessionID,
+		Issuer:       participant.Issuer,
+		Destination:  participant.SingleLogoutURL,
+		NameID:       participant.NameID,
+		NameIDFormat: participant.NameIDFormat,
+		SessionIndex: participant.SessionIndex,
+	}
+	if participant.Binding == provider.RedirectBinding {
+		location, err := request.RedirectURL(sessionID, signer, time.Now())
+		if err != nil {
+			return err
+		}
+		http.Redirect(w, r, location, http.StatusFound)
+		return nil
+	}
+	data, err := request.SignedXML(signer, time.Now())
+	if err != nil {
+		return err
+	}
+	return logoutPostTemplate.Execute(w, &logoutPostForm{
+		URL:        participant.SingleLogoutURL,
+		RelayState: sessionID,
+		Parameter:  "SAMLRequest",
+		Message:    base64.StdEncoding.EncodeToString(data),
+	})
+}
+
+// sendLogoutResponse sends the LogoutResponse to the SingleLogoutService of the service provider,
+// which uses a front channel binding.
+func (p *Provider) sendLogoutResponse(w http.ResponseWriter, r *http.Request, entityID string, response *slo.Response, relayState, signatureAlgorithm string) error {
+	sp, err := p.storage.GetEntityByID(r.Context(), entityID)
+	if err != nil {
+		return err
+	}
+	endpoint := slo.Endpoint(sp.Metadata.SPSSODescriptor, slo.FrontChannelBindings)
+	if endpoint == nil {
+		return zerrors.ThrowPreconditionFailed(nil, "SAML-Tu2wd", "no single logout service in the metadata of the service provider")
+	}
+	response.Destination = endpoint.Location
+	if endpoint.ResponseLocation != "" {
+		response.Destination = endpoint.ResponseLocation
+	}
+	signer, err := p.logoutSigner(r, signatureAlgorithm)
+	if err != nil {
+		return err
+	}
+	if endpoint.Binding == provider.RedirectBinding {
+		location, err := response.RedirectURL(relayState, signer, time.Now())
+		if err != nil {
+			return err
+		}
+		http.Redirect(w, r, location, http.StatusFound)
+		return nil
+	}
+	data, err := response.SignedXML(signer, time.Now())
+	if err != nil {
+		return err
+	}
+	return logoutPostTemplate.Execute(w, &logoutPostForm{
+		URL:        response.Destination,
+		RelayState: relayState,
+		Parameter:  "SAMLResponse",
+		Message:    base64.StdEncoding.EncodeToString(data),
+	})
+}
+
+func (p *Provider) logoutSigner(r *http.Request, signatureAlgorithm string) (*slo.Signer, error) {
+	cert, key, err := p.responseSigningKey(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	if signatureAlgorithm == "" {
+		signatureAlgorithm = p.signatureAlgorithm
+	}
+	return &slo.Signer{
+		Certificate: cert,
+		Key:         key,
+		Algorithm:   signatureAlgorithm,
+	}, nil
+}
@@ -0,0 +1,82 @@
+package saml
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+
+	"github.com/zitadel/logging"
+	"github.com/zitadel/saml/pkg/provider/signature"
+	"github.com/zitadel/saml/pkg/provider/xml"
+	"github.com/zitadel/saml/pkg/provider/xml/md"
+	"github.com/zitadel/saml/pkg/provider/xml/xml_dsig"
+
+	"github.com/zitadel/zitadel/internal/crypto/keyprovider"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// metadataHandler serves the metadata of the identity provider
+// including the certificate of the external signing key of the key provider.
+func (p *Provider) metadataHandler(w http.ResponseWriter, r *http.Request) {
+	metadata, err := p.metadata(r.Context())
+	if err != nil {
+		logging.WithError(err).Error("error while getting metadata")
+		http.Error(w, "error while getting metadata", http.StatusInternalServerError)
+		return
+	}
+	if err := xml.WriteXMLMarshalled(w, metadata); err != nil {
+		http.Error(w, "failed to respond with metadata", http.StatusInternalServerError)
+	}
+}
+
+// metadata adds the certificate of the external signing key to the signing keys of the metadata.
+// The certificate of the instance is kept, as the attribute query responses are still signed with its key.
+// If the metadata is signed, it's signed again, as the added certificate invalidates the signature.
+func (p *Provider) metadata(ctx context.Context) (*md.EntityDescriptorType, error) {
+	metadata, err := p.Provider.GetMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+	signingKey := p.query.ExternalSigningKey()
+	if signingKey == nil || len(signingKey.Certificate) == 0 {
+		return metadata, nil
+	}
+	if idp := metadata.IDPSSODescriptor; idp != nil {
+		idp.KeyDescriptor = append([]md.KeyDescriptorType{externalKeyDescriptor(signingKey)}, idp.KeyDescriptor...)
+	}
+	if aa := metadata.AttributeAuthorityDescriptor; aa != nil {
+		aa.KeyDescriptor = append([]md.KeyDescriptorType{externalKeyDescriptor(signingKey)}, aa.KeyDescriptor...)
+	}
+	if metadata.Signature == nil {
+		return metadata, nil
+	}
+	metadata.Signature = nil
+	certAndKey, err := p.storage.GetMetadataSigningKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if certAndKey.Key == nil || certAndKey.Certificate == nil {
+		return nil, zerrors.ThrowInternal(nil, "SAML-Mq3vb", "no metadata signing key")
+	}
+	signer, err := signature.GetSigner(certAndKey.Certificate, certAndKey.Key, p.metadataSignatureAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+	metadata.Signature, err = signature.Create(signer, metadata)
+	if err != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
+
+func externalKeyDescriptor(signingKey *keyprovider.SigningKey) md.KeyDescriptorType {
+	return md.KeyDescriptorType{
+		Use: md.KeyTypesSigning,
+		KeyInfo: xml_dsig.KeyInfoType{
+			KeyName: []string{signingKey.ID},
+			X509Data: []xml_dsig.X509DataType{{
+				X509Certificate: base64.StdEncoding.EncodeToString(signingKey.Certificate),
+			}},
+		},
+	}
+}
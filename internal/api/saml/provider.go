@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/gorilla/mux"
 	"github.com/zitadel/saml/pkg/provider"
 
 	"github.com/zitadel/zitadel/backend/v3/instrumentation/metrics"
@@ -32,6 +33,15 @@ type Config struct {
 type Provider struct {
 	*provider.Provider
 	command *command.Commands
+	query   *query.Queries
+	storage *Storage
+	handler http.Handler
+
+	signatureAlgorithm         string
+	metadataSignatureAlgorithm string
+	metadataEndpoint           string
+	ssoEndpoint                string
+	sloEndpoint                string
 }
 
 func NewProvider(
@@ -70,20 +80,21 @@ func NewProvider(
 		return nil, err
 	}
 
+	interceptors := []provider.HttpInterceptor{
+		middleware.CallDurationHandler,
+		middleware.RequestDetailsHandler(),
+		middleware.MetricsHandler(metricTypes),
+		middleware.TraceHandler(),
+		middleware.LogHandler("saml"),
+		middleware.NoCacheInterceptor().Handler,
+		instanceHandler,
+		userAgentCookie,
+		accessHandler.HandleWithPublicAuthPathPrefixes(publicAuthPathPrefixes(conf.ProviderConfig)),
+		http_utils.CopyHeadersToContext,
+		middleware.ActivityHandler,
+	}
 	options := []provider.Option{
-		provider.WithHttpInterceptors(
-			middleware.CallDurationHandler,
-			middleware.RequestDetailsHandler(),
-			middleware.MetricsHandler(metricTypes),
-			middleware.TraceHandler(),
-			middleware.LogHandler("saml"),
-			middleware.NoCacheInterceptor().Handler,
-			instanceHandler,
-			userAgentCookie,
-			accessHandler.HandleWithPublicAuthPathPrefixes(publicAuthPathPrefixes(conf.ProviderConfig)),
-			http_utils.CopyHeadersToContext,
-			middleware.ActivityHandler,
-		),
+		provider.WithHttpInterceptors(interceptors...),
 		provider.WithCustomTimeFormat("2006-01-02T15:04:05.999Z"),
 	}
 	if !externalSecure {
@@ -99,10 +110,63 @@ func NewProvider(
 	if err != nil {
 		return nil, err
 	}
-	return &Provider{
-		p,
-		command,
-	}, nil
+	samlProvider := &Provider{
+		Provider:    p,
+		command:     command,
+		query:       query,
+		storage:     provStorage,
+		ssoEndpoint: "/" + provider.DefaultSingleSignOnEndpoint,
+		sloEndpoint: "/" + provider.DefaultSingleLogOutEndpoint,
+	}
+	samlProvider.metadataEndpoint = provider.DefaultMetadataEndpoint
+	if conf.ProviderConfig.Metadata != nil && conf.ProviderConfig.Metadata.Relative() != "" {
+		samlProvider.metadataEndpoint = conf.ProviderConfig.Metadata.Relative()
+	}
+	if conf.ProviderConfig.MetadataConfig != nil {
+		samlProvider.metadataSignatureAlgorithm = conf.ProviderConfig.MetadataConfig.SignatureAlgorithm
+	}
+	callbackEndpoint := "/" + provider.DefaultCallbackEndpoint
+	if idpConfig := conf.ProviderConfig.IDPConfig; idpConfig != nil {
+		samlProvider.signatureAlgorithm = idpConfig.SignatureAlgorithm
+		if idpConfig.Endpoints != nil && idpConfig.Endpoints.SingleSignOn != nil && idpConfig.Endpoints.SingleSignOn.Relative() != "" {
+			samlProvider.ssoEndpoint = idpConfig.Endpoints.SingleSignOn.Relative()
+		}
+		if idpConfig.Endpoints != nil && idpConfig.Endpoints.SingleLogOut != nil && idpConfig.Endpoints.SingleLogOut.Relative() != "" {
+			samlProvider.sloEndpoint = idpConfig.Endpoints.SingleLogOut.Relative()
+		}
+		if idpConfig.Endpoints != nil && idpConfig.Endpoints.Callback != nil && idpConfig.Endpoints.Callback.Relative() != "" {
+			callbackEndpoint = idpConfig.Endpoints.Callback.Relative()
+		}
+	}
+	samlProvider.handler = samlProvider.newHandler(callbackEndpoint, interceptors)
+	return samlProvider, nil
+}
+
+// HttpHandler returns the handler of the provider,
+// extended by the IdP-initiated login, the login callback applying the settings of the service provider,
+// the Single Logout propagated to all participants of the session
+// and the metadata including the certificate of the external signing key.
+func (p *Provider) HttpHandler() http.Handler {
+	return p.handler
+}
+
+func (p *Provider) newHandler(callbackEndpoint string, interceptors []provider.HttpInterceptor) http.Handler {
+	intercept := func(handler http.Handler) http.Handler {
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			handler = interceptors[i](handler)
+		}
+		return provider.NewIssuerInterceptor(p.IssuerFromRequest).Handler(handler)
+	}
+	router := mux.NewRouter()
+	router.Handle(IDPInitiatedEndpoint+"{"+idpInitiatedAppIDParam+"}", intercept(http.HandlerFunc(p.idpInitiatedHandler)))
+	router.Handle(callbackEndpoint, intercept(http.HandlerFunc(p.callbackHandler)))
+	router.Handle(p.sloEndpoint, intercept(http.HandlerFunc(p.logoutHandler)))
+	router.Handle(FrontChannelLogoutEndpoint, intercept(http.HandlerFunc(p.frontChannelLogoutHandler)))
+	if signingKey := p.query.ExternalSigningKey(); signingKey != nil && len(signingKey.Certificate) > 0 {
+		router.Handle(p.metadataEndpoint, intercept(http.HandlerFunc(p.metadataHandler)))
+	}
+	router.PathPrefix("/").Handler(p.Provider.HttpHandler())
+	return router
 }
 
 func ContextToIssuer(ctx context.Context) string {
@@ -149,11 +213,12 @@ func publicAuthPathPrefixes(config *provider.Config) []string {
 	metadataEndpoint := HandlerPrefix + provider.DefaultMetadataEndpoint
 	certificateEndpoint := HandlerPrefix + provider.DefaultCertificateEndpoint
 	ssoEndpoint := HandlerPrefix + provider.DefaultSingleSignOnEndpoint
+	idpInitiatedEndpoint := HandlerPrefix + IDPInitiatedEndpoint
 	if config.MetadataConfig != nil && config.MetadataConfig.Path != "" {
 		metadataEndpoint = HandlerPrefix + config.MetadataConfig.Path
 	}
 	if config.IDPConfig == nil || config.IDPConfig.Endpoints == nil {
-		return []string{metadataEndpoint, certificateEndpoint, ssoEndpoint}
+		return []string{metadataEndpoint, certificateEndpoint, ssoEndpoint, idpInitiatedEndpoint}
 	}
 	if config.IDPConfig.Endpoints.Certificate != nil && config.IDPConfig.Endpoints.Certificate.Relative() != "" {
 		certificateEndpoint = HandlerPrefix + config.IDPConfig.Endpoints.Certificate.Relative()
@@ -161,5 +226,5 @@ func publicAuthPathPrefixes(config *provider.Config) []string {
 	if config.IDPConfig.Endpoints.SingleSignOn != nil && config.IDPConfig.Endpoints.SingleSignOn.Relative() != "" {
 		ssoEndpoint = HandlerPrefix + config.IDPConfig.Endpoints.SingleSignOn.Relative()
 	}
-	return []string{metadataEndpoint, certificateEndpoint, ssoEndpoint}
+	return []string{metadataEndpoint, certificateEndpoint, ssoEndpoint, idpInitiatedEndpoint}
 }
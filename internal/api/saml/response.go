@@ -0,0 +1,305 @@
+package saml
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/base64"
+	stdxml "encoding/xml"
+
+	"github.com/beevik/etree"
+	"github.com/crewjam/saml/xmlenc"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/zitadel/saml/pkg/provider"
+	"github.com/zitadel/saml/pkg/provider/signature"
+	"github.com/zitadel/saml/pkg/provider/xml"
+	"github.com/zitadel/saml/pkg/provider/xml/md"
+	"github.com/zitadel/saml/pkg/provider/xml/samlp"
+
+	"github.com/zitadel/zitadel/internal/api/saml/slo"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	encryptedAssertionNamespace = "urn:oasis:names:tc:SAML:2.0:assertion"
+	encryptedElementType        = "http://www.w3.org/2001/04/xmlenc#Element"
+)
+
+// responseSettings are the settings of a service provider,
+// which are applied to the successful responses sent to it.
+type responseSettings struct {
+	nameIDFormat       domain.SAMLNameIDFormat
+	nameID             string
+	signatureAlgorithm string
+	encryptionCert     *x509.Certificate
+	singleLogout       *md.EndpointType
+}
+
+// applyResponseSettings applies the settings of the service provider (audience of the response)
+// to the successful response for the user and returns the marshalled response.
+// For the redirect binding the signature and its algorithm are set on the provided response.
+func (p *Provider) applyResponseSettings(ctx context.Context, samlResponse *samlp.ResponseType, resp *provider.Response, userID string) (*responseSettings, []byte, error) {
+	settings, err := p.responseSettings(ctx, resp.Audience, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	cert, key, err := p.responseSigningKey(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	respData, sigAlg, sig, err := settings.apply(samlResponse, resp.ProtocolBinding, resp.RelayState, cert, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.ProtocolBinding == provider.RedirectBinding {
+		resp.SigAlg = sigAlg
+		resp.Signature = sig
+	}
+	return settings, respData, nil
+}
+
+// responseSigningKey returns the certificate and key to sign the responses and logout messages.
+// The external signing key of the key provider is used instead of the key of the instance, if it has a certificate.
+func (p *Provider) responseSigningKey(ctx context.Context) (cert []byte, key crypto.Signer, err error) {
+	if signingKey := p.query.ExternalSigningKey(); signingKey != nil && len(signingKey.Certificate) > 0 {
+		return signingKey.Certificate, signingKey.Signer, nil
+	}
+	certAndKey, err := p.storage.GetResponseSigningKey(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if certAndKey == nil || certAndKey.Key == nil || len(certAndKey.Certificate) == 0 {
+		return nil, nil, zerrors.ThrowInternal(nil, "SAML-p0Uzd", "no response signing key")
+	}
+	return certAndKey.Certificate, certAndKey.Key, nil
+}
+
+// responseSettings loads the settings of the service provider with the provided entityID
+// and resolves the NameID of the user according to them.
+func (p *Provider) responseSettings(ctx context.Context, entityID, userID string) (_ *responseSettings, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	sp, err := p.query.ActiveSAMLServiceProviderByID(ctx, entityID)
+	if err != nil {
+		return nil, err
+	}
+	user, err := p.query.GetUserByID(ctx, true, userID)
+	if err != nil {
+		return nil, err
+	}
+	settings := newResponseSettings(sp, user, p.signatureAlgorithm)
+	metadata, err := xml.ParseMetadataXmlIntoStruct(sp.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	settings.singleLogout = slo.Endpoint(metadata.SPSSODescriptor, slo.Bindings)
+	if !sp.EncryptAssertion {
+		return settings, nil
+	}
+	settings.encryptionCert, err = domain.SAMLEncryptionCertificate(metadata)
+	if err != nil {
+		return nil, err
+	}
+	if settings.encryptionCert == nil {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "SAML-Rk2oY", "Errors.Project.App.SAMLEncryptionCertificateMissing")
+	}
+	return settings, nil
+}
+
+// newResponseSettings selects the NameID of the user and the signature algorithm for the service provider.
+// The NameID falls back to the preferred login name, if the configured source is not available for the user,
+// and the signature algorithm to the one of the provider configuration, if none is set for the service provider.
+func newResponseSettings(sp *query.SAMLServiceProvider, user *query.User, defaultSignatureAlgorithm string) *responseSettings {
+	settings := &responseSettings{
+		nameIDFormat:       sp.NameIDFormat,
+		nameID:             user.PreferredLoginName,
+		signatureAlgorithm: sp.SignatureAlgorithm.URI(),
+	}
+	if settings.signatureAlgorithm == "" {
+		settings.signatureAlgorithm = defaultSignatureAlgorithm
+	}
+	switch {
+	case sp.NameIDFormat == domain.SAMLNameIDFormatTransient:
+		// a transient identifier must not be correlated with the user across responses
+		settings.nameID = provider.NewID()
+	case sp.NameIDSource == domain.SAMLNameIDSourceEmail && user.Human != nil && user.Human.Email != "":
+		settings.nameID = string(user.Human.Email)
+	case sp.NameIDSource == domain.SAMLNameIDSourceUserID:
+		settings.nameID = user.ID
+	}
+	return settings
+}
+
+// logout returns the information needed to notify the service provider about the logout of the session,
+// or nil if its metadata does not contain a supported SingleLogoutService.
+func (s *responseSettings) logout(issuer string, samlResponse *samlp.ResponseType) *command.SAMLLogout {
+	if s.singleLogout == nil || samlResponse.Assertion == nil {
+		return nil
+	}
+	logout := &command.SAMLLogout{
+		Issuer:             issuer,
+		NameID:             s.nameID,
+		NameIDFormat:       s.nameIDFormat.URI(),
+		SignatureAlgorithm: s.signatureAlgorithm,
+		SingleLogoutURL:    s.singleLogout.Location,
+		Binding:            s.singleLogout.Binding,
+	}
+	if len(samlResponse.Assertion.AuthnStatement) > 0 {
+		logout.SessionIndex = samlResponse.Assertion.AuthnStatement[0].SessionIndex
+	}
+	return logout
+}
+
+// apply sets the NameID of the response, signs it with the algorithm of the service provider
+// and encrypts the assertion if required.
+// It returns the marshalled response and for the redirect binding the signature and its algorithm.
+func (s *responseSettings) apply(samlResponse *samlp.ResponseType, binding, relayState string, cert []byte, key crypto.Signer) (respData []byte, sigAlg, sig string, err error) {
+	if subject := samlResponse.Assertion.Subject; subject != nil && subject.NameID != nil {
+		subject.NameID.Format = s.nameIDFormat.URI()
+		subject.NameID.Text = s.nameID
+	}
+	samlResponse.Signature = nil
+	samlResponse.Assertion.Signature = nil
+
+	switch binding {
+	case provider.PostBinding:
+		respData, err = signPostResponse(samlResponse, s.encryptionCert, cert, key, s.signatureAlgorithm)
+		return respData, "", "", err
+	case provider.RedirectBinding:
+		// the assertion can only be encrypted for the POST binding,
+		// as the SAML profile does not allow the assertion to be sent with the redirect binding
+		if s.encryptionCert != nil {
+			return nil, "", "", zerrors.ThrowPreconditionFailed(nil, "SAML-vB3oq", "encrypted assertions require the POST binding")
+		}
+		respData, err = xml.Marshal(samlResponse)
+		if err != nil {
+			return nil, "", "", err
+		}
+		sigAlg, sig, err = signRedirectResponse(respData, relayState, cert, key, s.signatureAlgorithm)
+		return respData, sigAlg, sig, err
+	}
+	respData, err = xml.Marshal(samlResponse)
+	return respData, "", "", err
+}
+
+// signRedirectResponse creates the signature of the query for the redirect binding (SAML Bindings, section 3.4.4.1)
+// and returns the algorithm and the base64 encoded signature to be added to the query by [createResponse].
+func signRedirectResponse(respData []byte, relayState string, cert []byte, key crypto.Signer, signatureAlgorithm string) (sigAlg, sig string, err error) {
+	deflated, err := xml.DeflateAndBase64(respData)
+	if err != nil {
+		return "", "", err
+	}
+	signingContext, err := signingContext(cert, key, signatureAlgorithm)
+	if err != nil {
+		return "", "", err
+	}
+	signed, err := signature.CreateRedirect(signingContext, provider.BuildRedirectQuery(string(deflated), relayState, signatureAlgorithm, ""))
+	if err != nil {
+		return "", "", err
+	}
+	return signatureAlgorithm, base64.StdEncoding.EncodeToString(signed), nil
+}
+
+// signPostResponse signs the assertion and the response for the POST binding.
+// If an encryption certificate is provided, the signed assertion is replaced by an EncryptedAssertion.
+// As [samlp.ResponseType] can't represent the encrypted assertion, the response is signed on its XML representation
+// and the marshalled response is returned.
+func signPostResponse(samlResponse *samlp.ResponseType, encryptionCert *x509.Certificate, cert []byte, key crypto.Signer, signatureAlgorithm string) ([]byte, error) {
+	signingContext, err := signingContext(cert, key, signatureAlgorithm)
+	if err != nil {
+		return nil, err
+	}
+	respData, err := stdxml.Marshal(samlResponse)
+	if err != nil {
+		return nil, err
+	}
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(respData); err != nil {
+		return nil, err
+	}
+	response := doc.Root()
+	assertion := response.FindElement("./Assertion")
+	if assertion == nil {
+		return nil, zerrors.ThrowInternal(nil, "SAML-Hn5ct", "response does not contain an assertion")
+	}
+	signedAssertion, err := signEnveloped(signingContext, assertion)
+	if err != nil {
+		return nil, err
+	}
+	if encryptionCert != nil {
+		signedAssertion, err = encryptAssertion(signedAssertion, encryptionCert)
+		if err != nil {
+			return nil, err
+		}
+	}
+	response.InsertChildAt(assertion.Index(), signedAssertion)
+	response.RemoveChild(assertion)
+
+	signedResponse, err := signEnveloped(signingContext, response)
+	if err != nil {
+		return nil, err
+	}
+	doc.SetRoot(signedResponse)
+	signedData, err := doc.WriteToBytes()
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(stdxml.Header), signedData...), nil
+}
+
+func signingContext(cert []byte, key crypto.Signer, signatureAlgorithm string) (*dsig.SigningContext, error) {
+	signingContext, err := dsig.NewSigningContext(key, [][]byte{cert})
+	if err != nil {
+		return nil, err
+	}
+	signingContext.Canonicalizer = dsig.MakeC14N10ExclusiveCanonicalizerWithPrefixList("")
+	if err = signingContext.SetSignatureMethod(signatureAlgorithm); err != nil {
+		return nil, err
+	}
+	return signingContext, nil
+}
+
+// signEnveloped signs the element and moves the signature directly after the issuer as required by the schema.
+// The position doesn't affect the enveloped signature itself.
+func signEnveloped(signingContext *dsig.SigningContext, element *etree.Element) (*etree.Element, error) {
+	signed, err := signingContext.SignEnveloped(element)
+	if err != nil {
+		return nil, err
+	}
+	// the signature is appended as last child
+	sig, ok := signed.RemoveChildAt(len(signed.Child) - 1).(*etree.Element)
+	if !ok {
+		return nil, zerrors.ThrowInternal(nil, "SAML-Qe7zb", "signature not found")
+	}
+	index := 0
+	if issuer := signed.FindElement("./Issuer"); issuer != nil {
+		index = issuer.Index() + 1
+	}
+	signed.InsertChildAt(index, sig)
+	return signed, nil
+}
+
+// encryptAssertion encrypts the (signed) assertion for the provided certificate
+// and returns it wrapped into an EncryptedAssertion.
+func encryptAssertion(assertion *etree.Element, encryptionCert *x509.Certificate) (*etree.Element, error) {
+	doc := etree.NewDocument()
+	doc.SetRoot(assertion.Copy())
+	plaintext, err := doc.WriteToBytes()
+	if err != nil {
+		return nil, err
+	}
+	encryptedData, err := xmlenc.OAEP().Encrypt(encryptionCert, plaintext, nil)
+	if err != nil {
+		return nil, err
+	}
+	encryptedData.CreateAttr("Type", encryptedElementType)
+	encryptedAssertion := etree.NewElement("saml:EncryptedAssertion")
+	encryptedAssertion.CreateAttr("xmlns:saml", encryptedAssertionNamespace)
+	encryptedAssertion.AddChild(encryptedData)
+	return encryptedAssertion, nil
+}
@@ -0,0 +1,433 @@
+package saml
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/crewjam/saml/xmlenc"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/saml/pkg/provider"
+	"github.com/zitadel/saml/pkg/provider/signature"
+	"github.com/zitadel/saml/pkg/provider/xml"
+	"github.com/zitadel/saml/pkg/provider/xml/saml"
+	"github.com/zitadel/saml/pkg/provider/xml/samlp"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func newTestCertificate(t *testing.T) (*x509.Certificate, *rsa.PrivateKey) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert, key
+}
+
+func newTestResponse() *samlp.ResponseType {
+	return &samlp.ResponseType{
+		Id:           "_response",
+		Version:      "2.0",
+		IssueInstant: "2026-01-01T00:00:00Z",
+		Destination:  "https://sp.example.com/acs",
+		Issuer:       &saml.NameIDType{Text: "https://idp.example.com/saml/v2/metadata"},
+		Status: samlp.StatusType{
+			StatusCode: samlp.StatusCodeType{Value: provider.StatusCodeSuccess},
+		},
+		Assertion: saml.AssertionType{
+			Version:      "2.0",
+			Id:           "_assertion",
+			IssueInstant: "2026-01-01T00:00:00Z",
+			Issuer:       saml.NameIDType{Text: "https://idp.example.com/saml/v2/metadata"},
+			Subject: &saml.SubjectType{
+				NameID: &saml.NameIDType{Text: "original"},
+			},
+		},
+	}
+}
+
+// validateSignature validates the enveloped signature of the element with goxmldsig
+// and returns the validated element.
+func validateSignature(t *testing.T, cert *x509.Certificate, element *etree.Element) *etree.Element {
+	validationContext := dsig.NewDefaultValidationContext(&dsig.MemoryX509CertificateStore{Roots: []*x509.Certificate{cert}})
+	validationContext.IdAttribute = "ID"
+	validated, err := validationContext.Validate(element)
+	require.NoError(t, err)
+	return validated
+}
+
+// assertSignature checks the algorithms of the signature, which must directly follow the issuer as required by the schema.
+func assertSignature(t *testing.T, element *etree.Element, signatureAlgorithm, digestAlgorithm string) {
+	require.Greater(t, len(element.ChildElements()), 1)
+	signature := element.ChildElements()[1]
+	require.Equal(t, "Signature", signature.Tag)
+	assert.Equal(t, signatureAlgorithm, signature.FindElement("./SignedInfo/SignatureMethod").SelectAttrValue("Algorithm", ""))
+	assert.Equal(t, digestAlgorithm, signature.FindElement("./SignedInfo/Reference/DigestMethod").SelectAttrValue("Algorithm", ""))
+}
+
+func Test_responseSettings_apply_post(t *testing.T) {
+	cert, key := newTestCertificate(t)
+	tests := []struct {
+		name               string
+		signatureAlgorithm string
+		wantDigest         string
+	}{
+		{
+			name:               "rsa-sha1",
+			signatureAlgorithm: dsig.RSASHA1SignatureMethod,
+			wantDigest:         "http://www.w3.org/2000/09/xmldsig#sha1",
+		},
+		{
+			name:               "rsa-sha256",
+			signatureAlgorithm: dsig.RSASHA256SignatureMethod,
+			wantDigest:         "http://www.w3.org/2001/04/xmlenc#sha256",
+		},
+		{
+			name:               "rsa-sha512",
+			signatureAlgorithm: dsig.RSASHA512SignatureMethod,
+			wantDigest:         "http://www.w3.org/2001/04/xmlenc#sha512",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := &responseSettings{
+				nameIDFormat:       domain.SAMLNameIDFormatEmailAddress,
+				nameID:             "user@example.com",
+				signatureAlgorithm: tt.signatureAlgorithm,
+			}
+			respData, sigAlg, sig, err := settings.apply(newTestResponse(), provider.PostBinding, "state", cert.Raw, key)
+			require.NoError(t, err)
+			assert.Empty(t, sigAlg)
+			assert.Empty(t, sig)
+
+			doc := etree.NewDocument()
+			require.NoError(t, doc.ReadFromBytes(respData))
+			assertSignature(t, doc.Root(), tt.signatureAlgorithm, tt.wantDigest)
+			assertion := doc.Root().FindElement("./Assertion")
+			require.NotNil(t, assertion)
+			assertSignature(t, assertion, tt.signatureAlgorithm, tt.wantDigest)
+
+			validateSignature(t, cert, doc.Root())
+			assertion = validateSignature(t, cert, assertion)
+			nameID := assertion.FindElement("./Subject/NameID")
+			assert.Equal(t, "user@example.com", nameID.Text())
+			assert.Equal(t, domain.SAMLNameIDFormatEmailAddress.URI(), nameID.SelectAttrValue("Format", ""))
+		})
+	}
+}
+
+func Test_responseSettings_apply_post_tampered(t *testing.T) {
+	cert, key := newTestCertificate(t)
+	settings := &responseSettings{
+		nameIDFormat:       domain.SAMLNameIDFormatEmailAddress,
+		nameID:             "user@example.com",
+		signatureAlgorithm: dsig.RSASHA256SignatureMethod,
+	}
+	respData, _, _, err := settings.apply(newTestResponse(), provider.PostBinding, "", cert.Raw, key)
+	require.NoError(t, err)
+
+	tampered := strings.Replace(string(respData), "user@example.com", "admin@example.com", 1)
+	doc := etree.NewDocument()
+	require.NoError(t, doc.ReadFromString(tampered))
+	validationContext := dsig.NewDefaultValidationContext(&dsig.MemoryX509CertificateStore{Roots: []*x509.Certificate{cert}})
+	validationContext.IdAttribute = "ID"
+	_, err = validationContext.Validate(doc.Root())
+	assert.Error(t, err)
+
+	otherCert, _ := newTestCertificate(t)
+	doc = etree.NewDocument()
+	require.NoError(t, doc.ReadFromBytes(respData))
+	validationContext = dsig.NewDefaultValidationContext(&dsig.MemoryX509CertificateStore{Roots: []*x509.Certificate{otherCert}})
+	validationContext.IdAttribute = "ID"
+	_, err = validationContext.Validate(doc.Root())
+	assert.Error(t, err)
+}
+
+func Test_responseSettings_apply_encrypted(t *testing.T) {
+	cert, key := newTestCertificate(t)
+	spCert, spKey := newTestCertificate(t)
+	settings := &responseSettings{
+		nameIDFormat:       domain.SAMLNameIDFormatPersistent,
+		nameID:             "userID",
+		signatureAlgorithm: dsig.RSASHA256SignatureMethod,
+		encryptionCert:     spCert,
+	}
+	respData, _, _, err := settings.apply(newTestResponse(), provider.PostBinding, "", cert.Raw, key)
+	require.NoError(t, err)
+	assert.NotContains(t, string(respData), "userID", "plaintext NameID must not be part of the response")
+
+	doc := etree.NewDocument()
+	require.NoError(t, doc.ReadFromBytes(respData))
+	response := validateSignature(t, cert, doc.Root())
+	assert.Nil(t, response.FindElement("./Assertion"), "plaintext assertion must be removed")
+	encryptedAssertion := response.FindElement("./EncryptedAssertion")
+	require.NotNil(t, encryptedAssertion)
+	assert.Equal(t, encryptedAssertionNamespace, encryptedAssertion.NamespaceURI())
+	encryptedData := encryptedAssertion.FindElement("./EncryptedData")
+	require.NotNil(t, encryptedData)
+	assert.Equal(t, encryptedElementType, encryptedData.SelectAttrValue("Type", ""))
+
+	_, err = xmlenc.Decrypt(key, encryptedData)
+	assert.Error(t, err, "the assertion must only decrypt with the key of the service provider")
+
+	plaintext, err := xmlenc.Decrypt(spKey, encryptedData)
+	require.NoError(t, err)
+	assertionDoc := etree.NewDocument()
+	require.NoError(t, assertionDoc.ReadFromBytes(plaintext))
+	assertion := validateSignature(t, cert, assertionDoc.Root())
+	assert.Equal(t, "Assertion", assertion.Tag)
+	nameID := assertion.FindElement("./Subject/NameID")
+	assert.Equal(t, "userID", nameID.Text())
+	assert.Equal(t, domain.SAMLNameIDFormatPersistent.URI(), nameID.SelectAttrValue("Format", ""))
+}
+
+func Test_responseSettings_apply_redirect(t *testing.T) {
+	cert, key := newTestCertificate(t)
+	tests := []struct {
+		name               string
+		signatureAlgorithm string
+		hash               crypto.Hash
+		relayState         string
+		acs                string
+	}{
+		{
+			name:               "rsa-sha1",
+			signatureAlgorithm: dsig.RSASHA1SignatureMethod,
+			hash:               crypto.SHA1,
+			relayState:         "state",
+			acs:                "https://sp.example.com/acs",
+		},
+		{
+			name:               "rsa-sha256",
+			signatureAlgorithm: dsig.RSASHA256SignatureMethod,
+			hash:               crypto.SHA256,
+			relayState:         "https://sp.example.com/app?page=1&tab=a b",
+			acs:                "https://sp.example.com/acs",
+		},
+		{
+			name:               "rsa-sha512, no relay state, acs with query",
+			signatureAlgorithm: dsig.RSASHA512SignatureMethod,
+			hash:               crypto.SHA512,
+			acs:                "https://sp.example.com/acs?tenant=1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := &responseSettings{
+				nameIDFormat:       domain.SAMLNameIDFormatEmailAddress,
+				nameID:             "user@example.com",
+				signatureAlgorithm: tt.signatureAlgorithm,
+			}
+			respData, sigAlg, sig, err := settings.apply(newTestResponse(), provider.RedirectBinding, tt.relayState, cert.Raw, key)
+			require.NoError(t, err)
+			assert.Equal(t, tt.signatureAlgorithm, sigAlg)
+
+			location, _, err := createResponse(respData, provider.RedirectBinding, tt.acs, tt.relayState, sigAlg, sig)
+			require.NoError(t, err)
+			parsed, err := url.Parse(location)
+			require.NoError(t, err)
+
+			// the service provider verifies the signature over the parameters as they were received (SAML Bindings, section 3.4.4.1)
+			raw := make(map[string]string)
+			for _, param := range strings.Split(parsed.RawQuery, "&") {
+				name, value, _ := strings.Cut(param, "=")
+				raw[name] = value
+			}
+			signed := "SAMLResponse=" + raw["SAMLResponse"]
+			if tt.relayState != "" {
+				signed += "&RelayState=" + raw["RelayState"]
+			} else {
+				assert.NotContains(t, raw, "RelayState")
+			}
+			signed += "&SigAlg=" + raw["SigAlg"]
+
+			values := parsed.Query()
+			assert.Equal(t, tt.relayState, values.Get("RelayState"))
+			assert.Equal(t, tt.signatureAlgorithm, values.Get("SigAlg"))
+			signatureValue, err := base64.StdEncoding.DecodeString(values.Get("Signature"))
+			require.NoError(t, err)
+			hash := tt.hash.New()
+			hash.Write([]byte(signed))
+			assert.NoError(t, rsa.VerifyPKCS1v15(cert.PublicKey.(*rsa.PublicKey), tt.hash, hash.Sum(nil), signatureValue))
+			if tt.hash != crypto.SHA512 {
+				assert.NoError(t, signature.ValidateRedirect(sigAlg, []byte(signed), signatureValue, cert.PublicKey))
+			}
+
+			// the signature must not be valid for another relay state
+			hash = tt.hash.New()
+			hash.Write([]byte(strings.Replace(signed, "&SigAlg=", "&RelayState=other&SigAlg=", 1)))
+			assert.Error(t, rsa.VerifyPKCS1v15(cert.PublicKey.(*rsa.PublicKey), tt.hash, hash.Sum(nil), signatureValue))
+
+			// the query of the assertion consumer service is kept
+			if acs, _ := url.Parse(tt.acs); acs.RawQuery != "" {
+				for name := range acs.Query() {
+					assert.Equal(t, acs.Query().Get(name), values.Get(name))
+				}
+			}
+
+			inflated, err := xml.InflateAndDecode(xml.EncodingDeflate, true, values.Get("SAMLResponse"))
+			require.NoError(t, err)
+			assert.Contains(t, string(inflated), "user@example.com")
+		})
+	}
+}
+
+func Test_responseSettings_apply_redirect_encrypted(t *testing.T) {
+	cert, key := newTestCertificate(t)
+	spCert, _ := newTestCertificate(t)
+	settings := &responseSettings{
+		nameIDFormat:       domain.SAMLNameIDFormatEmailAddress,
+		nameID:             "user@example.com",
+		signatureAlgorithm: dsig.RSASHA256SignatureMethod,
+		encryptionCert:     spCert,
+	}
+	_, _, _, err := settings.apply(newTestResponse(), provider.RedirectBinding, "", cert.Raw, key)
+	assert.Error(t, err)
+}
+
+func Test_newResponseSettings(t *testing.T) {
+	human := &query.User{
+		ID:                 "userID",
+		PreferredLoginName: "user@org.example.com",
+		Human:              &query.Human{Email: "user@example.com"},
+	}
+	machine := &query.User{
+		ID:                 "machineID",
+		PreferredLoginName: "machine@org.example.com",
+		Machine:            &query.Machine{Name: "machine"},
+	}
+	tests := []struct {
+		name                   string
+		sp                     *query.SAMLServiceProvider
+		user                   *query.User
+		wantNameIDFormat       domain.SAMLNameIDFormat
+		wantNameID             string
+		wantSignatureAlgorithm string
+	}{
+		{
+			name:                   "defaults",
+			sp:                     &query.SAMLServiceProvider{NameIDFormat: domain.SAMLNameIDFormatEmailAddress},
+			user:                   human,
+			wantNameIDFormat:       domain.SAMLNameIDFormatEmailAddress,
+			wantNameID:             "user@org.example.com",
+			wantSignatureAlgorithm: dsig.RSASHA256SignatureMethod,
+		},
+		{
+			name: "email",
+			sp: &query.SAMLServiceProvider{
+				NameIDFormat: domain.SAMLNameIDFormatEmailAddress,
+				NameIDSource: domain.SAMLNameIDSourceEmail,
+			},
+			user:                   human,
+			wantNameIDFormat:       domain.SAMLNameIDFormatEmailAddress,
+			wantNameID:             "user@example.com",
+			wantSignatureAlgorithm: dsig.RSASHA256SignatureMethod,
+		},
+		{
+			name: "email of machine user, fallback to login name",
+			sp: &query.SAMLServiceProvider{
+				NameIDFormat: domain.SAMLNameIDFormatEmailAddress,
+				NameIDSource: domain.SAMLNameIDSourceEmail,
+			},
+			user:                   machine,
+			wantNameIDFormat:       domain.SAMLNameIDFormatEmailAddress,
+			wantNameID:             "machine@org.example.com",
+			wantSignatureAlgorithm: dsig.RSASHA256SignatureMethod,
+		},
+		{
+			name: "empty email, fallback to login name",
+			sp: &query.SAMLServiceProvider{
+				NameIDFormat: domain.SAMLNameIDFormatEmailAddress,
+				NameIDSource: domain.SAMLNameIDSourceEmail,
+			},
+			user: &query.User{
+				ID:                 "userID",
+				PreferredLoginName: "user@org.example.com",
+				Human:              &query.Human{},
+			},
+			wantNameIDFormat:       domain.SAMLNameIDFormatEmailAddress,
+			wantNameID:             "user@org.example.com",
+			wantSignatureAlgorithm: dsig.RSASHA256SignatureMethod,
+		},
+		{
+			name: "persistent user id",
+			sp: &query.SAMLServiceProvider{
+				NameIDFormat: domain.SAMLNameIDFormatPersistent,
+				NameIDSource: domain.SAMLNameIDSourceUserID,
+			},
+			user:                   human,
+			wantNameIDFormat:       domain.SAMLNameIDFormatPersistent,
+			wantNameID:             "userID",
+			wantSignatureAlgorithm: dsig.RSASHA256SignatureMethod,
+		},
+		{
+			name: "unspecified format",
+			sp: &query.SAMLServiceProvider{
+				NameIDFormat: domain.SAMLNameIDFormatUnspecified,
+				NameIDSource: domain.SAMLNameIDSourceUserID,
+			},
+			user:                   machine,
+			wantNameIDFormat:       domain.SAMLNameIDFormatUnspecified,
+			wantNameID:             "machineID",
+			wantSignatureAlgorithm: dsig.RSASHA256SignatureMethod,
+		},
+		{
+			name: "signature algorithm of the service provider",
+			sp: &query.SAMLServiceProvider{
+				NameIDFormat:       domain.SAMLNameIDFormatEmailAddress,
+				SignatureAlgorithm: domain.SAMLSignatureAlgorithmRSASHA512,
+			},
+			user:                   human,
+			wantNameIDFormat:       domain.SAMLNameIDFormatEmailAddress,
+			wantNameID:             "user@org.example.com",
+			wantSignatureAlgorithm: dsig.RSASHA512SignatureMethod,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := newResponseSettings(tt.sp, tt.user, dsig.RSASHA256SignatureMethod)
+			assert.Equal(t, tt.wantNameIDFormat, got.nameIDFormat)
+			assert.Equal(t, tt.wantNameID, got.nameID)
+			assert.Equal(t, tt.wantSignatureAlgorithm, got.signatureAlgorithm)
+		})
+	}
+}
+
+func Test_newResponseSettings_transient(t *testing.T) {
+	sp := &query.SAMLServiceProvider{
+		NameIDFormat: domain.SAMLNameIDFormatTransient,
+		NameIDSource: domain.SAMLNameIDSourceUserID,
+	}
+	user := &query.User{
+		ID:                 "userID",
+		PreferredLoginName: "user@org.example.com",
+		Human:              &query.Human{Email: "user@example.com"},
+	}
+	first := newResponseSettings(sp, user, dsig.RSASHA256SignatureMethod)
+	second := newResponseSettings(sp, user, dsig.RSASHA256SignatureMethod)
+	assert.Equal(t, domain.SAMLNameIDFormatTransient, first.nameIDFormat)
+	assert.NotEmpty(t, first.nameID)
+	assert.NotContains(t, []string{"userID", "user@org.example.com", "user@example.com"}, first.nameID)
+	assert.NotEqual(t, first.nameID, second.nameID, "transient identifiers must not correlate responses")
+}
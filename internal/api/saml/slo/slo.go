@@ -0,0 +1,410 @@
+// Package slo implements the messages of the SAML Single Logout profile,
+// which are sent to the service providers participating in a session.
+package slo
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/dsa" //nolint:staticcheck // DSA is one of the algorithms of the redirect binding
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	stdxml "encoding/xml"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/beevik/etree"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/zitadel/saml/pkg/provider"
+	"github.com/zitadel/saml/pkg/provider/signature"
+	"github.com/zitadel/saml/pkg/provider/xml"
+	"github.com/zitadel/saml/pkg/provider/xml/md"
+	"github.com/zitadel/saml/pkg/provider/xml/saml"
+	"github.com/zitadel/saml/pkg/provider/xml/samlp"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	SOAPBinding = "urn:oasis:names:tc:SAML:2.0:bindings:SOAP"
+
+	soapEnvelopeNamespace = "http://schemas.xmlsoap.org/soap/envelope/"
+	timeFormat            = "2006-01-02T15:04:05.999Z"
+	requestLifetime       = 5 * time.Minute
+)
+
+var (
+	// Bindings are the supported bindings of the SingleLogoutService.
+	// The SOAP binding is preferred, as it does not depend on the user agent.
+	Bindings = []string{SOAPBinding, provider.RedirectBinding, provider.PostBinding}
+	// FrontChannelBindings are the supported bindings, which send the messages through the user agent.
+	FrontChannelBindings = []string{provider.RedirectBinding, provider.PostBinding}
+)
+
+// Endpoint returns the SingleLogoutService of the service provider metadata
+// with the first of the bindings in order of preference.
+// It returns nil if the service provider does not support any of them.
+func Endpoint(descriptor *md.SPSSODescriptorType, bindings []string) *md.EndpointType {
+	if descriptor == nil {
+		return nil
+	}
+	for _, binding := range bindings {
+		for _, endpoint := range descriptor.SingleLogoutService {
+			if endpoint.Binding == binding && endpoint.Location != "" {
+				return &endpoint
+			}
+		}
+	}
+	return nil
+}
+
+// IsFrontChannel returns if messages of the binding are sent through the user agent.
+func IsFrontChannel(binding string) bool {
+	return slices.Contains(FrontChannelBindings, binding)
+}
+
+// Signer signs the messages with the response signing key of the identity provider.
+type Signer struct {
+	// Certificate is DER encoded.
+	Certificate []byte
+	// Key is either the private key of the instance or a signer of the key provider.
+	Key       crypto.Signer
+	Algorithm string
+}
+
+// Request is a LogoutRequest for the session of a service provider.
+type Request struct {
+	ID           string
+	Issuer       string
+	Destination  string
+	NameID       string
+	NameIDFormat string
+	SessionIndex string
+}
+
+// logoutRequest is marshalled instead of [samlp.LogoutRequestType],
+// as the elements of the latter are not in the order required by the schema.
+type logoutRequest struct {
+	XMLName      stdxml.Name      `xml:"urn:oasis:names:tc:SAML:2.0:protocol LogoutRequest"`
+	ID           string           `xml:"ID,attr"`
+	Version      string           `xml:"Version,attr"`
+	IssueInstant string           `xml:"IssueInstant,attr"`
+	NotOnOrAfter string           `xml:"NotOnOrAfter,attr,omitempty"`
+	Destination  string           `xml:"Destination,attr,omitempty"`
+	Issuer       *saml.NameIDType `xml:"urn:oasis:names:tc:SAML:2.0:assertion Issuer"`
+	NameID       *saml.NameIDType `xml:"urn:oasis:names:tc:SAML:2.0:assertion NameID"`
+	SessionIndex []string         `xml:"urn:oasis:names:tc:SAML:2.0:protocol SessionIndex,omitempty"`
+}
+
+func (r *Request) logoutRequest(now time.Time) *logoutRequest {
+	request := &logoutRequest{
+		ID:           r.ID,
+		Version:      "2.0",
+		IssueInstant: now.UTC().Format(timeFormat),
+		NotOnOrAfter: now.Add(requestLifetime).UTC().Format(timeFormat),
+		Destination:  r.Destination,
+		Issuer:       issuer(r.Issuer),
+		NameID: &saml.NameIDType{
+			Format: r.NameIDFormat,
+			Text:   r.NameID,
+		},
+	}
+	if r.SessionIndex != "" {
+		request.SessionIndex = []string{r.SessionIndex}
+	}
+	return request
+}
+
+// SignedXML returns the LogoutRequest with an enveloped signature, as used by the POST and SOAP binding.
+func (r *Request) SignedXML(signer *Signer, now time.Time) ([]byte, error) {
+	return signEnveloped(r.logoutRequest(now), signer)
+}
+
+// RedirectURL returns the URL of the redirect binding, which carries the deflated LogoutRequest and its signature.
+func (r *Request) RedirectURL(relayState string, signer *Signer, now time.Time) (string, error) {
+	data, err := xml.Marshal(r.logoutRequest(now))
+	if err != nil {
+		return "", err
+	}
+	return redirectURL(r.Destination, "SAMLRequest", data, relayState, signer)
+}
+
+// Response is a LogoutResponse to the LogoutRequest of a service provider.
+type Response struct {
+	InResponseTo string
+	Issuer       string
+	Destination  string
+	Status       string
+	Message      string
+}
+
+func (r *Response) logoutResponse(now time.Time) *samlp.LogoutResponseType {
+	return &samlp.LogoutResponseType{
+		Id:           provider.NewID(),
+		InResponseTo: r.InResponseTo,
+		Version:      "2.0",
+		IssueInstant: now.UTC().Format(timeFormat),
+		Destination:  r.Destination,
+		Issuer:       issuer(r.Issuer),
+		Status: samlp.StatusType{
+			StatusCode: samlp.StatusCodeType{
+				Value: r.Status,
+			},
+			StatusMessage: r.Message,
+		},
+	}
+}
+
+// SignedXML returns the LogoutResponse with an enveloped signature, as used by the POST binding.
+func (r *Response) SignedXML(signer *Signer, now time.Time) ([]byte, error) {
+	return signEnveloped(r.logoutResponse(now), signer)
+}
+
+// RedirectURL returns the URL of the redirect binding, which carries the deflated LogoutResponse and its signature.
+func (r *Response) RedirectURL(relayState string, signer *Signer, now time.Time) (string, error) {
+	data, err := xml.Marshal(r.logoutResponse(now))
+	if err != nil {
+		return "", err
+	}
+	return redirectURL(r.Destination, "SAMLResponse", data, relayState, signer)
+}
+
+// DecodeResponse decodes the LogoutResponse sent by a service provider.
+// Messages of the redirect binding are deflated, the ones of the POST binding are not.
+func DecodeResponse(encoding, message string) (*samlp.LogoutResponseType, error) {
+	data, err := xml.InflateAndDecode(encoding, true, message)
+	if err != nil {
+		return nil, err
+	}
+	response := new(samlp.LogoutResponseType)
+	if err := stdxml.Unmarshal(data, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+type soapEnvelope struct {
+	XMLName stdxml.Name `xml:"http://schemas.xmlsoap.org/soap/envelope/ Envelope"`
+	Body    struct {
+		Response *samlp.LogoutResponseType `xml:"urn:oasis:names:tc:SAML:2.0:protocol LogoutResponse"`
+	} `xml:"http://schemas.xmlsoap.org/soap/envelope/ Body"`
+}
+
+// SendSOAP sends the signed LogoutRequest to the service provider using the SOAP binding
+// and checks the status of the returned LogoutResponse.
+func SendSOAP(ctx context.Context, client *http.Client, endpoint string, signedRequest []byte) error {
+	var body bytes.Buffer
+	body.WriteString(`<soap11:Envelope xmlns:soap11="` + soapEnvelopeNamespace + `"><soap11:Body>`)
+	// the signed request already contains the xml header, which must not be part of the body
+	body.Write(bytes.TrimPrefix(signedRequest, []byte(stdxml.Header)))
+	body.WriteString(`</soap11:Body></soap11:Envelope>`)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, &body)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "SLO-Zr4fT", "Errors.Internal")
+	}
+	req.Header.Set("Content-Type", "text/xml; charset=utf-8")
+	req.Header.Set("SOAPAction", "http://www.oasis-open.org/committees/security")
+	resp, err := client.Do(req)
+	if err != nil {
+		return zerrors.ThrowUnavailable(err, "SLO-Vb8kA", "logout request could not be sent")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return zerrors.ThrowUnavailablef(nil, "SLO-g2Wcx", "logout request failed with status %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return zerrors.ThrowUnavailable(err, "SLO-Mh5uE", "logout response could not be read")
+	}
+	envelope := new(soapEnvelope)
+	if err := stdxml.Unmarshal(data, envelope); err != nil || envelope.Body.Response == nil {
+		return zerrors.ThrowInternal(err, "SLO-q9NcL", "invalid logout response")
+	}
+	if status := envelope.Body.Response.Status.StatusCode.Value; status != provider.StatusCodeSuccess {
+		return zerrors.ThrowInternalf(nil, "SLO-Kp3oY", "logout failed with status %s", status)
+	}
+	return nil
+}
+
+// SigningCertificates returns the signing certificates of the service provider metadata.
+func SigningCertificates(metadata *md.EntityDescriptorType) ([]*x509.Certificate, error) {
+	if metadata == nil || metadata.SPSSODescriptor == nil {
+		return nil, nil
+	}
+	certs, err := signature.ParseCertificates(xml.GetCertsFromKeyDescriptors(metadata.SPSSODescriptor.KeyDescriptor))
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "SLO-Rw3nD", "invalid certificate in the metadata of the service provider")
+	}
+	return certs, nil
+}
+
+// VerifyRedirect verifies the signature of a message received with the redirect binding.
+// The signature is computed over the URL encoded parameters as sent by the service provider,
+// so they are taken from the raw query without decoding and encoding them again.
+// Unsigned messages are rejected.
+func VerifyRedirect(rawQuery, parameter string, certs []*x509.Certificate) error {
+	values := rawQueryValues(rawQuery)
+	message, sigAlg, sig := values[parameter], values["SigAlg"], values["Signature"]
+	if message == "" || sigAlg == "" || sig == "" {
+		return zerrors.ThrowPermissionDenied(nil, "SLO-Ue8xB", "logout message is not signed")
+	}
+	signed := parameter + "=" + message
+	if relayState, ok := values["RelayState"]; ok {
+		signed += "&RelayState=" + relayState
+	}
+	signed += "&SigAlg=" + sigAlg
+
+	algorithm, err := url.QueryUnescape(sigAlg)
+	if err != nil {
+		return zerrors.ThrowInvalidArgument(err, "SLO-Pb2sJ", "invalid signature algorithm")
+	}
+	sig, err = url.QueryUnescape(sig)
+	if err != nil {
+		return zerrors.ThrowInvalidArgument(err, "SLO-Hy5gW", "invalid signature")
+	}
+	signatureValue, err := base64.StdEncoding.DecodeString(sig)
+	if err != nil {
+		return zerrors.ThrowInvalidArgument(err, "SLO-Xk4tQ", "invalid signature")
+	}
+	for _, cert := range certs {
+		if !keyMatchesAlgorithm(cert.PublicKey, algorithm) {
+			continue
+		}
+		if signature.ValidateRedirect(algorithm, []byte(signed), signatureValue, cert.PublicKey) == nil {
+			return nil
+		}
+	}
+	return zerrors.ThrowPermissionDenied(nil, "SLO-Dq7vM", "invalid signature of logout message")
+}
+
+// VerifyPost verifies the enveloped signature of a message received with the POST binding.
+// Unsigned messages are rejected.
+func VerifyPost(data []byte, certs []*x509.Certificate) error {
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(data); err != nil || doc.Root() == nil {
+		return zerrors.ThrowInvalidArgument(err, "SLO-Fs6oN", "invalid logout message")
+	}
+	if doc.Root().FindElement("./Signature") == nil {
+		return zerrors.ThrowPermissionDenied(nil, "SLO-Lm9cE", "logout message is not signed")
+	}
+	if len(certs) == 0 {
+		return zerrors.ThrowPermissionDenied(nil, "SLO-Gt2rV", "no certificate to verify the logout message")
+	}
+	if err := signature.ValidatePost(certs, doc.Root()); err != nil {
+		return zerrors.ThrowPermissionDenied(err, "SLO-Nw5aK", "invalid signature of logout message")
+	}
+	return nil
+}
+
+// rawQueryValues returns the first value of each parameter of the query, without decoding it.
+func rawQueryValues(rawQuery string) map[string]string {
+	values := make(map[string]string)
+	for _, pair := range strings.Split(rawQuery, "&") {
+		key, value, _ := strings.Cut(pair, "=")
+		if _, ok := values[key]; !ok && key != "" {
+			values[key] = value
+		}
+	}
+	return values
+}
+
+// keyMatchesAlgorithm prevents verifying a signature with a key of another type,
+// which [signature.ValidateRedirect] doesn't check.
+func keyMatchesAlgorithm(key any, algorithm string) bool {
+	switch key.(type) {
+	case *rsa.PublicKey:
+		return strings.Contains(algorithm, "#rsa-")
+	case *dsa.PublicKey:
+		return strings.Contains(algorithm, "#dsa-")
+	default:
+		return false
+	}
+}
+
+func issuer(entityID string) *saml.NameIDType {
+	return &saml.NameIDType{
+		Format: "urn:oasis:names:tc:SAML:2.0:nameid-format:entity",
+		Text:   entityID,
+	}
+}
+
+// redirectURL appends the deflated message, the relay state and the signature of the query to the destination.
+func redirectURL(destination, parameter string, data []byte, relayState string, signer *Signer) (string, error) {
+	deflated, err := xml.DeflateAndBase64(data)
+	if err != nil {
+		return "", err
+	}
+	signingContext, err := signingContext(signer)
+	if err != nil {
+		return "", err
+	}
+	query := parameter + "=" + url.QueryEscape(string(deflated))
+	if relayState != "" {
+		query += "&RelayState=" + url.QueryEscape(relayState)
+	}
+	query += "&SigAlg=" + url.QueryEscape(signer.Algorithm)
+	sig, err := signature.CreateRedirect(signingContext, query)
+	if err != nil {
+		return "", err
+	}
+	query += "&Signature=" + url.QueryEscape(base64.StdEncoding.EncodeToString(sig))
+	if strings.Contains(destination, "?") {
+		return destination + "&" + query, nil
+	}
+	return destination + "?" + query, nil
+}
+
+func signingContext(signer *Signer) (*dsig.SigningContext, error) {
+	signingContext, err := dsig.NewSigningContext(signer.Key, [][]byte{signer.Certificate})
+	if err != nil {
+		return nil, err
+	}
+	signingContext.Canonicalizer = dsig.MakeC14N10ExclusiveCanonicalizerWithPrefixList("")
+	if err = signingContext.SetSignatureMethod(signer.Algorithm); err != nil {
+		return nil, err
+	}
+	return signingContext, nil
+}
+
+// signEnveloped marshals and signs the message.
+// The signature is moved directly after the issuer as required by the schema.
+func signEnveloped(message any, signer *Signer) ([]byte, error) {
+	signingContext, err := signingContext(signer)
+	if err != nil {
+		return nil, err
+	}
+	data, err := stdxml.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(data); err != nil {
+		return nil, err
+	}
+	signed, err := signingContext.SignEnveloped(doc.Root())
+	if err != nil {
+		return nil, err
+	}
+	// the signature is appended as last child
+	sig, ok := signed.RemoveChildAt(len(signed.Child) - 1).(*etree.Element)
+	if !ok {
+		return nil, zerrors.ThrowInternal(nil, "SLO-c7Gxe", "signature not found")
+	}
+	index := 0
+	if issuer := signed.FindElement("./Issuer"); issuer != nil {
+		index = issuer.Index() + 1
+	}
+	signed.InsertChildAt(index, sig)
+	doc.SetRoot(signed)
+	signedData, err := doc.WriteToBytes()
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(stdxml.Header), signedData...), nil
+}
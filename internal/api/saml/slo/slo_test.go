@@ -0,0 +1,392 @@
+package slo
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/saml/pkg/provider"
+	"github.com/zitadel/saml/pkg/provider/signature"
+	"github.com/zitadel/saml/pkg/provider/xml"
+	"github.com/zitadel/saml/pkg/provider/xml/md"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const signatureAlgorithm = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
+
+func testSigner(t *testing.T) (*Signer, *x509.Certificate) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &Signer{
+		Certificate: der,
+		Key:         key,
+		Algorithm:   signatureAlgorithm,
+	}, cert
+}
+
+func TestEndpoint(t *testing.T) {
+	redirect := md.EndpointType{Binding: provider.RedirectBinding, Location: "https://sp.example.com/slo/redirect"}
+	post := md.EndpointType{Binding: provider.PostBinding, Location: "https://sp.example.com/slo/post"}
+	soap := md.EndpointType{Binding: SOAPBinding, Location: "https://sp.example.com/slo/soap"}
+	tests := []struct {
+		name       string
+		descriptor *md.SPSSODescriptorType
+		bindings   []string
+		want       *md.EndpointType
+	}{
+		{
+			name: "no descriptor",
+		},
+		{
+			name:       "no single logout service",
+			descriptor: &md.SPSSODescriptorType{},
+		},
+		{
+			name: "unsupported binding",
+			descriptor: &md.SPSSODescriptorType{
+				SingleLogoutService: []md.EndpointType{{Binding: "urn:oasis:names:tc:SAML:2.0:bindings:PAOS", Location: "https://sp.example.com/slo"}},
+			},
+			bindings: Bindings,
+		},
+		{
+			name: "soap preferred",
+			descriptor: &md.SPSSODescriptorType{
+				SingleLogoutService: []md.EndpointType{post, redirect, soap},
+			},
+			bindings: Bindings,
+			want:     &soap,
+		},
+		{
+			name: "redirect preferred over post",
+			descriptor: &md.SPSSODescriptorType{
+				SingleLogoutService: []md.EndpointType{post, redirect},
+			},
+			bindings: Bindings,
+			want:     &redirect,
+		},
+		{
+			name: "missing location ignored",
+			descriptor: &md.SPSSODescriptorType{
+				SingleLogoutService: []md.EndpointType{{Binding: SOAPBinding}, post},
+			},
+			bindings: Bindings,
+			want:     &post,
+		},
+		{
+			name: "front channel only",
+			descriptor: &md.SPSSODescriptorType{
+				SingleLogoutService: []md.EndpointType{soap, post},
+			},
+			bindings: FrontChannelBindings,
+			want:     &post,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Endpoint(tt.descriptor, tt.bindings))
+		})
+	}
+}
+
+func TestRequest_SignedXML(t *testing.T) {
+	signer, cert := testSigner(t)
+	request := &Request{
+		ID:           "_request",
+		Issuer:       "https://idp.example.com/saml/v2/metadata",
+		Destination:  "https://sp.example.com/slo",
+		NameID:       "user@example.com",
+		NameIDFormat: "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress",
+		SessionIndex: "_session",
+	}
+	data, err := request.SignedXML(signer, time.Now())
+	require.NoError(t, err)
+
+	doc := etree.NewDocument()
+	require.NoError(t, doc.ReadFromBytes(data))
+	root := doc.Root()
+	assert.Equal(t, "LogoutRequest", root.Tag)
+	assert.Equal(t, "_request", root.SelectAttrValue("ID", ""))
+	assert.Equal(t, "user@example.com", root.FindElement("./NameID").Text())
+	assert.Equal(t, "_session", root.FindElement("./SessionIndex").Text())
+	// the elements must be in the order of the schema
+	var tags []string
+	for _, child := range root.ChildElements() {
+		tags = append(tags, child.Tag)
+	}
+	assert.Equal(t, []string{"Issuer", "Signature", "NameID", "SessionIndex"}, tags)
+	assert.NoError(t, signature.ValidatePost([]*x509.Certificate{cert}, root))
+}
+
+func TestRequest_RedirectURL(t *testing.T) {
+	signer, cert := testSigner(t)
+	request := &Request{
+		ID:           "_request",
+		Issuer:       "https://idp.example.com/saml/v2/metadata",
+		Destination:  "https://sp.example.com/slo?tenant=1",
+		NameID:       "user@example.com",
+		SessionIndex: "_session",
+	}
+	redirect, err := request.RedirectURL("relay", signer, time.Now())
+	require.NoError(t, err)
+
+	parsed, err := url.Parse(redirect)
+	require.NoError(t, err)
+	query := parsed.Query()
+	assert.Equal(t, "1", query.Get("tenant"))
+	assert.Equal(t, "relay", query.Get("RelayState"))
+	assert.Equal(t, signatureAlgorithm, query.Get("SigAlg"))
+
+	logoutRequest, err := xml.DecodeLogoutRequest(xml.EncodingDeflate, query.Get("SAMLRequest"))
+	require.NoError(t, err)
+	assert.Equal(t, "_request", logoutRequest.Id)
+	assert.Equal(t, []string{"_session"}, logoutRequest.SessionIndex)
+
+	sig, err := base64.StdEncoding.DecodeString(query.Get("Signature"))
+	require.NoError(t, err)
+	signed := parsed.RawQuery[strings.Index(parsed.RawQuery, "SAMLRequest="):strings.Index(parsed.RawQuery, "&Signature=")]
+	assert.NoError(t, signature.ValidateRedirect(signatureAlgorithm, []byte(signed), sig, cert.PublicKey))
+}
+
+func TestResponse_RedirectURL(t *testing.T) {
+	signer, _ := testSigner(t)
+	response := &Response{
+		InResponseTo: "_request",
+		Issuer:       "https://idp.example.com/saml/v2/metadata",
+		Destination:  "https://sp.example.com/slo",
+		Status:       provider.StatusCodeSuccess,
+	}
+	redirect, err := response.RedirectURL("relay", signer, time.Now())
+	require.NoError(t, err)
+
+	parsed, err := url.Parse(redirect)
+	require.NoError(t, err)
+	logoutResponse, err := DecodeResponse(xml.EncodingDeflate, parsed.Query().Get("SAMLResponse"))
+	require.NoError(t, err)
+	assert.Equal(t, "_request", logoutResponse.InResponseTo)
+	assert.Equal(t, provider.StatusCodeSuccess, logoutResponse.Status.StatusCode.Value)
+	assert.Equal(t, "relay", parsed.Query().Get("RelayState"))
+}
+
+func TestSendSOAP(t *testing.T) {
+	signer, _ := testSigner(t)
+	signedRequest, err := (&Request{ID: "_request", Issuer: "idp", NameID: "user", SessionIndex: "_session"}).SignedXML(signer, time.Now())
+	require.NoError(t, err)
+
+	soapResponse := func(status string) string {
+		return `<soap11:Envelope xmlns:soap11="http://schemas.xmlsoap.org/soap/envelope/"><soap11:Body>` +
+			`<samlp:LogoutResponse xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" ID="_response" InResponseTo="_request" Version="2.0">` +
+			`<samlp:Status><samlp:StatusCode Value="` + status + `"/></samlp:Status>` +
+			`</samlp:LogoutResponse></soap11:Body></soap11:Envelope>`
+	}
+	tests := []struct {
+		name       string
+		statusCode int
+		body       string
+		wantErr    func(error) bool
+	}{
+		{
+			name:       "success",
+			statusCode: http.StatusOK,
+			body:       soapResponse(provider.StatusCodeSuccess),
+		},
+		{
+			name:       "http error",
+			statusCode: http.StatusInternalServerError,
+			wantErr:    zerrors.IsUnavailable,
+		},
+		{
+			name:       "invalid response",
+			statusCode: http.StatusOK,
+			body:       "<html></html>",
+			wantErr:    zerrors.IsInternal,
+		},
+		{
+			name:       "logout failed",
+			statusCode: http.StatusOK,
+			body:       soapResponse(provider.StatusCodeResponder),
+			wantErr:    zerrors.IsInternal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				assert.Contains(t, string(body), "<soap11:Body><LogoutRequest")
+				assert.NotContains(t, string(body), "<?xml")
+				w.WriteHeader(tt.statusCode)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			err := SendSOAP(context.Background(), server.Client(), server.URL, signedRequest)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, tt.wantErr(err), err)
+		})
+	}
+}
+
+func TestVerifyRedirect(t *testing.T) {
+	signer, cert := testSigner(t)
+	_, otherCert := testSigner(t)
+	response := &Response{
+		InResponseTo: "_request",
+		Issuer:       "https://sp.example.com/metadata",
+		Destination:  "https://idp.example.com/saml/v2/SLO",
+		Status:       provider.StatusCodeSuccess,
+	}
+	redirect, err := response.RedirectURL("relay state", signer, time.Now())
+	require.NoError(t, err)
+	parsed, err := url.Parse(redirect)
+	require.NoError(t, err)
+	rawQuery := parsed.RawQuery
+
+	tests := []struct {
+		name      string
+		rawQuery  string
+		parameter string
+		certs     []*x509.Certificate
+		wantErr   bool
+	}{
+		{
+			name:      "valid",
+			rawQuery:  rawQuery,
+			parameter: "SAMLResponse",
+			certs:     []*x509.Certificate{otherCert, cert},
+		},
+		{
+			name:      "other certificate",
+			rawQuery:  rawQuery,
+			parameter: "SAMLResponse",
+			certs:     []*x509.Certificate{otherCert},
+			wantErr:   true,
+		},
+		{
+			name:      "no certificate",
+			rawQuery:  rawQuery,
+			parameter: "SAMLResponse",
+			wantErr:   true,
+		},
+		{
+			name:      "relay state changed",
+			rawQuery:  strings.Replace(rawQuery, "RelayState=relay", "RelayState=other", 1),
+			parameter: "SAMLResponse",
+			certs:     []*x509.Certificate{cert},
+			wantErr:   true,
+		},
+		{
+			name:      "unsigned",
+			rawQuery:  rawQuery[:strings.Index(rawQuery, "&Signature=")],
+			parameter: "SAMLResponse",
+			certs:     []*x509.Certificate{cert},
+			wantErr:   true,
+		},
+		{
+			name:      "wrong parameter",
+			rawQuery:  rawQuery,
+			parameter: "SAMLRequest",
+			certs:     []*x509.Certificate{cert},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifyRedirect(tt.rawQuery, tt.parameter, tt.certs)
+			if tt.wantErr {
+				assert.True(t, zerrors.IsPermissionDenied(err), "want permission denied, got %v", err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestVerifyPost(t *testing.T) {
+	signer, cert := testSigner(t)
+	_, otherCert := testSigner(t)
+	request := &Request{
+		ID:           "_request",
+		Issuer:       "https://sp.example.com/metadata",
+		Destination:  "https://idp.example.com/saml/v2/SLO",
+		NameID:       "user@example.com",
+		SessionIndex: "_session",
+	}
+	signed, err := request.SignedXML(signer, time.Now())
+	require.NoError(t, err)
+	unsigned, err := xml.Marshal(request.logoutRequest(time.Now()))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		data    []byte
+		certs   []*x509.Certificate
+		wantErr bool
+	}{
+		{
+			name:  "valid",
+			data:  signed,
+			certs: []*x509.Certificate{cert},
+		},
+		{
+			name:    "other certificate",
+			data:    signed,
+			certs:   []*x509.Certificate{otherCert},
+			wantErr: true,
+		},
+		{
+			name:    "no certificate",
+			data:    signed,
+			wantErr: true,
+		},
+		{
+			name:    "session index changed",
+			data:    []byte(strings.Replace(string(signed), "_session", "_other", 1)),
+			certs:   []*x509.Certificate{cert},
+			wantErr: true,
+		},
+		{
+			name:    "unsigned",
+			data:    []byte(unsigned),
+			certs:   []*x509.Certificate{cert},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifyPost(tt.data, tt.certs)
+			if tt.wantErr {
+				assert.True(t, zerrors.IsPermissionDenied(err), "want permission denied, got %v", err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
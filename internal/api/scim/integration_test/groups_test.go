@@ -0,0 +1,127 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/scim/resources"
+	"github.com/zitadel/zitadel/internal/integration"
+	"github.com/zitadel/zitadel/internal/integration/scim"
+)
+
+func TestGroup_errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		orgID       string
+		errorStatus int
+	}{
+		{
+			name:        "not authenticated",
+			ctx:         context.Background(),
+			errorStatus: http.StatusUnauthorized,
+		},
+		{
+			name:        "no permissions",
+			ctx:         Instance.WithAuthorization(CTX, integration.UserTypeNoPermission),
+			errorStatus: http.StatusNotFound,
+		},
+		{
+			name:        "unknown group id",
+			errorStatus: http.StatusNotFound,
+		},
+		{
+			name:        "another org",
+			orgID:       SecondaryOrganization.OrganizationId,
+			errorStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = CTX
+			}
+
+			orgID := tt.orgID
+			if orgID == "" {
+				orgID = Instance.DefaultOrg.Id
+			}
+
+			_, err := Instance.Client.SCIM.Groups.Get(ctx, orgID, "1")
+			scim.RequireScimError(t, tt.errorStatus, err)
+
+			err = Instance.Client.SCIM.Groups.Delete(ctx, orgID, "1")
+			scim.RequireScimError(t, tt.errorStatus, err)
+		})
+	}
+}
+
+func TestGroup_lifecycle(t *testing.T) {
+	userID1 := Instance.CreateHumanUser(CTX).GetUserId()
+	userID2 := Instance.CreateHumanUser(CTX).GetUserId()
+	userID3 := Instance.CreateHumanUser(CTX).GetUserId()
+	displayName := integration.GroupName()
+
+	// create the group with two members
+	createdGroup, err := Instance.Client.SCIM.Groups.Create(CTX, Instance.DefaultOrg.Id, []byte(`{
+		"schemas": ["urn:ietf:params:scim:schemas:core:2.0:Group"],
+		"displayName": "`+displayName+`",
+		"members": [{ "value": "`+userID1+`" }, { "value": "`+userID2+`" }]
+	}`))
+	require.NoError(t, err)
+	require.NotEmpty(t, createdGroup.ID)
+	assert.Equal(t, displayName, createdGroup.DisplayName)
+
+	// add the third member and remove the first one
+	err = Instance.Client.SCIM.Groups.Update(CTX, Instance.DefaultOrg.Id, createdGroup.ID, []byte(`{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [
+			{ "op": "add", "path": "members", "value": [{ "value": "`+userID3+`" }] },
+			{ "op": "remove", "path": "members[value eq \"`+userID1+`\"]" }
+		]
+	}`))
+	require.NoError(t, err)
+
+	retryDuration, tick := integration.WaitForAndTickWithMaxDuration(CTX, time.Minute)
+	require.EventuallyWithT(t, func(ttt *assert.CollectT) {
+		fetchedGroup, err := Instance.Client.SCIM.Groups.Get(CTX, Instance.DefaultOrg.Id, createdGroup.ID)
+		require.NoError(ttt, err)
+		assert.Equal(ttt, displayName, fetchedGroup.DisplayName)
+		assert.ElementsMatch(ttt, []string{userID2, userID3}, groupMemberIDs(fetchedGroup))
+	}, retryDuration, tick)
+
+	// the group is found by filtering for one of its members
+	require.EventuallyWithT(t, func(ttt *assert.CollectT) {
+		listResp, err := Instance.Client.SCIM.Groups.List(CTX, Instance.DefaultOrg.Id, &scim.ListRequest{
+			Filter: gu.Ptr(`members[value eq "` + userID3 + `"]`),
+		})
+		require.NoError(ttt, err)
+		require.Len(ttt, listResp.Resources, 1)
+		assert.Equal(ttt, createdGroup.ID, listResp.Resources[0].ID)
+	}, retryDuration, tick)
+
+	err = Instance.Client.SCIM.Groups.Delete(CTX, Instance.DefaultOrg.Id, createdGroup.ID)
+	require.NoError(t, err)
+
+	require.EventuallyWithT(t, func(ttt *assert.CollectT) {
+		_, err := Instance.Client.SCIM.Groups.Get(CTX, Instance.DefaultOrg.Id, createdGroup.ID)
+		scim.RequireScimError(ttt, http.StatusNotFound, err)
+	}, retryDuration, tick)
+}
+
+func groupMemberIDs(group *resources.ScimGroup) []string {
+	ids := make([]string, len(group.Members))
+	for i, member := range group.Members {
+		ids[i] = member.Value
+	}
+	return ids
+}
@@ -0,0 +1,281 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/scim/resources/filter"
+	"github.com/zitadel/zitadel/internal/api/scim/resources/patch"
+	scim_schemas "github.com/zitadel/zitadel/internal/api/scim/schemas"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type GroupsHandler struct {
+	command         *command.Commands
+	query           *query.Queries
+	filterEvaluator *filter.Evaluator
+	schema          *scim_schemas.ResourceSchema
+}
+
+type ScimGroup struct {
+	*scim_schemas.Resource `scim:"ignoreInSchema"`
+	ID                     string             `json:"id" scim:"ignoreInSchema"`
+	DisplayName            string             `json:"displayName,omitempty" scim:"required"`
+	Members                []*ScimGroupMember `json:"members,omitempty"`
+}
+
+type ScimGroupMember struct {
+	Value   string `json:"value" scim:"required"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+	Type    string `json:"type,omitempty"`
+}
+
+func NewGroupsHandler(
+	command *command.Commands,
+	query *query.Queries,
+) ResourceHandler[*ScimGroup] {
+	return &GroupsHandler{
+		command,
+		query,
+		filter.NewEvaluator(scim_schemas.IdGroup),
+		scim_schemas.BuildSchema(scim_schemas.SchemaBuilderArgs{
+			ID:           scim_schemas.IdGroup,
+			Name:         scim_schemas.GroupResourceType,
+			EndpointName: scim_schemas.GroupsResourceType,
+			Description:  "Group",
+			Resource:     new(ScimGroup),
+		}),
+	}
+}
+
+func (g *ScimGroup) GetResource() *scim_schemas.Resource {
+	return g.Resource
+}
+
+func (g *ScimGroup) GetSchemas() []scim_schemas.ScimSchemaType {
+	if g.Resource == nil {
+		return nil
+	}
+
+	return g.Resource.Schemas
+}
+
+func (h *GroupsHandler) Schema() *scim_schemas.ResourceSchema {
+	return h.schema
+}
+
+func (h *GroupsHandler) NewResource() *ScimGroup {
+	return new(ScimGroup)
+}
+
+func (h *GroupsHandler) Create(ctx context.Context, group *ScimGroup) (*ScimGroup, error) {
+	memberIDs, err := resolveGroupMemberIDs(ctx, group.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	createGroup := h.mapToCreateGroup(ctx, group)
+	details, err := h.command.CreateGroup(ctx, createGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(memberIDs) > 0 {
+		details, err = h.command.AddUsersToGroup(ctx, createGroup.AggregateID, memberIDs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	group.ID = createGroup.AggregateID
+	group.Resource = buildResource(ctx, h, details)
+	h.mapMemberRefs(ctx, group)
+	return group, nil
+}
+
+func (h *GroupsHandler) Replace(ctx context.Context, id string, group *ScimGroup) (*ScimGroup, error) {
+	existing, err := h.getGroup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	existingMemberIDs, err := h.queryMemberIDs(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	memberIDs, err := resolveGroupMemberIDs(ctx, group.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	details, err := h.applyGroupChanges(ctx, existing, group.DisplayName, existingMemberIDs, memberIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	group.ID = id
+	group.Resource = buildResource(ctx, h, details)
+	h.mapMemberRefs(ctx, group)
+	return group, nil
+}
+
+func (h *GroupsHandler) Update(ctx context.Context, id string, operations patch.OperationCollection) error {
+	existing, err := h.getGroup(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	existingMemberIDs, err := h.queryMemberIDs(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	group := h.mapToScimGroup(ctx, existing, existingMemberIDs)
+	if err = h.applyPatches(group, operations); err != nil {
+		return err
+	}
+
+	memberIDs, err := resolveGroupMemberIDs(ctx, group.Members)
+	if err != nil {
+		return err
+	}
+
+	_, err = h.applyGroupChanges(ctx, existing, group.DisplayName, existingMemberIDs, memberIDs)
+	return err
+}
+
+func (h *GroupsHandler) Delete(ctx context.Context, id string) error {
+	if _, err := h.getGroup(ctx, id); err != nil {
+		return err
+	}
+
+	_, err := h.command.DeleteGroup(ctx, id)
+	return err
+}
+
+func (h *GroupsHandler) Get(ctx context.Context, id string) (*ScimGroup, error) {
+	group, err := h.getGroup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	memberIDs, err := h.queryMemberIDs(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.mapToScimGroup(ctx, group, memberIDs), nil
+}
+
+func (h *GroupsHandler) List(ctx context.Context, request *ListRequest) (*ListResponse[*ScimGroup], error) {
+	q, err := h.buildListQuery(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Count == 0 {
+		// the total count is returned with each row,
+		// therefore it is enough to query a single group
+		countQuery := *q
+		countQuery.Limit = 1
+		groups, err := h.query.SearchGroups(ctx, &countQuery, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewListResponse(groups.Count, q.SearchRequest, make([]*ScimGroup, 0)), nil
+	}
+
+	groups, err := h.query.SearchGroups(ctx, q, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := h.queryMembersByGroupIDs(ctx, groupsToIDs(groups.Groups))
+	if err != nil {
+		return nil, err
+	}
+
+	scimGroups := make([]*ScimGroup, len(groups.Groups))
+	for i, group := range groups.Groups {
+		scimGroups[i] = h.mapToScimGroup(ctx, group, members[group.ID])
+	}
+	return NewListResponse(groups.Count, q.SearchRequest, scimGroups), nil
+}
+
+// getGroup returns the group with the provided id
+// if it exists in the organization of the scim request.
+func (h *GroupsHandler) getGroup(ctx context.Context, id string) (*query.Group, error) {
+	group, err := h.query.GetGroupByID(ctx, id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if group.ResourceOwner != authz.GetCtxData(ctx).OrgID || group.State != domain.GroupStateActive {
+		return nil, zerrors.ThrowNotFound(nil, "SCIM-GRP1", "Errors.Group.NotFound")
+	}
+	return group, nil
+}
+
+// applyGroupChanges updates the display name and reconciles the members of the group
+// to match the desired member ids.
+func (h *GroupsHandler) applyGroupChanges(ctx context.Context, existing *query.Group, displayName string, existingMemberIDs, memberIDs []string) (details *domain.ObjectDetails, err error) {
+	details, err = h.command.UpdateGroup(ctx, h.mapToUpdateGroup(existing, displayName))
+	if err != nil {
+		return nil, err
+	}
+
+	toAdd, toRemove := diffGroupMemberIDs(existingMemberIDs, memberIDs)
+	if len(toAdd) > 0 {
+		details, err = h.command.AddUsersToGroup(ctx, existing.ID, toAdd)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(toRemove) > 0 {
+		details, err = h.command.RemoveUsersFromGroup(ctx, existing.ID, toRemove)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return details, nil
+}
+
+func (h *GroupsHandler) queryMemberIDs(ctx context.Context, groupID string) ([]string, error) {
+	members, err := h.queryMembersByGroupIDs(ctx, []string{groupID})
+	if err != nil {
+		return nil, err
+	}
+	return members[groupID], nil
+}
+
+func (h *GroupsHandler) queryMembersByGroupIDs(ctx context.Context, groupIDs []string) (map[string][]string, error) {
+	if len(groupIDs) == 0 {
+		return nil, nil
+	}
+
+	groupIDsQuery, err := query.NewGroupUsersGroupIDsSearchQuery(groupIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	groupUsers, err := h.query.SearchGroupUsers(ctx, &query.GroupUsersSearchQuery{
+		Queries: []query.SearchQuery{groupIDsQuery},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	membersByGroupID := make(map[string][]string, len(groupIDs))
+	for _, groupUser := range groupUsers.GroupUsers {
+		membersByGroupID[groupUser.GroupID] = append(membersByGroupID[groupUser.GroupID], groupUser.UserID)
+	}
+	return membersByGroupID, nil
+}
@@ -0,0 +1,130 @@
+package resources
+
+import (
+	"context"
+	"slices"
+	"strconv"
+
+	"github.com/muhlemmer/gu"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/scim/metadata"
+	"github.com/zitadel/zitadel/internal/api/scim/schemas"
+	"github.com/zitadel/zitadel/internal/api/scim/serrors"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func (h *GroupsHandler) mapToCreateGroup(ctx context.Context, group *ScimGroup) *command.CreateGroup {
+	return &command.CreateGroup{
+		ObjectRoot: models.ObjectRoot{
+			ResourceOwner: authz.GetCtxData(ctx).OrgID,
+		},
+		Name: group.DisplayName,
+	}
+}
+
+func (h *GroupsHandler) mapToUpdateGroup(group *query.Group, displayName string) *command.UpdateGroup {
+	return &command.UpdateGroup{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID:   group.ID,
+			ResourceOwner: group.ResourceOwner,
+		},
+		Name: &displayName,
+	}
+}
+
+func (h *GroupsHandler) mapToScimGroup(ctx context.Context, group *query.Group, memberIDs []string) *ScimGroup {
+	scimGroup := &ScimGroup{
+		Resource:    h.buildResourceForQuery(ctx, group),
+		ID:          group.ID,
+		DisplayName: group.Name,
+	}
+
+	if len(memberIDs) > 0 {
+		scimGroup.Members = make([]*ScimGroupMember, len(memberIDs))
+		for i, memberID := range memberIDs {
+			scimGroup.Members[i] = &ScimGroupMember{
+				Value: memberID,
+			}
+		}
+	}
+
+	h.mapMemberRefs(ctx, scimGroup)
+	return scimGroup
+}
+
+// mapMemberRefs sets the reference and the type of each member of the group.
+// zitadel groups can only contain users.
+func (h *GroupsHandler) mapMemberRefs(ctx context.Context, group *ScimGroup) {
+	for _, member := range group.Members {
+		member.Ref = schemas.BuildLocationForResource(ctx, schemas.UsersResourceType, member.Value)
+		member.Type = string(schemas.UserResourceType)
+	}
+}
+
+func (h *GroupsHandler) buildResourceForQuery(ctx context.Context, group *query.Group) *schemas.Resource {
+	return &schemas.Resource{
+		ID:      group.ID,
+		Schemas: []schemas.ScimSchemaType{schemas.IdGroup},
+		Meta: &schemas.ResourceMeta{
+			ResourceType: schemas.GroupResourceType,
+			Created:      gu.Ptr(group.CreationDate.UTC()),
+			LastModified: gu.Ptr(group.ChangeDate.UTC()),
+			Version:      strconv.FormatUint(group.Sequence, 10),
+			Location:     schemas.BuildLocationForResource(ctx, h.schema.PluralName, group.ID),
+		},
+	}
+}
+
+// resolveGroupMemberIDs returns the distinct user ids of the members.
+// Members referencing a bulkId are resolved to the id of the created user.
+func resolveGroupMemberIDs(ctx context.Context, members []*ScimGroupMember) ([]string, error) {
+	memberIDs := make([]string, 0, len(members))
+	for _, member := range members {
+		if member == nil || member.Value == "" {
+			return nil, serrors.ThrowInvalidValue(zerrors.ThrowInvalidArgument(nil, "SCIM-GRPm1", "Group members require a value"))
+		}
+
+		if member.Type != "" && member.Type != string(schemas.UserResourceType) {
+			return nil, serrors.ThrowInvalidValue(zerrors.ThrowInvalidArgumentf(nil, "SCIM-GRPm2", "Group member type %s is not supported", member.Type))
+		}
+
+		memberID, err := metadata.ResolveScimBulkIDIfNeeded(ctx, member.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		if !slices.Contains(memberIDs, memberID) {
+			memberIDs = append(memberIDs, memberID)
+		}
+	}
+	return memberIDs, nil
+}
+
+// diffGroupMemberIDs returns the member ids which have to be added and removed
+// to transform the existing members into the desired members.
+func diffGroupMemberIDs(existingMemberIDs, memberIDs []string) (toAdd, toRemove []string) {
+	for _, memberID := range memberIDs {
+		if !slices.Contains(existingMemberIDs, memberID) {
+			toAdd = append(toAdd, memberID)
+		}
+	}
+
+	for _, existingMemberID := range existingMemberIDs {
+		if !slices.Contains(memberIDs, existingMemberID) {
+			toRemove = append(toRemove, existingMemberID)
+		}
+	}
+	return toAdd, toRemove
+}
+
+func groupsToIDs(groups []*query.Group) []string {
+	ids := make([]string, len(groups))
+	for i, group := range groups {
+		ids[i] = group.ID
+	}
+	return ids
+}
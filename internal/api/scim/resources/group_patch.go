@@ -0,0 +1,90 @@
+package resources
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/scim/resources/filter"
+	"github.com/zitadel/zitadel/internal/api/scim/resources/patch"
+	"github.com/zitadel/zitadel/internal/api/scim/serrors"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const groupMembersAttribute = "members"
+
+type groupPatcher struct {
+	handler *GroupsHandler
+}
+
+// applyPatches applies the patch operations to the group.
+// Changes are detected by comparing the patched group with the persisted state,
+// therefore the patcher does not need to track modified attributes.
+func (h *GroupsHandler) applyPatches(group *ScimGroup, operations patch.OperationCollection) error {
+	patcher := &groupPatcher{
+		handler: h,
+	}
+
+	for _, op := range operations {
+		// some scim clients (e.g. Entra ID) remove members
+		// by providing the members to remove as value instead of a filtered path.
+		// The generic remove patch would remove all members in this case.
+		if isRemoveMembersByValue(op) {
+			if err := removeGroupMembersByValue(group, op.Value); err != nil {
+				return err
+			}
+			continue
+		}
+
+		operation := patch.OperationCollection{op}
+		if err := operation.Apply(patcher, group); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *groupPatcher) FilterEvaluator() *filter.Evaluator {
+	return p.handler.filterEvaluator
+}
+
+func (p *groupPatcher) Added([]string) error {
+	return nil
+}
+
+func (p *groupPatcher) Replaced([]string) error {
+	return nil
+}
+
+func (p *groupPatcher) Removed([]string) error {
+	return nil
+}
+
+func isRemoveMembersByValue(op *patch.Operation) bool {
+	if !strings.EqualFold(string(op.Operation), string(patch.OperationTypeRemove)) || len(op.Value) == 0 {
+		return false
+	}
+
+	if op.Path == nil || op.Path.AttrPath == nil || op.Path.AttrPath.SubAttr != nil {
+		return false
+	}
+
+	return strings.EqualFold(op.Path.AttrPath.AttrName, groupMembersAttribute)
+}
+
+func removeGroupMembersByValue(group *ScimGroup, value json.RawMessage) error {
+	var membersToRemove []*ScimGroupMember
+	if err := json.Unmarshal(value, &membersToRemove); err != nil {
+		logging.WithError(err).Info("SCIM: Invalid group members patch value")
+		return serrors.ThrowInvalidValue(zerrors.ThrowInvalidArgument(err, "SCIM-GRPp1", "Invalid patch value"))
+	}
+
+	group.Members = slices.DeleteFunc(group.Members, func(member *ScimGroupMember) bool {
+		return slices.ContainsFunc(membersToRemove, func(memberToRemove *ScimGroupMember) bool {
+			return memberToRemove != nil && memberToRemove.Value == member.Value
+		})
+	})
+	return nil
+}
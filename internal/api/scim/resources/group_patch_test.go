@@ -0,0 +1,173 @@
+package resources
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/scim/resources/filter"
+	"github.com/zitadel/zitadel/internal/api/scim/resources/patch"
+	"github.com/zitadel/zitadel/internal/api/scim/schemas"
+	"github.com/zitadel/zitadel/internal/test"
+)
+
+func TestGroupsHandler_applyPatches(t *testing.T) {
+	tests := []struct {
+		name    string
+		ops     patch.OperationCollection
+		want    *ScimGroup
+		wantErr bool
+	}{
+		{
+			name: "replace display name without path",
+			ops: patch.OperationCollection{
+				{
+					Operation: patch.OperationTypeReplace,
+					Value:     json.RawMessage(`{ "displayName": "Engineering" }`),
+				},
+			},
+			want: &ScimGroup{
+				DisplayName: "Engineering",
+				Members:     []*ScimGroupMember{{Value: "user1"}, {Value: "user2"}},
+			},
+		},
+		{
+			name: "add members",
+			ops: patch.OperationCollection{
+				{
+					Operation: patch.OperationTypeAdd,
+					Path:      test.Must(filter.ParsePath("members")),
+					Value:     json.RawMessage(`[{ "value": "user3" }, { "value": "user1" }]`),
+				},
+			},
+			want: &ScimGroup{
+				DisplayName: "Developers",
+				Members:     []*ScimGroupMember{{Value: "user1"}, {Value: "user2"}, {Value: "user3"}},
+			},
+		},
+		{
+			name: "remove member by filter",
+			ops: patch.OperationCollection{
+				{
+					Operation: patch.OperationTypeRemove,
+					Path:      test.Must(filter.ParsePath(`members[value eq "user1"]`)),
+				},
+			},
+			want: &ScimGroup{
+				DisplayName: "Developers",
+				Members:     []*ScimGroupMember{{Value: "user2"}},
+			},
+		},
+		{
+			name: "remove member by value",
+			ops: patch.OperationCollection{
+				{
+					Operation: patch.OperationTypeRemove,
+					Path:      test.Must(filter.ParsePath("members")),
+					Value:     json.RawMessage(`[{ "value": "user2" }]`),
+				},
+			},
+			want: &ScimGroup{
+				DisplayName: "Developers",
+				Members:     []*ScimGroupMember{{Value: "user1"}},
+			},
+		},
+		{
+			name: "remove all members",
+			ops: patch.OperationCollection{
+				{
+					Operation: patch.OperationTypeRemove,
+					Path:      test.Must(filter.ParsePath("members")),
+				},
+			},
+			want: &ScimGroup{
+				DisplayName: "Developers",
+			},
+		},
+		{
+			name: "replace members",
+			ops: patch.OperationCollection{
+				{
+					Operation: patch.OperationTypeReplace,
+					Path:      test.Must(filter.ParsePath("members")),
+					Value:     json.RawMessage(`[{ "value": "user3" }]`),
+				},
+			},
+			want: &ScimGroup{
+				DisplayName: "Developers",
+				Members:     []*ScimGroupMember{{Value: "user3"}},
+			},
+		},
+		{
+			name: "remove member by invalid value",
+			ops: patch.OperationCollection{
+				{
+					Operation: patch.OperationTypeRemove,
+					Path:      test.Must(filter.ParsePath("members")),
+					Value:     json.RawMessage(`"user2"`),
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &GroupsHandler{
+				filterEvaluator: filter.NewEvaluator(schemas.IdGroup),
+			}
+			group := &ScimGroup{
+				DisplayName: "Developers",
+				Members:     []*ScimGroupMember{{Value: "user1"}, {Value: "user2"}},
+			}
+
+			err := h.applyPatches(group, tt.ops)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, group)
+		})
+	}
+}
+
+func Test_diffGroupMemberIDs(t *testing.T) {
+	tests := []struct {
+		name              string
+		existingMemberIDs []string
+		memberIDs         []string
+		wantToAdd         []string
+		wantToRemove      []string
+	}{
+		{
+			name: "no members",
+		},
+		{
+			name:              "unchanged",
+			existingMemberIDs: []string{"user1", "user2"},
+			memberIDs:         []string{"user2", "user1"},
+		},
+		{
+			name:              "add and remove",
+			existingMemberIDs: []string{"user1", "user2"},
+			memberIDs:         []string{"user2", "user3"},
+			wantToAdd:         []string{"user3"},
+			wantToRemove:      []string{"user1"},
+		},
+		{
+			name:              "remove all",
+			existingMemberIDs: []string{"user1", "user2"},
+			wantToRemove:      []string{"user1", "user2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			toAdd, toRemove := diffGroupMemberIDs(tt.existingMemberIDs, tt.memberIDs)
+			assert.Equal(t, tt.wantToAdd, toAdd)
+			assert.Equal(t, tt.wantToRemove, toRemove)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/scim/resources/filter"
+	"github.com/zitadel/zitadel/internal/api/scim/serrors"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// groupFieldPathColumnMapping maps lowercase json field names of the scim group to the matching column in the projection
+// only a limited set of fields is supported
+// to ensure database performance.
+var groupFieldPathColumnMapping = filter.FieldPathMapping{
+	"meta.created": {
+		Column:    query.GroupColumnCreationDate,
+		FieldType: filter.FieldTypeTimestamp,
+	},
+	"meta.lastmodified": {
+		Column:    query.GroupColumnChangeDate,
+		FieldType: filter.FieldTypeTimestamp,
+	},
+	"id": {
+		Column:    query.GroupColumnID,
+		FieldType: filter.FieldTypeString,
+	},
+	"displayname": {
+		Column:    query.GroupColumnName,
+		FieldType: filter.FieldTypeString,
+	},
+	"members": {
+		FieldType:        filter.FieldTypeCustom,
+		BuildMappedQuery: buildGroupMemberQuery,
+	},
+	"members.value": {
+		FieldType:        filter.FieldTypeCustom,
+		BuildMappedQuery: buildGroupMemberQuery,
+	},
+}
+
+func (h *GroupsHandler) buildListQuery(ctx context.Context, request *ListRequest) (*query.GroupSearchQuery, error) {
+	searchRequest, err := request.toSearchRequest(query.GroupColumnID, groupFieldPathColumnMapping)
+	if err != nil {
+		return nil, err
+	}
+
+	q := &query.GroupSearchQuery{
+		SearchRequest: searchRequest,
+	}
+
+	// the scim service is always limited to one organization
+	// the organization is the resource owner
+	orgIDQuery, err := query.NewGroupOrganizationIdSearchQuery(authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+
+	q.Queries = append(q.Queries, orgIDQuery)
+
+	if request.Filter == nil {
+		return q, nil
+	}
+
+	filterQuery, err := request.Filter.BuildQuery(ctx, h.schema.ID, groupFieldPathColumnMapping)
+	if err != nil {
+		return nil, err
+	}
+
+	q.Queries = append(q.Queries, filterQuery)
+	return q, nil
+}
+
+func buildGroupMemberQuery(_ context.Context, compareValue *filter.CompValue, op *filter.CompareOp) (query.SearchQuery, error) {
+	if !op.Equal {
+		return nil, serrors.ThrowInvalidFilter(zerrors.ThrowInvalidArgument(nil, "SCIM-GRPf1", "invalid filter expression: members unsupported comparison operator"))
+	}
+
+	if compareValue.StringValue == nil {
+		return nil, serrors.ThrowInvalidFilter(zerrors.ThrowInvalidArgument(nil, "SCIM-GRPf2", "invalid filter expression: members unsupported comparison value"))
+	}
+
+	return query.NewGroupUserIDSearchQuery(*compareValue.StringValue)
+}
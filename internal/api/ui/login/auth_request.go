@@ -24,6 +24,16 @@ func (l *Login) getAuthRequest(r *http.Request) (*domain.AuthRequest, error) {
 	return l.authRepo.AuthRequestByID(r.Context(), authRequestID, userAgentID)
 }
 
+// rateLimitUser returns the user of the auth request,
+// so the checks of the credentials of a user are limited across all auth requests.
+func (l *Login) rateLimitUser(r *http.Request) string {
+	authRequest, err := l.getAuthRequest(r)
+	if err != nil || authRequest == nil {
+		return ""
+	}
+	return authRequest.UserID
+}
+
 func (l *Login) ensureAuthRequest(r *http.Request) (*domain.AuthRequest, error) {
 	authRequest, err := l.getAuthRequest(r)
 	if authRequest != nil || err != nil {
@@ -0,0 +1,106 @@
+package login
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	tmplConsent = "consent"
+)
+
+type consentFormData struct{}
+
+type consentData struct {
+	userData
+	ApplicationName string
+	Scopes          []string
+	Roles           []string
+}
+
+func (l *Login) handleConsentCheck(w http.ResponseWriter, r *http.Request) {
+	authReq, err := l.ensureAuthRequestAndParseData(r, new(consentFormData))
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	step, ok := consentStep(authReq)
+	if !ok {
+		l.renderError(w, r, authReq, zerrors.ThrowPreconditionFailed(nil, "LOGIN-Cns7p", "Errors.User.NotAllowed"))
+		return
+	}
+	_, err = l.command.GrantUserConsent(setContext(r.Context(), authReq.UserOrgID), authReq.UserID, authReq.UserOrgID, authReq.ApplicationID, step.Scope)
+	if err != nil {
+		l.renderConsent(w, r, authReq, step, err)
+		return
+	}
+	l.renderNextStep(w, r, authReq)
+}
+
+// renderConsent lists the scopes requested by a third-party client and the roles
+// the user has on its project, which the user needs to allow before the login can continue.
+func (l *Login) renderConsent(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, step *domain.ConsentStep, err error) {
+	translator := l.getTranslator(r.Context(), authReq)
+	data := &consentData{
+		userData: l.getUserData(r, authReq, translator, "Consent.Title", "Consent.Description", err),
+	}
+	app, appErr := l.query.AppByOIDCClientID(r.Context(), authReq.ApplicationID)
+	logging.OnError(appErr).WithField("authRequestID", authReq.ID).Warn("unable to get application for consent")
+	for _, scope := range step.Scope {
+		if role, ok := strings.CutPrefix(scope, domain.ProjectRoleScope); ok {
+			data.Roles = append(data.Roles, role)
+			continue
+		}
+		data.Scopes = append(data.Scopes, scope)
+	}
+	if appErr == nil {
+		data.ApplicationName = app.Name
+		roles, rolesErr := l.userProjectRoles(r.Context(), authReq.UserID, app.ProjectID)
+		logging.OnError(rolesErr).WithField("authRequestID", authReq.ID).Warn("unable to get user roles for consent")
+		for _, role := range roles {
+			if !slices.Contains(data.Roles, role) {
+				data.Roles = append(data.Roles, role)
+			}
+		}
+	}
+	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplConsent], data, nil)
+}
+
+// userProjectRoles returns the roles granted to the user on the project,
+// which might be asserted in the tokens of the application.
+func (l *Login) userProjectRoles(ctx context.Context, userID, projectID string) ([]string, error) {
+	userIDQuery, err := query.NewUserGrantUserIDSearchQuery(userID)
+	if err != nil {
+		return nil, err
+	}
+	projectIDQuery, err := query.NewUserGrantProjectIDSearchQuery(projectID)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := l.query.UserGrants(ctx, &query.UserGrantsQueries{Queries: []query.SearchQuery{userIDQuery, projectIDQuery}}, false, nil)
+	if err != nil {
+		return nil, err
+	}
+	var roles []string
+	for _, grant := range grants.UserGrants {
+		roles = append(roles, grant.Roles...)
+	}
+	return roles, nil
+}
+
+// consentStep returns the consent step, if it's the current step of the auth request.
+func consentStep(authReq *domain.AuthRequest) (*domain.ConsentStep, bool) {
+	if authReq == nil || len(authReq.PossibleSteps) == 0 {
+		return nil, false
+	}
+	step, ok := authReq.PossibleSteps[0].(*domain.ConsentStep)
+	return step, ok
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/zitadel/zitadel/internal/domain/federatedlogout"
 	"github.com/zitadel/zitadel/internal/form"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/ratelimit"
 	"github.com/zitadel/zitadel/internal/static"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -196,6 +197,7 @@ func CreateLogin(
 	oidcAuthCallbackURL, samlAuthCallbackURL func(context.Context, string) string,
 	externalSecure bool,
 	userAgentCookie, issuerInterceptor, oidcInstanceHandler, samlInstanceHandler, assetCache, accessHandler mux.MiddlewareFunc,
+	rateLimiter *ratelimit.Limiter,
 	userCodeAlg, idpConfigAlg crypto.EncryptionAlgorithm,
 	csrfCookieKey []byte,
 	cacheConnectors connector.Connectors,
@@ -232,6 +234,7 @@ func CreateLogin(
 		userAgentCookie,
 		issuerInterceptor,
 		accessHandler,
+		middleware.RateLimitHandler(rateLimiter, ratelimit.EndpointLogin, login.rateLimitUser, rateLimitedEndpoints...),
 	)
 	login.renderer = CreateRenderer(HandlerPrefix, staticStorage, config.LanguageCookieName)
 	login.parser = form.NewParser()
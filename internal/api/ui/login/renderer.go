@@ -81,6 +81,7 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 		tmplChangeUsername:               "change_username.html",
 		tmplChangeUsernameDone:           "change_username_done.html",
 		tmplLinkUsersDone:                "link_users_done.html",
+		tmplConsent:                      "consent.html",
 		tmplExternalNotFoundOption:       "external_not_found_option.html",
 		tmplLoginSuccess:                 "login_success.html",
 		tmplLDAPLogin:                    "ldap_login.html",
@@ -163,6 +164,9 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 		"mfaVerifyUrl": func() string {
 			return path.Join(r.pathPrefix, EndpointMFAVerify)
 		},
+		"consentUrl": func() string {
+			return path.Join(r.pathPrefix, EndpointConsent)
+		},
 		"mfaPromptUrl": func() string {
 			return path.Join(r.pathPrefix, EndpointMFAPrompt)
 		},
@@ -334,6 +338,8 @@ func (l *Login) chooseNextStep(w http.ResponseWriter, r *http.Request, authReq *
 		l.renderInternalError(w, r, authReq, zerrors.ThrowPreconditionFailed(nil, "APP-m92d", "Errors.User.ProjectRequired"))
 	case *domain.VerifyInviteStep:
 		l.renderInviteUser(w, r, authReq, "", "", "", "", nil)
+	case *domain.ConsentStep:
+		l.renderConsent(w, r, authReq, step, err)
 	default:
 		l.renderInternalError(w, r, authReq, zerrors.ThrowInternal(nil, "APP-ds3QF", "step no possible"))
 	}
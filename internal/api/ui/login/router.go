@@ -50,6 +50,7 @@ const (
 	EndpointLogoutDone                    = "/logout/done"
 	EndpointLoginSuccess                  = "/login/success"
 	EndpointExternalNotFoundOption        = "/externaluser/option"
+	EndpointConsent                       = "/consent"
 
 	EndpointResources        = "/resources"
 	EndpointDynamicResources = "/resources/dynamic"
@@ -61,6 +62,17 @@ const (
 )
 
 var (
+	// rateLimitedEndpoints are the endpoints checking the login name and the credentials of the user
+	rateLimitedEndpoints = []string{
+		EndpointLoginName,
+		EndpointLDAPCallback,
+		EndpointPassword,
+		EndpointPasswordlessLogin,
+		EndpointMFAVerify,
+		EndpointMFAOTPVerify,
+		EndpointU2FVerification,
+	}
+
 	IgnoreInstanceEndpoints = []string{
 		EndpointResources + "/fonts",
 		EndpointResources + "/images",
@@ -115,6 +127,7 @@ func CreateRouter(login *Login, interceptors ...mux.MiddlewareFunc) *mux.Router
 	router.HandleFunc(EndpointRegisterOption, login.handleRegisterOption).Methods(http.MethodGet)
 	router.HandleFunc(EndpointRegisterOption, login.handleRegisterOptionCheck).Methods(http.MethodPost)
 	router.HandleFunc(EndpointExternalNotFoundOption, login.handleExternalNotFoundOptionCheck).Methods(http.MethodPost)
+	router.HandleFunc(EndpointConsent, login.handleConsentCheck).Methods(http.MethodPost)
 	router.HandleFunc(EndpointRegister, login.handleRegister).Methods(http.MethodGet)
 	router.HandleFunc(EndpointRegister, login.handleRegisterCheck).Methods(http.MethodPost)
 	router.HandleFunc(EndpointExternalRegister, login.handleExternalRegister).Methods(http.MethodGet)
@@ -58,6 +58,7 @@ type AuthRequestRepo struct {
 	UserGrantProvider         userGrantProvider
 	ProjectProvider           projectProvider
 	ApplicationProvider       applicationProvider
+	UserConsentProvider       userConsentProvider
 	CustomTextProvider        customTextProvider
 	PasswordReset             passwordReset
 	PasswordChecker           passwordChecker
@@ -132,6 +133,10 @@ type applicationProvider interface {
 	AppByOIDCClientID(context.Context, string) (*query.App, error)
 }
 
+type userConsentProvider interface {
+	UserConsentByClientID(ctx context.Context, userID, clientID string) (*query.UserConsent, error)
+}
+
 type customTextProvider interface {
 	CustomTextListByTemplate(ctx context.Context, aggregateID string, text string, withOwnerRemoved bool) (texts *query.CustomTexts, err error)
 }
@@ -808,7 +813,8 @@ func (repo *AuthRequestRepo) checkLoginName(ctx context.Context, request *domain
 		return err
 	}
 	// if there's an active User (Human), let's use it
-	if user != nil && !user.HumanView.IsZero() && domain.UserState(user.State).IsEnabled() {
+	// (users with an expired lock are unlocked on the next password check)
+	if user != nil && !user.HumanView.IsZero() && (domain.UserState(user.State).IsEnabled() || domain.IsLockExpired(user.LockedUntil, time.Now())) {
 		request.SetUserInfo(user.ID, loginNameInput, preferredLoginName, "", "", user.ResourceOwner)
 		return nil
 	}
@@ -1156,7 +1162,6 @@ func (repo *AuthRequestRepo) nextSteps(ctx context.Context, request *domain.Auth
 	if len(request.LinkingUsers) != 0 {
 		return append(steps, &domain.LinkUsersStep{}), nil
 	}
-	//PLANNED: consent step
 
 	missing, err := projectRequired(ctx, request, repo.ProjectProvider)
 	if err != nil {
@@ -1174,6 +1179,14 @@ func (repo *AuthRequestRepo) nextSteps(ctx context.Context, request *domain.Auth
 		return append(steps, &domain.GrantRequiredStep{}), nil
 	}
 
+	scope, err := consentRequired(ctx, request, user.ID, repo.UserConsentProvider)
+	if err != nil {
+		return nil, err
+	}
+	if len(scope) > 0 {
+		return append(steps, &domain.ConsentStep{Scope: scope}), nil
+	}
+
 	ok, err = repo.hasSucceededPage(ctx, request, repo.ApplicationProvider)
 	if err != nil {
 		return nil, err
@@ -1741,10 +1754,11 @@ func activeUserByID(ctx context.Context, userViewProvider userViewProvider, user
 	if user.HumanView == nil {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "EVENT-Lm69x", "Errors.User.NotHuman")
 	}
-	if user.State == user_model.UserStateLocked || user.State == user_model.UserStateSuspend {
+	lockExpired := user.State == user_model.UserStateLocked && domain.IsLockExpired(user.LockedUntil, time.Now())
+	if (user.State == user_model.UserStateLocked && !lockExpired) || user.State == user_model.UserStateSuspend {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "EVENT-FJ262", "Errors.User.Locked")
 	}
-	if !(user.State == user_model.UserStateActive || user.State == user_model.UserStateInitial) {
+	if !(user.State == user_model.UserStateActive || user.State == user_model.UserStateInitial || lockExpired) {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "EVENT-FJ262", "Errors.User.NotActive")
 	}
 	org, err := queries.OrgByID(ctx, user.ResourceOwner)
@@ -1846,6 +1860,26 @@ func userGrantRequired(ctx context.Context, request *domain.AuthRequest, user *u
 	return len(grants) == 0, nil
 }
 
+// consentRequired returns the requested scopes, if the client requires the consent of the user
+// and the user did not yet consent to all of them.
+func consentRequired(ctx context.Context, request *domain.AuthRequest, userID string, consentProvider userConsentProvider) (_ []string, err error) {
+	if !request.ConsentRequired {
+		return nil, nil
+	}
+	oidcRequest, ok := request.Request.(*domain.AuthRequestOIDC)
+	if !ok {
+		return nil, nil
+	}
+	consent, err := consentProvider.UserConsentByClientID(ctx, userID, request.ApplicationID)
+	if err != nil {
+		return nil, err
+	}
+	if consent != nil && consent.Covers(oidcRequest.Scopes) {
+		return nil, nil
+	}
+	return oidcRequest.Scopes, nil
+}
+
 func projectRequired(ctx context.Context, request *domain.AuthRequest, projectProvider projectProvider) (missingGrant bool, err error) {
 	var project *query.Project
 	switch request.Request.Type() {
@@ -305,6 +305,14 @@ func (m *mockApp) AppByOIDCClientID(ctx context.Context, id string) (*query.App,
 	return nil, zerrors.ThrowNotFound(nil, "ERROR", "error")
 }
 
+type mockUserConsent struct {
+	consent *query.UserConsent
+}
+
+func (m *mockUserConsent) UserConsentByClientID(ctx context.Context, userID, clientID string) (*query.UserConsent, error) {
+	return m.consent, nil
+}
+
 type mockIDPUserLinks struct {
 	idps []*query.IDPUserLink
 }
@@ -354,6 +362,7 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 		userGrantProvider         userGrantProvider
 		projectProvider           projectProvider
 		applicationProvider       applicationProvider
+		userConsentProvider       userConsentProvider
 		loginPolicyProvider       loginPolicyViewProvider
 		lockoutPolicyProvider     lockoutPolicyViewProvider
 		idpUserLinksProvider      idpUserLinksProvider
@@ -2007,6 +2016,123 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 			[]domain.NextStep{&domain.RedirectToCallbackStep{}},
 			nil,
 		},
+		{
+			"consent required and not given, consent step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider:   &mockEventUser{},
+				orgViewProvider:     &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider:   &mockUserGrants{},
+				projectProvider:     &mockProject{},
+				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb}}},
+				userConsentProvider: &mockUserConsent{},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{&domain.AuthRequest{
+				UserID:          "UserID",
+				ConsentRequired: true,
+				Request:         &domain.AuthRequestOIDC{Scopes: []string{"openid", "email"}},
+				LoginPolicy: &domain.LoginPolicy{
+					AllowUsernamePassword:     true,
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, false},
+			[]domain.NextStep{&domain.ConsentStep{Scope: []string{"openid", "email"}}},
+			nil,
+		},
+		{
+			"consent required and only given to other scopes, consent step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider:   &mockEventUser{},
+				orgViewProvider:     &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider:   &mockUserGrants{},
+				projectProvider:     &mockProject{},
+				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb}}},
+				userConsentProvider: &mockUserConsent{consent: &query.UserConsent{ClientID: "clientID", Scope: []string{"openid"}}},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{&domain.AuthRequest{
+				UserID:          "UserID",
+				ConsentRequired: true,
+				Request:         &domain.AuthRequestOIDC{Scopes: []string{"openid", "email"}},
+				LoginPolicy: &domain.LoginPolicy{
+					AllowUsernamePassword:     true,
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, false},
+			[]domain.NextStep{&domain.ConsentStep{Scope: []string{"openid", "email"}}},
+			nil,
+		},
+		{
+			"consent required and given, redirect to callback step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider:   &mockEventUser{},
+				orgViewProvider:     &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider:   &mockUserGrants{},
+				projectProvider:     &mockProject{},
+				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb}}},
+				userConsentProvider: &mockUserConsent{consent: &query.UserConsent{ClientID: "clientID", Scope: []string{"openid", "email", "profile"}}},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{&domain.AuthRequest{
+				UserID:          "UserID",
+				ConsentRequired: true,
+				Request:         &domain.AuthRequestOIDC{Scopes: []string{"openid", "email"}},
+				LoginPolicy: &domain.LoginPolicy{
+					AllowUsernamePassword:     true,
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, false},
+			[]domain.NextStep{&domain.RedirectToCallbackStep{}},
+			nil,
+		},
 		{
 			"prompt none, checkLoggedIn true and authenticated, redirect to callback step",
 			fields{
@@ -2410,6 +2536,7 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 				UserGrantProvider:         tt.fields.userGrantProvider,
 				ProjectProvider:           tt.fields.projectProvider,
 				ApplicationProvider:       tt.fields.applicationProvider,
+				UserConsentProvider:       tt.fields.userConsentProvider,
 				LoginPolicyViewProvider:   tt.fields.loginPolicyProvider,
 				LockoutPolicyViewProvider: tt.fields.lockoutPolicyProvider,
 				IDPUserLinksProvider:      tt.fields.idpUserLinksProvider,
@@ -78,6 +78,7 @@ func Start(ctx context.Context, conf Config, systemDefaults sd.SystemDefaults, c
 			UserGrantProvider:         queryView,
 			ProjectProvider:           queryView,
 			ApplicationProvider:       queries,
+			UserConsentProvider:       queries,
 			CustomTextProvider:        queries,
 			PasswordReset:             command,
 			PasswordChecker:           command,
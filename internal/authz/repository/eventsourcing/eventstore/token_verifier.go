@@ -150,6 +150,10 @@ func (repo *TokenVerifierRepo) verifyAccessTokenV2(ctx context.Context, token, s
 	if activeToken.UserID != subject {
 		return "", "", "", "", "", zerrors.ThrowUnauthenticated(nil, "APP-3f4fs", "invalid token")
 	}
+	// A DPoP bound token must be used with a proof of the bound key, the proof was already verified by the authz package.
+	if activeToken.DPoPJKT != "" && activeToken.DPoPJKT != authz.DPoPJKTFromCtx(ctx) {
+		return "", "", "", "", "", zerrors.ThrowUnauthenticated(nil, "APP-Dp0pa", "Errors.Token.DPoPProofInvalid")
+	}
 	if err = repo.checkAuthentication(ctx, activeToken.AuthMethods, activeToken.UserID); err != nil {
 		return "", "", "", "", "", err
 	}
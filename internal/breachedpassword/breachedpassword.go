@@ -0,0 +1,142 @@
+package breachedpassword
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1" //nolint:gosec // sha1 is required by the k-anonymity range API
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// prefixLength is the amount of characters of the hex encoded SHA-1 hash sent to the range API.
+const prefixLength = 5
+
+type Config struct {
+	// Endpoint of a range API compatible with https://haveibeenpwned.com/API/v3#PwnedPasswords,
+	// e.g. a local mirror for air-gapped installations.
+	// The first 5 characters of the (upper case) hex encoded SHA-1 hash of the password are appended to it.
+	// If empty, no range lookups are made.
+	Endpoint string
+	// Timeout of a single range lookup.
+	Timeout time.Duration
+	// LocalFile is the path to a file of banned passwords, one per line.
+	// If empty, no local corpus is used.
+	LocalFile string
+}
+
+// Checker checks passwords against a k-anonymity range API and a local corpus of banned passwords.
+type Checker struct {
+	client   *http.Client
+	endpoint string
+	banned   map[string]struct{}
+}
+
+// NewChecker returns a Checker for the configuration or nil, if neither an endpoint nor a local file is configured.
+func (c *Config) NewChecker(client *http.Client) (*Checker, error) {
+	if c == nil || (c.Endpoint == "" && c.LocalFile == "") {
+		return nil, nil
+	}
+	checker := &Checker{
+		endpoint: c.Endpoint,
+	}
+	if c.Endpoint != "" {
+		if client == nil {
+			client = http.DefaultClient
+		}
+		checker.client = &http.Client{
+			Transport:     client.Transport,
+			CheckRedirect: client.CheckRedirect,
+			Jar:           client.Jar,
+			Timeout:       c.Timeout,
+		}
+	}
+	if c.LocalFile != "" {
+		file, err := os.Open(c.LocalFile)
+		if err != nil {
+			return nil, fmt.Errorf("breached passwords: open local file: %w", err)
+		}
+		defer file.Close()
+		checker.banned, err = readBanned(file)
+		if err != nil {
+			return nil, fmt.Errorf("breached passwords: read local file: %w", err)
+		}
+	}
+	return checker, nil
+}
+
+func readBanned(r io.Reader) (map[string]struct{}, error) {
+	banned := make(map[string]struct{})
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		password := strings.TrimRight(scanner.Text(), "\r")
+		if password == "" {
+			continue
+		}
+		banned[hash(password)] = struct{}{}
+	}
+	return banned, scanner.Err()
+}
+
+// IsBreached returns true if the password is part of the local corpus or was found by the range API.
+// Only the first 5 characters of the SHA-1 hash of the password are sent to the range API.
+func (c *Checker) IsBreached(ctx context.Context, password string) (bool, error) {
+	if c == nil {
+		return false, nil
+	}
+	hashed := hash(password)
+	if _, ok := c.banned[hashed]; ok {
+		return true, nil
+	}
+	if c.endpoint == "" {
+		return false, nil
+	}
+	return c.rangeLookup(ctx, hashed)
+}
+
+func (c *Checker) rangeLookup(ctx context.Context, hashed string) (bool, error) {
+	prefix, suffix := hashed[:prefixLength], hashed[prefixLength:]
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.endpoint+prefix, nil)
+	if err != nil {
+		return false, err
+	}
+	// padding prevents observers from guessing the prefix by the response size
+	req.Header.Set("Add-Padding", "true")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("breached passwords: range lookup returned status %d", resp.StatusCode)
+	}
+	return containsSuffix(resp.Body, suffix)
+}
+
+// containsSuffix parses a range API response with lines in the format `SUFFIX:COUNT`.
+// Padding entries have a count of 0 and are ignored.
+func containsSuffix(r io.Reader, suffix string) (bool, error) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lineSuffix, count, ok := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if !ok || !strings.EqualFold(lineSuffix, suffix) {
+			continue
+		}
+		n, err := strconv.ParseUint(count, 10, 64)
+		if err != nil {
+			return false, fmt.Errorf("breached passwords: invalid count %q", count)
+		}
+		return n > 0, nil
+	}
+	return false, scanner.Err()
+}
+
+func hash(password string) string {
+	sum := sha1.Sum([]byte(password)) //nolint:gosec // sha1 is required by the k-anonymity range API
+	return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
@@ -0,0 +1,106 @@
+package breachedpassword
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// SHA-1 of "password" is 5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8
+const passwordSuffix = "1E4C9B93F3F0682250B6CF8331B7EE68FD8"
+
+func TestConfig_NewChecker(t *testing.T) {
+	checker, err := (&Config{}).NewChecker(nil)
+	require.NoError(t, err)
+	assert.Nil(t, checker)
+
+	_, err = (&Config{LocalFile: filepath.Join(t.TempDir(), "missing")}).NewChecker(nil)
+	assert.Error(t, err)
+}
+
+func TestChecker_IsBreached(t *testing.T) {
+	var requestedPath, padding string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestedPath = r.URL.Path
+		padding = r.Header.Get("Add-Padding")
+		switch r.URL.Path {
+		case "/range/5BAA6":
+			_, _ = w.Write([]byte("0018A45C4D1DEF81644B54AB7F969B88D65:1\r\n" + passwordSuffix + ":3730471\r\n"))
+		case "/range/B1B37":
+			// padding entry of "qwerty" (B1B3773A05C0ED0176787A4F1574FF0075F7521E)
+			_, _ = w.Write([]byte("73A05C0ED0176787A4F1574FF0075F7521E:0\r\n"))
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	localFile := filepath.Join(t.TempDir(), "banned.txt")
+	require.NoError(t, os.WriteFile(localFile, []byte("company2024\r\n\nzitadel\n"), 0o600))
+
+	checker, err := (&Config{
+		Endpoint:  server.URL + "/range/",
+		LocalFile: localFile,
+	}).NewChecker(server.Client())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		password string
+		want     bool
+		wantPath string
+		wantErr  bool
+	}{
+		{
+			name:     "local corpus",
+			password: "company2024",
+			want:     true,
+		},
+		{
+			name:     "range lookup, breached",
+			password: "password",
+			want:     true,
+			wantPath: "/range/5BAA6",
+		},
+		{
+			name:     "range lookup, padding ignored",
+			password: "qwerty",
+			want:     false,
+			wantPath: "/range/B1B37",
+		},
+		{
+			name:     "range lookup, error",
+			password: "unknown",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestedPath, padding = "", ""
+			got, err := checker.IsBreached(context.Background(), tt.password)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantPath, requestedPath)
+			if tt.wantPath != "" {
+				assert.Equal(t, "true", padding)
+			}
+		})
+	}
+}
+
+func TestChecker_IsBreached_nil(t *testing.T) {
+	var checker *Checker
+	got, err := checker.IsBreached(context.Background(), "password")
+	require.NoError(t, err)
+	assert.False(t, got)
+}
@@ -19,6 +19,9 @@ const (
 	PurposeOrganization
 	PurposeIdPFormCallback
 	PurposeFederatedLogout
+	PurposeRateLimit
+	PurposeDPoPProof
+	PurposeCIBAPoll
 )
 
 // Cache stores objects with a value of type `V`.
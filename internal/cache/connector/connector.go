@@ -25,6 +25,9 @@ type CachesConfig struct {
 	Organization     *cache.Config
 	IdPFormCallbacks *cache.Config
 	FederatedLogouts *cache.Config
+	RateLimits       *cache.Config
+	DPoPProofs       *cache.Config
+	CIBAPolls        *cache.Config
 }
 
 type Connectors struct {
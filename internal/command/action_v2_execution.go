@@ -6,6 +6,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	target_domain "github.com/zitadel/zitadel/internal/execution/target"
 	"github.com/zitadel/zitadel/internal/repository/execution"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -60,10 +61,8 @@ func (c *Commands) SetExecutionRequest(ctx context.Context, cond *ExecutionAPICo
 	if err := cond.IsValid(); err != nil {
 		return nil, err
 	}
-	for _, target := range set.Targets {
-		if err = target.Validate(); err != nil {
-			return nil, err
-		}
+	if err := set.IsValid(); err != nil {
+		return nil, err
 	}
 	if err := cond.Existing(c); err != nil {
 		return nil, err
@@ -78,10 +77,8 @@ func (c *Commands) SetExecutionResponse(ctx context.Context, cond *ExecutionAPIC
 	if err := cond.IsValid(); err != nil {
 		return nil, err
 	}
-	for _, target := range set.Targets {
-		if err = target.Validate(); err != nil {
-			return nil, err
-		}
+	if err := set.IsValid(); err != nil {
+		return nil, err
 	}
 	if err := cond.Existing(c); err != nil {
 		return nil, err
@@ -116,19 +113,12 @@ func (c *Commands) SetExecutionFunction(ctx context.Context, cond ExecutionFunct
 	if err := cond.IsValid(); err != nil {
 		return nil, err
 	}
-	for _, target := range set.Targets {
-		if err = target.Validate(); err != nil {
-			return nil, err
-		}
+	if err := set.IsValid(); err != nil {
+		return nil, err
 	}
 	if err := cond.Existing(c); err != nil {
 		return nil, err
 	}
-	for _, target := range set.Targets {
-		if err = target.Validate(); err != nil {
-			return nil, err
-		}
-	}
 	if set.AggregateID == "" {
 		set.AggregateID = cond.ID()
 	}
@@ -185,10 +175,8 @@ func (c *Commands) SetExecutionEvent(ctx context.Context, cond *ExecutionEventCo
 	if err := cond.IsValid(); err != nil {
 		return nil, err
 	}
-	for _, target := range set.Targets {
-		if err = target.Validate(); err != nil {
-			return nil, err
-		}
+	if err := set.IsValid(); err != nil {
+		return nil, err
 	}
 	if err := cond.Existing(c); err != nil {
 		return nil, err
@@ -203,6 +191,17 @@ type SetExecution struct {
 	models.ObjectRoot
 
 	Targets []*execution.Target
+	// Filter is an optional expression, which has to match the payload of the execution to call the targets.
+	Filter string
+}
+
+func (t SetExecution) IsValid() error {
+	for _, target := range t.Targets {
+		if err := target.Validate(); err != nil {
+			return err
+		}
+	}
+	return target_domain.Filter(t.Filter).Validate()
 }
 
 func (t SetExecution) GetIncludes() []string {
@@ -248,7 +247,7 @@ func (c *Commands) setExecution(ctx context.Context, set *SetExecution, resource
 		return nil, err
 	}
 	// Check if targets and includes for execution are existing
-	if wm.ExecutionTargetsEqual(set.Targets) {
+	if wm.ExecutionTargetsEqual(set.Targets) && wm.Filter == set.Filter {
 		return writeModelToObjectDetails(&wm.WriteModel), err
 	}
 	if err := set.Existing(c, ctx, resourceOwner); err != nil {
@@ -258,6 +257,7 @@ func (c *Commands) setExecution(ctx context.Context, set *SetExecution, resource
 		ctx,
 		ExecutionAggregateFromWriteModel(&wm.WriteModel),
 		set.Targets,
+		set.Filter,
 	)); err != nil {
 		return nil, err
 	}
@@ -14,6 +14,7 @@ type ExecutionWriteModel struct {
 	Targets          []string
 	Includes         []string
 	ExecutionTargets []*execution.Target
+	Filter           string
 }
 
 func (e *ExecutionWriteModel) ExecutionTargetsEqual(targets []*execution.Target) bool {
@@ -60,10 +61,12 @@ func (wm *ExecutionWriteModel) Reduce() error {
 			wm.Includes = e.Includes
 		case *execution.SetEventV2:
 			wm.ExecutionTargets = e.Targets
+			wm.Filter = e.Filter
 		case *execution.RemovedEvent:
 			wm.Targets = nil
 			wm.Includes = nil
 			wm.ExecutionTargets = nil
+			wm.Filter = ""
 		}
 	}
 	return wm.WriteModel.Reduce()
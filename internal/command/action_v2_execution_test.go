@@ -182,6 +182,8 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 									Crypted:    []byte("12345678"),
 								},
 								target_domain.PayloadTypeJSON,
+								nil,
+								nil,
 							),
 						),
 					),
@@ -191,6 +193,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 							[]*execution.Target{
 								{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 							},
+							"",
 						),
 					),
 				),
@@ -217,6 +220,91 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 				},
 			},
 		},
+		{
+			"invalid filter, error",
+			fields{
+				eventstore:       expectEventstore(),
+				grpcMethodExists: existsMock(true),
+			},
+			args{
+				ctx: context.Background(),
+				cond: &ExecutionAPICondition{
+					"method",
+					"",
+					false,
+				},
+				set: &SetExecution{
+					Targets: []*execution.Target{
+						{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
+					},
+					Filter: `payload.orgID ==`,
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"push ok, method target with filter",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(), // execution doesn't exist yet
+					expectFilter(
+						eventFromEventPusher(
+							target.NewAddedEvent(context.Background(),
+								target.NewAggregate("target", "instance"),
+								"name",
+								target_domain.TargetTypeWebhook,
+								"https://example.com",
+								time.Second,
+								true,
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("12345678"),
+								},
+								target_domain.PayloadTypeJSON,
+								nil,
+								nil,
+							),
+						),
+					),
+					expectPush(
+						execution.NewSetEventV2(context.Background(),
+							execution.NewAggregate("request/method", "instance"),
+							[]*execution.Target{
+								{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
+							},
+							`payload.orgID == "org"`,
+						),
+					),
+				),
+				grpcMethodExists: existsMock(true),
+			},
+			args{
+				ctx: context.Background(),
+				cond: &ExecutionAPICondition{
+					"method",
+					"",
+					false,
+				},
+				set: &SetExecution{
+					Targets: []*execution.Target{
+						{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
+					},
+					Filter: `payload.orgID == "org"`,
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "instance",
+					ID:            "request/method",
+				},
+			},
+		},
 		{
 			"push ok, service target",
 			fields{
@@ -238,6 +326,8 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 									Crypted:    []byte("12345678"),
 								},
 								target_domain.PayloadTypeJSON,
+								nil,
+								nil,
 							),
 						),
 					),
@@ -247,6 +337,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 							[]*execution.Target{
 								{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 							},
+							"",
 						),
 					),
 				),
@@ -294,6 +385,8 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 									Crypted:    []byte("12345678"),
 								},
 								target_domain.PayloadTypeJSON,
+								nil,
+								nil,
 							),
 						),
 					),
@@ -303,6 +396,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 							[]*execution.Target{
 								{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 							},
+							"",
 						),
 					),
 				),
@@ -367,6 +461,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -377,6 +472,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -386,6 +482,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 							[]*execution.Target{
 								{Type: domain.ExecutionTargetTypeInclude, Target: "request/include"},
 							},
+							"",
 						),
 					),
 				),
@@ -451,6 +548,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -461,6 +559,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -470,6 +569,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 							[]*execution.Target{
 								{Type: domain.ExecutionTargetTypeInclude, Target: "request/include"},
 							},
+							"",
 						),
 					),
 				),
@@ -534,6 +634,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -544,6 +645,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -553,6 +655,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 							[]*execution.Target{
 								{Type: domain.ExecutionTargetTypeInclude, Target: "request/include"},
 							},
+							"",
 						),
 					),
 				),
@@ -589,6 +692,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -596,6 +700,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 						execution.NewSetEventV2(context.Background(),
 							execution.NewAggregate("request", "instance"),
 							[]*execution.Target{},
+							"",
 						),
 					),
 				),
@@ -630,6 +735,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -668,6 +774,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -675,6 +782,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 						execution.NewSetEventV2(context.Background(),
 							execution.NewAggregate("request", "instance"),
 							[]*execution.Target{},
+							"",
 						),
 					),
 				),
@@ -708,6 +816,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -867,6 +976,8 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 								Crypted:    []byte("12345678"),
 							},
 							target_domain.PayloadTypeJSON,
+							nil,
+							nil,
 						),
 					),
 					expectPushFailed(
@@ -876,6 +987,7 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 							[]*execution.Target{
 								{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 							},
+							"",
 						),
 					),
 				),
@@ -968,6 +1080,8 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 									Crypted:    []byte("12345678"),
 								},
 								target_domain.PayloadTypeJSON,
+								nil,
+								nil,
 							),
 						),
 					),
@@ -977,6 +1091,7 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 							[]*execution.Target{
 								{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 							},
+							"",
 						),
 					),
 				),
@@ -1017,6 +1132,7 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 							[]*execution.Target{
 								{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 							},
+							"",
 						),
 					),
 				),
@@ -1057,6 +1173,7 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 							[]*execution.Target{
 								{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 							},
+							"",
 						),
 					),
 				),
@@ -1093,6 +1210,7 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -1100,6 +1218,7 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 						execution.NewSetEventV2(context.Background(),
 							execution.NewAggregate("response", "instance"),
 							[]*execution.Target{},
+							"",
 						),
 					),
 				),
@@ -1134,6 +1253,7 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -1172,6 +1292,7 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -1179,6 +1300,7 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 						execution.NewSetEventV2(context.Background(),
 							execution.NewAggregate("response", "instance"),
 							[]*execution.Target{},
+							"",
 						),
 					),
 				),
@@ -1212,6 +1334,7 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -1386,6 +1509,7 @@ func TestCommands_SetExecutionEvent(t *testing.T) {
 							[]*execution.Target{
 								{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 							},
+							"",
 						),
 					),
 				),
@@ -1471,6 +1595,7 @@ func TestCommands_SetExecutionEvent(t *testing.T) {
 							[]*execution.Target{
 								{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 							},
+							"",
 						),
 					),
 				),
@@ -1511,6 +1636,7 @@ func TestCommands_SetExecutionEvent(t *testing.T) {
 							[]*execution.Target{
 								{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 							},
+							"",
 						),
 					),
 				),
@@ -1551,6 +1677,7 @@ func TestCommands_SetExecutionEvent(t *testing.T) {
 							[]*execution.Target{
 								{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 							},
+							"",
 						),
 					),
 				),
@@ -1587,6 +1714,7 @@ func TestCommands_SetExecutionEvent(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -1594,6 +1722,7 @@ func TestCommands_SetExecutionEvent(t *testing.T) {
 						execution.NewSetEventV2(context.Background(),
 							execution.NewAggregate("event", "instance"),
 							[]*execution.Target{},
+							"",
 						),
 					),
 				),
@@ -1628,6 +1757,7 @@ func TestCommands_SetExecutionEvent(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -1666,6 +1796,7 @@ func TestCommands_SetExecutionEvent(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -1673,6 +1804,7 @@ func TestCommands_SetExecutionEvent(t *testing.T) {
 						execution.NewSetEventV2(context.Background(),
 							execution.NewAggregate("event", "instance"),
 							[]*execution.Target{},
+							"",
 						),
 					),
 				),
@@ -1706,6 +1838,7 @@ func TestCommands_SetExecutionEvent(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -1837,6 +1970,7 @@ func TestCommands_SetExecutionFunction(t *testing.T) {
 							[]*execution.Target{
 								{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 							},
+							"",
 						),
 					),
 				),
@@ -1912,6 +2046,7 @@ func TestCommands_SetExecutionFunction(t *testing.T) {
 							[]*execution.Target{
 								{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 							},
+							"",
 						),
 					),
 				),
@@ -1946,6 +2081,7 @@ func TestCommands_SetExecutionFunction(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -1953,6 +2089,7 @@ func TestCommands_SetExecutionFunction(t *testing.T) {
 						execution.NewSetEventV2(context.Background(),
 							execution.NewAggregate("function/function", "instance"),
 							[]*execution.Target{},
+							"",
 						),
 					),
 				),
@@ -1984,6 +2121,7 @@ func TestCommands_SetExecutionFunction(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -2019,6 +2157,7 @@ func TestCommands_SetExecutionFunction(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -2026,6 +2165,7 @@ func TestCommands_SetExecutionFunction(t *testing.T) {
 						execution.NewSetEventV2(context.Background(),
 							execution.NewAggregate("function/function", "instance"),
 							[]*execution.Target{},
+							"",
 						),
 					),
 				),
@@ -2056,6 +2196,7 @@ func TestCommands_SetExecutionFunction(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -2374,6 +2515,7 @@ func TestCommands_getExecutionIncludes(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -2401,6 +2543,7 @@ func TestCommands_getExecutionIncludes(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeInclude, Target: "include"},
 								},
+								"",
 							),
 						),
 					),
@@ -2484,6 +2627,7 @@ func TestCommands_getExecutionIncludes(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 								},
+								"",
 							),
 						),
 					),
@@ -2520,6 +2664,7 @@ func TestCommands_getExecutionIncludes(t *testing.T) {
 								[]*execution.Target{
 									{Type: domain.ExecutionTargetTypeInclude, Target: "include"},
 								},
+								"",
 							),
 						),
 					),
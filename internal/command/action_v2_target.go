@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"crypto/tls"
 	"net/url"
 	"time"
 
@@ -25,6 +26,12 @@ type AddTarget struct {
 	Timeout          time.Duration
 	InterruptOnError bool
 	PayloadType      target_domain.PayloadType
+	// ClientCertificate and ClientKey are the PEM encoded certificate and private key
+	// used for mTLS when calling a gRPC target.
+	ClientCertificate []byte
+	ClientKey         []byte
+	// RetryPolicy defines the retries of failed calls in event executions.
+	RetryPolicy *target_domain.RetryPolicy
 
 	SigningKey string
 }
@@ -44,8 +51,13 @@ func (a *AddTarget) isValid(inputDenyList []denylist.AddressChecker, lookupFunc
 	if err := denylist.IsURLBlocked(inputDenyList, parsedURL, lookupFunc); err != nil {
 		return zerrors.ThrowInvalidArgument(err, "COMMAND-NcJUKo", "Errors.Target.DeniedURL")
 	}
-
-	return nil
+	if a.TargetType == target_domain.TargetTypeGRPC && !a.PayloadType.SupportedByGRPC() {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Gq8rT2", "Errors.Target.InvalidPayloadType")
+	}
+	if err := validateRetryPolicy(a.RetryPolicy); err != nil {
+		return err
+	}
+	return validateClientCertificate(a.TargetType, a.ClientCertificate, a.ClientKey)
 }
 
 func (c *Commands) AddTarget(ctx context.Context, add *AddTarget, resourceOwner string) (_ time.Time, err error) {
@@ -75,6 +87,10 @@ func (c *Commands) AddTarget(ctx context.Context, add *AddTarget, resourceOwner
 		return time.Time{}, err
 	}
 	add.SigningKey = code.PlainCode()
+	clientCertificate, err := c.encryptClientCertificate(add.ClientCertificate, add.ClientKey)
+	if err != nil {
+		return time.Time{}, err
+	}
 	pushedEvents, err := c.eventstore.Push(ctx, target.NewAddedEvent(
 		ctx,
 		TargetAggregateFromWriteModel(&wm.WriteModel),
@@ -85,6 +101,8 @@ func (c *Commands) AddTarget(ctx context.Context, add *AddTarget, resourceOwner
 		add.InterruptOnError,
 		code.Crypted,
 		add.PayloadType,
+		clientCertificate,
+		add.RetryPolicy,
 	))
 	if err != nil {
 		return time.Time{}, err
@@ -104,6 +122,12 @@ type ChangeTarget struct {
 	Timeout          *time.Duration
 	InterruptOnError *bool
 	PayloadType      target_domain.PayloadType
+	// ClientCertificate and ClientKey replace the PEM encoded certificate and private key
+	// used for mTLS when calling a gRPC target, if set.
+	ClientCertificate []byte
+	ClientKey         []byte
+	// RetryPolicy replaces the retries of failed calls in event executions, if set.
+	RetryPolicy *target_domain.RetryPolicy
 
 	ExpirationSigningKey bool
 	SigningKey           *string
@@ -128,7 +152,12 @@ func (a *ChangeTarget) isValid(inputDenyList []denylist.AddressChecker, lookupFu
 			return zerrors.ThrowInvalidArgument(err, "COMMAND-jKbbu2", "Errors.Target.DeniedURL")
 		}
 	}
-
+	if err := validateRetryPolicy(a.RetryPolicy); err != nil {
+		return err
+	}
+	if len(a.ClientCertificate) > 0 || len(a.ClientKey) > 0 {
+		return validateClientCertificate(target_domain.TargetTypeGRPC, a.ClientCertificate, a.ClientKey)
+	}
 	return nil
 }
 
@@ -147,6 +176,25 @@ func (c *Commands) ChangeTarget(ctx context.Context, change *ChangeTarget, resou
 		return time.Time{}, zerrors.ThrowNotFound(nil, "COMMAND-xj14f2cccn", "Errors.Target.NotFound")
 	}
 
+	targetType := existing.TargetType
+	if change.TargetType != nil {
+		targetType = *change.TargetType
+	}
+	payloadType := existing.PayloadType
+	if change.PayloadType != target_domain.PayloadTypeUnspecified {
+		payloadType = change.PayloadType
+	}
+	if targetType == target_domain.TargetTypeGRPC && !payloadType.SupportedByGRPC() {
+		return time.Time{}, zerrors.ThrowInvalidArgument(nil, "COMMAND-Gq8rT3", "Errors.Target.InvalidPayloadType")
+	}
+	if len(change.ClientCertificate) > 0 && targetType != target_domain.TargetTypeGRPC {
+		return time.Time{}, zerrors.ThrowInvalidArgument(nil, "COMMAND-Gq8rT4", "Errors.Target.InvalidClientCertificate")
+	}
+	clientCertificate, err := c.encryptClientCertificate(change.ClientCertificate, change.ClientKey)
+	if err != nil {
+		return time.Time{}, err
+	}
+
 	var changedSigningKey *crypto.CryptoValue
 	if change.ExpirationSigningKey {
 		code, err := c.newSigningKey(ctx, c.eventstore.Filter, c.targetEncryption) //nolint
@@ -167,6 +215,8 @@ func (c *Commands) ChangeTarget(ctx context.Context, change *ChangeTarget, resou
 		change.InterruptOnError,
 		changedSigningKey,
 		change.PayloadType,
+		clientCertificate,
+		change.RetryPolicy,
 	)
 	if changedEvent == nil {
 		return existing.WriteModel.ChangeDate, nil
@@ -207,6 +257,52 @@ func (c *Commands) DeleteTarget(ctx context.Context, id, resourceOwner string) (
 	return existing.WriteModel.ChangeDate, nil
 }
 
+// validateClientCertificate checks that the certificate and the private key form a valid key pair.
+// Client certificates are only used for mTLS to gRPC targets.
+func validateClientCertificate(targetType target_domain.TargetType, certificate, key []byte) error {
+	if len(certificate) == 0 && len(key) == 0 {
+		return nil
+	}
+	if targetType != target_domain.TargetTypeGRPC {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Gq8rT5", "Errors.Target.InvalidClientCertificate")
+	}
+	if _, err := tls.X509KeyPair(certificate, key); err != nil {
+		return zerrors.ThrowInvalidArgument(err, "COMMAND-Gq8rT6", "Errors.Target.InvalidClientCertificate")
+	}
+	return nil
+}
+
+// validateRetryPolicy checks that retries are delayed and the delay is limited
+// between the initial backoff and [target_domain.MaxRetryBackoff].
+func validateRetryPolicy(policy *target_domain.RetryPolicy) error {
+	if policy == nil || policy.MaxRetries == 0 {
+		return nil
+	}
+	if policy.InitialBackoff <= 0 {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Rt4pL1", "Errors.Target.InvalidRetryPolicy")
+	}
+	if policy.MaxBackoff != 0 && policy.MaxBackoff < policy.InitialBackoff {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Rt4pL2", "Errors.Target.InvalidRetryPolicy")
+	}
+	if policy.InitialBackoff > target_domain.MaxRetryBackoff || policy.MaxBackoff > target_domain.MaxRetryBackoff {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Rt4pL3", "Errors.Target.InvalidRetryPolicy")
+	}
+	return nil
+}
+
+// encryptClientCertificate encrypts the PEM encoded certificate and private key as one value,
+// so they are always rotated together.
+func (c *Commands) encryptClientCertificate(certificate, key []byte) (*crypto.CryptoValue, error) {
+	if len(certificate) == 0 {
+		return nil, nil
+	}
+	keyPair := make([]byte, 0, len(certificate)+len(key)+1)
+	keyPair = append(keyPair, certificate...)
+	keyPair = append(keyPair, '\n')
+	keyPair = append(keyPair, key...)
+	return crypto.Encrypt(keyPair, c.targetEncryption)
+}
+
 func (c *Commands) existsTargetsByIDs(ctx context.Context, ids []string, resourceOwner string) bool {
 	wm := NewTargetsExistsWriteModel(ids, resourceOwner)
 	err := c.eventstore.FilterToQueryReducer(ctx, wm)
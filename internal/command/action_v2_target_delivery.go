@@ -0,0 +1,143 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	target_domain "github.com/zitadel/zitadel/internal/execution/target"
+	exec_repo "github.com/zitadel/zitadel/internal/repository/execution"
+	"github.com/zitadel/zitadel/internal/repository/target"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// TargetDeliveryFailed stores a call of an event execution to the target, which still failed after all retries.
+// If the request is a re-drive of a failed delivery, the existing delivery is updated.
+// Nothing is stored if the target was removed in the meantime.
+// It is called by the execution worker, therefore no permission check is done.
+func (c *Commands) TargetDeliveryFailed(ctx context.Context, request *exec_repo.Request, targetID, errorMessage string, attempts uint8) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	instanceID := request.Aggregate.InstanceID
+	existing, err := c.getTargetWriteModelByID(ctx, targetID, instanceID)
+	if err != nil {
+		return err
+	}
+	if !existing.State.Exists() {
+		return nil
+	}
+	deliveryID := request.DeliveryID
+	if deliveryID == "" {
+		deliveryID, err = c.idGenerator.Next()
+		if err != nil {
+			return err
+		}
+	}
+	failed := *request
+	failed.DeliveryID = deliveryID
+	failed.Attempt = 0
+
+	return c.pushAppendAndReduce(ctx, existing, target.NewDeliveryFailedEvent(
+		ctx,
+		TargetAggregateFromWriteModel(&existing.WriteModel),
+		deliveryID,
+		&failed,
+		errorMessage,
+		attempts,
+	))
+}
+
+// TargetDeliverySucceeded marks a re-driven delivery as delivered.
+// It is called by the execution worker, therefore no permission check is done.
+func (c *Commands) TargetDeliverySucceeded(ctx context.Context, deliveryID, instanceID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	existing, err := c.getTargetDeliveryWriteModelByID(ctx, deliveryID, instanceID)
+	if err != nil {
+		return err
+	}
+	if existing.State != domain.TargetDeliveryStateRedriving {
+		return nil
+	}
+	return c.pushAppendAndReduce(ctx, existing, target.NewDeliverySucceededEvent(
+		ctx,
+		TargetAggregateFromWriteModel(&existing.WriteModel),
+		deliveryID,
+	))
+}
+
+// RedriveTargetDelivery requests a new call of a failed delivery.
+// The target is called with its current configuration, so a misconfigured target can be fixed before the re-drive.
+func (c *Commands) RedriveTargetDelivery(ctx context.Context, deliveryID, instanceID string) (_ time.Time, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if deliveryID == "" || instanceID == "" {
+		return time.Time{}, zerrors.ThrowInvalidArgument(nil, "COMMAND-Dl3vR1", "Errors.IDMissing")
+	}
+	existing, err := c.getTargetDeliveryWriteModelByID(ctx, deliveryID, instanceID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !existing.State.Exists() {
+		return time.Time{}, zerrors.ThrowNotFound(nil, "COMMAND-Dl3vR2", "Errors.Target.DeliveryNotFound")
+	}
+	if existing.State == domain.TargetDeliveryStateDelivered {
+		return time.Time{}, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Dl3vR3", "Errors.Target.DeliveryAlreadyDelivered")
+	}
+	targetWriteModel, err := c.getTargetWriteModelByID(ctx, existing.AggregateID, instanceID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !targetWriteModel.State.Exists() {
+		return time.Time{}, zerrors.ThrowNotFound(nil, "COMMAND-Dl3vR4", "Errors.Target.NotFound")
+	}
+	request, err := redriveRequest(existing.Request, targetWriteModel)
+	if err != nil {
+		return time.Time{}, zerrors.ThrowInternal(err, "COMMAND-Dl3vR5", "Errors.Internal")
+	}
+	if err := c.pushAppendAndReduce(ctx, existing, target.NewDeliveryRedriveRequestedEvent(
+		ctx,
+		TargetAggregateFromWriteModel(&existing.WriteModel),
+		deliveryID,
+		request,
+	)); err != nil {
+		return time.Time{}, err
+	}
+	return existing.ChangeDate, nil
+}
+
+// redriveRequest replaces the configuration of the target in the failed request with the current configuration.
+func redriveRequest(failed *exec_repo.Request, wm *TargetWriteModel) (*exec_repo.Request, error) {
+	var targets []target_domain.Target
+	if err := json.Unmarshal(failed.TargetsData, &targets); err != nil {
+		return nil, err
+	}
+	for i := range targets {
+		targets[i].TargetType = wm.TargetType
+		targets[i].Endpoint = wm.Endpoint
+		targets[i].Timeout = wm.Timeout
+		targets[i].SigningKey = wm.SigningKey
+		targets[i].PayloadType = wm.PayloadType
+		targets[i].ClientCertificate = wm.ClientCertificate
+		targets[i].RetryPolicy = wm.RetryPolicy
+	}
+	request, err := failed.WithTargets(targets)
+	if err != nil {
+		return nil, err
+	}
+	request.Attempt = 0
+	return request, nil
+}
+
+func (c *Commands) getTargetDeliveryWriteModelByID(ctx context.Context, deliveryID, instanceID string) (*TargetDeliveryWriteModel, error) {
+	wm := NewTargetDeliveryWriteModel(deliveryID, instanceID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
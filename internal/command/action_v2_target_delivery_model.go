@@ -0,0 +1,59 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	exec_repo "github.com/zitadel/zitadel/internal/repository/execution"
+	"github.com/zitadel/zitadel/internal/repository/target"
+)
+
+// TargetDeliveryWriteModel is a failed call of an event execution to a target.
+// The delivery is identified by its ID only, the aggregate is the target it was sent to.
+type TargetDeliveryWriteModel struct {
+	eventstore.WriteModel
+
+	DeliveryID string
+	Request    *exec_repo.Request
+	State      domain.TargetDeliveryState
+}
+
+func NewTargetDeliveryWriteModel(deliveryID string, instanceID string) *TargetDeliveryWriteModel {
+	return &TargetDeliveryWriteModel{
+		WriteModel: eventstore.WriteModel{
+			ResourceOwner: instanceID,
+			InstanceID:    instanceID,
+		},
+		DeliveryID: deliveryID,
+	}
+}
+
+func (wm *TargetDeliveryWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *target.DeliveryFailedEvent:
+			wm.AggregateID = e.Aggregate().ID
+			wm.Request = e.Request
+			wm.State = domain.TargetDeliveryStateFailed
+		case *target.DeliveryRedriveRequestedEvent:
+			wm.Request = e.Request
+			wm.State = domain.TargetDeliveryStateRedriving
+		case *target.DeliverySucceededEvent:
+			wm.State = domain.TargetDeliveryStateDelivered
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *TargetDeliveryWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(target.AggregateType).
+		EventTypes(
+			target.DeliveryFailedEventType,
+			target.DeliveryRedriveRequestedEventType,
+			target.DeliverySucceededEventType,
+		).
+		EventData(map[string]interface{}{"deliveryId": wm.DeliveryID}).
+		Builder()
+}
@@ -0,0 +1,332 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	target_domain "github.com/zitadel/zitadel/internal/execution/target"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	exec_repo "github.com/zitadel/zitadel/internal/repository/execution"
+	"github.com/zitadel/zitadel/internal/repository/target"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func targetDeliveryRequest(t *testing.T, deliveryID, endpoint string) *exec_repo.Request {
+	data, err := json.Marshal([]target_domain.Target{{
+		ExecutionID: "event/user.added",
+		TargetID:    "target1",
+		TargetType:  target_domain.TargetTypeWebhook,
+		Endpoint:    endpoint,
+		Timeout:     time.Second,
+		SigningKey: &crypto.CryptoValue{
+			CryptoType: crypto.TypeEncryption,
+			Algorithm:  "enc",
+			KeyID:      "id",
+			Crypted:    []byte("12345678"),
+		},
+		PayloadType: target_domain.PayloadTypeJSON,
+	}})
+	require.NoError(t, err)
+	return &exec_repo.Request{
+		Aggregate: &eventstore.Aggregate{
+			ID:            "user1",
+			Type:          "user",
+			ResourceOwner: "org1",
+			InstanceID:    "instance",
+		},
+		Sequence:    1,
+		EventType:   "user.added",
+		TargetsData: data,
+		DeliveryID:  deliveryID,
+	}
+}
+
+func TestCommands_TargetDeliveryFailed(t *testing.T) {
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		request  func(t *testing.T) *exec_repo.Request
+		targetID string
+		attempts uint8
+	}
+	type res struct {
+		err func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"target removed, ignored",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							targetAddEvent("target1", "instance"),
+						),
+						eventFromEventPusher(
+							targetRemoveEvent("target1", "instance"),
+						),
+					),
+				),
+			},
+			args{
+				request: func(t *testing.T) *exec_repo.Request {
+					return targetDeliveryRequest(t, "", "https://example.com")
+				},
+				targetID: "target1",
+				attempts: 1,
+			},
+			res{},
+		},
+		{
+			"first failure, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							targetAddEvent("target1", "instance"),
+						),
+					),
+					expectPush(
+						target.NewDeliveryFailedEvent(context.Background(),
+							target.NewAggregate("target1", "instance"),
+							"delivery1",
+							targetDeliveryRequest(t, "delivery1", "https://example.com"),
+							"unavailable",
+							3,
+						),
+					),
+				),
+				idGenerator: mock.ExpectID(t, "delivery1"),
+			},
+			args{
+				request: func(t *testing.T) *exec_repo.Request {
+					request := targetDeliveryRequest(t, "", "https://example.com")
+					request.Attempt = 2
+					return request
+				},
+				targetID: "target1",
+				attempts: 3,
+			},
+			res{},
+		},
+		{
+			"failed re-drive, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							targetAddEvent("target1", "instance"),
+						),
+					),
+					expectPush(
+						target.NewDeliveryFailedEvent(context.Background(),
+							target.NewAggregate("target1", "instance"),
+							"delivery1",
+							targetDeliveryRequest(t, "delivery1", "https://example.com"),
+							"unavailable",
+							1,
+						),
+					),
+				),
+			},
+			args{
+				request: func(t *testing.T) *exec_repo.Request {
+					return targetDeliveryRequest(t, "delivery1", "https://example.com")
+				},
+				targetID: "target1",
+				attempts: 1,
+			},
+			res{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			err := c.TargetDeliveryFailed(context.Background(), tt.args.request(t), tt.args.targetID, "unavailable", tt.args.attempts)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
+
+func TestCommands_RedriveTargetDelivery(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		id         string
+		instanceID string
+	}
+	type res struct {
+		err func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"id missing, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				id:         "",
+				instanceID: "instance",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				id:         "delivery1",
+				instanceID: "instance",
+			},
+			res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			"already delivered, precondition error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							target.NewDeliveryFailedEvent(context.Background(),
+								target.NewAggregate("target1", "instance"),
+								"delivery1",
+								targetDeliveryRequest(t, "delivery1", "https://example.com"),
+								"unavailable",
+								1,
+							),
+						),
+						eventFromEventPusher(
+							target.NewDeliverySucceededEvent(context.Background(),
+								target.NewAggregate("target1", "instance"),
+								"delivery1",
+							),
+						),
+					),
+				),
+			},
+			args{
+				id:         "delivery1",
+				instanceID: "instance",
+			},
+			res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			"target removed, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							target.NewDeliveryFailedEvent(context.Background(),
+								target.NewAggregate("target1", "instance"),
+								"delivery1",
+								targetDeliveryRequest(t, "delivery1", "https://example.com"),
+								"unavailable",
+								1,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							targetAddEvent("target1", "instance"),
+						),
+						eventFromEventPusher(
+							targetRemoveEvent("target1", "instance"),
+						),
+					),
+				),
+			},
+			args{
+				id:         "delivery1",
+				instanceID: "instance",
+			},
+			res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			"redrive with current target, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							target.NewDeliveryFailedEvent(context.Background(),
+								target.NewAggregate("target1", "instance"),
+								"delivery1",
+								targetDeliveryRequest(t, "delivery1", "https://old.example.com"),
+								"unavailable",
+								1,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							targetAddEvent("target1", "instance"),
+						),
+					),
+					expectPush(
+						target.NewDeliveryRedriveRequestedEvent(context.Background(),
+							target.NewAggregate("target1", "instance"),
+							"delivery1",
+							targetDeliveryRequest(t, "delivery1", "https://example.com"),
+						),
+					),
+				),
+			},
+			args{
+				id:         "delivery1",
+				instanceID: "instance",
+			},
+			res{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			_, err := c.RedriveTargetDelivery(context.Background(), tt.args.id, tt.args.instanceID)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
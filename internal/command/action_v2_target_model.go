@@ -23,6 +23,9 @@ type TargetWriteModel struct {
 	SigningKey       *crypto.CryptoValue
 	PayloadType      target_domain.PayloadType
 
+	ClientCertificate *crypto.CryptoValue
+	RetryPolicy       *target_domain.RetryPolicy
+
 	State domain.TargetState
 }
 
@@ -47,6 +50,8 @@ func (wm *TargetWriteModel) Reduce() error {
 			wm.State = domain.TargetActive
 			wm.SigningKey = e.SigningKey
 			wm.PayloadType = e.PayloadType
+			wm.ClientCertificate = e.ClientCertificate
+			wm.RetryPolicy = e.RetryPolicy
 		case *target.ChangedEvent:
 			if e.Name != nil {
 				wm.Name = *e.Name
@@ -69,6 +74,12 @@ func (wm *TargetWriteModel) Reduce() error {
 			if e.PayloadType != target_domain.PayloadTypeUnspecified {
 				wm.PayloadType = e.PayloadType
 			}
+			if e.ClientCertificate != nil {
+				wm.ClientCertificate = e.ClientCertificate
+			}
+			if e.RetryPolicy != nil {
+				wm.RetryPolicy = e.RetryPolicy
+			}
 		case *target.RemovedEvent:
 			wm.State = domain.TargetRemoved
 		}
@@ -98,6 +109,8 @@ func (wm *TargetWriteModel) NewChangedEvent(
 	interruptOnError *bool,
 	signingKey *crypto.CryptoValue,
 	payloadType target_domain.PayloadType,
+	clientCertificate *crypto.CryptoValue,
+	retryPolicy *target_domain.RetryPolicy,
 ) *target.ChangedEvent {
 	changes := make([]target.Changes, 0)
 	if name != nil && wm.Name != *name {
@@ -122,6 +135,13 @@ func (wm *TargetWriteModel) NewChangedEvent(
 	if payloadType != target_domain.PayloadTypeUnspecified && wm.PayloadType != payloadType {
 		changes = append(changes, target.ChangePayloadType(payloadType))
 	}
+	// if the client certificate is set, update it as it is encrypted
+	if clientCertificate != nil {
+		changes = append(changes, target.ChangeClientCertificate(clientCertificate))
+	}
+	if retryPolicy != nil && (wm.RetryPolicy == nil || *wm.RetryPolicy != *retryPolicy) {
+		changes = append(changes, target.ChangeRetryPolicy(retryPolicy))
+	}
 	if len(changes) == 0 {
 		return nil
 	}
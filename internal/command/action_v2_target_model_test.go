@@ -28,6 +28,8 @@ func targetAddEvent(aggID, resourceOwner string) *target.AddedEvent {
 			Crypted:    []byte("12345678"),
 		},
 		target_domain.PayloadTypeJSON,
+		nil,
+		nil,
 	)
 }
 
@@ -149,6 +149,68 @@ func TestCommands_AddTarget(t *testing.T) {
 				err: zerrors.IsErrorInvalidArgument,
 			},
 		},
+		{
+			"grpc with jwt payload, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: context.Background(),
+				add: &AddTarget{
+					Name:        "name",
+					TargetType:  target_domain.TargetTypeGRPC,
+					Timeout:     time.Second,
+					Endpoint:    "https://example.com",
+					PayloadType: target_domain.PayloadTypeJWT,
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"client certificate on webhook, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: context.Background(),
+				add: &AddTarget{
+					Name:              "name",
+					TargetType:        target_domain.TargetTypeWebhook,
+					Timeout:           time.Second,
+					Endpoint:          "https://example.com",
+					ClientCertificate: []byte("certificate"),
+					ClientKey:         []byte("key"),
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"invalid client certificate, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: context.Background(),
+				add: &AddTarget{
+					Name:              "name",
+					TargetType:        target_domain.TargetTypeGRPC,
+					Timeout:           time.Second,
+					Endpoint:          "https://example.com",
+					ClientCertificate: []byte("certificate"),
+					ClientKey:         []byte("key"),
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
 		{
 			"unique constraint failed, error",
 			fields{
@@ -170,6 +232,8 @@ func TestCommands_AddTarget(t *testing.T) {
 								Crypted:    []byte("12345678"),
 							},
 							target_domain.PayloadTypeJSON,
+							nil,
+							nil,
 						),
 					),
 				),
@@ -768,3 +832,58 @@ func TestCommands_DeleteTarget(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateRetryPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  *target_domain.RetryPolicy
+		wantErr func(error) bool
+	}{
+		{
+			name:   "no policy",
+			policy: nil,
+		},
+		{
+			name:   "no retries",
+			policy: &target_domain.RetryPolicy{},
+		},
+		{
+			name:    "no initial backoff",
+			policy:  &target_domain.RetryPolicy{MaxRetries: 3},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name:    "max backoff below initial backoff",
+			policy:  &target_domain.RetryPolicy{MaxRetries: 3, InitialBackoff: time.Minute, MaxBackoff: time.Second},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name:    "initial backoff above upper limit",
+			policy:  &target_domain.RetryPolicy{MaxRetries: 3, InitialBackoff: target_domain.MaxRetryBackoff + time.Second},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name:    "max backoff above upper limit",
+			policy:  &target_domain.RetryPolicy{MaxRetries: 3, InitialBackoff: time.Second, MaxBackoff: target_domain.MaxRetryBackoff + time.Second},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name:   "max backoff not set",
+			policy: &target_domain.RetryPolicy{MaxRetries: 3, InitialBackoff: time.Second},
+		},
+		{
+			name:   "max backoff at upper limit",
+			policy: &target_domain.RetryPolicy{MaxRetries: 20, InitialBackoff: time.Second, MaxBackoff: target_domain.MaxRetryBackoff},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRetryPolicy(tt.policy)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+		})
+	}
+}
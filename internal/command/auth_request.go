@@ -2,35 +2,40 @@ package command
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/authrequest"
+	"github.com/zitadel/zitadel/internal/repository/user"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 type AuthRequest struct {
-	ID               string
-	LoginClient      string
-	ClientID         string
-	RedirectURI      string
-	State            string
-	Nonce            string
-	Scope            []string
-	Audience         []string
-	ResponseType     domain.OIDCResponseType
-	ResponseMode     domain.OIDCResponseMode
-	CodeChallenge    *domain.OIDCCodeChallenge
-	Prompt           []domain.Prompt
-	UILocales        []string
-	MaxAge           *time.Duration
-	LoginHint        *string
-	HintUserID       *string
-	NeedRefreshToken bool
-	Issuer           string
-	OrganizationID   string
+	ID                   string
+	LoginClient          string
+	ClientID             string
+	RedirectURI          string
+	State                string
+	Nonce                string
+	Scope                []string
+	Audience             []string
+	ResponseType         domain.OIDCResponseType
+	ResponseMode         domain.OIDCResponseMode
+	CodeChallenge        *domain.OIDCCodeChallenge
+	Prompt               []domain.Prompt
+	UILocales            []string
+	MaxAge               *time.Duration
+	LoginHint            *string
+	HintUserID           *string
+	NeedRefreshToken     bool
+	Issuer               string
+	OrganizationID       string
+	AuthorizationDetails domain.AuthorizationDetails
+	ConsentRequired      bool
 }
 
 type CurrentAuthRequest struct {
@@ -77,6 +82,8 @@ func (c *Commands) AddAuthRequest(ctx context.Context, authRequest *AuthRequest)
 		authRequest.NeedRefreshToken,
 		authRequest.Issuer,
 		authRequest.OrganizationID,
+		authRequest.AuthorizationDetails,
+		authRequest.ConsentRequired,
 	))
 	if err != nil {
 		return nil, err
@@ -84,7 +91,12 @@ func (c *Commands) AddAuthRequest(ctx context.Context, authRequest *AuthRequest)
 	return authRequestWriteModelToCurrentAuthRequest(writeModel), nil
 }
 
-func (c *Commands) LinkSessionToAuthRequest(ctx context.Context, id, sessionID, sessionToken string, checkLoginClient bool, projectPermissionCheck domain.ProjectPermissionCheck) (*domain.ObjectDetails, *CurrentAuthRequest, error) {
+// LinkSessionToAuthRequest links the session to the auth request.
+// If the client requires the consent of the user and the user has not yet consented to the requested scopes,
+// the login needs to show the consent screen and link the session again with grantConsent set.
+// The login can narrow down the requested authorization details to the ones approved by the user,
+// if none are passed, all requested authorization details are granted.
+func (c *Commands) LinkSessionToAuthRequest(ctx context.Context, id, sessionID, sessionToken string, checkLoginClient bool, projectPermissionCheck domain.ProjectPermissionCheck, grantConsent bool, authorizationDetails domain.AuthorizationDetails) (*domain.ObjectDetails, *CurrentAuthRequest, error) {
 	writeModel, err := c.getAuthRequestWriteModel(ctx, id)
 	if err != nil {
 		return nil, nil, err
@@ -121,19 +133,60 @@ func (c *Commands) LinkSessionToAuthRequest(ctx context.Context, id, sessionID,
 	if writeModel.OrganizationID != "" && writeModel.OrganizationID != sessionWriteModel.UserResourceOwner {
 		return nil, nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-59ljd", "Errors.User.NotAllowedOrg")
 	}
+	if !writeModel.AuthorizationDetails.Contains(authorizationDetails) {
+		return nil, nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rar3n", "Errors.AuthorizationDetails.Invalid")
+	}
 
-	if err := c.pushAppendAndReduce(ctx, writeModel, authrequest.NewSessionLinkedEvent(
+	cmds := make([]eventstore.Command, 0, 2)
+	if writeModel.ConsentRequired {
+		consent, err := c.authRequestConsent(ctx, writeModel, sessionWriteModel.UserID, sessionWriteModel.UserResourceOwner, grantConsent)
+		if err != nil {
+			return nil, nil, err
+		}
+		if consent != nil {
+			cmds = append(cmds, consent)
+		}
+	}
+	cmds = append(cmds, authrequest.NewSessionLinkedEvent(
 		ctx, &authrequest.NewAggregate(id, authz.GetInstance(ctx).InstanceID()).Aggregate,
 		sessionID,
 		sessionWriteModel.UserID,
 		sessionWriteModel.AuthenticationTime(),
 		sessionWriteModel.AuthMethodTypes(),
-	)); err != nil {
+		authorizationDetails,
+	))
+	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return nil, nil, err
+	}
+	// the consent is stored on the user, only the last event belongs to the auth request
+	if err = AppendAndReduce(writeModel, pushedEvents[len(pushedEvents)-1]); err != nil {
 		return nil, nil, err
 	}
 	return writeModelToObjectDetails(&writeModel.WriteModel), authRequestWriteModelToCurrentAuthRequest(writeModel), nil
 }
 
+// authRequestConsent checks if the user already consented to the requested scopes of the client.
+// Otherwise, or if the client explicitly prompts for it, the consent must be granted and is returned as event.
+func (c *Commands) authRequestConsent(ctx context.Context, authReq *AuthRequestWriteModel, userID, resourceOwner string, grantConsent bool) (eventstore.Command, error) {
+	consent, err := c.getUserConsentWriteModel(ctx, userID, resourceOwner, authReq.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	if consent.Covers(authReq.Scope) && !slices.Contains(authReq.Prompt, domain.PromptConsent) {
+		return nil, nil
+	}
+	if !grantConsent {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Cns6r", "Errors.AuthRequest.ConsentRequired")
+	}
+	return user.NewConsentGrantedEvent(
+		ctx,
+		UserAggregateFromWriteModelCtx(ctx, &consent.WriteModel),
+		authReq.ClientID,
+		consent.grantedScope(authReq.Scope),
+	), nil
+}
+
 func (c *Commands) FailAuthRequest(ctx context.Context, id string, reason domain.OIDCErrorReason) (*domain.ObjectDetails, *CurrentAuthRequest, error) {
 	writeModel, err := c.getAuthRequestWriteModel(ctx, id)
 	if err != nil {
@@ -176,24 +229,26 @@ func (c *Commands) AddAuthRequestCode(ctx context.Context, authRequestID, code s
 func authRequestWriteModelToCurrentAuthRequest(writeModel *AuthRequestWriteModel) (_ *CurrentAuthRequest) {
 	return &CurrentAuthRequest{
 		AuthRequest: &AuthRequest{
-			ID:             writeModel.AggregateID,
-			LoginClient:    writeModel.LoginClient,
-			ClientID:       writeModel.ClientID,
-			RedirectURI:    writeModel.RedirectURI,
-			State:          writeModel.State,
-			Nonce:          writeModel.Nonce,
-			Scope:          writeModel.Scope,
-			Audience:       writeModel.Audience,
-			ResponseType:   writeModel.ResponseType,
-			ResponseMode:   writeModel.ResponseMode,
-			CodeChallenge:  writeModel.CodeChallenge,
-			Prompt:         writeModel.Prompt,
-			UILocales:      writeModel.UILocales,
-			MaxAge:         writeModel.MaxAge,
-			LoginHint:      writeModel.LoginHint,
-			HintUserID:     writeModel.HintUserID,
-			Issuer:         writeModel.Issuer,
-			OrganizationID: writeModel.OrganizationID,
+			ID:                   writeModel.AggregateID,
+			LoginClient:          writeModel.LoginClient,
+			ClientID:             writeModel.ClientID,
+			RedirectURI:          writeModel.RedirectURI,
+			State:                writeModel.State,
+			Nonce:                writeModel.Nonce,
+			Scope:                writeModel.Scope,
+			Audience:             writeModel.Audience,
+			ResponseType:         writeModel.ResponseType,
+			ResponseMode:         writeModel.ResponseMode,
+			CodeChallenge:        writeModel.CodeChallenge,
+			Prompt:               writeModel.Prompt,
+			UILocales:            writeModel.UILocales,
+			MaxAge:               writeModel.MaxAge,
+			LoginHint:            writeModel.LoginHint,
+			HintUserID:           writeModel.HintUserID,
+			Issuer:               writeModel.Issuer,
+			OrganizationID:       writeModel.OrganizationID,
+			AuthorizationDetails: writeModel.AuthorizationDetails,
+			ConsentRequired:      writeModel.ConsentRequired,
 		},
 		SessionID:   writeModel.SessionID,
 		UserID:      writeModel.UserID,
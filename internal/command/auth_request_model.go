@@ -15,29 +15,31 @@ type AuthRequestWriteModel struct {
 	eventstore.WriteModel
 	aggregate *eventstore.Aggregate
 
-	LoginClient      string
-	ClientID         string
-	RedirectURI      string
-	State            string
-	Nonce            string
-	Scope            []string
-	Audience         []string
-	ResponseType     domain.OIDCResponseType
-	ResponseMode     domain.OIDCResponseMode
-	CodeChallenge    *domain.OIDCCodeChallenge
-	Prompt           []domain.Prompt
-	UILocales        []string
-	MaxAge           *time.Duration
-	LoginHint        *string
-	HintUserID       *string
-	SessionID        string
-	UserID           string
-	AuthTime         time.Time
-	AuthMethods      []domain.UserAuthMethodType
-	AuthRequestState domain.AuthRequestState
-	NeedRefreshToken bool
-	Issuer           string
-	OrganizationID   string
+	LoginClient          string
+	ClientID             string
+	RedirectURI          string
+	State                string
+	Nonce                string
+	Scope                []string
+	Audience             []string
+	ResponseType         domain.OIDCResponseType
+	ResponseMode         domain.OIDCResponseMode
+	CodeChallenge        *domain.OIDCCodeChallenge
+	Prompt               []domain.Prompt
+	UILocales            []string
+	MaxAge               *time.Duration
+	LoginHint            *string
+	HintUserID           *string
+	SessionID            string
+	UserID               string
+	AuthTime             time.Time
+	AuthMethods          []domain.UserAuthMethodType
+	AuthRequestState     domain.AuthRequestState
+	NeedRefreshToken     bool
+	Issuer               string
+	OrganizationID       string
+	AuthorizationDetails domain.AuthorizationDetails
+	ConsentRequired      bool
 }
 
 func NewAuthRequestWriteModel(ctx context.Context, id string) *AuthRequestWriteModel {
@@ -72,11 +74,16 @@ func (m *AuthRequestWriteModel) Reduce() error {
 			m.NeedRefreshToken = e.NeedRefreshToken
 			m.Issuer = e.Issuer
 			m.OrganizationID = e.OrganizationID
+			m.AuthorizationDetails = e.AuthorizationDetails
+			m.ConsentRequired = e.ConsentRequired
 		case *authrequest.SessionLinkedEvent:
 			m.SessionID = e.SessionID
 			m.UserID = e.UserID
 			m.AuthTime = e.AuthTime
 			m.AuthMethods = e.AuthMethods
+			if len(e.AuthorizationDetails) > 0 {
+				m.AuthorizationDetails = e.AuthorizationDetails
+			}
 		case *authrequest.CodeAddedEvent:
 			m.AuthRequestState = domain.AuthRequestStateCodeAdded
 		case *authrequest.FailedEvent:
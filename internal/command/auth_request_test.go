@@ -19,6 +19,7 @@ import (
 	"github.com/zitadel/zitadel/internal/id/mock"
 	"github.com/zitadel/zitadel/internal/repository/authrequest"
 	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -64,6 +65,8 @@ func TestCommands_AddAuthRequest(t *testing.T) {
 								false,
 								"issuer",
 								"",
+								nil,
+								false,
 							),
 						),
 					),
@@ -105,6 +108,8 @@ func TestCommands_AddAuthRequest(t *testing.T) {
 							false,
 							"issuer",
 							"organizationID",
+							nil,
+							false,
 						),
 					),
 				),
@@ -184,12 +189,14 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 		checkPermission domain.PermissionCheck
 	}
 	type args struct {
-		ctx              context.Context
-		id               string
-		sessionID        string
-		sessionToken     string
-		checkLoginClient bool
-		permissionCheck  domain.ProjectPermissionCheck
+		ctx                  context.Context
+		id                   string
+		sessionID            string
+		sessionToken         string
+		checkLoginClient     bool
+		permissionCheck      domain.ProjectPermissionCheck
+		grantConsent         bool
+		authorizationDetails domain.AuthorizationDetails
 	}
 	type res struct {
 		details *domain.ObjectDetails
@@ -244,6 +251,8 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								true,
 								"issuer",
 								"",
+								nil,
+								false,
 							),
 						),
 						eventFromEventPusher(
@@ -288,6 +297,8 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								true,
 								"issuer",
 								"",
+								nil,
+								false,
 							),
 						),
 					),
@@ -331,6 +342,8 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								true,
 								"issuer",
 								"",
+								nil,
+								false,
 							),
 						),
 					),
@@ -372,6 +385,8 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								true,
 								"issuer",
 								"",
+								nil,
+								false,
 							),
 						),
 					),
@@ -436,6 +451,8 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								true,
 								"issuer",
 								"",
+								nil,
+								false,
 							),
 						),
 					),
@@ -489,6 +506,8 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								true,
 								"issuer",
 								"organizationID",
+								nil,
+								false,
 							),
 						),
 					),
@@ -554,6 +573,8 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								true,
 								"issuer",
 								"",
+								nil,
+								false,
 							),
 						),
 					),
@@ -587,6 +608,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 							"userID",
 							testNow,
 							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+							nil,
 						),
 					),
 				),
@@ -620,6 +642,474 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 				},
 			},
 		},
+		{
+			"authorization details not requested",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							authrequest.NewAddedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate,
+								"loginClient",
+								"clientID",
+								"redirectURI",
+								"state",
+								"nonce",
+								[]string{"openid"},
+								[]string{"audience"},
+								domain.OIDCResponseTypeCode,
+								domain.OIDCResponseModeQuery,
+								nil,
+								nil,
+								nil,
+								nil,
+								nil,
+								nil,
+								true,
+								"issuer",
+								"",
+								domain.AuthorizationDetails{
+									{"type": "payment_initiation", "amount": "10"},
+									{"type": "account_information"},
+								},
+								false,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(mockCtx,
+								&session.NewAggregate("sessionID", "instance1").Aggregate,
+								&domain.UserAgent{
+									FingerprintID: gu.Ptr("fp1"),
+									IP:            net.ParseIP("1.2.3.4"),
+									Description:   gu.Ptr("firefox"),
+									Header:        http.Header{"foo": []string{"bar"}},
+								},
+							)),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								"userID", "org1", testNow, &language.Afrikaans),
+						),
+						eventFromEventPusher(
+							session.NewPasswordCheckedEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								testNow),
+						),
+						eventFromEventPusherWithCreationDateNow(
+							session.NewLifetimeSetEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								2*time.Minute),
+						),
+					),
+				),
+				tokenVerifier: newMockTokenVerifierValid(),
+			},
+			args{
+				ctx:          mockCtx,
+				id:           "V2_id",
+				sessionID:    "sessionID",
+				sessionToken: "token",
+				authorizationDetails: domain.AuthorizationDetails{
+					{"type": "payment_initiation", "amount": "1000"},
+				},
+			},
+			res{
+				wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Rar3n", "Errors.AuthorizationDetails.Invalid"),
+			},
+		},
+		{
+			"linked with narrowed authorization details",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							authrequest.NewAddedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate,
+								"loginClient",
+								"clientID",
+								"redirectURI",
+								"state",
+								"nonce",
+								[]string{"openid"},
+								[]string{"audience"},
+								domain.OIDCResponseTypeCode,
+								domain.OIDCResponseModeQuery,
+								nil,
+								nil,
+								nil,
+								nil,
+								nil,
+								nil,
+								true,
+								"issuer",
+								"",
+								domain.AuthorizationDetails{
+									{"type": "payment_initiation", "amount": "10"},
+									{"type": "account_information"},
+								},
+								false,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(mockCtx,
+								&session.NewAggregate("sessionID", "instance1").Aggregate,
+								&domain.UserAgent{
+									FingerprintID: gu.Ptr("fp1"),
+									IP:            net.ParseIP("1.2.3.4"),
+									Description:   gu.Ptr("firefox"),
+									Header:        http.Header{"foo": []string{"bar"}},
+								},
+							)),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								"userID", "org1", testNow, &language.Afrikaans),
+						),
+						eventFromEventPusher(
+							session.NewPasswordCheckedEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								testNow),
+						),
+						eventFromEventPusherWithCreationDateNow(
+							session.NewLifetimeSetEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								2*time.Minute),
+						),
+					),
+					expectPush(
+						authrequest.NewSessionLinkedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate,
+							"sessionID",
+							"userID",
+							testNow,
+							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+							domain.AuthorizationDetails{
+								{"type": "account_information"},
+							},
+						),
+					),
+				),
+				tokenVerifier: newMockTokenVerifierValid(),
+			},
+			args{
+				ctx:          mockCtx,
+				id:           "V2_id",
+				sessionID:    "sessionID",
+				sessionToken: "token",
+				authorizationDetails: domain.AuthorizationDetails{
+					{"type": "account_information"},
+				},
+			},
+			res{
+				details: &domain.ObjectDetails{ResourceOwner: "instanceID"},
+				authReq: &CurrentAuthRequest{
+					AuthRequest: &AuthRequest{
+						ID:           "V2_id",
+						LoginClient:  "loginClient",
+						ClientID:     "clientID",
+						RedirectURI:  "redirectURI",
+						State:        "state",
+						Nonce:        "nonce",
+						Scope:        []string{"openid"},
+						Audience:     []string{"audience"},
+						ResponseType: domain.OIDCResponseTypeCode,
+						ResponseMode: domain.OIDCResponseModeQuery,
+						Issuer:       "issuer",
+						AuthorizationDetails: domain.AuthorizationDetails{
+							{"type": "account_information"},
+						},
+					},
+					SessionID:   "sessionID",
+					UserID:      "userID",
+					AuthMethods: []domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+				},
+			},
+		},
+		{
+			"consent required, not granted",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							authrequest.NewAddedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate,
+								"loginClient",
+								"clientID",
+								"redirectURI",
+								"state",
+								"nonce",
+								[]string{"openid"},
+								[]string{"audience"},
+								domain.OIDCResponseTypeCode,
+								domain.OIDCResponseModeQuery,
+								nil,
+								nil,
+								nil,
+								nil,
+								nil,
+								nil,
+								true,
+								"issuer",
+								"",
+								nil,
+								true,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(mockCtx,
+								&session.NewAggregate("sessionID", "instance1").Aggregate,
+								&domain.UserAgent{
+									FingerprintID: gu.Ptr("fp1"),
+									IP:            net.ParseIP("1.2.3.4"),
+									Description:   gu.Ptr("firefox"),
+									Header:        http.Header{"foo": []string{"bar"}},
+								},
+							)),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								"userID", "org1", testNow, &language.Afrikaans),
+						),
+						eventFromEventPusher(
+							session.NewPasswordCheckedEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								testNow),
+						),
+						eventFromEventPusherWithCreationDateNow(
+							session.NewLifetimeSetEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								2*time.Minute),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(mockCtx, &user.NewAggregate("userID", "org1").Aggregate,
+								"username", "firstname", "lastname", "nickname", "displayname",
+								language.English, domain.GenderUnspecified, "email@test.ch", true,
+							),
+						),
+					),
+				),
+				tokenVerifier: newMockTokenVerifierValid(),
+			},
+			args{
+				ctx:          mockCtx,
+				id:           "V2_id",
+				sessionID:    "sessionID",
+				sessionToken: "token",
+			},
+			res{
+				wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Cns6r", "Errors.AuthRequest.ConsentRequired"),
+			},
+		},
+		{
+			"consent required, granted",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							authrequest.NewAddedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate,
+								"loginClient",
+								"clientID",
+								"redirectURI",
+								"state",
+								"nonce",
+								[]string{"openid"},
+								[]string{"audience"},
+								domain.OIDCResponseTypeCode,
+								domain.OIDCResponseModeQuery,
+								nil,
+								nil,
+								nil,
+								nil,
+								nil,
+								nil,
+								true,
+								"issuer",
+								"",
+								nil,
+								true,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(mockCtx,
+								&session.NewAggregate("sessionID", "instance1").Aggregate,
+								&domain.UserAgent{
+									FingerprintID: gu.Ptr("fp1"),
+									IP:            net.ParseIP("1.2.3.4"),
+									Description:   gu.Ptr("firefox"),
+									Header:        http.Header{"foo": []string{"bar"}},
+								},
+							)),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								"userID", "org1", testNow, &language.Afrikaans),
+						),
+						eventFromEventPusher(
+							session.NewPasswordCheckedEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								testNow),
+						),
+						eventFromEventPusherWithCreationDateNow(
+							session.NewLifetimeSetEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								2*time.Minute),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(mockCtx, &user.NewAggregate("userID", "org1").Aggregate,
+								"username", "firstname", "lastname", "nickname", "displayname",
+								language.English, domain.GenderUnspecified, "email@test.ch", true,
+							),
+						),
+					),
+					expectPush(
+						user.NewConsentGrantedEvent(mockCtx, &user.NewAggregate("userID", "org1").Aggregate,
+							"clientID", []string{"openid"},
+						),
+						authrequest.NewSessionLinkedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate,
+							"sessionID",
+							"userID",
+							testNow,
+							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+							nil,
+						),
+					),
+				),
+				tokenVerifier: newMockTokenVerifierValid(),
+			},
+			args{
+				ctx:          mockCtx,
+				id:           "V2_id",
+				sessionID:    "sessionID",
+				sessionToken: "token",
+				grantConsent: true,
+			},
+			res{
+				details: &domain.ObjectDetails{ResourceOwner: "instanceID"},
+				authReq: &CurrentAuthRequest{
+					AuthRequest: &AuthRequest{
+						ID:              "V2_id",
+						LoginClient:     "loginClient",
+						ClientID:        "clientID",
+						RedirectURI:     "redirectURI",
+						State:           "state",
+						Nonce:           "nonce",
+						Scope:           []string{"openid"},
+						Audience:        []string{"audience"},
+						ResponseType:    domain.OIDCResponseTypeCode,
+						ResponseMode:    domain.OIDCResponseModeQuery,
+						Issuer:          "issuer",
+						ConsentRequired: true,
+					},
+					SessionID:   "sessionID",
+					UserID:      "userID",
+					AuthMethods: []domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+				},
+			},
+		},
+		{
+			"consent required, already given",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							authrequest.NewAddedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate,
+								"loginClient",
+								"clientID",
+								"redirectURI",
+								"state",
+								"nonce",
+								[]string{"openid"},
+								[]string{"audience"},
+								domain.OIDCResponseTypeCode,
+								domain.OIDCResponseModeQuery,
+								nil,
+								nil,
+								nil,
+								nil,
+								nil,
+								nil,
+								true,
+								"issuer",
+								"",
+								nil,
+								true,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(mockCtx,
+								&session.NewAggregate("sessionID", "instance1").Aggregate,
+								&domain.UserAgent{
+									FingerprintID: gu.Ptr("fp1"),
+									IP:            net.ParseIP("1.2.3.4"),
+									Description:   gu.Ptr("firefox"),
+									Header:        http.Header{"foo": []string{"bar"}},
+								},
+							)),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								"userID", "org1", testNow, &language.Afrikaans),
+						),
+						eventFromEventPusher(
+							session.NewPasswordCheckedEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								testNow),
+						),
+						eventFromEventPusherWithCreationDateNow(
+							session.NewLifetimeSetEvent(mockCtx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+								2*time.Minute),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(mockCtx, &user.NewAggregate("userID", "org1").Aggregate,
+								"username", "firstname", "lastname", "nickname", "displayname",
+								language.English, domain.GenderUnspecified, "email@test.ch", true,
+							),
+						),
+						eventFromEventPusher(
+							user.NewConsentGrantedEvent(mockCtx, &user.NewAggregate("userID", "org1").Aggregate,
+								"clientID", []string{"openid", "profile"},
+							),
+						),
+					),
+					expectPush(
+						authrequest.NewSessionLinkedEvent(mockCtx, &authrequest.NewAggregate("V2_id", "instanceID").Aggregate,
+							"sessionID",
+							"userID",
+							testNow,
+							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+							nil,
+						),
+					),
+				),
+				tokenVerifier: newMockTokenVerifierValid(),
+			},
+			args{
+				ctx:          mockCtx,
+				id:           "V2_id",
+				sessionID:    "sessionID",
+				sessionToken: "token",
+			},
+			res{
+				details: &domain.ObjectDetails{ResourceOwner: "instanceID"},
+				authReq: &CurrentAuthRequest{
+					AuthRequest: &AuthRequest{
+						ID:              "V2_id",
+						LoginClient:     "loginClient",
+						ClientID:        "clientID",
+						RedirectURI:     "redirectURI",
+						State:           "state",
+						Nonce:           "nonce",
+						Scope:           []string{"openid"},
+						Audience:        []string{"audience"},
+						ResponseType:    domain.OIDCResponseTypeCode,
+						ResponseMode:    domain.OIDCResponseModeQuery,
+						Issuer:          "issuer",
+						ConsentRequired: true,
+					},
+					SessionID:   "sessionID",
+					UserID:      "userID",
+					AuthMethods: []domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+				},
+			},
+		},
 		{
 			"linked with organization check",
 			fields{
@@ -645,6 +1135,8 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								true,
 								"issuer",
 								"org1",
+								nil,
+								false,
 							),
 						),
 					),
@@ -678,6 +1170,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 							"userID",
 							testNow,
 							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+							nil,
 						),
 					),
 				),
@@ -737,6 +1230,8 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								true,
 								"issuer",
 								"",
+								nil,
+								false,
 							),
 						),
 					),
@@ -770,6 +1265,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 							"userID",
 							testNow,
 							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+							nil,
 						),
 					),
 				),
@@ -829,6 +1325,8 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								true,
 								"issuer",
 								"",
+								nil,
+								false,
 							),
 						),
 					),
@@ -862,6 +1360,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 							"userID",
 							testNow,
 							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+							nil,
 						),
 					),
 				),
@@ -922,6 +1421,8 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								true,
 								"issuer",
 								"",
+								nil,
+								false,
 							),
 						),
 					),
@@ -955,6 +1456,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 							"userID",
 							testNow,
 							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+							nil,
 						),
 					),
 				),
@@ -1016,6 +1518,8 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								true,
 								"issuer",
 								"",
+								nil,
+								false,
 							),
 						),
 					),
@@ -1067,7 +1571,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 				sessionTokenVerifier: tt.fields.tokenVerifier,
 				checkPermission:      tt.fields.checkPermission,
 			}
-			details, got, err := c.LinkSessionToAuthRequest(tt.args.ctx, tt.args.id, tt.args.sessionID, tt.args.sessionToken, tt.args.checkLoginClient, tt.args.permissionCheck)
+			details, got, err := c.LinkSessionToAuthRequest(tt.args.ctx, tt.args.id, tt.args.sessionID, tt.args.sessionToken, tt.args.checkLoginClient, tt.args.permissionCheck, tt.args.grantConsent, tt.args.authorizationDetails)
 			require.ErrorIs(t, err, tt.res.wantErr)
 			assertObjectDetails(t, tt.res.details, details)
 			if err == nil {
@@ -1142,6 +1646,8 @@ func TestCommands_FailAuthRequest(t *testing.T) {
 								true,
 								"issuer",
 								"",
+								nil,
+								false,
 							),
 						),
 					),
@@ -1182,6 +1688,8 @@ func TestCommands_FailAuthRequest(t *testing.T) {
 								true,
 								"issuer",
 								"",
+								nil,
+								false,
 							),
 						),
 					),
@@ -1287,6 +1795,8 @@ func TestCommands_AddAuthRequestCode(t *testing.T) {
 								true,
 								"issuer",
 								"",
+								nil,
+								false,
 							),
 						),
 					),
@@ -1327,6 +1837,8 @@ func TestCommands_AddAuthRequestCode(t *testing.T) {
 								true,
 								"issuer",
 								"",
+								nil,
+								false,
 							),
 						),
 						eventFromEventPusher(
@@ -1335,6 +1847,7 @@ func TestCommands_AddAuthRequestCode(t *testing.T) {
 								"userID",
 								testNow,
 								[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+								nil,
 							),
 						),
 					),
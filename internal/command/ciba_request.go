@@ -0,0 +1,229 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/cibarequest"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// CIBARequest is a backchannel authentication request of a client for a user (OpenID CIBA).
+// The user is already identified by the client's hint and approves or denies the request
+// on their own device, while the client polls the token endpoint or waits to be notified.
+type CIBARequest struct {
+	ClientID         string
+	UserID           string
+	UserOrgID        string
+	Scopes           []string
+	Audience         []string
+	BindingMessage   string
+	Expires          time.Time
+	NeedRefreshToken bool
+	// NotificationURI and NotificationToken are set for clients using the ping mode.
+	// The client is notified with the token as bearer once the request was approved or denied.
+	NotificationURI   string
+	NotificationToken string
+}
+
+// AddCIBARequest creates a new backchannel authentication request.
+// The returned details contain the ID of the request.
+func (c *Commands) AddCIBARequest(ctx context.Context, request *CIBARequest) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	var notificationToken *crypto.CryptoValue
+	if request.NotificationToken != "" {
+		notificationToken, err = crypto.Encrypt([]byte(request.NotificationToken), c.userEncryption)
+		if err != nil {
+			return nil, err
+		}
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	model := NewCIBARequestWriteModel(id, authz.GetInstance(ctx).InstanceID())
+	return c.pushAppendAndReduceDetails(ctx, model, cibarequest.NewAddedEvent(
+		ctx,
+		model.aggregate,
+		request.ClientID,
+		request.UserID,
+		request.UserOrgID,
+		request.Scopes,
+		request.Audience,
+		request.BindingMessage,
+		request.Expires,
+		request.NeedRefreshToken,
+		request.NotificationURI,
+		notificationToken,
+	))
+}
+
+// ApproveCIBARequestWithSession approves the backchannel authentication request
+// with the session of the user the request was made for.
+func (c *Commands) ApproveCIBARequestWithSession(ctx context.Context, id, sessionID, sessionToken string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	model, err := c.getInitiatedCIBARequestWriteModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkPermission(ctx, domain.PermissionSessionLink, model.ResourceOwner, ""); err != nil {
+		return nil, err
+	}
+
+	sessionWriteModel := NewSessionWriteModel(sessionID, authz.GetInstance(ctx).InstanceID())
+	err = c.eventstore.FilterToQueryReducer(ctx, sessionWriteModel)
+	if err != nil {
+		return nil, err
+	}
+	if err = sessionWriteModel.CheckIsActive(); err != nil {
+		return nil, err
+	}
+	if err := c.sessionTokenVerifier(ctx, sessionToken, sessionWriteModel.AggregateID, sessionWriteModel.TokenID); err != nil {
+		return nil, err
+	}
+	if sessionWriteModel.UserID != model.UserID {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ciu4a", "Errors.CIBARequest.OtherUser")
+	}
+
+	return c.pushAppendAndReduceDetails(ctx, model, cibarequest.NewApprovedEvent(
+		ctx,
+		model.aggregate,
+		sessionWriteModel.AuthMethodTypes(),
+		sessionWriteModel.AuthenticationTime(),
+		sessionWriteModel.PreferredLanguage,
+		sessionWriteModel.UserAgent,
+		sessionID,
+	))
+}
+
+// DenyCIBARequest denies the backchannel authentication request.
+func (c *Commands) DenyCIBARequest(ctx context.Context, id string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	model, err := c.getInitiatedCIBARequestWriteModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkPermission(ctx, domain.PermissionSessionLink, model.ResourceOwner, ""); err != nil {
+		return nil, err
+	}
+	return c.pushAppendAndReduceDetails(ctx, model, cibarequest.NewCanceledEvent(ctx, model.aggregate, domain.CIBARequestCanceledDenied))
+}
+
+// getInitiatedCIBARequestWriteModel returns the backchannel authentication request,
+// if it's still waiting for the decision of the user.
+func (c *Commands) getInitiatedCIBARequestWriteModel(ctx context.Context, id string) (*CIBARequestWriteModel, error) {
+	model, err := c.getCIBARequestWriteModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !model.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ciu1n", "Errors.CIBARequest.NotFound")
+	}
+	if model.State != domain.CIBARequestStateInitiated {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ciu2h", "Errors.CIBARequest.AlreadyHandled")
+	}
+	if model.Expires.Before(time.Now()) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ciu3e", "Errors.CIBARequest.Expired")
+	}
+	return model, nil
+}
+
+func (c *Commands) getCIBARequestWriteModel(ctx context.Context, id string) (*CIBARequestWriteModel, error) {
+	model := NewCIBARequestWriteModel(id, authz.GetInstance(ctx).InstanceID())
+	err := c.eventstore.FilterToQueryReducer(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+	return model, nil
+}
+
+type CIBARequestStateError domain.CIBARequestState
+
+func (e CIBARequestStateError) Error() string {
+	return fmt.Sprintf("ciba request state not approved: %s", domain.CIBARequestState(e).String())
+}
+
+// CreateOIDCSessionFromCIBARequest creates a new OIDC session if the backchannel authentication request
+// was approved by the user.
+// A [CIBARequestStateError] is returned if the request was not approved,
+// containing a [domain.CIBARequestState] which can be used to inform the client about the state.
+//
+// As with the device authorization, an explicit state takes precedence over expiry.
+// If a token binding is passed, the tokens are bound to the key of the DPoP proof or the client certificate.
+func (c *Commands) CreateOIDCSessionFromCIBARequest(ctx context.Context, id, backChannelLogoutURI, clientID string, binding *TokenBinding) (_ *OIDCSession, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	model, err := c.getCIBARequestWriteModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if model.State.Exists() && model.ClientID != clientID {
+		return nil, oidc.ErrInvalidClient().WithDescription("client_id does not correspond to the client_id in the authentication request")
+	}
+
+	switch model.State {
+	case domain.CIBARequestStateApproved:
+		break
+	case domain.CIBARequestStateUndefined:
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ciu5n", "Errors.CIBARequest.NotFound")
+	case domain.CIBARequestStateInitiated:
+		if model.Expires.Before(time.Now()) {
+			c.asyncPush(ctx, cibarequest.NewCanceledEvent(ctx, model.aggregate, domain.CIBARequestCanceledExpired))
+			return nil, CIBARequestStateError(domain.CIBARequestStateExpired)
+		}
+		fallthrough
+	case domain.CIBARequestStateDenied, domain.CIBARequestStateExpired, domain.CIBARequestStateDone:
+		fallthrough
+	default:
+		return nil, CIBARequestStateError(model.State)
+	}
+
+	cmd, err := c.newOIDCSessionAddEvents(ctx, model.UserID, model.UserOrgID)
+	if err != nil {
+		return nil, err
+	}
+	cmd.AddSession(ctx,
+		model.UserID,
+		model.UserOrgID,
+		model.SessionID,
+		model.ClientID,
+		model.Audience,
+		model.Scopes,
+		model.UserAuthMethods,
+		model.AuthTime,
+		"",
+		model.PreferredLanguage,
+		model.UserAgent,
+		nil,
+	)
+	cmd.RegisterLogout(ctx, model.SessionID, model.UserID, model.ClientID, backChannelLogoutURI)
+	if err = cmd.AddAccessToken(ctx, model.Scopes, model.UserID, model.UserOrgID, domain.TokenReasonAuthRequest, nil, binding, nil); err != nil {
+		return nil, err
+	}
+	if model.NeedRefreshToken {
+		if err = cmd.AddRefreshToken(ctx, model.UserID, binding.refreshTokenJKT()); err != nil {
+			return nil, err
+		}
+	}
+	cmd.CIBARequestDone(ctx, model.aggregate)
+	return cmd.PushEvents(ctx)
+}
+
+func (cmd *OIDCSessionEvents) CIBARequestDone(ctx context.Context, cibaRequestAggregate *eventstore.Aggregate) {
+	cmd.events = append(cmd.events, cibarequest.NewDoneEvent(ctx, cibaRequestAggregate))
+}
@@ -0,0 +1,95 @@
+package command
+
+import (
+	"time"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/cibarequest"
+)
+
+type CIBARequestWriteModel struct {
+	eventstore.WriteModel
+	aggregate *eventstore.Aggregate
+
+	ClientID          string
+	UserID            string
+	UserOrgID         string
+	Scopes            []string
+	Audience          []string
+	BindingMessage    string
+	Expires           time.Time
+	NeedRefreshToken  bool
+	NotificationURI   string
+	NotificationToken *crypto.CryptoValue
+	State             domain.CIBARequestState
+	UserAuthMethods   []domain.UserAuthMethodType
+	AuthTime          time.Time
+	PreferredLanguage *language.Tag
+	UserAgent         *domain.UserAgent
+	SessionID         string
+}
+
+func NewCIBARequestWriteModel(id, resourceOwner string) *CIBARequestWriteModel {
+	return &CIBARequestWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			ResourceOwner: resourceOwner,
+		},
+		aggregate: cibarequest.NewAggregate(id, resourceOwner),
+	}
+}
+
+func (m *CIBARequestWriteModel) GetWriteModel() *eventstore.WriteModel {
+	return &m.WriteModel
+}
+
+func (m *CIBARequestWriteModel) Reduce() error {
+	for _, event := range m.Events {
+		switch e := event.(type) {
+		case *cibarequest.AddedEvent:
+			m.ClientID = e.ClientID
+			m.UserID = e.UserID
+			m.UserOrgID = e.UserOrgID
+			m.Scopes = e.Scopes
+			m.Audience = e.Audience
+			m.BindingMessage = e.BindingMessage
+			m.Expires = e.Expires
+			m.NeedRefreshToken = e.NeedRefreshToken
+			m.NotificationURI = e.NotificationURI
+			m.NotificationToken = e.NotificationToken
+			m.State = domain.CIBARequestStateInitiated
+		case *cibarequest.ApprovedEvent:
+			m.State = domain.CIBARequestStateApproved
+			m.UserAuthMethods = e.UserAuthMethods
+			m.AuthTime = e.AuthTime
+			m.PreferredLanguage = e.PreferredLanguage
+			m.UserAgent = e.UserAgent
+			m.SessionID = e.SessionID
+		case *cibarequest.CanceledEvent:
+			m.State = e.Reason.State()
+		case *cibarequest.DoneEvent:
+			m.State = domain.CIBARequestStateDone
+		}
+	}
+
+	return m.WriteModel.Reduce()
+}
+
+func (m *CIBARequestWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(m.ResourceOwner).
+		AddQuery().
+		AggregateTypes(cibarequest.AggregateType).
+		AggregateIDs(m.AggregateID).
+		EventTypes(
+			cibarequest.AddedEventType,
+			cibarequest.ApprovedEventType,
+			cibarequest.CanceledEventType,
+			cibarequest.DoneEventType,
+		).
+		Builder()
+}
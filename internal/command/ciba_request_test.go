@@ -0,0 +1,454 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/cibarequest"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_AddCIBARequest(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	pushErr := errors.New("pushErr")
+	expires := time.Now().Add(time.Minute)
+
+	type fields struct {
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	tests := []struct {
+		name        string
+		fields      fields
+		request     *CIBARequest
+		wantDetails *domain.ObjectDetails
+		wantErr     error
+	}{
+		{
+			name: "poll mode, ok",
+			fields: fields{
+				eventstore: expectEventstore(expectPush(
+					cibarequest.NewAddedEvent(
+						ctx,
+						cibarequest.NewAggregate("request1", "instance1"),
+						"clientID", "userID", "orgID",
+						[]string{"openid"}, []string{"projectID", "clientID"},
+						"binding", expires, false, "", nil,
+					),
+				)),
+				idGenerator: mock.ExpectID(t, "request1"),
+			},
+			request: &CIBARequest{
+				ClientID:       "clientID",
+				UserID:         "userID",
+				UserOrgID:      "orgID",
+				Scopes:         []string{"openid"},
+				Audience:       []string{"projectID", "clientID"},
+				BindingMessage: "binding",
+				Expires:        expires,
+			},
+			wantDetails: &domain.ObjectDetails{
+				ResourceOwner: "instance1",
+				ID:            "request1",
+			},
+		},
+		{
+			name: "ping mode, ok",
+			fields: fields{
+				eventstore: expectEventstore(expectPush(
+					cibarequest.NewAddedEvent(
+						ctx,
+						cibarequest.NewAggregate("request1", "instance1"),
+						"clientID", "userID", "orgID",
+						[]string{"openid", "offline_access"}, []string{"projectID", "clientID"},
+						"", expires, true, "https://client.example.com/ciba",
+						&crypto.CryptoValue{
+							CryptoType: crypto.TypeEncryption,
+							Algorithm:  "enc",
+							KeyID:      "id",
+							Crypted:    []byte("notificationToken"),
+						},
+					),
+				)),
+				idGenerator: mock.ExpectID(t, "request1"),
+			},
+			request: &CIBARequest{
+				ClientID:          "clientID",
+				UserID:            "userID",
+				UserOrgID:         "orgID",
+				Scopes:            []string{"openid", "offline_access"},
+				Audience:          []string{"projectID", "clientID"},
+				Expires:           expires,
+				NeedRefreshToken:  true,
+				NotificationURI:   "https://client.example.com/ciba",
+				NotificationToken: "notificationToken",
+			},
+			wantDetails: &domain.ObjectDetails{
+				ResourceOwner: "instance1",
+				ID:            "request1",
+			},
+		},
+		{
+			name: "push error",
+			fields: fields{
+				eventstore: expectEventstore(expectPushFailed(pushErr,
+					cibarequest.NewAddedEvent(
+						ctx,
+						cibarequest.NewAggregate("request1", "instance1"),
+						"clientID", "userID", "orgID",
+						[]string{"openid"}, []string{"projectID", "clientID"},
+						"", expires, false, "", nil,
+					),
+				)),
+				idGenerator: mock.ExpectID(t, "request1"),
+			},
+			request: &CIBARequest{
+				ClientID:  "clientID",
+				UserID:    "userID",
+				UserOrgID: "orgID",
+				Scopes:    []string{"openid"},
+				Audience:  []string{"projectID", "clientID"},
+				Expires:   expires,
+			},
+			wantErr: pushErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:     tt.fields.eventstore(t),
+				idGenerator:    tt.fields.idGenerator,
+				userEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			}
+			gotDetails, err := c.AddCIBARequest(ctx, tt.request)
+			require.ErrorIs(t, err, tt.wantErr)
+			assertObjectDetails(t, tt.wantDetails, gotDetails)
+		})
+	}
+}
+
+func TestCommands_ApproveCIBARequestWithSession(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	expires := time.Now().Add(time.Minute)
+	userAgent := &domain.UserAgent{
+		FingerprintID: gu.Ptr("fp1"),
+		IP:            net.ParseIP("1.2.3.4"),
+		Description:   gu.Ptr("firefox"),
+		Header:        http.Header{"foo": []string{"bar"}},
+	}
+	requestAdded := func(expires time.Time) eventstore.Event {
+		return eventFromEventPusherWithInstanceID(
+			"instance1",
+			cibarequest.NewAddedEvent(
+				ctx,
+				cibarequest.NewAggregate("request1", "instance1"),
+				"clientID", "userID", "orgID",
+				[]string{"openid"}, []string{"projectID", "clientID"},
+				"", expires, false, "", nil,
+			),
+		)
+	}
+	sessionEvents := func(userID string) []eventstore.Event {
+		return []eventstore.Event{
+			eventFromEventPusher(
+				session.NewAddedEvent(ctx, &session.NewAggregate("sessionID", "instance1").Aggregate, userAgent),
+			),
+			eventFromEventPusher(
+				session.NewUserCheckedEvent(ctx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+					userID, "orgID", testNow, &language.Afrikaans),
+			),
+			eventFromEventPusher(
+				session.NewPasswordCheckedEvent(ctx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+					testNow),
+			),
+			eventFromEventPusherWithCreationDateNow(
+				session.NewLifetimeSetEvent(ctx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+					2*time.Minute),
+			),
+		}
+	}
+
+	type fields struct {
+		eventstore      func(*testing.T) *eventstore.Eventstore
+		tokenVerifier   func(ctx context.Context, sessionToken, sessionID, tokenID string) (err error)
+		checkPermission domain.PermissionCheck
+	}
+	tests := []struct {
+		name        string
+		fields      fields
+		wantDetails *domain.ObjectDetails
+		wantErr     error
+	}{
+		{
+			name: "not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Ciu1n", "Errors.CIBARequest.NotFound"),
+		},
+		{
+			name: "already handled error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						requestAdded(expires),
+						eventFromEventPusherWithInstanceID(
+							"instance1",
+							cibarequest.NewCanceledEvent(ctx, cibarequest.NewAggregate("request1", "instance1"), domain.CIBARequestCanceledDenied),
+						),
+					),
+				),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ciu2h", "Errors.CIBARequest.AlreadyHandled"),
+		},
+		{
+			name: "expired error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(requestAdded(time.Now().Add(-time.Minute))),
+				),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ciu3e", "Errors.CIBARequest.Expired"),
+		},
+		{
+			name: "missing permission, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(requestAdded(expires)),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+		},
+		{
+			name: "invalid session token, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(requestAdded(expires)),
+					expectFilter(sessionEvents("userID")...),
+				),
+				tokenVerifier:   newMockTokenVerifierInvalid(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "COMMAND-sGr42", "Errors.Session.Token.Invalid"),
+		},
+		{
+			name: "other user, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(requestAdded(expires)),
+					expectFilter(sessionEvents("otherUserID")...),
+				),
+				tokenVerifier:   newMockTokenVerifierValid(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ciu4a", "Errors.CIBARequest.OtherUser"),
+		},
+		{
+			name: "approved",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(requestAdded(expires)),
+					expectFilter(sessionEvents("userID")...),
+					expectPush(
+						cibarequest.NewApprovedEvent(
+							ctx, cibarequest.NewAggregate("request1", "instance1"),
+							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+							testNow, &language.Afrikaans, userAgent, "sessionID",
+						),
+					),
+				),
+				tokenVerifier:   newMockTokenVerifierValid(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			wantDetails: &domain.ObjectDetails{
+				ResourceOwner: "instance1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:           tt.fields.eventstore(t),
+				sessionTokenVerifier: tt.fields.tokenVerifier,
+				checkPermission:      tt.fields.checkPermission,
+			}
+			gotDetails, err := c.ApproveCIBARequestWithSession(ctx, "request1", "sessionID", "sessionToken")
+			require.ErrorIs(t, err, tt.wantErr)
+			assertObjectDetails(t, tt.wantDetails, gotDetails)
+		})
+	}
+}
+
+func TestCommands_DenyCIBARequest(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	requestAdded := eventFromEventPusherWithInstanceID(
+		"instance1",
+		cibarequest.NewAddedEvent(
+			ctx,
+			cibarequest.NewAggregate("request1", "instance1"),
+			"clientID", "userID", "orgID",
+			[]string{"openid"}, []string{"projectID", "clientID"},
+			"", time.Now().Add(time.Minute), false, "", nil,
+		),
+	)
+
+	type fields struct {
+		eventstore      func(*testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	tests := []struct {
+		name        string
+		fields      fields
+		wantDetails *domain.ObjectDetails
+		wantErr     error
+	}{
+		{
+			name: "filter error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilterError(io.ErrClosedPipe),
+				),
+			},
+			wantErr: io.ErrClosedPipe,
+		},
+		{
+			name: "missing permission, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(requestAdded),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+		},
+		{
+			name: "denied",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(requestAdded),
+					expectPush(
+						cibarequest.NewCanceledEvent(ctx, cibarequest.NewAggregate("request1", "instance1"), domain.CIBARequestCanceledDenied),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			wantDetails: &domain.ObjectDetails{
+				ResourceOwner: "instance1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			gotDetails, err := c.DenyCIBARequest(ctx, "request1")
+			require.ErrorIs(t, err, tt.wantErr)
+			assertObjectDetails(t, tt.wantDetails, gotDetails)
+		})
+	}
+}
+
+func TestCommands_CreateOIDCSessionFromCIBARequest(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	requestAdded := func(expires time.Time) eventstore.Event {
+		return eventFromEventPusherWithInstanceID(
+			"instance1",
+			cibarequest.NewAddedEvent(
+				ctx,
+				cibarequest.NewAggregate("request1", "instance1"),
+				"clientID", "userID", "orgID",
+				[]string{"openid"}, []string{"projectID", "clientID"},
+				"", expires, false, "", nil,
+			),
+		)
+	}
+
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		clientID   string
+		wantErr    error
+	}{
+		{
+			name: "filter error",
+			eventstore: expectEventstore(
+				expectFilterError(io.ErrClosedPipe),
+			),
+			clientID: "clientID",
+			wantErr:  io.ErrClosedPipe,
+		},
+		{
+			name: "not found",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			clientID: "clientID",
+			wantErr:  zerrors.ThrowNotFound(nil, "COMMAND-Ciu5n", "Errors.CIBARequest.NotFound"),
+		},
+		{
+			name: "pending",
+			eventstore: expectEventstore(
+				expectFilter(requestAdded(time.Now().Add(time.Minute))),
+			),
+			clientID: "clientID",
+			wantErr:  CIBARequestStateError(domain.CIBARequestStateInitiated),
+		},
+		{
+			name: "expired",
+			eventstore: expectEventstore(
+				expectFilter(requestAdded(time.Now().Add(-time.Minute))),
+				expectPush(
+					cibarequest.NewCanceledEvent(ctx, cibarequest.NewAggregate("request1", "instance1"), domain.CIBARequestCanceledExpired),
+				),
+			),
+			clientID: "clientID",
+			wantErr:  CIBARequestStateError(domain.CIBARequestStateExpired),
+		},
+		{
+			name: "denied",
+			eventstore: expectEventstore(
+				expectFilter(
+					requestAdded(time.Now().Add(time.Minute)),
+					eventFromEventPusherWithInstanceID(
+						"instance1",
+						cibarequest.NewCanceledEvent(ctx, cibarequest.NewAggregate("request1", "instance1"), domain.CIBARequestCanceledDenied),
+					),
+				),
+			),
+			clientID: "clientID",
+			wantErr:  CIBARequestStateError(domain.CIBARequestStateDenied),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			_, err := c.CreateOIDCSessionFromCIBARequest(ctx, "request1", "", tt.clientID, nil)
+			c.jobs.Wait()
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -75,6 +75,7 @@ type Commands struct {
 	defaultRefreshTokenIdleLifetime time.Duration
 	phoneCodeVerifier               func(ctx context.Context, id string) (senders.CodeGenerator, error)
 	tarpit                          func(failedAttempts uint64)
+	breachedPasswordChecker         BreachedPasswordChecker
 
 	multifactors            domain.MultifactorConfigs
 	webauthnConfig          *webauthn_helper.Config
@@ -111,6 +112,11 @@ type Commands struct {
 	denyList         []denylist.AddressChecker
 }
 
+// BreachedPasswordChecker checks if a password is known to be breached.
+type BreachedPasswordChecker interface {
+	IsBreached(ctx context.Context, password string) (bool, error)
+}
+
 //go:generate mockgen -package command -destination ./mock_login_paths.go . LoginPaths
 type LoginPaths interface {
 	DefaultEmailCodeURLTemplate(ctx context.Context) string
@@ -243,6 +249,13 @@ func StartCommands(
 	}
 	repo.phoneCodeVerifier = repo.phoneCodeVerifierFromConfig
 	repo.tarpit = defaults.Tarpit.Tarpit()
+	breachedPasswordChecker, err := defaults.BreachedPasswords.NewChecker(httpClient)
+	if err != nil {
+		return nil, err
+	}
+	if breachedPasswordChecker != nil {
+		repo.breachedPasswordChecker = breachedPasswordChecker
+	}
 	return repo, nil
 }
 
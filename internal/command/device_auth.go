@@ -185,7 +185,8 @@ func (e DeviceAuthStateError) Error() string {
 // As devices can poll at various intervals, an explicit state takes precedence over expiry.
 // This is to prevent cases where users might approve or deny the authorization on time, but the next poll
 // happens after expiry.
-func (c *Commands) CreateOIDCSessionFromDeviceAuth(ctx context.Context, deviceCode, backChannelLogoutURI, clientID string) (_ *OIDCSession, err error) {
+// If a token binding is passed, the tokens are bound to the key of the DPoP proof or the client certificate.
+func (c *Commands) CreateOIDCSessionFromDeviceAuth(ctx context.Context, deviceCode, backChannelLogoutURI, clientID string, binding *TokenBinding) (_ *OIDCSession, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
@@ -233,14 +234,15 @@ func (c *Commands) CreateOIDCSessionFromDeviceAuth(ctx context.Context, deviceCo
 		"",
 		deviceAuthModel.PreferredLanguage,
 		deviceAuthModel.UserAgent,
+		nil,
 	)
 	cmd.RegisterLogout(ctx, deviceAuthModel.SessionID, deviceAuthModel.UserID, deviceAuthModel.ClientID, backChannelLogoutURI)
-	if err = cmd.AddAccessToken(ctx, deviceAuthModel.Scopes, deviceAuthModel.UserID, deviceAuthModel.UserOrgID, domain.TokenReasonAuthRequest, nil); err != nil {
+	if err = cmd.AddAccessToken(ctx, deviceAuthModel.Scopes, deviceAuthModel.UserID, deviceAuthModel.UserOrgID, domain.TokenReasonAuthRequest, nil, binding, nil); err != nil {
 		return nil, err
 	}
 
 	if deviceAuthModel.NeedRefreshToken {
-		if err = cmd.AddRefreshToken(ctx, deviceAuthModel.UserID); err != nil {
+		if err = cmd.AddRefreshToken(ctx, deviceAuthModel.UserID, binding.refreshTokenJKT()); err != nil {
 			return nil, err
 		}
 	}
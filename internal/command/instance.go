@@ -53,11 +53,12 @@ type InstanceSetup struct {
 		}
 	}
 	PasswordComplexityPolicy struct {
-		MinLength    uint64
-		HasLowercase bool
-		HasUppercase bool
-		HasNumber    bool
-		HasSymbol    bool
+		MinLength     uint64
+		HasLowercase  bool
+		HasUppercase  bool
+		HasNumber     bool
+		HasSymbol     bool
+		CheckBreached bool
 	}
 	PasswordAgePolicy struct {
 		ExpireWarnDays uint64
@@ -117,6 +118,8 @@ type InstanceSetup struct {
 		MaxPasswordAttempts      uint64
 		MaxOTPAttempts           uint64
 		ShouldShowLockoutFailure bool
+		LockoutDuration          time.Duration
+		FailedAttemptDelay       time.Duration
 	}
 	EmailTemplate          []byte
 	MessageTexts           []*domain.CustomMessageText
@@ -351,6 +354,7 @@ func setupInstanceElements(instanceAgg *instance.Aggregate, setup *InstanceSetup
 			setup.PasswordComplexityPolicy.HasUppercase,
 			setup.PasswordComplexityPolicy.HasNumber,
 			setup.PasswordComplexityPolicy.HasSymbol,
+			setup.PasswordComplexityPolicy.CheckBreached,
 		),
 		prepareAddDefaultPasswordAgePolicy(
 			instanceAgg,
@@ -393,7 +397,7 @@ func setupInstanceElements(instanceAgg *instance.Aggregate, setup *InstanceSetup
 
 		prepareAddDefaultPrivacyPolicy(instanceAgg, setup.PrivacyPolicy.TOSLink, setup.PrivacyPolicy.PrivacyLink, setup.PrivacyPolicy.HelpLink, setup.PrivacyPolicy.SupportEmail, setup.PrivacyPolicy.DocsLink, setup.PrivacyPolicy.CustomLink, setup.PrivacyPolicy.CustomLinkText),
 		prepareAddDefaultNotificationPolicy(instanceAgg, setup.NotificationPolicy.PasswordChange),
-		prepareAddDefaultLockoutPolicy(instanceAgg, setup.LockoutPolicy.MaxPasswordAttempts, setup.LockoutPolicy.MaxOTPAttempts, setup.LockoutPolicy.ShouldShowLockoutFailure),
+		prepareAddDefaultLockoutPolicy(instanceAgg, setup.LockoutPolicy.MaxPasswordAttempts, setup.LockoutPolicy.MaxOTPAttempts, setup.LockoutPolicy.ShouldShowLockoutFailure, setup.LockoutPolicy.LockoutDuration, setup.LockoutPolicy.FailedAttemptDelay),
 
 		prepareAddDefaultLabelPolicy(
 			instanceAgg,
@@ -161,7 +161,7 @@ func TestCommandSide_AddInstanceDomain(t *testing.T) {
 								"",
 								"",
 								"",
-								nil),
+								nil, false, false, "", "", "", false),
 						),
 					),
 					expectPush(
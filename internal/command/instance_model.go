@@ -81,6 +81,16 @@ func (wm *InstanceWriteModel) Query() *eventstore.SearchQueryBuilder {
 		Builder()
 }
 
+// SnapshotType implements [eventstore.Snapshotter]
+func (wm *InstanceWriteModel) SnapshotType() string {
+	return "instance"
+}
+
+// SnapshotVersion implements [eventstore.Snapshotter]
+func (wm *InstanceWriteModel) SnapshotVersion() string {
+	return "v1"
+}
+
 func InstanceAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
 	return &eventstore.Aggregate{
 		ID:            wm.AggregateID,
@@ -67,6 +67,7 @@ func TestCommandSide_AddDefaultPasswordComplexityPolicy(t *testing.T) {
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								8,
 								true, true, true, true,
+								false,
 							),
 						),
 					),
@@ -95,6 +96,7 @@ func TestCommandSide_AddDefaultPasswordComplexityPolicy(t *testing.T) {
 							&instance.NewAggregate("INSTANCE").Aggregate,
 							8,
 							true, true, true, true,
+							false,
 						),
 					),
 				),
@@ -119,7 +121,7 @@ func TestCommandSide_AddDefaultPasswordComplexityPolicy(t *testing.T) {
 			r := &Commands{
 				eventstore: tt.fields.eventstore,
 			}
-			got, err := r.AddDefaultPasswordComplexityPolicy(tt.args.ctx, tt.args.minLength, tt.args.hasLowercase, tt.args.hasUppercase, tt.args.hasNumber, tt.args.hasSymbol)
+			got, err := r.AddDefaultPasswordComplexityPolicy(tt.args.ctx, tt.args.minLength, tt.args.hasLowercase, tt.args.hasUppercase, tt.args.hasNumber, tt.args.hasSymbol, false)
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
@@ -205,6 +207,7 @@ func TestCommandSide_ChangeDefaultPasswordComplexityPolicy(t *testing.T) {
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								8,
 								true, true, true, true,
+								false,
 							),
 						),
 					),
@@ -235,6 +238,7 @@ func TestCommandSide_ChangeDefaultPasswordComplexityPolicy(t *testing.T) {
 								&instance.NewAggregate("INSTANCE").Aggregate,
 								8,
 								true, true, true, true,
+								false,
 							),
 						),
 					),
@@ -18,6 +18,10 @@ type IAMSMTPConfigWriteModel struct {
 
 	SMTPConfig *SMTPConfig
 	HTTPConfig *HTTPConfig
+	APIConfig  *EmailAPIConfig
+
+	// FeedbackKey authenticates the provider, when reporting bounces and complaints.
+	FeedbackKey *crypto.CryptoValue
 
 	State domain.SMTPConfigState
 
@@ -37,6 +41,17 @@ type SMTPConfig struct {
 	XOAuth2Auth    *instance.XOAuth2Auth
 }
 
+type EmailAPIConfig struct {
+	Provider       domain.EmailAPIProviderType
+	SenderAddress  string
+	SenderName     string
+	ReplyToAddress string
+	Region         string
+	Domain         string
+	AccessKeyID    string
+	APIKey         *crypto.CryptoValue
+}
+
 func NewIAMSMTPConfigWriteModel(instanceID, id, domain string) *IAMSMTPConfigWriteModel {
 	return &IAMSMTPConfigWriteModel{
 		WriteModel: eventstore.WriteModel{
@@ -93,6 +108,16 @@ func (wm *IAMSMTPConfigWriteModel) Reduce() error {
 					wm.SMTPConfig.PlainAuth.Password = e.Password
 				}
 			}
+		case *instance.SMTPConfigFeedbackKeyGeneratedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.FeedbackKey = e.FeedbackKey
+		case *instance.SMTPConfigSecretsReEncryptedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.reduceSMTPConfigSecretsReEncryptedEvent(e)
 		case *instance.SMTPConfigHTTPAddedEvent:
 			if wm.ID != e.ID {
 				continue
@@ -103,6 +128,16 @@ func (wm *IAMSMTPConfigWriteModel) Reduce() error {
 				continue
 			}
 			wm.reduceSMTPConfigHTTPChangedEvent(e)
+		case *instance.SMTPConfigAPIAddedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.reduceSMTPConfigAPIAddedEvent(e)
+		case *instance.SMTPConfigAPIChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.reduceSMTPConfigAPIChangedEvent(e)
 		case *instance.SMTPConfigRemovedEvent:
 			if wm.ID != e.ID {
 				continue
@@ -151,8 +186,12 @@ func (wm *IAMSMTPConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
 			instance.SMTPConfigRemovedEventType,
 			instance.SMTPConfigChangedEventType,
 			instance.SMTPConfigPasswordChangedEventType,
+			instance.SMTPConfigFeedbackKeyGeneratedType,
+			instance.SMTPConfigSecretsReEncryptedType,
 			instance.SMTPConfigHTTPAddedEventType,
 			instance.SMTPConfigHTTPChangedEventType,
+			instance.SMTPConfigAPIAddedEventType,
+			instance.SMTPConfigAPIChangedEventType,
 			instance.SMTPConfigActivatedEventType,
 			instance.SMTPConfigDeactivatedEventType,
 			instance.SMTPConfigRemovedEventType,
@@ -329,6 +368,51 @@ func (wm *IAMSMTPConfigWriteModel) NewHTTPChangedEvent(
 	return changeEvent, true, nil
 }
 
+func (wm *IAMSMTPConfigWriteModel) NewAPIChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id, description, senderAddress, senderName, replyToAddress, region, sendingDomain, accessKeyID string,
+	apiKey *crypto.CryptoValue,
+) (*instance.SMTPConfigAPIChangedEvent, bool, error) {
+	if wm.APIConfig == nil {
+		return nil, false, nil
+	}
+	changes := make([]instance.SMTPConfigAPIChanges, 0)
+	if wm.Description != description {
+		changes = append(changes, instance.ChangeSMTPConfigAPIDescription(description))
+	}
+	if wm.APIConfig.SenderAddress != senderAddress {
+		changes = append(changes, instance.ChangeSMTPConfigAPISenderAddress(senderAddress))
+	}
+	if wm.APIConfig.SenderName != senderName {
+		changes = append(changes, instance.ChangeSMTPConfigAPISenderName(senderName))
+	}
+	if wm.APIConfig.ReplyToAddress != replyToAddress {
+		changes = append(changes, instance.ChangeSMTPConfigAPIReplyToAddress(replyToAddress))
+	}
+	if wm.APIConfig.Region != region {
+		changes = append(changes, instance.ChangeSMTPConfigAPIRegion(region))
+	}
+	if wm.APIConfig.Domain != sendingDomain {
+		changes = append(changes, instance.ChangeSMTPConfigAPIDomain(sendingDomain))
+	}
+	if wm.APIConfig.AccessKeyID != accessKeyID {
+		changes = append(changes, instance.ChangeSMTPConfigAPIAccessKeyID(accessKeyID))
+	}
+	// if the api key is set, update it as it is encrypted
+	if apiKey != nil {
+		changes = append(changes, instance.ChangeSMTPConfigAPIKey(apiKey))
+	}
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := instance.NewSMTPConfigAPIChangeEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
+
 func (wm *IAMSMTPConfigWriteModel) reduceSMTPConfigAddedEvent(e *instance.SMTPConfigAddedEvent) {
 	wm.Description = e.Description
 	wm.SMTPConfig = &SMTPConfig{
@@ -385,6 +469,51 @@ func (wm *IAMSMTPConfigWriteModel) reduceSMTPConfigHTTPAddedEvent(e *instance.SM
 	}
 }
 
+func (wm *IAMSMTPConfigWriteModel) reduceSMTPConfigAPIAddedEvent(e *instance.SMTPConfigAPIAddedEvent) {
+	wm.Description = e.Description
+	wm.APIConfig = &EmailAPIConfig{
+		Provider:       e.Provider,
+		SenderAddress:  e.SenderAddress,
+		SenderName:     e.SenderName,
+		ReplyToAddress: e.ReplyToAddress,
+		Region:         e.Region,
+		Domain:         e.Domain,
+		AccessKeyID:    e.AccessKeyID,
+		APIKey:         e.APIKey,
+	}
+	wm.State = domain.SMTPConfigStateInactive
+}
+
+func (wm *IAMSMTPConfigWriteModel) reduceSMTPConfigAPIChangedEvent(e *instance.SMTPConfigAPIChangedEvent) {
+	if wm.APIConfig == nil {
+		return
+	}
+	if e.Description != nil {
+		wm.Description = *e.Description
+	}
+	if e.SenderAddress != nil {
+		wm.APIConfig.SenderAddress = *e.SenderAddress
+	}
+	if e.SenderName != nil {
+		wm.APIConfig.SenderName = *e.SenderName
+	}
+	if e.ReplyToAddress != nil {
+		wm.APIConfig.ReplyToAddress = *e.ReplyToAddress
+	}
+	if e.Region != nil {
+		wm.APIConfig.Region = *e.Region
+	}
+	if e.Domain != nil {
+		wm.APIConfig.Domain = *e.Domain
+	}
+	if e.AccessKeyID != nil {
+		wm.APIConfig.AccessKeyID = *e.AccessKeyID
+	}
+	if e.APIKey != nil {
+		wm.APIConfig.APIKey = e.APIKey
+	}
+}
+
 func (wm *IAMSMTPConfigWriteModel) reduceSMTPConfigChangedEvent(e *instance.SMTPConfigChangedEvent) {
 	if wm.SMTPConfig == nil {
 		return
@@ -482,6 +611,8 @@ func (wm *IAMSMTPConfigWriteModel) reduceSMTPConfigRemovedEvent(e *instance.SMTP
 	wm.Description = ""
 	wm.HTTPConfig = nil
 	wm.SMTPConfig = nil
+	wm.APIConfig = nil
+	wm.FeedbackKey = nil
 	wm.State = domain.SMTPConfigStateRemoved
 
 	// If ID has empty value we're dealing with the old and unique smtp settings
@@ -490,3 +621,86 @@ func (wm *IAMSMTPConfigWriteModel) reduceSMTPConfigRemovedEvent(e *instance.SMTP
 		wm.ID = e.Aggregate().ResourceOwner
 	}
 }
+
+func (wm *IAMSMTPConfigWriteModel) reduceSMTPConfigSecretsReEncryptedEvent(e *instance.SMTPConfigSecretsReEncryptedEvent) {
+	if e.Password != nil && wm.SMTPConfig != nil && wm.SMTPConfig.PlainAuth != nil {
+		wm.SMTPConfig.PlainAuth.Password = e.Password
+	}
+	if e.ClientSecret != nil && wm.SMTPConfig != nil && wm.SMTPConfig.XOAuth2Auth != nil && wm.SMTPConfig.XOAuth2Auth.ClientCredentials != nil {
+		wm.SMTPConfig.XOAuth2Auth.ClientCredentials.ClientSecret = e.ClientSecret
+	}
+	if e.SigningKey != nil && wm.HTTPConfig != nil {
+		wm.HTTPConfig.SigningKey = e.SigningKey
+	}
+	if e.APIKey != nil && wm.APIConfig != nil {
+		wm.APIConfig.APIKey = e.APIKey
+	}
+	if e.FeedbackKey != nil {
+		wm.FeedbackKey = e.FeedbackKey
+	}
+}
+
+// IAMSMTPConfigIDsWriteModel collects the IDs of the existing smtp configs of the instance.
+type IAMSMTPConfigIDsWriteModel struct {
+	eventstore.WriteModel
+
+	IDs []string
+}
+
+func NewIAMSMTPConfigIDsWriteModel(instanceID string) *IAMSMTPConfigIDsWriteModel {
+	return &IAMSMTPConfigIDsWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   instanceID,
+			ResourceOwner: instanceID,
+			InstanceID:    instanceID,
+		},
+	}
+}
+
+func (wm *IAMSMTPConfigIDsWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *instance.SMTPConfigAddedEvent:
+			wm.add(e.ID, e.Aggregate())
+		case *instance.SMTPConfigHTTPAddedEvent:
+			wm.add(e.ID, e.Aggregate())
+		case *instance.SMTPConfigAPIAddedEvent:
+			wm.add(e.ID, e.Aggregate())
+		case *instance.SMTPConfigRemovedEvent:
+			id := smtpConfigID(e.ID, e.Aggregate())
+			wm.IDs = slices.DeleteFunc(wm.IDs, func(existing string) bool {
+				return existing == id
+			})
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *IAMSMTPConfigIDsWriteModel) add(id string, aggregate *eventstore.Aggregate) {
+	id = smtpConfigID(id, aggregate)
+	if !slices.Contains(wm.IDs, id) {
+		wm.IDs = append(wm.IDs, id)
+	}
+}
+
+func (wm *IAMSMTPConfigIDsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.SMTPConfigAddedEventType,
+			instance.SMTPConfigHTTPAddedEventType,
+			instance.SMTPConfigAPIAddedEventType,
+			instance.SMTPConfigRemovedEventType).
+		Builder()
+}
+
+// smtpConfigID returns the ID of the config, the old and unique smtp settings (empty ID) are identified by the instance.
+func smtpConfigID(id string, aggregate *eventstore.Aggregate) string {
+	if id != "" {
+		return id
+	}
+	return aggregate.ResourceOwner
+}
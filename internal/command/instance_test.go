@@ -90,6 +90,8 @@ func apiAppEvents(ctx context.Context, orgID, projectID, id, name string) []even
 			"clientID",
 			"",
 			domain.APIAuthMethodTypePrivateKeyJWT,
+			"",
+			"",
 		),
 	}
 }
@@ -128,7 +130,7 @@ func oidcAppEvents(ctx context.Context, orgID, projectID, id, name, clientID str
 			"",
 			"",
 			"",
-			nil),
+			nil, false, false, "", "", "", false),
 	}
 }
 
@@ -209,7 +211,7 @@ func instancePoliciesFilters(_ string) []expect {
 func instancePoliciesEvents(ctx context.Context, instanceID string) []eventstore.Command {
 	instanceAgg := instance.NewAggregate(instanceID)
 	return []eventstore.Command{
-		instance.NewPasswordComplexityPolicyAddedEvent(ctx, &instanceAgg.Aggregate, 8, true, true, true, true),
+		instance.NewPasswordComplexityPolicyAddedEvent(ctx, &instanceAgg.Aggregate, 8, true, true, true, true, false),
 		instance.NewPasswordAgePolicyAddedEvent(ctx, &instanceAgg.Aggregate, 0, 0),
 		instance.NewDomainPolicyAddedEvent(ctx, &instanceAgg.Aggregate, false, false, false),
 		instance.NewLoginPolicyAddedEvent(ctx, &instanceAgg.Aggregate, true, true, true, false, false, false, false, true, false, false, domain.PasswordlessTypeAllowed, "", 240*time.Hour, 240*time.Hour, 720*time.Hour, 18*time.Hour, 12*time.Hour),
@@ -218,7 +220,7 @@ func instancePoliciesEvents(ctx context.Context, instanceID string) []eventstore
 		instance.NewLoginPolicyMultiFactorAddedEvent(ctx, &instanceAgg.Aggregate, domain.MultiFactorTypeU2FWithPIN),
 		instance.NewPrivacyPolicyAddedEvent(ctx, &instanceAgg.Aggregate, "", "", "", "", "", "", ""),
 		instance.NewNotificationPolicyAddedEvent(ctx, &instanceAgg.Aggregate, true),
-		instance.NewLockoutPolicyAddedEvent(ctx, &instanceAgg.Aggregate, 0, 0, true),
+		instance.NewLockoutPolicyAddedEvent(ctx, &instanceAgg.Aggregate, 0, 0, true, 0, 0),
 		instance.NewLabelPolicyAddedEvent(ctx, &instanceAgg.Aggregate, "#5469d4", "#fafafa", "#cd3d56", "#000000", "#2073c4", "#111827", "#ff3b5b", "#ffffff", false, false, false, domain.LabelPolicyThemeAuto),
 		instance.NewLabelPolicyActivatedEvent(ctx, &instanceAgg.Aggregate),
 	}
@@ -474,7 +476,7 @@ func generatedDomainFilters(instanceID, orgID, projectID, appID, generatedDomain
 				"",
 				"",
 				"",
-				nil),
+				nil, false, false, "", "", "", false),
 		),
 		expectFilter(
 			func() eventstore.Event {
@@ -520,6 +522,7 @@ func humanFilters(orgID string) []expect {
 				false,
 				false,
 				false,
+				false,
 			),
 		),
 	}
@@ -9,6 +9,7 @@ import (
 	"github.com/zitadel/zitadel/internal/command/preparation"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/ratelimit"
 	"github.com/zitadel/zitadel/internal/repository/limits"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -16,6 +17,8 @@ import (
 type SetLimits struct {
 	AuditLogRetention *time.Duration
 	Block             *bool
+	RateLimits        *ratelimit.Limits
+	ArchiveHorizon    *time.Duration
 }
 
 // SetLimits creates new limits or updates existing limits.
@@ -144,7 +147,7 @@ func (c *Commands) getBulkInstanceLimitsWriteModel(ctx context.Context, target [
 
 func (c *Commands) SetLimitsCommand(a *limits.Aggregate, wm *limitsWriteModel, setLimits *SetLimits) preparation.Validation {
 	return func() (preparation.CreateCommands, error) {
-		if setLimits == nil || (setLimits.AuditLogRetention == nil && setLimits.Block == nil) {
+		if setLimits == nil || (setLimits.AuditLogRetention == nil && setLimits.Block == nil && setLimits.RateLimits == nil && setLimits.ArchiveHorizon == nil) {
 			return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-4M9vs", "Errors.Limits.NoneSpecified")
 		}
 		return func(ctx context.Context, _ preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
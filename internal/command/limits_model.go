@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/ratelimit"
 	"github.com/zitadel/zitadel/internal/repository/limits"
 )
 
@@ -12,6 +13,8 @@ type limitsWriteModel struct {
 	rollingAggregateID string
 	auditLogRetention  *time.Duration
 	block              *bool
+	rateLimits         *ratelimit.Limits
+	archiveHorizon     *time.Duration
 }
 
 // newLimitsWriteModel aggregateId is filled by reducing unit matching events
@@ -50,10 +53,18 @@ func (wm *limitsWriteModel) Reduce() error {
 			if e.Block != nil {
 				wm.block = e.Block
 			}
+			if e.RateLimits != nil {
+				wm.rateLimits = e.RateLimits
+			}
+			if e.ArchiveHorizon != nil {
+				wm.archiveHorizon = e.ArchiveHorizon
+			}
 		case *limits.ResetEvent:
 			wm.rollingAggregateID = ""
 			wm.auditLogRetention = nil
 			wm.block = nil
+			wm.rateLimits = nil
+			wm.archiveHorizon = nil
 		}
 	}
 	if err := wm.WriteModel.Reduce(); err != nil {
@@ -77,5 +88,11 @@ func (wm *limitsWriteModel) NewChanges(setLimits *SetLimits) (changes []limits.L
 	if setLimits.Block != nil && (wm.block == nil || *wm.block != *setLimits.Block) {
 		changes = append(changes, limits.ChangeBlock(setLimits.Block))
 	}
+	if setLimits.RateLimits != nil && (wm.rateLimits == nil || *wm.rateLimits != *setLimits.RateLimits) {
+		changes = append(changes, limits.ChangeRateLimits(setLimits.RateLimits))
+	}
+	if setLimits.ArchiveHorizon != nil && (wm.archiveHorizon == nil || *wm.archiveHorizon != *setLimits.ArchiveHorizon) {
+		changes = append(changes, limits.ChangeArchiveHorizon(setLimits.ArchiveHorizon))
+	}
 	return changes
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/id"
 	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/ratelimit"
 	"github.com/zitadel/zitadel/internal/repository/limits"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -159,6 +160,138 @@ func TestLimits_SetLimits(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "update limits rate limits, ok",
+			fields: func(*testing.T) (*eventstore.Eventstore, id.Generator) {
+				return eventstoreExpect(
+						t,
+						expectFilter(
+							eventFromEventPusher(
+								limits.NewSetEvent(
+									eventstore.NewBaseEventForPush(
+										context.Background(),
+										&limits.NewAggregate("limits1", "instance1").Aggregate,
+										limits.SetEventType,
+									),
+									limits.ChangeRateLimits(&ratelimit.Limits{
+										Login: ratelimit.Policy{IP: ratelimit.Limit{Requests: 60, Period: time.Minute}},
+									}),
+								),
+							),
+						),
+						expectPush(
+							eventFromEventPusherWithInstanceID(
+								"instance1",
+								limits.NewSetEvent(
+									eventstore.NewBaseEventForPush(
+										context.Background(),
+										&limits.NewAggregate("limits1", "instance1").Aggregate,
+										limits.SetEventType,
+									),
+									limits.ChangeRateLimits(&ratelimit.Limits{
+										Login: ratelimit.Policy{IP: ratelimit.Limit{Requests: 30, Period: time.Minute}},
+									}),
+								),
+							),
+						),
+					),
+					nil
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+				setLimits: &SetLimits{
+					RateLimits: &ratelimit.Limits{
+						Login: ratelimit.Policy{IP: ratelimit.Limit{Requests: 30, Period: time.Minute}},
+					},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "instance1",
+				},
+			},
+		},
+		{
+			name: "update limits rate limits unchanged, ok",
+			fields: func(*testing.T) (*eventstore.Eventstore, id.Generator) {
+				return eventstoreExpect(
+						t,
+						expectFilter(
+							eventFromEventPusher(
+								limits.NewSetEvent(
+									eventstore.NewBaseEventForPush(
+										context.Background(),
+										&limits.NewAggregate("limits1", "instance1").Aggregate,
+										limits.SetEventType,
+									),
+									limits.ChangeRateLimits(&ratelimit.Limits{
+										Login: ratelimit.Policy{IP: ratelimit.Limit{Requests: 60, Period: time.Minute}},
+									}),
+								),
+							),
+						),
+					),
+					nil
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+				setLimits: &SetLimits{
+					RateLimits: &ratelimit.Limits{
+						Login: ratelimit.Policy{IP: ratelimit.Limit{Requests: 60, Period: time.Minute}},
+					},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "instance1",
+				},
+			},
+		},
+		{
+			name: "update limits archive horizon, ok",
+			fields: func(*testing.T) (*eventstore.Eventstore, id.Generator) {
+				return eventstoreExpect(
+						t,
+						expectFilter(
+							eventFromEventPusher(
+								limits.NewSetEvent(
+									eventstore.NewBaseEventForPush(
+										context.Background(),
+										&limits.NewAggregate("limits1", "instance1").Aggregate,
+										limits.SetEventType,
+									),
+									limits.ChangeArchiveHorizon(gu.Ptr(time.Hour)),
+								),
+							),
+						),
+						expectPush(
+							eventFromEventPusherWithInstanceID(
+								"instance1",
+								limits.NewSetEvent(
+									eventstore.NewBaseEventForPush(
+										context.Background(),
+										&limits.NewAggregate("limits1", "instance1").Aggregate,
+										limits.SetEventType,
+									),
+									limits.ChangeArchiveHorizon(gu.Ptr(24*time.Hour)),
+								),
+							),
+						),
+					),
+					nil
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+				setLimits: &SetLimits{
+					ArchiveHorizon: gu.Ptr(24 * time.Hour),
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "instance1",
+				},
+			},
+		},
 		{
 			name: "set limits after resetting limits, ok",
 			fields: func(*testing.T) (*eventstore.Eventstore, id.Generator) {
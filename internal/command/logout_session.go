@@ -2,9 +2,13 @@ package command
 
 import (
 	"context"
+	"slices"
 
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/saml/slo"
 	"github.com/zitadel/zitadel/internal/repository/sessionlogout"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 func (c *Commands) BackChannelLogoutSent(ctx context.Context, id, oidcSessionID, instanceID string) (err error) {
@@ -22,3 +26,132 @@ func (c *Commands) BackChannelLogoutSent(ctx context.Context, id, oidcSessionID,
 		sessionlogout.NewBackChannelLogoutSentEvent(ctx, sessionWriteModel.aggregate, oidcSessionID),
 	)
 }
+
+// SAMLLogout contains the information needed to send a LogoutRequest
+// to a service provider participating in a session.
+type SAMLLogout struct {
+	Issuer             string
+	NameID             string
+	NameIDFormat       string
+	SessionIndex       string
+	SignatureAlgorithm string
+	SingleLogoutURL    string
+	Binding            string
+}
+
+// SAMLLogoutParticipant is a service provider, which received an assertion in a session.
+type SAMLLogoutParticipant struct {
+	SAMLSessionID string
+	UserID        string
+	EntityID      string
+	SAMLLogout
+}
+
+// SAMLSessionLogout is the state of the SAML Single Logout of a session.
+type SAMLSessionLogout struct {
+	SessionID string
+	// RequestID and RelayState are set from the LogoutRequest of the initiating service provider.
+	RequestID  string
+	RelayState string
+	// Initiator is nil if the logout was initiated by ZITADEL,
+	// the user agent is then sent to the PostLogoutRedirectURI after all participants were notified.
+	Initiator             *SAMLLogoutParticipant
+	PostLogoutRedirectURI string
+	// Pending are the participants, which were not notified about the logout yet.
+	Pending []*SAMLLogoutParticipant
+}
+
+// StartSAMLLogout handles the LogoutRequest of a service provider participating in a session.
+// The request is stored, so the LogoutResponse can be sent after the other participants were notified,
+// and the session is terminated, which notifies the participants supporting the SOAP binding in the background.
+func (c *Commands) StartSAMLLogout(ctx context.Context, entityID, sessionIndex, requestID, relayState string) (_ *SAMLSessionLogout, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if entityID == "" || sessionIndex == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Wm2fP", "Errors.Session.IDMissing")
+	}
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	participantWriteModel := NewSAMLLogoutParticipantWriteModel(instanceID, entityID, sessionIndex)
+	if err = c.eventstore.FilterToQueryReducer(ctx, participantWriteModel); err != nil {
+		return nil, err
+	}
+	if participantWriteModel.Participant == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-x8Ubn", "Errors.Session.NotExisting")
+	}
+	logoutWriteModel := NewSAMLSessionLogoutWriteModel(participantWriteModel.SessionID, instanceID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, logoutWriteModel); err != nil {
+		return nil, err
+	}
+	samlSessionID := participantWriteModel.Participant.SAMLSessionID
+	if err = c.pushAppendAndReduce(ctx, logoutWriteModel,
+		sessionlogout.NewSAMLLogoutInitiatedEvent(ctx, logoutWriteModel.aggregate, samlSessionID, requestID, relayState, ""),
+		sessionlogout.NewSAMLLogoutSentEvent(ctx, logoutWriteModel.aggregate, samlSessionID),
+	); err != nil {
+		return nil, err
+	}
+	if _, err = c.TerminateSessionWithoutTokenCheck(ctx, participantWriteModel.SessionID); err != nil {
+		return nil, err
+	}
+	return logoutWriteModel.logout(), nil
+}
+
+// StartSAMLFrontChannelLogout starts the SAML Single Logout of a session terminated by ZITADEL (e.g. on end_session).
+// The participants supporting the SOAP binding are notified in the background,
+// the ones using a front channel binding have to be notified through the user agent.
+// It returns nil if no such participant has to be notified.
+func (c *Commands) StartSAMLFrontChannelLogout(ctx context.Context, sessionID, postLogoutRedirectURI string) (_ *SAMLSessionLogout, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if sessionID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rk8vT", "Errors.Session.IDMissing")
+	}
+	writeModel := NewSAMLSessionLogoutWriteModel(sessionID, authz.GetInstance(ctx).InstanceID())
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if !slices.ContainsFunc(writeModel.logout().Pending, func(participant *SAMLLogoutParticipant) bool {
+		return slo.IsFrontChannel(participant.Binding)
+	}) {
+		return nil, nil
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel,
+		sessionlogout.NewSAMLLogoutInitiatedEvent(ctx, writeModel.aggregate, "", "", "", postLogoutRedirectURI),
+	); err != nil {
+		return nil, err
+	}
+	return writeModel.logout(), nil
+}
+
+// GetSAMLSessionLogout returns the state of the SAML Single Logout of the session.
+func (c *Commands) GetSAMLSessionLogout(ctx context.Context, sessionID string) (_ *SAMLSessionLogout, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewSAMLSessionLogoutWriteModel(sessionID, authz.GetInstance(ctx).InstanceID())
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if !writeModel.Initiated {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Jd3pQ", "Errors.Session.NotExisting")
+	}
+	return writeModel.logout(), nil
+}
+
+// SAMLLogoutSent marks the participant of the session as notified about the logout.
+func (c *Commands) SAMLLogoutSent(ctx context.Context, id, samlSessionID, instanceID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewSAMLSessionLogoutWriteModel(id, instanceID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return err
+	}
+
+	return c.pushAppendAndReduce(
+		ctx,
+		writeModel,
+		sessionlogout.NewSAMLLogoutSentEvent(ctx, writeModel.aggregate, samlSessionID),
+	)
+}
@@ -72,3 +72,144 @@ func (wm *SessionLogoutWriteModel) reduceSent(e *sessionlogout.BackChannelLogout
 	}
 	wm.BackChannelLogoutSent = true
 }
+
+// SAMLSessionLogoutWriteModel contains the service providers participating in a session
+// and the state of their SAML Single Logout.
+type SAMLSessionLogoutWriteModel struct {
+	eventstore.WriteModel
+
+	Participants           []*SAMLLogoutParticipant
+	Initiated              bool
+	InitiatorSAMLSessionID string
+	RequestID              string
+	RelayState             string
+	PostLogoutRedirectURI  string
+	sent                   map[string]bool
+
+	aggregate *eventstore.Aggregate
+}
+
+func NewSAMLSessionLogoutWriteModel(id string, instanceID string) *SAMLSessionLogoutWriteModel {
+	return &SAMLSessionLogoutWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			ResourceOwner: instanceID,
+			InstanceID:    instanceID,
+		},
+		aggregate: &sessionlogout.NewAggregate(id, instanceID).Aggregate,
+		sent:      make(map[string]bool),
+	}
+}
+
+func (wm *SAMLSessionLogoutWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *sessionlogout.SAMLLogoutRegisteredEvent:
+			wm.Participants = append(wm.Participants, samlLogoutParticipantFromEvent(e))
+		case *sessionlogout.SAMLLogoutInitiatedEvent:
+			wm.Initiated = true
+			wm.InitiatorSAMLSessionID = e.SAMLSessionID
+			wm.RequestID = e.RequestID
+			wm.RelayState = e.RelayState
+			wm.PostLogoutRedirectURI = e.PostLogoutRedirectURI
+		case *sessionlogout.SAMLLogoutSentEvent:
+			wm.sent[e.SAMLSessionID] = true
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *SAMLSessionLogoutWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(sessionlogout.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			sessionlogout.SAMLLogoutRegisteredType,
+			sessionlogout.SAMLLogoutInitiatedType,
+			sessionlogout.SAMLLogoutSentType,
+		).
+		Builder()
+}
+
+func (wm *SAMLSessionLogoutWriteModel) logout() *SAMLSessionLogout {
+	logout := &SAMLSessionLogout{
+		SessionID:             wm.AggregateID,
+		RequestID:             wm.RequestID,
+		RelayState:            wm.RelayState,
+		PostLogoutRedirectURI: wm.PostLogoutRedirectURI,
+	}
+	for _, participant := range wm.Participants {
+		if wm.InitiatorSAMLSessionID != "" && participant.SAMLSessionID == wm.InitiatorSAMLSessionID {
+			logout.Initiator = participant
+			continue
+		}
+		if !wm.sent[participant.SAMLSessionID] {
+			logout.Pending = append(logout.Pending, participant)
+		}
+	}
+	return logout
+}
+
+// SAMLLogoutParticipantWriteModel searches the service provider participating in a session
+// by the SessionIndex of the assertion it received.
+type SAMLLogoutParticipantWriteModel struct {
+	eventstore.WriteModel
+
+	EntityID     string
+	SessionIndex string
+	SessionID    string
+	Participant  *SAMLLogoutParticipant
+}
+
+func NewSAMLLogoutParticipantWriteModel(instanceID, entityID, sessionIndex string) *SAMLLogoutParticipantWriteModel {
+	return &SAMLLogoutParticipantWriteModel{
+		WriteModel: eventstore.WriteModel{
+			InstanceID: instanceID,
+		},
+		EntityID:     entityID,
+		SessionIndex: sessionIndex,
+	}
+}
+
+func (wm *SAMLLogoutParticipantWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		e, ok := event.(*sessionlogout.SAMLLogoutRegisteredEvent)
+		if !ok || e.EntityID != wm.EntityID || e.SessionIndex != wm.SessionIndex {
+			continue
+		}
+		wm.SessionID = e.Aggregate().ID
+		wm.Participant = samlLogoutParticipantFromEvent(e)
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *SAMLLogoutParticipantWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		InstanceID(wm.InstanceID).
+		AddQuery().
+		AggregateTypes(sessionlogout.AggregateType).
+		EventTypes(sessionlogout.SAMLLogoutRegisteredType).
+		EventData(map[string]interface{}{
+			"entity_id":     wm.EntityID,
+			"session_index": wm.SessionIndex,
+		}).
+		Builder()
+}
+
+func samlLogoutParticipantFromEvent(e *sessionlogout.SAMLLogoutRegisteredEvent) *SAMLLogoutParticipant {
+	return &SAMLLogoutParticipant{
+		SAMLSessionID: e.SAMLSessionID,
+		UserID:        e.UserID,
+		EntityID:      e.EntityID,
+		SAMLLogout: SAMLLogout{
+			Issuer:             e.Issuer,
+			NameID:             e.NameID,
+			NameIDFormat:       e.NameIDFormat,
+			SessionIndex:       e.SessionIndex,
+			SignatureAlgorithm: e.SignatureAlgorithm,
+			SingleLogoutURL:    e.SingleLogoutURL,
+			Binding:            e.Binding,
+		},
+	}
+}
@@ -0,0 +1,155 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zitadel/saml/pkg/provider"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/saml/slo"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/sessionlogout"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func samlLogoutRegisteredEvent(samlSessionID, binding string) *sessionlogout.SAMLLogoutRegisteredEvent {
+	return sessionlogout.NewSAMLLogoutRegisteredEvent(context.Background(),
+		&sessionlogout.NewAggregate("session1", "instance1").Aggregate,
+		samlSessionID,
+		"user1",
+		"https://sp.example.com/"+samlSessionID,
+		"https://idp.example.com",
+		"user@example.com",
+		"urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress",
+		"index-"+samlSessionID,
+		"",
+		"https://sp.example.com/"+samlSessionID+"/slo",
+		binding,
+	)
+}
+
+func TestCommands_StartSAMLFrontChannelLogout(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		sessionID             string
+		postLogoutRedirectURI string
+	}
+	type res struct {
+		pending []string
+		err     error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing session id",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Rk8vT", "Errors.Session.IDMissing"),
+			},
+		},
+		{
+			name: "no participants",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				sessionID:             "session1",
+				postLogoutRedirectURI: "https://rp.example.com/logged-out",
+			},
+			res: res{},
+		},
+		{
+			name: "only soap participants, nothing to do",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(samlLogoutRegisteredEvent("saml1", slo.SOAPBinding)),
+					),
+				),
+			},
+			args: args{
+				sessionID:             "session1",
+				postLogoutRedirectURI: "https://rp.example.com/logged-out",
+			},
+			res: res{},
+		},
+		{
+			name: "front channel participants already notified, nothing to do",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(samlLogoutRegisteredEvent("saml1", provider.RedirectBinding)),
+						eventFromEventPusher(sessionlogout.NewSAMLLogoutSentEvent(context.Background(),
+							&sessionlogout.NewAggregate("session1", "instance1").Aggregate,
+							"saml1",
+						)),
+					),
+				),
+			},
+			args: args{
+				sessionID:             "session1",
+				postLogoutRedirectURI: "https://rp.example.com/logged-out",
+			},
+			res: res{},
+		},
+		{
+			name: "front channel participants, logout started",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(samlLogoutRegisteredEvent("saml1", slo.SOAPBinding)),
+						eventFromEventPusher(samlLogoutRegisteredEvent("saml2", provider.RedirectBinding)),
+						eventFromEventPusher(samlLogoutRegisteredEvent("saml3", provider.PostBinding)),
+					),
+					expectPush(
+						sessionlogout.NewSAMLLogoutInitiatedEvent(context.Background(),
+							&sessionlogout.NewAggregate("session1", "instance1").Aggregate,
+							"", "", "", "https://rp.example.com/logged-out",
+						),
+					),
+				),
+			},
+			args: args{
+				sessionID:             "session1",
+				postLogoutRedirectURI: "https://rp.example.com/logged-out",
+			},
+			res: res{
+				pending: []string{"saml1", "saml2", "saml3"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			ctx := authz.WithInstanceID(context.Background(), "instance1")
+			got, err := c.StartSAMLFrontChannelLogout(ctx, tt.args.sessionID, tt.args.postLogoutRedirectURI)
+			assert.ErrorIs(t, err, tt.res.err)
+			if tt.res.pending == nil {
+				assert.Nil(t, got)
+				return
+			}
+			assert.Nil(t, got.Initiator)
+			assert.Equal(t, tt.args.postLogoutRedirectURI, got.PostLogoutRedirectURI)
+			pending := make([]string, len(got.Pending))
+			for i, participant := range got.Pending {
+				pending[i] = participant.SAMLSessionID
+			}
+			assert.Equal(t, tt.res.pending, pending)
+		})
+	}
+}
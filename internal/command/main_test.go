@@ -19,6 +19,7 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore/repository/mock"
 	"github.com/zitadel/zitadel/internal/execution/target"
 	"github.com/zitadel/zitadel/internal/feature"
+	"github.com/zitadel/zitadel/internal/ratelimit"
 	"github.com/zitadel/zitadel/internal/repository/org"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -195,6 +196,14 @@ func (m *mockInstance) Block() *bool {
 	panic("shouldn't be called here")
 }
 
+func (m *mockInstance) RateLimits() *ratelimit.Limits {
+	panic("shouldn't be called here")
+}
+
+func (m *mockInstance) ArchiveHorizon() *time.Duration {
+	panic("shouldn't be called here")
+}
+
 func (m *mockInstance) AuditLogRetention() *time.Duration {
 	panic("shouldn't be called here")
 }
@@ -0,0 +1,59 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// NotificationAttempt is a single attempt of the notification worker to send a notification.
+type NotificationAttempt struct {
+	// ID of the notification job
+	ID            string
+	InstanceID    string
+	ResourceOwner string
+
+	UserID              string
+	TriggeringEventType eventstore.EventType
+	MessageType         string
+	NotificationType    domain.NotificationType
+	ProviderID          string
+	Attempt             int
+
+	// DeliveryID is the id of the message returned by the provider on success
+	DeliveryID string
+	// Error is set if the attempt failed
+	Error string
+	// Final is set if a failed notification will not be retried anymore
+	Final bool
+}
+
+// NotificationAttempted stores the result of an attempt to send a notification.
+// It is called by the notification worker, therefore no permission check is done.
+func (c *Commands) NotificationAttempted(ctx context.Context, attempt *NotificationAttempt) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if attempt.ID == "" || attempt.InstanceID == "" || attempt.UserID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Nh1st0", "Errors.IDMissing")
+	}
+	aggregate := notification.NewAggregate(attempt.ID, attempt.ResourceOwner, attempt.InstanceID)
+	info := notification.Info{
+		UserID:              attempt.UserID,
+		TriggeringEventType: attempt.TriggeringEventType,
+		MessageType:         attempt.MessageType,
+		NotificationType:    attempt.NotificationType,
+		ProviderID:          attempt.ProviderID,
+		Attempt:             attempt.Attempt,
+	}
+	var event eventstore.Command = notification.NewAttemptSucceededEvent(ctx, aggregate, info, attempt.DeliveryID)
+	if attempt.Error != "" {
+		event = notification.NewAttemptFailedEvent(ctx, aggregate, info, attempt.Error, attempt.Final)
+	}
+	_, err = c.eventstore.Push(ctx, event)
+	return err
+}
@@ -0,0 +1,133 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func notificationAttemptInfo() notification.Info {
+	return notification.Info{
+		UserID:              "user1",
+		TriggeringEventType: user.HumanInviteCodeAddedType,
+		MessageType:         domain.InviteUserMessageType,
+		NotificationType:    domain.NotificationTypeEmail,
+		ProviderID:          "provider1",
+		Attempt:             1,
+	}
+}
+
+func TestCommands_NotificationAttempted(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		attempt *NotificationAttempt
+	}
+	type res struct {
+		err func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"id missing, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				attempt: &NotificationAttempt{
+					InstanceID: "instance",
+					UserID:     "user1",
+				},
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"succeeded, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectPush(
+						notification.NewAttemptSucceededEvent(context.Background(),
+							notification.NewAggregate("job1", "org1", "instance"),
+							notificationAttemptInfo(),
+							"delivery1",
+						),
+					),
+				),
+			},
+			args{
+				attempt: &NotificationAttempt{
+					ID:                  "job1",
+					InstanceID:          "instance",
+					ResourceOwner:       "org1",
+					UserID:              "user1",
+					TriggeringEventType: user.HumanInviteCodeAddedType,
+					MessageType:         domain.InviteUserMessageType,
+					NotificationType:    domain.NotificationTypeEmail,
+					ProviderID:          "provider1",
+					Attempt:             1,
+					DeliveryID:          "delivery1",
+				},
+			},
+			res{},
+		},
+		{
+			"failed, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectPush(
+						notification.NewAttemptFailedEvent(context.Background(),
+							notification.NewAggregate("job1", "org1", "instance"),
+							notificationAttemptInfo(),
+							"send error",
+							true,
+						),
+					),
+				),
+			},
+			args{
+				attempt: &NotificationAttempt{
+					ID:                  "job1",
+					InstanceID:          "instance",
+					ResourceOwner:       "org1",
+					UserID:              "user1",
+					TriggeringEventType: user.HumanInviteCodeAddedType,
+					MessageType:         domain.InviteUserMessageType,
+					NotificationType:    domain.NotificationTypeEmail,
+					ProviderID:          "provider1",
+					Attempt:             1,
+					Error:               "send error",
+					Final:               true,
+				},
+			},
+			res{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := c.NotificationAttempted(context.Background(), tt.args.attempt)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
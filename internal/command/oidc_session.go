@@ -47,6 +47,44 @@ type OIDCSession struct {
 	Reason            domain.TokenReason
 	Actor             *domain.TokenActor
 	RefreshToken      string
+	DPoPJKT           string
+	CertThumbprint    string
+	// AuthorizationDetails granted to the session (RFC 9396)
+	AuthorizationDetails domain.AuthorizationDetails
+}
+
+// TokenBinding binds the tokens of an OIDC session to the key of a DPoP proof (RFC 9449)
+// and / or to the certificate of a client authenticated by mutual TLS (RFC 8705).
+type TokenBinding struct {
+	// JKT is the base64url encoded SHA-256 thumbprint of the public key of the DPoP proof.
+	JKT string
+	// BindRefreshToken must only be set for public clients,
+	// refresh tokens of confidential clients are already bound by the client authentication.
+	BindRefreshToken bool
+	// CertThumbprint is the base64url encoded SHA-256 thumbprint of the client certificate.
+	// Only access tokens are bound to it, as the refresh token requires the client authentication anyway.
+	CertThumbprint string
+}
+
+func (b *TokenBinding) jkt() string {
+	if b == nil {
+		return ""
+	}
+	return b.JKT
+}
+
+func (b *TokenBinding) refreshTokenJKT() string {
+	if b == nil || !b.BindRefreshToken {
+		return ""
+	}
+	return b.JKT
+}
+
+func (b *TokenBinding) certThumbprint() string {
+	if b == nil {
+		return ""
+	}
+	return b.CertThumbprint
 }
 
 type AuthRequestComplianceChecker func(context.Context, *AuthRequestWriteModel) error
@@ -54,12 +92,14 @@ type AuthRequestComplianceChecker func(context.Context, *AuthRequestWriteModel)
 // CreateOIDCSessionFromAuthRequest creates a new OIDC Session, creates an access token and refresh token.
 // It returns the access token id, expiration and the refresh token.
 // If the underlying [AuthRequest] is a OIDC Auth Code Flow, it will set the code as exchanged.
+// If a token binding is passed, the tokens are bound to the key of the DPoP proof or the client certificate.
 func (c *Commands) CreateOIDCSessionFromAuthRequest(
 	ctx context.Context,
 	authReqId string,
 	complianceCheck AuthRequestComplianceChecker,
 	needRefreshToken bool,
 	backChannelLogoutURI string,
+	binding *TokenBinding,
 ) (session *OIDCSession, state string, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -112,16 +152,17 @@ func (c *Commands) CreateOIDCSessionFromAuthRequest(
 		authReqModel.Nonce,
 		sessionModel.PreferredLanguage,
 		sessionModel.UserAgent,
+		authReqModel.AuthorizationDetails,
 	)
 	cmd.RegisterLogout(ctx, sessionModel.AggregateID, sessionModel.UserID, authReqModel.ClientID, backChannelLogoutURI)
 
 	if authReqModel.ResponseType != domain.OIDCResponseTypeIDToken {
-		if err = cmd.AddAccessToken(ctx, authReqModel.Scope, sessionModel.UserID, sessionModel.UserResourceOwner, domain.TokenReasonAuthRequest, nil); err != nil {
+		if err = cmd.AddAccessToken(ctx, authReqModel.Scope, sessionModel.UserID, sessionModel.UserResourceOwner, domain.TokenReasonAuthRequest, nil, binding, nil); err != nil {
 			return nil, "", err
 		}
 	}
 	if authReqModel.NeedRefreshToken && needRefreshToken {
-		if err = cmd.AddRefreshToken(ctx, sessionModel.UserID); err != nil {
+		if err = cmd.AddRefreshToken(ctx, sessionModel.UserID, binding.refreshTokenJKT()); err != nil {
 			return nil, "", err
 		}
 	}
@@ -154,6 +195,7 @@ func (c *Commands) CreateOIDCSession(ctx context.Context,
 	needRefreshToken bool,
 	sessionID string,
 	responseType domain.OIDCResponseType,
+	binding *TokenBinding,
 ) (session *OIDCSession, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -169,15 +211,15 @@ func (c *Commands) CreateOIDCSession(ctx context.Context,
 		cmd.UserImpersonated(ctx, userID, resourceOwner, clientID, actor)
 	}
 
-	cmd.AddSession(ctx, userID, resourceOwner, sessionID, clientID, audience, scope, authMethods, authTime, nonce, preferredLanguage, userAgent)
+	cmd.AddSession(ctx, userID, resourceOwner, sessionID, clientID, audience, scope, authMethods, authTime, nonce, preferredLanguage, userAgent, nil)
 	cmd.RegisterLogout(ctx, sessionID, userID, clientID, backChannelLogoutURI)
 	if responseType != domain.OIDCResponseTypeIDToken {
-		if err = cmd.AddAccessToken(ctx, scope, userID, resourceOwner, reason, actor); err != nil {
+		if err = cmd.AddAccessToken(ctx, scope, userID, resourceOwner, reason, actor, binding, nil); err != nil {
 			return nil, err
 		}
 	}
 	if needRefreshToken {
-		if err = cmd.AddRefreshToken(ctx, userID); err != nil {
+		if err = cmd.AddRefreshToken(ctx, userID, binding.refreshTokenJKT()); err != nil {
 			return nil, err
 		}
 	}
@@ -196,7 +238,10 @@ type RefreshTokenComplianceChecker func(ctx context.Context, wm *OIDCSessionWrit
 
 // ExchangeOIDCSessionRefreshAndAccessToken updates an existing OIDC Session, creates a new access and refresh token.
 // It returns the access token id and expiration and the new refresh token.
-func (c *Commands) ExchangeOIDCSessionRefreshAndAccessToken(ctx context.Context, refreshToken string, scope []string, reqClientID string, complianceCheck RefreshTokenComplianceChecker) (_ *OIDCSession, err error) {
+// If the refresh token is bound to a DPoP key, the DPoP key of the passed binding must match.
+// The new access token is bound to the passed binding, if any.
+// The complianceCheck might narrow down the authorization details of the session for the new access token.
+func (c *Commands) ExchangeOIDCSessionRefreshAndAccessToken(ctx context.Context, refreshToken string, scope []string, reqClientID string, complianceCheck RefreshTokenComplianceChecker, binding *TokenBinding) (_ *OIDCSession, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
@@ -208,11 +253,16 @@ func (c *Commands) ExchangeOIDCSessionRefreshAndAccessToken(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	if err = cmd.oidcSessionWriteModel.CheckRefreshTokenDPoP(binding.jkt()); err != nil {
+		return nil, err
+	}
 	err = cmd.AddAccessToken(ctx, scope,
 		cmd.oidcSessionWriteModel.UserID,
 		cmd.oidcSessionWriteModel.UserResourceOwner,
 		domain.TokenReasonRefresh,
 		cmd.oidcSessionWriteModel.AccessTokenActor,
+		binding,
+		cmd.oidcSessionWriteModel.AuthorizationDetails,
 	)
 	if err != nil {
 		return nil, err
@@ -244,6 +294,9 @@ func (c *Commands) OIDCSessionByRefreshToken(ctx context.Context, refreshToken s
 	if err = c.checkOrgNotDeactivatedAfter(ctx, writeModel.UserResourceOwner, writeModel.RefreshTokenIssuedAt); err != nil {
 		return nil, err
 	}
+	if err = c.checkConsentNotRevokedAfter(ctx, writeModel.UserID, writeModel.ClientID, writeModel.RefreshTokenIssuedAt); err != nil {
+		return nil, err
+	}
 	return writeModel, nil
 }
 
@@ -356,6 +409,9 @@ func (c *Commands) newOIDCSessionUpdateEvents(ctx context.Context, refreshToken
 	if err = c.checkOrgNotDeactivatedAfter(ctx, sessionWriteModel.UserResourceOwner, sessionWriteModel.RefreshTokenIssuedAt); err != nil {
 		return nil, err
 	}
+	if err = c.checkConsentNotRevokedAfter(ctx, sessionWriteModel.UserID, sessionWriteModel.ClientID, sessionWriteModel.RefreshTokenIssuedAt); err != nil {
+		return nil, err
+	}
 	if _, err = c.userStateForAuthentication(ctx, sessionWriteModel.UserID, sessionWriteModel.UserResourceOwner, "OIDCS-J39h2", "OIDCS-pQ2mB"); err != nil {
 		return nil, err
 	}
@@ -407,6 +463,7 @@ func (c *OIDCSessionEvents) AddSession(
 	nonce string,
 	preferredLanguage *language.Tag,
 	userAgent *domain.UserAgent,
+	authorizationDetails domain.AuthorizationDetails,
 ) {
 	c.events = append(c.events, oidcsession.NewAddedEvent(
 		ctx,
@@ -422,6 +479,7 @@ func (c *OIDCSessionEvents) AddSession(
 		nonce,
 		preferredLanguage,
 		userAgent,
+		authorizationDetails,
 	))
 }
 
@@ -457,22 +515,24 @@ func (c *OIDCSessionEvents) RegisterLogout(ctx context.Context, sessionID, userI
 	))
 }
 
-func (c *OIDCSessionEvents) AddAccessToken(ctx context.Context, scope []string, userID, resourceOwner string, reason domain.TokenReason, actor *domain.TokenActor) error {
+// AddAccessToken adds a new access token to the session.
+// The authorizationDetails narrow down the ones granted to the session for this token, if empty all are granted.
+func (c *OIDCSessionEvents) AddAccessToken(ctx context.Context, scope []string, userID, resourceOwner string, reason domain.TokenReason, actor *domain.TokenActor, binding *TokenBinding, authorizationDetails domain.AuthorizationDetails) error {
 	accessTokenID, err := c.idGenerator.Next()
 	if err != nil {
 		return err
 	}
 	c.accessTokenID = AccessTokenPrefix + accessTokenID
-	c.events = append(c.events, oidcsession.NewAccessTokenAddedEvent(ctx, c.oidcSessionWriteModel.aggregate, c.accessTokenID, scope, c.accessTokenLifetime, reason, actor))
+	c.events = append(c.events, oidcsession.NewAccessTokenAddedEvent(ctx, c.oidcSessionWriteModel.aggregate, c.accessTokenID, scope, c.accessTokenLifetime, reason, actor, binding.jkt(), binding.certThumbprint(), authorizationDetails))
 	return nil
 }
 
-func (c *OIDCSessionEvents) AddRefreshToken(ctx context.Context, userID string) (err error) {
+func (c *OIDCSessionEvents) AddRefreshToken(ctx context.Context, userID, dpopJKT string) (err error) {
 	c.refreshTokenID, c.refreshToken, err = c.generateRefreshToken(userID)
 	if err != nil {
 		return err
 	}
-	c.events = append(c.events, oidcsession.NewRefreshTokenAddedEvent(ctx, c.oidcSessionWriteModel.aggregate, c.refreshTokenID, c.refreshTokenLifeTime, c.refreshTokenIdleLifetime))
+	c.events = append(c.events, oidcsession.NewRefreshTokenAddedEvent(ctx, c.oidcSessionWriteModel.aggregate, c.refreshTokenID, c.refreshTokenLifeTime, c.refreshTokenIdleLifetime, dpopJKT))
 	return nil
 }
 
@@ -513,20 +573,23 @@ func (c *OIDCSessionEvents) PushEvents(ctx context.Context) (*OIDCSession, error
 		return nil, err
 	}
 	session := &OIDCSession{
-		SessionID:         c.oidcSessionWriteModel.SessionID,
-		ClientID:          c.oidcSessionWriteModel.ClientID,
-		UserID:            c.oidcSessionWriteModel.UserID,
-		Audience:          c.oidcSessionWriteModel.Audience,
-		Expiration:        c.oidcSessionWriteModel.AccessTokenExpiration,
-		Scope:             c.oidcSessionWriteModel.Scope,
-		AuthMethods:       c.oidcSessionWriteModel.AuthMethods,
-		AuthTime:          c.oidcSessionWriteModel.AuthTime,
-		Nonce:             c.oidcSessionWriteModel.Nonce,
-		PreferredLanguage: c.oidcSessionWriteModel.PreferredLanguage,
-		UserAgent:         c.oidcSessionWriteModel.UserAgent,
-		Reason:            c.oidcSessionWriteModel.AccessTokenReason,
-		Actor:             c.oidcSessionWriteModel.AccessTokenActor,
-		RefreshToken:      c.refreshToken,
+		SessionID:            c.oidcSessionWriteModel.SessionID,
+		ClientID:             c.oidcSessionWriteModel.ClientID,
+		UserID:               c.oidcSessionWriteModel.UserID,
+		Audience:             c.oidcSessionWriteModel.Audience,
+		Expiration:           c.oidcSessionWriteModel.AccessTokenExpiration,
+		Scope:                c.oidcSessionWriteModel.Scope,
+		AuthMethods:          c.oidcSessionWriteModel.AuthMethods,
+		AuthTime:             c.oidcSessionWriteModel.AuthTime,
+		Nonce:                c.oidcSessionWriteModel.Nonce,
+		PreferredLanguage:    c.oidcSessionWriteModel.PreferredLanguage,
+		UserAgent:            c.oidcSessionWriteModel.UserAgent,
+		Reason:               c.oidcSessionWriteModel.AccessTokenReason,
+		Actor:                c.oidcSessionWriteModel.AccessTokenActor,
+		RefreshToken:         c.refreshToken,
+		DPoPJKT:              c.oidcSessionWriteModel.AccessTokenDPoPJKT,
+		CertThumbprint:       c.oidcSessionWriteModel.AccessTokenCertThumbprint,
+		AuthorizationDetails: c.oidcSessionWriteModel.AuthorizationDetails,
 	}
 	if c.accessTokenID != "" {
 		// prefix the returned id with the oidcSessionID so that we can retrieve it later on
@@ -25,17 +25,21 @@ type OIDCSessionWriteModel struct {
 	AuthTime                   time.Time
 	Nonce                      string
 	UserAgent                  *domain.UserAgent
+	AuthorizationDetails       domain.AuthorizationDetails
 	State                      domain.OIDCSessionState
 	AccessTokenID              string
 	AccessTokenCreation        time.Time
 	AccessTokenExpiration      time.Time
 	AccessTokenReason          domain.TokenReason
 	AccessTokenActor           *domain.TokenActor
+	AccessTokenDPoPJKT         string
+	AccessTokenCertThumbprint  string
 	RefreshTokenID             string
 	RefreshToken               string
 	RefreshTokenExpiration     time.Time
 	RefreshTokenIdleExpiration time.Time
 	RefreshTokenIssuedAt       time.Time
+	RefreshTokenDPoPJKT        string
 
 	aggregate *eventstore.Aggregate
 }
@@ -102,6 +106,7 @@ func (wm *OIDCSessionWriteModel) reduceAdded(e *oidcsession.AddedEvent) {
 	wm.Nonce = e.Nonce
 	wm.PreferredLanguage = e.PreferredLanguage
 	wm.UserAgent = e.UserAgent
+	wm.AuthorizationDetails = e.AuthorizationDetails
 	wm.State = domain.OIDCSessionStateActive
 	// the write model might be initialized without resource owner,
 	// so update the aggregate
@@ -115,11 +120,15 @@ func (wm *OIDCSessionWriteModel) reduceAccessTokenAdded(e *oidcsession.AccessTok
 	wm.AccessTokenExpiration = e.CreationDate().Add(e.Lifetime)
 	wm.AccessTokenReason = e.Reason
 	wm.AccessTokenActor = e.Actor
+	wm.AccessTokenDPoPJKT = e.DPoPJKT
+	wm.AccessTokenCertThumbprint = e.CertThumbprint
 }
 
 func (wm *OIDCSessionWriteModel) reduceAccessTokenRevoked(e *oidcsession.AccessTokenRevokedEvent) {
 	wm.AccessTokenID = ""
 	wm.AccessTokenExpiration = e.CreationDate()
+	wm.AccessTokenDPoPJKT = ""
+	wm.AccessTokenCertThumbprint = ""
 }
 
 func (wm *OIDCSessionWriteModel) reduceRefreshTokenAdded(e *oidcsession.RefreshTokenAddedEvent) {
@@ -127,6 +136,7 @@ func (wm *OIDCSessionWriteModel) reduceRefreshTokenAdded(e *oidcsession.RefreshT
 	wm.RefreshTokenExpiration = e.CreationDate().Add(e.Lifetime)
 	wm.RefreshTokenIdleExpiration = e.CreationDate().Add(e.IdleLifetime)
 	wm.RefreshTokenIssuedAt = e.CreationDate()
+	wm.RefreshTokenDPoPJKT = e.DPoPJKT
 }
 
 func (wm *OIDCSessionWriteModel) reduceRefreshTokenRenewed(e *oidcsession.RefreshTokenRenewedEvent) {
@@ -139,8 +149,11 @@ func (wm *OIDCSessionWriteModel) reduceRefreshTokenRevoked(e *oidcsession.Refres
 	wm.RefreshTokenExpiration = e.CreationDate()
 	wm.RefreshTokenIdleExpiration = e.CreationDate()
 	wm.RefreshTokenIssuedAt = time.Time{}
+	wm.RefreshTokenDPoPJKT = ""
 	wm.AccessTokenID = ""
 	wm.AccessTokenExpiration = e.CreationDate()
+	wm.AccessTokenDPoPJKT = ""
+	wm.AccessTokenCertThumbprint = ""
 }
 
 func (wm *OIDCSessionWriteModel) CheckRefreshToken(refreshTokenID string) error {
@@ -157,6 +170,14 @@ func (wm *OIDCSessionWriteModel) CheckRefreshToken(refreshTokenID string) error
 	return nil
 }
 
+// CheckRefreshTokenDPoP checks that a refresh token bound to a DPoP key is used with a proof of the same key.
+func (wm *OIDCSessionWriteModel) CheckRefreshTokenDPoP(dpopJKT string) error {
+	if wm.RefreshTokenDPoPJKT != "" && wm.RefreshTokenDPoPJKT != dpopJKT {
+		return zerrors.ThrowPreconditionFailed(nil, "OIDCS-Dp0pK", "Errors.OIDCSession.RefreshTokenInvalid")
+	}
+	return nil
+}
+
 func (wm *OIDCSessionWriteModel) CheckAccessToken(accessTokenID string) error {
 	if wm.State != domain.OIDCSessionStateActive {
 		return zerrors.ThrowPreconditionFailed(nil, "OIDCS-KL2pk", "Errors.OIDCSession.Token.Invalid")
@@ -62,6 +62,16 @@ func (wm *OrgWriteModel) Query() *eventstore.SearchQueryBuilder {
 		Builder()
 }
 
+// SnapshotType implements [eventstore.Snapshotter]
+func (wm *OrgWriteModel) SnapshotType() string {
+	return "org"
+}
+
+// SnapshotVersion implements [eventstore.Snapshotter]
+func (wm *OrgWriteModel) SnapshotVersion() string {
+	return "v1"
+}
+
 func OrgAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
 	return eventstore.AggregateFromWriteModel(wm, org.AggregateType, org.AggregateVersion)
 }
@@ -32,6 +32,8 @@ func (c *Commands) AddLockoutPolicy(ctx context.Context, resourceOwner string, p
 		policy.MaxPasswordAttempts,
 		policy.MaxOTPAttempts,
 		policy.ShowLockOutFailures,
+		policy.LockoutDuration,
+		policy.FailedAttemptDelay,
 	))
 	if err != nil {
 		return nil, err
@@ -56,7 +58,7 @@ func (c *Commands) ChangeLockoutPolicy(ctx context.Context, resourceOwner string
 	}
 
 	orgAgg := OrgAggregateFromWriteModel(&existingPolicy.LockoutPolicyWriteModel.WriteModel)
-	changedEvent, hasChanged := existingPolicy.NewChangedEvent(ctx, orgAgg, policy.MaxPasswordAttempts, policy.MaxOTPAttempts, policy.ShowLockOutFailures)
+	changedEvent, hasChanged := existingPolicy.NewChangedEvent(ctx, orgAgg, policy.MaxPasswordAttempts, policy.MaxOTPAttempts, policy.ShowLockOutFailures, policy.LockoutDuration, policy.FailedAttemptDelay)
 	if !hasChanged {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-0JFSr", "Errors.Org.LockoutPolicy.NotChanged")
 	}
@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -64,6 +65,7 @@ func TestCommandSide_AddPasswordLockoutPolicy(t *testing.T) {
 								10,
 								10,
 								true,
+								0, 0,
 							),
 						),
 					),
@@ -94,6 +96,7 @@ func TestCommandSide_AddPasswordLockoutPolicy(t *testing.T) {
 							10,
 							10,
 							true,
+							0, 0,
 						),
 					),
 				),
@@ -210,6 +213,7 @@ func TestCommandSide_ChangePasswordLockoutPolicy(t *testing.T) {
 								10,
 								10,
 								true,
+								0, 0,
 							),
 						),
 					),
@@ -240,6 +244,7 @@ func TestCommandSide_ChangePasswordLockoutPolicy(t *testing.T) {
 								10,
 								10,
 								true,
+								0, 0,
 							),
 						),
 					),
@@ -269,6 +274,61 @@ func TestCommandSide_ChangePasswordLockoutPolicy(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "change lockout duration and failed attempt delay, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewLockoutPolicyAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								10,
+								10,
+								true,
+								0, 0,
+							),
+						),
+					),
+					expectPush(
+						func() *org.LockoutPolicyChangedEvent {
+							event, _ := org.NewLockoutPolicyChangedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								[]policy.LockoutPolicyChanges{
+									policy.ChangeLockoutDuration(15 * time.Minute),
+									policy.ChangeFailedAttemptDelay(time.Second),
+								},
+							)
+							return event
+						}(),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				policy: &domain.LockoutPolicy{
+					MaxPasswordAttempts: 10,
+					MaxOTPAttempts:      10,
+					ShowLockOutFailures: true,
+					LockoutDuration:     15 * time.Minute,
+					FailedAttemptDelay:  time.Second,
+				},
+			},
+			res: res{
+				want: &domain.LockoutPolicy{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "org1",
+						ResourceOwner: "org1",
+					},
+					MaxPasswordAttempts: 10,
+					MaxOTPAttempts:      10,
+					ShowLockOutFailures: true,
+					LockoutDuration:     15 * time.Minute,
+					FailedAttemptDelay:  time.Second,
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -349,6 +409,7 @@ func TestCommandSide_RemovePasswordLockoutPolicy(t *testing.T) {
 								10,
 								10,
 								true,
+								0, 0,
 							),
 						),
 					),
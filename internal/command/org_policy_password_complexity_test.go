@@ -65,6 +65,7 @@ func TestCommandSide_AddPasswordComplexityPolicy(t *testing.T) {
 								&org.NewAggregate("org1").Aggregate,
 								8,
 								true, true, true, true,
+								false,
 							),
 						),
 					),
@@ -96,6 +97,7 @@ func TestCommandSide_AddPasswordComplexityPolicy(t *testing.T) {
 							&org.NewAggregate("org1").Aggregate,
 							8,
 							true, true, true, true,
+							false,
 						),
 					),
 				),
@@ -219,6 +221,7 @@ func TestCommandSide_ChangePasswordComplexityPolicy(t *testing.T) {
 								&org.NewAggregate("org1").Aggregate,
 								8,
 								true, true, true, true,
+								false,
 							),
 						),
 					),
@@ -250,6 +253,7 @@ func TestCommandSide_ChangePasswordComplexityPolicy(t *testing.T) {
 								&org.NewAggregate("org1").Aggregate,
 								8,
 								true, true, true, true,
+								false,
 							),
 						),
 					),
@@ -283,6 +287,61 @@ func TestCommandSide_ChangePasswordComplexityPolicy(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "change check breached, ok",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewPasswordComplexityPolicyAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								8,
+								true, true, true, true,
+								false,
+							),
+						),
+					),
+					expectPush(
+						func() *org.PasswordComplexityPolicyChangedEvent {
+							event, _ := org.NewPasswordComplexityPolicyChangedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								[]policy.PasswordComplexityPolicyChanges{
+									policy.ChangeCheckBreached(true),
+								},
+							)
+							return event
+						}(),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				policy: &domain.PasswordComplexityPolicy{
+					MinLength:     8,
+					HasUppercase:  true,
+					HasLowercase:  true,
+					HasNumber:     true,
+					HasSymbol:     true,
+					CheckBreached: true,
+				},
+			},
+			res: res{
+				want: &domain.PasswordComplexityPolicy{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "org1",
+						ResourceOwner: "org1",
+					},
+					MinLength:     8,
+					HasUppercase:  true,
+					HasLowercase:  true,
+					HasNumber:     true,
+					HasSymbol:     true,
+					CheckBreached: true,
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -362,6 +421,7 @@ func TestCommandSide_RemovePasswordComplexityPolicy(t *testing.T) {
 								&org.NewAggregate("org1").Aggregate,
 								8,
 								true, true, true, true,
+								false,
 							),
 						),
 					),
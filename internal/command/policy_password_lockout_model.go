@@ -1,6 +1,8 @@
 package command
 
 import (
+	"time"
+
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/policy"
@@ -12,6 +14,8 @@ type LockoutPolicyWriteModel struct {
 	MaxPasswordAttempts uint64
 	MaxOTPAttempts      uint64
 	ShowLockOutFailures bool
+	LockoutDuration     time.Duration
+	FailedAttemptDelay  time.Duration
 	State               domain.PolicyState
 }
 
@@ -22,6 +26,8 @@ func (wm *LockoutPolicyWriteModel) Reduce() error {
 			wm.MaxPasswordAttempts = e.MaxPasswordAttempts
 			wm.MaxOTPAttempts = e.MaxOTPAttempts
 			wm.ShowLockOutFailures = e.ShowLockOutFailures
+			wm.LockoutDuration = e.LockoutDuration
+			wm.FailedAttemptDelay = e.FailedAttemptDelay
 			wm.State = domain.PolicyStateActive
 		case *policy.LockoutPolicyChangedEvent:
 			if e.MaxPasswordAttempts != nil {
@@ -33,6 +39,12 @@ func (wm *LockoutPolicyWriteModel) Reduce() error {
 			if e.ShowLockOutFailures != nil {
 				wm.ShowLockOutFailures = *e.ShowLockOutFailures
 			}
+			if e.LockoutDuration != nil {
+				wm.LockoutDuration = *e.LockoutDuration
+			}
+			if e.FailedAttemptDelay != nil {
+				wm.FailedAttemptDelay = *e.FailedAttemptDelay
+			}
 		case *policy.LockoutPolicyRemovedEvent:
 			wm.State = domain.PolicyStateRemoved
 		}
@@ -14,7 +14,9 @@ import (
 
 type addAPIApp struct {
 	AddApp
-	AuthMethodType domain.APIAuthMethodType
+	AuthMethodType         domain.APIAuthMethodType
+	TLSClientAuthSubjectDN string
+	TLSClientAuthSAN       string
 
 	ClientID          string
 	EncodedHash       string
@@ -61,6 +63,8 @@ func (c *Commands) AddAPIAppCommand(app *addAPIApp) preparation.Validation {
 					app.ClientID,
 					app.EncodedHash,
 					app.AuthMethodType,
+					strings.TrimSpace(app.TLSClientAuthSubjectDN),
+					strings.TrimSpace(app.TLSClientAuthSAN),
 				),
 			}, nil
 		}, nil
@@ -149,7 +153,10 @@ func (c *Commands) addAPIApplicationWithID(ctx context.Context, apiApp *domain.A
 		apiApp.AppID,
 		apiApp.ClientID,
 		apiApp.EncodedHash,
-		apiApp.AuthMethodType))
+		apiApp.AuthMethodType,
+		strings.TrimSpace(apiApp.TLSClientAuthSubjectDN),
+		strings.TrimSpace(apiApp.TLSClientAuthSAN),
+	))
 
 	addedApplication.AppID = apiApp.AppID
 	pushedEvents, err := c.eventstore.Push(ctx, events...)
@@ -166,7 +173,7 @@ func (c *Commands) addAPIApplicationWithID(ctx context.Context, apiApp *domain.A
 }
 
 func (c *Commands) UpdateAPIApplication(ctx context.Context, apiApp *domain.APIApp, resourceOwner string) (*domain.APIApp, error) {
-	if apiApp.AppID == "" || apiApp.AggregateID == "" {
+	if apiApp.AppID == "" || apiApp.AggregateID == "" || !apiApp.TLSClientAuthConfigValid() {
 		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-1m900", "Errors.Project.App.APIConfigInvalid")
 	}
 
@@ -192,7 +199,10 @@ func (c *Commands) UpdateAPIApplication(ctx context.Context, apiApp *domain.APIA
 		ctx,
 		projectAgg,
 		apiApp.AppID,
-		apiApp.AuthMethodType)
+		apiApp.AuthMethodType,
+		strings.TrimSpace(apiApp.TLSClientAuthSubjectDN),
+		strings.TrimSpace(apiApp.TLSClientAuthSAN),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -12,14 +12,16 @@ import (
 type APIApplicationWriteModel struct {
 	eventstore.WriteModel
 
-	AppID              string
-	AppName            string
-	ClientID           string
-	HashedSecret       string
-	ClientSecretString string
-	AuthMethodType     domain.APIAuthMethodType
-	State              domain.AppState
-	api                bool
+	AppID                  string
+	AppName                string
+	ClientID               string
+	HashedSecret           string
+	ClientSecretString     string
+	AuthMethodType         domain.APIAuthMethodType
+	TLSClientAuthSubjectDN string
+	TLSClientAuthSAN       string
+	State                  domain.AppState
+	api                    bool
 }
 
 func NewAPIApplicationWriteModelWithAppID(projectID, appID, resourceOwner string) *APIApplicationWriteModel {
@@ -128,6 +130,8 @@ func (wm *APIApplicationWriteModel) Reduce() error {
 			wm.HashedSecret = ""
 			wm.ClientSecretString = ""
 			wm.AuthMethodType = domain.APIAuthMethodTypeBasic
+			wm.TLSClientAuthSubjectDN = ""
+			wm.TLSClientAuthSAN = ""
 			wm.api = false
 			wm.State = domain.AppStateRemoved
 		case *project.ProjectAddedEvent:
@@ -136,6 +140,8 @@ func (wm *APIApplicationWriteModel) Reduce() error {
 			wm.HashedSecret = ""
 			wm.ClientSecretString = ""
 			wm.AuthMethodType = domain.APIAuthMethodTypeBasic
+			wm.TLSClientAuthSubjectDN = ""
+			wm.TLSClientAuthSAN = ""
 			wm.api = false
 			wm.State = domain.AppStateUnspecified
 		}
@@ -148,12 +154,20 @@ func (wm *APIApplicationWriteModel) appendAddAPIEvent(e *project.APIConfigAddedE
 	wm.ClientID = e.ClientID
 	wm.HashedSecret = crypto.SecretOrEncodedHash(e.ClientSecret, e.HashedSecret)
 	wm.AuthMethodType = e.AuthMethodType
+	wm.TLSClientAuthSubjectDN = e.TLSClientAuthSubjectDN
+	wm.TLSClientAuthSAN = e.TLSClientAuthSAN
 }
 
 func (wm *APIApplicationWriteModel) appendChangeAPIEvent(e *project.APIConfigChangedEvent) {
 	if e.AuthMethodType != nil {
 		wm.AuthMethodType = *e.AuthMethodType
 	}
+	if e.TLSClientAuthSubjectDN != nil {
+		wm.TLSClientAuthSubjectDN = *e.TLSClientAuthSubjectDN
+	}
+	if e.TLSClientAuthSAN != nil {
+		wm.TLSClientAuthSAN = *e.TLSClientAuthSAN
+	}
 }
 
 func (wm *APIApplicationWriteModel) Query() *eventstore.SearchQueryBuilder {
@@ -182,6 +196,8 @@ func (wm *APIApplicationWriteModel) NewChangedEvent(
 	aggregate *eventstore.Aggregate,
 	appID string,
 	authMethodType domain.APIAuthMethodType,
+	tlsClientAuthSubjectDN,
+	tlsClientAuthSAN string,
 ) (*project.APIConfigChangedEvent, bool, error) {
 	changes := make([]project.APIConfigChanges, 0)
 	var err error
@@ -189,6 +205,12 @@ func (wm *APIApplicationWriteModel) NewChangedEvent(
 	if wm.AuthMethodType != authMethodType {
 		changes = append(changes, project.ChangeAPIAuthMethodType(authMethodType))
 	}
+	if wm.TLSClientAuthSubjectDN != tlsClientAuthSubjectDN {
+		changes = append(changes, project.ChangeAPITLSClientAuthSubjectDN(tlsClientAuthSubjectDN))
+	}
+	if wm.TLSClientAuthSAN != tlsClientAuthSAN {
+		changes = append(changes, project.ChangeAPITLSClientAuthSAN(tlsClientAuthSAN))
+	}
 	if len(changes) == 0 {
 		return nil, false, nil
 	}
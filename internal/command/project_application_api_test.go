@@ -115,6 +115,8 @@ func TestAddAPIConfig(t *testing.T) {
 						"clientID",
 						"",
 						domain.APIAuthMethodTypePrivateKeyJWT,
+						"",
+						"",
 					),
 				},
 			},
@@ -251,7 +253,7 @@ func TestCommandSide_AddAPIApplication(t *testing.T) {
 							"app1",
 							"client1",
 							"secret",
-							domain.APIAuthMethodTypeBasic),
+							domain.APIAuthMethodTypeBasic, "", ""),
 					),
 				),
 				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "app1", "client1"),
@@ -306,7 +308,7 @@ func TestCommandSide_AddAPIApplication(t *testing.T) {
 							"app1",
 							"client1@project1",
 							"secret",
-							domain.APIAuthMethodTypeBasic),
+							domain.APIAuthMethodTypeBasic, "", ""),
 					),
 				),
 				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "app1", "client1@project1"),
@@ -361,7 +363,7 @@ func TestCommandSide_AddAPIApplication(t *testing.T) {
 							"app1",
 							"client1",
 							"",
-							domain.APIAuthMethodTypePrivateKeyJWT),
+							domain.APIAuthMethodTypePrivateKeyJWT, "", ""),
 					),
 				),
 				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "app1", "client1"),
@@ -522,7 +524,7 @@ func TestCommandSide_ChangeAPIApplication(t *testing.T) {
 								"app1",
 								"client1@project",
 								"",
-								domain.APIAuthMethodTypePrivateKeyJWT),
+								domain.APIAuthMethodTypePrivateKeyJWT, "", ""),
 						),
 					),
 					expectFilter(),
@@ -562,7 +564,7 @@ func TestCommandSide_ChangeAPIApplication(t *testing.T) {
 								"app1",
 								"client1@project",
 								"secret",
-								domain.APIAuthMethodTypeBasic),
+								domain.APIAuthMethodTypeBasic, "", ""),
 						),
 					),
 					expectFilter(),
@@ -714,7 +716,7 @@ func TestCommandSide_ChangeAPIApplicationSecret(t *testing.T) {
 								"app1",
 								"client1@project",
 								"secret",
-								domain.APIAuthMethodTypeBasic),
+								domain.APIAuthMethodTypeBasic, "", ""),
 						),
 					),
 					expectPush(
@@ -117,23 +117,23 @@ func (wm *ApplicationKeyWriteModel) Reduce() error {
 
 func (wm *ApplicationKeyWriteModel) appendAddOIDCEvent(e *project.OIDCConfigAddedEvent) {
 	wm.ClientID = e.ClientID
-	wm.KeysAllowed = e.AuthMethodType == domain.OIDCAuthMethodTypePrivateKeyJWT
+	wm.KeysAllowed = e.AuthMethodType.UsesKeys()
 }
 
 func (wm *ApplicationKeyWriteModel) appendChangeOIDCEvent(e *project.OIDCConfigChangedEvent) {
 	if e.AuthMethodType != nil {
-		wm.KeysAllowed = *e.AuthMethodType == domain.OIDCAuthMethodTypePrivateKeyJWT
+		wm.KeysAllowed = e.AuthMethodType.UsesKeys()
 	}
 }
 
 func (wm *ApplicationKeyWriteModel) appendAddAPIEvent(e *project.APIConfigAddedEvent) {
 	wm.ClientID = e.ClientID
-	wm.KeysAllowed = e.AuthMethodType == domain.APIAuthMethodTypePrivateKeyJWT
+	wm.KeysAllowed = e.AuthMethodType.UsesKeys()
 }
 
 func (wm *ApplicationKeyWriteModel) appendChangeAPIEvent(e *project.APIConfigChangedEvent) {
 	if e.AuthMethodType != nil {
-		wm.KeysAllowed = *e.AuthMethodType == domain.APIAuthMethodTypePrivateKeyJWT
+		wm.KeysAllowed = e.AuthMethodType.UsesKeys()
 	}
 }
 
@@ -114,7 +114,7 @@ func TestCommandSide_AddAPIApplicationKey(t *testing.T) {
 								"app1",
 								"client1@project",
 								"secret",
-								domain.APIAuthMethodTypeBasic),
+								domain.APIAuthMethodTypeBasic, "", ""),
 						),
 					),
 				),
@@ -155,7 +155,7 @@ func TestCommandSide_AddAPIApplicationKey(t *testing.T) {
 								"app1",
 								"client1@project",
 								"secret",
-								domain.APIAuthMethodTypeBasic),
+								domain.APIAuthMethodTypeBasic, "", ""),
 						),
 					),
 				),
@@ -197,7 +197,7 @@ func TestCommandSide_AddAPIApplicationKey(t *testing.T) {
 								"app1",
 								"client1@project",
 								"secret",
-								domain.APIAuthMethodTypeBasic),
+								domain.APIAuthMethodTypeBasic, "", ""),
 						),
 					),
 				),
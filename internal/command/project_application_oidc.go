@@ -20,28 +20,34 @@ import (
 
 type addOIDCApp struct {
 	AddApp
-	Version                       domain.OIDCVersion
-	RedirectUris                  []string
-	ResponseTypes                 []domain.OIDCResponseType
-	GrantTypes                    []domain.OIDCGrantType
-	ApplicationType               domain.OIDCApplicationType
-	AuthMethodType                domain.OIDCAuthMethodType
-	PostLogoutRedirectUris        []string
-	DevMode                       bool
-	AccessTokenType               domain.OIDCTokenType
-	AccessTokenRoleAssertion      bool
-	IDTokenRoleAssertion          bool
-	IDTokenUserinfoAssertion      bool
-	ClockSkew                     time.Duration
-	AdditionalOrigins             []string
-	SkipSuccessPageForNativeApp   bool
-	BackChannelLogoutURI          string
-	LoginVersion                  domain.LoginVersion
-	LoginBaseURI                  string
-	IOSTeamID                     string
-	IOSBundleID                   string
-	AndroidPackageName            string
-	AndroidSHA256CertFingerprints []string
+	Version                          domain.OIDCVersion
+	RedirectUris                     []string
+	ResponseTypes                    []domain.OIDCResponseType
+	GrantTypes                       []domain.OIDCGrantType
+	ApplicationType                  domain.OIDCApplicationType
+	AuthMethodType                   domain.OIDCAuthMethodType
+	PostLogoutRedirectUris           []string
+	DevMode                          bool
+	AccessTokenType                  domain.OIDCTokenType
+	AccessTokenRoleAssertion         bool
+	IDTokenRoleAssertion             bool
+	IDTokenUserinfoAssertion         bool
+	ClockSkew                        time.Duration
+	AdditionalOrigins                []string
+	SkipSuccessPageForNativeApp      bool
+	BackChannelLogoutURI             string
+	LoginVersion                     domain.LoginVersion
+	LoginBaseURI                     string
+	IOSTeamID                        string
+	IOSBundleID                      string
+	AndroidPackageName               string
+	AndroidSHA256CertFingerprints    []string
+	DPoPRequired                     bool
+	PARRequired                      bool
+	TLSClientAuthSubjectDN           string
+	TLSClientAuthSAN                 string
+	BackChannelClientNotificationURI string
+	ConsentRequired                  bool
 
 	ClientID          string
 	ClientSecret      string
@@ -126,6 +132,12 @@ func (c *Commands) AddOIDCAppCommand(app *addOIDCApp) preparation.Validation {
 					app.IOSBundleID,
 					app.AndroidPackageName,
 					app.AndroidSHA256CertFingerprints,
+					app.DPoPRequired,
+					app.PARRequired,
+					strings.TrimSpace(app.TLSClientAuthSubjectDN),
+					strings.TrimSpace(app.TLSClientAuthSAN),
+					strings.TrimSpace(app.BackChannelClientNotificationURI),
+					app.ConsentRequired,
 				),
 			}, nil
 		}, nil
@@ -263,6 +275,12 @@ func (c *Commands) pushOIDCApplication(ctx context.Context, addedApplication *OI
 		strings.TrimSpace(gu.Value(oidcApp.IOSBundleID)),
 		strings.TrimSpace(gu.Value(oidcApp.AndroidPackageName)),
 		trimStringSliceWhiteSpaces(oidcApp.AndroidSHA256CertFingerprints),
+		gu.Value(oidcApp.DPoPRequired),
+		gu.Value(oidcApp.PARRequired),
+		strings.TrimSpace(gu.Value(oidcApp.TLSClientAuthSubjectDN)),
+		strings.TrimSpace(gu.Value(oidcApp.TLSClientAuthSAN)),
+		strings.TrimSpace(gu.Value(oidcApp.BackChannelClientNotificationURI)),
+		gu.Value(oidcApp.ConsentRequired),
 	))
 
 	events = append(events, extraEvents...)
@@ -352,7 +370,7 @@ func (c *Commands) UpdateOIDCApplication(ctx context.Context, oidc *domain.OIDCA
 // UpdateDynamicOIDCClient). It reports whether anything actually changed.
 func (c *Commands) oidcApplicationChangeEvent(ctx context.Context, existingOIDC *OIDCApplicationWriteModel, oidc *domain.OIDCApp) (*project_repo.OIDCConfigChangedEvent, bool, error) {
 	projectAgg := ProjectAggregateFromWriteModelWithCTX(ctx, &existingOIDC.WriteModel)
-	var backChannelLogout, loginBaseURI, iosTeamID, iosBundleID, androidPackageName *string
+	var backChannelLogout, loginBaseURI, iosTeamID, iosBundleID, androidPackageName, tlsClientAuthSubjectDN, tlsClientAuthSAN, backChannelClientNotificationURI *string
 	if oidc.BackChannelLogoutURI != nil {
 		bcl, err := c.validateBackchannelLogoutURI(oidc)
 		if err != nil {
@@ -373,6 +391,15 @@ func (c *Commands) oidcApplicationChangeEvent(ctx context.Context, existingOIDC
 	if oidc.AndroidPackageName != nil {
 		androidPackageName = gu.Ptr(strings.TrimSpace(*oidc.AndroidPackageName))
 	}
+	if oidc.TLSClientAuthSubjectDN != nil {
+		tlsClientAuthSubjectDN = gu.Ptr(strings.TrimSpace(*oidc.TLSClientAuthSubjectDN))
+	}
+	if oidc.TLSClientAuthSAN != nil {
+		tlsClientAuthSAN = gu.Ptr(strings.TrimSpace(*oidc.TLSClientAuthSAN))
+	}
+	if oidc.BackChannelClientNotificationURI != nil {
+		backChannelClientNotificationURI = gu.Ptr(strings.TrimSpace(*oidc.BackChannelClientNotificationURI))
+	}
 
 	return existingOIDC.NewChangedEvent(
 		ctx,
@@ -400,6 +427,12 @@ func (c *Commands) oidcApplicationChangeEvent(ctx context.Context, existingOIDC
 		iosBundleID,
 		androidPackageName,
 		trimStringSliceWhiteSpaces(oidc.AndroidSHA256CertFingerprints),
+		oidc.DPoPRequired,
+		oidc.PARRequired,
+		tlsClientAuthSubjectDN,
+		tlsClientAuthSAN,
+		backChannelClientNotificationURI,
+		oidc.ConsentRequired,
 	)
 }
 
@@ -237,7 +237,7 @@ func TestCommandSide_AddDynamicOIDCClient(t *testing.T) {
 							"",
 							"",
 							"",
-							nil),
+							nil, false, false, "", "", "", false),
 						// The registration access token (RFC 7592 §3) is persisted in the same
 						// push as the application, so a registered client is never left
 						// unmanageable.
@@ -292,6 +292,9 @@ func TestCommandSide_AddDynamicOIDCClient(t *testing.T) {
 					BackChannelLogoutURI:     gu.Ptr(""),
 					LoginVersion:             gu.Ptr(domain.LoginVersionUnspecified),
 					LoginBaseURI:             gu.Ptr(""),
+					DPoPRequired:             gu.Ptr(false),
+					PARRequired:              gu.Ptr(false),
+					ConsentRequired:          gu.Ptr(false),
 					State:                    domain.AppStateActive,
 					Compliance:               &domain.Compliance{},
 				},
@@ -342,7 +345,7 @@ func TestCommandSide_AddDynamicOIDCClient(t *testing.T) {
 							"",
 							"",
 							"",
-							nil),
+							nil, false, false, "", "", "", false),
 						project.NewOIDCConfigRegistrationTokenChangedEvent(context.Background(),
 							&project.NewAggregate("project1", "org1").Aggregate,
 							"app1",
@@ -393,6 +396,9 @@ func TestCommandSide_AddDynamicOIDCClient(t *testing.T) {
 					BackChannelLogoutURI:     gu.Ptr(""),
 					LoginVersion:             gu.Ptr(domain.LoginVersionUnspecified),
 					LoginBaseURI:             gu.Ptr(""),
+					DPoPRequired:             gu.Ptr(false),
+					PARRequired:              gu.Ptr(false),
+					ConsentRequired:          gu.Ptr(false),
 					State:                    domain.AppStateActive,
 					Compliance:               &domain.Compliance{},
 				},
@@ -591,7 +597,7 @@ func TestCommandSide_UpdateDynamicOIDCClient(t *testing.T) {
 				"",
 				"",
 				"",
-				nil)),
+				nil, false, false, "", "", "", false)),
 		}
 	}
 	sameMetadata := &domain.OIDCApp{
@@ -14,36 +14,42 @@ import (
 type OIDCApplicationWriteModel struct {
 	eventstore.WriteModel
 
-	AppID                         string
-	AppName                       string
-	ClientID                      string
-	HashedSecret                  string
-	ClientSecretString            string
-	RedirectUris                  []string
-	ResponseTypes                 []domain.OIDCResponseType
-	GrantTypes                    []domain.OIDCGrantType
-	ApplicationType               domain.OIDCApplicationType
-	AuthMethodType                domain.OIDCAuthMethodType
-	PostLogoutRedirectUris        []string
-	OIDCVersion                   domain.OIDCVersion
-	Compliance                    *domain.Compliance
-	DevMode                       bool
-	AccessTokenType               domain.OIDCTokenType
-	AccessTokenRoleAssertion      bool
-	IDTokenRoleAssertion          bool
-	IDTokenUserinfoAssertion      bool
-	ClockSkew                     time.Duration
-	State                         domain.AppState
-	AdditionalOrigins             []string
-	SkipNativeAppSuccessPage      bool
-	BackChannelLogoutURI          string
-	LoginVersion                  domain.LoginVersion
-	LoginBaseURI                  string
-	IOSTeamID                     string
-	IOSBundleID                   string
-	AndroidPackageName            string
-	AndroidSHA256CertFingerprints []string
-	oidc                          bool
+	AppID                            string
+	AppName                          string
+	ClientID                         string
+	HashedSecret                     string
+	ClientSecretString               string
+	RedirectUris                     []string
+	ResponseTypes                    []domain.OIDCResponseType
+	GrantTypes                       []domain.OIDCGrantType
+	ApplicationType                  domain.OIDCApplicationType
+	AuthMethodType                   domain.OIDCAuthMethodType
+	PostLogoutRedirectUris           []string
+	OIDCVersion                      domain.OIDCVersion
+	Compliance                       *domain.Compliance
+	DevMode                          bool
+	AccessTokenType                  domain.OIDCTokenType
+	AccessTokenRoleAssertion         bool
+	IDTokenRoleAssertion             bool
+	IDTokenUserinfoAssertion         bool
+	ClockSkew                        time.Duration
+	State                            domain.AppState
+	AdditionalOrigins                []string
+	SkipNativeAppSuccessPage         bool
+	BackChannelLogoutURI             string
+	LoginVersion                     domain.LoginVersion
+	LoginBaseURI                     string
+	IOSTeamID                        string
+	IOSBundleID                      string
+	AndroidPackageName               string
+	AndroidSHA256CertFingerprints    []string
+	DPoPRequired                     bool
+	PARRequired                      bool
+	TLSClientAuthSubjectDN           string
+	TLSClientAuthSAN                 string
+	BackChannelClientNotificationURI string
+	ConsentRequired                  bool
+	oidc                             bool
 }
 
 func NewOIDCApplicationWriteModelWithAppID(projectID, appID, resourceOwner string) *OIDCApplicationWriteModel {
@@ -148,6 +154,12 @@ func (wm *OIDCApplicationWriteModel) Reduce() error {
 			wm.IOSBundleID = ""
 			wm.AndroidPackageName = ""
 			wm.AndroidSHA256CertFingerprints = nil
+			wm.DPoPRequired = false
+			wm.PARRequired = false
+			wm.TLSClientAuthSubjectDN = ""
+			wm.TLSClientAuthSAN = ""
+			wm.BackChannelClientNotificationURI = ""
+			wm.ConsentRequired = false
 			wm.oidc = false
 			wm.AppName = e.Name
 			wm.State = domain.AppStateActive
@@ -201,6 +213,12 @@ func (wm *OIDCApplicationWriteModel) Reduce() error {
 			wm.IOSBundleID = ""
 			wm.AndroidPackageName = ""
 			wm.AndroidSHA256CertFingerprints = nil
+			wm.DPoPRequired = false
+			wm.PARRequired = false
+			wm.TLSClientAuthSubjectDN = ""
+			wm.TLSClientAuthSAN = ""
+			wm.BackChannelClientNotificationURI = ""
+			wm.ConsentRequired = false
 			wm.oidc = false
 			wm.State = domain.AppStateRemoved
 		}
@@ -234,6 +252,12 @@ func (wm *OIDCApplicationWriteModel) appendAddOIDCEvent(e *project.OIDCConfigAdd
 	wm.IOSBundleID = e.IOSBundleID
 	wm.AndroidPackageName = e.AndroidPackageName
 	wm.AndroidSHA256CertFingerprints = e.AndroidSHA256CertFingerprints
+	wm.DPoPRequired = e.DPoPRequired
+	wm.PARRequired = e.PARRequired
+	wm.TLSClientAuthSubjectDN = e.TLSClientAuthSubjectDN
+	wm.TLSClientAuthSAN = e.TLSClientAuthSAN
+	wm.BackChannelClientNotificationURI = e.BackChannelClientNotificationURI
+	wm.ConsentRequired = e.ConsentRequired
 }
 
 func (wm *OIDCApplicationWriteModel) appendChangeOIDCEvent(e *project.OIDCConfigChangedEvent) {
@@ -303,6 +327,24 @@ func (wm *OIDCApplicationWriteModel) appendChangeOIDCEvent(e *project.OIDCConfig
 	if e.AndroidSHA256CertFingerprints != nil {
 		wm.AndroidSHA256CertFingerprints = *e.AndroidSHA256CertFingerprints
 	}
+	if e.DPoPRequired != nil {
+		wm.DPoPRequired = *e.DPoPRequired
+	}
+	if e.PARRequired != nil {
+		wm.PARRequired = *e.PARRequired
+	}
+	if e.TLSClientAuthSubjectDN != nil {
+		wm.TLSClientAuthSubjectDN = *e.TLSClientAuthSubjectDN
+	}
+	if e.TLSClientAuthSAN != nil {
+		wm.TLSClientAuthSAN = *e.TLSClientAuthSAN
+	}
+	if e.BackChannelClientNotificationURI != nil {
+		wm.BackChannelClientNotificationURI = *e.BackChannelClientNotificationURI
+	}
+	if e.ConsentRequired != nil {
+		wm.ConsentRequired = *e.ConsentRequired
+	}
 }
 
 func (wm *OIDCApplicationWriteModel) Query() *eventstore.SearchQueryBuilder {
@@ -352,6 +394,12 @@ func (wm *OIDCApplicationWriteModel) NewChangedEvent(
 	iosBundleID *string,
 	androidPackageName *string,
 	androidSHA256CertFingerprints []string,
+	dpopRequired *bool,
+	parRequired *bool,
+	tlsClientAuthSubjectDN *string,
+	tlsClientAuthSAN *string,
+	backChannelClientNotificationURI *string,
+	consentRequired *bool,
 ) (*project.OIDCConfigChangedEvent, bool, error) {
 	changes := make([]project.OIDCConfigChanges, 0)
 	var err error
@@ -422,6 +470,24 @@ func (wm *OIDCApplicationWriteModel) NewChangedEvent(
 	if androidSHA256CertFingerprints != nil && !slices.Equal(wm.AndroidSHA256CertFingerprints, androidSHA256CertFingerprints) {
 		changes = append(changes, project.ChangeAndroidSHA256CertFingerprints(androidSHA256CertFingerprints))
 	}
+	if dpopRequired != nil && wm.DPoPRequired != *dpopRequired {
+		changes = append(changes, project.ChangeDPoPRequired(*dpopRequired))
+	}
+	if parRequired != nil && wm.PARRequired != *parRequired {
+		changes = append(changes, project.ChangePARRequired(*parRequired))
+	}
+	if tlsClientAuthSubjectDN != nil && wm.TLSClientAuthSubjectDN != *tlsClientAuthSubjectDN {
+		changes = append(changes, project.ChangeTLSClientAuthSubjectDN(*tlsClientAuthSubjectDN))
+	}
+	if tlsClientAuthSAN != nil && wm.TLSClientAuthSAN != *tlsClientAuthSAN {
+		changes = append(changes, project.ChangeTLSClientAuthSAN(*tlsClientAuthSAN))
+	}
+	if backChannelClientNotificationURI != nil && wm.BackChannelClientNotificationURI != *backChannelClientNotificationURI {
+		changes = append(changes, project.ChangeBackChannelClientNotificationURI(*backChannelClientNotificationURI))
+	}
+	if consentRequired != nil && wm.ConsentRequired != *consentRequired {
+		changes = append(changes, project.ChangeConsentRequired(*consentRequired))
+	}
 
 	if len(changes) == 0 {
 		return nil, false, nil
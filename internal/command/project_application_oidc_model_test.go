@@ -38,6 +38,12 @@ func TestOIDCApplicationWriteModel_NewChangedEvent_AppLinks(t *testing.T) {
 			nil, nil, nil, nil, nil, nil, nil, nil,
 			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
 			nil, nil, nil, nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
 		)
 		require.NoError(t, err)
 		assert.False(t, hasChanged)
@@ -55,6 +61,12 @@ func TestOIDCApplicationWriteModel_NewChangedEvent_AppLinks(t *testing.T) {
 			gu.Ptr("com.new.app"),
 			gu.Ptr("com.new.app"),
 			[]string{"BB:BB"},
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
 		)
 		require.NoError(t, err)
 		require.True(t, hasChanged)
@@ -76,6 +88,12 @@ func TestOIDCApplicationWriteModel_NewChangedEvent_AppLinks(t *testing.T) {
 			gu.Ptr(""),
 			gu.Ptr(""),
 			[]string{},
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
 		)
 		require.NoError(t, err)
 		require.True(t, hasChanged)
@@ -85,4 +103,109 @@ func TestOIDCApplicationWriteModel_NewChangedEvent_AppLinks(t *testing.T) {
 		assert.Equal(t, gu.Ptr(""), event.AndroidPackageName)
 		assert.Equal(t, &[]string{}, event.AndroidSHA256CertFingerprints)
 	})
+	t.Run("set dpop required", func(t *testing.T) {
+		t.Parallel()
+		wm := base()
+		event, hasChanged, err := wm.NewChangedEvent(
+			context.Background(), agg, "app-id",
+			nil, nil, nil, nil, nil, nil, nil, nil,
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			nil, nil, nil, nil,
+			gu.Ptr(true),
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+		require.NoError(t, err)
+		require.True(t, hasChanged)
+		require.NotNil(t, event)
+		assert.Equal(t, gu.Ptr(true), event.DPoPRequired)
+		assert.Nil(t, event.IOSTeamID)
+	})
+	t.Run("set par required", func(t *testing.T) {
+		t.Parallel()
+		wm := base()
+		event, hasChanged, err := wm.NewChangedEvent(
+			context.Background(), agg, "app-id",
+			nil, nil, nil, nil, nil, nil, nil, nil,
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			nil, nil, nil, nil,
+			nil,
+			gu.Ptr(true),
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+		require.NoError(t, err)
+		require.True(t, hasChanged)
+		require.NotNil(t, event)
+		assert.Equal(t, gu.Ptr(true), event.PARRequired)
+		assert.Nil(t, event.DPoPRequired)
+	})
+	t.Run("set tls client auth subject", func(t *testing.T) {
+		t.Parallel()
+		wm := base()
+		event, hasChanged, err := wm.NewChangedEvent(
+			context.Background(), agg, "app-id",
+			nil, nil, nil, nil, nil, nil, nil, nil,
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			nil, nil, nil, nil,
+			nil,
+			nil,
+			gu.Ptr("CN=client,O=ZITADEL"),
+			gu.Ptr(""),
+			nil,
+			nil,
+		)
+		require.NoError(t, err)
+		require.True(t, hasChanged)
+		require.NotNil(t, event)
+		assert.Equal(t, gu.Ptr("CN=client,O=ZITADEL"), event.TLSClientAuthSubjectDN)
+		assert.Nil(t, event.TLSClientAuthSAN)
+	})
+	t.Run("set backchannel client notification uri", func(t *testing.T) {
+		t.Parallel()
+		wm := base()
+		event, hasChanged, err := wm.NewChangedEvent(
+			context.Background(), agg, "app-id",
+			nil, nil, nil, nil, nil, nil, nil, nil,
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			nil, nil, nil, nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			gu.Ptr("https://client.example.com/ciba"),
+			nil,
+		)
+		require.NoError(t, err)
+		require.True(t, hasChanged)
+		require.NotNil(t, event)
+		assert.Equal(t, gu.Ptr("https://client.example.com/ciba"), event.BackChannelClientNotificationURI)
+		assert.Nil(t, event.TLSClientAuthSAN)
+	})
+	t.Run("set consent required", func(t *testing.T) {
+		t.Parallel()
+		wm := base()
+		event, hasChanged, err := wm.NewChangedEvent(
+			context.Background(), agg, "app-id",
+			nil, nil, nil, nil, nil, nil, nil, nil,
+			nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+			nil, nil, nil, nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			gu.Ptr(true),
+		)
+		require.NoError(t, err)
+		require.True(t, hasChanged)
+		require.NotNil(t, event)
+		assert.Equal(t, gu.Ptr(true), event.ConsentRequired)
+		assert.Nil(t, event.PARRequired)
+	})
 }
@@ -179,7 +179,7 @@ func TestAddOIDCApp(t *testing.T) {
 						"",
 						"",
 						"",
-						nil),
+						nil, false, false, "", "", "", false),
 				},
 			},
 		},
@@ -250,7 +250,7 @@ func TestAddOIDCApp(t *testing.T) {
 						"",
 						"",
 						"",
-						nil),
+						nil, false, false, "", "", "", false),
 				},
 			},
 		},
@@ -321,7 +321,7 @@ func TestAddOIDCApp(t *testing.T) {
 						"",
 						"",
 						"",
-						nil),
+						nil, false, false, "", "", "", false),
 				},
 			},
 		},
@@ -392,7 +392,7 @@ func TestAddOIDCApp(t *testing.T) {
 						"",
 						"",
 						"",
-						nil),
+						nil, false, false, "", "", "", false),
 				},
 			},
 		},
@@ -549,7 +549,7 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 							"",
 							"",
 							"",
-							nil),
+							nil, false, false, "", "", "", false),
 					),
 				),
 				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "app1", "client1"),
@@ -610,6 +610,9 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 					BackChannelLogoutURI:     gu.Ptr("https://test.ch/backchannel"),
 					LoginVersion:             gu.Ptr(domain.LoginVersion2),
 					LoginBaseURI:             gu.Ptr("https://login.test.ch"),
+					DPoPRequired:             gu.Ptr(false),
+					PARRequired:              gu.Ptr(false),
+					ConsentRequired:          gu.Ptr(false),
 					State:                    domain.AppStateActive,
 					Compliance:               &domain.Compliance{},
 				},
@@ -661,7 +664,7 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 							"",
 							"",
 							"",
-							nil),
+							nil, false, false, "", "", "", false),
 					),
 				),
 				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "client1"),
@@ -723,6 +726,9 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 					BackChannelLogoutURI:     gu.Ptr("https://test.ch/backchannel"),
 					LoginVersion:             gu.Ptr(domain.LoginVersion2),
 					LoginBaseURI:             gu.Ptr("https://login.test.ch"),
+					DPoPRequired:             gu.Ptr(false),
+					PARRequired:              gu.Ptr(false),
+					ConsentRequired:          gu.Ptr(false),
 					State:                    domain.AppStateActive,
 					Compliance:               &domain.Compliance{},
 				},
@@ -774,7 +780,7 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 							"",
 							"",
 							"",
-							nil),
+							nil, false, false, "", "", "", false),
 					),
 				),
 				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "app1", "client1"),
@@ -835,6 +841,9 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 					BackChannelLogoutURI:     gu.Ptr("https://test.ch/backchannel"),
 					LoginVersion:             gu.Ptr(domain.LoginVersion2),
 					LoginBaseURI:             gu.Ptr("https://login.test.ch"),
+					DPoPRequired:             gu.Ptr(false),
+					PARRequired:              gu.Ptr(false),
+					ConsentRequired:          gu.Ptr(false),
 					State:                    domain.AppStateActive,
 					Compliance:               &domain.Compliance{},
 				},
@@ -1096,7 +1105,7 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								"",
 								"",
 								"",
-								nil),
+								nil, false, false, "", "", "", false),
 						),
 					),
 					expectFilter(),
@@ -1174,7 +1183,7 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								"",
 								"",
 								"",
-								nil),
+								nil, false, false, "", "", "", false),
 						),
 					),
 					expectFilter(),
@@ -1252,7 +1261,7 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								"",
 								"",
 								"",
-								nil),
+								nil, false, false, "", "", "", false),
 						),
 					),
 					expectFilter(),
@@ -1305,6 +1314,9 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 					BackChannelLogoutURI:     gu.Ptr("https://test.ch/backchannel"),
 					LoginVersion:             gu.Ptr(domain.LoginVersion1),
 					LoginBaseURI:             gu.Ptr(""),
+					DPoPRequired:             gu.Ptr(false),
+					PARRequired:              gu.Ptr(false),
+					ConsentRequired:          gu.Ptr(false),
 					Compliance:               &domain.Compliance{},
 					State:                    domain.AppStateActive,
 				},
@@ -1349,7 +1361,7 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								"",
 								"",
 								"",
-								nil),
+								nil, false, false, "", "", "", false),
 						),
 					),
 					expectFilter(),
@@ -1412,7 +1424,7 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								"",
 								"",
 								"",
-								nil),
+								nil, false, false, "", "", "", false),
 						),
 					),
 					expectFilter(),
@@ -1570,7 +1582,7 @@ func TestCommandSide_ChangeOIDCApplicationSecret(t *testing.T) {
 								"",
 								"",
 								"",
-								nil),
+								nil, false, false, "", "", "", false),
 						),
 					),
 					expectPush(
@@ -1616,6 +1628,9 @@ func TestCommandSide_ChangeOIDCApplicationSecret(t *testing.T) {
 					BackChannelLogoutURI:     gu.Ptr(""),
 					LoginVersion:             gu.Ptr(domain.LoginVersionUnspecified),
 					LoginBaseURI:             gu.Ptr(""),
+					DPoPRequired:             gu.Ptr(false),
+					PARRequired:              gu.Ptr(false),
+					ConsentRequired:          gu.Ptr(false),
 					State:                    domain.AppStateActive,
 				},
 			},
@@ -5,6 +5,7 @@ import (
 
 	"github.com/muhlemmer/gu"
 	"github.com/zitadel/saml/pkg/provider/xml"
+	"github.com/zitadel/saml/pkg/provider/xml/md"
 
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
@@ -74,6 +75,9 @@ func (c *Commands) addSAMLApplication(ctx context.Context, projectAgg *eventstor
 	if err != nil {
 		return nil, zerrors.ThrowInvalidArgument(err, "SAML-bquso", "Errors.Project.App.SAMLMetadataFormat")
 	}
+	if err := checkSAMLAssertionEncryption(entity, gu.Value(samlApp.EncryptAssertion)); err != nil {
+		return nil, err
+	}
 
 	samlApp.AppID, err = c.idGenerator.Next()
 	if err != nil {
@@ -90,6 +94,12 @@ func (c *Commands) addSAMLApplication(ctx context.Context, projectAgg *eventstor
 			gu.Value(samlApp.MetadataURL),
 			gu.Value(samlApp.LoginVersion),
 			gu.Value(samlApp.LoginBaseURI),
+			gu.Value(samlApp.IDPInitiatedEnabled),
+			gu.Value(samlApp.IDPInitiatedRelayState),
+			gu.Value(samlApp.EncryptAssertion),
+			samlApp.NameIDFormat,
+			gu.Value(samlApp.NameIDSource),
+			gu.Value(samlApp.SignatureAlgorithm),
 		),
 	}, nil
 }
@@ -128,6 +138,13 @@ func (c *Commands) UpdateSAMLApplication(ctx context.Context, samlApp *domain.SA
 	if err != nil {
 		return nil, zerrors.ThrowInvalidArgument(err, "SAML-3fk2b", "Errors.Project.App.SAMLMetadataFormat")
 	}
+	encryptAssertion := existingSAML.EncryptAssertion
+	if samlApp.EncryptAssertion != nil {
+		encryptAssertion = *samlApp.EncryptAssertion
+	}
+	if err := checkSAMLAssertionEncryption(entity, encryptAssertion); err != nil {
+		return nil, err
+	}
 
 	changedEvent, hasChanged, err := existingSAML.NewChangedEvent(
 		ctx,
@@ -138,6 +155,12 @@ func (c *Commands) UpdateSAMLApplication(ctx context.Context, samlApp *domain.SA
 		samlApp.MetadataURL,
 		samlApp.LoginVersion,
 		samlApp.LoginBaseURI,
+		samlApp.IDPInitiatedEnabled,
+		samlApp.IDPInitiatedRelayState,
+		samlApp.EncryptAssertion,
+		samlApp.NameIDFormat,
+		samlApp.NameIDSource,
+		samlApp.SignatureAlgorithm,
 	)
 	if err != nil {
 		return nil, err
@@ -158,6 +181,22 @@ func (c *Commands) UpdateSAMLApplication(ctx context.Context, samlApp *domain.SA
 	return samlWriteModelToSAMLConfig(existingSAML), nil
 }
 
+// checkSAMLAssertionEncryption ensures the metadata of the service provider contains a certificate
+// to encrypt the assertion with, if the encryption is enabled.
+func checkSAMLAssertionEncryption(entity *md.EntityDescriptorType, encryptAssertion bool) error {
+	if !encryptAssertion {
+		return nil
+	}
+	cert, err := domain.SAMLEncryptionCertificate(entity)
+	if err != nil {
+		return zerrors.ThrowInvalidArgument(err, "SAML-Lq0fE", "Errors.Project.App.SAMLMetadataFormat")
+	}
+	if cert == nil {
+		return zerrors.ThrowInvalidArgument(nil, "SAML-Wd8sT", "Errors.Project.App.SAMLEncryptionCertificateMissing")
+	}
+	return nil
+}
+
 func (c *Commands) getSAMLAppWriteModel(ctx context.Context, projectID, appID, resourceOwner string) (*SAMLApplicationWriteModel, error) {
 	appWriteModel := NewSAMLApplicationWriteModelWithAppID(projectID, appID, resourceOwner)
 	err := c.eventstore.FilterToQueryReducer(ctx, appWriteModel)
@@ -20,6 +20,13 @@ type SAMLApplicationWriteModel struct {
 	LoginVersion domain.LoginVersion
 	LoginBaseURI string
 
+	IDPInitiatedEnabled    bool
+	IDPInitiatedRelayState string
+	EncryptAssertion       bool
+	NameIDFormat           domain.SAMLNameIDFormat
+	NameIDSource           domain.SAMLNameIDSource
+	SignatureAlgorithm     domain.SAMLSignatureAlgorithm
+
 	State domain.AppState
 	saml  bool
 }
@@ -118,6 +125,12 @@ func (wm *SAMLApplicationWriteModel) Reduce() error {
 			wm.MetadataURL = ""
 			wm.LoginVersion = domain.LoginVersionUnspecified
 			wm.LoginBaseURI = ""
+			wm.IDPInitiatedEnabled = false
+			wm.IDPInitiatedRelayState = ""
+			wm.EncryptAssertion = false
+			wm.NameIDFormat = domain.SAMLNameIDFormatEmailAddress
+			wm.NameIDSource = domain.SAMLNameIDSourceLoginName
+			wm.SignatureAlgorithm = domain.SAMLSignatureAlgorithmUnspecified
 			wm.saml = false
 			wm.State = domain.AppStateRemoved
 		case *project.ProjectAddedEvent:
@@ -127,6 +140,12 @@ func (wm *SAMLApplicationWriteModel) Reduce() error {
 			wm.MetadataURL = ""
 			wm.LoginVersion = domain.LoginVersionUnspecified
 			wm.LoginBaseURI = ""
+			wm.IDPInitiatedEnabled = false
+			wm.IDPInitiatedRelayState = ""
+			wm.EncryptAssertion = false
+			wm.NameIDFormat = domain.SAMLNameIDFormatEmailAddress
+			wm.NameIDSource = domain.SAMLNameIDSourceLoginName
+			wm.SignatureAlgorithm = domain.SAMLSignatureAlgorithmUnspecified
 			wm.saml = false
 			wm.State = domain.AppStateUnspecified
 		}
@@ -141,6 +160,16 @@ func (wm *SAMLApplicationWriteModel) appendAddSAMLEvent(e *project.SAMLConfigAdd
 	wm.EntityID = e.EntityID
 	wm.LoginVersion = e.LoginVersion
 	wm.LoginBaseURI = e.LoginBaseURI
+	wm.IDPInitiatedEnabled = e.IDPInitiatedEnabled
+	wm.IDPInitiatedRelayState = e.IDPInitiatedRelayState
+	wm.EncryptAssertion = e.EncryptAssertion
+	// apps created before the format was configurable use the email address format
+	wm.NameIDFormat = domain.SAMLNameIDFormatEmailAddress
+	if e.NameIDFormat != nil {
+		wm.NameIDFormat = *e.NameIDFormat
+	}
+	wm.NameIDSource = e.NameIDSource
+	wm.SignatureAlgorithm = e.SignatureAlgorithm
 }
 
 func (wm *SAMLApplicationWriteModel) appendChangeSAMLEvent(e *project.SAMLConfigChangedEvent) {
@@ -160,6 +189,24 @@ func (wm *SAMLApplicationWriteModel) appendChangeSAMLEvent(e *project.SAMLConfig
 	if e.LoginBaseURI != nil {
 		wm.LoginBaseURI = *e.LoginBaseURI
 	}
+	if e.IDPInitiatedEnabled != nil {
+		wm.IDPInitiatedEnabled = *e.IDPInitiatedEnabled
+	}
+	if e.IDPInitiatedRelayState != nil {
+		wm.IDPInitiatedRelayState = *e.IDPInitiatedRelayState
+	}
+	if e.EncryptAssertion != nil {
+		wm.EncryptAssertion = *e.EncryptAssertion
+	}
+	if e.NameIDFormat != nil {
+		wm.NameIDFormat = *e.NameIDFormat
+	}
+	if e.NameIDSource != nil {
+		wm.NameIDSource = *e.NameIDSource
+	}
+	if e.SignatureAlgorithm != nil {
+		wm.SignatureAlgorithm = *e.SignatureAlgorithm
+	}
 }
 
 func (wm *SAMLApplicationWriteModel) Query() *eventstore.SearchQueryBuilder {
@@ -190,6 +237,12 @@ func (wm *SAMLApplicationWriteModel) NewChangedEvent(
 	metadataURL *string,
 	loginVersion *domain.LoginVersion,
 	loginBaseURI *string,
+	idpInitiatedEnabled *bool,
+	idpInitiatedRelayState *string,
+	encryptAssertion *bool,
+	nameIDFormat *domain.SAMLNameIDFormat,
+	nameIDSource *domain.SAMLNameIDSource,
+	signatureAlgorithm *domain.SAMLSignatureAlgorithm,
 ) (*project.SAMLConfigChangedEvent, bool, error) {
 	changes := make([]project.SAMLConfigChanges, 0)
 	var err error
@@ -208,6 +261,24 @@ func (wm *SAMLApplicationWriteModel) NewChangedEvent(
 	if loginBaseURI != nil && wm.LoginBaseURI != *loginBaseURI {
 		changes = append(changes, project.ChangeSAMLLoginBaseURI(*loginBaseURI))
 	}
+	if idpInitiatedEnabled != nil && wm.IDPInitiatedEnabled != *idpInitiatedEnabled {
+		changes = append(changes, project.ChangeSAMLIDPInitiatedEnabled(*idpInitiatedEnabled))
+	}
+	if idpInitiatedRelayState != nil && wm.IDPInitiatedRelayState != *idpInitiatedRelayState {
+		changes = append(changes, project.ChangeSAMLIDPInitiatedRelayState(*idpInitiatedRelayState))
+	}
+	if encryptAssertion != nil && wm.EncryptAssertion != *encryptAssertion {
+		changes = append(changes, project.ChangeSAMLEncryptAssertion(*encryptAssertion))
+	}
+	if nameIDFormat != nil && wm.NameIDFormat != *nameIDFormat {
+		changes = append(changes, project.ChangeSAMLNameIDFormat(*nameIDFormat))
+	}
+	if nameIDSource != nil && wm.NameIDSource != *nameIDSource {
+		changes = append(changes, project.ChangeSAMLNameIDSource(*nameIDSource))
+	}
+	if signatureAlgorithm != nil && wm.SignatureAlgorithm != *signatureAlgorithm {
+		changes = append(changes, project.ChangeSAMLSignatureAlgorithm(*signatureAlgorithm))
+	}
 
 	if len(changes) == 0 {
 		return nil, false, nil
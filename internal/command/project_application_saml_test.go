@@ -202,6 +202,40 @@ func TestCommandSide_AddSAMLApplication(t *testing.T) {
 				err: zerrors.IsErrorInvalidArgument,
 			},
 		},
+		{
+			name: "create saml app, encrypt assertion without encryption certificate",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"project", true, true, true,
+								domain.PrivateLabelingSettingUnspecified),
+						),
+					),
+					expectFilter(),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instanceID"),
+				samlApp: &domain.SAMLApp{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					AppName:          "app",
+					EntityID:         "https://test.com/saml/metadata",
+					Metadata:         testMetadata,
+					MetadataURL:      gu.Ptr(""),
+					EncryptAssertion: gu.Ptr(true),
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
 		{
 			name: "create saml app, ok",
 			fields: fields{
@@ -229,6 +263,12 @@ func TestCommandSide_AddSAMLApplication(t *testing.T) {
 							"",
 							domain.LoginVersionUnspecified,
 							"",
+							false,
+							"",
+							false,
+							nil,
+							domain.SAMLNameIDSourceLoginName,
+							domain.SAMLSignatureAlgorithmUnspecified,
 						),
 					),
 				),
@@ -253,14 +293,20 @@ func TestCommandSide_AddSAMLApplication(t *testing.T) {
 						AggregateID:   "project1",
 						ResourceOwner: "org1",
 					},
-					AppID:        "app1",
-					AppName:      "app",
-					EntityID:     "https://test.com/saml/metadata",
-					Metadata:     testMetadata,
-					MetadataURL:  gu.Ptr(""),
-					State:        domain.AppStateActive,
-					LoginVersion: gu.Ptr(domain.LoginVersionUnspecified),
-					LoginBaseURI: gu.Ptr(""),
+					AppID:                  "app1",
+					AppName:                "app",
+					EntityID:               "https://test.com/saml/metadata",
+					Metadata:               testMetadata,
+					MetadataURL:            gu.Ptr(""),
+					State:                  domain.AppStateActive,
+					LoginVersion:           gu.Ptr(domain.LoginVersionUnspecified),
+					LoginBaseURI:           gu.Ptr(""),
+					IDPInitiatedEnabled:    gu.Ptr(false),
+					IDPInitiatedRelayState: gu.Ptr(""),
+					EncryptAssertion:       gu.Ptr(false),
+					NameIDFormat:           gu.Ptr(domain.SAMLNameIDFormatEmailAddress),
+					NameIDSource:           gu.Ptr(domain.SAMLNameIDSourceLoginName),
+					SignatureAlgorithm:     gu.Ptr(domain.SAMLSignatureAlgorithmUnspecified),
 				},
 			},
 		},
@@ -291,6 +337,12 @@ func TestCommandSide_AddSAMLApplication(t *testing.T) {
 							"",
 							domain.LoginVersion2,
 							"https://test.com/login",
+							false,
+							"",
+							false,
+							nil,
+							domain.SAMLNameIDSourceLoginName,
+							domain.SAMLSignatureAlgorithmUnspecified,
 						),
 					),
 				),
@@ -317,14 +369,20 @@ func TestCommandSide_AddSAMLApplication(t *testing.T) {
 						AggregateID:   "project1",
 						ResourceOwner: "org1",
 					},
-					AppID:        "app1",
-					AppName:      "app",
-					EntityID:     "https://test.com/saml/metadata",
-					Metadata:     testMetadata,
-					MetadataURL:  gu.Ptr(""),
-					State:        domain.AppStateActive,
-					LoginVersion: gu.Ptr(domain.LoginVersion2),
-					LoginBaseURI: gu.Ptr("https://test.com/login"),
+					AppID:                  "app1",
+					AppName:                "app",
+					EntityID:               "https://test.com/saml/metadata",
+					Metadata:               testMetadata,
+					MetadataURL:            gu.Ptr(""),
+					State:                  domain.AppStateActive,
+					LoginVersion:           gu.Ptr(domain.LoginVersion2),
+					LoginBaseURI:           gu.Ptr("https://test.com/login"),
+					IDPInitiatedEnabled:    gu.Ptr(false),
+					IDPInitiatedRelayState: gu.Ptr(""),
+					EncryptAssertion:       gu.Ptr(false),
+					NameIDFormat:           gu.Ptr(domain.SAMLNameIDFormatEmailAddress),
+					NameIDSource:           gu.Ptr(domain.SAMLNameIDSourceLoginName),
+					SignatureAlgorithm:     gu.Ptr(domain.SAMLSignatureAlgorithmUnspecified),
 				},
 			},
 		},
@@ -355,6 +413,12 @@ func TestCommandSide_AddSAMLApplication(t *testing.T) {
 							"http://localhost:8080/saml/metadata",
 							domain.LoginVersionUnspecified,
 							"",
+							false,
+							"",
+							false,
+							nil,
+							domain.SAMLNameIDSourceLoginName,
+							domain.SAMLSignatureAlgorithmUnspecified,
 						),
 					),
 				),
@@ -380,14 +444,20 @@ func TestCommandSide_AddSAMLApplication(t *testing.T) {
 						AggregateID:   "project1",
 						ResourceOwner: "org1",
 					},
-					AppID:        "app1",
-					AppName:      "app",
-					EntityID:     "https://test.com/saml/metadata",
-					Metadata:     testMetadata,
-					MetadataURL:  gu.Ptr("http://localhost:8080/saml/metadata"),
-					State:        domain.AppStateActive,
-					LoginVersion: gu.Ptr(domain.LoginVersionUnspecified),
-					LoginBaseURI: gu.Ptr(""),
+					AppID:                  "app1",
+					AppName:                "app",
+					EntityID:               "https://test.com/saml/metadata",
+					Metadata:               testMetadata,
+					MetadataURL:            gu.Ptr("http://localhost:8080/saml/metadata"),
+					State:                  domain.AppStateActive,
+					LoginVersion:           gu.Ptr(domain.LoginVersionUnspecified),
+					LoginBaseURI:           gu.Ptr(""),
+					IDPInitiatedEnabled:    gu.Ptr(false),
+					IDPInitiatedRelayState: gu.Ptr(""),
+					EncryptAssertion:       gu.Ptr(false),
+					NameIDFormat:           gu.Ptr(domain.SAMLNameIDFormatEmailAddress),
+					NameIDSource:           gu.Ptr(domain.SAMLNameIDSourceLoginName),
+					SignatureAlgorithm:     gu.Ptr(domain.SAMLSignatureAlgorithmUnspecified),
 				},
 			},
 		},
@@ -576,6 +646,12 @@ func TestCommandSide_ChangeSAMLApplication(t *testing.T) {
 								"http://localhost:8080/saml/metadata",
 								domain.LoginVersionUnspecified,
 								"",
+								false,
+								"",
+								false,
+								nil,
+								domain.SAMLNameIDSourceLoginName,
+								domain.SAMLSignatureAlgorithmUnspecified,
 							),
 						),
 					),
@@ -622,6 +698,12 @@ func TestCommandSide_ChangeSAMLApplication(t *testing.T) {
 								"",
 								domain.LoginVersionUnspecified,
 								"",
+								false,
+								"",
+								false,
+								nil,
+								domain.SAMLNameIDSourceLoginName,
+								domain.SAMLSignatureAlgorithmUnspecified,
 							),
 						),
 					),
@@ -668,6 +750,12 @@ func TestCommandSide_ChangeSAMLApplication(t *testing.T) {
 								"http://localhost:8080/saml/metadata",
 								domain.LoginVersionUnspecified,
 								"",
+								false,
+								"",
+								false,
+								nil,
+								domain.SAMLNameIDSourceLoginName,
+								domain.SAMLSignatureAlgorithmUnspecified,
 							),
 						),
 					),
@@ -705,14 +793,20 @@ func TestCommandSide_ChangeSAMLApplication(t *testing.T) {
 						AggregateID:   "project1",
 						ResourceOwner: "org1",
 					},
-					AppID:        "app1",
-					AppName:      "app",
-					EntityID:     "https://test2.com/saml/metadata",
-					Metadata:     testMetadataChangedEntityID,
-					MetadataURL:  gu.Ptr("http://localhost:8080/saml/metadata"),
-					State:        domain.AppStateActive,
-					LoginVersion: gu.Ptr(domain.LoginVersionUnspecified),
-					LoginBaseURI: gu.Ptr(""),
+					AppID:                  "app1",
+					AppName:                "app",
+					EntityID:               "https://test2.com/saml/metadata",
+					Metadata:               testMetadataChangedEntityID,
+					MetadataURL:            gu.Ptr("http://localhost:8080/saml/metadata"),
+					State:                  domain.AppStateActive,
+					LoginVersion:           gu.Ptr(domain.LoginVersionUnspecified),
+					LoginBaseURI:           gu.Ptr(""),
+					IDPInitiatedEnabled:    gu.Ptr(false),
+					IDPInitiatedRelayState: gu.Ptr(""),
+					EncryptAssertion:       gu.Ptr(false),
+					NameIDFormat:           gu.Ptr(domain.SAMLNameIDFormatEmailAddress),
+					NameIDSource:           gu.Ptr(domain.SAMLNameIDSourceLoginName),
+					SignatureAlgorithm:     gu.Ptr(domain.SAMLSignatureAlgorithmUnspecified),
 				},
 			},
 		},
@@ -737,6 +831,12 @@ func TestCommandSide_ChangeSAMLApplication(t *testing.T) {
 								"",
 								domain.LoginVersionUnspecified,
 								"",
+								false,
+								"",
+								false,
+								nil,
+								domain.SAMLNameIDSourceLoginName,
+								domain.SAMLSignatureAlgorithmUnspecified,
 							),
 						),
 					),
@@ -774,14 +874,20 @@ func TestCommandSide_ChangeSAMLApplication(t *testing.T) {
 						AggregateID:   "project1",
 						ResourceOwner: "org1",
 					},
-					AppID:        "app1",
-					AppName:      "app",
-					EntityID:     "https://test2.com/saml/metadata",
-					Metadata:     testMetadataChangedEntityID,
-					MetadataURL:  gu.Ptr(""),
-					State:        domain.AppStateActive,
-					LoginVersion: gu.Ptr(domain.LoginVersionUnspecified),
-					LoginBaseURI: gu.Ptr(""),
+					AppID:                  "app1",
+					AppName:                "app",
+					EntityID:               "https://test2.com/saml/metadata",
+					Metadata:               testMetadataChangedEntityID,
+					MetadataURL:            gu.Ptr(""),
+					State:                  domain.AppStateActive,
+					LoginVersion:           gu.Ptr(domain.LoginVersionUnspecified),
+					LoginBaseURI:           gu.Ptr(""),
+					IDPInitiatedEnabled:    gu.Ptr(false),
+					IDPInitiatedRelayState: gu.Ptr(""),
+					EncryptAssertion:       gu.Ptr(false),
+					NameIDFormat:           gu.Ptr(domain.SAMLNameIDFormatEmailAddress),
+					NameIDSource:           gu.Ptr(domain.SAMLNameIDSourceLoginName),
+					SignatureAlgorithm:     gu.Ptr(domain.SAMLSignatureAlgorithmUnspecified),
 				},
 			},
 		},
@@ -806,6 +912,12 @@ func TestCommandSide_ChangeSAMLApplication(t *testing.T) {
 								"",
 								domain.LoginVersionUnspecified,
 								"",
+								false,
+								"",
+								false,
+								nil,
+								domain.SAMLNameIDSourceLoginName,
+								domain.SAMLSignatureAlgorithmUnspecified,
 							),
 						),
 					),
@@ -847,15 +959,156 @@ func TestCommandSide_ChangeSAMLApplication(t *testing.T) {
 						AggregateID:   "project1",
 						ResourceOwner: "org1",
 					},
-					AppID:        "app1",
-					AppName:      "app",
-					EntityID:     "https://test2.com/saml/metadata",
-					Metadata:     testMetadataChangedEntityID,
-					MetadataURL:  gu.Ptr(""),
-					State:        domain.AppStateActive,
-					LoginVersion: gu.Ptr(domain.LoginVersion2),
-					LoginBaseURI: gu.Ptr("https://test.com/login"),
+					AppID:                  "app1",
+					AppName:                "app",
+					EntityID:               "https://test2.com/saml/metadata",
+					Metadata:               testMetadataChangedEntityID,
+					MetadataURL:            gu.Ptr(""),
+					State:                  domain.AppStateActive,
+					LoginVersion:           gu.Ptr(domain.LoginVersion2),
+					LoginBaseURI:           gu.Ptr("https://test.com/login"),
+					IDPInitiatedEnabled:    gu.Ptr(false),
+					IDPInitiatedRelayState: gu.Ptr(""),
+					EncryptAssertion:       gu.Ptr(false),
+					NameIDFormat:           gu.Ptr(domain.SAMLNameIDFormatEmailAddress),
+					NameIDSource:           gu.Ptr(domain.SAMLNameIDSourceLoginName),
+					SignatureAlgorithm:     gu.Ptr(domain.SAMLSignatureAlgorithmUnspecified),
+				},
+			},
+		},
+		{
+			name: "change saml app, ok, idp initiated and response settings",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewApplicationAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"app",
+							),
+						),
+						eventFromEventPusher(
+							project.NewSAMLConfigAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"https://test.com/saml/metadata",
+								testMetadata,
+								"",
+								domain.LoginVersionUnspecified,
+								"",
+								false,
+								"",
+								false,
+								nil,
+								domain.SAMLNameIDSourceLoginName,
+								domain.SAMLSignatureAlgorithmUnspecified,
+							),
+						),
+					),
+					expectPush(
+						newSAMLAppChangedEventResponseSettings(context.Background(),
+							"app1",
+							"project1",
+							"org1",
+							"https://test.com/saml/metadata",
+						),
+					),
+				),
+				httpClient: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				samlApp: &domain.SAMLApp{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "project1",
+						ResourceOwner: "org1",
+					},
+					AppID:                  "app1",
+					AppName:                "app",
+					EntityID:               "https://test.com/saml/metadata",
+					Metadata:               testMetadata,
+					IDPInitiatedEnabled:    gu.Ptr(true),
+					IDPInitiatedRelayState: gu.Ptr("https://test.com/home"),
+					NameIDFormat:           gu.Ptr(domain.SAMLNameIDFormatPersistent),
+					NameIDSource:           gu.Ptr(domain.SAMLNameIDSourceUserID),
+					SignatureAlgorithm:     gu.Ptr(domain.SAMLSignatureAlgorithmRSASHA512),
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.SAMLApp{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "project1",
+						ResourceOwner: "org1",
+					},
+					AppID:                  "app1",
+					AppName:                "app",
+					EntityID:               "https://test.com/saml/metadata",
+					Metadata:               testMetadata,
+					MetadataURL:            gu.Ptr(""),
+					State:                  domain.AppStateActive,
+					LoginVersion:           gu.Ptr(domain.LoginVersionUnspecified),
+					LoginBaseURI:           gu.Ptr(""),
+					IDPInitiatedEnabled:    gu.Ptr(true),
+					IDPInitiatedRelayState: gu.Ptr("https://test.com/home"),
+					EncryptAssertion:       gu.Ptr(false),
+					NameIDFormat:           gu.Ptr(domain.SAMLNameIDFormatPersistent),
+					NameIDSource:           gu.Ptr(domain.SAMLNameIDSourceUserID),
+					SignatureAlgorithm:     gu.Ptr(domain.SAMLSignatureAlgorithmRSASHA512),
+				},
+			},
+		},
+		{
+			name: "change saml app, encrypt assertion without encryption certificate",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewApplicationAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"app",
+							),
+						),
+						eventFromEventPusher(
+							project.NewSAMLConfigAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"https://test.com/saml/metadata",
+								testMetadata,
+								"",
+								domain.LoginVersionUnspecified,
+								"",
+								false,
+								"",
+								false,
+								nil,
+								domain.SAMLNameIDSourceLoginName,
+								domain.SAMLSignatureAlgorithmUnspecified,
+							),
+						),
+					),
+				),
+				httpClient: nil,
+			},
+			args: args{
+				ctx: context.Background(),
+				samlApp: &domain.SAMLApp{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "project1",
+						ResourceOwner: "org1",
+					},
+					AppID:            "app1",
+					AppName:          "app",
+					EntityID:         "https://test.com/saml/metadata",
+					Metadata:         testMetadata,
+					EncryptAssertion: gu.Ptr(true),
 				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
 			},
 		},
 	}
@@ -927,6 +1180,23 @@ func newSAMLAppChangedEventLoginVersion(ctx context.Context, appID, projectID, r
 	return event
 }
 
+func newSAMLAppChangedEventResponseSettings(ctx context.Context, appID, projectID, resourceOwner, entityID string) *project.SAMLConfigChangedEvent {
+	changes := []project.SAMLConfigChanges{
+		project.ChangeSAMLIDPInitiatedEnabled(true),
+		project.ChangeSAMLIDPInitiatedRelayState("https://test.com/home"),
+		project.ChangeSAMLNameIDFormat(domain.SAMLNameIDFormatPersistent),
+		project.ChangeSAMLNameIDSource(domain.SAMLNameIDSourceUserID),
+		project.ChangeSAMLSignatureAlgorithm(domain.SAMLSignatureAlgorithmRSASHA512),
+	}
+	event, _ := project.NewSAMLConfigChangedEvent(ctx,
+		&project.NewAggregate(projectID, resourceOwner).Aggregate,
+		appID,
+		entityID,
+		changes,
+	)
+	return event
+}
+
 type roundTripperFunc func(*http.Request) *http.Response
 
 // RoundTrip implements the http.RoundTripper interface.
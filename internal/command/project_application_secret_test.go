@@ -121,7 +121,7 @@ func TestCommandSide_ChangeApplicationSecret(t *testing.T) {
 								"",
 								"",
 								"",
-								nil),
+								nil, false, false, "", "", "", false),
 						),
 					),
 					expectPush(
@@ -162,6 +162,8 @@ func TestCommandSide_ChangeApplicationSecret(t *testing.T) {
 								"client1@project",
 								"secret",
 								domain.APIAuthMethodTypeBasic,
+								"",
+								"",
 							),
 						),
 					),
@@ -596,6 +596,12 @@ func TestCommandSide_RemoveApplication(t *testing.T) {
 							"",
 							domain.LoginVersionUnspecified,
 							"",
+							false,
+							"",
+							false,
+							nil,
+							domain.SAMLNameIDSourceLoginName,
+							domain.SAMLSignatureAlgorithmUnspecified,
 						)),
 					),
 					expectPush(
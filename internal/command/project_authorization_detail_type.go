@@ -0,0 +1,69 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddProjectAuthorizationDetailType registers a type of authorization details (RFC 9396),
+// which the clients of the project's applications are allowed to request.
+func (c *Commands) AddProjectAuthorizationDetailType(ctx context.Context, projectID, resourceOwner, detailType string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if projectID == "" || !domain.AuthorizationDetailTypeValid(detailType) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rar3v", "Errors.Project.AuthorizationDetailType.Invalid")
+	}
+	writeModel, err := c.getProjectAuthorizationDetailTypesWriteModel(ctx, projectID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.ProjectState != domain.ProjectStateActive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Rar4n", "Errors.Project.NotFound")
+	}
+	if err := c.checkPermissionUpdateProject(ctx, writeModel.ResourceOwner, projectID); err != nil {
+		return nil, err
+	}
+	if writeModel.exists(detailType) {
+		return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-Rar5e", "Errors.Project.AuthorizationDetailType.AlreadyExists")
+	}
+	return c.pushAppendAndReduceDetails(ctx, writeModel,
+		project.NewAuthorizationDetailTypeAddedEvent(ctx, ProjectAggregateFromWriteModelWithCTX(ctx, &writeModel.WriteModel), detailType),
+	)
+}
+
+// RemoveProjectAuthorizationDetailType removes a registered type of authorization details.
+// Already issued tokens keep their authorization details until they expire.
+func (c *Commands) RemoveProjectAuthorizationDetailType(ctx context.Context, projectID, resourceOwner, detailType string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if projectID == "" || detailType == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rar6v", "Errors.Project.AuthorizationDetailType.Invalid")
+	}
+	writeModel, err := c.getProjectAuthorizationDetailTypesWriteModel(ctx, projectID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.exists(detailType) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Rar7n", "Errors.Project.AuthorizationDetailType.NotFound")
+	}
+	if err := c.checkPermissionUpdateProject(ctx, writeModel.ResourceOwner, projectID); err != nil {
+		return nil, err
+	}
+	return c.pushAppendAndReduceDetails(ctx, writeModel,
+		project.NewAuthorizationDetailTypeRemovedEvent(ctx, ProjectAggregateFromWriteModelWithCTX(ctx, &writeModel.WriteModel), detailType),
+	)
+}
+
+func (c *Commands) getProjectAuthorizationDetailTypesWriteModel(ctx context.Context, projectID, resourceOwner string) (*ProjectAuthorizationDetailTypesWriteModel, error) {
+	writeModel := NewProjectAuthorizationDetailTypesWriteModel(projectID, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,66 @@
+package command
+
+import (
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+type ProjectAuthorizationDetailTypesWriteModel struct {
+	eventstore.WriteModel
+
+	ProjectState domain.ProjectState
+	Types        []string
+}
+
+func NewProjectAuthorizationDetailTypesWriteModel(projectID, resourceOwner string) *ProjectAuthorizationDetailTypesWriteModel {
+	return &ProjectAuthorizationDetailTypesWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   projectID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *ProjectAuthorizationDetailTypesWriteModel) GetWriteModel() *eventstore.WriteModel {
+	return &wm.WriteModel
+}
+
+func (wm *ProjectAuthorizationDetailTypesWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *project.ProjectAddedEvent:
+			wm.ProjectState = domain.ProjectStateActive
+		case *project.ProjectRemovedEvent:
+			wm.ProjectState = domain.ProjectStateRemoved
+			wm.Types = nil
+		case *project.AuthorizationDetailTypeAddedEvent:
+			wm.Types = append(wm.Types, e.DetailType)
+		case *project.AuthorizationDetailTypeRemovedEvent:
+			wm.Types = slices.DeleteFunc(wm.Types, func(detailType string) bool {
+				return detailType == e.DetailType
+			})
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *ProjectAuthorizationDetailTypesWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			project.ProjectAddedType,
+			project.ProjectRemovedType,
+			project.AuthorizationDetailTypeAddedEventType,
+			project.AuthorizationDetailTypeRemovedEventType).
+		Builder()
+}
+
+func (wm *ProjectAuthorizationDetailTypesWriteModel) exists(detailType string) bool {
+	return slices.Contains(wm.Types, detailType)
+}
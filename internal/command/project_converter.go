@@ -29,33 +29,39 @@ func projectGrantWriteModelToProjectGrant(writeModel *ProjectGrantWriteModel) *d
 
 func oidcWriteModelToOIDCConfig(writeModel *OIDCApplicationWriteModel) *domain.OIDCApp {
 	return &domain.OIDCApp{
-		ObjectRoot:                    writeModelToObjectRoot(writeModel.WriteModel),
-		AppID:                         writeModel.AppID,
-		AppName:                       writeModel.AppName,
-		State:                         writeModel.State,
-		ClientID:                      writeModel.ClientID,
-		RedirectUris:                  writeModel.RedirectUris,
-		ResponseTypes:                 writeModel.ResponseTypes,
-		GrantTypes:                    writeModel.GrantTypes,
-		ApplicationType:               gu.Ptr(writeModel.ApplicationType),
-		AuthMethodType:                gu.Ptr(writeModel.AuthMethodType),
-		PostLogoutRedirectUris:        writeModel.PostLogoutRedirectUris,
-		OIDCVersion:                   gu.Ptr(writeModel.OIDCVersion),
-		DevMode:                       gu.Ptr(writeModel.DevMode),
-		AccessTokenType:               gu.Ptr(writeModel.AccessTokenType),
-		AccessTokenRoleAssertion:      gu.Ptr(writeModel.AccessTokenRoleAssertion),
-		IDTokenRoleAssertion:          gu.Ptr(writeModel.IDTokenRoleAssertion),
-		IDTokenUserinfoAssertion:      gu.Ptr(writeModel.IDTokenUserinfoAssertion),
-		ClockSkew:                     gu.Ptr(writeModel.ClockSkew),
-		AdditionalOrigins:             writeModel.AdditionalOrigins,
-		SkipNativeAppSuccessPage:      gu.Ptr(writeModel.SkipNativeAppSuccessPage),
-		BackChannelLogoutURI:          gu.Ptr(writeModel.BackChannelLogoutURI),
-		LoginVersion:                  gu.Ptr(writeModel.LoginVersion),
-		LoginBaseURI:                  gu.Ptr(writeModel.LoginBaseURI),
-		IOSTeamID:                     emptyStringPtr(writeModel.IOSTeamID),
-		IOSBundleID:                   emptyStringPtr(writeModel.IOSBundleID),
-		AndroidPackageName:            emptyStringPtr(writeModel.AndroidPackageName),
-		AndroidSHA256CertFingerprints: writeModel.AndroidSHA256CertFingerprints,
+		ObjectRoot:                       writeModelToObjectRoot(writeModel.WriteModel),
+		AppID:                            writeModel.AppID,
+		AppName:                          writeModel.AppName,
+		State:                            writeModel.State,
+		ClientID:                         writeModel.ClientID,
+		RedirectUris:                     writeModel.RedirectUris,
+		ResponseTypes:                    writeModel.ResponseTypes,
+		GrantTypes:                       writeModel.GrantTypes,
+		ApplicationType:                  gu.Ptr(writeModel.ApplicationType),
+		AuthMethodType:                   gu.Ptr(writeModel.AuthMethodType),
+		PostLogoutRedirectUris:           writeModel.PostLogoutRedirectUris,
+		OIDCVersion:                      gu.Ptr(writeModel.OIDCVersion),
+		DevMode:                          gu.Ptr(writeModel.DevMode),
+		AccessTokenType:                  gu.Ptr(writeModel.AccessTokenType),
+		AccessTokenRoleAssertion:         gu.Ptr(writeModel.AccessTokenRoleAssertion),
+		IDTokenRoleAssertion:             gu.Ptr(writeModel.IDTokenRoleAssertion),
+		IDTokenUserinfoAssertion:         gu.Ptr(writeModel.IDTokenUserinfoAssertion),
+		ClockSkew:                        gu.Ptr(writeModel.ClockSkew),
+		AdditionalOrigins:                writeModel.AdditionalOrigins,
+		SkipNativeAppSuccessPage:         gu.Ptr(writeModel.SkipNativeAppSuccessPage),
+		BackChannelLogoutURI:             gu.Ptr(writeModel.BackChannelLogoutURI),
+		LoginVersion:                     gu.Ptr(writeModel.LoginVersion),
+		LoginBaseURI:                     gu.Ptr(writeModel.LoginBaseURI),
+		IOSTeamID:                        emptyStringPtr(writeModel.IOSTeamID),
+		IOSBundleID:                      emptyStringPtr(writeModel.IOSBundleID),
+		AndroidPackageName:               emptyStringPtr(writeModel.AndroidPackageName),
+		AndroidSHA256CertFingerprints:    writeModel.AndroidSHA256CertFingerprints,
+		DPoPRequired:                     gu.Ptr(writeModel.DPoPRequired),
+		PARRequired:                      gu.Ptr(writeModel.PARRequired),
+		TLSClientAuthSubjectDN:           emptyStringPtr(writeModel.TLSClientAuthSubjectDN),
+		TLSClientAuthSAN:                 emptyStringPtr(writeModel.TLSClientAuthSAN),
+		BackChannelClientNotificationURI: emptyStringPtr(writeModel.BackChannelClientNotificationURI),
+		ConsentRequired:                  gu.Ptr(writeModel.ConsentRequired),
 	}
 }
 
@@ -79,17 +85,26 @@ func samlWriteModelToSAMLConfig(writeModel *SAMLApplicationWriteModel) *domain.S
 		EntityID:     writeModel.EntityID,
 		LoginVersion: gu.Ptr(writeModel.LoginVersion),
 		LoginBaseURI: gu.Ptr(writeModel.LoginBaseURI),
+
+		IDPInitiatedEnabled:    gu.Ptr(writeModel.IDPInitiatedEnabled),
+		IDPInitiatedRelayState: gu.Ptr(writeModel.IDPInitiatedRelayState),
+		EncryptAssertion:       gu.Ptr(writeModel.EncryptAssertion),
+		NameIDFormat:           gu.Ptr(writeModel.NameIDFormat),
+		NameIDSource:           gu.Ptr(writeModel.NameIDSource),
+		SignatureAlgorithm:     gu.Ptr(writeModel.SignatureAlgorithm),
 	}
 }
 
 func apiWriteModelToAPIConfig(writeModel *APIApplicationWriteModel) *domain.APIApp {
 	return &domain.APIApp{
-		ObjectRoot:     writeModelToObjectRoot(writeModel.WriteModel),
-		AppID:          writeModel.AppID,
-		AppName:        writeModel.AppName,
-		State:          writeModel.State,
-		ClientID:       writeModel.ClientID,
-		AuthMethodType: writeModel.AuthMethodType,
+		ObjectRoot:             writeModelToObjectRoot(writeModel.WriteModel),
+		AppID:                  writeModel.AppID,
+		AppName:                writeModel.AppName,
+		State:                  writeModel.State,
+		ClientID:               writeModel.ClientID,
+		AuthMethodType:         writeModel.AuthMethodType,
+		TLSClientAuthSubjectDN: writeModel.TLSClientAuthSubjectDN,
+		TLSClientAuthSAN:       writeModel.TLSClientAuthSAN,
 	}
 }
 
@@ -1112,6 +1112,12 @@ func TestCommandSide_RemoveProject(t *testing.T) {
 								"http://localhost:8080/saml/metadata",
 								domain.LoginVersionUnspecified,
 								"",
+								false,
+								"",
+								false,
+								nil,
+								domain.SAMLNameIDSourceLoginName,
+								domain.SAMLSignatureAlgorithmUnspecified,
 							),
 						),
 					),
@@ -1165,6 +1171,12 @@ func TestCommandSide_RemoveProject(t *testing.T) {
 								"",
 								domain.LoginVersionUnspecified,
 								"",
+								false,
+								"",
+								false,
+								nil,
+								domain.SAMLNameIDSourceLoginName,
+								domain.SAMLSignatureAlgorithmUnspecified,
 							),
 						),
 						eventFromEventPusher(project.NewApplicationAddedEvent(context.Background(),
@@ -1181,6 +1193,12 @@ func TestCommandSide_RemoveProject(t *testing.T) {
 								"",
 								domain.LoginVersionUnspecified,
 								"",
+								false,
+								"",
+								false,
+								nil,
+								domain.SAMLNameIDSourceLoginName,
+								domain.SAMLSignatureAlgorithmUnspecified,
 							),
 						),
 						eventFromEventPusher(project.NewApplicationAddedEvent(context.Background(),
@@ -1197,6 +1215,12 @@ func TestCommandSide_RemoveProject(t *testing.T) {
 								"",
 								domain.LoginVersionUnspecified,
 								"",
+								false,
+								"",
+								false,
+								nil,
+								domain.SAMLNameIDSourceLoginName,
+								domain.SAMLSignatureAlgorithmUnspecified,
 							),
 						),
 					),
@@ -1441,6 +1465,12 @@ func TestCommandSide_DeleteProject(t *testing.T) {
 								"http://localhost:8080/saml/metadata",
 								domain.LoginVersionUnspecified,
 								"",
+								false,
+								"",
+								false,
+								nil,
+								domain.SAMLNameIDSourceLoginName,
+								domain.SAMLSignatureAlgorithmUnspecified,
 							),
 						),
 					),
@@ -1492,6 +1522,12 @@ func TestCommandSide_DeleteProject(t *testing.T) {
 								"",
 								domain.LoginVersionUnspecified,
 								"",
+								false,
+								"",
+								false,
+								nil,
+								domain.SAMLNameIDSourceLoginName,
+								domain.SAMLSignatureAlgorithmUnspecified,
 							),
 						),
 						eventFromEventPusher(project.NewApplicationAddedEvent(context.Background(),
@@ -1508,6 +1544,12 @@ func TestCommandSide_DeleteProject(t *testing.T) {
 								"",
 								domain.LoginVersionUnspecified,
 								"",
+								false,
+								"",
+								false,
+								nil,
+								domain.SAMLNameIDSourceLoginName,
+								domain.SAMLSignatureAlgorithmUnspecified,
 							),
 						),
 						eventFromEventPusher(project.NewApplicationAddedEvent(context.Background(),
@@ -1524,6 +1566,12 @@ func TestCommandSide_DeleteProject(t *testing.T) {
 								"",
 								domain.LoginVersionUnspecified,
 								"",
+								false,
+								"",
+								false,
+								nil,
+								domain.SAMLNameIDSourceLoginName,
+								domain.SAMLSignatureAlgorithmUnspecified,
 							),
 						),
 					),
@@ -0,0 +1,280 @@
+package command
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/denylist"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	internal_net "github.com/zitadel/zitadel/internal/net"
+	"github.com/zitadel/zitadel/internal/repository/provisioning"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddProvisioningTarget configures the downstream SCIM endpoint of an application,
+// to which the users granted on the project of the application are provisioned.
+type AddProvisioningTarget struct {
+	models.ObjectRoot
+
+	Name      string
+	ProjectID string
+	AppID     string
+	Endpoint  string
+	// Token is the bearer token used to authenticate against the downstream endpoint.
+	Token string
+}
+
+func (a *AddProvisioningTarget) isValid(inputDenyList []denylist.AddressChecker, lookupFunc internal_net.IPLookupFunc) error {
+	if a.ProjectID == "" || a.AppID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Pv7rTq", "Errors.IDMissing")
+	}
+	if a.Name == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Pv8sLm", "Errors.ProvisioningTarget.Invalid")
+	}
+	return isValidProvisioningEndpoint(a.Endpoint, inputDenyList, lookupFunc)
+}
+
+func isValidProvisioningEndpoint(endpoint string, inputDenyList []denylist.AddressChecker, lookupFunc internal_net.IPLookupFunc) error {
+	parsedURL, err := url.Parse(endpoint)
+	if err != nil || endpoint == "" || parsedURL.Host == "" {
+		return zerrors.ThrowInvalidArgument(err, "COMMAND-Pv2kWe", "Errors.ProvisioningTarget.InvalidURL")
+	}
+	if err := denylist.IsURLBlocked(inputDenyList, parsedURL, lookupFunc); err != nil {
+		return zerrors.ThrowInvalidArgument(err, "COMMAND-Pv4hNz", "Errors.ProvisioningTarget.DeniedURL")
+	}
+	return nil
+}
+
+func (c *Commands) AddProvisioningTarget(ctx context.Context, add *AddProvisioningTarget) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if err := add.isValid(c.denyList, c.ipLookupFunction); err != nil {
+		return nil, err
+	}
+	projectResourceOwner, err := c.checkProjectExists(ctx, add.ProjectID, add.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if add.ResourceOwner == "" {
+		add.ResourceOwner = projectResourceOwner
+	}
+	if err := c.checkPermission(ctx, domain.PermissionProjectAppWrite, add.ResourceOwner, add.ProjectID); err != nil {
+		return nil, err
+	}
+	app, err := c.getApplicationWriteModel(ctx, add.ProjectID, add.AppID, add.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !app.State.Exists() {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pv9qXc", "Errors.Project.App.NotExisting")
+	}
+
+	if add.AggregateID == "" {
+		add.AggregateID, err = c.idGenerator.Next()
+		if err != nil {
+			return nil, err
+		}
+	}
+	wm, err := c.getProvisioningTargetWriteModelByID(ctx, add.AggregateID, add.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if wm.State.Exists() {
+		return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-Pv3aYd", "Errors.ProvisioningTarget.AlreadyExists")
+	}
+	token, err := c.encryptProvisioningToken(add.Token)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.pushAppendAndReduce(ctx, wm, provisioning.NewAddedEvent(
+		ctx,
+		provisioning.NewAggregate(add.AggregateID, add.ResourceOwner, authz.GetInstance(ctx).InstanceID()),
+		add.Name,
+		add.ProjectID,
+		add.AppID,
+		add.Endpoint,
+		token,
+	)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+type ChangeProvisioningTarget struct {
+	models.ObjectRoot
+
+	Name     *string
+	Endpoint *string
+	Token    *string
+}
+
+func (a *ChangeProvisioningTarget) isValid(inputDenyList []denylist.AddressChecker, lookupFunc internal_net.IPLookupFunc) error {
+	if a.AggregateID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Pv5fGh", "Errors.IDMissing")
+	}
+	if a.Name != nil && *a.Name == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Pv6jKl", "Errors.ProvisioningTarget.Invalid")
+	}
+	if a.Endpoint != nil {
+		return isValidProvisioningEndpoint(*a.Endpoint, inputDenyList, lookupFunc)
+	}
+	return nil
+}
+
+func (c *Commands) ChangeProvisioningTarget(ctx context.Context, change *ChangeProvisioningTarget) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if err := change.isValid(c.denyList, c.ipLookupFunction); err != nil {
+		return nil, err
+	}
+	existing, err := c.getExistingProvisioningTarget(ctx, change.AggregateID, change.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkPermission(ctx, domain.PermissionProjectAppWrite, existing.ResourceOwner, existing.ProjectID); err != nil {
+		return nil, err
+	}
+
+	var token *crypto.CryptoValue
+	if change.Token != nil {
+		token, err = c.encryptProvisioningToken(*change.Token)
+		if err != nil {
+			return nil, err
+		}
+	}
+	changedEvent := existing.NewChangedEvent(
+		ctx,
+		ProvisioningTargetAggregateFromWriteModel(&existing.WriteModel),
+		change.Name,
+		change.Endpoint,
+		token,
+	)
+	if changedEvent == nil {
+		return writeModelToObjectDetails(&existing.WriteModel), nil
+	}
+	if err := c.pushAppendAndReduce(ctx, existing, changedEvent); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existing.WriteModel), nil
+}
+
+func (c *Commands) RemoveProvisioningTarget(ctx context.Context, id, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pv1zUi", "Errors.IDMissing")
+	}
+	existing, err := c.getProvisioningTargetWriteModelByID(ctx, id, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existing.State.Exists() {
+		return writeModelToObjectDetails(&existing.WriteModel), nil
+	}
+	if err := c.checkPermission(ctx, domain.PermissionProjectAppWrite, existing.ResourceOwner, existing.ProjectID); err != nil {
+		return nil, err
+	}
+	if err := c.pushAppendAndReduce(ctx, existing, provisioning.NewRemovedEvent(
+		ctx,
+		ProvisioningTargetAggregateFromWriteModel(&existing.WriteModel),
+	)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existing.WriteModel), nil
+}
+
+// ResyncProvisioningTarget requests the provisioning of all users granted on the project of the target
+// and the deprovisioning of all users of the target, which are no longer granted.
+func (c *Commands) ResyncProvisioningTarget(ctx context.Context, id, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	existing, err := c.getExistingProvisioningTarget(ctx, id, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkPermission(ctx, domain.PermissionProjectAppWrite, existing.ResourceOwner, existing.ProjectID); err != nil {
+		return nil, err
+	}
+	if err := c.pushAppendAndReduce(ctx, existing, provisioning.NewResyncRequestedEvent(
+		ctx,
+		ProvisioningTargetAggregateFromWriteModel(&existing.WriteModel),
+	)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existing.WriteModel), nil
+}
+
+// ProvisioningTargetSyncSucceeded records a successful delivery to the target.
+// An event is only pushed if the previous delivery failed, so successful deliveries do not fill the eventstore.
+// It is called by the provisioning worker, therefore no permission check is done.
+func (c *Commands) ProvisioningTargetSyncSucceeded(ctx context.Context, id, resourceOwner string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	existing, err := c.getExistingProvisioningTarget(ctx, id, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if existing.SyncStatus == domain.ProvisioningStatusSynced {
+		return nil
+	}
+	return c.pushAppendAndReduce(ctx, existing, provisioning.NewSyncSucceededEvent(
+		ctx,
+		ProvisioningTargetAggregateFromWriteModel(&existing.WriteModel),
+	))
+}
+
+// ProvisioningTargetSyncFailed records a delivery of the user to the target, which failed after all retries.
+// It is called by the provisioning worker, therefore no permission check is done.
+func (c *Commands) ProvisioningTargetSyncFailed(ctx context.Context, id, resourceOwner, userID, errorMessage string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	existing, err := c.getExistingProvisioningTarget(ctx, id, resourceOwner)
+	if err != nil {
+		return err
+	}
+	return c.pushAppendAndReduce(ctx, existing, provisioning.NewSyncFailedEvent(
+		ctx,
+		ProvisioningTargetAggregateFromWriteModel(&existing.WriteModel),
+		userID,
+		errorMessage,
+	))
+}
+
+func (c *Commands) encryptProvisioningToken(token string) (*crypto.CryptoValue, error) {
+	if token == "" {
+		return nil, nil
+	}
+	return crypto.Encrypt([]byte(token), c.targetEncryption)
+}
+
+func (c *Commands) getExistingProvisioningTarget(ctx context.Context, id, resourceOwner string) (*ProvisioningTargetWriteModel, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pv0oPa", "Errors.IDMissing")
+	}
+	existing, err := c.getProvisioningTargetWriteModelByID(ctx, id, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existing.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Pv2bSd", "Errors.ProvisioningTarget.NotFound")
+	}
+	return existing, nil
+}
+
+func (c *Commands) getProvisioningTargetWriteModelByID(ctx context.Context, id, resourceOwner string) (*ProvisioningTargetWriteModel, error) {
+	wm := NewProvisioningTargetWriteModel(id, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
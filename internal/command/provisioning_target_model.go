@@ -0,0 +1,111 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/provisioning"
+)
+
+type ProvisioningTargetWriteModel struct {
+	eventstore.WriteModel
+
+	Name      string
+	ProjectID string
+	AppID     string
+	Endpoint  string
+	Token     *crypto.CryptoValue
+
+	State      domain.ProvisioningTargetState
+	SyncStatus domain.ProvisioningStatus
+}
+
+func NewProvisioningTargetWriteModel(id, resourceOwner string) *ProvisioningTargetWriteModel {
+	return &ProvisioningTargetWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *ProvisioningTargetWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *provisioning.AddedEvent:
+			wm.Name = e.Name
+			wm.ProjectID = e.ProjectID
+			wm.AppID = e.AppID
+			wm.Endpoint = e.Endpoint
+			wm.Token = e.Token
+			wm.State = domain.ProvisioningTargetActive
+		case *provisioning.ChangedEvent:
+			if e.Name != nil {
+				wm.Name = *e.Name
+			}
+			if e.Endpoint != nil {
+				wm.Endpoint = *e.Endpoint
+			}
+			if e.Token != nil {
+				wm.Token = e.Token
+			}
+		case *provisioning.SyncSucceededEvent:
+			wm.SyncStatus = domain.ProvisioningStatusSynced
+		case *provisioning.SyncFailedEvent:
+			wm.SyncStatus = domain.ProvisioningStatusFailed
+		case *provisioning.RemovedEvent:
+			wm.State = domain.ProvisioningTargetRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *ProvisioningTargetWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(provisioning.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(provisioning.AddedEventType,
+			provisioning.ChangedEventType,
+			provisioning.SyncSucceededEventType,
+			provisioning.SyncFailedEventType,
+			provisioning.RemovedEventType).
+		Builder()
+}
+
+func (wm *ProvisioningTargetWriteModel) NewChangedEvent(
+	ctx context.Context,
+	agg *eventstore.Aggregate,
+	name *string,
+	endpoint *string,
+	token *crypto.CryptoValue,
+) *provisioning.ChangedEvent {
+	changes := make([]provisioning.Changes, 0)
+	if name != nil && wm.Name != *name {
+		changes = append(changes, provisioning.ChangeName(*name))
+	}
+	if endpoint != nil && wm.Endpoint != *endpoint {
+		changes = append(changes, provisioning.ChangeEndpoint(*endpoint))
+	}
+	// the token is always updated if set, as it is encrypted
+	if token != nil {
+		changes = append(changes, provisioning.ChangeToken(token))
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	return provisioning.NewChangedEvent(ctx, agg, changes)
+}
+
+func ProvisioningTargetAggregateFromWriteModel(wm *eventstore.WriteModel) *eventstore.Aggregate {
+	return &eventstore.Aggregate{
+		ID:            wm.AggregateID,
+		Type:          provisioning.AggregateType,
+		ResourceOwner: wm.ResourceOwner,
+		InstanceID:    wm.InstanceID,
+		Version:       provisioning.AggregateVersion,
+	}
+}
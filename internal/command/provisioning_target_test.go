@@ -0,0 +1,425 @@
+package command
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	internal_net "github.com/zitadel/zitadel/internal/net"
+	"github.com/zitadel/zitadel/internal/repository/provisioning"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func provisioningTargetAddEvent(id, resourceOwner string) *provisioning.AddedEvent {
+	return provisioning.NewAddedEvent(context.Background(),
+		provisioning.NewAggregate(id, resourceOwner, "instance"),
+		"name",
+		"project1",
+		"app1",
+		"https://example.com/scim/v2",
+		nil,
+	)
+}
+
+func TestCommands_ChangeProvisioningTarget(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+		lookupFunc      internal_net.IPLookupFunc
+	}
+	type args struct {
+		ctx    context.Context
+		change *ChangeProvisioningTarget
+	}
+	type res struct {
+		err func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"id missing, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:    context.Background(),
+				change: &ChangeProvisioningTarget{},
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"invalid endpoint, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: context.Background(),
+				change: &ChangeProvisioningTarget{
+					ObjectRoot: models.ObjectRoot{AggregateID: "id1"},
+					Endpoint:   gu.Ptr("://invalid"),
+				},
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				change: &ChangeProvisioningTarget{
+					ObjectRoot: models.ObjectRoot{AggregateID: "id1", ResourceOwner: "org1"},
+					Name:       gu.Ptr("name2"),
+				},
+			},
+			res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			"no permission, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							provisioningTargetAddEvent("id1", "org1"),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args{
+				ctx: context.Background(),
+				change: &ChangeProvisioningTarget{
+					ObjectRoot: models.ObjectRoot{AggregateID: "id1", ResourceOwner: "org1"},
+					Name:       gu.Ptr("name2"),
+				},
+			},
+			res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			"no changes",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							provisioningTargetAddEvent("id1", "org1"),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: context.Background(),
+				change: &ChangeProvisioningTarget{
+					ObjectRoot: models.ObjectRoot{AggregateID: "id1", ResourceOwner: "org1"},
+					Name:       gu.Ptr("name"),
+				},
+			},
+			res{},
+		},
+		{
+			"change ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							provisioningTargetAddEvent("id1", "org1"),
+						),
+					),
+					expectPush(
+						provisioning.NewChangedEvent(context.Background(),
+							provisioning.NewAggregate("id1", "org1", "instance"),
+							[]provisioning.Changes{
+								provisioning.ChangeName("name2"),
+								provisioning.ChangeEndpoint("https://example.com/scim"),
+							},
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+				lookupFunc: func(_ string) ([]net.IP, error) {
+					return []net.IP{net.ParseIP("192.168.2.1")}, nil
+				},
+			},
+			args{
+				ctx: context.Background(),
+				change: &ChangeProvisioningTarget{
+					ObjectRoot: models.ObjectRoot{AggregateID: "id1", ResourceOwner: "org1"},
+					Name:       gu.Ptr("name2"),
+					Endpoint:   gu.Ptr("https://example.com/scim"),
+				},
+			},
+			res{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:       tt.fields.eventstore(t),
+				checkPermission:  tt.fields.checkPermission,
+				ipLookupFunction: tt.fields.lookupFunc,
+			}
+			_, err := c.ChangeProvisioningTarget(tt.args.ctx, tt.args.change)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveProvisioningTarget(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx           context.Context
+		id            string
+		resourceOwner string
+	}
+	type res struct {
+		err func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"id missing, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"not found",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				id:            "id1",
+				resourceOwner: "org1",
+			},
+			res{},
+		},
+		{
+			"remove ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							provisioningTargetAddEvent("id1", "org1"),
+						),
+					),
+					expectPush(
+						provisioning.NewRemovedEvent(context.Background(),
+							provisioning.NewAggregate("id1", "org1", "instance"),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:           context.Background(),
+				id:            "id1",
+				resourceOwner: "org1",
+			},
+			res{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			_, err := c.RemoveProvisioningTarget(tt.args.ctx, tt.args.id, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
+
+func TestCommands_ResyncProvisioningTarget(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx           context.Context
+		id            string
+		resourceOwner string
+	}
+	type res struct {
+		err func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				id:            "id1",
+				resourceOwner: "org1",
+			},
+			res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			"resync ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							provisioningTargetAddEvent("id1", "org1"),
+						),
+					),
+					expectPush(
+						provisioning.NewResyncRequestedEvent(context.Background(),
+							provisioning.NewAggregate("id1", "org1", "instance"),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:           context.Background(),
+				id:            "id1",
+				resourceOwner: "org1",
+			},
+			res{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			_, err := c.ResyncProvisioningTarget(tt.args.ctx, tt.args.id, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
+
+func TestCommands_ProvisioningTargetSyncSucceeded(t *testing.T) {
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}{
+		{
+			"first success, pushed",
+			expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						provisioningTargetAddEvent("id1", "org1"),
+					),
+				),
+				expectPush(
+					provisioning.NewSyncSucceededEvent(context.Background(),
+						provisioning.NewAggregate("id1", "org1", "instance"),
+					),
+				),
+			),
+		},
+		{
+			"success after failure, pushed",
+			expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						provisioningTargetAddEvent("id1", "org1"),
+					),
+					eventFromEventPusher(
+						provisioning.NewSyncFailedEvent(context.Background(),
+							provisioning.NewAggregate("id1", "org1", "instance"),
+							"user1",
+							"unavailable",
+						),
+					),
+				),
+				expectPush(
+					provisioning.NewSyncSucceededEvent(context.Background(),
+						provisioning.NewAggregate("id1", "org1", "instance"),
+					),
+				),
+			),
+		},
+		{
+			"already synced, not pushed",
+			expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						provisioningTargetAddEvent("id1", "org1"),
+					),
+					eventFromEventPusher(
+						provisioning.NewSyncSucceededEvent(context.Background(),
+							provisioning.NewAggregate("id1", "org1", "instance"),
+						),
+					),
+				),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			err := c.ProvisioningTargetSyncSucceeded(context.Background(), "id1", "org1")
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package command
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/repository/authrequest"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// PushedAuthRequest is an authorization request pushed by an authenticated client (RFC 9126).
+// The authorization endpoint resolves it by its ID until it expires.
+type PushedAuthRequest struct {
+	ID         string
+	ClientID   string
+	Parameters url.Values
+	Expires    time.Time
+}
+
+// AddPushedAuthRequest stores the parameters of an authorization request pushed by the client.
+// The parameters must already be validated and must not contain the client credentials.
+func (c *Commands) AddPushedAuthRequest(ctx context.Context, clientID string, parameters url.Values, expires time.Time) (_ *PushedAuthRequest, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	writeModel := NewPushedAuthRequestWriteModel(ctx, id)
+	err = c.pushAppendAndReduce(ctx, writeModel, authrequest.NewPushedEvent(
+		ctx,
+		writeModel.aggregate,
+		clientID,
+		parameters,
+		expires,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return &PushedAuthRequest{
+		ID:         writeModel.AggregateID,
+		ClientID:   writeModel.ClientID,
+		Parameters: writeModel.Parameters,
+		Expires:    writeModel.Expires,
+	}, nil
+}
+
+// UsePushedAuthRequest returns the parameters of the pushed authorization request of the client
+// and marks it as used, as a request_uri must only be used once (RFC 9126, section 4).
+func (c *Commands) UsePushedAuthRequest(ctx context.Context, id, clientID string) (_ url.Values, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewPushedAuthRequestWriteModel(ctx, id)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	// requests of other clients are reported as not existing, so their IDs can't be probed
+	if !writeModel.Pushed || writeModel.ClientID != clientID {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Pa9nf", "Errors.AuthRequest.NotExisting")
+	}
+	if writeModel.Used {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pa2ah", "Errors.AuthRequest.AlreadyHandled")
+	}
+	if writeModel.Expires.Before(time.Now()) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pa3ex", "Errors.AuthRequest.Expired")
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel, authrequest.NewPushedUsedEvent(ctx, writeModel.aggregate)); err != nil {
+		return nil, err
+	}
+	return writeModel.Parameters, nil
+}
@@ -0,0 +1,59 @@
+package command
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/authrequest"
+)
+
+type PushedAuthRequestWriteModel struct {
+	eventstore.WriteModel
+	aggregate *eventstore.Aggregate
+
+	ClientID   string
+	Parameters url.Values
+	Expires    time.Time
+	Pushed     bool
+	Used       bool
+}
+
+func NewPushedAuthRequestWriteModel(ctx context.Context, id string) *PushedAuthRequestWriteModel {
+	return &PushedAuthRequestWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID: id,
+		},
+		aggregate: &authrequest.NewAggregate(id, authz.GetInstance(ctx).InstanceID()).Aggregate,
+	}
+}
+
+func (m *PushedAuthRequestWriteModel) Reduce() error {
+	for _, event := range m.Events {
+		switch e := event.(type) {
+		case *authrequest.PushedEvent:
+			m.ClientID = e.ClientID
+			m.Parameters = e.Parameters
+			m.Expires = e.Expires
+			m.Pushed = true
+		case *authrequest.PushedUsedEvent:
+			m.Used = true
+		}
+	}
+
+	return m.WriteModel.Reduce()
+}
+
+func (m *PushedAuthRequestWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(authrequest.AggregateType).
+		AggregateIDs(m.AggregateID).
+		EventTypes(
+			authrequest.PushedType,
+			authrequest.PushedUsedType,
+		).
+		Builder()
+}
@@ -0,0 +1,243 @@
+package command
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/authrequest"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_AddPushedAuthRequest(t *testing.T) {
+	mockCtx := authz.NewMockContext("instanceID", "orgID", "loginClient")
+	expires := time.Now().Add(time.Minute)
+	parameters := url.Values{
+		"client_id":     {"clientID"},
+		"redirect_uri":  {"https://example.com/callback"},
+		"response_type": {"code"},
+		"scope":         {"openid"},
+	}
+	type fields struct {
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		ctx        context.Context
+		clientID   string
+		parameters url.Values
+		expires    time.Time
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *PushedAuthRequest
+		wantErr error
+	}{
+		{
+			"push error",
+			fields{
+				eventstore: expectEventstore(
+					expectPushFailed(zerrors.ThrowInternal(nil, "id", "push failed"),
+						authrequest.NewPushedEvent(mockCtx, &authrequest.NewAggregate("id", "instanceID").Aggregate,
+							"clientID",
+							parameters,
+							expires,
+						),
+					),
+				),
+				idGenerator: mock.NewIDGeneratorExpectIDs(t, "id"),
+			},
+			args{
+				ctx:        mockCtx,
+				clientID:   "clientID",
+				parameters: parameters,
+				expires:    expires,
+			},
+			nil,
+			zerrors.ThrowInternal(nil, "id", "push failed"),
+		},
+		{
+			"pushed",
+			fields{
+				eventstore: expectEventstore(
+					expectPush(
+						authrequest.NewPushedEvent(mockCtx, &authrequest.NewAggregate("id", "instanceID").Aggregate,
+							"clientID",
+							parameters,
+							expires,
+						),
+					),
+				),
+				idGenerator: mock.NewIDGeneratorExpectIDs(t, "id"),
+			},
+			args{
+				ctx:        mockCtx,
+				clientID:   "clientID",
+				parameters: parameters,
+				expires:    expires,
+			},
+			&PushedAuthRequest{
+				ID:         "id",
+				ClientID:   "clientID",
+				Parameters: parameters,
+				Expires:    expires,
+			},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			got, err := c.AddPushedAuthRequest(tt.args.ctx, tt.args.clientID, tt.args.parameters, tt.args.expires)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommands_UsePushedAuthRequest(t *testing.T) {
+	mockCtx := authz.NewMockContext("instanceID", "orgID", "loginClient")
+	parameters := url.Values{
+		"client_id":     {"clientID"},
+		"redirect_uri":  {"https://example.com/callback"},
+		"response_type": {"code"},
+		"scope":         {"openid"},
+	}
+	pushedEvent := func(expires time.Time) eventstore.Event {
+		return eventFromEventPusher(
+			authrequest.NewPushedEvent(mockCtx, &authrequest.NewAggregate("id", "instanceID").Aggregate,
+				"clientID",
+				parameters,
+				expires,
+			),
+		)
+	}
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx      context.Context
+		id       string
+		clientID string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    url.Values
+		wantErr error
+	}{
+		{
+			"not existing",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:      mockCtx,
+				id:       "id",
+				clientID: "clientID",
+			},
+			nil,
+			zerrors.ThrowNotFound(nil, "COMMAND-Pa9nf", "Errors.AuthRequest.NotExisting"),
+		},
+		{
+			"other client",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						pushedEvent(time.Now().Add(time.Minute)),
+					),
+				),
+			},
+			args{
+				ctx:      mockCtx,
+				id:       "id",
+				clientID: "otherClientID",
+			},
+			nil,
+			zerrors.ThrowNotFound(nil, "COMMAND-Pa9nf", "Errors.AuthRequest.NotExisting"),
+		},
+		{
+			"already used",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						pushedEvent(time.Now().Add(time.Minute)),
+						eventFromEventPusher(
+							authrequest.NewPushedUsedEvent(mockCtx, &authrequest.NewAggregate("id", "instanceID").Aggregate),
+						),
+					),
+				),
+			},
+			args{
+				ctx:      mockCtx,
+				id:       "id",
+				clientID: "clientID",
+			},
+			nil,
+			zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pa2ah", "Errors.AuthRequest.AlreadyHandled"),
+		},
+		{
+			"expired",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						pushedEvent(time.Now().Add(-time.Minute)),
+					),
+				),
+			},
+			args{
+				ctx:      mockCtx,
+				id:       "id",
+				clientID: "clientID",
+			},
+			nil,
+			zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pa3ex", "Errors.AuthRequest.Expired"),
+		},
+		{
+			"used",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						pushedEvent(time.Now().Add(time.Minute)),
+					),
+					expectPush(
+						authrequest.NewPushedUsedEvent(mockCtx, &authrequest.NewAggregate("id", "instanceID").Aggregate),
+					),
+				),
+			},
+			args{
+				ctx:      mockCtx,
+				id:       "id",
+				clientID: "clientID",
+			},
+			parameters,
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.UsePushedAuthRequest(tt.args.ctx, tt.args.id, tt.args.clientID)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
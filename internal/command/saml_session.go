@@ -14,6 +14,7 @@ import (
 	"github.com/zitadel/zitadel/internal/id"
 	"github.com/zitadel/zitadel/internal/repository/samlrequest"
 	"github.com/zitadel/zitadel/internal/repository/samlsession"
+	"github.com/zitadel/zitadel/internal/repository/sessionlogout"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -33,7 +34,10 @@ type SAMLSession struct {
 
 type SAMLRequestComplianceChecker func(context.Context, *SAMLRequestWriteModel) error
 
-func (c *Commands) CreateSAMLSessionFromSAMLRequest(ctx context.Context, samlReqId string, complianceCheck SAMLRequestComplianceChecker, samlResponseID string, samlResponseLifetime time.Duration) (err error) {
+// CreateSAMLSessionFromSAMLRequest creates the SAML session for the successful SAML request.
+// If logout is provided, the service provider is registered as participant of the session,
+// so it's notified when the session is terminated.
+func (c *Commands) CreateSAMLSessionFromSAMLRequest(ctx context.Context, samlReqId string, complianceCheck SAMLRequestComplianceChecker, samlResponseID string, samlResponseLifetime time.Duration, logout *SAMLLogout) (err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
@@ -75,6 +79,7 @@ func (c *Commands) CreateSAMLSessionFromSAMLRequest(ctx context.Context, samlReq
 		sessionModel.PreferredLanguage,
 		sessionModel.UserAgent,
 	)
+	cmd.RegisterLogout(ctx, sessionModel.AggregateID, sessionModel.UserID, samlReqModel.Issuer, logout)
 
 	if err = cmd.AddSAMLResponse(ctx, samlResponseID, samlResponseLifetime); err != nil {
 		return err
@@ -151,6 +156,28 @@ func (c *SAMLSessionEvents) AddSession(
 	))
 }
 
+func (c *SAMLSessionEvents) RegisterLogout(ctx context.Context, sessionID, userID, entityID string, logout *SAMLLogout) {
+	// If the service provider did not provide a SingleLogoutService in its metadata, it does not support the Single Logout.
+	if sessionID == "" || logout == nil || logout.SingleLogoutURL == "" {
+		return
+	}
+
+	c.events = append(c.events, sessionlogout.NewSAMLLogoutRegisteredEvent(
+		ctx,
+		&sessionlogout.NewAggregate(sessionID, authz.GetInstance(ctx).InstanceID()).Aggregate,
+		c.samlSessionWriteModel.AggregateID,
+		userID,
+		entityID,
+		logout.Issuer,
+		logout.NameID,
+		logout.NameIDFormat,
+		logout.SessionIndex,
+		logout.SignatureAlgorithm,
+		logout.SingleLogoutURL,
+		logout.Binding,
+	))
+}
+
 func (c *SAMLSessionEvents) SetSAMLRequestSuccessful(ctx context.Context, samlRequestAggregate *eventstore.Aggregate) {
 	c.events = append(c.events, samlrequest.NewSucceededEvent(ctx, samlRequestAggregate))
 }
@@ -694,6 +694,7 @@ func TestCommands_OTPEmailSent(t *testing.T) {
 		ctx           context.Context
 		sessionID     string
 		resourceOwner string
+		generatorInfo *senders.CodeGeneratorInfo
 	}
 	tests := []struct {
 		name    string
@@ -735,7 +736,7 @@ func TestCommands_OTPEmailSent(t *testing.T) {
 						),
 					),
 					expectPush(
-						session.NewOTPEmailSentEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate),
+						session.NewOTPEmailSentEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate, nil),
 					),
 				),
 			},
@@ -752,7 +753,7 @@ func TestCommands_OTPEmailSent(t *testing.T) {
 			c := &Commands{
 				eventstore: tt.fields.eventstore(t),
 			}
-			err := c.OTPEmailSent(tt.args.ctx, tt.args.sessionID, tt.args.resourceOwner)
+			err := c.OTPEmailSent(tt.args.ctx, tt.args.sessionID, tt.args.resourceOwner, tt.args.generatorInfo)
 			assert.ErrorIs(t, err, tt.wantErr)
 		})
 	}
@@ -849,6 +850,7 @@ func TestCheckOTPSMS(t *testing.T) {
 						eventFromEventPusher(
 							org.NewLockoutPolicyAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
 								0, 0, false,
+								0, 0,
 							),
 						),
 					),
@@ -888,6 +890,7 @@ func TestCheckOTPSMS(t *testing.T) {
 						eventFromEventPusher(
 							org.NewLockoutPolicyAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
 								0, 1, false,
+								0, 0,
 							),
 						),
 					),
@@ -912,7 +915,7 @@ func TestCheckOTPSMS(t *testing.T) {
 				err: zerrors.ThrowPreconditionFailed(nil, "CODE-QvUQ4P", "Errors.User.Code.Expired"),
 				errorCommands: []eventstore.Command{
 					user.NewHumanOTPSMSCheckFailedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate, nil),
-					user.NewUserLockedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate),
+					user.NewUserLockedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate, nil),
 				},
 			},
 		},
@@ -993,7 +996,7 @@ func TestCheckOTPSMS(t *testing.T) {
 						eventFromEventPusher(user.NewHumanOTPSMSAddedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate)),
 					),
 					expectFilter(
-						user.NewUserLockedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate),
+						user.NewUserLockedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate, nil),
 					),
 				),
 				userID: "userID",
@@ -1145,6 +1148,7 @@ func TestCheckOTPEmail(t *testing.T) {
 						eventFromEventPusher(
 							org.NewLockoutPolicyAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
 								0, 0, false,
+								0, 0,
 							),
 						),
 					),
@@ -1185,6 +1189,7 @@ func TestCheckOTPEmail(t *testing.T) {
 						eventFromEventPusher(
 							org.NewLockoutPolicyAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate,
 								0, 1, false,
+								0, 0,
 							),
 						),
 					),
@@ -1210,7 +1215,7 @@ func TestCheckOTPEmail(t *testing.T) {
 				err: zerrors.ThrowPreconditionFailed(nil, "CODE-QvUQ4P", "Errors.User.Code.Expired"),
 				errorCommands: []eventstore.Command{
 					user.NewHumanOTPEmailCheckFailedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate, nil),
-					user.NewUserLockedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate),
+					user.NewUserLockedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate, nil),
 				},
 			},
 		},
@@ -1257,7 +1262,7 @@ func TestCheckOTPEmail(t *testing.T) {
 						eventFromEventPusher(user.NewHumanOTPEmailAddedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate)),
 					),
 					expectFilter(
-						user.NewUserLockedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate),
+						user.NewUserLockedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate, nil),
 					),
 				),
 				userID: "userID",
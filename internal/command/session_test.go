@@ -590,7 +590,7 @@ func TestCommands_updateSession(t *testing.T) {
 					),
 					expectFilter(), // recheck
 					expectFilter(
-						org.NewLockoutPolicyAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, 0, 0, false),
+						org.NewLockoutPolicyAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, 0, 0, false, 0, 0),
 					),
 					expectPush(
 						user.NewHumanPasswordCheckFailedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate, nil),
@@ -1172,7 +1172,7 @@ func TestCheckTOTP(t *testing.T) {
 					),
 					expectFilter(), // recheck
 					expectFilter(
-						eventFromEventPusher(org.NewLockoutPolicyAddedEvent(ctx, orgAgg, 0, 0, false)),
+						eventFromEventPusher(org.NewLockoutPolicyAddedEvent(ctx, orgAgg, 0, 0, false, 0, 0)),
 					),
 				),
 				tarpit: expectTarpit(1),
@@ -1202,14 +1202,14 @@ func TestCheckTOTP(t *testing.T) {
 					),
 					expectFilter(), // recheck
 					expectFilter(
-						eventFromEventPusher(org.NewLockoutPolicyAddedEvent(ctx, orgAgg, 1, 1, false)),
+						eventFromEventPusher(org.NewLockoutPolicyAddedEvent(ctx, orgAgg, 1, 1, false, 0, 0)),
 					),
 				),
 				tarpit: expectTarpit(1),
 			},
 			wantErrorCommands: []eventstore.Command{
 				user.NewHumanOTPCheckFailedEvent(ctx, userAgg, nil),
-				user.NewUserLockedEvent(ctx, userAgg),
+				user.NewUserLockedEvent(ctx, userAgg, nil),
 			},
 			wantErr: zerrors.ThrowInvalidArgument(nil, "EVENT-8isk2", "Errors.User.MFA.OTP.InvalidCode"),
 		},
@@ -1259,13 +1259,43 @@ func TestCheckTOTP(t *testing.T) {
 						),
 					),
 					expectFilter(
-						user.NewUserLockedEvent(ctx, userAgg),
+						user.NewUserLockedEvent(ctx, userAgg, nil),
 					),
 				),
 				tarpit: expectTarpit(0),
 			},
 			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-SF3fg", "Errors.User.Locked"),
 		},
+		{
+			name: "ok, lock expired",
+			code: code,
+			fields: fields{
+				sessionWriteModel: &SessionWriteModel{
+					UserID:        "user1",
+					UserCheckedAt: testNow,
+					aggregate:     sessAgg,
+				},
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanOTPAddedEvent(ctx, userAgg, secret),
+						),
+						eventFromEventPusher(
+							user.NewHumanOTPVerifiedEvent(ctx, userAgg, "agent1"),
+						),
+					),
+					expectFilter(
+						user.NewUserLockedEvent(ctx, userAgg, gu.Ptr(time.Now().Add(-time.Minute))),
+					),
+				),
+				tarpit: expectTarpit(0),
+			},
+			wantEventCommands: []eventstore.Command{
+				user.NewUserUnlockedEvent(ctx, userAgg),
+				user.NewHumanOTPCheckSucceededEvent(ctx, userAgg, nil),
+				session.NewTOTPCheckedEvent(ctx, sessAgg, testNow),
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1374,7 +1404,7 @@ func TestCheckRecoveryCode(t *testing.T) {
 					),
 					expectFilter(), // additional lock check
 					expectFilter(
-						eventFromEventPusher(org.NewLockoutPolicyAddedEvent(ctx, orgAgg, 0, 0, false)),
+						eventFromEventPusher(org.NewLockoutPolicyAddedEvent(ctx, orgAgg, 0, 0, false, 0, 0)),
 					),
 				),
 				hasher: hasher,
@@ -1400,14 +1430,14 @@ func TestCheckRecoveryCode(t *testing.T) {
 					),
 					expectFilter(), // additional lock check
 					expectFilter(
-						eventFromEventPusher(org.NewLockoutPolicyAddedEvent(ctx, orgAgg, 1, 1, false)),
+						eventFromEventPusher(org.NewLockoutPolicyAddedEvent(ctx, orgAgg, 1, 1, false, 0, 0)),
 					),
 				),
 				hasher: hasher,
 			},
 			wantErrorCommands: []eventstore.Command{
 				user.NewHumanRecoveryCodeCheckFailedEvent(ctx, userAgg, nil),
-				user.NewUserLockedEvent(ctx, userAgg),
+				user.NewUserLockedEvent(ctx, userAgg, nil),
 			},
 			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-6uvh0", "Errors.User.MFA.RecoveryCodes.InvalidCode"),
 		},
@@ -1425,7 +1455,7 @@ func TestCheckRecoveryCode(t *testing.T) {
 							user.NewHumanRecoveryCodesAddedEvent(ctx, userAgg, hashedCodes, nil),
 						),
 						eventFromEventPusher(
-							user.NewUserLockedEvent(ctx, userAgg),
+							user.NewUserLockedEvent(ctx, userAgg, nil),
 						),
 					),
 				),
@@ -2,9 +2,11 @@ package command
 
 import (
 	"context"
+	"strings"
 
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/notification/channels/sigv4"
 	"github.com/zitadel/zitadel/internal/repository/instance"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -269,6 +271,292 @@ func (c *Commands) ChangeSMSConfigHTTP(ctx context.Context, config *ChangeSMSHTT
 	return nil
 }
 
+type AddSMSVonage struct {
+	Details       *domain.ObjectDetails
+	ResourceOwner string
+	ID            string
+
+	Description  string
+	APIKey       string
+	APISecret    string
+	SenderNumber string
+}
+
+func (c *Commands) AddSMSConfigVonage(ctx context.Context, config *AddSMSVonage) (err error) {
+	if config.ResourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Vn2kq8Lw1p", "Errors.ResourceOwnerMissing")
+	}
+	if config.ID == "" {
+		config.ID, err = c.idGenerator.Next()
+		if err != nil {
+			return err
+		}
+	}
+	if strings.TrimSpace(config.APIKey) == "" || config.APISecret == "" || strings.TrimSpace(config.SenderNumber) == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Vn2kq8Lw8p", "Errors.Invalid.Argument")
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, config.ResourceOwner, config.ID)
+	if err != nil {
+		return err
+	}
+
+	apiSecret, err := crypto.Encrypt([]byte(config.APISecret), c.smsEncryption)
+	if err != nil {
+		return err
+	}
+	err = c.pushAppendAndReduce(ctx,
+		smsConfigWriteModel,
+		instance.NewSMSConfigVonageAddedEvent(
+			ctx,
+			InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel),
+			config.ID,
+			config.Description,
+			config.APIKey,
+			apiSecret,
+			config.SenderNumber,
+		),
+	)
+	if err != nil {
+		return err
+	}
+	config.Details = writeModelToObjectDetails(&smsConfigWriteModel.WriteModel)
+	return nil
+}
+
+type ChangeSMSVonage struct {
+	Details       *domain.ObjectDetails
+	ResourceOwner string
+	ID            string
+
+	Description  *string
+	APIKey       *string
+	SenderNumber *string
+}
+
+func (c *Commands) ChangeSMSConfigVonage(ctx context.Context, config *ChangeSMSVonage) (err error) {
+	if config.ResourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Vn2kq8Lw2p", "Errors.ResourceOwnerMissing")
+	}
+	if config.ID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Vn2kq8Lw3p", "Errors.IDMissing")
+	}
+	if isEmptyIfSet(config.APIKey) || isEmptyIfSet(config.SenderNumber) {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Vn2kq8Lw9p", "Errors.Invalid.Argument")
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, config.ResourceOwner, config.ID)
+	if err != nil {
+		return err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.Vonage == nil {
+		return zerrors.ThrowNotFound(nil, "COMMAND-Vn2kq8Lw4p", "Errors.SMSConfig.NotFound")
+	}
+	changedEvent, hasChanged, err := smsConfigWriteModel.NewVonageChangedEvent(
+		ctx,
+		InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel),
+		config.ID,
+		config.Description,
+		config.APIKey,
+		config.SenderNumber,
+	)
+	if err != nil {
+		return err
+	}
+	if !hasChanged {
+		config.Details = writeModelToObjectDetails(&smsConfigWriteModel.WriteModel)
+		return nil
+	}
+	err = c.pushAppendAndReduce(ctx, smsConfigWriteModel, changedEvent)
+	if err != nil {
+		return err
+	}
+	config.Details = writeModelToObjectDetails(&smsConfigWriteModel.WriteModel)
+	return nil
+}
+
+func (c *Commands) ChangeSMSConfigVonageSecret(ctx context.Context, resourceOwner, id, apiSecret string) (*domain.ObjectDetails, error) {
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Vn2kq8Lw5p", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Vn2kq8Lw6p", "Errors.IDMissing")
+	}
+	if apiSecret == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Vn2kq8Lx1p", "Errors.Invalid.Argument")
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, resourceOwner, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.Vonage == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Vn2kq8Lw7p", "Errors.SMSConfig.NotFound")
+	}
+	newSecret, err := crypto.Encrypt([]byte(apiSecret), c.smsEncryption)
+	if err != nil {
+		return nil, err
+	}
+	err = c.pushAppendAndReduce(ctx,
+		smsConfigWriteModel,
+		instance.NewSMSConfigVonageSecretChangedEvent(
+			ctx,
+			InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel),
+			id,
+			newSecret,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
+type AddSMSSNS struct {
+	Details       *domain.ObjectDetails
+	ResourceOwner string
+	ID            string
+
+	Description     string
+	AccessKeyID     string
+	SecretAccessKey string
+	Region          string
+	SenderID        string
+}
+
+func (c *Commands) AddSMSConfigSNS(ctx context.Context, config *AddSMSSNS) (err error) {
+	if config.ResourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sn3jr7Kv1q", "Errors.ResourceOwnerMissing")
+	}
+	if config.ID == "" {
+		config.ID, err = c.idGenerator.Next()
+		if err != nil {
+			return err
+		}
+	}
+	if strings.TrimSpace(config.AccessKeyID) == "" || config.SecretAccessKey == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sn3jr7Kv8q", "Errors.Invalid.Argument")
+	}
+	if !sigv4.IsValidRegion(config.Region) {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sn3jr7Kv9q", "Errors.Invalid.Argument")
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, config.ResourceOwner, config.ID)
+	if err != nil {
+		return err
+	}
+
+	secretAccessKey, err := crypto.Encrypt([]byte(config.SecretAccessKey), c.smsEncryption)
+	if err != nil {
+		return err
+	}
+	err = c.pushAppendAndReduce(ctx,
+		smsConfigWriteModel,
+		instance.NewSMSConfigSNSAddedEvent(
+			ctx,
+			InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel),
+			config.ID,
+			config.Description,
+			config.AccessKeyID,
+			secretAccessKey,
+			config.Region,
+			config.SenderID,
+		),
+	)
+	if err != nil {
+		return err
+	}
+	config.Details = writeModelToObjectDetails(&smsConfigWriteModel.WriteModel)
+	return nil
+}
+
+type ChangeSMSSNS struct {
+	Details       *domain.ObjectDetails
+	ResourceOwner string
+	ID            string
+
+	Description *string
+	AccessKeyID *string
+	Region      *string
+	SenderID    *string
+}
+
+func (c *Commands) ChangeSMSConfigSNS(ctx context.Context, config *ChangeSMSSNS) (err error) {
+	if config.ResourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sn3jr7Kv2q", "Errors.ResourceOwnerMissing")
+	}
+	if config.ID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sn3jr7Kv3q", "Errors.IDMissing")
+	}
+	if isEmptyIfSet(config.AccessKeyID) {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sn3jr7Kw1q", "Errors.Invalid.Argument")
+	}
+	if config.Region != nil && !sigv4.IsValidRegion(*config.Region) {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sn3jr7Kw2q", "Errors.Invalid.Argument")
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, config.ResourceOwner, config.ID)
+	if err != nil {
+		return err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.SNS == nil {
+		return zerrors.ThrowNotFound(nil, "COMMAND-Sn3jr7Kv4q", "Errors.SMSConfig.NotFound")
+	}
+	changedEvent, hasChanged, err := smsConfigWriteModel.NewSNSChangedEvent(
+		ctx,
+		InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel),
+		config.ID,
+		config.Description,
+		config.AccessKeyID,
+		config.Region,
+		config.SenderID,
+	)
+	if err != nil {
+		return err
+	}
+	if !hasChanged {
+		config.Details = writeModelToObjectDetails(&smsConfigWriteModel.WriteModel)
+		return nil
+	}
+	err = c.pushAppendAndReduce(ctx, smsConfigWriteModel, changedEvent)
+	if err != nil {
+		return err
+	}
+	config.Details = writeModelToObjectDetails(&smsConfigWriteModel.WriteModel)
+	return nil
+}
+
+func (c *Commands) ChangeSMSConfigSNSSecret(ctx context.Context, resourceOwner, id, secretAccessKey string) (*domain.ObjectDetails, error) {
+	if resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Sn3jr7Kv5q", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Sn3jr7Kv6q", "Errors.IDMissing")
+	}
+	if secretAccessKey == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Sn3jr7Kw3q", "Errors.Invalid.Argument")
+	}
+	smsConfigWriteModel, err := c.getSMSConfig(ctx, resourceOwner, id)
+	if err != nil {
+		return nil, err
+	}
+	if !smsConfigWriteModel.State.Exists() || smsConfigWriteModel.SNS == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Sn3jr7Kv7q", "Errors.SMSConfig.NotFound")
+	}
+	newSecret, err := crypto.Encrypt([]byte(secretAccessKey), c.smsEncryption)
+	if err != nil {
+		return nil, err
+	}
+	err = c.pushAppendAndReduce(ctx,
+		smsConfigWriteModel,
+		instance.NewSMSConfigSNSSecretChangedEvent(
+			ctx,
+			InstanceAggregateFromWriteModel(&smsConfigWriteModel.WriteModel),
+			id,
+			newSecret,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&smsConfigWriteModel.WriteModel), nil
+}
+
 func (c *Commands) ActivateSMSConfig(ctx context.Context, resourceOwner, id string) (*domain.ObjectDetails, error) {
 	if resourceOwner == "" {
 		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-EFgoOg997V", "Errors.ResourceOwnerMissing")
@@ -379,3 +667,8 @@ func (c *Commands) getActiveSMSConfig(ctx context.Context, instanceID string) (_
 	}
 	return c.getSMSConfig(ctx, instanceID, writeModel.activeID)
 }
+
+// isEmptyIfSet returns true if the optional value is set, but empty.
+func isEmptyIfSet(value *string) bool {
+	return value != nil && strings.TrimSpace(*value) == ""
+}
@@ -16,6 +16,8 @@ type IAMSMSConfigWriteModel struct {
 	Description string
 	Twilio      *TwilioConfig
 	HTTP        *HTTPConfig
+	Vonage      *VonageConfig
+	SNS         *SNSConfig
 	State       domain.SMSConfigState
 }
 
@@ -31,6 +33,19 @@ type HTTPConfig struct {
 	SigningKey *crypto.CryptoValue
 }
 
+type VonageConfig struct {
+	APIKey       string
+	APISecret    *crypto.CryptoValue
+	SenderNumber string
+}
+
+type SNSConfig struct {
+	AccessKeyID     string
+	SecretAccessKey *crypto.CryptoValue
+	Region          string
+	SenderID        string
+}
+
 func NewIAMSMSConfigWriteModel(instanceID, id string) *IAMSMSConfigWriteModel {
 	return &IAMSMSConfigWriteModel{
 		WriteModel: eventstore.WriteModel{
@@ -101,6 +116,68 @@ func (wm *IAMSMSConfigWriteModel) Reduce() error {
 			if e.SigningKey != nil {
 				wm.HTTP.SigningKey = e.SigningKey
 			}
+		case *instance.SMSConfigVonageAddedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.Vonage = &VonageConfig{
+				APIKey:       e.APIKey,
+				APISecret:    e.APISecret,
+				SenderNumber: e.SenderNumber,
+			}
+			wm.Description = e.Description
+			wm.State = domain.SMSConfigStateInactive
+		case *instance.SMSConfigVonageChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			if e.Description != nil {
+				wm.Description = *e.Description
+			}
+			if e.APIKey != nil {
+				wm.Vonage.APIKey = *e.APIKey
+			}
+			if e.SenderNumber != nil {
+				wm.Vonage.SenderNumber = *e.SenderNumber
+			}
+		case *instance.SMSConfigVonageSecretChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.Vonage.APISecret = e.APISecret
+		case *instance.SMSConfigSNSAddedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.SNS = &SNSConfig{
+				AccessKeyID:     e.AccessKeyID,
+				SecretAccessKey: e.SecretAccessKey,
+				Region:          e.Region,
+				SenderID:        e.SenderID,
+			}
+			wm.Description = e.Description
+			wm.State = domain.SMSConfigStateInactive
+		case *instance.SMSConfigSNSChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			if e.Description != nil {
+				wm.Description = *e.Description
+			}
+			if e.AccessKeyID != nil {
+				wm.SNS.AccessKeyID = *e.AccessKeyID
+			}
+			if e.Region != nil {
+				wm.SNS.Region = *e.Region
+			}
+			if e.SenderID != nil {
+				wm.SNS.SenderID = *e.SenderID
+			}
+		case *instance.SMSConfigSNSSecretChangedEvent:
+			if wm.ID != e.ID {
+				continue
+			}
+			wm.SNS.SecretAccessKey = e.SecretAccessKey
 		case *instance.SMSConfigTwilioActivatedEvent:
 			if wm.ID != e.ID {
 				wm.State = domain.SMSConfigStateInactive
@@ -118,6 +195,8 @@ func (wm *IAMSMSConfigWriteModel) Reduce() error {
 			}
 			wm.Twilio = nil
 			wm.HTTP = nil
+			wm.Vonage = nil
+			wm.SNS = nil
 			wm.State = domain.SMSConfigStateRemoved
 		case *instance.SMSConfigActivatedEvent:
 			if wm.ID != e.ID {
@@ -136,6 +215,8 @@ func (wm *IAMSMSConfigWriteModel) Reduce() error {
 			}
 			wm.Twilio = nil
 			wm.HTTP = nil
+			wm.Vonage = nil
+			wm.SNS = nil
 			wm.State = domain.SMSConfigStateRemoved
 		}
 	}
@@ -154,6 +235,12 @@ func (wm *IAMSMSConfigWriteModel) Query() *eventstore.SearchQueryBuilder {
 			instance.SMSConfigTwilioTokenChangedEventType,
 			instance.SMSConfigHTTPAddedEventType,
 			instance.SMSConfigHTTPChangedEventType,
+			instance.SMSConfigVonageAddedEventType,
+			instance.SMSConfigVonageChangedEventType,
+			instance.SMSConfigVonageSecretChangedEventType,
+			instance.SMSConfigSNSAddedEventType,
+			instance.SMSConfigSNSChangedEventType,
+			instance.SMSConfigSNSSecretChangedEventType,
 			instance.SMSConfigTwilioActivatedEventType,
 			instance.SMSConfigTwilioDeactivatedEventType,
 			instance.SMSConfigTwilioRemovedEventType,
@@ -229,6 +316,63 @@ func (wm *IAMSMSConfigWriteModel) NewHTTPChangedEvent(
 	return changeEvent, true, nil
 }
 
+func (wm *IAMSMSConfigWriteModel) NewVonageChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id string, description, apiKey, senderNumber *string) (*instance.SMSConfigVonageChangedEvent, bool, error) {
+	changes := make([]instance.SMSConfigVonageChanges, 0)
+
+	if wm.Vonage == nil {
+		return nil, false, nil
+	}
+
+	if description != nil && wm.Description != *description {
+		changes = append(changes, instance.ChangeSMSConfigVonageDescription(*description))
+	}
+	if apiKey != nil && wm.Vonage.APIKey != *apiKey {
+		changes = append(changes, instance.ChangeSMSConfigVonageAPIKey(*apiKey))
+	}
+	if senderNumber != nil && wm.Vonage.SenderNumber != *senderNumber {
+		changes = append(changes, instance.ChangeSMSConfigVonageSenderNumber(*senderNumber))
+	}
+
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := instance.NewSMSConfigVonageChangedEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
+
+func (wm *IAMSMSConfigWriteModel) NewSNSChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, id string, description, accessKeyID, region, senderID *string) (*instance.SMSConfigSNSChangedEvent, bool, error) {
+	changes := make([]instance.SMSConfigSNSChanges, 0)
+
+	if wm.SNS == nil {
+		return nil, false, nil
+	}
+
+	if description != nil && wm.Description != *description {
+		changes = append(changes, instance.ChangeSMSConfigSNSDescription(*description))
+	}
+	if accessKeyID != nil && wm.SNS.AccessKeyID != *accessKeyID {
+		changes = append(changes, instance.ChangeSMSConfigSNSAccessKeyID(*accessKeyID))
+	}
+	if region != nil && wm.SNS.Region != *region {
+		changes = append(changes, instance.ChangeSMSConfigSNSRegion(*region))
+	}
+	if senderID != nil && wm.SNS.SenderID != *senderID {
+		changes = append(changes, instance.ChangeSMSConfigSNSSenderID(*senderID))
+	}
+
+	if len(changes) == 0 {
+		return nil, false, nil
+	}
+	changeEvent, err := instance.NewSMSConfigSNSChangedEvent(ctx, aggregate, id, changes)
+	if err != nil {
+		return nil, false, err
+	}
+	return changeEvent, true, nil
+}
+
 type IAMSMSLastActivatedConfigWriteModel struct {
 	eventstore.WriteModel
 
@@ -657,6 +657,641 @@ func TestCommandSide_ChangeSMSConfigHTTP(t *testing.T) {
 	}
 }
 
+func TestCommandSide_AddSMSConfigVonage(t *testing.T) {
+	type fields struct {
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+		alg         crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx context.Context
+		sms *AddSMSVonage
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "add sms config vonage, missing resourceowner",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &AddSMSVonage{},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Vn2kq8Lw1p", "Errors.ResourceOwnerMissing"))
+				},
+			},
+		},
+		{
+			name: "add sms config vonage, missing api secret",
+			fields: fields{
+				eventstore:  expectEventstore(),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "providerid"),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &AddSMSVonage{
+					ResourceOwner: "INSTANCE",
+					APIKey:        "apiKey",
+					SenderNumber:  "senderNumber",
+				},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Vn2kq8Lw8p", "Errors.Invalid.Argument"))
+				},
+			},
+		},
+		{
+			name: "add sms config vonage, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						instance.NewSMSConfigVonageAddedEvent(
+							context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							"providerid",
+							"description",
+							"apiKey",
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("apiSecret"),
+							},
+							"senderNumber",
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "providerid"),
+				alg:         crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &AddSMSVonage{
+					ResourceOwner: "INSTANCE",
+					Description:   "description",
+					APIKey:        "apiKey",
+					APISecret:     "apiSecret",
+					SenderNumber:  "senderNumber",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:    tt.fields.eventstore(t),
+				idGenerator:   tt.fields.idGenerator,
+				smsEncryption: tt.fields.alg,
+			}
+			err := r.AddSMSConfigVonage(tt.args.ctx, tt.args.sms)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, tt.args.sms.Details)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ChangeSMSConfigVonage(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx context.Context
+		sms *ChangeSMSVonage
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "id empty, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &ChangeSMSVonage{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Vn2kq8Lw3p", "Errors.IDMissing"))
+				},
+			},
+		},
+		{
+			name: "sms config of other provider, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMSConfigHTTPAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"providerid",
+								"description",
+								"endpoint",
+								nil,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &ChangeSMSVonage{
+					ResourceOwner: "INSTANCE",
+					ID:            "providerid",
+					APIKey:        gu.Ptr("apiKey2"),
+				},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowNotFound(nil, "COMMAND-Vn2kq8Lw4p", "Errors.SMSConfig.NotFound"))
+				},
+			},
+		},
+		{
+			name: "no changes",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMSConfigVonageAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"providerid",
+								"description",
+								"apiKey",
+								nil,
+								"senderNumber",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &ChangeSMSVonage{
+					ResourceOwner: "INSTANCE",
+					ID:            "providerid",
+					Description:   gu.Ptr("description"),
+					APIKey:        gu.Ptr("apiKey"),
+					SenderNumber:  gu.Ptr("senderNumber"),
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+		{
+			name: "sms config vonage change, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMSConfigVonageAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"providerid",
+								"description",
+								"apiKey",
+								nil,
+								"senderNumber",
+							),
+						),
+					),
+					expectPush(
+						newSMSConfigVonageChangedEvent(
+							context.Background(),
+							"providerid",
+							"description2",
+							"apiKey2",
+							"senderNumber2",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &ChangeSMSVonage{
+					ResourceOwner: "INSTANCE",
+					ID:            "providerid",
+					Description:   gu.Ptr("description2"),
+					APIKey:        gu.Ptr("apiKey2"),
+					SenderNumber:  gu.Ptr("senderNumber2"),
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := r.ChangeSMSConfigVonage(tt.args.ctx, tt.args.sms)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, tt.args.sms.Details)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ChangeSMSConfigVonageSecret(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+		alg        crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx           context.Context
+		resourceOwner string
+		id            string
+		secret        string
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "sms not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "INSTANCE",
+				id:            "providerid",
+				secret:        "apiSecret2",
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowNotFound(nil, "COMMAND-Vn2kq8Lw7p", "Errors.SMSConfig.NotFound"))
+				},
+			},
+		},
+		{
+			name: "sms config vonage secret change, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMSConfigVonageAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"providerid",
+								"description",
+								"apiKey",
+								nil,
+								"senderNumber",
+							),
+						),
+					),
+					expectPush(
+						instance.NewSMSConfigVonageSecretChangedEvent(
+							context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							"providerid",
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("apiSecret2"),
+							},
+						),
+					),
+				),
+				alg: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "INSTANCE",
+				id:            "providerid",
+				secret:        "apiSecret2",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:    tt.fields.eventstore(t),
+				smsEncryption: tt.fields.alg,
+			}
+			got, err := r.ChangeSMSConfigVonageSecret(tt.args.ctx, tt.args.resourceOwner, tt.args.id, tt.args.secret)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_AddSMSConfigSNS(t *testing.T) {
+	type fields struct {
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+		alg         crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		ctx context.Context
+		sms *AddSMSSNS
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "add sms config sns, missing resourceowner",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &AddSMSSNS{},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Sn3jr7Kv1q", "Errors.ResourceOwnerMissing"))
+				},
+			},
+		},
+		{
+			name: "add sms config sns, invalid region",
+			fields: fields{
+				eventstore:  expectEventstore(),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "providerid"),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &AddSMSSNS{
+					ResourceOwner:   "INSTANCE",
+					AccessKeyID:     "accessKeyID",
+					SecretAccessKey: "secretAccessKey",
+					Region:          "eu-central-1.attacker.com",
+				},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Sn3jr7Kv9q", "Errors.Invalid.Argument"))
+				},
+			},
+		},
+		{
+			name: "add sms config sns, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						instance.NewSMSConfigSNSAddedEvent(
+							context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							"providerid",
+							"description",
+							"accessKeyID",
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("secretAccessKey"),
+							},
+							"eu-central-1",
+							"ZITADEL",
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "providerid"),
+				alg:         crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &AddSMSSNS{
+					ResourceOwner:   "INSTANCE",
+					Description:     "description",
+					AccessKeyID:     "accessKeyID",
+					SecretAccessKey: "secretAccessKey",
+					Region:          "eu-central-1",
+					SenderID:        "ZITADEL",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:    tt.fields.eventstore(t),
+				idGenerator:   tt.fields.idGenerator,
+				smsEncryption: tt.fields.alg,
+			}
+			err := r.AddSMSConfigSNS(tt.args.ctx, tt.args.sms)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, tt.args.sms.Details)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ChangeSMSConfigSNS(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx context.Context
+		sms *ChangeSMSSNS
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "sms not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &ChangeSMSSNS{
+					ResourceOwner: "INSTANCE",
+					ID:            "providerid",
+				},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowNotFound(nil, "COMMAND-Sn3jr7Kv4q", "Errors.SMSConfig.NotFound"))
+				},
+			},
+		},
+		{
+			name: "sms config sns change, invalid region",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &ChangeSMSSNS{
+					ResourceOwner: "INSTANCE",
+					ID:            "providerid",
+					Region:        gu.Ptr("attacker.com/us-east-1"),
+				},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Sn3jr7Kw2q", "Errors.Invalid.Argument"))
+				},
+			},
+		},
+		{
+			name: "sms config sns change, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMSConfigSNSAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"providerid",
+								"description",
+								"accessKeyID",
+								nil,
+								"eu-central-1",
+								"ZITADEL",
+							),
+						),
+					),
+					expectPush(
+						newSMSConfigSNSChangedEvent(
+							context.Background(),
+							"providerid",
+							"description2",
+							"accessKeyID2",
+							"us-east-1",
+							"ZITADEL2",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				sms: &ChangeSMSSNS{
+					ResourceOwner: "INSTANCE",
+					ID:            "providerid",
+					Description:   gu.Ptr("description2"),
+					AccessKeyID:   gu.Ptr("accessKeyID2"),
+					Region:        gu.Ptr("us-east-1"),
+					SenderID:      gu.Ptr("ZITADEL2"),
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := r.ChangeSMSConfigSNS(tt.args.ctx, tt.args.sms)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, tt.args.sms.Details)
+			}
+		})
+	}
+}
+
 func TestCommandSide_ActivateSMSConfig(t *testing.T) {
 	type fields struct {
 		eventstore func(*testing.T) *eventstore.Eventstore
@@ -1276,3 +1911,32 @@ func newSMSConfigHTTPChangedEvent(ctx context.Context, id, endpoint, description
 	)
 	return event
 }
+
+func newSMSConfigVonageChangedEvent(ctx context.Context, id, description, apiKey, senderNumber string) *instance.SMSConfigVonageChangedEvent {
+	changes := []instance.SMSConfigVonageChanges{
+		instance.ChangeSMSConfigVonageDescription(description),
+		instance.ChangeSMSConfigVonageAPIKey(apiKey),
+		instance.ChangeSMSConfigVonageSenderNumber(senderNumber),
+	}
+	event, _ := instance.NewSMSConfigVonageChangedEvent(ctx,
+		&instance.NewAggregate("INSTANCE").Aggregate,
+		id,
+		changes,
+	)
+	return event
+}
+
+func newSMSConfigSNSChangedEvent(ctx context.Context, id, description, accessKeyID, region, senderID string) *instance.SMSConfigSNSChangedEvent {
+	changes := []instance.SMSConfigSNSChanges{
+		instance.ChangeSMSConfigSNSDescription(description),
+		instance.ChangeSMSConfigSNSAccessKeyID(accessKeyID),
+		instance.ChangeSMSConfigSNSRegion(region),
+		instance.ChangeSMSConfigSNSSenderID(senderID),
+	}
+	event, _ := instance.NewSMSConfigSNSChangedEvent(ctx,
+		&instance.NewAggregate("INSTANCE").Aggregate,
+		id,
+		changes,
+	)
+	return event
+}
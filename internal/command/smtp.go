@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"crypto/subtle"
 	"net"
 	"net/url"
 	"strings"
@@ -12,6 +13,7 @@ import (
 	"github.com/zitadel/zitadel/internal/denylist"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/notification/channels/sigv4"
 	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
 	"github.com/zitadel/zitadel/internal/repository/instance"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -402,6 +404,192 @@ func (c *Commands) ChangeSMTPConfigHTTP(ctx context.Context, config *ChangeSMTPC
 	return nil
 }
 
+type AddSMTPConfigAPI struct {
+	Details       *domain.ObjectDetails
+	ResourceOwner string
+	ID            string
+
+	Description    string
+	Provider       domain.EmailAPIProviderType
+	From           string
+	FromName       string
+	ReplyToAddress string
+	// Region is the AWS region for Amazon SES and "eu" or empty (US) for Mailgun.
+	Region string
+	// Domain is the sending domain of Mailgun.
+	Domain string
+	// AccessKeyID is the AWS access key ID for Amazon SES.
+	AccessKeyID string
+	// APIKey is the secret access key for Amazon SES and the API key for SendGrid and Mailgun.
+	APIKey string
+}
+
+func (c *Commands) AddSMTPConfigAPI(ctx context.Context, config *AddSMTPConfigAPI) (err error) {
+	if config.ResourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ap3kr7Lw1p", "Errors.ResourceOwnerMissing")
+	}
+	if config.ID == "" {
+		config.ID, err = c.idGenerator.Next()
+		if err != nil {
+			return err
+		}
+	}
+	from := strings.TrimSpace(config.From)
+	if from == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ap3kr7Lw2p", "Errors.Invalid.Argument")
+	}
+	if config.APIKey == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ap3kr7Lw3p", "Errors.Invalid.Argument")
+	}
+	if err := validateEmailAPIConfig(config.Provider, config.Region, config.Domain, config.AccessKeyID); err != nil {
+		return err
+	}
+	fromSplitted := strings.Split(from, "@")
+	senderDomain := fromSplitted[len(fromSplitted)-1]
+
+	smtpConfigWriteModel, err := c.getSMTPConfig(ctx, config.ResourceOwner, config.ID, senderDomain)
+	if err != nil {
+		return err
+	}
+	err = checkSenderAddress(smtpConfigWriteModel)
+	if err != nil {
+		return err
+	}
+
+	apiKey, err := crypto.Encrypt([]byte(config.APIKey), c.smtpEncryption)
+	if err != nil {
+		return err
+	}
+
+	err = c.pushAppendAndReduce(ctx, smtpConfigWriteModel, instance.NewSMTPConfigAPIAddedEvent(
+		ctx,
+		InstanceAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel),
+		config.ID,
+		strings.TrimSpace(config.Description),
+		config.Provider,
+		from,
+		config.FromName,
+		strings.TrimSpace(config.ReplyToAddress),
+		strings.TrimSpace(config.Region),
+		strings.TrimSpace(config.Domain),
+		strings.TrimSpace(config.AccessKeyID),
+		apiKey,
+	))
+	if err != nil {
+		return err
+	}
+	config.Details = writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel)
+	return nil
+}
+
+type ChangeSMTPConfigAPI struct {
+	Details       *domain.ObjectDetails
+	ResourceOwner string
+	ID            string
+
+	Description    string
+	From           string
+	FromName       string
+	ReplyToAddress string
+	Region         string
+	Domain         string
+	AccessKeyID    string
+	// APIKey is only changed if set.
+	APIKey string
+}
+
+func (c *Commands) ChangeSMTPConfigAPI(ctx context.Context, config *ChangeSMTPConfigAPI) (err error) {
+	if config.ResourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ap3kr7Lw4p", "Errors.ResourceOwnerMissing")
+	}
+	if config.ID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ap3kr7Lw5p", "Errors.IDMissing")
+	}
+	from := strings.TrimSpace(config.From)
+	if from == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ap3kr7Lw6p", "Errors.Invalid.Argument")
+	}
+	fromSplitted := strings.Split(from, "@")
+	senderDomain := fromSplitted[len(fromSplitted)-1]
+
+	smtpConfigWriteModel, err := c.getSMTPConfig(ctx, config.ResourceOwner, config.ID, senderDomain)
+	if err != nil {
+		return err
+	}
+	if !smtpConfigWriteModel.State.Exists() || smtpConfigWriteModel.APIConfig == nil {
+		return zerrors.ThrowNotFound(nil, "COMMAND-Ap3kr7Lw7p", "Errors.SMTPConfig.NotFound")
+	}
+	if err := validateEmailAPIConfig(smtpConfigWriteModel.APIConfig.Provider, config.Region, config.Domain, config.AccessKeyID); err != nil {
+		return err
+	}
+	err = checkSenderAddress(smtpConfigWriteModel)
+	if err != nil {
+		return err
+	}
+
+	var apiKey *crypto.CryptoValue
+	if config.APIKey != "" {
+		apiKey, err = crypto.Encrypt([]byte(config.APIKey), c.smtpEncryption)
+		if err != nil {
+			return err
+		}
+	}
+
+	changedEvent, hasChanged, err := smtpConfigWriteModel.NewAPIChangedEvent(
+		ctx,
+		InstanceAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel),
+		config.ID,
+		strings.TrimSpace(config.Description),
+		from,
+		config.FromName,
+		strings.TrimSpace(config.ReplyToAddress),
+		strings.TrimSpace(config.Region),
+		strings.TrimSpace(config.Domain),
+		strings.TrimSpace(config.AccessKeyID),
+		apiKey,
+	)
+	if err != nil {
+		return err
+	}
+	if !hasChanged {
+		config.Details = writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel)
+		return nil
+	}
+
+	err = c.pushAppendAndReduce(ctx, smtpConfigWriteModel, changedEvent)
+	if err != nil {
+		return err
+	}
+	config.Details = writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel)
+	return nil
+}
+
+// validateEmailAPIConfig checks that the provider specific settings are present.
+func validateEmailAPIConfig(provider domain.EmailAPIProviderType, region, sendingDomain, accessKeyID string) error {
+	switch provider {
+	case domain.EmailAPIProviderTypeSES:
+		if strings.TrimSpace(region) == "" || strings.TrimSpace(accessKeyID) == "" {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ap4ls8Mx1q", "Errors.Invalid.Argument")
+		}
+		if !sigv4.IsValidRegion(strings.TrimSpace(region)) {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ap4ls8Mx5q", "Errors.Invalid.Argument")
+		}
+	case domain.EmailAPIProviderTypeMailgun:
+		if strings.TrimSpace(sendingDomain) == "" {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ap4ls8Mx2q", "Errors.Invalid.Argument")
+		}
+		if region = strings.TrimSpace(region); region != "" && region != "eu" && region != "us" {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ap4ls8Mx3q", "Errors.Invalid.Argument")
+		}
+	case domain.EmailAPIProviderTypeSendGrid:
+	case domain.EmailAPIProviderTypeUnspecified:
+		fallthrough
+	default:
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ap4ls8Mx4q", "Errors.Invalid.Argument")
+	}
+	return nil
+}
+
 func (c *Commands) ActivateSMTPConfig(ctx context.Context, resourceOwner, id string) (*domain.ObjectDetails, error) {
 	if resourceOwner == "" {
 		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-h5htMCebv3", "Errors.ResourceOwnerMissing")
@@ -499,6 +687,146 @@ func (c *Commands) RemoveSMTPConfig(ctx context.Context, resourceOwner, id strin
 	return writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
 }
 
+// GenerateSMTPConfigFeedbackKey generates a new key, which the email provider has to present
+// when reporting bounces and complaints. A previously generated key is invalidated immediately.
+func (c *Commands) GenerateSMTPConfigFeedbackKey(ctx context.Context, resourceOwner, id string) (string, *domain.ObjectDetails, error) {
+	if resourceOwner == "" {
+		return "", nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Fb3kq9Lw1z", "Errors.ResourceOwnerMissing")
+	}
+	if id == "" {
+		return "", nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Fb3kq9Lw2z", "Errors.IDMissing")
+	}
+
+	smtpConfigWriteModel, err := c.getSMTPConfig(ctx, resourceOwner, id, "")
+	if err != nil {
+		return "", nil, err
+	}
+	if !smtpConfigWriteModel.State.Exists() {
+		return "", nil, zerrors.ThrowNotFound(nil, "COMMAND-Fb3kq9Lw3z", "Errors.SMTPConfig.NotFound")
+	}
+
+	code, err := c.newSigningKey(ctx, c.eventstore.Filter, c.smtpEncryption) //nolint
+	if err != nil {
+		return "", nil, err
+	}
+	err = c.pushAppendAndReduce(ctx,
+		smtpConfigWriteModel,
+		instance.NewSMTPConfigFeedbackKeyGeneratedEvent(
+			ctx,
+			InstanceAggregateFromWriteModel(&smtpConfigWriteModel.WriteModel),
+			id,
+			code.Crypted,
+		),
+	)
+	if err != nil {
+		return "", nil, err
+	}
+	return code.PlainCode(), writeModelToObjectDetails(&smtpConfigWriteModel.WriteModel), nil
+}
+
+// VerifySMTPConfigFeedbackKey checks the key presented by the email provider when reporting bounces and complaints.
+// It returns the type of the API provider, which defines the format of the reports.
+// [domain.EmailAPIProviderTypeUnspecified] is returned for SMTP and HTTP providers.
+func (c *Commands) VerifySMTPConfigFeedbackKey(ctx context.Context, resourceOwner, id, key string) (domain.EmailAPIProviderType, error) {
+	if resourceOwner == "" || id == "" || key == "" {
+		return domain.EmailAPIProviderTypeUnspecified, zerrors.ThrowPermissionDenied(nil, "COMMAND-Fb3kq9Lw4z", "Errors.PermissionDenied")
+	}
+	smtpConfigWriteModel, err := c.getSMTPConfig(ctx, resourceOwner, id, "")
+	if err != nil {
+		return domain.EmailAPIProviderTypeUnspecified, err
+	}
+	if !smtpConfigWriteModel.State.Exists() || smtpConfigWriteModel.FeedbackKey == nil {
+		return domain.EmailAPIProviderTypeUnspecified, zerrors.ThrowPermissionDenied(nil, "COMMAND-Fb3kq9Lw5z", "Errors.PermissionDenied")
+	}
+	expected, err := crypto.DecryptString(smtpConfigWriteModel.FeedbackKey, c.smtpEncryption)
+	if err != nil {
+		return domain.EmailAPIProviderTypeUnspecified, err
+	}
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(key)) != 1 {
+		return domain.EmailAPIProviderTypeUnspecified, zerrors.ThrowPermissionDenied(nil, "COMMAND-Fb3kq9Lw6z", "Errors.PermissionDenied")
+	}
+	if smtpConfigWriteModel.APIConfig != nil {
+		return smtpConfigWriteModel.APIConfig.Provider, nil
+	}
+	return domain.EmailAPIProviderTypeUnspecified, nil
+}
+
+// ReEncryptSMTPConfigSecrets encrypts the secrets of all smtp configs of the instance,
+// which are not encrypted with the current smtp encryption key, with the current key.
+// After all instances are re-encrypted, the previous keys can be retired.
+// It returns the number of re-encrypted configs.
+func (c *Commands) ReEncryptSMTPConfigSecrets(ctx context.Context, instanceID string) (reEncrypted int, err error) {
+	if instanceID == "" {
+		return 0, zerrors.ThrowInvalidArgument(nil, "COMMAND-Kr3nZ", "Errors.ResourceOwnerMissing")
+	}
+	ids := NewIAMSMTPConfigIDsWriteModel(instanceID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, ids); err != nil {
+		return 0, err
+	}
+	for _, id := range ids.IDs {
+		smtpConfigWriteModel, err := c.getSMTPConfig(ctx, instanceID, id, "")
+		if err != nil {
+			return reEncrypted, err
+		}
+		event, err := c.reEncryptSMTPConfigSecrets(ctx, smtpConfigWriteModel)
+		if err != nil {
+			return reEncrypted, err
+		}
+		if event == nil {
+			continue
+		}
+		if err = c.pushAppendAndReduce(ctx, smtpConfigWriteModel, event); err != nil {
+			return reEncrypted, err
+		}
+		reEncrypted++
+	}
+	return reEncrypted, nil
+}
+
+// reEncryptSMTPConfigSecrets returns the event re-encrypting the secrets of the config
+// or nil if all of them are already encrypted with the current key.
+func (c *Commands) reEncryptSMTPConfigSecrets(ctx context.Context, wm *IAMSMTPConfigWriteModel) (*instance.SMTPConfigSecretsReEncryptedEvent, error) {
+	if !wm.State.Exists() {
+		return nil, nil
+	}
+	var password, clientSecret, signingKey, apiKey *crypto.CryptoValue
+	if wm.SMTPConfig != nil && wm.SMTPConfig.PlainAuth != nil {
+		password = wm.SMTPConfig.PlainAuth.Password
+	}
+	if wm.SMTPConfig != nil && wm.SMTPConfig.XOAuth2Auth != nil && wm.SMTPConfig.XOAuth2Auth.ClientCredentials != nil {
+		clientSecret = wm.SMTPConfig.XOAuth2Auth.ClientCredentials.ClientSecret
+	}
+	if wm.HTTPConfig != nil {
+		signingKey = wm.HTTPConfig.SigningKey
+	}
+	if wm.APIConfig != nil {
+		apiKey = wm.APIConfig.APIKey
+	}
+	secrets := []*crypto.CryptoValue{password, clientSecret, signingKey, apiKey, wm.FeedbackKey}
+	var changed bool
+	for i, secret := range secrets {
+		reEncrypted, err := crypto.ReEncrypt(secret, c.smtpEncryption)
+		if err != nil {
+			return nil, err
+		}
+		if reEncrypted != nil {
+			secrets[i] = reEncrypted
+			changed = true
+			continue
+		}
+		secrets[i] = nil
+	}
+	if !changed {
+		return nil, nil
+	}
+	return instance.NewSMTPConfigSecretsReEncryptedEvent(
+		ctx,
+		InstanceAggregateFromWriteModel(&wm.WriteModel),
+		wm.ID,
+		secrets[0], secrets[1], secrets[2], secrets[3], secrets[4],
+	), nil
+}
+
 func (c *Commands) TestSMTPConfig(ctx context.Context, instanceID, id, email string, config *smtp.Config) error {
 
 	if email == "" {
@@ -1674,6 +1674,1011 @@ func TestCommandSide_ChangeSMTPConfigHTTP(t *testing.T) {
 	}
 }
 
+func TestCommandSide_AddSMTPConfigAPI(t *testing.T) {
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+		alg         crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		api *AddSMTPConfigAPI
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "add smtp config, resourceowner empty",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				api: &AddSMTPConfigAPI{},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ap3kr7Lw1p", "Errors.ResourceOwnerMissing"))
+				},
+			},
+		},
+		{
+			name: "add smtp config, api key empty",
+			fields: fields{
+				eventstore:  expectEventstore(),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "configid"),
+			},
+			args: args{
+				api: &AddSMTPConfigAPI{
+					ResourceOwner: "INSTANCE",
+					Provider:      domain.EmailAPIProviderTypeSendGrid,
+					From:          "from@domain.ch",
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "add smtp config, ses without access key id",
+			fields: fields{
+				eventstore:  expectEventstore(),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "configid"),
+			},
+			args: args{
+				api: &AddSMTPConfigAPI{
+					ResourceOwner: "INSTANCE",
+					Provider:      domain.EmailAPIProviderTypeSES,
+					From:          "from@domain.ch",
+					Region:        "eu-central-1",
+					APIKey:        "secret",
+				},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ap4ls8Mx1q", "Errors.Invalid.Argument"))
+				},
+			},
+		},
+		{
+			name: "add smtp config, ses invalid region",
+			fields: fields{
+				eventstore:  expectEventstore(),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "configid"),
+			},
+			args: args{
+				api: &AddSMTPConfigAPI{
+					ResourceOwner: "INSTANCE",
+					Provider:      domain.EmailAPIProviderTypeSES,
+					From:          "from@domain.ch",
+					Region:        "attacker.com/eu-central-1",
+					AccessKeyID:   "AKIDEXAMPLE",
+					APIKey:        "secret",
+				},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ap4ls8Mx5q", "Errors.Invalid.Argument"))
+				},
+			},
+		},
+		{
+			name: "add smtp config, mailgun invalid region",
+			fields: fields{
+				eventstore:  expectEventstore(),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "configid"),
+			},
+			args: args{
+				api: &AddSMTPConfigAPI{
+					ResourceOwner: "INSTANCE",
+					Provider:      domain.EmailAPIProviderTypeMailgun,
+					From:          "from@domain.ch",
+					Domain:        "mg.domain.ch",
+					Region:        "asia",
+					APIKey:        "key",
+				},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ap4ls8Mx3q", "Errors.Invalid.Argument"))
+				},
+			},
+		},
+		{
+			name: "add smtp config, provider unspecified",
+			fields: fields{
+				eventstore:  expectEventstore(),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "configid"),
+			},
+			args: args{
+				api: &AddSMTPConfigAPI{
+					ResourceOwner: "INSTANCE",
+					From:          "from@domain.ch",
+					APIKey:        "key",
+				},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ap4ls8Mx4q", "Errors.Invalid.Argument"))
+				},
+			},
+		},
+		{
+			name: "add smtp config ses, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						instance.NewSMTPConfigAPIAddedEvent(
+							context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							"configid",
+							"test",
+							domain.EmailAPIProviderTypeSES,
+							"from@domain.ch",
+							"name",
+							"",
+							"eu-central-1",
+							"",
+							"AKIDEXAMPLE",
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("secret"),
+							},
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "configid"),
+				alg:         crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				api: &AddSMTPConfigAPI{
+					ResourceOwner: "INSTANCE",
+					Description:   "test",
+					Provider:      domain.EmailAPIProviderTypeSES,
+					From:          "from@domain.ch",
+					FromName:      "name",
+					Region:        "eu-central-1",
+					AccessKeyID:   "AKIDEXAMPLE",
+					APIKey:        "secret",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+		{
+			name: "add smtp config mailgun, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						instance.NewSMTPConfigAPIAddedEvent(
+							context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							"configid",
+							"test",
+							domain.EmailAPIProviderTypeMailgun,
+							"from@domain.ch",
+							"name",
+							"reply@domain.ch",
+							"eu",
+							"mg.domain.ch",
+							"",
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("key"),
+							},
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "configid"),
+				alg:         crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				api: &AddSMTPConfigAPI{
+					ResourceOwner:  "INSTANCE",
+					Description:    "test",
+					Provider:       domain.EmailAPIProviderTypeMailgun,
+					From:           "from@domain.ch",
+					FromName:       "name",
+					ReplyToAddress: "reply@domain.ch",
+					Region:         "eu",
+					Domain:         "mg.domain.ch",
+					APIKey:         "key",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:     tt.fields.eventstore(t),
+				idGenerator:    tt.fields.idGenerator,
+				smtpEncryption: tt.fields.alg,
+			}
+			err := r.AddSMTPConfigAPI(context.Background(), tt.args.api)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, tt.args.api.Details)
+				assert.NotEmpty(t, tt.args.api.ID)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ChangeSMTPConfigAPI(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		alg        crypto.EncryptionAlgorithm
+	}
+	type args struct {
+		api *ChangeSMTPConfigAPI
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	sendGridAdded := func() eventstore.Event {
+		return eventFromEventPusher(
+			instance.NewSMTPConfigAPIAddedEvent(
+				context.Background(),
+				&instance.NewAggregate("INSTANCE").Aggregate,
+				"ID",
+				"test",
+				domain.EmailAPIProviderTypeSendGrid,
+				"from@domain.ch",
+				"name",
+				"",
+				"",
+				"",
+				"",
+				&crypto.CryptoValue{
+					CryptoType: crypto.TypeEncryption,
+					Algorithm:  "enc",
+					KeyID:      "id",
+					Crypted:    []byte("key"),
+				},
+			),
+		)
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "change smtp config, id empty",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				api: &ChangeSMTPConfigAPI{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ap3kr7Lw5p", "Errors.IDMissing"))
+				},
+			},
+		},
+		{
+			name: "change smtp config, not found",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				api: &ChangeSMTPConfigAPI{
+					ResourceOwner: "INSTANCE",
+					ID:            "ID",
+					From:          "from@domain.ch",
+				},
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "change smtp config, no changes",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						sendGridAdded(),
+					),
+				),
+			},
+			args: args{
+				api: &ChangeSMTPConfigAPI{
+					ResourceOwner: "INSTANCE",
+					ID:            "ID",
+					Description:   "test",
+					From:          "from@domain.ch",
+					FromName:      "name",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+		{
+			name: "change smtp config, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						sendGridAdded(),
+					),
+					expectPush(
+						newSMTPConfigAPIChangedEvent(
+							context.Background(),
+							"ID",
+							"test2",
+							"from2@domain.ch",
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("key2"),
+							},
+						),
+					),
+				),
+				alg: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args: args{
+				api: &ChangeSMTPConfigAPI{
+					ResourceOwner: "INSTANCE",
+					ID:            "ID",
+					Description:   "test2",
+					From:          "from2@domain.ch",
+					FromName:      "name",
+					APIKey:        "key2",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:     tt.fields.eventstore(t),
+				smtpEncryption: tt.fields.alg,
+			}
+			err := r.ChangeSMTPConfigAPI(context.Background(), tt.args.api)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, tt.args.api.Details)
+			}
+		})
+	}
+}
+
+func TestCommandSide_GenerateSMTPConfigFeedbackKey(t *testing.T) {
+	type fields struct {
+		eventstore                  func(t *testing.T) *eventstore.Eventstore
+		newEncryptedCodeWithDefault encryptedCodeWithDefaultFunc
+		defaultSecretGenerators     *SecretGenerators
+	}
+	type args struct {
+		resourceOwner string
+		id            string
+	}
+	type res struct {
+		key  string
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "resourceowner empty, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				id: "configid",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "id empty, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				resourceOwner: "INSTANCE",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "config not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				resourceOwner: "INSTANCE",
+				id:            "configid",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "generate key, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMTPConfigHTTPAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+								"test",
+								"endpoint",
+								nil,
+							),
+						),
+					),
+					expectPush(
+						instance.NewSMTPConfigFeedbackKeyGeneratedEvent(
+							context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							"configid",
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("12345678"),
+							},
+						),
+					),
+				),
+				newEncryptedCodeWithDefault: mockEncryptedCodeWithDefault("12345678", time.Hour),
+				defaultSecretGenerators:     &SecretGenerators{},
+			},
+			args: args{
+				resourceOwner: "INSTANCE",
+				id:            "configid",
+			},
+			res: res{
+				key: "12345678",
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:                  tt.fields.eventstore(t),
+				newEncryptedCodeWithDefault: tt.fields.newEncryptedCodeWithDefault,
+				defaultSecretGenerators:     tt.fields.defaultSecretGenerators,
+			}
+			key, got, err := r.GenerateSMTPConfigFeedbackKey(context.Background(), tt.args.resourceOwner, tt.args.id)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.key, key)
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_VerifySMTPConfigFeedbackKey(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		resourceOwner string
+		id            string
+		key           string
+	}
+	type res struct {
+		want domain.EmailAPIProviderType
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "key empty, permission denied error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				resourceOwner: "INSTANCE",
+				id:            "configid",
+			},
+			res: res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			name: "no key generated, permission denied error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMTPConfigHTTPAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+								"test",
+								"endpoint",
+								nil,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				resourceOwner: "INSTANCE",
+				id:            "configid",
+				key:           "feedbackkey",
+			},
+			res: res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			name: "wrong key, permission denied error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMTPConfigHTTPAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+								"test",
+								"endpoint",
+								nil,
+							),
+						),
+						eventFromEventPusher(
+							instance.NewSMTPConfigFeedbackKeyGeneratedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("feedbackkey"),
+								},
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				resourceOwner: "INSTANCE",
+				id:            "configid",
+				key:           "wrong",
+			},
+			res: res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			name: "http provider, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMTPConfigHTTPAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+								"test",
+								"endpoint",
+								nil,
+							),
+						),
+						eventFromEventPusher(
+							instance.NewSMTPConfigFeedbackKeyGeneratedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("feedbackkey"),
+								},
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				resourceOwner: "INSTANCE",
+				id:            "configid",
+				key:           "feedbackkey",
+			},
+			res: res{
+				want: domain.EmailAPIProviderTypeUnspecified,
+			},
+		},
+		{
+			name: "api provider, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMTPConfigAPIAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+								"test",
+								domain.EmailAPIProviderTypeMailgun,
+								"from@example.com",
+								"name",
+								"",
+								"eu",
+								"mg.example.com",
+								"",
+								nil,
+							),
+						),
+						eventFromEventPusher(
+							instance.NewSMTPConfigFeedbackKeyGeneratedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("feedbackkey"),
+								},
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				resourceOwner: "INSTANCE",
+				id:            "configid",
+				key:           "feedbackkey",
+			},
+			res: res{
+				want: domain.EmailAPIProviderTypeMailgun,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:     tt.fields.eventstore(t),
+				smtpEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			}
+			got, err := r.VerifySMTPConfigFeedbackKey(context.Background(), tt.args.resourceOwner, tt.args.id, tt.args.key)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			assert.Equal(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommandSide_ReEncryptSMTPConfigSecrets(t *testing.T) {
+	rotatedEncryption := func(t *testing.T) crypto.EncryptionAlgorithm {
+		alg := crypto.NewMockEncryptionAlgorithm(gomock.NewController(t))
+		alg.EXPECT().Algorithm().AnyTimes().Return("enc")
+		alg.EXPECT().EncryptionKeyID().AnyTimes().Return("id2")
+		alg.EXPECT().DecryptionKeyIDs().AnyTimes().Return([]string{"id2", "id"})
+		alg.EXPECT().Encrypt(gomock.Any()).AnyTimes().DoAndReturn(
+			func(value []byte) ([]byte, error) {
+				return value, nil
+			},
+		)
+		alg.EXPECT().Decrypt(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+			func(value []byte, _ string) ([]byte, error) {
+				return value, nil
+			},
+		)
+		return alg
+	}
+	encrypted := func(keyID, value string) *crypto.CryptoValue {
+		return &crypto.CryptoValue{
+			CryptoType: crypto.TypeEncryption,
+			Algorithm:  "enc",
+			KeyID:      keyID,
+			Crypted:    []byte(value),
+		}
+	}
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		instanceID string
+	}
+	type res struct {
+		reEncrypted int
+		err         func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "instance empty, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "no configs, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				instanceID: "INSTANCE",
+			},
+			res: res{
+				reEncrypted: 0,
+			},
+		},
+		{
+			name: "secrets encrypted with current key, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMTPConfigHTTPAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+								"test",
+								"endpoint",
+								encrypted("id2", "signingkey"),
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMTPConfigHTTPAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+								"test",
+								"endpoint",
+								encrypted("id2", "signingkey"),
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				instanceID: "INSTANCE",
+			},
+			res: res{
+				reEncrypted: 0,
+			},
+		},
+		{
+			name: "removed config ignored, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMTPConfigHTTPAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+								"test",
+								"endpoint",
+								encrypted("id", "signingkey"),
+							),
+						),
+						eventFromEventPusher(
+							instance.NewSMTPConfigRemovedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				instanceID: "INSTANCE",
+			},
+			res: res{
+				reEncrypted: 0,
+			},
+		},
+		{
+			name: "secrets encrypted with previous key, re-encrypted",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMTPConfigAPIAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+								"test",
+								domain.EmailAPIProviderTypeSES,
+								"from@domain.ch",
+								"name",
+								"",
+								"eu-central-1",
+								"",
+								"accesskeyid",
+								encrypted("id", "apikey"),
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMTPConfigAPIAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+								"test",
+								domain.EmailAPIProviderTypeSES,
+								"from@domain.ch",
+								"name",
+								"",
+								"eu-central-1",
+								"",
+								"accesskeyid",
+								encrypted("id", "apikey"),
+							),
+						),
+						eventFromEventPusher(
+							instance.NewSMTPConfigFeedbackKeyGeneratedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+								encrypted("id2", "feedbackkey"),
+							),
+						),
+					),
+					expectPush(
+						instance.NewSMTPConfigSecretsReEncryptedEvent(
+							context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							"configid",
+							nil,
+							nil,
+							nil,
+							encrypted("id2", "apikey"),
+							nil,
+						),
+					),
+				),
+			},
+			args: args{
+				instanceID: "INSTANCE",
+			},
+			res: res{
+				reEncrypted: 1,
+			},
+		},
+		{
+			name: "key not available, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMTPConfigHTTPAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+								"test",
+								"endpoint",
+								encrypted("retired", "signingkey"),
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewSMTPConfigHTTPAddedEvent(
+								context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"configid",
+								"test",
+								"endpoint",
+								encrypted("retired", "signingkey"),
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				instanceID: "INSTANCE",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:     tt.fields.eventstore(t),
+				smtpEncryption: rotatedEncryption(t),
+			}
+			reEncrypted, err := r.ReEncryptSMTPConfigSecrets(context.Background(), tt.args.instanceID)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			assert.Equal(t, tt.res.reEncrypted, reEncrypted)
+		})
+	}
+}
+
 func TestCommands_validateNotificationWebhookEndpoint(t *testing.T) {
 	t.Parallel()
 
@@ -2862,3 +3867,17 @@ func newSMTPConfigHTTPChangedEvent(ctx context.Context, id, description, endpoin
 	)
 	return event
 }
+
+func newSMTPConfigAPIChangedEvent(ctx context.Context, id, description, senderAddress string, apiKey *crypto.CryptoValue) *instance.SMTPConfigAPIChangedEvent {
+	changes := []instance.SMTPConfigAPIChanges{
+		instance.ChangeSMTPConfigAPIDescription(description),
+		instance.ChangeSMTPConfigAPISenderAddress(senderAddress),
+		instance.ChangeSMTPConfigAPIKey(apiKey),
+	}
+	event, _ := instance.NewSMTPConfigAPIChangeEvent(ctx,
+		&instance.NewAggregate("INSTANCE").Aggregate,
+		id,
+		changes,
+	)
+	return event
+}
@@ -138,7 +138,7 @@ func (c *Commands) LockUser(ctx context.Context, userID, resourceOwner string) (
 	}
 
 	pushedEvents, err := c.eventstore.Push(ctx,
-		user.NewUserLockedEvent(ctx, UserAggregateFromWriteModel(&existingUser.WriteModel)))
+		user.NewUserLockedEvent(ctx, UserAggregateFromWriteModel(&existingUser.WriteModel), nil))
 	if err != nil {
 		return nil, err
 	}
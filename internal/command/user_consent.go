@@ -0,0 +1,132 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// GrantUserConsent stores the consent of the user to the requested scopes of the client.
+// It is called by the login after the user accepted the consent screen and therefore does not check any permission.
+func (c *Commands) GrantUserConsent(ctx context.Context, userID, resourceOwner, clientID string, scope []string) (*domain.ObjectDetails, error) {
+	if userID == "" || clientID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Cns1u", "Errors.IDMissing")
+	}
+	writeModel, err := c.getUserConsentWriteModel(ctx, userID, resourceOwner, clientID)
+	if err != nil {
+		return nil, err
+	}
+	if !isUserStateExists(writeModel.UserState) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Cns2n", "Errors.User.NotFound")
+	}
+	if writeModel.Covers(scope) {
+		return writeModelToObjectDetails(&writeModel.WriteModel), nil
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel, user.NewConsentGrantedEvent(
+		ctx,
+		UserAggregateFromWriteModelCtx(ctx, &writeModel.WriteModel),
+		clientID,
+		writeModel.grantedScope(scope),
+	)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RevokeUserConsent removes the consent of the user to the client,
+// so the consent screen will be shown again on the next authorization of the client.
+// Access and refresh tokens issued to the client for the user before the revocation are no longer valid.
+func (c *Commands) RevokeUserConsent(ctx context.Context, userID, clientID string) (*domain.ObjectDetails, error) {
+	if userID == "" || clientID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Cns3r", "Errors.IDMissing")
+	}
+	writeModel, err := c.getUserConsentWriteModel(ctx, userID, "", clientID)
+	if err != nil {
+		return nil, err
+	}
+	if !isUserStateExists(writeModel.UserState) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Cns4n", "Errors.User.NotFound")
+	}
+	if err := c.checkPermissionUpdateUser(ctx, writeModel.ResourceOwner, writeModel.AggregateID, true); err != nil {
+		return nil, err
+	}
+	if !writeModel.Granted {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Cns5n", "Errors.User.Consent.NotFound")
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel, user.NewConsentRevokedEvent(
+		ctx,
+		UserAggregateFromWriteModelCtx(ctx, &writeModel.WriteModel),
+		clientID,
+	)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) getUserConsentWriteModel(ctx context.Context, userID, resourceOwner, clientID string) (_ *UserConsentWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewUserConsentWriteModel(userID, resourceOwner, clientID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+// checkConsentNotRevokedAfter returns an invalid refresh token error if the user
+// revoked the consent for the client after the given time.
+func (c *Commands) checkConsentNotRevokedAfter(ctx context.Context, userID, clientID string, after time.Time) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" || clientID == "" || after.IsZero() {
+		return nil
+	}
+	model := &consentRevokedAfterModel{
+		userID:   userID,
+		clientID: clientID,
+		after:    after,
+	}
+	if err = c.eventstore.FilterToQueryReducer(ctx, model); err != nil {
+		return zerrors.ThrowPreconditionFailed(err, "OIDCS-Cns8n", "Errors.Internal")
+	}
+	if model.revoked {
+		return zerrors.ThrowPreconditionFailed(nil, "OIDCS-Cns9r", "Errors.OIDCSession.RefreshTokenInvalid")
+	}
+	return nil
+}
+
+type consentRevokedAfterModel struct {
+	userID   string
+	clientID string
+	after    time.Time
+
+	events  int
+	revoked bool
+}
+
+func (m *consentRevokedAfterModel) Reduce() error {
+	m.revoked = m.events > 0
+	return nil
+}
+
+func (m *consentRevokedAfterModel) AppendEvents(events ...eventstore.Event) {
+	m.events += len(events)
+}
+
+func (m *consentRevokedAfterModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		CreationDateAfter(m.after).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(m.userID).
+		EventTypes(user.ConsentRevokedType).
+		EventData(map[string]interface{}{"clientId": m.clientID}).
+		Builder()
+}
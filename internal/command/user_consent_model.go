@@ -0,0 +1,98 @@
+package command
+
+import (
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+// UserConsentWriteModel holds the consent a user gave to a single client.
+type UserConsentWriteModel struct {
+	eventstore.WriteModel
+
+	ClientID string
+
+	UserState domain.UserState
+	Granted   bool
+	Scope     []string
+}
+
+func NewUserConsentWriteModel(userID, resourceOwner, clientID string) *UserConsentWriteModel {
+	return &UserConsentWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+		ClientID: clientID,
+	}
+}
+
+func (wm *UserConsentWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanAddedEvent, *user.HumanRegisteredEvent, *user.MachineAddedEvent:
+			wm.UserState = domain.UserStateActive
+		case *user.UserRemovedEvent:
+			wm.UserState = domain.UserStateDeleted
+			wm.Granted = false
+			wm.Scope = nil
+		case *user.ConsentGrantedEvent:
+			if e.ClientID != wm.ClientID {
+				continue
+			}
+			wm.Granted = true
+			wm.Scope = e.Scope
+		case *user.ConsentRevokedEvent:
+			if e.ClientID != wm.ClientID {
+				continue
+			}
+			wm.Granted = false
+			wm.Scope = nil
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *UserConsentWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			user.HumanAddedType,
+			user.HumanRegisteredType,
+			user.MachineAddedEventType,
+			user.UserRemovedType,
+			user.ConsentGrantedType,
+			user.ConsentRevokedType,
+		).
+		Builder()
+}
+
+// Covers returns true if the user already consented to all of the requested scopes.
+func (wm *UserConsentWriteModel) Covers(scope []string) bool {
+	if !wm.Granted {
+		return false
+	}
+	for _, s := range scope {
+		if !slices.Contains(wm.Scope, s) {
+			return false
+		}
+	}
+	return true
+}
+
+// grantedScope returns the previously granted scopes extended by the requested ones,
+// so that consenting to a narrower request does not drop an earlier consent.
+func (wm *UserConsentWriteModel) grantedScope(scope []string) []string {
+	granted := slices.Clone(wm.Scope)
+	for _, s := range scope {
+		if !slices.Contains(granted, s) {
+			granted = append(granted, s)
+		}
+	}
+	return granted
+}
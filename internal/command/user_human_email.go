@@ -2,12 +2,14 @@ package command
 
 import (
 	"context"
+	"strings"
 
 	"github.com/zitadel/logging"
 
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/repository/user"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -145,7 +147,7 @@ func (c *Commands) CreateHumanEmailVerificationCode(ctx context.Context, userID,
 	return writeModelToObjectDetails(&existingEmail.WriteModel), nil
 }
 
-func (c *Commands) HumanEmailVerificationCodeSent(ctx context.Context, orgID, userID string) (err error) {
+func (c *Commands) HumanEmailVerificationCodeSent(ctx context.Context, orgID, userID string, generatorInfo *senders.CodeGeneratorInfo) (err error) {
 	if userID == "" {
 		return zerrors.ThrowInvalidArgument(nil, "COMMAND-4m9fs", "Errors.IDMissing")
 	}
@@ -157,10 +159,63 @@ func (c *Commands) HumanEmailVerificationCodeSent(ctx context.Context, orgID, us
 		return zerrors.ThrowNotFound(nil, "COMMAND-6n8uH", "Errors.User.Email.NotFound")
 	}
 	userAgg := UserAggregateFromWriteModel(&existingEmail.WriteModel)
-	_, err = c.eventstore.Push(ctx, user.NewHumanEmailCodeSentEvent(ctx, userAgg))
+	_, err = c.eventstore.Push(ctx, user.NewHumanEmailCodeSentEvent(ctx, userAgg, generatorInfo))
 	return err
 }
 
+// EmailUndeliverable is a bounce or complaint reported by the mail provider for an address.
+type EmailUndeliverable struct {
+	Email       domain.EmailAddress
+	Reason      domain.EmailUndeliverableReason
+	Description string
+	// ProviderID is the id of the email provider, which reported the address.
+	ProviderID string
+	// DeliveryID is the id of the message assigned by the provider, if reported.
+	DeliveryID string
+}
+
+// HumanEmailUndeliverable marks the current email address of the user as undeliverable.
+// Notifications will no longer be sent to the address until it is changed or verified again.
+// A report for an address, which is no longer used by the user, is rejected.
+func (c *Commands) HumanEmailUndeliverable(ctx context.Context, orgID, userID string, undeliverable *EmailUndeliverable) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ud2Lq8mR1x", "Errors.IDMissing")
+	}
+	if undeliverable == nil || !undeliverable.Reason.Valid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ud2Lq8mR2x", "Errors.Invalid.Argument")
+	}
+	existingEmail, err := c.emailWriteModel(ctx, userID, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if !existingEmail.UserState.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ud2Lq8mR3x", "Errors.User.NotFound")
+	}
+	if !strings.EqualFold(string(existingEmail.Email), string(undeliverable.Email)) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ud2Lq8mR4x", "Errors.User.Email.NotFound")
+	}
+	if existingEmail.Undeliverable {
+		return writeModelToObjectDetails(&existingEmail.WriteModel), nil
+	}
+	err = c.pushAppendAndReduce(ctx, existingEmail,
+		user.NewHumanEmailUndeliverableEvent(ctx,
+			UserAggregateFromWriteModel(&existingEmail.WriteModel),
+			existingEmail.Email,
+			undeliverable.Reason,
+			undeliverable.Description,
+			undeliverable.ProviderID,
+			undeliverable.DeliveryID,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingEmail.WriteModel), nil
+}
+
 func (c *Commands) emailWriteModel(ctx context.Context, userID, resourceOwner string) (writeModel *HumanEmailWriteModel, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
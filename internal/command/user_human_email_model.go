@@ -15,6 +15,8 @@ type HumanEmailWriteModel struct {
 
 	Email           domain.EmailAddress
 	IsEmailVerified bool
+	// Undeliverable is set, if the mail provider reported the current address as undeliverable.
+	Undeliverable bool
 
 	Code             *crypto.CryptoValue
 	CodeCreationDate time.Time
@@ -43,6 +45,7 @@ func (wm *HumanEmailWriteModel) Reduce() error {
 			wm.CodeExpiry = 0
 			wm.AuthRequestID = ""
 			wm.Email = e.EmailAddress
+			wm.Undeliverable = false
 			wm.UserState = domain.UserStateActive
 		case *user.HumanRegisteredEvent:
 			wm.IsEmailVerified = false
@@ -51,6 +54,7 @@ func (wm *HumanEmailWriteModel) Reduce() error {
 			wm.CodeExpiry = 0
 			wm.AuthRequestID = ""
 			wm.Email = e.EmailAddress
+			wm.Undeliverable = false
 			wm.UserState = domain.UserStateActive
 		case *user.HumanInitialCodeAddedEvent:
 			wm.UserState = domain.UserStateInitial
@@ -59,6 +63,7 @@ func (wm *HumanEmailWriteModel) Reduce() error {
 		case *user.HumanEmailChangedEvent:
 			wm.Email = e.EmailAddress
 			wm.IsEmailVerified = false
+			wm.Undeliverable = false
 			wm.Code = nil
 		case *user.HumanEmailCodeAddedEvent:
 			wm.Code = e.Code
@@ -67,10 +72,16 @@ func (wm *HumanEmailWriteModel) Reduce() error {
 			wm.AuthRequestID = e.AuthRequestID
 		case *user.HumanEmailVerifiedEvent:
 			wm.IsEmailVerified = true
+			wm.Undeliverable = false
 			wm.Code = nil
+		case *user.HumanEmailUndeliverableEvent:
+			if e.EmailAddress == wm.Email {
+				wm.Undeliverable = true
+			}
 		case *user.UserRemovedEvent:
 			wm.Email = ""
 			wm.IsEmailVerified = false
+			wm.Undeliverable = false
 			wm.Code = nil
 			wm.CodeCreationDate = time.Time{}
 			wm.CodeExpiry = 0
@@ -111,6 +122,7 @@ func (wm *HumanEmailWriteModel) Query() *eventstore.SearchQueryBuilder {
 			user.HumanEmailCodeAddedType,
 			user.UserV1EmailVerifiedType,
 			user.HumanEmailVerifiedType,
+			user.HumanEmailUndeliverableType,
 			user.UserRemovedType).
 		Builder()
 
@@ -12,6 +12,7 @@ import (
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/repository/org"
 	"github.com/zitadel/zitadel/internal/repository/user"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -721,6 +722,7 @@ func TestCommandSide_VerifyHumanEmail(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -798,6 +800,7 @@ func TestCommandSide_VerifyHumanEmail(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -1204,6 +1207,7 @@ func TestCommandSide_EmailVerificationCodeSent(t *testing.T) {
 		ctx           context.Context
 		userID        string
 		resourceOwner string
+		generatorInfo *senders.CodeGeneratorInfo
 	}
 	type res struct {
 		err func(error) bool
@@ -1277,6 +1281,10 @@ func TestCommandSide_EmailVerificationCodeSent(t *testing.T) {
 					expectPush(
 						user.NewHumanEmailCodeSentEvent(context.Background(),
 							&user.NewAggregate("user1", "org1").Aggregate,
+							&senders.CodeGeneratorInfo{
+								ID:         "emailProviderID",
+								DeliveryID: "deliveryID",
+							},
 						),
 					),
 				),
@@ -1285,6 +1293,10 @@ func TestCommandSide_EmailVerificationCodeSent(t *testing.T) {
 				ctx:           context.Background(),
 				userID:        "user1",
 				resourceOwner: "org1",
+				generatorInfo: &senders.CodeGeneratorInfo{
+					ID:         "emailProviderID",
+					DeliveryID: "deliveryID",
+				},
 			},
 			res: res{},
 		},
@@ -1294,7 +1306,7 @@ func TestCommandSide_EmailVerificationCodeSent(t *testing.T) {
 			r := &Commands{
 				eventstore: tt.fields.eventstore(t),
 			}
-			err := r.HumanEmailVerificationCodeSent(tt.args.ctx, tt.args.resourceOwner, tt.args.userID)
+			err := r.HumanEmailVerificationCodeSent(tt.args.ctx, tt.args.resourceOwner, tt.args.userID, tt.args.generatorInfo)
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
@@ -1304,3 +1316,244 @@ func TestCommandSide_EmailVerificationCodeSent(t *testing.T) {
 		})
 	}
 }
+
+func TestCommandSide_HumanEmailUndeliverable(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		userID        string
+		resourceOwner string
+		undeliverable *EmailUndeliverable
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "userid missing, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+				undeliverable: &EmailUndeliverable{
+					Email:  "email@test.ch",
+					Reason: domain.EmailUndeliverableReasonBounce,
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "reason missing, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				undeliverable: &EmailUndeliverable{
+					Email: "email@test.ch",
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "user not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				undeliverable: &EmailUndeliverable{
+					Email:       "EMAIL@test.ch",
+					Reason:      domain.EmailUndeliverableReasonBounce,
+					Description: "mailbox does not exist",
+					ProviderID:  "providerID",
+					DeliveryID:  "deliveryID",
+				},
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "email changed, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanEmailChangedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"email2@test.ch",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				undeliverable: &EmailUndeliverable{
+					Email:       "EMAIL@test.ch",
+					Reason:      domain.EmailUndeliverableReasonBounce,
+					Description: "mailbox does not exist",
+					ProviderID:  "providerID",
+					DeliveryID:  "deliveryID",
+				},
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "already undeliverable, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanEmailUndeliverableEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"email@test.ch",
+								domain.EmailUndeliverableReasonBounce,
+								"mailbox does not exist",
+								"providerID",
+								"deliveryID",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				undeliverable: &EmailUndeliverable{
+					Email:       "EMAIL@test.ch",
+					Reason:      domain.EmailUndeliverableReasonBounce,
+					Description: "mailbox does not exist",
+					ProviderID:  "providerID",
+					DeliveryID:  "deliveryID",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "undeliverable, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+					),
+					expectPush(
+						user.NewHumanEmailUndeliverableEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"email@test.ch",
+							domain.EmailUndeliverableReasonBounce,
+							"mailbox does not exist",
+							"providerID",
+							"deliveryID",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				undeliverable: &EmailUndeliverable{
+					Email:       "EMAIL@test.ch",
+					Reason:      domain.EmailUndeliverableReasonBounce,
+					Description: "mailbox does not exist",
+					ProviderID:  "providerID",
+					DeliveryID:  "deliveryID",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := r.HumanEmailUndeliverable(tt.args.ctx, tt.args.resourceOwner, tt.args.userID, tt.args.undeliverable)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/repository/org"
 	"github.com/zitadel/zitadel/internal/repository/user"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -653,6 +654,7 @@ func TestCommandSide_VerifyInitCode(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -729,6 +731,7 @@ func TestCommandSide_VerifyInitCode(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -787,6 +790,7 @@ func TestCommandSide_InitCodeSent(t *testing.T) {
 		ctx           context.Context
 		userID        string
 		resourceOwner string
+		generatorInfo *senders.CodeGeneratorInfo
 	}
 	type res struct {
 		err func(error) bool
@@ -849,6 +853,7 @@ func TestCommandSide_InitCodeSent(t *testing.T) {
 					expectPush(
 						user.NewHumanInitialCodeSentEvent(context.Background(),
 							&user.NewAggregate("user1", "org1").Aggregate,
+							nil,
 						),
 					),
 				),
@@ -866,7 +871,7 @@ func TestCommandSide_InitCodeSent(t *testing.T) {
 			r := &Commands{
 				eventstore: tt.fields.eventstore(t),
 			}
-			err := r.HumanInitCodeSent(tt.args.ctx, tt.args.resourceOwner, tt.args.userID)
+			err := r.HumanInitCodeSent(tt.args.ctx, tt.args.resourceOwner, tt.args.userID, tt.args.generatorInfo)
 			if tt.res.err == nil {
 				assert.NoError(t, err)
 			}
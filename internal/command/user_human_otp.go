@@ -205,26 +205,32 @@ func checkTOTP(
 	if recheckErr != nil {
 		return nil, recheckErr
 	}
+	commands := make([]eventstore.Command, 0, 3)
+	failedAttempts := existingOTP.CheckFailedCount
 	if existingOTP.UserLocked {
-		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-SF3fg", "Errors.User.Locked")
+		if !domain.IsLockExpired(existingOTP.LockedUntil, time.Now()) {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-SF3fg", "Errors.User.Locked")
+		}
+		// the lockout duration passed, so the user is unlocked automatically
+		commands = append(commands, user.NewUserUnlockedEvent(ctx, userAgg))
+		failedAttempts = 0
 	}
 
 	// the OTP check succeeded and the user was not locked in the meantime
 	if verifyErr == nil {
-		return []eventstore.Command{user.NewHumanOTPCheckSucceededEvent(ctx, userAgg, optionalAuthRequestInfo)}, nil
+		return append(commands, user.NewHumanOTPCheckSucceededEvent(ctx, userAgg, optionalAuthRequestInfo)), nil
 	}
 
 	// the OTP check failed, therefore check if the limit was reached and the user must additionally be locked
-	commands := make([]eventstore.Command, 0, 2)
 	commands = append(commands, user.NewHumanOTPCheckFailedEvent(ctx, userAgg, optionalAuthRequestInfo))
 	lockoutPolicy, err := getLockoutPolicy(ctx, existingOTP.ResourceOwner, queryReducer)
 	if err != nil {
 		return nil, err
 	}
-	if lockoutPolicy.MaxOTPAttempts > 0 && existingOTP.CheckFailedCount+1 >= lockoutPolicy.MaxOTPAttempts {
-		commands = append(commands, user.NewUserLockedEvent(ctx, userAgg))
+	if lockoutPolicy.MaxOTPAttempts > 0 && failedAttempts+1 >= lockoutPolicy.MaxOTPAttempts {
+		commands = append(commands, user.NewUserLockedEvent(ctx, userAgg, lockoutPolicy.LockedUntil(time.Now())))
 	}
-	tarpit(existingOTP.CheckFailedCount + 1)
+	tarpit(failedAttempts + 1)
 	return commands, verifyErr
 }
 
@@ -480,12 +486,12 @@ func (c *Commands) HumanSendOTPEmail(ctx context.Context, userID, resourceOwner
 	)
 }
 
-func (c *Commands) HumanOTPEmailCodeSent(ctx context.Context, userID, resourceOwner string) (err error) {
+func (c *Commands) HumanOTPEmailCodeSent(ctx context.Context, userID, resourceOwner string, generatorInfo *senders.CodeGeneratorInfo) (err error) {
 	smsWriteModel := func(ctx context.Context, userID string, resourceOwner string) (OTPWriteModel, error) {
 		return c.otpEmailWriteModelByID(ctx, userID, resourceOwner)
 	}
 	codeSentEvent := func(ctx context.Context, aggregate *eventstore.Aggregate) eventstore.Command {
-		return user.NewHumanOTPEmailCodeSentEvent(ctx, aggregate)
+		return user.NewHumanOTPEmailCodeSentEvent(ctx, aggregate, generatorInfo)
 	}
 	return c.humanOTPSent(ctx, userID, resourceOwner, smsWriteModel, codeSentEvent)
 }
@@ -616,24 +622,30 @@ func checkOTP(
 	if recheckErr != nil {
 		return nil, recheckErr
 	}
+	commands := make([]eventstore.Command, 0, 3)
+	failedAttempts := existingOTP.CheckFailedCount()
 	if existingOTP.UserLocked() {
-		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-S6h4R", "Errors.User.Locked")
+		if !domain.IsLockExpired(existingOTP.LockedUntil(), time.Now()) {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-S6h4R", "Errors.User.Locked")
+		}
+		// the lockout duration passed, so the user is unlocked automatically
+		commands = append(commands, user.NewUserUnlockedEvent(ctx, userAgg))
+		failedAttempts = 0
 	}
 
 	// the OTP check succeeded and the user was not locked in the meantime
 	if verifyErr == nil {
-		return []eventstore.Command{checkSucceededEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authRequest))}, nil
+		return append(commands, checkSucceededEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authRequest))), nil
 	}
 
 	// the OTP check failed, therefore check if the limit was reached and the user must additionally be locked
-	commands := make([]eventstore.Command, 0, 2)
 	commands = append(commands, checkFailedEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authRequest)))
 	lockoutPolicy, lockoutErr := getLockoutPolicy(ctx, existingOTP.ResourceOwner(), queryReducer)
 	logging.OnError(lockoutErr).Error("unable to get lockout policy")
-	if lockoutPolicy != nil && lockoutPolicy.MaxOTPAttempts > 0 && existingOTP.CheckFailedCount()+1 >= lockoutPolicy.MaxOTPAttempts {
-		commands = append(commands, user.NewUserLockedEvent(ctx, userAgg))
+	if lockoutPolicy != nil && lockoutPolicy.MaxOTPAttempts > 0 && failedAttempts+1 >= lockoutPolicy.MaxOTPAttempts {
+		commands = append(commands, user.NewUserLockedEvent(ctx, userAgg, lockoutPolicy.LockedUntil(time.Now())))
 	}
-	tarpit(existingOTP.CheckFailedCount() + 1)
+	tarpit(failedAttempts + 1)
 	return commands, verifyErr
 }
 
@@ -16,6 +16,7 @@ type HumanTOTPWriteModel struct {
 	Secret           *crypto.CryptoValue
 	CheckFailedCount uint64
 	UserLocked       bool
+	LockedUntil      *time.Time
 }
 
 func NewHumanTOTPWriteModel(userID, resourceOwner string) *HumanTOTPWriteModel {
@@ -42,20 +43,24 @@ func (wm *HumanTOTPWriteModel) Reduce() error {
 			wm.CheckFailedCount++
 		case *user.UserLockedEvent:
 			wm.UserLocked = true
+			wm.LockedUntil = e.LockedUntil
 		case *user.UserUnlockedEvent:
 			wm.CheckFailedCount = 0
 			wm.UserLocked = false
+			wm.LockedUntil = nil
 		case *user.HumanOTPRemovedEvent:
 			wm.State = domain.MFAStateRemoved
 		case *user.UserRemovedEvent:
 			wm.Secret = nil
 			wm.CheckFailedCount = 0
 			wm.UserLocked = false
+			wm.LockedUntil = nil
 			wm.State = domain.MFAStateRemoved
 		case *user.HumanAddedEvent, *user.HumanRegisteredEvent, *user.MachineAddedEvent:
 			wm.Secret = nil
 			wm.CheckFailedCount = 0
 			wm.UserLocked = false
+			wm.LockedUntil = nil
 			wm.State = domain.MFAStateUnspecified
 		}
 	}
@@ -102,6 +107,7 @@ type OTPCodeWriteModel interface {
 	Code() *crypto.CryptoValue
 	CheckFailedCount() uint64
 	UserLocked() bool
+	LockedUntil() *time.Time
 	GeneratorID() string
 	ProviderVerificationID() string
 	eventstore.QueryReducer
@@ -182,6 +188,7 @@ type HumanOTPSMSCodeWriteModel struct {
 
 	checkFailedCount uint64
 	userLocked       bool
+	lockedUntil      *time.Time
 }
 
 func (wm *HumanOTPSMSCodeWriteModel) CodeCreationDate() time.Time {
@@ -213,6 +220,10 @@ func (wm *HumanOTPSMSCodeWriteModel) UserLocked() bool {
 	return wm.userLocked
 }
 
+func (wm *HumanOTPSMSCodeWriteModel) LockedUntil() *time.Time {
+	return wm.lockedUntil
+}
+
 func (wm *HumanOTPSMSCodeWriteModel) GeneratorID() string {
 	if wm.otpCode == nil {
 		return ""
@@ -252,9 +263,11 @@ func (wm *HumanOTPSMSCodeWriteModel) Reduce() error {
 			wm.checkFailedCount++
 		case *user.UserLockedEvent:
 			wm.userLocked = true
+			wm.lockedUntil = e.LockedUntil
 		case *user.UserUnlockedEvent:
 			wm.checkFailedCount = 0
 			wm.userLocked = false
+			wm.lockedUntil = nil
 		}
 	}
 	return wm.HumanOTPSMSWriteModel.Reduce()
@@ -356,6 +369,7 @@ type HumanOTPEmailCodeWriteModel struct {
 
 	checkFailedCount uint64
 	userLocked       bool
+	lockedUntil      *time.Time
 }
 
 func (wm *HumanOTPEmailCodeWriteModel) CodeCreationDate() time.Time {
@@ -387,6 +401,10 @@ func (wm *HumanOTPEmailCodeWriteModel) UserLocked() bool {
 	return wm.userLocked
 }
 
+func (wm *HumanOTPEmailCodeWriteModel) LockedUntil() *time.Time {
+	return wm.lockedUntil
+}
+
 func (wm *HumanOTPEmailCodeWriteModel) GeneratorID() string {
 	if wm.otpCode == nil {
 		return ""
@@ -422,9 +440,11 @@ func (wm *HumanOTPEmailCodeWriteModel) Reduce() error {
 			wm.checkFailedCount++
 		case *user.UserLockedEvent:
 			wm.userLocked = true
+			wm.lockedUntil = e.LockedUntil
 		case *user.UserUnlockedEvent:
 			wm.checkFailedCount = 0
 			wm.userLocked = false
+			wm.lockedUntil = nil
 		}
 	}
 	return wm.HumanOTPEmailWriteModel.Reduce()
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/muhlemmer/gu"
 	"github.com/pquerna/otp/totp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -2074,6 +2075,7 @@ func TestCommandSide_HumanCheckOTPSMS(t *testing.T) {
 							org.NewLockoutPolicyAddedEvent(ctx,
 								&org.NewAggregate("orgID").Aggregate,
 								3, 3, true,
+								0, 0,
 							),
 						),
 					),
@@ -2153,6 +2155,7 @@ func TestCommandSide_HumanCheckOTPSMS(t *testing.T) {
 							org.NewLockoutPolicyAddedEvent(ctx,
 								&org.NewAggregate("orgID").Aggregate,
 								1, 1, true,
+								0, 0,
 							),
 						),
 					),
@@ -2171,6 +2174,7 @@ func TestCommandSide_HumanCheckOTPSMS(t *testing.T) {
 						),
 						user.NewUserLockedEvent(ctx,
 							&user.NewAggregate("user1", "org1").Aggregate,
+							nil,
 						),
 					),
 				),
@@ -2305,6 +2309,7 @@ func TestCommandSide_HumanCheckOTPSMS(t *testing.T) {
 					expectFilter( // recheck
 						user.NewUserLockedEvent(ctx,
 							&user.NewAggregate("user1", "org1").Aggregate,
+							nil,
 						),
 					),
 				),
@@ -2330,6 +2335,87 @@ func TestCommandSide_HumanCheckOTPSMS(t *testing.T) {
 				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-S6h4R", "Errors.User.Locked"),
 			},
 		},
+		{
+			name: "code ok, lock expired, user unlocked",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanOTPSMSAddedEvent(ctx,
+								&user.NewAggregate("user1", "org1").Aggregate,
+							),
+						),
+						eventFromEventPusherWithCreationDateNow(
+							user.NewHumanOTPSMSCodeAddedEvent(ctx,
+								&user.NewAggregate("user1", "org1").Aggregate,
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "enc",
+									KeyID:      "id",
+									Crypted:    []byte("code"),
+								},
+								time.Hour,
+								&user.AuthRequestInfo{
+									ID:          "authRequestID",
+									UserAgentID: "userAgentID",
+									BrowserInfo: &user.BrowserInfo{
+										UserAgent:      "user-agent",
+										AcceptLanguage: "en",
+										RemoteIP:       net.IP{192, 0, 2, 1},
+									},
+								},
+								"",
+							),
+						),
+					),
+					expectFilter( // recheck
+						user.NewUserLockedEvent(ctx,
+							&user.NewAggregate("user1", "org1").Aggregate,
+							gu.Ptr(time.Now().Add(-time.Minute)),
+						),
+					),
+					expectPush(
+						user.NewUserUnlockedEvent(ctx,
+							&user.NewAggregate("user1", "org1").Aggregate,
+						),
+						user.NewHumanOTPSMSCheckSucceededEvent(ctx,
+							&user.NewAggregate("user1", "org1").Aggregate,
+							&user.AuthRequestInfo{
+								ID:          "authRequestID",
+								UserAgentID: "userAgentID",
+								BrowserInfo: &user.BrowserInfo{
+									UserAgent:      "user-agent",
+									AcceptLanguage: "en",
+									RemoteIP:       net.IP{192, 0, 2, 1},
+								},
+							},
+						),
+					),
+				),
+				userEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+				tarpit:         expectTarpit(0),
+			},
+			args: args{
+				ctx:           ctx,
+				userID:        "user1",
+				code:          "code",
+				resourceOwner: "org1",
+				authRequest: &domain.AuthRequest{
+					ID:      "authRequestID",
+					AgentID: "userAgentID",
+					BrowserInfo: &domain.BrowserInfo{
+						UserAgent:      "user-agent",
+						AcceptLanguage: "en",
+						RemoteIP:       net.IP{192, 0, 2, 1},
+					},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
 		{
 			name: "code ok (external)",
 			fields: fields{
@@ -3039,6 +3125,7 @@ func TestCommandSide_HumanOTPEmailCodeSent(t *testing.T) {
 			ctx           context.Context
 			userID        string
 			resourceOwner string
+			generatorInfo *senders.CodeGeneratorInfo
 		}
 	)
 	type res struct {
@@ -3095,6 +3182,7 @@ func TestCommandSide_HumanOTPEmailCodeSent(t *testing.T) {
 					expectPush(
 						user.NewHumanOTPEmailCodeSentEvent(ctx,
 							&user.NewAggregate("user1", "org1").Aggregate,
+							nil,
 						),
 					),
 				),
@@ -3116,7 +3204,7 @@ func TestCommandSide_HumanOTPEmailCodeSent(t *testing.T) {
 			r := &Commands{
 				eventstore: tt.fields.eventstore(t),
 			}
-			err := r.HumanOTPEmailCodeSent(tt.args.ctx, tt.args.userID, tt.args.resourceOwner)
+			err := r.HumanOTPEmailCodeSent(tt.args.ctx, tt.args.userID, tt.args.resourceOwner, tt.args.generatorInfo)
 			assert.ErrorIs(t, err, tt.res.err)
 		})
 	}
@@ -3260,6 +3348,7 @@ func TestCommandSide_HumanCheckOTPEmail(t *testing.T) {
 							org.NewLockoutPolicyAddedEvent(ctx,
 								&org.NewAggregate("orgID").Aggregate,
 								3, 3, true,
+								0, 0,
 							),
 						),
 					),
@@ -3338,6 +3427,7 @@ func TestCommandSide_HumanCheckOTPEmail(t *testing.T) {
 							org.NewLockoutPolicyAddedEvent(ctx,
 								&org.NewAggregate("orgID").Aggregate,
 								1, 1, true,
+								0, 0,
 							),
 						),
 					),
@@ -3356,6 +3446,7 @@ func TestCommandSide_HumanCheckOTPEmail(t *testing.T) {
 						),
 						user.NewUserLockedEvent(ctx,
 							&user.NewAggregate("user1", "org1").Aggregate,
+							nil,
 						),
 					),
 				),
@@ -3488,6 +3579,7 @@ func TestCommandSide_HumanCheckOTPEmail(t *testing.T) {
 					expectFilter( // recheck
 						user.NewUserLockedEvent(ctx,
 							&user.NewAggregate("user1", "org1").Aggregate,
+							nil,
 						),
 					),
 				),
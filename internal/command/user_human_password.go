@@ -142,7 +142,9 @@ func (c *Commands) setPasswordWithVerifyCode(
 
 type HumanPasswordCheckWriteModel interface {
 	GetUserState() domain.UserState
+	GetLockedUntil() *time.Time
 	GetPasswordCheckFailedCount() uint64
+	GetPasswordCheckFailedAt() time.Time
 	GetEncodedHash() string
 	GetResourceOwner() string
 	GetWriteModel() *eventstore.WriteModel
@@ -279,6 +281,27 @@ func (c *Commands) checkPasswordComplexity(ctx context.Context, newPassword stri
 	if err := policy.Check(newPassword); err != nil {
 		return err
 	}
+	return c.checkBreachedPassword(ctx, newPassword, policy.CheckBreached)
+}
+
+// checkBreachedPassword checks the password against known breached passwords, if the policy requires it.
+// If the check itself fails (e.g. the range API is not reachable), the password is accepted
+// to not prevent users from setting a password at all.
+func (c *Commands) checkBreachedPassword(ctx context.Context, password string, checkBreached bool) (err error) {
+	if !checkBreached || c.breachedPasswordChecker == nil {
+		return nil
+	}
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	breached, checkErr := c.breachedPasswordChecker.IsBreached(ctx, password)
+	if checkErr != nil {
+		logging.WithError(checkErr).Warn("unable to check password against breached passwords")
+		return nil
+	}
+	if breached {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Brch3", "Errors.User.PasswordComplexityPolicy.Breached")
+	}
 	return nil
 }
 
@@ -413,22 +436,41 @@ func verifyPasswordWithLockoutPolicy(
 	if !wm.GetUserState().Exists() {
 		return nil, "", zerrors.ThrowPreconditionFailed(nil, "COMMAND-3n77z", "Errors.User.NotFound")
 	}
+	failedAttempts := wm.GetPasswordCheckFailedCount()
 	if wm.GetUserState() == domain.UserStateLocked {
-		wrongPasswordError := &commandErrors.WrongPasswordError{
-			FailedAttempts: int32(wm.GetPasswordCheckFailedCount()),
+		if !domain.IsLockExpired(wm.GetLockedUntil(), time.Now()) {
+			wrongPasswordError := &commandErrors.WrongPasswordError{
+				FailedAttempts: int32(failedAttempts),
+			}
+			return nil, "", zerrors.ThrowPreconditionFailed(wrongPasswordError, "COMMAND-JLK35", "Errors.User.Locked")
 		}
-		return nil, "", zerrors.ThrowPreconditionFailed(wrongPasswordError, "COMMAND-JLK35", "Errors.User.Locked")
+		// the lock expired, so the failed attempts are counted from the start again
+		failedAttempts = 0
 	}
 	if wm.GetEncodedHash() == "" {
 		return nil, "", zerrors.ThrowPreconditionFailed(nil, "COMMAND-3nJ4t", "Errors.User.Password.NotSet")
 	}
 
+	var lockoutPolicy *domain.LockoutPolicy
+	if failedAttempts > 0 {
+		var lockoutErr error
+		lockoutPolicy, lockoutErr = getLockoutPolicy(ctx, wm.GetResourceOwner(), es.FilterToQueryReducer)
+		logging.OnError(lockoutErr).Error("unable to get lockout policy")
+		// the password is not verified at all if the delay after the last failed attempt has not passed yet
+		if delay := lockoutPolicy.DelayAfterFailedAttempts(failedAttempts); delay > 0 && time.Now().Before(wm.GetPasswordCheckFailedAt().Add(delay)) {
+			wrongPasswordError := &commandErrors.WrongPasswordError{
+				FailedAttempts: int32(failedAttempts),
+			}
+			return nil, "", zerrors.ThrowPreconditionFailed(wrongPasswordError, "COMMAND-Dly5t", "Errors.User.Password.AttemptDelayed")
+		}
+	}
+
 	userAgg := UserAggregateFromWriteModel(wm.GetWriteModel())
 	ctx, spanPasswordComparison := tracing.NewNamedSpan(ctx, "passwap.Verify")
 	updated, err := verify(wm.GetEncodedHash(), password)
 	spanPasswordComparison.EndWithError(err)
-	err = convertLoginPasswapErr(wm.GetPasswordCheckFailedCount()+1, err)
-	commands := make([]eventstore.Command, 0, 2)
+	err = convertLoginPasswapErr(failedAttempts+1, err)
+	commands := make([]eventstore.Command, 0, 3)
 
 	// recheck for additional events (failed password checks or locks)
 	recheckErr := es.FilterToQueryReducer(ctx, wm)
@@ -436,10 +478,17 @@ func verifyPasswordWithLockoutPolicy(
 		return nil, "", recheckErr
 	}
 	if wm.GetUserState() == domain.UserStateLocked {
-		wrongPasswordError := &commandErrors.WrongPasswordError{
-			FailedAttempts: int32(wm.GetPasswordCheckFailedCount()),
+		if !domain.IsLockExpired(wm.GetLockedUntil(), time.Now()) {
+			wrongPasswordError := &commandErrors.WrongPasswordError{
+				FailedAttempts: int32(wm.GetPasswordCheckFailedCount()),
+			}
+			return nil, "", zerrors.ThrowPreconditionFailed(wrongPasswordError, "COMMAND-SFA3t", "Errors.User.Locked")
 		}
-		return nil, "", zerrors.ThrowPreconditionFailed(wrongPasswordError, "COMMAND-SFA3t", "Errors.User.Locked")
+		// the lockout duration passed, so the user is unlocked automatically
+		commands = append(commands, user.NewUserUnlockedEvent(ctx, userAgg))
+		failedAttempts = 0
+	} else {
+		failedAttempts = wm.GetPasswordCheckFailedCount()
 	}
 
 	if err == nil {
@@ -452,16 +501,19 @@ func verifyPasswordWithLockoutPolicy(
 
 	commands = append(commands, user.NewHumanPasswordCheckFailedEvent(ctx, userAgg, optionalAuthRequestInfo))
 
-	lockoutPolicy, lockoutErr := getLockoutPolicy(ctx, wm.GetResourceOwner(), es.FilterToQueryReducer)
-	logging.OnError(lockoutErr).Error("unable to get lockout policy")
-	if lockoutPolicy != nil && lockoutPolicy.MaxPasswordAttempts > 0 && wm.GetPasswordCheckFailedCount()+1 >= lockoutPolicy.MaxPasswordAttempts {
-		commands = append(commands, user.NewUserLockedEvent(ctx, userAgg))
+	if lockoutPolicy == nil {
+		var lockoutErr error
+		lockoutPolicy, lockoutErr = getLockoutPolicy(ctx, wm.GetResourceOwner(), es.FilterToQueryReducer)
+		logging.OnError(lockoutErr).Error("unable to get lockout policy")
+	}
+	if lockoutPolicy != nil && lockoutPolicy.MaxPasswordAttempts > 0 && failedAttempts+1 >= lockoutPolicy.MaxPasswordAttempts {
+		commands = append(commands, user.NewUserLockedEvent(ctx, userAgg, lockoutPolicy.LockedUntil(time.Now())))
 	}
 	// in case the login policy ignores unknown usernames,
 	// we do not slow down the response time with a tarpit
 	// since this would leak the user existence
 	if tarpit != nil {
-		tarpit(wm.GetPasswordCheckFailedCount() + 1)
+		tarpit(failedAttempts + 1)
 	}
 	return commands, "", err
 }
@@ -19,10 +19,12 @@ type HumanPasswordWriteModel struct {
 	CodeCreationDate         time.Time
 	CodeExpiry               time.Duration
 	PasswordCheckFailedCount uint64
+	PasswordCheckFailedAt    time.Time
 	GeneratorID              string
 	VerificationID           string
 
-	UserState domain.UserState
+	UserState   domain.UserState
+	LockedUntil *time.Time
 }
 
 func (wm *HumanPasswordWriteModel) GetUserState() domain.UserState {
@@ -33,6 +35,14 @@ func (wm *HumanPasswordWriteModel) GetPasswordCheckFailedCount() uint64 {
 	return wm.PasswordCheckFailedCount
 }
 
+func (wm *HumanPasswordWriteModel) GetPasswordCheckFailedAt() time.Time {
+	return wm.PasswordCheckFailedAt
+}
+
+func (wm *HumanPasswordWriteModel) GetLockedUntil() *time.Time {
+	return wm.LockedUntil
+}
+
 func (wm *HumanPasswordWriteModel) GetEncodedHash() string {
 	return wm.EncodedHash
 }
@@ -110,12 +120,15 @@ func (wm *HumanPasswordWriteModel) Reduce() error {
 			}
 		case *user.HumanPasswordCheckFailedEvent:
 			wm.PasswordCheckFailedCount += 1
+			wm.PasswordCheckFailedAt = e.CreationDate()
 		case *user.HumanPasswordCheckSucceededEvent:
 			wm.PasswordCheckFailedCount = 0
 		case *user.UserLockedEvent:
 			wm.UserState = domain.UserStateLocked
+			wm.LockedUntil = e.LockedUntil
 		case *user.UserUnlockedEvent:
 			wm.PasswordCheckFailedCount = 0
+			wm.LockedUntil = nil
 			if wm.UserState != domain.UserStateDeleted {
 				wm.UserState = domain.UserStateActive
 			}
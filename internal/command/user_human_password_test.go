@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/muhlemmer/gu"
 	"github.com/stretchr/testify/assert"
 	"github.com/zitadel/passwap"
 	"go.uber.org/mock/gomock"
@@ -150,6 +151,7 @@ func TestCommandSide_SetOneTimePassword(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -212,6 +214,7 @@ func TestCommandSide_SetOneTimePassword(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -463,6 +466,7 @@ func TestCommandSide_SetPasswordWithVerifyCode(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -540,6 +544,7 @@ func TestCommandSide_SetPasswordWithVerifyCode(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -618,6 +623,7 @@ func TestCommandSide_SetPasswordWithVerifyCode(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -701,6 +707,7 @@ func TestCommandSide_SetPasswordWithVerifyCode(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -936,6 +943,7 @@ func TestCommandSide_ChangePassword(t *testing.T) {
 							true,
 							true,
 							true,
+							false,
 						),
 					),
 				),
@@ -993,6 +1001,7 @@ func TestCommandSide_ChangePassword(t *testing.T) {
 							0,
 							0,
 							false,
+							0, 0,
 						),
 					),
 				),
@@ -1056,6 +1065,7 @@ func TestCommandSide_ChangePassword(t *testing.T) {
 							1,
 							0,
 							false,
+							0, 0,
 						),
 					),
 				),
@@ -1066,6 +1076,7 @@ func TestCommandSide_ChangePassword(t *testing.T) {
 					),
 					user.NewUserLockedEvent(context.Background(),
 						&user.NewAggregate("user1", "org1").Aggregate,
+						nil,
 					),
 				),
 			},
@@ -1124,6 +1135,7 @@ func TestCommandSide_ChangePassword(t *testing.T) {
 							false,
 							false,
 							false,
+							false,
 						),
 					),
 				),
@@ -1194,6 +1206,7 @@ func TestCommandSide_ChangePassword(t *testing.T) {
 							false,
 							false,
 							false,
+							false,
 						),
 					),
 				),
@@ -1265,6 +1278,7 @@ func TestCommandSide_ChangePassword(t *testing.T) {
 							false,
 							false,
 							false,
+							false,
 						),
 					),
 				),
@@ -1898,6 +1912,7 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 						eventFromEventPusher(
 							user.NewUserLockedEvent(context.Background(),
 								&user.NewAggregate("user1", "org1").Aggregate,
+								nil,
 							),
 						),
 					),
@@ -2034,6 +2049,7 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 							org.NewLockoutPolicyAddedEvent(context.Background(),
 								&org.NewAggregate("org1").Aggregate,
 								0, 0, false,
+								0, 0,
 							)),
 					),
 					expectPush(
@@ -2124,6 +2140,7 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 							org.NewLockoutPolicyAddedEvent(context.Background(),
 								&org.NewAggregate("org1").Aggregate,
 								0, 0, false,
+								0, 0,
 							)),
 					),
 					expectPush(
@@ -2215,6 +2232,7 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 							org.NewLockoutPolicyAddedEvent(context.Background(),
 								&org.NewAggregate("org1").Aggregate,
 								1, 1, false,
+								0, 0,
 							)),
 					),
 					expectPush(
@@ -2227,6 +2245,7 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 						),
 						user.NewUserLockedEvent(context.Background(),
 							&user.NewAggregate("user1", "org1").Aggregate,
+							nil,
 						),
 					),
 				),
@@ -2473,6 +2492,7 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 						eventFromEventPusher(
 							user.NewUserLockedEvent(context.Background(),
 								&user.NewAggregate("user1", "org1").Aggregate,
+								nil,
 							),
 						),
 					),
@@ -2494,6 +2514,183 @@ func TestCommandSide_CheckPassword(t *testing.T) {
 				err: zerrors.IsPreconditionFailed,
 			},
 		},
+		{
+			name: "check password ok, lock expired - user unlocked",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewLoginPolicyAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								true,
+								false,
+								false,
+								false,
+								false,
+								false,
+								false,
+								false,
+								false,
+								false,
+								domain.PasswordlessTypeNotAllowed,
+								"",
+								time.Hour*1,
+								time.Hour*2,
+								time.Hour*3,
+								time.Hour*4,
+								time.Hour*5,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanEmailVerifiedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanPasswordChangedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"$plain$x$password",
+								false,
+								"")),
+						eventFromEventPusher(
+							user.NewUserLockedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								gu.Ptr(time.Now().Add(-time.Minute)),
+							),
+						),
+					),
+					expectFilter(),
+					expectPush(
+						user.NewUserUnlockedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+						),
+						user.NewHumanPasswordCheckSucceededEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							&user.AuthRequestInfo{
+								ID:          "request1",
+								UserAgentID: "agent1",
+							},
+						),
+					),
+				),
+				userPasswordHasher: mockPasswordHasher("x"),
+				tarpit:             expectTarpit(0),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				password:      "password",
+				authReq: &domain.AuthRequest{
+					ID:      "request1",
+					AgentID: "agent1",
+				},
+			},
+			res: res{},
+		},
+		{
+			name: "password not matching, failed attempt delay not passed, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewLoginPolicyAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								true,
+								false,
+								false,
+								false,
+								false,
+								false,
+								false,
+								false,
+								false,
+								false,
+								domain.PasswordlessTypeNotAllowed,
+								"",
+								time.Hour*1,
+								time.Hour*2,
+								time.Hour*3,
+								time.Hour*4,
+								time.Hour*5,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanEmailVerifiedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanPasswordChangedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"$plain$x$password",
+								false,
+								"")),
+						eventFromEventPusherWithCreationDateNow(
+							user.NewHumanPasswordCheckFailedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								nil,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewLockoutPolicyAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								5, 5, false,
+								0, time.Minute,
+							)),
+					),
+				),
+				userPasswordHasher: mockPasswordHasher("x"),
+				tarpit:             expectTarpit(0),
+			},
+			args: args{
+				ctx:           context.Background(),
+				userID:        "user1",
+				resourceOwner: "org1",
+				password:      "password1",
+				authReq: &domain.AuthRequest{
+					ID:      "request1",
+					AgentID: "agent1",
+				},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
 		{
 			name: "regression test old version event",
 			fields: fields{
@@ -2646,3 +2843,88 @@ func Test_convertPasswapErr(t *testing.T) {
 		})
 	}
 }
+
+type breachedPasswordCheckerFunc func(ctx context.Context, password string) (bool, error)
+
+func (f breachedPasswordCheckerFunc) IsBreached(ctx context.Context, password string) (bool, error) {
+	return f(ctx, password)
+}
+
+func TestCommands_checkBreachedPassword(t *testing.T) {
+	breachedChecker := breachedPasswordCheckerFunc(func(_ context.Context, password string) (bool, error) {
+		return password == "password", nil
+	})
+	type fields struct {
+		breachedPasswordChecker BreachedPasswordChecker
+	}
+	type args struct {
+		password      string
+		checkBreached bool
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "check disabled",
+			fields: fields{
+				breachedPasswordChecker: breachedChecker,
+			},
+			args: args{
+				password:      "password",
+				checkBreached: false,
+			},
+		},
+		{
+			name: "no checker configured",
+			args: args{
+				password:      "password",
+				checkBreached: true,
+			},
+		},
+		{
+			name: "not breached",
+			fields: fields{
+				breachedPasswordChecker: breachedChecker,
+			},
+			args: args{
+				password:      "Password1!",
+				checkBreached: true,
+			},
+		},
+		{
+			name: "breached, invalid argument error",
+			fields: fields{
+				breachedPasswordChecker: breachedChecker,
+			},
+			args: args{
+				password:      "password",
+				checkBreached: true,
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Brch3", "Errors.User.PasswordComplexityPolicy.Breached"),
+		},
+		{
+			name: "check failed, ignored",
+			fields: fields{
+				breachedPasswordChecker: breachedPasswordCheckerFunc(func(context.Context, string) (bool, error) {
+					return false, io.ErrUnexpectedEOF
+				}),
+			},
+			args: args{
+				password:      "password",
+				checkBreached: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				breachedPasswordChecker: tt.fields.breachedPasswordChecker,
+			}
+			err := c.checkBreachedPassword(context.Background(), tt.args.password, tt.args.checkBreached)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
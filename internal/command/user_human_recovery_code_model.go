@@ -2,6 +2,7 @@ package command
 
 import (
 	"slices"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
@@ -15,6 +16,7 @@ type HumanRecoveryCodeWriteModel struct {
 	FailedAttempts uint64
 	codes          []string
 	userLocked     bool
+	lockedUntil    *time.Time
 }
 
 func (wm *HumanRecoveryCodeWriteModel) Codes() []string {
@@ -28,6 +30,10 @@ func (wm *HumanRecoveryCodeWriteModel) UserLocked() bool {
 	return wm.userLocked
 }
 
+func (wm *HumanRecoveryCodeWriteModel) LockedUntil() *time.Time {
+	return wm.lockedUntil
+}
+
 func NewHumanRecoveryCodeWriteModel(userID, resourceOwner string) *HumanRecoveryCodeWriteModel {
 	return &HumanRecoveryCodeWriteModel{
 		WriteModel: eventstore.WriteModel{
@@ -59,18 +65,22 @@ func (wm *HumanRecoveryCodeWriteModel) Reduce() error {
 			wm.State = domain.MFAStateRemoved
 		case *user.UserLockedEvent:
 			wm.userLocked = true
+			wm.lockedUntil = e.LockedUntil
 		case *user.UserUnlockedEvent:
 			wm.userLocked = false
+			wm.lockedUntil = nil
 			wm.FailedAttempts = 0
 		case *user.UserRemovedEvent:
 			wm.FailedAttempts = 0
 			wm.codes = nil
 			wm.userLocked = false
+			wm.lockedUntil = nil
 			wm.State = domain.MFAStateRemoved
 		case *user.HumanAddedEvent, *user.HumanRegisteredEvent, *user.MachineAddedEvent:
 			wm.FailedAttempts = 0
 			wm.codes = nil
 			wm.userLocked = false
+			wm.lockedUntil = nil
 			wm.State = domain.MFAStateUnspecified
 		}
 	}
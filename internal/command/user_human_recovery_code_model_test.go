@@ -238,6 +238,7 @@ func TestHumanRecoveryCodeWriteModel_Reduce(t *testing.T) {
 				user.NewUserLockedEvent(
 					ctx,
 					&userAgg.Aggregate,
+					nil,
 				),
 			},
 			want: &HumanRecoveryCodeWriteModel{
@@ -268,6 +269,7 @@ func TestHumanRecoveryCodeWriteModel_Reduce(t *testing.T) {
 				user.NewUserLockedEvent(
 					ctx,
 					&userAgg.Aggregate,
+					nil,
 				),
 				user.NewUserUnlockedEvent(
 					ctx,
@@ -341,6 +343,7 @@ func TestHumanRecoveryCodeWriteModel_Reduce(t *testing.T) {
 				user.NewUserLockedEvent(
 					ctx,
 					&userAgg.Aggregate,
+					nil,
 				),
 				user.NewUserUnlockedEvent(
 					ctx,
@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"time"
 
 	"github.com/zitadel/logging"
 
@@ -112,7 +113,7 @@ func (c *Commands) RemoveRecoveryCodes(ctx context.Context, userID, resourceOwne
 		return nil, err
 	}
 
-	if writeModel.UserLocked() {
+	if writeModel.UserLocked() && !domain.IsLockExpired(writeModel.LockedUntil(), time.Now()) {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-d9u8q", "Errors.User.Locked")
 	}
 
@@ -161,7 +162,7 @@ func checkRecoveryCode(
 		return nil, err
 	}
 
-	if recoveryCodeWm.UserLocked() {
+	if recoveryCodeWm.UserLocked() && !domain.IsLockExpired(recoveryCodeWm.LockedUntil(), time.Now()) {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-2w6oa", "Errors.User.Locked")
 	}
 
@@ -176,13 +177,18 @@ func checkRecoveryCode(
 	if recheckErr != nil {
 		return nil, recheckErr
 	}
+	userAgg := UserAggregateFromWriteModelCtx(ctx, &recoveryCodeWm.WriteModel)
+	commands := make([]eventstore.Command, 0, 3)
+	failedAttempts := recoveryCodeWm.FailedAttempts
 	if recoveryCodeWm.UserLocked() {
-		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-ASV12", "Errors.User.Locked")
+		if !domain.IsLockExpired(recoveryCodeWm.LockedUntil(), time.Now()) {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-ASV12", "Errors.User.Locked")
+		}
+		// the lockout duration passed, so the user is unlocked automatically
+		commands = append(commands, user.NewUserUnlockedEvent(ctx, userAgg))
+		failedAttempts = 0
 	}
 
-	userAgg := UserAggregateFromWriteModelCtx(ctx, &recoveryCodeWm.WriteModel)
-	commands := make([]eventstore.Command, 0, 2)
-
 	authRequestInfo := authRequestDomainToAuthRequestInfo(authRequest)
 
 	if err == nil {
@@ -193,8 +199,8 @@ func checkRecoveryCode(
 	lockoutPolicy, lockoutErr := getLockoutPolicy(ctx, recoveryCodeWm.ResourceOwner, queryReducer)
 	logging.OnError(lockoutErr).Error("failed to get lockout policy")
 
-	if lockoutPolicy != nil && lockoutPolicy.MaxOTPAttempts > 0 && recoveryCodeWm.FailedAttempts+1 >= lockoutPolicy.MaxOTPAttempts {
-		commands = append(commands, user.NewUserLockedEvent(ctx, userAgg))
+	if lockoutPolicy != nil && lockoutPolicy.MaxOTPAttempts > 0 && failedAttempts+1 >= lockoutPolicy.MaxOTPAttempts {
+		commands = append(commands, user.NewUserLockedEvent(ctx, userAgg, lockoutPolicy.LockedUntil(time.Now())))
 	}
 
 	return commands, err
@@ -5,7 +5,9 @@ import (
 	"io"
 	"net"
 	"testing"
+	"time"
 
+	"github.com/muhlemmer/gu"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/text/language"
@@ -475,6 +477,7 @@ func TestCommands_RemoveRecoveryCodes(t *testing.T) {
 						eventFromEventPusher(
 							user.NewUserLockedEvent(ctx,
 								&user.NewAggregate("user1", "org1").Aggregate,
+								nil,
 							),
 						),
 					),
@@ -690,6 +693,7 @@ func TestCommands_HumanCheckRecoveryCode(t *testing.T) {
 						eventFromEventPusher(
 							user.NewUserLockedEvent(ctx,
 								&user.NewAggregate("user1", "org1").Aggregate,
+								nil,
 							),
 						),
 					),
@@ -703,6 +707,45 @@ func TestCommands_HumanCheckRecoveryCode(t *testing.T) {
 			},
 			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-2w6oa", "Errors.User.Locked"),
 		},
+		{
+			name: "valid code, lock expired, user unlocked",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanRecoveryCodesAddedEvent(ctx,
+								&user.NewAggregate("user1", "org1").Aggregate,
+								[]string{"$plain$$validcode", "$plain$$validcode2"},
+								nil,
+							),
+						),
+						eventFromEventPusher(
+							user.NewUserLockedEvent(ctx,
+								&user.NewAggregate("user1", "org1").Aggregate,
+								gu.Ptr(time.Now().Add(-time.Minute)),
+							),
+						),
+					),
+					expectFilter(), // additional lock check
+					expectPush(
+						user.NewUserUnlockedEvent(ctx,
+							&user.NewAggregate("user1", "org1").Aggregate,
+						),
+						user.NewHumanRecoveryCodeCheckSucceededEvent(ctx,
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"$plain$$validcode",
+							nil,
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           ctx,
+				userID:        "user1",
+				code:          "validcode",
+				resourceOwner: "org1",
+			},
+		},
 		{
 			name: "recovery codes not ready, error",
 			fields: fields{
@@ -812,6 +855,7 @@ func TestCommands_HumanCheckRecoveryCode(t *testing.T) {
 						eventFromEventPusher(
 							user.NewUserLockedEvent(context.Background(),
 								&user.NewAggregate("user1", "org1").Aggregate,
+								nil,
 							),
 						),
 					),
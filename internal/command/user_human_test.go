@@ -533,6 +533,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -605,6 +606,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -680,6 +682,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -756,6 +759,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -818,6 +822,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -883,6 +888,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -948,6 +954,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -1074,6 +1081,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -1155,6 +1163,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -1333,6 +1342,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -1678,6 +1688,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -1724,6 +1735,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -1811,6 +1823,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -1898,6 +1911,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -1979,6 +1993,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -2079,6 +2094,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -2183,6 +2199,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -2319,6 +2336,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -2415,6 +2433,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -2501,6 +2520,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -2588,6 +2608,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -2734,6 +2755,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -2878,6 +2900,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -3051,6 +3074,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -3224,6 +3248,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -3404,6 +3429,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 										false,
 										false,
 										false,
+										false,
 									),
 								),
 							),
@@ -3503,6 +3529,7 @@ func TestCommandSide_ImportHuman(t *testing.T) {
 									&user.NewAggregate("user1", "org1").Aggregate),
 								user.NewUserLockedEvent(context.Background(),
 									&user.NewAggregate("user1", "org1").Aggregate,
+									nil,
 								),
 							),
 						),
@@ -4057,6 +4084,7 @@ func TestAddHumanCommand(t *testing.T) {
 									true,
 									true,
 									true,
+									false,
 								),
 							}, nil
 						}).
@@ -4114,6 +4142,7 @@ func TestAddHumanCommand(t *testing.T) {
 									false,
 									false,
 									false,
+									false,
 								),
 							}, nil
 						}).
@@ -4191,6 +4220,7 @@ func TestAddHumanCommand(t *testing.T) {
 									false,
 									false,
 									false,
+									false,
 								),
 							}, nil
 						}).
@@ -4266,6 +4296,7 @@ func TestAddHumanCommand(t *testing.T) {
 									false,
 									false,
 									false,
+									false,
 								),
 							}, nil
 						}).
@@ -4342,6 +4373,7 @@ func TestAddHumanCommand(t *testing.T) {
 									false,
 									false,
 									false,
+									false,
 								),
 							}, nil
 						}).
@@ -4418,6 +4450,7 @@ func TestAddHumanCommand(t *testing.T) {
 									false,
 									false,
 									false,
+									false,
 								),
 							}, nil
 						}).
@@ -129,7 +129,7 @@ func (c *Commands) AddMachine(ctx context.Context, machine *Machine, state *doma
 		case domain.UserStateInactive:
 			cmd = user.NewUserDeactivatedEvent(ctx, &agg.Aggregate)
 		case domain.UserStateLocked:
-			cmd = user.NewUserLockedEvent(ctx, &agg.Aggregate)
+			cmd = user.NewUserLockedEvent(ctx, &agg.Aggregate, nil)
 		case domain.UserStateDeleted:
 		// users are never imported if deleted
 		case domain.UserStateActive:
@@ -682,6 +682,7 @@ func TestCommandSide_AddMachine(t *testing.T) {
 						),
 						user.NewUserLockedEvent(context.Background(),
 							&user.NewAggregate("user1", "org1").Aggregate,
+							nil,
 						),
 					),
 				),
@@ -930,6 +930,7 @@ func TestCommandSide_LockUser(t *testing.T) {
 						eventFromEventPusher(
 							user.NewUserLockedEvent(context.Background(),
 								&user.NewAggregate("user1", "org1").Aggregate,
+								nil,
 							),
 						),
 					),
@@ -967,6 +968,7 @@ func TestCommandSide_LockUser(t *testing.T) {
 					expectPush(
 						user.NewUserLockedEvent(context.Background(),
 							&user.NewAggregate("user1", "org1").Aggregate,
+							nil,
 						),
 					),
 				),
@@ -1105,7 +1107,7 @@ func TestCommandSide_UnlockUser(t *testing.T) {
 						),
 						eventFromEventPusher(
 							user.NewUserLockedEvent(context.Background(),
-								&user.NewAggregate("user1", "org1").Aggregate),
+								&user.NewAggregate("user1", "org1").Aggregate, nil),
 						),
 					),
 					expectPush(
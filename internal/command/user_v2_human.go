@@ -212,7 +212,7 @@ func (c *Commands) AddUserHuman(ctx context.Context, organizationID string, huma
 
 	// separated to change when old user logic is not used anymore
 	filter := c.eventstore.Filter //nolint:staticcheck
-	if err := addHumanCommandPassword(ctx, filter, createCmd, human, c.userPasswordHasher); err != nil {
+	if err := c.addHumanCommandPassword(ctx, filter, createCmd, human, c.userPasswordHasher); err != nil {
 		return err
 	}
 
@@ -498,6 +498,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -642,6 +643,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -723,6 +725,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -805,6 +808,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -876,6 +880,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -947,6 +952,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -1085,6 +1091,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -1172,6 +1179,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -1289,6 +1297,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -1474,6 +1483,7 @@ func TestCommandSide_AddUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -3553,6 +3563,7 @@ func TestCommandSide_ChangeUserHuman(t *testing.T) {
 								true,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -3663,6 +3674,7 @@ func TestCommandSide_ChangeUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -3722,6 +3734,7 @@ func TestCommandSide_ChangeUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -3780,6 +3793,7 @@ func TestCommandSide_ChangeUserHuman(t *testing.T) {
 								0,
 								0,
 								false,
+								0, 0,
 							),
 						),
 					),
@@ -3851,6 +3865,7 @@ func TestCommandSide_ChangeUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -3981,6 +3996,7 @@ func TestCommandSide_ChangeUserHuman(t *testing.T) {
 								true,
 								true,
 								true,
+								false,
 							),
 						),
 					),
@@ -4109,6 +4125,7 @@ func TestCommandSide_ChangeUserHuman(t *testing.T) {
 								false,
 								false,
 								false,
+								false,
 							),
 						),
 					),
@@ -15,6 +15,7 @@ import (
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/repository/org"
 	"github.com/zitadel/zitadel/internal/repository/user"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -1262,9 +1263,10 @@ func TestCommands_InviteCodeSent(t *testing.T) {
 		eventstore func(*testing.T) *eventstore.Eventstore
 	}
 	type args struct {
-		ctx    context.Context
-		userID string
-		orgID  string
+		ctx           context.Context
+		userID        string
+		orgID         string
+		generatorInfo *senders.CodeGeneratorInfo
 	}
 	tests := []struct {
 		name    string
@@ -1362,6 +1364,7 @@ func TestCommands_InviteCodeSent(t *testing.T) {
 						eventFromEventPusher(
 							user.NewHumanInviteCodeSentEvent(context.Background(),
 								&user.NewAggregate("userID", "org1").Aggregate,
+								nil,
 							),
 						),
 					),
@@ -1380,7 +1383,7 @@ func TestCommands_InviteCodeSent(t *testing.T) {
 			c := &Commands{
 				eventstore: tt.fields.eventstore(t),
 			}
-			err := c.InviteCodeSent(tt.args.ctx, tt.args.userID, tt.args.orgID)
+			err := c.InviteCodeSent(tt.args.ctx, tt.args.userID, tt.args.orgID, tt.args.generatorInfo)
 			assert.ErrorIs(t, err, tt.wantErr)
 		})
 	}
@@ -1697,6 +1700,7 @@ func TestCommands_VerifyInviteCodeSetPassword(t *testing.T) {
 								true,
 								true,
 								true,
+								false,
 							),
 						),
 					),
@@ -1782,6 +1786,7 @@ func TestCommands_VerifyInviteCodeSetPassword(t *testing.T) {
 								true,
 								true,
 								true,
+								false,
 							),
 						),
 					),
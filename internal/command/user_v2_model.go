@@ -51,6 +51,7 @@ type UserV2WriteModel struct {
 	PasswordCodeCreationDate   time.Time
 	PasswordCodeExpiry         time.Duration
 	PasswordCheckFailedCount   uint64
+	PasswordCheckFailedAt      time.Time
 	PasswordCodeGeneratorID    string
 	PasswordCodeVerificationID string
 
@@ -72,6 +73,7 @@ type UserV2WriteModel struct {
 
 	StateWriteModel bool
 	UserState       domain.UserState
+	LockedUntil     *time.Time
 
 	IDPLinkWriteModel bool
 	IDPLinks          []*domain.UserIDPLink
@@ -88,6 +90,14 @@ func (wm *UserV2WriteModel) GetPasswordCheckFailedCount() uint64 {
 	return wm.PasswordCheckFailedCount
 }
 
+func (wm *UserV2WriteModel) GetPasswordCheckFailedAt() time.Time {
+	return wm.PasswordCheckFailedAt
+}
+
+func (wm *UserV2WriteModel) GetLockedUntil() *time.Time {
+	return wm.LockedUntil
+}
+
 func (wm *UserV2WriteModel) GetEncodedHash() string {
 	return wm.PasswordEncodedHash
 }
@@ -294,8 +304,10 @@ func (wm *UserV2WriteModel) Reduce() error {
 
 		case *user.UserLockedEvent:
 			wm.UserState = domain.UserStateLocked
+			wm.LockedUntil = e.LockedUntil
 		case *user.UserUnlockedEvent:
 			wm.PasswordCheckFailedCount = 0
+			wm.LockedUntil = nil
 			wm.UserState = domain.UserStateActive
 
 		case *user.UserDeactivatedEvent:
@@ -311,6 +323,7 @@ func (wm *UserV2WriteModel) Reduce() error {
 			wm.PasswordEncodedHash = e.EncodedHash
 		case *user.HumanPasswordCheckFailedEvent:
 			wm.PasswordCheckFailedCount += 1
+			wm.PasswordCheckFailedAt = e.CreationDate()
 		case *user.HumanPasswordCheckSucceededEvent:
 			wm.PasswordCheckFailedCount = 0
 		case *user.HumanPasswordChangedEvent:
@@ -656,6 +669,7 @@ func (wm *UserV2WriteModel) reset() {
 	wm.PasswordCodeCreationDate = time.Time{}
 	wm.PasswordCodeExpiry = 0
 	wm.PasswordCheckFailedCount = 0
+	wm.PasswordCheckFailedAt = time.Time{}
 	wm.PasswordCodeGeneratorID = ""
 	wm.PasswordCodeVerificationID = ""
 	wm.Email = ""
@@ -1791,6 +1791,7 @@ func TestCommandSide_userStateWriteModel(t *testing.T) {
 						eventFromEventPusher(
 							user.NewUserLockedEvent(context.Background(),
 								&userAgg.Aggregate,
+								nil,
 							),
 						),
 					),
@@ -1854,6 +1855,7 @@ func TestCommandSide_userStateWriteModel(t *testing.T) {
 						eventFromEventPusher(
 							user.NewUserLockedEvent(context.Background(),
 								&userAgg.Aggregate,
+								nil,
 							),
 						),
 						eventFromEventPusher(
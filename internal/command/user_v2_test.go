@@ -96,6 +96,7 @@ func TestCommandSide_LockUserV2(t *testing.T) {
 						eventFromEventPusher(
 							user.NewUserLockedEvent(context.Background(),
 								userAgg,
+								nil,
 							),
 						),
 					),
@@ -130,6 +131,7 @@ func TestCommandSide_LockUserV2(t *testing.T) {
 						eventFromEventPusher(
 							user.NewUserLockedEvent(context.Background(),
 								userAgg,
+								nil,
 							),
 						),
 					),
@@ -169,6 +171,7 @@ func TestCommandSide_LockUserV2(t *testing.T) {
 					expectPush(
 						user.NewUserLockedEvent(context.Background(),
 							userAgg,
+							nil,
 						),
 					),
 				),
@@ -236,6 +239,7 @@ func TestCommandSide_LockUserV2(t *testing.T) {
 					expectPush(
 						user.NewUserLockedEvent(context.Background(),
 							userAgg,
+							nil,
 						),
 					),
 				),
@@ -409,7 +413,7 @@ func TestCommandSide_UnlockUserV2(t *testing.T) {
 						),
 						eventFromEventPusher(
 							user.NewUserLockedEvent(context.Background(),
-								userAgg),
+								userAgg, nil),
 						),
 					),
 					expectPush(
@@ -451,7 +455,7 @@ func TestCommandSide_UnlockUserV2(t *testing.T) {
 						),
 						eventFromEventPusher(
 							user.NewUserLockedEvent(context.Background(),
-								userAgg),
+								userAgg, nil),
 						),
 					),
 				),
@@ -484,7 +488,7 @@ func TestCommandSide_UnlockUserV2(t *testing.T) {
 						),
 						eventFromEventPusher(
 							user.NewUserLockedEvent(context.Background(),
-								userAgg),
+								userAgg, nil),
 						),
 					),
 					expectPush(
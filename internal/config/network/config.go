@@ -25,6 +25,10 @@ type TLS struct {
 	Key []byte
 	//Certificate for the TLS connection (CertPath will this overwrite, if specified)
 	Cert []byte
+	//If enabled, clients are asked for a certificate during the handshake,
+	//which is used for mutual-TLS client authentication (RFC 8705).
+	//The certificate is optional and verified on the OIDC endpoints only.
+	RequestClientCertificate bool
 }
 
 func (t *TLS) Config() (_ *tls.Config, err error) {
@@ -50,7 +54,11 @@ func (t *TLS) Config() (_ *tls.Config, err error) {
 	if err != nil {
 		return nil, err
 	}
-	return &tls.Config{
+	config := &tls.Config{
 		Certificates: []tls.Certificate{tlsCert},
-	}, nil
+	}
+	if t.RequestClientCertificate {
+		config.ClientAuth = tls.RequestClientCert
+	}
+	return config, nil
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/zitadel/zitadel/internal/breachedpassword"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 )
@@ -14,6 +15,7 @@ type SystemDefaults struct {
 	SecretHasher         crypto.HashConfig
 	Multifactors         MultifactorConfig
 	Tarpit               TarpitConfig
+	BreachedPasswords    breachedpassword.Config
 	DomainVerification   DomainVerification
 	Notifications        Notifications
 	KeyConfig            KeyConfig
@@ -133,6 +133,26 @@ func DecryptString(value *CryptoValue, alg EncryptionAlgorithm) (string, error)
 	return alg.DecryptString(value.Crypted, value.KeyID)
 }
 
+// ReEncrypt encrypts the value with the current encryption key of the algorithm,
+// so the key it was encrypted with can be retired.
+// If the value is nil or already encrypted with the current key, nil is returned.
+func ReEncrypt(value *CryptoValue, alg EncryptionAlgorithm) (*CryptoValue, error) {
+	if value == nil || value.CryptoType != TypeEncryption {
+		return nil, nil
+	}
+	if alg == nil {
+		return nil, zerrors.ThrowInvalidArgument(nil, "CRYPT-Rf3kq", "input encryption algorithm cannot be nil")
+	}
+	if value.Algorithm == alg.Algorithm() && value.KeyID == alg.EncryptionKeyID() {
+		return nil, nil
+	}
+	decrypted, err := Decrypt(value, alg)
+	if err != nil {
+		return nil, err
+	}
+	return Encrypt(decrypted, alg)
+}
+
 func checkEncryptionAlgorithm(value *CryptoValue, alg EncryptionAlgorithm) error {
 	if value == nil {
 		return zerrors.ThrowInvalidArgument(nil, "CRYPT-mNsQwe", "input value cannot be nil")
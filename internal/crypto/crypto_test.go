@@ -224,3 +224,76 @@ func TestDecryptString(t *testing.T) {
 		})
 	}
 }
+
+// mockRotatedEncCrypto encrypts with a new key, but is still able to decrypt with the old one.
+type mockRotatedEncCrypto struct {
+	mockEncCrypto
+}
+
+func (m *mockRotatedEncCrypto) EncryptionKeyID() string {
+	return "keyID2"
+}
+
+func (m *mockRotatedEncCrypto) DecryptionKeyIDs() []string {
+	return []string{"keyID2", "keyID"}
+}
+
+func TestReEncrypt(t *testing.T) {
+	type args struct {
+		value *CryptoValue
+		c     EncryptionAlgorithm
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *CryptoValue
+		wantErr bool
+	}{
+		{
+			name:    "re-encrypted with current key",
+			args:    args{&CryptoValue{CryptoType: TypeEncryption, Algorithm: "enc", KeyID: "keyID", Crypted: []byte("test")}, &mockRotatedEncCrypto{}},
+			want:    &CryptoValue{CryptoType: TypeEncryption, Algorithm: "enc", KeyID: "keyID2", Crypted: []byte("test")},
+			wantErr: false,
+		},
+		{
+			name:    "already encrypted with current key",
+			args:    args{&CryptoValue{CryptoType: TypeEncryption, Algorithm: "enc", KeyID: "keyID2", Crypted: []byte("test")}, &mockRotatedEncCrypto{}},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:    "key not available for decryption",
+			args:    args{&CryptoValue{CryptoType: TypeEncryption, Algorithm: "enc", KeyID: "keyID3", Crypted: []byte("test")}, &mockRotatedEncCrypto{}},
+			wantErr: true,
+		},
+		{
+			name:    "when encryption algorithm is nil should return error",
+			args:    args{&CryptoValue{CryptoType: TypeEncryption, Algorithm: "enc", KeyID: "keyID", Crypted: []byte("test")}, nil},
+			wantErr: true,
+		},
+		{
+			name:    "when crypto value is nil nothing is re-encrypted",
+			args:    args{nil, &mockRotatedEncCrypto{}},
+			want:    nil,
+			wantErr: false,
+		},
+		{
+			name:    "hashed values are not re-encrypted",
+			args:    args{&CryptoValue{CryptoType: TypeHash, Algorithm: "hash", Crypted: []byte("test")}, &mockRotatedEncCrypto{}},
+			want:    nil,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReEncrypt(tt.args.value, tt.args.c)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ReEncrypt() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReEncrypt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
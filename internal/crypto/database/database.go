@@ -129,6 +129,88 @@ func (d *Database) CreateKeys(ctx context.Context, keys ...*crypto.Key) error {
 	return nil
 }
 
+// RotateMasterKey re-encrypts all keys with the new master key.
+// The keys are locked during the rotation, so no key can be added or changed concurrently.
+// After the rotation the storage uses the new master key.
+func (d *Database) RotateMasterKey(ctx context.Context, newMasterKey string) (err error) {
+	if err := checkMasterKeyLength(newMasterKey); err != nil {
+		return err
+	}
+	stmt, args, err := sq.Select(encryptionKeysIDCol, encryptionKeysKeyCol).
+		From(EncryptionKeysTable).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return zerrors.ThrowInternal(err, "", "unable to rotate master key")
+	}
+	tx, err := d.client.BeginTx(ctx, nil)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "", "unable to rotate master key")
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+	rows, err := tx.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "", "unable to read keys")
+	}
+	keys := make(map[string]string)
+	for rows.Next() {
+		var id, encryptionKey string
+		if err = rows.Scan(&id, &encryptionKey); err != nil {
+			rows.Close()
+			return zerrors.ThrowInternal(err, "", "unable to read keys")
+		}
+		keys[id] = encryptionKey
+	}
+	if err = rows.Close(); err != nil {
+		return zerrors.ThrowInternal(err, "", "unable to read keys")
+	}
+	for id, encryptionKey := range keys {
+		key, err := d.decrypt(encryptionKey, d.masterKey)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "", "unable to decrypt key")
+		}
+		encryptionKey, err = d.encrypt(key, newMasterKey)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "", "unable to encrypt key")
+		}
+		stmt, args, err := sq.Update(EncryptionKeysTable).
+			Set(encryptionKeysKeyCol, encryptionKey).
+			Where(sq.Eq{encryptionKeysIDCol: id}).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return zerrors.ThrowInternal(err, "", "unable to update key")
+		}
+		if _, err = tx.ExecContext(ctx, stmt, args...); err != nil {
+			return zerrors.ThrowInternal(err, "", "unable to update key")
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return zerrors.ThrowInternal(err, "", "unable to rotate master key")
+	}
+	d.masterKey = newMasterKey
+	return nil
+}
+
+// DeleteKeys removes the keys, values encrypted with them can't be decrypted anymore.
+func (d *Database) DeleteKeys(ctx context.Context, ids ...string) error {
+	stmt, args, err := sq.Delete(EncryptionKeysTable).
+		Where(sq.Eq{encryptionKeysIDCol: ids}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return zerrors.ThrowInternal(err, "", "unable to delete keys")
+	}
+	if _, err = d.client.ExecContext(ctx, stmt, args...); err != nil {
+		return zerrors.ThrowInternal(err, "", "unable to delete keys")
+	}
+	return nil
+}
+
 func checkMasterKeyLength(masterKey string) error {
 	if length := len([]byte(masterKey)); length != 32 {
 		return zerrors.ThrowInternalf(nil, "", "masterkey must be 32 bytes, but is %d", length)
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -410,6 +411,241 @@ func Test_database_CreateKeys(t *testing.T) {
 	}
 }
 
+func Test_database_RotateMasterKey(t *testing.T) {
+	type fields struct {
+		client    db
+		masterKey string
+		encrypt   func(key, masterKey string) (encryptedKey string, err error)
+		decrypt   func(encryptedKey, masterKey string) (key string, err error)
+	}
+	type args struct {
+		newMasterKey string
+	}
+	type res struct {
+		masterKey string
+		err       func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"invalid master key length, error",
+			fields{
+				client:    dbMock(t),
+				masterKey: "masterkey",
+			},
+			args{
+				newMasterKey: "short",
+			},
+			res{
+				masterKey: "masterkey",
+				err:       zerrors.IsInternal,
+			},
+		},
+		{
+			"query fails, error",
+			fields{
+				client: dbMock(t,
+					expectBegin(nil),
+					expectQueryErr("SELECT id, key FROM system.encryption_keys FOR UPDATE", sql.ErrConnDone),
+					expectRollback(nil),
+				),
+				masterKey: "masterkey",
+			},
+			args{
+				newMasterKey: "12345678901234567890123456789012",
+			},
+			res{
+				masterKey: "masterkey",
+				err: func(err error) bool {
+					return errors.Is(err, sql.ErrConnDone)
+				},
+			},
+		},
+		{
+			"decryption fails, error",
+			fields{
+				client: dbMock(t,
+					expectBegin(nil),
+					expectQuery(
+						"SELECT id, key FROM system.encryption_keys FOR UPDATE",
+						[]string{"id", "key"},
+						[][]driver.Value{
+							{
+								"id1",
+								"key1",
+							},
+						},
+					),
+					expectRollback(nil),
+				),
+				masterKey: "wrong key",
+				decrypt: func(encryptedKey, masterKey string) (key string, err error) {
+					return "", fmt.Errorf("wrong masterkey")
+				},
+			},
+			args{
+				newMasterKey: "12345678901234567890123456789012",
+			},
+			res{
+				masterKey: "wrong key",
+				err:       zerrors.IsInternal,
+			},
+		},
+		{
+			"update fails, error",
+			fields{
+				client: dbMock(t,
+					expectBegin(nil),
+					expectQuery(
+						"SELECT id, key FROM system.encryption_keys FOR UPDATE",
+						[]string{"id", "key"},
+						[][]driver.Value{
+							{
+								"id1",
+								"key1:masterkey",
+							},
+						},
+					),
+					expectExec("UPDATE system.encryption_keys SET key = $1 WHERE id = $2", sql.ErrTxDone),
+					expectRollback(nil),
+				),
+				masterKey: "masterkey",
+				encrypt: func(key, masterKey string) (encryptedKey string, err error) {
+					return key + ":" + masterKey, nil
+				},
+				decrypt: func(encryptedKey, masterKey string) (key string, err error) {
+					return strings.TrimSuffix(encryptedKey, ":"+masterKey), nil
+				},
+			},
+			args{
+				newMasterKey: "12345678901234567890123456789012",
+			},
+			res{
+				masterKey: "masterkey",
+				err: func(err error) bool {
+					return errors.Is(err, sql.ErrTxDone)
+				},
+			},
+		},
+		{
+			"rotate ok",
+			fields{
+				client: dbMock(t,
+					expectBegin(nil),
+					expectQuery(
+						"SELECT id, key FROM system.encryption_keys FOR UPDATE",
+						[]string{"id", "key"},
+						[][]driver.Value{
+							{
+								"id1",
+								"key1:masterkey",
+							},
+						},
+					),
+					expectExec("UPDATE system.encryption_keys SET key = $1 WHERE id = $2", nil, "key1:12345678901234567890123456789012", "id1"),
+					expectCommit(nil),
+				),
+				masterKey: "masterkey",
+				encrypt: func(key, masterKey string) (encryptedKey string, err error) {
+					return key + ":" + masterKey, nil
+				},
+				decrypt: func(encryptedKey, masterKey string) (key string, err error) {
+					return strings.TrimSuffix(encryptedKey, ":"+masterKey), nil
+				},
+			},
+			args{
+				newMasterKey: "12345678901234567890123456789012",
+			},
+			res{
+				masterKey: "12345678901234567890123456789012",
+				err:       nil,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &Database{
+				client:    tt.fields.client.db,
+				masterKey: tt.fields.masterKey,
+				encrypt:   tt.fields.encrypt,
+				decrypt:   tt.fields.decrypt,
+			}
+			err := d.RotateMasterKey(context.Background(), tt.args.newMasterKey)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			} else if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v", err)
+			}
+			assert.Equal(t, tt.res.masterKey, d.masterKey)
+			if err := tt.fields.client.mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func Test_database_DeleteKeys(t *testing.T) {
+	type args struct {
+		ids []string
+	}
+	type res struct {
+		err func(error) bool
+	}
+	tests := []struct {
+		name   string
+		client db
+		args   args
+		res    res
+	}{
+		{
+			"delete fails, error",
+			dbMock(t,
+				expectExec("DELETE FROM system.encryption_keys WHERE id IN ($1)", sql.ErrConnDone, "id1"),
+			),
+			args{
+				ids: []string{"id1"},
+			},
+			res{
+				err: func(err error) bool {
+					return errors.Is(err, sql.ErrConnDone)
+				},
+			},
+		},
+		{
+			"delete ok",
+			dbMock(t,
+				expectExec("DELETE FROM system.encryption_keys WHERE id IN ($1,$2)", nil, "id1", "id2"),
+			),
+			args{
+				ids: []string{"id1", "id2"},
+			},
+			res{
+				err: nil,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &Database{
+				client: tt.client.db,
+			}
+			err := d.DeleteKeys(context.Background(), tt.args.ids...)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			} else if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v", err)
+			}
+			if err := tt.client.mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
 func Test_checkMasterKeyLength(t *testing.T) {
 	type args struct {
 		masterKey string
@@ -0,0 +1,316 @@
+//go:build pkcs11
+
+package keyprovider
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/miekg/pkcs11"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// PKCS11Enabled is true if the binary was built with the pkcs11 build tag.
+const PKCS11Enabled = true
+
+var (
+	oidCurveP256 = asn1.ObjectIdentifier{1, 2, 840, 10045, 3, 1, 7}
+	oidCurveP384 = asn1.ObjectIdentifier{1, 3, 132, 0, 34}
+	oidCurveP521 = asn1.ObjectIdentifier{1, 3, 132, 0, 35}
+
+	// digestInfoPrefixes are prepended to the digest for CKM_RSA_PKCS, see RFC 8017 section 9.2
+	digestInfoPrefixes = map[crypto.Hash][]byte{
+		crypto.SHA256: {0x30, 0x31, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x01, 0x05, 0x00, 0x04, 0x20},
+		crypto.SHA384: {0x30, 0x41, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x02, 0x05, 0x00, 0x04, 0x30},
+		crypto.SHA512: {0x30, 0x51, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x03, 0x05, 0x00, 0x04, 0x40},
+	}
+
+	pssHashParams = map[crypto.Hash][2]uint{
+		crypto.SHA256: {pkcs11.CKM_SHA256, pkcs11.CKG_MGF1_SHA256},
+		crypto.SHA384: {pkcs11.CKM_SHA384, pkcs11.CKG_MGF1_SHA384},
+		crypto.SHA512: {pkcs11.CKM_SHA512, pkcs11.CKG_MGF1_SHA512},
+	}
+)
+
+// PKCS11 delegates the operations to a token of a PKCS#11 module, e.g. an HSM or SoftHSM.
+// The keys are identified by their label (CKA_LABEL).
+// The master key must be wrapped with RSA-OAEP (SHA-256) by the public key of the configured key pair
+// and provided base64 encoded.
+type PKCS11 struct {
+	ctx *pkcs11.Ctx
+	// mutex guards the session, which must not be used concurrently
+	mutex   sync.Mutex
+	session pkcs11.SessionHandle
+}
+
+func NewPKCS11(config *PKCS11Config) (_ *PKCS11, err error) {
+	p := pkcs11.New(config.Module)
+	if p == nil {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "KEYPR-Ks2bi", "unable to load pkcs11 module %s", config.Module)
+	}
+	if err = p.Initialize(); err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_CRYPTOKI_ALREADY_INITIALIZED)) {
+		return nil, zerrors.ThrowInternal(err, "KEYPR-Ny7wh", "unable to initialize pkcs11 module")
+	}
+	slot, err := findSlot(p, config.TokenLabel)
+	if err != nil {
+		return nil, err
+	}
+	session, err := p.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "KEYPR-Ec3us", "unable to open pkcs11 session")
+	}
+	if err = p.Login(session, pkcs11.CKU_USER, config.PIN); err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN)) {
+		p.CloseSession(session)
+		return nil, zerrors.ThrowPermissionDenied(err, "KEYPR-Yq1ma", "unable to login to pkcs11 token")
+	}
+	return &PKCS11{
+		ctx:     p,
+		session: session,
+	}, nil
+}
+
+func findSlot(p *pkcs11.Ctx, tokenLabel string) (uint, error) {
+	slots, err := p.GetSlotList(true)
+	if err != nil {
+		return 0, zerrors.ThrowInternal(err, "KEYPR-Dw5kp", "unable to list pkcs11 slots")
+	}
+	for _, slot := range slots {
+		info, err := p.GetTokenInfo(slot)
+		if err != nil {
+			return 0, zerrors.ThrowInternal(err, "KEYPR-Dw5kp", "unable to read pkcs11 token")
+		}
+		// the label is padded with spaces
+		if strings.TrimRight(info.Label, " \x00") == tokenLabel {
+			return slot, nil
+		}
+	}
+	return 0, zerrors.ThrowNotFoundf(nil, "KEYPR-Ob6ry", "pkcs11 token %s not found", tokenLabel)
+}
+
+// Decrypt implements [Provider].
+// The ciphertext is base64 encoded and decrypted with RSA-OAEP (SHA-256) by the private key.
+func (p *PKCS11) Decrypt(_ context.Context, keyID, ciphertext string) ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(strings.TrimSpace(ciphertext))
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "KEYPR-Ui8dn", "ciphertext must be base64 encoded")
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	key, err := p.findObject(pkcs11.CKO_PRIVATE_KEY, keyID)
+	if err != nil {
+		return nil, err
+	}
+	params := pkcs11.NewOAEPParams(pkcs11.CKM_SHA256, pkcs11.CKG_MGF1_SHA256, pkcs11.CKZ_DATA_SPECIFIED, nil)
+	if err = p.ctx.DecryptInit(p.session, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_RSA_PKCS_OAEP, params)}, key); err != nil {
+		return nil, zerrors.ThrowInternal(err, "KEYPR-Av4fe", "unable to decrypt")
+	}
+	plaintext, err := p.ctx.Decrypt(p.session, data)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "KEYPR-Av4fe", "unable to decrypt")
+	}
+	return plaintext, nil
+}
+
+// Signer implements [Provider].
+// The public key of the key pair must be stored on the token with the same label.
+func (p *PKCS11) Signer(_ context.Context, keyID string) (crypto.Signer, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	privateKey, err := p.findObject(pkcs11.CKO_PRIVATE_KEY, keyID)
+	if err != nil {
+		return nil, err
+	}
+	publicKey, err := p.findObject(pkcs11.CKO_PUBLIC_KEY, keyID)
+	if err != nil {
+		return nil, err
+	}
+	public, err := p.publicKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	return &pkcs11Signer{
+		provider:  p,
+		key:       privateKey,
+		publicKey: public,
+	}, nil
+}
+
+// findObject must be called with the lock held.
+func (p *PKCS11) findObject(class uint, label string) (pkcs11.ObjectHandle, error) {
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, class),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	}
+	if err := p.ctx.FindObjectsInit(p.session, template); err != nil {
+		return 0, zerrors.ThrowInternal(err, "KEYPR-Hf9cu", "unable to search pkcs11 objects")
+	}
+	objects, _, err := p.ctx.FindObjects(p.session, 1)
+	finalErr := p.ctx.FindObjectsFinal(p.session)
+	if err = errors.Join(err, finalErr); err != nil {
+		return 0, zerrors.ThrowInternal(err, "KEYPR-Hf9cu", "unable to search pkcs11 objects")
+	}
+	if len(objects) == 0 {
+		return 0, zerrors.ThrowNotFoundf(nil, "KEYPR-Tk2le", "pkcs11 key %s not found", label)
+	}
+	return objects[0], nil
+}
+
+// publicKey must be called with the lock held.
+func (p *PKCS11) publicKey(object pkcs11.ObjectHandle) (crypto.PublicKey, error) {
+	attributes, err := p.ctx.GetAttributeValue(p.session, object, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, nil),
+	})
+	if err != nil || len(attributes) != 1 {
+		return nil, zerrors.ThrowInternal(err, "KEYPR-Wj3sa", "unable to read pkcs11 key type")
+	}
+	switch attributeUint(attributes[0].Value) {
+	case pkcs11.CKK_RSA:
+		attributes, err = p.ctx.GetAttributeValue(p.session, object, []*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_MODULUS, nil),
+			pkcs11.NewAttribute(pkcs11.CKA_PUBLIC_EXPONENT, nil),
+		})
+		if err != nil || len(attributes) != 2 {
+			return nil, zerrors.ThrowInternal(err, "KEYPR-Wj3sa", "unable to read pkcs11 public key")
+		}
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(attributes[0].Value),
+			E: int(new(big.Int).SetBytes(attributes[1].Value).Int64()),
+		}, nil
+	case pkcs11.CKK_EC:
+		attributes, err = p.ctx.GetAttributeValue(p.session, object, []*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, nil),
+			pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil),
+		})
+		if err != nil || len(attributes) != 2 {
+			return nil, zerrors.ThrowInternal(err, "KEYPR-Wj3sa", "unable to read pkcs11 public key")
+		}
+		return parseECPublicKey(attributes[0].Value, attributes[1].Value)
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "KEYPR-Qa6ve", "unsupported pkcs11 key type")
+	}
+}
+
+// attributeUint decodes a CK_ULONG attribute, which is stored in the native byte order.
+func attributeUint(value []byte) uint {
+	switch len(value) {
+	case 4:
+		return uint(binary.NativeEndian.Uint32(value))
+	case 8:
+		return uint(binary.NativeEndian.Uint64(value))
+	}
+	return 0
+}
+
+func parseECPublicKey(params, point []byte) (*ecdsa.PublicKey, error) {
+	var oid asn1.ObjectIdentifier
+	if _, err := asn1.Unmarshal(params, &oid); err != nil {
+		return nil, zerrors.ThrowInternal(err, "KEYPR-Ix5pb", "unable to parse ec params")
+	}
+	var curve elliptic.Curve
+	switch {
+	case oid.Equal(oidCurveP256):
+		curve = elliptic.P256()
+	case oid.Equal(oidCurveP384):
+		curve = elliptic.P384()
+	case oid.Equal(oidCurveP521):
+		curve = elliptic.P521()
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "KEYPR-Ix5pb", "unsupported curve %s", oid)
+	}
+	// the point is DER encoded as octet string
+	var raw []byte
+	if _, err := asn1.Unmarshal(point, &raw); err != nil {
+		return nil, zerrors.ThrowInternal(err, "KEYPR-Ix5pb", "unable to parse ec point")
+	}
+	key, err := ecdsa.ParseUncompressedPublicKey(curve, raw)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "KEYPR-Ix5pb", "unable to parse ec point")
+	}
+	return key, nil
+}
+
+type pkcs11Signer struct {
+	provider  *PKCS11
+	key       pkcs11.ObjectHandle
+	publicKey crypto.PublicKey
+}
+
+func (s *pkcs11Signer) Public() crypto.PublicKey {
+	return s.publicKey
+}
+
+// Sign implements [crypto.Signer].
+func (s *pkcs11Signer) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	var (
+		mechanism *pkcs11.Mechanism
+		message   = digest
+	)
+	switch s.publicKey.(type) {
+	case *rsa.PublicKey:
+		if pss, ok := opts.(*rsa.PSSOptions); ok {
+			params, ok := pssHashParams[opts.HashFunc()]
+			if !ok {
+				return nil, zerrors.ThrowInvalidArgumentf(nil, "KEYPR-Gd8zm", "unsupported hash %s", opts.HashFunc())
+			}
+			saltLength := pss.SaltLength
+			if saltLength == rsa.PSSSaltLengthEqualsHash || saltLength == rsa.PSSSaltLengthAuto {
+				saltLength = opts.HashFunc().Size()
+			}
+			mechanism = pkcs11.NewMechanism(pkcs11.CKM_RSA_PKCS_PSS, pkcs11.NewPSSParams(params[0], params[1], uint(saltLength)))
+			break
+		}
+		prefix, ok := digestInfoPrefixes[opts.HashFunc()]
+		if !ok {
+			return nil, zerrors.ThrowInvalidArgumentf(nil, "KEYPR-Gd8zm", "unsupported hash %s", opts.HashFunc())
+		}
+		mechanism = pkcs11.NewMechanism(pkcs11.CKM_RSA_PKCS, nil)
+		message = append(append(make([]byte, 0, len(prefix)+len(digest)), prefix...), digest...)
+	case *ecdsa.PublicKey:
+		mechanism = pkcs11.NewMechanism(pkcs11.CKM_ECDSA, nil)
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "KEYPR-Qa6ve", "unsupported pkcs11 key type")
+	}
+
+	s.provider.mutex.Lock()
+	defer s.provider.mutex.Unlock()
+	if err := s.provider.ctx.SignInit(s.provider.session, []*pkcs11.Mechanism{mechanism}, s.key); err != nil {
+		return nil, zerrors.ThrowInternal(err, "KEYPR-Lo4tg", "unable to sign")
+	}
+	signature, err := s.provider.ctx.Sign(s.provider.session, message)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "KEYPR-Lo4tg", "unable to sign")
+	}
+	if _, ok := s.publicKey.(*ecdsa.PublicKey); ok {
+		return ecdsaSignatureToASN1(signature)
+	}
+	return signature, nil
+}
+
+// ecdsaSignatureToASN1 converts the signature from r||s returned by CKM_ECDSA
+// to the ASN.1 encoding expected from a [crypto.Signer].
+func ecdsaSignatureToASN1(signature []byte) ([]byte, error) {
+	if len(signature) == 0 || len(signature)%2 != 0 {
+		return nil, zerrors.ThrowInternal(nil, "KEYPR-Zc7xu", "invalid ecdsa signature")
+	}
+	half := len(signature) / 2
+	return asn1.Marshal(struct {
+		R, S *big.Int
+	}{
+		R: new(big.Int).SetBytes(signature[:half]),
+		S: new(big.Int).SetBytes(signature[half:]),
+	})
+}
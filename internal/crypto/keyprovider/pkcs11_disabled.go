@@ -0,0 +1,15 @@
+//go:build !pkcs11
+
+package keyprovider
+
+import (
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// PKCS11Enabled is true if the binary was built with the pkcs11 build tag.
+const PKCS11Enabled = false
+
+// NewPKCS11 returns an error, as PKCS#11 requires cgo and the binary must be built with the pkcs11 build tag.
+func NewPKCS11(*PKCS11Config) (Provider, error) {
+	return nil, zerrors.ThrowUnimplemented(nil, "KEYPR-Cg5ex", "pkcs11 is not supported, build with the pkcs11 tag")
+}
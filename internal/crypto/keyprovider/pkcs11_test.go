@@ -0,0 +1,115 @@
+//go:build pkcs11
+
+package keyprovider
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"os"
+	"testing"
+
+	"github.com/miekg/pkcs11"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newSoftHSM returns a provider for an initialized SoftHSM token, e.g.:
+//
+//	softhsm2-util --init-token --free --label zitadel --so-pin 1234 --pin 1234
+//	SOFTHSM2_MODULE=/usr/lib/softhsm/libsofthsm2.so go test -tags pkcs11 ./internal/crypto/keyprovider/...
+func newSoftHSM(t *testing.T) *PKCS11 {
+	module := os.Getenv("SOFTHSM2_MODULE")
+	if module == "" {
+		t.Skip("SOFTHSM2_MODULE not set")
+	}
+	config := &PKCS11Config{
+		Module:     module,
+		TokenLabel: "zitadel",
+		PIN:        "1234",
+	}
+	if label := os.Getenv("SOFTHSM2_TOKEN_LABEL"); label != "" {
+		config.TokenLabel = label
+	}
+	if pin := os.Getenv("SOFTHSM2_PIN"); pin != "" {
+		config.PIN = pin
+	}
+	provider, err := NewPKCS11(config)
+	require.NoError(t, err)
+	return provider
+}
+
+func generateKeyPair(t *testing.T, p *PKCS11, label string, mechanism uint, publicTemplate []*pkcs11.Attribute) {
+	publicTemplate = append(publicTemplate,
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, false),
+		pkcs11.NewAttribute(pkcs11.CKA_VERIFY, true),
+		pkcs11.NewAttribute(pkcs11.CKA_ENCRYPT, mechanism == pkcs11.CKM_RSA_PKCS_KEY_PAIR_GEN),
+	)
+	privateTemplate := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, false),
+		pkcs11.NewAttribute(pkcs11.CKA_SIGN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_DECRYPT, mechanism == pkcs11.CKM_RSA_PKCS_KEY_PAIR_GEN),
+		pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, false),
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	_, _, err := p.ctx.GenerateKeyPair(p.session, []*pkcs11.Mechanism{pkcs11.NewMechanism(mechanism, nil)}, publicTemplate, privateTemplate)
+	require.NoError(t, err)
+}
+
+func TestPKCS11_SoftHSM(t *testing.T) {
+	p := newSoftHSM(t)
+	generateKeyPair(t, p, "rsa", pkcs11.CKM_RSA_PKCS_KEY_PAIR_GEN, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_MODULUS_BITS, 2048),
+		pkcs11.NewAttribute(pkcs11.CKA_PUBLIC_EXPONENT, []byte{1, 0, 1}),
+	})
+	p256, err := asn1.Marshal(oidCurveP256)
+	require.NoError(t, err)
+	generateKeyPair(t, p, "ecdsa", pkcs11.CKM_EC_KEY_PAIR_GEN, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, p256),
+	})
+	digest := sha256.Sum256([]byte("message"))
+
+	t.Run("rsa pkcs1v15", func(t *testing.T) {
+		signer, err := p.Signer(context.Background(), "rsa")
+		require.NoError(t, err)
+		signature, err := signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+		require.NoError(t, err)
+		assert.NoError(t, rsa.VerifyPKCS1v15(signer.Public().(*rsa.PublicKey), crypto.SHA256, digest[:], signature))
+	})
+	t.Run("rsa pss", func(t *testing.T) {
+		signer, err := p.Signer(context.Background(), "rsa")
+		require.NoError(t, err)
+		signature, err := signer.Sign(rand.Reader, digest[:], &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: crypto.SHA256})
+		require.NoError(t, err)
+		assert.NoError(t, rsa.VerifyPSS(signer.Public().(*rsa.PublicKey), crypto.SHA256, digest[:], signature, nil))
+	})
+	t.Run("ecdsa", func(t *testing.T) {
+		signer, err := p.Signer(context.Background(), "ecdsa")
+		require.NoError(t, err)
+		signature, err := signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+		require.NoError(t, err)
+		assert.True(t, ecdsa.VerifyASN1(signer.Public().(*ecdsa.PublicKey), digest[:], signature))
+	})
+	t.Run("decrypt", func(t *testing.T) {
+		signer, err := p.Signer(context.Background(), "rsa")
+		require.NoError(t, err)
+		wrapped, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, signer.Public().(*rsa.PublicKey), []byte("masterkey"), nil)
+		require.NoError(t, err)
+		got, err := p.Decrypt(context.Background(), "rsa", base64.StdEncoding.EncodeToString(wrapped))
+		require.NoError(t, err)
+		assert.Equal(t, []byte("masterkey"), got)
+	})
+	t.Run("unknown key", func(t *testing.T) {
+		_, err := p.Signer(context.Background(), "unknown")
+		assert.Error(t, err)
+	})
+}
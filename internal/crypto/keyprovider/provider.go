@@ -0,0 +1,218 @@
+package keyprovider
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/cryptosigner"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// Provider delegates the operations with keys, which must not leave an external device like an HSM or a KMS.
+type Provider interface {
+	// Decrypt unwraps the ciphertext with the key identified by keyID.
+	// The ciphertext is in the format returned by the device when the value was wrapped.
+	Decrypt(ctx context.Context, keyID, ciphertext string) ([]byte, error)
+	// Signer returns a signer for the key identified by keyID.
+	// Only the digest is sent to the device, the private key never leaves it.
+	Signer(ctx context.Context, keyID string) (crypto.Signer, error)
+}
+
+type Type string
+
+const (
+	TypeNone   Type = ""
+	TypePKCS11 Type = "pkcs11"
+	TypeVault  Type = "vault"
+)
+
+type Config struct {
+	// Type of the provider, if empty the keys are not delegated.
+	Type Type
+	// MasterKeyID identifies the key which wraps the master key.
+	// If set, the provided master key is the ciphertext returned by the device and is unwrapped on startup.
+	MasterKeyID string
+	// SigningKey is used to sign the tokens and SAML messages of all instances instead of their own keys.
+	SigningKey SigningKeyConfig
+	PKCS11     PKCS11Config
+	Vault      VaultConfig
+}
+
+type SigningKeyConfig struct {
+	// KeyID identifies the key on the device, it is also used as key ID in the JSON Web Key Set.
+	KeyID string
+	// Algorithm is the JSON Web Signature algorithm used with an RSA key: RS256, RS384, RS512, PS256, PS384 or PS512.
+	// If empty RS256 is used. The algorithm of ECDSA and Ed25519 keys is derived from the key.
+	Algorithm string
+	// Certificate is the path to the PEM encoded certificate of the key.
+	// It's required to sign SAML messages and is published in the SAML metadata.
+	Certificate string
+}
+
+type PKCS11Config struct {
+	// Module is the path to the PKCS#11 library of the device, e.g. /usr/lib/softhsm/libsofthsm2.so
+	Module     string
+	TokenLabel string
+	PIN        string
+}
+
+// NewProvider returns the provider of the configured type.
+// If no type is configured nil is returned.
+func NewProvider(config *Config) (Provider, error) {
+	if config == nil {
+		return nil, nil
+	}
+	switch config.Type {
+	case TypeNone:
+		return nil, nil
+	case TypePKCS11:
+		return NewPKCS11(&config.PKCS11)
+	case TypeVault:
+		return NewVault(&config.Vault)
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "KEYPR-Wq3nd", "unknown key provider type %q", config.Type)
+	}
+}
+
+// UnwrapMasterKey decrypts the master key with the configured key of the provider.
+// If no provider or key is configured, the master key is returned as provided.
+func UnwrapMasterKey(ctx context.Context, config *Config, masterKey string) (string, error) {
+	if config == nil || config.MasterKeyID == "" {
+		return masterKey, nil
+	}
+	provider, err := NewProvider(config)
+	if err != nil {
+		return "", err
+	}
+	if provider == nil {
+		return "", zerrors.ThrowPreconditionFailed(nil, "KEYPR-Lx8cq", "master key id is set without key provider type")
+	}
+	key, err := provider.Decrypt(ctx, config.MasterKeyID, masterKey)
+	if err != nil {
+		return "", zerrors.ThrowInternal(err, "KEYPR-Bd2mf", "unable to unwrap master key")
+	}
+	return string(key), nil
+}
+
+// SigningKey is a key held by the provider, used to sign tokens and SAML messages.
+type SigningKey struct {
+	ID        string
+	Algorithm jose.SignatureAlgorithm
+	Signer    crypto.Signer
+	// Certificate is DER encoded and empty if none is configured.
+	Certificate []byte
+}
+
+// NewSigningKey loads the configured signing key from the provider.
+// If no provider or key is configured nil is returned.
+func NewSigningKey(ctx context.Context, provider Provider, config *Config) (*SigningKey, error) {
+	if provider == nil || config == nil || config.SigningKey.KeyID == "" {
+		return nil, nil
+	}
+	signer, err := provider.Signer(ctx, config.SigningKey.KeyID)
+	if err != nil {
+		return nil, err
+	}
+	alg, err := signatureAlgorithm(signer.Public(), jose.SignatureAlgorithm(config.SigningKey.Algorithm))
+	if err != nil {
+		return nil, err
+	}
+	key := &SigningKey{
+		ID:        config.SigningKey.KeyID,
+		Algorithm: alg,
+		Signer:    signer,
+	}
+	if config.SigningKey.Certificate == "" {
+		return key, nil
+	}
+	key.Certificate, err = readCertificate(config.SigningKey.Certificate, signer.Public())
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// WebKey returns the key for signing with [jose.NewSigner].
+func (k *SigningKey) WebKey() *jose.JSONWebKey {
+	return &jose.JSONWebKey{
+		Key:       cryptosigner.Opaque(k.Signer),
+		KeyID:     k.ID,
+		Algorithm: string(k.Algorithm),
+		Use:       "sig",
+	}
+}
+
+// PublicWebKey returns the public key for the JSON Web Key Set.
+func (k *SigningKey) PublicWebKey() *jose.JSONWebKey {
+	return &jose.JSONWebKey{
+		Key:       k.Signer.Public(),
+		KeyID:     k.ID,
+		Algorithm: string(k.Algorithm),
+		Use:       "sig",
+	}
+}
+
+// signatureAlgorithm returns the configured algorithm for RSA keys
+// and checks that a configured algorithm matches the ECDSA and Ed25519 keys.
+func signatureAlgorithm(publicKey crypto.PublicKey, configured jose.SignatureAlgorithm) (jose.SignatureAlgorithm, error) {
+	var alg jose.SignatureAlgorithm
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		switch configured {
+		case "":
+			return jose.RS256, nil
+		case jose.RS256, jose.RS384, jose.RS512, jose.PS256, jose.PS384, jose.PS512:
+			return configured, nil
+		default:
+			return "", zerrors.ThrowInvalidArgumentf(nil, "KEYPR-Pq4wm", "algorithm %s is not supported for RSA signing keys", configured)
+		}
+	case *ecdsa.PublicKey:
+		switch key.Curve {
+		case elliptic.P256():
+			alg = jose.ES256
+		case elliptic.P384():
+			alg = jose.ES384
+		case elliptic.P521():
+			alg = jose.ES512
+		default:
+			return "", zerrors.ThrowInvalidArgumentf(nil, "KEYPR-Ue4vo", "unsupported signing key type %T", publicKey)
+		}
+	case ed25519.PublicKey:
+		alg = jose.EdDSA
+	default:
+		return "", zerrors.ThrowInvalidArgumentf(nil, "KEYPR-Ue4vo", "unsupported signing key type %T", publicKey)
+	}
+	if configured != "" && configured != alg {
+		return "", zerrors.ThrowInvalidArgumentf(nil, "KEYPR-Vn7jd", "algorithm %s does not match the signing key, use %s", configured, alg)
+	}
+	return alg, nil
+}
+
+func readCertificate(path string, publicKey crypto.PublicKey) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "KEYPR-Hy6rz", "unable to read certificate")
+	}
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "KEYPR-Cv9pa", "certificate must be PEM encoded")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "KEYPR-Zt5ke", "unable to parse certificate")
+	}
+	key, ok := cert.PublicKey.(interface{ Equal(crypto.PublicKey) bool })
+	if !ok || !key.Equal(publicKey) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "KEYPR-Rm2xs", "certificate does not match the signing key")
+	}
+	return block.Bytes, nil
+}
@@ -0,0 +1,187 @@
+package keyprovider
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testProvider struct {
+	signer crypto.Signer
+}
+
+func (p *testProvider) Decrypt(_ context.Context, _, ciphertext string) ([]byte, error) {
+	return []byte(ciphertext), nil
+}
+
+func (p *testProvider) Signer(context.Context, string) (crypto.Signer, error) {
+	return p.signer, nil
+}
+
+func writeCertificate(t *testing.T, key crypto.Signer) string {
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "cert.pem")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert}), 0o600))
+	return path
+}
+
+func TestNewProvider(t *testing.T) {
+	provider, err := NewProvider(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, provider)
+
+	provider, err = NewProvider(&Config{})
+	assert.NoError(t, err)
+	assert.Nil(t, provider)
+
+	_, err = NewProvider(&Config{Type: "unknown"})
+	assert.Error(t, err)
+
+	_, err = NewProvider(&Config{Type: TypeVault})
+	assert.Error(t, err)
+
+	provider, err = NewProvider(&Config{Type: TypeVault, Vault: VaultConfig{Address: "http://localhost:8200"}})
+	assert.NoError(t, err)
+	assert.NotNil(t, provider)
+}
+
+func TestUnwrapMasterKey(t *testing.T) {
+	server := newTransitServer(t, nil)
+
+	got, err := UnwrapMasterKey(context.Background(), nil, "masterkey")
+	require.NoError(t, err)
+	assert.Equal(t, "masterkey", got)
+
+	_, err = UnwrapMasterKey(context.Background(), &Config{MasterKeyID: "masterkey"}, "vault:v1:wrapped")
+	assert.Error(t, err)
+
+	got, err = UnwrapMasterKey(context.Background(), &Config{
+		Type:        TypeVault,
+		MasterKeyID: "masterkey",
+		Vault:       VaultConfig{Address: server.URL, Token: "token"},
+	}, "vault:v1:wrapped")
+	require.NoError(t, err)
+	assert.Equal(t, "plaintext", got)
+}
+
+func TestNewSigningKey(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	provider := &testProvider{signer: key}
+
+	t.Run("not configured", func(t *testing.T) {
+		signingKey, err := NewSigningKey(context.Background(), nil, &Config{SigningKey: SigningKeyConfig{KeyID: "key"}})
+		require.NoError(t, err)
+		assert.Nil(t, signingKey)
+		signingKey, err = NewSigningKey(context.Background(), provider, &Config{})
+		require.NoError(t, err)
+		assert.Nil(t, signingKey)
+	})
+	t.Run("certificate of other key", func(t *testing.T) {
+		_, err := NewSigningKey(context.Background(), provider, &Config{SigningKey: SigningKeyConfig{KeyID: "key", Certificate: writeCertificate(t, otherKey)}})
+		assert.Error(t, err)
+	})
+	t.Run("sign and verify", func(t *testing.T) {
+		signingKey, err := NewSigningKey(context.Background(), provider, &Config{SigningKey: SigningKeyConfig{KeyID: "key", Certificate: writeCertificate(t, key)}})
+		require.NoError(t, err)
+		assert.Equal(t, jose.ES256, signingKey.Algorithm)
+		assert.NotEmpty(t, signingKey.Certificate)
+
+		signer, err := jose.NewSigner(jose.SigningKey{Algorithm: signingKey.Algorithm, Key: signingKey.WebKey()}, nil)
+		require.NoError(t, err)
+		signed, err := signer.Sign([]byte("payload"))
+		require.NoError(t, err)
+		token, err := signed.CompactSerialize()
+		require.NoError(t, err)
+		jws, err := jose.ParseSigned(token, []jose.SignatureAlgorithm{jose.ES256})
+		require.NoError(t, err)
+		assert.Equal(t, "key", jws.Signatures[0].Header.KeyID)
+		payload, err := jws.Verify(signingKey.PublicWebKey())
+		require.NoError(t, err)
+		assert.Equal(t, []byte("payload"), payload)
+	})
+}
+
+func Test_signatureAlgorithm(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		publicKey  crypto.PublicKey
+		configured jose.SignatureAlgorithm
+		want       jose.SignatureAlgorithm
+		wantErr    bool
+	}{
+		{
+			name:      "rsa, default",
+			publicKey: rsaKey.Public(),
+			want:      jose.RS256,
+		},
+		{
+			name:       "rsa, configured",
+			publicKey:  rsaKey.Public(),
+			configured: jose.PS512,
+			want:       jose.PS512,
+		},
+		{
+			name:       "rsa, ecdsa algorithm",
+			publicKey:  rsaKey.Public(),
+			configured: jose.ES256,
+			wantErr:    true,
+		},
+		{
+			name:      "ecdsa, derived",
+			publicKey: ecKey.Public(),
+			want:      jose.ES384,
+		},
+		{
+			name:       "ecdsa, matching",
+			publicKey:  ecKey.Public(),
+			configured: jose.ES384,
+			want:       jose.ES384,
+		},
+		{
+			name:       "ecdsa, other curve",
+			publicKey:  ecKey.Public(),
+			configured: jose.ES256,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := signatureAlgorithm(tt.publicKey, tt.configured)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package keyprovider
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	vaultTokenHeader     = "X-Vault-Token"
+	vaultNamespaceHeader = "X-Vault-Namespace"
+	vaultSignaturePrefix = "vault:v"
+)
+
+type VaultConfig struct {
+	// Address of the Vault server, e.g. https://vault.example.com:8200
+	Address string
+	Token   string
+	// Namespace is only sent if set (Vault Enterprise).
+	Namespace string
+	// Mount path of the transit secrets engine, defaults to transit.
+	Mount   string
+	Timeout time.Duration
+}
+
+// Vault delegates the operations to the transit secrets engine of HashiCorp Vault
+// or a compatible API (e.g. OpenBao).
+type Vault struct {
+	config *VaultConfig
+	client *http.Client
+}
+
+func NewVault(config *VaultConfig) (*Vault, error) {
+	if config.Address == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "KEYPR-Pq7ad", "vault address must be set")
+	}
+	if config.Mount == "" {
+		config.Mount = "transit"
+	}
+	return &Vault{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+	}, nil
+}
+
+// Decrypt implements [Provider].
+// The ciphertext is the value returned by the encrypt endpoint, e.g. vault:v1:...
+func (v *Vault) Decrypt(ctx context.Context, keyID, ciphertext string) ([]byte, error) {
+	var resp struct {
+		Plaintext string `json:"plaintext"`
+	}
+	err := v.do(ctx, http.MethodPost, "decrypt/"+url.PathEscape(keyID), map[string]any{"ciphertext": strings.TrimSpace(ciphertext)}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := base64.StdEncoding.DecodeString(resp.Plaintext)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "KEYPR-Vd3ow", "invalid plaintext returned by vault")
+	}
+	return plaintext, nil
+}
+
+// Signer implements [Provider].
+// The signer uses the latest version of the key at the time it's loaded.
+func (v *Vault) Signer(ctx context.Context, keyID string) (crypto.Signer, error) {
+	var resp struct {
+		LatestVersion int `json:"latest_version"`
+		Keys          map[string]struct {
+			PublicKey string `json:"public_key"`
+		} `json:"keys"`
+	}
+	if err := v.do(ctx, http.MethodGet, "keys/"+url.PathEscape(keyID), nil, &resp); err != nil {
+		return nil, err
+	}
+	key, ok := resp.Keys[strconv.Itoa(resp.LatestVersion)]
+	if !ok || key.PublicKey == "" {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "KEYPR-Ko9ts", "vault key has no public key")
+	}
+	publicKey, err := parseVaultPublicKey(key.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return &vaultSigner{
+		vault:     v,
+		keyID:     keyID,
+		version:   resp.LatestVersion,
+		publicKey: publicKey,
+	}, nil
+}
+
+func (v *Vault) do(ctx context.Context, method, path string, body any, data any) error {
+	var reqBody io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "KEYPR-Ae1nx", "unable to marshal vault request")
+		}
+		reqBody = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(v.config.Address, "/")+"/v1/"+strings.Trim(v.config.Mount, "/")+"/"+path, reqBody)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "KEYPR-Xu6bf", "unable to create vault request")
+	}
+	req.Header.Set(vaultTokenHeader, v.config.Token)
+	if v.config.Namespace != "" {
+		req.Header.Set(vaultNamespaceHeader, v.config.Namespace)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return zerrors.ThrowUnavailable(err, "KEYPR-Jh4wy", "vault not reachable")
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []string        `json:"errors"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&result); err != nil && resp.StatusCode == http.StatusOK {
+		return zerrors.ThrowInternal(err, "KEYPR-Rb8ze", "unable to parse vault response")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return zerrors.ThrowInternalf(nil, "KEYPR-Fn2qc", "vault responded with status %d: %s", resp.StatusCode, strings.Join(result.Errors, ", "))
+	}
+	if err = json.Unmarshal(result.Data, data); err != nil {
+		return zerrors.ThrowInternal(err, "KEYPR-Rb8ze", "unable to parse vault response")
+	}
+	return nil
+}
+
+func parseVaultPublicKey(publicKey string) (crypto.PublicKey, error) {
+	if block, _ := pem.Decode([]byte(publicKey)); block != nil {
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, zerrors.ThrowInternal(err, "KEYPR-Mw3ut", "unable to parse vault public key")
+		}
+		return key, nil
+	}
+	// ed25519 keys are returned base64 encoded
+	key, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		return nil, zerrors.ThrowInternal(err, "KEYPR-Mw3ut", "unable to parse vault public key")
+	}
+	return ed25519.PublicKey(key), nil
+}
+
+type vaultSigner struct {
+	vault     *Vault
+	keyID     string
+	version   int
+	publicKey crypto.PublicKey
+}
+
+func (s *vaultSigner) Public() crypto.PublicKey {
+	return s.publicKey
+}
+
+// Sign implements [crypto.Signer].
+// As the interface has no context, the request is bound by the configured timeout.
+func (s *vaultSigner) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	path := "sign/" + url.PathEscape(s.keyID)
+	body := map[string]any{
+		"input":       base64.StdEncoding.EncodeToString(digest),
+		"key_version": s.version,
+	}
+	if opts.HashFunc() != 0 {
+		hash, err := vaultHashAlgorithm(opts.HashFunc())
+		if err != nil {
+			return nil, err
+		}
+		path += "/" + hash
+		body["prehashed"] = true
+		body["marshaling_algorithm"] = "asn1"
+	}
+	if _, ok := s.publicKey.(*rsa.PublicKey); ok {
+		body["signature_algorithm"] = "pkcs1v15"
+		if pss, ok := opts.(*rsa.PSSOptions); ok {
+			body["signature_algorithm"] = "pss"
+			body["salt_length"] = "auto"
+			if pss.SaltLength == rsa.PSSSaltLengthEqualsHash {
+				body["salt_length"] = "hash"
+			}
+		}
+	}
+	var resp struct {
+		Signature string `json:"signature"`
+	}
+	if err := s.vault.do(context.Background(), http.MethodPost, path, body, &resp); err != nil {
+		return nil, err
+	}
+	// the signature is prefixed with the key version, e.g. vault:v1:
+	_, sig, ok := strings.Cut(strings.TrimPrefix(resp.Signature, vaultSignaturePrefix), ":")
+	if !ok {
+		return nil, zerrors.ThrowInternal(nil, "KEYPR-Gs5ol", "invalid signature returned by vault")
+	}
+	signature, err := base64.StdEncoding.DecodeString(sig)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "KEYPR-Gs5ol", "invalid signature returned by vault")
+	}
+	return signature, nil
+}
+
+func vaultHashAlgorithm(hash crypto.Hash) (string, error) {
+	switch hash {
+	case crypto.SHA256:
+		return "sha2-256", nil
+	case crypto.SHA384:
+		return "sha2-384", nil
+	case crypto.SHA512:
+		return "sha2-512", nil
+	default:
+		return "", zerrors.ThrowInvalidArgumentf(nil, "KEYPR-Tz4ic", "unsupported hash %s", hash)
+	}
+}
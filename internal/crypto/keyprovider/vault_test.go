@@ -0,0 +1,175 @@
+package keyprovider
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTransitServer mocks the transit secrets engine with the provided keys in version 1.
+func newTransitServer(t *testing.T, keys map[string]crypto.Signer) *httptest.Server {
+	writeData := func(w http.ResponseWriter, data any) {
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"data": data}))
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/transit/decrypt/{name}", func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Ciphertext string `json:"ciphertext"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if req.Ciphertext != "vault:v1:wrapped" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"errors":["cipher: message authentication failed"]}`))
+			return
+		}
+		writeData(w, map[string]string{"plaintext": base64.StdEncoding.EncodeToString([]byte("plaintext"))})
+	})
+	mux.HandleFunc("GET /v1/transit/keys/{name}", func(w http.ResponseWriter, r *http.Request) {
+		key, ok := keys[r.PathValue("name")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors":[]}`))
+			return
+		}
+		public, err := x509.MarshalPKIXPublicKey(key.Public())
+		require.NoError(t, err)
+		writeData(w, map[string]any{
+			"latest_version": 1,
+			"keys": map[string]any{
+				"1": map[string]string{"public_key": string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: public}))},
+			},
+		})
+	})
+	mux.HandleFunc("POST /v1/transit/sign/{name}/{hash}", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "token", r.Header.Get(vaultTokenHeader))
+		assert.Equal(t, "sha2-256", r.PathValue("hash"))
+		var req struct {
+			Input              string `json:"input"`
+			Prehashed          bool   `json:"prehashed"`
+			KeyVersion         int    `json:"key_version"`
+			SignatureAlgorithm string `json:"signature_algorithm"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.True(t, req.Prehashed)
+		assert.Equal(t, 1, req.KeyVersion)
+		digest, err := base64.StdEncoding.DecodeString(req.Input)
+		require.NoError(t, err)
+		var opts crypto.SignerOpts = crypto.SHA256
+		if req.SignatureAlgorithm == "pss" {
+			opts = &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: crypto.SHA256}
+		}
+		signature, err := keys[r.PathValue("name")].Sign(rand.Reader, digest, opts)
+		require.NoError(t, err)
+		writeData(w, map[string]string{"signature": "vault:v1:" + base64.StdEncoding.EncodeToString(signature)})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestVault_Decrypt(t *testing.T) {
+	server := newTransitServer(t, nil)
+	vault, err := NewVault(&VaultConfig{Address: server.URL, Token: "token"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		ciphertext string
+		want       []byte
+		wantErr    bool
+	}{
+		{
+			name:       "invalid ciphertext",
+			ciphertext: "vault:v1:invalid",
+			wantErr:    true,
+		},
+		{
+			name:       "ok",
+			ciphertext: "vault:v1:wrapped\n",
+			want:       []byte("plaintext"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := vault.Decrypt(context.Background(), "masterkey", tt.ciphertext)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestVault_Signer(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	server := newTransitServer(t, map[string]crypto.Signer{"rsa": rsaKey, "ecdsa": ecdsaKey})
+	vault, err := NewVault(&VaultConfig{Address: server.URL + "/", Token: "token"})
+	require.NoError(t, err)
+	digest := sha256.Sum256([]byte("message"))
+
+	tests := []struct {
+		name   string
+		keyID  string
+		opts   crypto.SignerOpts
+		verify func(t *testing.T, signature []byte)
+	}{
+		{
+			name:  "rsa pkcs1v15",
+			keyID: "rsa",
+			opts:  crypto.SHA256,
+			verify: func(t *testing.T, signature []byte) {
+				assert.NoError(t, rsa.VerifyPKCS1v15(&rsaKey.PublicKey, crypto.SHA256, digest[:], signature))
+			},
+		},
+		{
+			name:  "rsa pss",
+			keyID: "rsa",
+			opts:  &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: crypto.SHA256},
+			verify: func(t *testing.T, signature []byte) {
+				assert.NoError(t, rsa.VerifyPSS(&rsaKey.PublicKey, crypto.SHA256, digest[:], signature, nil))
+			},
+		},
+		{
+			name:  "ecdsa",
+			keyID: "ecdsa",
+			opts:  crypto.SHA256,
+			verify: func(t *testing.T, signature []byte) {
+				assert.True(t, ecdsa.VerifyASN1(&ecdsaKey.PublicKey, digest[:], signature))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signer, err := vault.Signer(context.Background(), tt.keyID)
+			require.NoError(t, err)
+			signature, err := signer.Sign(rand.Reader, digest[:], tt.opts)
+			require.NoError(t, err)
+			tt.verify(t, signature)
+		})
+	}
+
+	t.Run("unknown key", func(t *testing.T) {
+		_, err := vault.Signer(context.Background(), "unknown")
+		assert.Error(t, err)
+	})
+}
@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"strings"
+
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
 )
@@ -14,6 +16,10 @@ type APIApp struct {
 	EncodedHash        string
 	ClientSecretString string
 	AuthMethodType     APIAuthMethodType
+	// TLSClientAuthSubjectDN and TLSClientAuthSAN identify the certificate of an app
+	// using [APIAuthMethodTypeTLSClientAuth] (RFC 8705, section 2.1.2).
+	TLSClientAuthSubjectDN string
+	TLSClientAuthSAN       string
 
 	State AppState
 }
@@ -31,10 +37,30 @@ type APIAuthMethodType int32
 const (
 	APIAuthMethodTypeBasic APIAuthMethodType = iota
 	APIAuthMethodTypePrivateKeyJWT
+	// APIAuthMethodTypeTLSClientAuth authenticates the client by a certificate issued by a trusted CA (RFC 8705, section 2.1).
+	APIAuthMethodTypeTLSClientAuth
+	// APIAuthMethodTypeSelfSignedTLSClientAuth authenticates the client by a self-signed certificate
+	// of one of its registered keys (RFC 8705, section 2.2).
+	APIAuthMethodTypeSelfSignedTLSClientAuth
 )
 
+// UsesKeys reports whether the app authenticates with one of its registered keys,
+// either by a signed assertion or by a self-signed certificate.
+func (m APIAuthMethodType) UsesKeys() bool {
+	return m == APIAuthMethodTypePrivateKeyJWT || m == APIAuthMethodTypeSelfSignedTLSClientAuth
+}
+
 func (a *APIApp) IsValid() bool {
-	return a.AppName != ""
+	return a.AppName != "" && a.TLSClientAuthConfigValid()
+}
+
+// TLSClientAuthConfigValid checks that the certificate of an app using [APIAuthMethodTypeTLSClientAuth]
+// is identified by its subject DN or a SAN.
+func (a *APIApp) TLSClientAuthConfigValid() bool {
+	if a.AuthMethodType != APIAuthMethodTypeTLSClientAuth {
+		return true
+	}
+	return strings.TrimSpace(a.TLSClientAuthSubjectDN) != "" || strings.TrimSpace(a.TLSClientAuthSAN) != ""
 }
 
 func (a *APIApp) setClientID(clientID string) {
@@ -50,7 +76,7 @@ func (a *APIApp) requiresClientSecret() bool {
 }
 
 func (a *APIApp) GenerateClientSecretIfNeeded(generator *crypto.HashGenerator) (plain string, err error) {
-	if a.AuthMethodType == APIAuthMethodTypePrivateKeyJWT {
+	if !a.requiresClientSecret() {
 		return "", nil
 	}
 	a.EncodedHash, plain, err = generator.NewCode()
@@ -59,6 +59,20 @@ type OIDCApp struct {
 	// passkey trust fields. Package name is required when fingerprints are non-empty.
 	AndroidPackageName            *string
 	AndroidSHA256CertFingerprints []string
+	// DPoPRequired rejects token requests of the app without a DPoP proof (RFC 9449).
+	DPoPRequired *bool
+	// PARRequired rejects authorization requests of the app which were not pushed (RFC 9126).
+	PARRequired *bool
+	// TLSClientAuthSubjectDN and TLSClientAuthSAN identify the certificate of an app
+	// using [OIDCAuthMethodTypeTLSClientAuth] (RFC 8705, section 2.1.2).
+	TLSClientAuthSubjectDN *string
+	TLSClientAuthSAN       *string
+	// BackChannelClientNotificationURI is the endpoint the app is notified on about completed
+	// backchannel authentication requests (CIBA ping mode). Without it, the app has to poll.
+	BackChannelClientNotificationURI *string
+	// ConsentRequired requires the user to consent to the requested scopes of the app,
+	// before any token is issued. Dynamically registered apps always require the consent.
+	ConsentRequired *bool
 
 	State AppState
 }
@@ -116,6 +130,8 @@ const (
 	OIDCGrantTypeRefreshToken
 	OIDCGrantTypeDeviceCode
 	OIDCGrantTypeTokenExchange
+	// OIDCGrantTypeCIBA is the grant of the OpenID Client-Initiated Backchannel Authentication flow.
+	OIDCGrantTypeCIBA
 )
 
 type OIDCApplicationType int32
@@ -133,8 +149,19 @@ const (
 	OIDCAuthMethodTypePost
 	OIDCAuthMethodTypeNone
 	OIDCAuthMethodTypePrivateKeyJWT
+	// OIDCAuthMethodTypeTLSClientAuth authenticates the client by a certificate issued by a trusted CA (RFC 8705, section 2.1).
+	OIDCAuthMethodTypeTLSClientAuth
+	// OIDCAuthMethodTypeSelfSignedTLSClientAuth authenticates the client by a self-signed certificate
+	// of one of its registered keys (RFC 8705, section 2.2).
+	OIDCAuthMethodTypeSelfSignedTLSClientAuth
 )
 
+// UsesKeys reports whether the app authenticates with one of its registered keys,
+// either by a signed assertion or by a self-signed certificate.
+func (m OIDCAuthMethodType) UsesKeys() bool {
+	return m == OIDCAuthMethodTypePrivateKeyJWT || m == OIDCAuthMethodTypeSelfSignedTLSClientAuth
+}
+
 type Compliance struct {
 	NoneCompliant bool
 	Problems      []string
@@ -148,7 +175,7 @@ const (
 )
 
 func (a *OIDCApp) IsValid() bool {
-	if (a.ClockSkew != nil && (*a.ClockSkew > time.Second*5 || *a.ClockSkew < time.Second*0)) || !a.OriginsValid() || !a.AppLinkConfigValid() {
+	if (a.ClockSkew != nil && (*a.ClockSkew > time.Second*5 || *a.ClockSkew < time.Second*0)) || !a.OriginsValid() || !a.AppLinkConfigValid() || !a.TLSClientAuthConfigValid() || !a.BackChannelClientNotificationURIValid() {
 		return false
 	}
 	grantTypes := a.getRequiredGrantTypes()
@@ -177,6 +204,29 @@ func (a *OIDCApp) AppLinkConfigValid() bool {
 	return len(a.AndroidSHA256CertFingerprints) == 0 || packageName != ""
 }
 
+// TLSClientAuthConfigValid checks that the certificate of an app using [OIDCAuthMethodTypeTLSClientAuth]
+// is identified by its subject DN or a SAN.
+func (a *OIDCApp) TLSClientAuthConfigValid() bool {
+	if gu.Value(a.AuthMethodType) != OIDCAuthMethodTypeTLSClientAuth {
+		return true
+	}
+	return strings.TrimSpace(gu.Value(a.TLSClientAuthSubjectDN)) != "" || strings.TrimSpace(gu.Value(a.TLSClientAuthSAN)) != ""
+}
+
+// BackChannelClientNotificationURIValid checks that the notification endpoint of the app
+// is an absolute https URL, as required by CIBA (section 4). Apps in dev mode may use http.
+func (a *OIDCApp) BackChannelClientNotificationURIValid() bool {
+	notificationURI := strings.TrimSpace(gu.Value(a.BackChannelClientNotificationURI))
+	if notificationURI == "" {
+		return true
+	}
+	parsed, err := url.Parse(notificationURI)
+	if err != nil || parsed.Host == "" {
+		return false
+	}
+	return parsed.Scheme == "https" || (parsed.Scheme == "http" && gu.Value(a.DevMode))
+}
+
 func (a *OIDCApp) OriginsValid() bool {
 	for _, origin := range a.AdditionalOrigins {
 		if !http_util.IsOrigin(strings.TrimSpace(origin)) {
@@ -197,11 +247,14 @@ func RequiredOIDCGrantTypes(responseTypes []OIDCResponseType, grantTypesSet []OI
 	for _, r := range responseTypes {
 		switch r {
 		case OIDCResponseTypeCode:
-			// #5684 when "Device Code" is selected, "Authorization Code" is no longer a hard requirement
-			if !containsOIDCGrantType(grantTypesSet, OIDCGrantTypeDeviceCode) {
-				grantTypes = append(grantTypes, OIDCGrantTypeAuthorizationCode)
-			} else {
+			// #5684 when "Device Code" or "CIBA" is selected, "Authorization Code" is no longer a hard requirement
+			switch {
+			case containsOIDCGrantType(grantTypesSet, OIDCGrantTypeDeviceCode):
 				grantTypes = append(grantTypes, OIDCGrantTypeDeviceCode)
+			case containsOIDCGrantType(grantTypesSet, OIDCGrantTypeCIBA):
+				grantTypes = append(grantTypes, OIDCGrantTypeCIBA)
+			default:
+				grantTypes = append(grantTypes, OIDCGrantTypeAuthorizationCode)
 			}
 		case OIDCResponseTypeIDToken, OIDCResponseTypeIDTokenToken:
 			if !implicit {
@@ -260,7 +313,7 @@ func GetOIDCV1Compliance(appType *OIDCApplicationType, grantTypes []OIDCGrantTyp
 }
 
 func checkGrantTypesCombination(compliance *Compliance, grantTypes []OIDCGrantType) {
-	if !containsOIDCGrantType(grantTypes, OIDCGrantTypeDeviceCode) && containsOIDCGrantType(grantTypes, OIDCGrantTypeRefreshToken) && !containsOIDCGrantType(grantTypes, OIDCGrantTypeAuthorizationCode) {
+	if !containsRedirectlessGrantType(grantTypes) && containsOIDCGrantType(grantTypes, OIDCGrantTypeRefreshToken) && !containsOIDCGrantType(grantTypes, OIDCGrantTypeAuthorizationCode) {
 		compliance.NoneCompliant = true
 		compliance.Problems = append(compliance.Problems, "Application.OIDC.V1.GrantType.Refresh.NoAuthCode")
 	}
@@ -268,7 +321,7 @@ func checkGrantTypesCombination(compliance *Compliance, grantTypes []OIDCGrantTy
 
 func checkRedirectURIs(compliance *Compliance, grantTypes []OIDCGrantType, appType *OIDCApplicationType, redirectUris []string) {
 	// See #5684 for OIDCGrantTypeDeviceCode and redirectUris further explanation
-	if len(redirectUris) == 0 && (!containsOIDCGrantType(grantTypes, OIDCGrantTypeDeviceCode) || (containsOIDCGrantType(grantTypes, OIDCGrantTypeDeviceCode) && containsOIDCGrantType(grantTypes, OIDCGrantTypeAuthorizationCode))) {
+	if len(redirectUris) == 0 && (!containsRedirectlessGrantType(grantTypes) || containsOIDCGrantType(grantTypes, OIDCGrantTypeAuthorizationCode)) {
 		compliance.NoneCompliant = true
 		compliance.Problems = append([]string{"Application.OIDC.V1.NoRedirectUris"}, compliance.Problems...)
 	}
@@ -285,6 +338,12 @@ func checkRedirectURIs(compliance *Compliance, grantTypes []OIDCGrantType, appTy
 	}
 }
 
+// containsRedirectlessGrantType reports whether the grant types contain a grant,
+// where the user is authenticated on another device, so no redirect is involved.
+func containsRedirectlessGrantType(grantTypes []OIDCGrantType) bool {
+	return containsOIDCGrantType(grantTypes, OIDCGrantTypeDeviceCode) || containsOIDCGrantType(grantTypes, OIDCGrantTypeCIBA)
+}
+
 func checkApplicationType(compliance *Compliance, appType *OIDCApplicationType, authMethod *OIDCAuthMethodType) {
 	if appType != nil {
 		switch *appType {
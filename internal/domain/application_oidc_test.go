@@ -243,6 +243,11 @@ func Test_checkGrantTypesCombination(t *testing.T) {
 			want:       &Compliance{},
 			grantTypes: []OIDCGrantType{OIDCGrantTypeDeviceCode, OIDCGrantTypeRefreshToken},
 		},
+		{
+			name:       "ciba and refresh token doesnt require OIDCGrantTypeAuthorizationCode",
+			want:       &Compliance{},
+			grantTypes: []OIDCGrantType{OIDCGrantTypeCIBA, OIDCGrantTypeRefreshToken},
+		},
 		{
 			name:       "refresh token and authorization code",
 			want:       &Compliance{},
@@ -286,6 +291,25 @@ func Test_checkRedirectURIs(t *testing.T) {
 			},
 			args: args{},
 		},
+		{
+			name: "no redirect uris with ciba",
+			want: &Compliance{},
+			args: args{
+				grantTypes: []OIDCGrantType{OIDCGrantTypeCIBA},
+			},
+		},
+		{
+			name: "no redirect uris with ciba and authorization code",
+			want: &Compliance{
+				NoneCompliant: true,
+				Problems: []string{
+					"Application.OIDC.V1.NoRedirectUris",
+				},
+			},
+			args: args{
+				grantTypes: []OIDCGrantType{OIDCGrantTypeCIBA, OIDCGrantTypeAuthorizationCode},
+			},
+		},
 		{
 			name: "implicit and authorization code",
 			want: &Compliance{
@@ -750,3 +774,107 @@ func TestOIDCApp_AppLinkConfigValid(t *testing.T) {
 		})
 	}
 }
+
+func TestOIDCApp_TLSClientAuthConfigValid(t *testing.T) {
+	tests := []struct {
+		name string
+		app  *OIDCApp
+		want bool
+	}{
+		{
+			name: "other auth method",
+			app: &OIDCApp{
+				AuthMethodType: gu.Ptr(OIDCAuthMethodTypeBasic),
+			},
+			want: true,
+		},
+		{
+			name: "self signed without subject",
+			app: &OIDCApp{
+				AuthMethodType: gu.Ptr(OIDCAuthMethodTypeSelfSignedTLSClientAuth),
+			},
+			want: true,
+		},
+		{
+			name: "tls client auth without subject",
+			app: &OIDCApp{
+				AuthMethodType:         gu.Ptr(OIDCAuthMethodTypeTLSClientAuth),
+				TLSClientAuthSubjectDN: gu.Ptr(" "),
+			},
+			want: false,
+		},
+		{
+			name: "tls client auth with subject dn",
+			app: &OIDCApp{
+				AuthMethodType:         gu.Ptr(OIDCAuthMethodTypeTLSClientAuth),
+				TLSClientAuthSubjectDN: gu.Ptr("CN=client,O=ZITADEL"),
+			},
+			want: true,
+		},
+		{
+			name: "tls client auth with san",
+			app: &OIDCApp{
+				AuthMethodType:   gu.Ptr(OIDCAuthMethodTypeTLSClientAuth),
+				TLSClientAuthSAN: gu.Ptr("client.example.com"),
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.app.TLSClientAuthConfigValid(); got != tt.want {
+				t.Errorf("TLSClientAuthConfigValid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOIDCApp_BackChannelClientNotificationURIValid(t *testing.T) {
+	tests := []struct {
+		name string
+		app  *OIDCApp
+		want bool
+	}{
+		{
+			name: "not set",
+			app:  &OIDCApp{},
+			want: true,
+		},
+		{
+			name: "https",
+			app: &OIDCApp{
+				BackChannelClientNotificationURI: gu.Ptr("https://client.example.com/ciba"),
+			},
+			want: true,
+		},
+		{
+			name: "http",
+			app: &OIDCApp{
+				BackChannelClientNotificationURI: gu.Ptr("http://client.example.com/ciba"),
+			},
+			want: false,
+		},
+		{
+			name: "http in dev mode",
+			app: &OIDCApp{
+				BackChannelClientNotificationURI: gu.Ptr("http://localhost:8080/ciba"),
+				DevMode:                          gu.Ptr(true),
+			},
+			want: true,
+		},
+		{
+			name: "relative",
+			app: &OIDCApp{
+				BackChannelClientNotificationURI: gu.Ptr("/ciba"),
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.app.BackChannelClientNotificationURIValid(); got != tt.want {
+				t.Errorf("BackChannelClientNotificationURIValid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
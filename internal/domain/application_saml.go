@@ -1,6 +1,12 @@
 package domain
 
 import (
+	"crypto/x509"
+
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/zitadel/saml/pkg/provider/signature"
+	"github.com/zitadel/saml/pkg/provider/xml/md"
+
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
 )
 
@@ -14,6 +20,18 @@ type SAMLApp struct {
 	MetadataURL  *string
 	LoginVersion *LoginVersion
 	LoginBaseURI *string
+	// IDPInitiatedEnabled allows to start a login at the launch URL of the app (IdP-initiated SSO).
+	// After the login, an unsolicited response is posted to the assertion consumer service of the service provider.
+	IDPInitiatedEnabled *bool
+	// IDPInitiatedRelayState is sent as RelayState with unsolicited responses,
+	// e.g. the page the service provider should open after the login.
+	IDPInitiatedRelayState *string
+	// EncryptAssertion encrypts the assertion with the encryption certificate from the metadata of the service provider.
+	EncryptAssertion *bool
+	// NameIDFormat of the subject, defaults to [SAMLNameIDFormatEmailAddress] if not set.
+	NameIDFormat       *SAMLNameIDFormat
+	NameIDSource       *SAMLNameIDSource
+	SignatureAlgorithm *SAMLSignatureAlgorithm
 
 	State AppState
 }
@@ -43,3 +61,64 @@ func (a *SAMLApp) IsValid() bool {
 	}
 	return true
 }
+
+// SAMLNameIDSource defines the user attribute used as value of the NameID.
+type SAMLNameIDSource int32
+
+const (
+	// SAMLNameIDSourceLoginName is the default for backwards compatibility.
+	SAMLNameIDSourceLoginName SAMLNameIDSource = iota
+	SAMLNameIDSourceEmail
+	SAMLNameIDSourceUserID
+)
+
+type SAMLSignatureAlgorithm int32
+
+const (
+	// SAMLSignatureAlgorithmUnspecified uses the signature algorithm of the SAML provider configuration.
+	SAMLSignatureAlgorithmUnspecified SAMLSignatureAlgorithm = iota
+	SAMLSignatureAlgorithmRSASHA1
+	SAMLSignatureAlgorithmRSASHA256
+	SAMLSignatureAlgorithmRSASHA512
+)
+
+// URI returns the identifier of the signature method or an empty string if unspecified.
+func (a SAMLSignatureAlgorithm) URI() string {
+	switch a {
+	case SAMLSignatureAlgorithmRSASHA1:
+		return dsig.RSASHA1SignatureMethod
+	case SAMLSignatureAlgorithmRSASHA256:
+		return dsig.RSASHA256SignatureMethod
+	case SAMLSignatureAlgorithmRSASHA512:
+		return dsig.RSASHA512SignatureMethod
+	case SAMLSignatureAlgorithmUnspecified:
+		fallthrough
+	default:
+		return ""
+	}
+}
+
+// SAMLEncryptionCertificate returns the first certificate of the service provider which can be used for encryption,
+// which are the key descriptors with use "encryption" or without a use.
+// It returns nil if the metadata does not contain such a certificate.
+func SAMLEncryptionCertificate(metadata *md.EntityDescriptorType) (*x509.Certificate, error) {
+	if metadata == nil || metadata.SPSSODescriptor == nil {
+		return nil, nil
+	}
+	for _, keyDescriptor := range metadata.SPSSODescriptor.KeyDescriptor {
+		if keyDescriptor.Use != "" && keyDescriptor.Use != md.KeyTypesEncryption {
+			continue
+		}
+		for _, x509Data := range keyDescriptor.KeyInfo.X509Data {
+			if x509Data.X509Certificate == "" {
+				continue
+			}
+			certs, err := signature.ParseCertificates([]string{x509Data.X509Certificate})
+			if err != nil {
+				return nil, err
+			}
+			return certs[0], nil
+		}
+	}
+	return nil, nil
+}
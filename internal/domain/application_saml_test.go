@@ -0,0 +1,130 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/saml/pkg/provider/xml/md"
+	"github.com/zitadel/saml/pkg/provider/xml/xml_dsig"
+)
+
+func TestSAMLEncryptionCertificate(t *testing.T) {
+	signingCert := createSAMLTestCertificate(t, "signing")
+	encryptionCert := createSAMLTestCertificate(t, "encryption")
+	keyDescriptor := func(use md.KeyTypes, cert []byte) md.KeyDescriptorType {
+		return md.KeyDescriptorType{
+			Use: use,
+			KeyInfo: xml_dsig.KeyInfoType{
+				X509Data: []xml_dsig.X509DataType{{X509Certificate: base64.StdEncoding.EncodeToString(cert)}},
+			},
+		}
+	}
+	tests := []struct {
+		name     string
+		metadata *md.EntityDescriptorType
+		want     []byte
+		wantErr  bool
+	}{
+		{
+			name:     "no metadata",
+			metadata: nil,
+		},
+		{
+			name:     "no sp descriptor",
+			metadata: &md.EntityDescriptorType{},
+		},
+		{
+			name: "signing only",
+			metadata: &md.EntityDescriptorType{
+				SPSSODescriptor: &md.SPSSODescriptorType{
+					KeyDescriptor: []md.KeyDescriptorType{keyDescriptor("signing", signingCert)},
+				},
+			},
+		},
+		{
+			name: "encryption",
+			metadata: &md.EntityDescriptorType{
+				SPSSODescriptor: &md.SPSSODescriptorType{
+					KeyDescriptor: []md.KeyDescriptorType{
+						keyDescriptor("signing", signingCert),
+						keyDescriptor("encryption", encryptionCert),
+					},
+				},
+			},
+			want: encryptionCert,
+		},
+		{
+			name: "without use",
+			metadata: &md.EntityDescriptorType{
+				SPSSODescriptor: &md.SPSSODescriptorType{
+					KeyDescriptor: []md.KeyDescriptorType{keyDescriptor("", signingCert)},
+				},
+			},
+			want: signingCert,
+		},
+		{
+			name: "invalid certificate",
+			metadata: &md.EntityDescriptorType{
+				SPSSODescriptor: &md.SPSSODescriptorType{
+					KeyDescriptor: []md.KeyDescriptorType{keyDescriptor("encryption", []byte("invalid"))},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SAMLEncryptionCertificate(tt.metadata)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if tt.want == nil {
+				assert.Nil(t, got)
+				return
+			}
+			require.NotNil(t, got)
+			assert.Equal(t, tt.want, got.Raw)
+		})
+	}
+}
+
+func TestSAMLSignatureAlgorithm_URI(t *testing.T) {
+	tests := []struct {
+		algorithm SAMLSignatureAlgorithm
+		want      string
+	}{
+		{SAMLSignatureAlgorithmUnspecified, ""},
+		{SAMLSignatureAlgorithmRSASHA1, "http://www.w3.org/2000/09/xmldsig#rsa-sha1"},
+		{SAMLSignatureAlgorithmRSASHA256, "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"},
+		{SAMLSignatureAlgorithmRSASHA512, "http://www.w3.org/2001/04/xmldsig-more#rsa-sha512"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.algorithm.URI())
+		})
+	}
+}
+
+func createSAMLTestCertificate(t *testing.T, commonName string) []byte {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return cert
+}
@@ -62,6 +62,8 @@ type AuthRequest struct {
 	OrgTranslations          []*CustomText
 	SAMLRequestID            string
 	RequestLocalAuth         bool
+	// ConsentRequired is set for clients which need the user to consent to the requested scopes
+	ConsentRequired bool
 	// orgID the policies were last loaded with
 	policyOrgID string
 	// SessionID is set to the computed sessionID of the login session table
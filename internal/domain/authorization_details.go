@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AuthorizationDetail is a single object of the authorization_details parameter
+// of Rich Authorization Requests (RFC 9396).
+// Besides the required type, its fields are defined by the API registering the type.
+type AuthorizationDetail map[string]any
+
+// Type returns the type of the authorization detail, which is used to validate it against
+// the types registered on the project.
+func (d AuthorizationDetail) Type() string {
+	t, _ := d["type"].(string)
+	return t
+}
+
+// AuthorizationDetails is the list of authorization details requested by a client (RFC 9396).
+type AuthorizationDetails []AuthorizationDetail
+
+// ParseAuthorizationDetails parses the JSON encoded authorization_details parameter.
+// An empty value returns nil.
+func ParseAuthorizationDetails(value string) (AuthorizationDetails, error) {
+	if value == "" {
+		return nil, nil
+	}
+	var details AuthorizationDetails
+	if err := json.Unmarshal([]byte(value), &details); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "DOMAIN-Rar1p", "Errors.AuthorizationDetails.Invalid")
+	}
+	for _, detail := range details {
+		if detail.Type() == "" {
+			return nil, zerrors.ThrowInvalidArgument(nil, "DOMAIN-Rar2t", "Errors.AuthorizationDetails.Invalid")
+		}
+	}
+	return details, nil
+}
+
+// Types returns the distinct types of the authorization details.
+func (d AuthorizationDetails) Types() []string {
+	types := make([]string, 0, len(d))
+	for _, detail := range d {
+		if !slices.Contains(types, detail.Type()) {
+			types = append(types, detail.Type())
+		}
+	}
+	return types
+}
+
+// UnsupportedType returns the first type of the authorization details which is not part of the allowed types,
+// or an empty string if all of them are allowed.
+func (d AuthorizationDetails) UnsupportedType(allowed []string) string {
+	for _, detail := range d {
+		if !slices.Contains(allowed, detail.Type()) {
+			return detail.Type()
+		}
+	}
+	return ""
+}
+
+// Contains checks if every requested authorization detail is part of the granted ones (d),
+// which allows clients to narrow down the authorization details on the token request (RFC 9396, section 6.1).
+func (d AuthorizationDetails) Contains(requested AuthorizationDetails) bool {
+	for _, detail := range requested {
+		if !slices.ContainsFunc(d, func(granted AuthorizationDetail) bool {
+			return reflect.DeepEqual(granted, detail)
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// AuthorizationDetailTypeValid checks the type a project registers for authorization details.
+func AuthorizationDetailTypeValid(detailType string) bool {
+	return detailType != "" && len(detailType) <= 200 && strings.TrimSpace(detailType) == detailType
+}
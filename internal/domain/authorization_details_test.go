@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAuthorizationDetails(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    AuthorizationDetails
+		wantErr bool
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:    "invalid json",
+			value:   `{"type":"payment_initiation"}`,
+			wantErr: true,
+		},
+		{
+			name:    "missing type",
+			value:   `[{"actions":["initiate"]}]`,
+			wantErr: true,
+		},
+		{
+			name:    "type not a string",
+			value:   `[{"type":1}]`,
+			wantErr: true,
+		},
+		{
+			name:  "valid",
+			value: `[{"type":"payment_initiation","instructedAmount":{"currency":"EUR","amount":"200.00"},"creditorAccount":{"iban":"DE02100100109307118603"}}]`,
+			want: AuthorizationDetails{
+				{
+					"type":             "payment_initiation",
+					"instructedAmount": map[string]any{"currency": "EUR", "amount": "200.00"},
+					"creditorAccount":  map[string]any{"iban": "DE02100100109307118603"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAuthorizationDetails(tt.value)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAuthorizationDetails_UnsupportedType(t *testing.T) {
+	details := AuthorizationDetails{
+		{"type": "payment_initiation"},
+		{"type": "account_information"},
+		{"type": "payment_initiation"},
+	}
+	assert.Equal(t, []string{"payment_initiation", "account_information"}, details.Types())
+	assert.Equal(t, "", details.UnsupportedType([]string{"account_information", "payment_initiation"}))
+	assert.Equal(t, "account_information", details.UnsupportedType([]string{"payment_initiation"}))
+	assert.Equal(t, "", AuthorizationDetails(nil).UnsupportedType(nil))
+}
+
+func TestAuthorizationDetails_Contains(t *testing.T) {
+	granted, err := ParseAuthorizationDetails(`[{"type":"payment_initiation","amount":"200.00"},{"type":"account_information","accounts":["DE02"]}]`)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		requested string
+		want      bool
+	}{
+		{
+			name: "none requested",
+			want: true,
+		},
+		{
+			name:      "subset",
+			requested: `[{"type":"account_information","accounts":["DE02"]}]`,
+			want:      true,
+		},
+		{
+			name:      "all",
+			requested: `[{"accounts":["DE02"],"type":"account_information"},{"amount":"200.00","type":"payment_initiation"}]`,
+			want:      true,
+		},
+		{
+			name:      "changed",
+			requested: `[{"type":"payment_initiation","amount":"2000.00"}]`,
+			want:      false,
+		},
+		{
+			name:      "other type",
+			requested: `[{"type":"other"}]`,
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requested, err := ParseAuthorizationDetails(tt.requested)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, granted.Contains(requested))
+		})
+	}
+}
+
+func TestAuthorizationDetailTypeValid(t *testing.T) {
+	assert.True(t, AuthorizationDetailTypeValid("payment_initiation"))
+	assert.True(t, AuthorizationDetailTypeValid("https://api.example.com/payment"))
+	assert.False(t, AuthorizationDetailTypeValid(""))
+	assert.False(t, AuthorizationDetailTypeValid(" payment"))
+}
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"strconv"
+)
+
+// CIBARequestState describes the step the
+// backchannel authentication request is in.
+// We generate the Stringer implementation for prettier
+// log output.
+//
+//go:generate stringer -type=CIBARequestState -linecomment
+type CIBARequestState uint
+
+const (
+	CIBARequestStateUndefined CIBARequestState = iota // undefined
+	CIBARequestStateInitiated                         // initiated
+	CIBARequestStateApproved                          // approved
+	CIBARequestStateDenied                            // denied
+	CIBARequestStateExpired                           // expired
+	CIBARequestStateDone                              // done
+
+	cibaRequestStateCount // invalid
+)
+
+// Exists returns true when not Undefined and
+// any status lower than cibaRequestStateCount.
+func (s CIBARequestState) Exists() bool {
+	return s > CIBARequestStateUndefined && s < cibaRequestStateCount
+}
+
+func (s CIBARequestState) GoString() string {
+	return strconv.Itoa(int(s))
+}
+
+// CIBARequestCanceled is a subset of CIBARequestState, allowed to
+// be used in the cibarequest.CanceledEvent.
+// The string type is used to make the eventstore more readable
+// on the reason of cancelation.
+type CIBARequestCanceled string
+
+const (
+	CIBARequestCanceledDenied  = "denied"
+	CIBARequestCanceledExpired = "expired"
+)
+
+func (c CIBARequestCanceled) State() CIBARequestState {
+	switch c {
+	case CIBARequestCanceledDenied:
+		return CIBARequestStateDenied
+	case CIBARequestCanceledExpired:
+		return CIBARequestStateExpired
+	default:
+		return CIBARequestStateUndefined
+	}
+}
@@ -0,0 +1,30 @@
+package cibapoll
+
+import "time"
+
+type Index int
+
+const (
+	IndexUnspecified Index = iota
+	IndexAuthReqID
+)
+
+// Poll is the last token request of a client for a backchannel authentication request,
+// used to enforce the poll interval (OpenID CIBA Core, section 11).
+type Poll struct {
+	InstanceID string
+	AuthReqID  string
+	PolledAt   time.Time
+}
+
+// Keys implements cache.Entry
+func (p *Poll) Keys(i Index) []string {
+	if i == IndexAuthReqID {
+		return []string{Key(p.InstanceID, p.AuthReqID)}
+	}
+	return nil
+}
+
+func Key(instanceID, authReqID string) string {
+	return instanceID + "-" + authReqID
+}
@@ -43,6 +43,21 @@ type Email struct {
 	PlainCode *string
 }
 
+// EmailUndeliverableReason describes why the mail provider reported an email address as undeliverable.
+type EmailUndeliverableReason int32
+
+const (
+	EmailUndeliverableReasonUnspecified EmailUndeliverableReason = iota
+	// EmailUndeliverableReasonBounce is a permanent (hard) bounce of the address.
+	EmailUndeliverableReasonBounce
+	// EmailUndeliverableReasonComplaint is a spam complaint of the recipient.
+	EmailUndeliverableReasonComplaint
+)
+
+func (r EmailUndeliverableReason) Valid() bool {
+	return r > EmailUndeliverableReasonUnspecified && r <= EmailUndeliverableReasonComplaint
+}
+
 type EmailCode struct {
 	es_models.ObjectRoot
 
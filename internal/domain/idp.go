@@ -147,3 +147,18 @@ const (
 	SAMLNameIDFormatPersistent
 	SAMLNameIDFormatTransient
 )
+
+func (f SAMLNameIDFormat) URI() string {
+	switch f {
+	case SAMLNameIDFormatUnspecified:
+		return "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified"
+	case SAMLNameIDFormatEmailAddress:
+		return "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+	case SAMLNameIDFormatPersistent:
+		return "urn:oasis:names:tc:SAML:2.0:nameid-format:persistent"
+	case SAMLNameIDFormatTransient:
+		return "urn:oasis:names:tc:SAML:2.0:nameid-format:transient"
+	default:
+		return ""
+	}
+}
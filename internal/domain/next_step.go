@@ -30,6 +30,7 @@ const (
 	NextStepRedirectToExternalIDP
 	NextStepLoginSucceeded
 	NextStepVerifyInvite
+	NextStepConsent
 )
 
 type LoginStep struct{}
@@ -198,3 +199,11 @@ type VerifyInviteStep struct{}
 func (s *VerifyInviteStep) Type() NextStepType {
 	return NextStepVerifyInvite
 }
+
+type ConsentStep struct {
+	Scope []string
+}
+
+func (s *ConsentStep) Type() NextStepType {
+	return NextStepConsent
+}
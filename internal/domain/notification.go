@@ -36,6 +36,22 @@ const (
 	notificationProviderTypeCount
 )
 
+// NotificationDeliveryState is the state of a notification after its last sending attempt.
+type NotificationDeliveryState int32
+
+const (
+	NotificationDeliveryStateUnspecified NotificationDeliveryState = iota
+	NotificationDeliveryStateDelivered
+	NotificationDeliveryStateRetrying
+	NotificationDeliveryStateFailed
+
+	notificationDeliveryStateCount
+)
+
+func (s NotificationDeliveryState) Valid() bool {
+	return s >= 0 && s < notificationDeliveryStateCount
+}
+
 type NotificationArguments struct {
 	Origin          string        `json:"origin,omitempty"`
 	Domain          string        `json:"domain,omitempty"`
@@ -22,6 +22,8 @@ type PasswordComplexityPolicy struct {
 	HasUppercase bool
 	HasNumber    bool
 	HasSymbol    bool
+	// CheckBreached defines if passwords are checked against known breached passwords.
+	CheckBreached bool
 
 	Default bool
 }
@@ -1,9 +1,14 @@
 package domain
 
 import (
+	"time"
+
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
 )
 
+// maxFailedAttemptDelay caps the exponentially growing delay between failed attempts.
+const maxFailedAttemptDelay = time.Hour
+
 type LockoutPolicy struct {
 	models.ObjectRoot
 
@@ -11,4 +16,40 @@ type LockoutPolicy struct {
 	MaxPasswordAttempts uint64
 	MaxOTPAttempts      uint64
 	ShowLockOutFailures bool
+	// LockoutDuration defines after how long a locked user is automatically unlocked.
+	// If zero, the user stays locked until unlocked by an administrator.
+	LockoutDuration time.Duration
+	// FailedAttemptDelay is the initial delay required after a failed attempt before the next one is allowed.
+	// The delay is doubled with every further failed attempt. If zero, no delay is enforced.
+	FailedAttemptDelay time.Duration
+}
+
+// LockedUntil returns the time a user locked at the provided time is automatically unlocked
+// or nil if the lock does not expire.
+func (p *LockoutPolicy) LockedUntil(lockedAt time.Time) *time.Time {
+	if p == nil || p.LockoutDuration <= 0 {
+		return nil
+	}
+	until := lockedAt.Add(p.LockoutDuration)
+	return &until
+}
+
+// DelayAfterFailedAttempts returns the delay required after the provided number of consecutive failed attempts.
+func (p *LockoutPolicy) DelayAfterFailedAttempts(failedAttempts uint64) time.Duration {
+	if p == nil || p.FailedAttemptDelay <= 0 || failedAttempts == 0 {
+		return 0
+	}
+	delay := p.FailedAttemptDelay
+	for i := uint64(1); i < failedAttempts; i++ {
+		delay *= 2
+		if delay >= maxFailedAttemptDelay {
+			return maxFailedAttemptDelay
+		}
+	}
+	return min(delay, maxFailedAttemptDelay)
+}
+
+// IsLockExpired returns true if the lock of a user ended at the provided time.
+func IsLockExpired(lockedUntil *time.Time, now time.Time) bool {
+	return lockedUntil != nil && !now.Before(*lockedUntil)
 }
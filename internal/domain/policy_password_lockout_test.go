@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLockoutPolicy_DelayAfterFailedAttempts(t *testing.T) {
+	tests := []struct {
+		name           string
+		policy         *LockoutPolicy
+		failedAttempts uint64
+		want           time.Duration
+	}{
+		{
+			"no policy, no delay",
+			nil,
+			3,
+			0,
+		},
+		{
+			"delay disabled, no delay",
+			&LockoutPolicy{},
+			3,
+			0,
+		},
+		{
+			"no failed attempts, no delay",
+			&LockoutPolicy{FailedAttemptDelay: time.Second},
+			0,
+			0,
+		},
+		{
+			"first failed attempt, initial delay",
+			&LockoutPolicy{FailedAttemptDelay: time.Second},
+			1,
+			time.Second,
+		},
+		{
+			"third failed attempt, doubled twice",
+			&LockoutPolicy{FailedAttemptDelay: time.Second},
+			3,
+			4 * time.Second,
+		},
+		{
+			"many failed attempts, capped",
+			&LockoutPolicy{FailedAttemptDelay: time.Second},
+			100,
+			time.Hour,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.policy.DelayAfterFailedAttempts(tt.failedAttempts))
+		})
+	}
+}
+
+func TestLockoutPolicy_LockedUntil(t *testing.T) {
+	lockedAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	until := lockedAt.Add(15 * time.Minute)
+	tests := []struct {
+		name   string
+		policy *LockoutPolicy
+		want   *time.Time
+	}{
+		{
+			"no policy, no expiry",
+			nil,
+			nil,
+		},
+		{
+			"no duration, no expiry",
+			&LockoutPolicy{},
+			nil,
+		},
+		{
+			"duration, expiry",
+			&LockoutPolicy{LockoutDuration: 15 * time.Minute},
+			&until,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.policy.LockedUntil(lockedAt))
+		})
+	}
+}
+
+func TestIsLockExpired(t *testing.T) {
+	now := time.Now()
+	assert.False(t, IsLockExpired(nil, now))
+	assert.False(t, IsLockExpired(gu.Ptr(now.Add(time.Minute)), now))
+	assert.True(t, IsLockExpired(gu.Ptr(now), now))
+	assert.True(t, IsLockExpired(gu.Ptr(now.Add(-time.Minute)), now))
+}
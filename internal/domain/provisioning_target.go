@@ -0,0 +1,27 @@
+package domain
+
+type ProvisioningTargetState int32
+
+const (
+	ProvisioningTargetUnspecified ProvisioningTargetState = iota
+	ProvisioningTargetActive
+	ProvisioningTargetRemoved
+	provisioningTargetStateCount
+)
+
+func (s ProvisioningTargetState) Valid() bool {
+	return s >= 0 && s < provisioningTargetStateCount
+}
+
+func (s ProvisioningTargetState) Exists() bool {
+	return s != ProvisioningTargetUnspecified && s != ProvisioningTargetRemoved
+}
+
+// ProvisioningStatus is the outcome of the last delivery to a provisioning target.
+type ProvisioningStatus int32
+
+const (
+	ProvisioningStatusUnspecified ProvisioningStatus = iota
+	ProvisioningStatusSynced
+	ProvisioningStatusFailed
+)
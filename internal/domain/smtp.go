@@ -12,3 +12,18 @@ const (
 func (s SMTPConfigState) Exists() bool {
 	return s != SMTPConfigStateUnspecified && s != SMTPConfigStateRemoved
 }
+
+// EmailAPIProviderType is the provider whose HTTP API is used to deliver emails
+// instead of SMTP.
+type EmailAPIProviderType int32
+
+const (
+	EmailAPIProviderTypeUnspecified EmailAPIProviderType = iota
+	EmailAPIProviderTypeSES
+	EmailAPIProviderTypeSendGrid
+	EmailAPIProviderTypeMailgun
+)
+
+func (t EmailAPIProviderType) Valid() bool {
+	return t > EmailAPIProviderTypeUnspecified && t <= EmailAPIProviderTypeMailgun
+}
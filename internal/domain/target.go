@@ -16,3 +16,23 @@ func (s TargetState) Valid() bool {
 func (s TargetState) Exists() bool {
 	return s != TargetUnspecified && s != TargetRemoved
 }
+
+// TargetDeliveryState is the state of a call of an event execution to a target,
+// which failed after all retries.
+type TargetDeliveryState int32
+
+const (
+	TargetDeliveryStateUnspecified TargetDeliveryState = iota
+	TargetDeliveryStateFailed
+	TargetDeliveryStateRedriving
+	TargetDeliveryStateDelivered
+	targetDeliveryStateCount
+)
+
+func (s TargetDeliveryState) Valid() bool {
+	return s >= 0 && s < targetDeliveryStateCount
+}
+
+func (s TargetDeliveryState) Exists() bool {
+	return s != TargetDeliveryStateUnspecified
+}
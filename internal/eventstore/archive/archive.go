@@ -0,0 +1,133 @@
+// Package archive moves old events out of eventstore.events2 to keep the table small.
+// An event is archived if it is older than the archive horizon of its instance and
+// its aggregate has a snapshot of a later sequence, so no write model needs to reduce it anymore.
+// The latest event of an aggregate is never archived, because new events are sequenced based on it.
+//
+// Archived events are moved into the archive table, they are still found by searches with [eventstore.SearchQueryBuilder.IncludeArchived].
+// Write models read through the archive as long as [eventstore.Config.IncludeArchived] is set.
+// Projections are not able to reduce archived events, so archived events are missing if a projection is recreated.
+package archive
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	// batchTable is the temporary table containing the events of the current batch.
+	batchTable = "archive_batch"
+
+	createBatchStmt = "CREATE TEMPORARY TABLE " + batchTable + " (LIKE eventstore.events2) ON COMMIT DROP"
+	fillBatchStmt   = "INSERT INTO " + batchTable + " (" + eventColumns + ")" +
+		` SELECT e.instance_id, e.aggregate_type, e.aggregate_id, e.event_type, e."sequence", e.revision, e.created_at, e.payload, e.creator, e."owner", e."position", e.in_tx_order` +
+		` FROM eventstore.events2 e` +
+		` JOIN (` +
+		`SELECT aggregate_type, aggregate_id, MIN("sequence") AS "sequence" FROM eventstore.snapshots WHERE instance_id = $1 GROUP BY aggregate_type, aggregate_id` +
+		`) s ON e.aggregate_type = s.aggregate_type AND e.aggregate_id = s.aggregate_id` +
+		` WHERE e.instance_id = $1 AND e.created_at < $2 AND e."sequence" < s."sequence"` +
+		` ORDER BY e."position", e.in_tx_order` +
+		` LIMIT $3`
+	deleteBatchStmt = `DELETE FROM eventstore.events2 e USING ` + batchTable + ` b` +
+		` WHERE e.instance_id = b.instance_id AND e.aggregate_type = b.aggregate_type AND e.aggregate_id = b.aggregate_id AND e."sequence" = b."sequence"`
+
+	defaultBatchSize = 10000
+)
+
+// Sink stores the archived events.
+type Sink interface {
+	// Store stores the events of the batch table, before they are deleted from the eventstore in the same transaction.
+	Store(ctx context.Context, tx *sql.Tx, instanceID string) error
+	// Restore pushes the archived events of the instance created in [from, to) back into the eventstore.
+	Restore(ctx context.Context, tx *sql.Tx, instanceID string, from, to time.Time) (int64, error)
+}
+
+type Archiver struct {
+	client    database.Beginner
+	sink      Sink
+	batchSize uint32
+}
+
+func NewArchiver(client database.Beginner, config *Config) (*Archiver, error) {
+	sink, err := newSink(&config.Sink)
+	if err != nil {
+		return nil, err
+	}
+	batchSize := config.BatchSize
+	if batchSize == 0 {
+		batchSize = defaultBatchSize
+	}
+	return &Archiver{
+		client:    client,
+		sink:      sink,
+		batchSize: batchSize,
+	}, nil
+}
+
+func newSink(config *SinkConfig) (Sink, error) {
+	switch config.Type {
+	case SinkTypeTable:
+		return newTableSink(&config.Table)
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "ARCHI-Gk2nv", "unknown archive sink type %q", config.Type)
+	}
+}
+
+// Archive moves the archivable events of the instance created before the given time to the sink.
+// The events are moved in batches, each batch in its own transaction.
+// It returns the number of archived events.
+func (a *Archiver) Archive(ctx context.Context, instanceID string, before time.Time) (archived int64, err error) {
+	for {
+		moved, err := a.archiveBatch(ctx, instanceID, before)
+		archived += moved
+		if err != nil || moved < int64(a.batchSize) {
+			return archived, err
+		}
+	}
+}
+
+func (a *Archiver) archiveBatch(ctx context.Context, instanceID string, before time.Time) (_ int64, err error) {
+	tx, err := a.client.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, zerrors.ThrowInternal(err, "ARCHI-Wn4ch", "unable to begin transaction")
+	}
+	defer func() {
+		err = database.CloseTransaction(tx, err)
+	}()
+
+	if _, err = tx.ExecContext(ctx, createBatchStmt); err != nil {
+		return 0, zerrors.ThrowInternal(err, "ARCHI-Pu7qe", "unable to create batch")
+	}
+	result, err := tx.ExecContext(ctx, fillBatchStmt, instanceID, before, a.batchSize)
+	if err != nil {
+		return 0, zerrors.ThrowInternal(err, "ARCHI-Xe3jr", "unable to select archivable events")
+	}
+	selected, err := result.RowsAffected()
+	if err != nil || selected == 0 {
+		return 0, err
+	}
+	if err = a.sink.Store(ctx, tx, instanceID); err != nil {
+		return 0, err
+	}
+	if _, err = tx.ExecContext(ctx, deleteBatchStmt); err != nil {
+		return 0, zerrors.ThrowInternal(err, "ARCHI-Tb8wm", "unable to delete archived events")
+	}
+	return selected, nil
+}
+
+// Restore pushes the archived events of the instance created in [from, to) back into the eventstore.
+// Events already present in the eventstore are skipped.
+// It returns the number of restored events.
+func (a *Archiver) Restore(ctx context.Context, instanceID string, from, to time.Time) (_ int64, err error) {
+	tx, err := a.client.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, zerrors.ThrowInternal(err, "ARCHI-Ma5vd", "unable to begin transaction")
+	}
+	defer func() {
+		err = database.CloseTransaction(tx, err)
+	}()
+	return a.sink.Restore(ctx, tx, instanceID, from, to)
+}
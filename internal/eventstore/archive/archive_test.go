@@ -0,0 +1,135 @@
+package archive
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiver_Archive(t *testing.T) {
+	before := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		batchSize uint32
+		expect    func(mock sqlmock.Sqlmock)
+		wantMoved int64
+		wantErr   bool
+	}{
+		{
+			name:      "nothing to archive",
+			batchSize: 2,
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(createBatchStmt)).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(regexp.QuoteMeta(fillBatchStmt)).
+					WithArgs("instance", before, uint32(2)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name:      "two batches",
+			batchSize: 2,
+			expect: func(mock sqlmock.Sqlmock) {
+				expectBatch(mock, before, 2, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+				expectBatch(mock, before, 1, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC))
+			},
+			wantMoved: 3,
+		},
+		{
+			name:      "store fails, rollback",
+			batchSize: 2,
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(createBatchStmt)).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(regexp.QuoteMeta(fillBatchStmt)).
+					WithArgs("instance", before, uint32(2)).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectQuery(regexp.QuoteMeta(batchMonthsQuery)).
+					WillReturnRows(sqlmock.NewRows([]string{"month"}).AddRow(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)))
+				mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS eventstore.events2_archive_y2024m01")).
+					WillReturnError(assert.AnError)
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			tt.expect(mock)
+			a := &Archiver{
+				client:    db,
+				sink:      &tableSink{compression: "lz4"},
+				batchSize: tt.batchSize,
+			}
+			moved, err := a.Archive(context.Background(), "instance", before)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantMoved, moved)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func expectBatch(mock sqlmock.Sqlmock, before time.Time, moved int64, months ...time.Time) {
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(createBatchStmt)).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(fillBatchStmt)).
+		WithArgs("instance", before, uint32(2)).
+		WillReturnResult(sqlmock.NewResult(0, moved))
+	rows := sqlmock.NewRows([]string{"month"})
+	for _, month := range months {
+		rows.AddRow(month)
+	}
+	mock.ExpectQuery(regexp.QuoteMeta(batchMonthsQuery)).WillReturnRows(rows)
+	for _, month := range months {
+		name := partitionName(month)
+		mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS " + name + " PARTITION OF eventstore.events2_archive FOR VALUES FROM ('" + month.Format(time.RFC3339) + "') TO ('" + month.AddDate(0, 1, 0).Format(time.RFC3339) + "')")).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(regexp.QuoteMeta("ALTER TABLE " + name + " ALTER COLUMN payload SET COMPRESSION lz4")).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+	mock.ExpectExec(regexp.QuoteMeta(storeBatchStmt)).WillReturnResult(sqlmock.NewResult(0, moved))
+	mock.ExpectExec(regexp.QuoteMeta(deleteBatchStmt)).WillReturnResult(sqlmock.NewResult(0, moved))
+	mock.ExpectCommit()
+}
+
+func TestArchiver_Restore(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(restoreArchiveStmt)).
+		WithArgs("instance", from, to).
+		WillReturnResult(sqlmock.NewResult(0, 5))
+	mock.ExpectCommit()
+
+	a := &Archiver{client: db, sink: &tableSink{}}
+	restored, err := a.Restore(context.Background(), "instance", from, to)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), restored)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNewArchiver(t *testing.T) {
+	_, err := NewArchiver(nil, &Config{Sink: SinkConfig{Type: "unknown"}})
+	assert.Error(t, err)
+
+	_, err = NewArchiver(nil, &Config{Sink: SinkConfig{Type: SinkTypeTable, Table: TableConfig{Compression: "zstd; DROP TABLE"}}})
+	assert.Error(t, err)
+
+	a, err := NewArchiver(nil, &Config{Sink: SinkConfig{Type: SinkTypeTable, Table: TableConfig{Compression: "lz4"}}})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(defaultBatchSize), a.batchSize)
+}
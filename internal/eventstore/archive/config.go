@@ -0,0 +1,39 @@
+package archive
+
+import (
+	"time"
+)
+
+type Config struct {
+	// Enabled schedules the archival of the events.
+	Enabled bool
+	// Interval is the cron expression defining when the events are archived.
+	Interval string
+	// MaxAttempts is the maximum number of attempts for a failed archival.
+	MaxAttempts uint8
+	// Horizon is the age after which events are archived, if the instance doesn't set its own.
+	// A value of 0 disables the archival for instances without their own horizon.
+	Horizon time.Duration
+	// BatchSize is the maximum number of events moved in one transaction.
+	BatchSize uint32
+	Sink      SinkConfig
+}
+
+type SinkType string
+
+const (
+	// SinkTypeTable moves the events into the monthly partitions of eventstore.events2_archive.
+	// It is the only sink, because write models and the events API must be able to read the archived events.
+	SinkTypeTable SinkType = "table"
+)
+
+type SinkConfig struct {
+	Type  SinkType
+	Table TableConfig
+}
+
+type TableConfig struct {
+	// Compression of the payload in new partitions, either pglz or lz4.
+	// If empty, the default of the database is used.
+	Compression string
+}
@@ -0,0 +1,111 @@
+package archive
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	archiveTable = "eventstore.events2_archive"
+
+	// eventColumns are listed explicitly, so copying events doesn't depend on the column order of the tables
+	eventColumns = `instance_id, aggregate_type, aggregate_id, event_type, "sequence", revision, created_at, payload, creator, "owner", "position", in_tx_order`
+
+	batchMonthsQuery   = "SELECT DISTINCT date_trunc('month', created_at AT TIME ZONE 'UTC') FROM " + batchTable
+	storeBatchStmt     = "INSERT INTO " + archiveTable + " (" + eventColumns + ") SELECT " + eventColumns + " FROM " + batchTable
+	restoreArchiveStmt = "WITH restored AS (" +
+		"DELETE FROM " + archiveTable + " WHERE instance_id = $1 AND created_at >= $2 AND created_at < $3 RETURNING " + eventColumns +
+		") INSERT INTO eventstore.events2 (" + eventColumns + ") SELECT " + eventColumns + " FROM restored ON CONFLICT DO NOTHING"
+)
+
+var _ Sink = (*tableSink)(nil)
+
+// tableSink moves the events into the archive table, which is partitioned by month of the creation date.
+type tableSink struct {
+	compression string
+}
+
+func newTableSink(config *TableConfig) (*tableSink, error) {
+	switch config.Compression {
+	case "", "pglz", "lz4":
+		return &tableSink{compression: config.Compression}, nil
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "ARCHI-Lr6zo", "unsupported compression %q", config.Compression)
+	}
+}
+
+// Store implements [Sink].
+func (s *tableSink) Store(ctx context.Context, tx *sql.Tx, _ string) error {
+	months, err := batchMonths(ctx, tx)
+	if err != nil {
+		return err
+	}
+	for _, month := range months {
+		if err = s.createPartition(ctx, tx, month); err != nil {
+			return err
+		}
+	}
+	if _, err = tx.ExecContext(ctx, storeBatchStmt); err != nil {
+		return zerrors.ThrowInternal(err, "ARCHI-Ob3ki", "unable to store archived events")
+	}
+	return nil
+}
+
+// Restore implements [Sink].
+func (s *tableSink) Restore(ctx context.Context, tx *sql.Tx, instanceID string, from, to time.Time) (int64, error) {
+	result, err := tx.ExecContext(ctx, restoreArchiveStmt, instanceID, from, to)
+	if err != nil {
+		return 0, zerrors.ThrowInternal(err, "ARCHI-Qn8sy", "unable to restore archived events")
+	}
+	return result.RowsAffected()
+}
+
+func (s *tableSink) createPartition(ctx context.Context, tx *sql.Tx, month time.Time) error {
+	name := partitionName(month)
+	stmt := fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s PARTITION OF %s FOR VALUES FROM ('%s') TO ('%s')",
+		name,
+		archiveTable,
+		month.Format(time.RFC3339),
+		month.AddDate(0, 1, 0).Format(time.RFC3339),
+	)
+	if _, err := tx.ExecContext(ctx, stmt); err != nil {
+		return zerrors.ThrowInternal(err, "ARCHI-Hd4uf", "unable to create archive partition")
+	}
+	if s.compression == "" {
+		return nil
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN payload SET COMPRESSION %s", name, s.compression)); err != nil {
+		return zerrors.ThrowInternal(err, "ARCHI-Ce9wt", "unable to set compression of archive partition")
+	}
+	return nil
+}
+
+// partitionName returns the name of the partition of the archive table containing the events of the month.
+func partitionName(month time.Time) string {
+	return fmt.Sprintf("%s_y%04dm%02d", archiveTable, month.Year(), month.Month())
+}
+
+// batchMonths returns the first instant (UTC) of each month in which events of the batch were created.
+func batchMonths(ctx context.Context, tx *sql.Tx) ([]time.Time, error) {
+	rows, err := tx.QueryContext(ctx, batchMonthsQuery)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ARCHI-Vy2pa", "unable to query months of batch")
+	}
+	defer rows.Close()
+	var months []time.Time
+	for rows.Next() {
+		var month time.Time
+		if err = rows.Scan(&month); err != nil {
+			return nil, zerrors.ThrowInternal(err, "ARCHI-Ju5gn", "unable to scan month of batch")
+		}
+		months = append(months, time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.UTC))
+	}
+	if err = rows.Err(); err != nil {
+		return nil, zerrors.ThrowInternal(err, "ARCHI-Ne6xc", "unable to scan month of batch")
+	}
+	return months, nil
+}
@@ -0,0 +1,138 @@
+package archive
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/robfig/cron/v3"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/queue"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const QueueName = "eventstore_archive"
+
+var _ river.Worker[*ArchiveEvents] = (*Worker)(nil)
+
+// ArchiveEvents are the arguments of the periodic archival job.
+type ArchiveEvents struct{}
+
+func (*ArchiveEvents) Kind() string {
+	return "eventstore_archive"
+}
+
+type Worker struct {
+	river.WorkerDefaults[*ArchiveEvents]
+
+	archiver       archiver
+	queries        Queries
+	defaultHorizon time.Duration
+	now            func() time.Time
+}
+
+type archiver interface {
+	Archive(ctx context.Context, instanceID string, before time.Time) (int64, error)
+}
+
+type Queries interface {
+	SearchInstances(ctx context.Context, queries *query.InstanceSearchQueries) (*query.Instances, error)
+	InstanceByID(ctx context.Context, id string) (authz.Instance, error)
+}
+
+// Register implements the [queue.Worker] interface.
+func (w *Worker) Register(workers *river.Workers, queues map[string]river.QueueConfig) {
+	river.AddWorker[*ArchiveEvents](workers, w)
+	queues[QueueName] = river.QueueConfig{
+		MaxWorkers: 1,
+	}
+}
+
+// Work implements the [river.Worker] interface.
+// The events of all instances are archived, an instance failing doesn't prevent the others from being archived.
+func (w *Worker) Work(ctx context.Context, _ *river.Job[*ArchiveEvents]) error {
+	instances, err := w.queries.SearchInstances(ctx, &query.InstanceSearchQueries{})
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, instance := range instances.Instances {
+		horizon, err := w.horizon(ctx, instance.ID)
+		if err != nil {
+			logging.WithFields("instanceID", instance.ID).WithError(err).Warn("unable to get archive horizon")
+			errs = append(errs, err)
+			continue
+		}
+		if horizon == 0 {
+			continue
+		}
+		archived, err := w.archiver.Archive(ctx, instance.ID, w.now().Add(-horizon))
+		if err != nil {
+			logging.WithFields("instanceID", instance.ID).WithError(err).Warn("unable to archive events")
+			errs = append(errs, err)
+			continue
+		}
+		if archived > 0 {
+			logging.WithFields("instanceID", instance.ID, "events", archived).Info("events archived")
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// horizon returns the archive horizon of the instance or the default if the instance doesn't set its own.
+func (w *Worker) horizon(ctx context.Context, instanceID string) (time.Duration, error) {
+	instance, err := w.queries.InstanceByID(ctx, instanceID)
+	if err != nil {
+		return 0, err
+	}
+	if horizon := instance.ArchiveHorizon(); horizon != nil {
+		return *horizon, nil
+	}
+	return w.defaultHorizon, nil
+}
+
+func Register(
+	ctx context.Context,
+	q *queue.Queue,
+	queries Queries,
+	client *database.DB,
+	config *Config,
+) error {
+	if config == nil || !config.Enabled {
+		return nil
+	}
+	archiver, err := NewArchiver(client, config)
+	if err != nil {
+		return err
+	}
+	q.AddWorkers(ctx, &Worker{
+		archiver:       archiver,
+		queries:        queries,
+		defaultHorizon: config.Horizon,
+		now:            time.Now,
+	})
+	return nil
+}
+
+func Start(ctx context.Context, config *Config, q *queue.Queue) error {
+	if config == nil || !config.Enabled {
+		return nil
+	}
+	schedule, err := cron.ParseStandard(config.Interval)
+	if err != nil {
+		return zerrors.ThrowInvalidArgument(err, "ARCHI-Fp6ck", "invalid interval")
+	}
+	q.AddPeriodicJob(
+		ctx,
+		schedule,
+		&ArchiveEvents{},
+		queue.WithQueueName(QueueName),
+		queue.WithMaxAttempts(config.MaxAttempts),
+	)
+	return nil
+}
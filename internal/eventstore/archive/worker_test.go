@@ -0,0 +1,122 @@
+package archive
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type instance struct {
+	authz.Instance
+	horizon *time.Duration
+}
+
+func (i *instance) ArchiveHorizon() *time.Duration {
+	return i.horizon
+}
+
+type queries struct {
+	ids      []string
+	horizons map[string]time.Duration
+	err      error
+}
+
+func (q *queries) SearchInstances(context.Context, *query.InstanceSearchQueries) (*query.Instances, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	instances := &query.Instances{Instances: make([]*query.Instance, len(q.ids))}
+	for i, id := range q.ids {
+		instances.Instances[i] = &query.Instance{ID: id}
+	}
+	return instances, nil
+}
+
+func (q *queries) InstanceByID(_ context.Context, id string) (authz.Instance, error) {
+	horizon, ok := q.horizons[id]
+	if !ok {
+		return &instance{}, nil
+	}
+	return &instance{horizon: &horizon}, nil
+}
+
+type archiverFunc func(ctx context.Context, instanceID string, before time.Time) (int64, error)
+
+func (f archiverFunc) Archive(ctx context.Context, instanceID string, before time.Time) (int64, error) {
+	return f(ctx, instanceID, before)
+}
+
+func TestWorker_Work(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	errArchive := zerrors.ThrowInternal(nil, "TEST", "db error")
+	tests := []struct {
+		name           string
+		queries        *queries
+		defaultHorizon time.Duration
+		errs           map[string]error
+		wantCalled     map[string]time.Time
+		wantErr        error
+	}{
+		{
+			name:    "search instances fails, error",
+			queries: &queries{err: errArchive},
+			wantErr: errArchive,
+		},
+		{
+			name:           "default and instance horizon",
+			queries:        &queries{ids: []string{"instance1", "instance2"}, horizons: map[string]time.Duration{"instance2": time.Hour}},
+			defaultHorizon: 24 * time.Hour,
+			wantCalled: map[string]time.Time{
+				"instance1": now.Add(-24 * time.Hour),
+				"instance2": now.Add(-time.Hour),
+			},
+		},
+		{
+			name:       "archival disabled",
+			queries:    &queries{ids: []string{"instance1", "instance2"}, horizons: map[string]time.Duration{"instance2": 0}},
+			wantCalled: map[string]time.Time{},
+		},
+		{
+			name:           "instance fails, others archived",
+			queries:        &queries{ids: []string{"instance1", "instance2"}},
+			defaultHorizon: time.Hour,
+			errs:           map[string]error{"instance1": errArchive},
+			wantCalled: map[string]time.Time{
+				"instance1": now.Add(-time.Hour),
+				"instance2": now.Add(-time.Hour),
+			},
+			wantErr: errArchive,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := make(map[string]time.Time)
+			w := &Worker{
+				archiver: archiverFunc(func(_ context.Context, instanceID string, before time.Time) (int64, error) {
+					called[instanceID] = before
+					return 1, tt.errs[instanceID]
+				}),
+				queries:        tt.queries,
+				defaultHorizon: tt.defaultHorizon,
+				now:            func() time.Time { return now },
+			}
+			err := w.Work(context.Background(), &river.Job[*ArchiveEvents]{})
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			if tt.wantCalled != nil {
+				assert.Equal(t, tt.wantCalled, called)
+			}
+		})
+	}
+}
@@ -13,6 +13,14 @@ type Config struct {
 	Searcher Searcher
 	Queue    ExecutionQueue
 
+	// SnapshotStore persists the snapshots of write models, snapshots are disabled if nil.
+	SnapshotStore SnapshotStore
+	// Snapshots configures the snapshots of write models implementing [Snapshotter].
+	Snapshots SnapshotConfig
+	// IncludeArchived reads the archived events in addition to the events when reducing write models.
+	// It must be set as long as events are archived.
+	IncludeArchived bool
+
 	// Autovacuum tunes PostgreSQL's autovacuum and autoanalyze behavior of the
 	// events2 table.
 	Autovacuum AutovacuumConfig
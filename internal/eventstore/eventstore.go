@@ -35,6 +35,10 @@ type Eventstore struct {
 	querier  Querier
 	searcher Searcher
 
+	snapshots         SnapshotStore
+	snapshotThreshold uint32
+	includeArchived   bool
+
 	logger *slog.Logger
 }
 
@@ -69,7 +73,7 @@ type eventTypeInterceptors struct {
 }
 
 func NewEventstore(config *Config) *Eventstore {
-	return &Eventstore{
+	es := &Eventstore{
 		PushTimeout: config.PushTimeout,
 		maxRetries:  int(config.MaxRetries),
 
@@ -77,7 +81,14 @@ func NewEventstore(config *Config) *Eventstore {
 		querier:  config.Querier,
 		searcher: config.Searcher,
 		logger:   logging.New(logging.StreamEventPusher),
+
+		includeArchived: config.IncludeArchived,
+	}
+	if config.Snapshots.Enabled {
+		es.snapshots = config.SnapshotStore
+		es.snapshotThreshold = config.Snapshots.Threshold
 	}
+	return es
 }
 
 // Health checks if the eventstore can properly work
@@ -252,6 +263,9 @@ type reducer interface {
 // FilterToReducer filters the events based on the search query, appends all events to the reducer and calls it's reduce function
 func (es *Eventstore) FilterToReducer(ctx context.Context, searchQuery *SearchQueryBuilder, r reducer) error {
 	searchQuery.ensureInstanceID(ctx)
+	if es.includeArchived {
+		searchQuery.IncludeArchived()
+	}
 	return es.querier.FilterToReducer(ctx, searchQuery, func(event Event) error {
 		event, err := es.mapEvent(event)
 		if err != nil {
@@ -287,7 +301,11 @@ type QueryReducer interface {
 
 // FilterToQueryReducer filters the events based on the search query of the query function,
 // appends all events to the reducer and calls it's reduce function
+// If snapshots are enabled, reducers implementing [Snapshotter] start from their latest snapshot
 func (es *Eventstore) FilterToQueryReducer(ctx context.Context, r QueryReducer) error {
+	if snapshotter, ok := r.(Snapshotter); ok && es.snapshots != nil {
+		return es.filterToSnapshotter(ctx, snapshotter)
+	}
 	return es.FilterToReducer(ctx, r.Query(), r)
 }
 
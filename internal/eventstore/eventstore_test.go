@@ -1497,3 +1497,91 @@ func TestEventstore_mapEvents(t *testing.T) {
 		})
 	}
 }
+
+// testArchiveQuerier returns the archived events only if the query includes them
+type testArchiveQuerier struct {
+	testQuerier
+	archived []Event
+}
+
+func (repo *testArchiveQuerier) FilterToReducer(ctx context.Context, searchQuery *SearchQueryBuilder, reduce Reducer) error {
+	events := repo.events
+	if searchQuery.GetIncludeArchived() {
+		events = append(repo.archived, events...)
+	}
+	for _, event := range events {
+		if err := reduce(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (*testArchiveQuerier) Client() *database.DB {
+	return nil
+}
+
+// testArchiveModel is a write model without snapshots
+type testArchiveModel struct {
+	WriteModel
+
+	Names []string
+}
+
+func (wm *testArchiveModel) Reduce() error {
+	for _, event := range wm.Events {
+		wm.Names = append(wm.Names, string(event.Type()))
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *testArchiveModel) Query() *SearchQueryBuilder {
+	return NewSearchQueryBuilder(ColumnsEvent).
+		InstanceID("instance").
+		AddQuery().
+		AggregateTypes("test.agg").
+		AggregateIDs("agg").
+		Builder()
+}
+
+func TestEventstore_FilterToQueryReducer_Archived(t *testing.T) {
+	events := testSnapshotEvents("test.a", "test.b", "test.c")
+	tests := []struct {
+		name            string
+		includeArchived bool
+		wantNames       []string
+	}{
+		{
+			name:            "archived events reduced",
+			includeArchived: true,
+			wantNames:       []string{"test.a", "test.b", "test.c"},
+		},
+		{
+			name:      "archived events not read",
+			wantNames: []string{"test.c"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			es := NewEventstore(&Config{
+				Querier: &testArchiveQuerier{
+					testQuerier: testQuerier{events: events[2:], t: t},
+					archived:    events[:2],
+				},
+				SnapshotStore:   new(testSnapshotStore),
+				Snapshots:       SnapshotConfig{Enabled: true},
+				IncludeArchived: tt.includeArchived,
+			})
+			wm := new(testArchiveModel)
+			if err := es.FilterToQueryReducer(context.Background(), wm); err != nil {
+				t.Fatalf("FilterToQueryReducer() error = %v", err)
+			}
+			if !reflect.DeepEqual(wm.Names, tt.wantNames) {
+				t.Errorf("FilterToQueryReducer() names = %v, want %v", wm.Names, tt.wantNames)
+			}
+			if wm.ProcessedSequence != 3 {
+				t.Errorf("FilterToQueryReducer() processed sequence = %d, want 3", wm.ProcessedSequence)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ type SearchQuery struct {
 	SubQueries            [][]*Filter
 	Tx                    *sql.Tx
 	AwaitOpenTransactions bool
+	IncludeArchived       bool
 	Limit                 uint64
 	Offset                uint32
 	Desc                  bool
@@ -131,6 +132,7 @@ func QueryFromBuilder(builder *eventstore.SearchQueryBuilder) (*SearchQuery, err
 		Desc:                  builder.GetDesc(),
 		Tx:                    builder.GetTx(),
 		AwaitOpenTransactions: builder.GetAwaitOpenTransactions(),
+		IncludeArchived:       builder.GetIncludeArchived(),
 		SubQueries:            make([][]*Filter, len(builder.GetQueries())),
 	}
 
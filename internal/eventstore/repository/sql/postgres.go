@@ -96,7 +96,7 @@ func (db *Postgres) orderByEventSequence(desc, shouldOrderBySequence, useV1 bool
 	return ` ORDER BY "position", in_tx_order, instance_id, aggregate_type, aggregate_id`
 }
 
-func (db *Postgres) eventQuery(useV1 bool) string {
+func (db *Postgres) eventQuery(useV1, includeArchived bool) string {
 	if useV1 {
 		return "SELECT" +
 			" creation_date" +
@@ -123,22 +123,18 @@ func (db *Postgres) eventQuery(useV1 bool) string {
 		", aggregate_type" +
 		", aggregate_id" +
 		", revision" +
-		" FROM eventstore.events2"
+		" FROM " + eventsTable(useV1, includeArchived)
 }
 
-func (db *Postgres) maxPositionQuery(useV1 bool) string {
+func (db *Postgres) maxPositionQuery(useV1, includeArchived bool) string {
 	if useV1 {
 		return `SELECT event_sequence FROM eventstore.events`
 	}
-	return `SELECT "position" FROM eventstore.events2`
+	return `SELECT "position" FROM ` + eventsTable(useV1, includeArchived)
 }
 
-func (db *Postgres) instanceIDsQuery(useV1 bool) string {
-	table := "eventstore.events2"
-	if useV1 {
-		table = "eventstore.events"
-	}
-	return "SELECT DISTINCT instance_id FROM " + table
+func (db *Postgres) instanceIDsQuery(useV1, includeArchived bool) string {
+	return "SELECT DISTINCT instance_id FROM " + eventsTable(useV1, includeArchived)
 }
 
 func (db *Postgres) columnName(col repository.Field, useV1 bool) string {
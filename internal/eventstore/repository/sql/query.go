@@ -25,9 +25,9 @@ type querier interface {
 	operation(repository.Operation) string
 	conditionFormat(repository.Operation) string
 	placeholder(query string) string
-	eventQuery(useV1 bool) string
-	maxPositionQuery(useV1 bool) string
-	instanceIDsQuery(useV1 bool) string
+	eventQuery(useV1, includeArchived bool) string
+	maxPositionQuery(useV1, includeArchived bool) string
+	instanceIDsQuery(useV1, includeArchived bool) string
 	Client() *database.DB
 	orderByEventSequence(desc, shouldOrderBySequence, useV1 bool) string
 	dialect.Database
@@ -61,7 +61,7 @@ func query(ctx context.Context, criteria querier, searchQuery *eventstore.Search
 		return err
 	}
 
-	query, rowScanner := prepareColumns(criteria, q.Columns, useV1)
+	query, rowScanner := prepareColumns(criteria, q.Columns, useV1, q.IncludeArchived)
 	where, values := prepareConditions(criteria, q, useV1)
 	if where == "" || query == "" {
 		return zerrors.ThrowInvalidArgument(nil, "SQL-rWeBw", "invalid query factory")
@@ -147,14 +147,31 @@ func query(ctx context.Context, criteria querier, searchQuery *eventstore.Search
 	return nil
 }
 
-func prepareColumns(criteria querier, columns eventstore.Columns, useV1 bool) (string, func(s scan, dest interface{}) error) {
+// archivedEventsColumns are the columns of the events and the archived events,
+// they are listed explicitly so the union doesn't depend on the column order of the tables.
+const archivedEventsColumns = `instance_id, aggregate_type, aggregate_id, event_type, "sequence", revision, created_at, payload, creator, "owner", "position", in_tx_order`
+
+// eventsTable returns the table the events are selected from.
+// If archived events are included, the union of the events and the archived events is used.
+func eventsTable(useV1, includeArchived bool) string {
+	if useV1 {
+		return "eventstore.events"
+	}
+	if includeArchived {
+		return "(SELECT " + archivedEventsColumns + " FROM eventstore.events2" +
+			" UNION ALL SELECT " + archivedEventsColumns + " FROM eventstore.events2_archive) AS events2"
+	}
+	return "eventstore.events2"
+}
+
+func prepareColumns(criteria querier, columns eventstore.Columns, useV1, includeArchived bool) (string, func(s scan, dest interface{}) error) {
 	switch columns {
 	case eventstore.ColumnsMaxPosition:
-		return criteria.maxPositionQuery(useV1), maxPositionScanner
+		return criteria.maxPositionQuery(useV1, includeArchived), maxPositionScanner
 	case eventstore.ColumnsInstanceIDs:
-		return criteria.instanceIDsQuery(useV1), instanceIDsScanner
+		return criteria.instanceIDsQuery(useV1, includeArchived), instanceIDsScanner
 	case eventstore.ColumnsEvent:
-		return criteria.eventQuery(useV1), eventsScanner(useV1)
+		return criteria.eventQuery(useV1, includeArchived), eventsScanner(useV1)
 	default:
 		return "", nil
 	}
@@ -288,11 +305,7 @@ func prepareConditions(criteria querier, query *repository.SearchQuery, useV1 bo
 		if clauses != "" {
 			clauses += " AND "
 		}
-		if useV1 {
-			clauses += "aggregate_id NOT IN (SELECT aggregate_id FROM eventstore.events WHERE " + excludeAggregateIDsClauses + ")"
-		} else {
-			clauses += "aggregate_id NOT IN (SELECT aggregate_id FROM eventstore.events2 WHERE " + excludeAggregateIDsClauses + ")"
-		}
+		clauses += "aggregate_id NOT IN (SELECT aggregate_id FROM " + eventsTable(useV1, query.IncludeArchived) + " WHERE " + excludeAggregateIDsClauses + ")"
 		args = append(args, excludeAggregateIDsArgs...)
 	}
 
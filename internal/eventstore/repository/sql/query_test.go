@@ -240,7 +240,7 @@ func Test_prepareColumns(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			query, rowScanner := prepareColumns(new(Postgres), tt.args.columns, tt.args.useV1)
+			query, rowScanner := prepareColumns(new(Postgres), tt.args.columns, tt.args.useV1, false)
 			if query != tt.res.query {
 				t.Errorf("prepareColumns() got = %s, want %s", query, tt.res.query)
 			}
@@ -941,6 +941,34 @@ func Test_query_events_mocked(t *testing.T) {
 				wantErr: false,
 			},
 		},
+		{
+			name: "include archived, v2",
+			args: args{
+				dest: &[]*repository.Event{},
+				query: eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+					InstanceID("instanceID").
+					OrderDesc().
+					Limit(5).
+					IncludeArchived().
+					AddQuery().
+					AggregateTypes("notify").
+					Builder().
+					ExcludeAggregateIDs().
+					AggregateTypes("notify").
+					EventTypes("notification.failed", "notification.success").
+					Builder(),
+				useV1: false,
+			},
+			fields: fields{
+				mock: newMockClient(t).expectQuery(
+					regexp.QuoteMeta(`SELECT created_at, event_type, "sequence", "position", payload, creator, "owner", instance_id, aggregate_type, aggregate_id, revision FROM (SELECT instance_id, aggregate_type, aggregate_id, event_type, "sequence", revision, created_at, payload, creator, "owner", "position", in_tx_order FROM eventstore.events2 UNION ALL SELECT instance_id, aggregate_type, aggregate_id, event_type, "sequence", revision, created_at, payload, creator, "owner", "position", in_tx_order FROM eventstore.events2_archive) AS events2 WHERE instance_id = $1 AND aggregate_type = $2 AND aggregate_id NOT IN (SELECT aggregate_id FROM (SELECT instance_id, aggregate_type, aggregate_id, event_type, "sequence", revision, created_at, payload, creator, "owner", "position", in_tx_order FROM eventstore.events2 UNION ALL SELECT instance_id, aggregate_type, aggregate_id, event_type, "sequence", revision, created_at, payload, creator, "owner", "position", in_tx_order FROM eventstore.events2_archive) AS events2 WHERE aggregate_type = $3 AND event_type = ANY($4) AND instance_id = $5) ORDER BY "position" DESC, in_tx_order DESC, instance_id, aggregate_type, aggregate_id LIMIT $6`),
+					[]driver.Value{"instanceID", eventstore.AggregateType("notify"), eventstore.AggregateType("notify"), []eventstore.EventType{"notification.failed", "notification.success"}, "instanceID", uint64(5)},
+				),
+			},
+			res: res{
+				wantErr: false,
+			},
+		},
 	}
 	client := NewPostgres(&database.DB{Database: new(testDB)})
 	for _, tt := range tests {
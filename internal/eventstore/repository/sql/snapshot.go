@@ -0,0 +1,68 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var _ eventstore.SnapshotStore = (*Postgres)(nil)
+
+const (
+	snapshotQuery = `SELECT "version", "sequence", "position", payload FROM eventstore.snapshots` +
+		` WHERE instance_id = $1 AND aggregate_type = $2 AND aggregate_id = $3 AND write_model = $4`
+	// saveSnapshotStmt doesn't replace a snapshot of the same version taken at a later sequence
+	saveSnapshotStmt = `INSERT INTO eventstore.snapshots (instance_id, aggregate_type, aggregate_id, write_model, "version", "sequence", "position", payload)` +
+		` VALUES ($1, $2, $3, $4, $5, $6, $7, $8)` +
+		` ON CONFLICT (instance_id, aggregate_type, aggregate_id, write_model) DO UPDATE SET` +
+		` "version" = EXCLUDED."version", "sequence" = EXCLUDED."sequence", "position" = EXCLUDED."position", payload = EXCLUDED.payload, created_at = NOW()` +
+		` WHERE snapshots."version" <> EXCLUDED."version" OR snapshots."sequence" < EXCLUDED."sequence"`
+)
+
+// Snapshot implements [eventstore.SnapshotStore]
+func (psql *Postgres) Snapshot(ctx context.Context, instanceID string, aggregateType eventstore.AggregateType, aggregateID, writeModel string) (_ *eventstore.Snapshot, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	snapshot := &eventstore.Snapshot{
+		InstanceID:    instanceID,
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		WriteModel:    writeModel,
+	}
+	err = psql.QueryRowContext(ctx, func(row *sql.Row) error {
+		return row.Scan(&snapshot.Version, &snapshot.Sequence, &snapshot.Position, &snapshot.Payload)
+	}, snapshotQuery, instanceID, aggregateType, aggregateID, writeModel)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "SQL-Snp1q", "unable to load snapshot")
+	}
+	return snapshot, nil
+}
+
+// SaveSnapshot implements [eventstore.SnapshotStore]
+func (psql *Postgres) SaveSnapshot(ctx context.Context, snapshot *eventstore.Snapshot) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	_, err = psql.ExecContext(ctx, saveSnapshotStmt,
+		snapshot.InstanceID,
+		snapshot.AggregateType,
+		snapshot.AggregateID,
+		snapshot.WriteModel,
+		snapshot.Version,
+		snapshot.Sequence,
+		snapshot.Position,
+		snapshot.Payload,
+	)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "SQL-Snp2r", "unable to save snapshot")
+	}
+	return nil
+}
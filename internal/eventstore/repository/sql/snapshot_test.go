@@ -0,0 +1,106 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+func TestPostgres_Snapshot(t *testing.T) {
+	tests := []struct {
+		name    string
+		expect  func(mock sqlmock.Sqlmock)
+		want    *eventstore.Snapshot
+		wantErr bool
+	}{
+		{
+			name: "not found",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(snapshotQuery)).
+					WithArgs("instance", "org", "org1", "org").
+					WillReturnError(sql.ErrNoRows)
+			},
+		},
+		{
+			name: "query fails",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(snapshotQuery)).
+					WithArgs("instance", "org", "org1", "org").
+					WillReturnError(sql.ErrConnDone)
+			},
+			wantErr: true,
+		},
+		{
+			name: "found",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(snapshotQuery)).
+					WithArgs("instance", "org", "org1", "org").
+					WillReturnRows(sqlmock.NewRows([]string{"version", "sequence", "position", "payload"}).
+						AddRow("v1-abcdef12", 42, "1700000000.5", []byte(`{"state":{}}`)))
+			},
+			want: &eventstore.Snapshot{
+				InstanceID:    "instance",
+				AggregateType: "org",
+				AggregateID:   "org1",
+				WriteModel:    "org",
+				Version:       "v1-abcdef12",
+				Sequence:      42,
+				Position:      decimal.RequireFromString("1700000000.5"),
+				Payload:       []byte(`{"state":{}}`),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			tt.expect(mock)
+
+			got, err := NewPostgres(&database.DB{DB: db}).Snapshot(context.Background(), "instance", "org", "org1", "org")
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.want == nil {
+				assert.Nil(t, got)
+			} else {
+				require.NotNil(t, got)
+				assert.True(t, tt.want.Position.Equal(got.Position))
+				got.Position = tt.want.Position
+				assert.Equal(t, tt.want, got)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPostgres_SaveSnapshot(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	snapshot := &eventstore.Snapshot{
+		InstanceID:    "instance",
+		AggregateType: "org",
+		AggregateID:   "org1",
+		WriteModel:    "org",
+		Version:       "v1-abcdef12",
+		Sequence:      42,
+		Position:      decimal.RequireFromString("1700000000.5"),
+		Payload:       []byte(`{"state":{}}`),
+	}
+	mock.ExpectExec(regexp.QuoteMeta(saveSnapshotStmt)).
+		WithArgs("instance", "org", "org1", "org", "v1-abcdef12", 42, snapshot.Position, []byte(`{"state":{}}`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, NewPostgres(&database.DB{DB: db}).SaveSnapshot(context.Background(), snapshot))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -27,6 +27,7 @@ type SearchQueryBuilder struct {
 	tx                    *sql.Tx
 	positionAtLeast       decimal.Decimal
 	awaitOpenTransactions bool
+	includeArchived       bool
 	creationDateAfter     time.Time
 	creationDateBefore    time.Time
 	eventSequenceGreater  uint64
@@ -84,6 +85,10 @@ func (b SearchQueryBuilder) GetAwaitOpenTransactions() bool {
 	return b.awaitOpenTransactions
 }
 
+func (b SearchQueryBuilder) GetIncludeArchived() bool {
+	return b.includeArchived
+}
+
 func (q SearchQueryBuilder) GetEventSequenceGreater() uint64 {
 	return q.eventSequenceGreater
 }
@@ -292,6 +297,12 @@ func (builder *SearchQueryBuilder) AwaitOpenTransactions() *SearchQueryBuilder {
 	return builder
 }
 
+// IncludeArchived also searches the events moved to the archive table (eventstore.events2_archive).
+func (builder *SearchQueryBuilder) IncludeArchived() *SearchQueryBuilder {
+	builder.includeArchived = true
+	return builder
+}
+
 // SequenceGreater filters for events with sequence greater the requested sequence
 func (builder *SearchQueryBuilder) SequenceGreater(sequence uint64) *SearchQueryBuilder {
 	builder.eventSequenceGreater = sequence
@@ -0,0 +1,269 @@
+package eventstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/zitadel/zitadel/backend/v3/instrumentation/logging"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// Snapshotter is implemented by write models which opt in to snapshots.
+// The reduced state of a snapshotter is persisted after replaying many events,
+// so the next [Eventstore.FilterToQueryReducer] only replays the events created after the snapshot.
+//
+// The state consists of the exported fields of the write model which are marshaled to JSON,
+// including the fields of an embedded [WriteModel].
+// Only write models whose query is fixed by the aggregate
+// (a single aggregate type and id, the same event types for every instance of the write model)
+// may implement the interface.
+// The implementation must not be promoted to write models embedding a snapshotter.
+type Snapshotter interface {
+	QueryReducer
+	// SnapshotType identifies the write model, it must be unique across all snapshotters.
+	SnapshotType() string
+	// SnapshotVersion must be changed whenever the reduce logic of the write model changes.
+	// Changes of the state fields invalidate existing snapshots without changing the version.
+	SnapshotVersion() string
+}
+
+// Snapshot is the persisted state of a [Snapshotter] for a single aggregate.
+type Snapshot struct {
+	InstanceID    string
+	AggregateType AggregateType
+	AggregateID   string
+	WriteModel    string
+	Version       string
+	// Sequence is the sequence of the last event reduced into the snapshot.
+	Sequence uint64
+	// Position is the position of the last event reduced into the snapshot.
+	Position decimal.Decimal
+	Payload  []byte
+}
+
+// SnapshotStore persists the snapshots of write models.
+type SnapshotStore interface {
+	// Snapshot returns the snapshot of the write model for the aggregate, nil if none exists.
+	Snapshot(ctx context.Context, instanceID string, aggregateType AggregateType, aggregateID, writeModel string) (*Snapshot, error)
+	// SaveSnapshot creates or replaces the snapshot of the write model for the aggregate.
+	SaveSnapshot(ctx context.Context, snapshot *Snapshot) error
+}
+
+// SnapshotConfig configures the snapshots of write models implementing [Snapshotter].
+type SnapshotConfig struct {
+	// Enabled loads and persists snapshots.
+	Enabled bool
+	// Threshold is the amount of events replayed after the latest snapshot
+	// (or from the start of the aggregate) which triggers persisting a new snapshot.
+	Threshold uint32
+}
+
+// snapshotWriteModel is the part of the base [WriteModel] stored in a snapshot.
+// The fields are excluded from the json representation of the write model itself.
+type snapshotWriteModel struct {
+	AggregateID       string    `json:"aggregateId,omitempty"`
+	ProcessedSequence uint64    `json:"processedSequence,omitempty"`
+	ResourceOwner     string    `json:"resourceOwner,omitempty"`
+	InstanceID        string    `json:"instanceId,omitempty"`
+	ChangeDate        time.Time `json:"changeDate,omitempty"`
+}
+
+type snapshotPayload struct {
+	WriteModel *snapshotWriteModel `json:"writeModel,omitempty"`
+	State      json.RawMessage     `json:"state"`
+}
+
+// baseWriteModel is implemented by all write models embedding [WriteModel].
+type baseWriteModel interface {
+	writeModel() *WriteModel
+}
+
+func (wm *WriteModel) writeModel() *WriteModel {
+	return wm
+}
+
+func (es *Eventstore) filterToSnapshotter(ctx context.Context, r Snapshotter) error {
+	searchQuery := r.Query()
+	searchQuery.ensureInstanceID(ctx)
+	aggregateType, aggregateID, ok := snapshotAggregate(searchQuery)
+	if !ok {
+		return es.FilterToReducer(ctx, searchQuery, r)
+	}
+	instanceID := *searchQuery.GetInstanceID()
+	version := snapshotVersion(r)
+
+	snapshot, err := es.snapshots.Snapshot(ctx, instanceID, aggregateType, aggregateID, r.SnapshotType())
+	if err != nil {
+		logging.WithError(ctx, err).Warn("unable to load snapshot", "writeModel", r.SnapshotType(), "aggregateID", aggregateID)
+	}
+	if snapshot != nil && snapshot.Version == version {
+		if err = restoreSnapshot(r, snapshot.Payload); err != nil {
+			return err
+		}
+		// the events of the aggregate are only archived up to its oldest snapshot
+		searchQuery.SequenceGreater(snapshot.Sequence)
+	} else if es.includeArchived {
+		searchQuery.IncludeArchived()
+	}
+
+	var (
+		reduced  uint32
+		sequence uint64
+		position decimal.Decimal
+	)
+	err = es.querier.FilterToReducer(ctx, searchQuery, func(event Event) error {
+		event, err := es.mapEvent(event)
+		if err != nil {
+			return err
+		}
+		r.AppendEvents(event)
+		if err = r.Reduce(); err != nil {
+			return err
+		}
+		reduced++
+		sequence = event.Sequence()
+		position = event.Position()
+		return nil
+	})
+	if err != nil || reduced == 0 || reduced < es.snapshotThreshold {
+		return err
+	}
+
+	payload, err := takeSnapshot(r)
+	if err != nil {
+		logging.WithError(ctx, err).Warn("unable to take snapshot", "writeModel", r.SnapshotType(), "aggregateID", aggregateID)
+		return nil
+	}
+	err = es.snapshots.SaveSnapshot(ctx, &Snapshot{
+		InstanceID:    instanceID,
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		WriteModel:    r.SnapshotType(),
+		Version:       version,
+		Sequence:      sequence,
+		Position:      position,
+		Payload:       payload,
+	})
+	logging.OnError(ctx, err).Warn("unable to save snapshot", "writeModel", r.SnapshotType(), "aggregateID", aggregateID)
+	return nil
+}
+
+// snapshotAggregate returns the aggregate a snapshot can be taken for.
+// Snapshots are only possible for queries of a single instance and a single aggregate.
+func snapshotAggregate(searchQuery *SearchQueryBuilder) (AggregateType, string, bool) {
+	if searchQuery.GetInstanceID() == nil || *searchQuery.GetInstanceID() == "" ||
+		len(searchQuery.GetQueries()) != 1 ||
+		searchQuery.GetDesc() ||
+		searchQuery.GetLimit() > 0 ||
+		searchQuery.GetEventSequenceGreater() > 0 ||
+		!searchQuery.GetPositionAtLeast().IsZero() ||
+		!searchQuery.GetCreationDateAfter().IsZero() ||
+		!searchQuery.GetCreationDateBefore().IsZero() {
+		return "", "", false
+	}
+	query := searchQuery.GetQueries()[0]
+	if len(query.GetAggregateTypes()) != 1 || len(query.GetAggregateIDs()) != 1 || query.GetAggregateIDs()[0] == "" {
+		return "", "", false
+	}
+	return query.GetAggregateTypes()[0], query.GetAggregateIDs()[0], true
+}
+
+func takeSnapshot(r Snapshotter) ([]byte, error) {
+	state, err := json.Marshal(r)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ES-Sn4pq", "unable to marshal write model")
+	}
+	payload := &snapshotPayload{State: state}
+	if base, ok := r.(baseWriteModel); ok {
+		wm := base.writeModel()
+		payload.WriteModel = &snapshotWriteModel{
+			AggregateID:       wm.AggregateID,
+			ProcessedSequence: wm.ProcessedSequence,
+			ResourceOwner:     wm.ResourceOwner,
+			InstanceID:        wm.InstanceID,
+			ChangeDate:        wm.ChangeDate,
+		}
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "ES-Sn5qr", "unable to marshal snapshot")
+	}
+	return data, nil
+}
+
+func restoreSnapshot(r Snapshotter, data []byte) error {
+	payload := new(snapshotPayload)
+	if err := json.Unmarshal(data, payload); err != nil {
+		return zerrors.ThrowInternal(err, "ES-Sn6rs", "unable to unmarshal snapshot")
+	}
+	if err := json.Unmarshal(payload.State, r); err != nil {
+		return zerrors.ThrowInternal(err, "ES-Sn7st", "unable to unmarshal write model")
+	}
+	base, ok := r.(baseWriteModel)
+	if !ok || payload.WriteModel == nil {
+		return nil
+	}
+	wm := base.writeModel()
+	wm.AggregateID = payload.WriteModel.AggregateID
+	wm.ProcessedSequence = payload.WriteModel.ProcessedSequence
+	wm.ResourceOwner = payload.WriteModel.ResourceOwner
+	wm.InstanceID = payload.WriteModel.InstanceID
+	wm.ChangeDate = payload.WriteModel.ChangeDate
+	return nil
+}
+
+var stateSchemas sync.Map
+
+// snapshotVersion combines the version of the snapshotter with a hash of its state fields,
+// so snapshots become invalid as soon as the state of the write model changes.
+func snapshotVersion(r Snapshotter) string {
+	t := reflect.TypeOf(r)
+	schema, ok := stateSchemas.Load(t)
+	if !ok {
+		var b strings.Builder
+		writeStateSchema(&b, t, make(map[reflect.Type]bool))
+		hash := sha256.Sum256([]byte(b.String()))
+		schema, _ = stateSchemas.LoadOrStore(t, hex.EncodeToString(hash[:4]))
+	}
+	return r.SnapshotVersion() + "-" + schema.(string)
+}
+
+func writeStateSchema(b *strings.Builder, t reflect.Type, visited map[reflect.Type]bool) {
+	for t.Kind() == reflect.Pointer {
+		b.WriteString("*")
+		t = t.Elem()
+	}
+	b.WriteString(t.String())
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		if t.Kind() == reflect.Map {
+			writeStateSchema(b, t.Key(), visited)
+		}
+		writeStateSchema(b, t.Elem(), visited)
+	case reflect.Struct:
+		if visited[t] {
+			return
+		}
+		visited[t] = true
+		b.WriteString("{")
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() || field.Tag.Get("json") == "-" {
+				continue
+			}
+			fmt.Fprintf(b, "%s %q ", field.Name, field.Tag.Get("json"))
+			writeStateSchema(b, field.Type, visited)
+			b.WriteString(";")
+		}
+		b.WriteString("}")
+	}
+}
@@ -0,0 +1,226 @@
+package eventstore
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/database"
+)
+
+type testSnapshotModel struct {
+	WriteModel
+
+	aggregateIDs []string
+	Names        []string
+}
+
+func (wm *testSnapshotModel) Reduce() error {
+	for _, event := range wm.Events {
+		wm.Names = append(wm.Names, string(event.Type()))
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *testSnapshotModel) Query() *SearchQueryBuilder {
+	return NewSearchQueryBuilder(ColumnsEvent).
+		InstanceID("instance").
+		AddQuery().
+		AggregateTypes("test.agg").
+		AggregateIDs(wm.aggregateIDs...).
+		Builder()
+}
+
+func (wm *testSnapshotModel) SnapshotType() string {
+	return "test"
+}
+
+func (wm *testSnapshotModel) SnapshotVersion() string {
+	return "v1"
+}
+
+type testSnapshotStore struct {
+	snapshot *Snapshot
+	err      error
+	saved    *Snapshot
+}
+
+func (s *testSnapshotStore) Snapshot(context.Context, string, AggregateType, string, string) (*Snapshot, error) {
+	return s.snapshot, s.err
+}
+
+func (s *testSnapshotStore) SaveSnapshot(_ context.Context, snapshot *Snapshot) error {
+	s.saved = snapshot
+	return nil
+}
+
+// testSequenceQuerier only returns the events matching the sequence filter of the query
+type testSequenceQuerier struct {
+	testQuerier
+	includeArchived bool
+}
+
+func (repo *testSequenceQuerier) FilterToReducer(ctx context.Context, searchQuery *SearchQueryBuilder, reduce Reducer) error {
+	repo.includeArchived = searchQuery.GetIncludeArchived()
+	for _, event := range repo.events {
+		if event.Sequence() <= searchQuery.GetEventSequenceGreater() {
+			continue
+		}
+		if err := reduce(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (*testSequenceQuerier) Client() *database.DB {
+	return nil
+}
+
+func testSnapshotEvents(names ...string) []Event {
+	events := make([]Event, len(names))
+	for i, name := range names {
+		events[i] = &BaseEvent{
+			Agg: &Aggregate{
+				ID:            "agg",
+				Type:          "test.agg",
+				ResourceOwner: "ro",
+				InstanceID:    "instance",
+			},
+			EventType: EventType(name),
+			Seq:       uint64(i + 1),
+			Pos:       decimal.NewFromInt(int64(i + 1)),
+			Creation:  time.Date(2024, 1, i+1, 0, 0, 0, 0, time.UTC),
+		}
+	}
+	return events
+}
+
+func testSnapshot(t *testing.T, version string, sequence uint64, names ...string) *Snapshot {
+	wm := &testSnapshotModel{
+		WriteModel: WriteModel{
+			AggregateID:       "agg",
+			ProcessedSequence: sequence,
+			ResourceOwner:     "ro",
+			InstanceID:        "instance",
+		},
+		Names: names,
+	}
+	payload, err := takeSnapshot(wm)
+	require.NoError(t, err)
+	return &Snapshot{Version: version, Sequence: sequence, Payload: payload}
+}
+
+func TestEventstore_FilterToQueryReducer_Snapshot(t *testing.T) {
+	version := snapshotVersion(new(testSnapshotModel))
+	events := testSnapshotEvents("test.a", "test.b", "test.c")
+	tests := []struct {
+		name                string
+		aggregateIDs        []string
+		store               *testSnapshotStore
+		threshold           uint32
+		wantNames           []string
+		wantSequence        uint64
+		wantIncludeArchived bool
+		wantSaved           *uint64
+	}{
+		{
+			name:                "no snapshot, snapshot saved",
+			aggregateIDs:        []string{"agg"},
+			store:               &testSnapshotStore{},
+			threshold:           3,
+			wantNames:           []string{"test.a", "test.b", "test.c"},
+			wantSequence:        3,
+			wantIncludeArchived: true,
+			wantSaved:           gu(3),
+		},
+		{
+			name:         "snapshot, newer events replayed",
+			aggregateIDs: []string{"agg"},
+			store:        &testSnapshotStore{snapshot: testSnapshot(t, version, 2, "snap.a", "snap.b")},
+			threshold:    3,
+			wantNames:    []string{"snap.a", "snap.b", "test.c"},
+			wantSequence: 3,
+		},
+		{
+			name:                "outdated snapshot, all events replayed",
+			aggregateIDs:        []string{"agg"},
+			store:               &testSnapshotStore{snapshot: testSnapshot(t, "v0-00000000", 2, "snap.a", "snap.b")},
+			threshold:           10,
+			wantNames:           []string{"test.a", "test.b", "test.c"},
+			wantSequence:        3,
+			wantIncludeArchived: true,
+		},
+		{
+			name:                "load fails, all events replayed",
+			aggregateIDs:        []string{"agg"},
+			store:               &testSnapshotStore{err: errors.New("db error")},
+			threshold:           1,
+			wantNames:           []string{"test.a", "test.b", "test.c"},
+			wantSequence:        3,
+			wantIncludeArchived: true,
+			wantSaved:           gu(3),
+		},
+		{
+			name:                "multiple aggregates, snapshot ignored",
+			aggregateIDs:        []string{"agg", "other"},
+			store:               &testSnapshotStore{snapshot: testSnapshot(t, version, 2, "snap.a", "snap.b")},
+			threshold:           1,
+			wantNames:           []string{"test.a", "test.b", "test.c"},
+			wantSequence:        3,
+			wantIncludeArchived: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			querier := &testSequenceQuerier{testQuerier: testQuerier{events: events, t: t}}
+			es := NewEventstore(&Config{
+				Querier:       querier,
+				SnapshotStore: tt.store,
+				Snapshots:     SnapshotConfig{Enabled: true, Threshold: tt.threshold},
+
+				IncludeArchived: true,
+			})
+			wm := &testSnapshotModel{aggregateIDs: tt.aggregateIDs}
+			require.NoError(t, es.FilterToQueryReducer(context.Background(), wm))
+
+			assert.Equal(t, tt.wantNames, wm.Names)
+			assert.Equal(t, tt.wantSequence, wm.ProcessedSequence)
+			assert.Equal(t, "ro", wm.ResourceOwner)
+			assert.Equal(t, tt.wantIncludeArchived, querier.includeArchived)
+			if tt.wantSaved == nil {
+				assert.Nil(t, tt.store.saved)
+				return
+			}
+			require.NotNil(t, tt.store.saved)
+			assert.Equal(t, *tt.wantSaved, tt.store.saved.Sequence)
+			assert.Equal(t, version, tt.store.saved.Version)
+
+			restored := new(testSnapshotModel)
+			require.NoError(t, restoreSnapshot(restored, tt.store.saved.Payload))
+			assert.Equal(t, wm.Names, restored.Names)
+			assert.Equal(t, wm.WriteModel.ProcessedSequence, restored.ProcessedSequence)
+			assert.Equal(t, wm.WriteModel.ChangeDate, restored.ChangeDate)
+		})
+	}
+}
+
+type testSnapshotModelV2 struct {
+	testSnapshotModel
+	Count int
+}
+
+func Test_snapshotVersion(t *testing.T) {
+	v1 := snapshotVersion(new(testSnapshotModel))
+	assert.Equal(t, v1, snapshotVersion(new(testSnapshotModel)))
+	assert.NotEqual(t, v1, snapshotVersion(new(testSnapshotModelV2)))
+}
+
+func gu(v uint64) *uint64 {
+	return &v
+}
@@ -0,0 +1,99 @@
+package execution
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	target_domain "github.com/zitadel/zitadel/internal/execution/target"
+	"github.com/zitadel/zitadel/internal/logstore"
+	"github.com/zitadel/zitadel/internal/logstore/record"
+)
+
+var logstoreService *logstore.Service[*record.TargetCallLog]
+
+// SetLogstoreService sets the service which records all calls of targets.
+func SetLogstoreService(svc *logstore.Service[*record.TargetCallLog]) {
+	logstoreService = svc
+}
+
+// Exchange is the request sent to a target and the received response.
+type Exchange struct {
+	// Request is the body sent to the target, after signing or encryption.
+	Request []byte
+	// StatusCode is the HTTP status code returned by REST targets.
+	StatusCode int
+	// Response is the body returned by the target.
+	Response    []byte
+	Took        time.Duration
+	Err         error
+	Interrupted bool
+
+	started time.Time
+}
+
+type exchangeKey struct{}
+
+func withExchange(ctx context.Context, exchange *Exchange) context.Context {
+	return context.WithValue(ctx, exchangeKey{}, exchange)
+}
+
+func exchangeFromContext(ctx context.Context) *Exchange {
+	exchange, _ := ctx.Value(exchangeKey{}).(*Exchange)
+	return exchange
+}
+
+// recordResponse sets the status code and captures the body of the response, if the call is recorded.
+func recordResponse(ctx context.Context, resp *http.Response) {
+	exchange := exchangeFromContext(ctx)
+	if exchange == nil {
+		return
+	}
+	exchange.StatusCode = resp.StatusCode
+	resp.Body = io.NopCloser(io.TeeReader(resp.Body, (*exchangeBody)(exchange)))
+}
+
+type exchangeBody Exchange
+
+func (b *exchangeBody) Write(p []byte) (int, error) {
+	b.Response = append(b.Response, p...)
+	return len(p), nil
+}
+
+func newExchange() *Exchange {
+	return &Exchange{started: time.Now()}
+}
+
+func (e *Exchange) finish(target target_domain.Target, response []byte, err error) {
+	e.Took = time.Since(e.started)
+	e.Err = err
+	e.Interrupted = err != nil && target.IsInterruptOnError()
+	// targets not called over HTTP, e.g. gRPC targets, only return the converted response
+	if len(e.Response) == 0 {
+		e.Response = response
+	}
+}
+
+// emitCallLog records the call of the target, if a logstore service is set.
+func emitCallLog(ctx context.Context, target target_domain.Target, exchange *Exchange) {
+	if logstoreService == nil || !logstoreService.Enabled() {
+		return
+	}
+	callLog := &record.TargetCallLog{
+		LogDate:      exchange.started,
+		InstanceID:   authz.GetInstance(ctx).InstanceID(),
+		TargetID:     target.GetTargetID(),
+		ExecutionID:  target.GetExecutionID(),
+		StatusCode:   exchange.StatusCode,
+		Took:         exchange.Took,
+		ResponseBody: string(bytes.ToValidUTF8(exchange.Response, nil)),
+		Interrupted:  exchange.Interrupted,
+	}
+	if exchange.Err != nil {
+		callLog.Error = exchange.Err.Error()
+	}
+	logstoreService.Handle(ctx, callLog)
+}
@@ -76,6 +76,8 @@ type ContextInfoRequest interface {
 }
 
 // CallTarget call the desired type of target with handling of responses
+// The target is not called if the filter of the execution does not match the payload.
+// The call is recorded in the logstore, if set.
 func CallTarget(
 	ctx context.Context,
 	target target_domain.Target,
@@ -88,6 +90,26 @@ func CallTarget(
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	if !target.GetFilter().Matches(ctx, info.GetHTTPRequestBody()) {
+		return nil, nil
+	}
+
+	exchange := newExchange()
+	res, err = callTarget(withExchange(ctx, exchange), target, info, alg, signerOnce, encrypters, client)
+	exchange.finish(target, res, err)
+	emitCallLog(ctx, target, exchange)
+	return res, err
+}
+
+func callTarget(
+	ctx context.Context,
+	target target_domain.Target,
+	info ContextInfoRequest,
+	alg crypto.EncryptionAlgorithm,
+	signerOnce sign.SignerFunc,
+	encrypters *sync.Map,
+	client *http.Client,
+) (res []byte, err error) {
 	signingKey, err := target.GetSigningKey(alg)
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "EXEC-thiiCh5b", "Errors.Internal")
@@ -97,6 +119,9 @@ func CallTarget(
 	if err != nil {
 		return nil, err
 	}
+	if exchange := exchangeFromContext(ctx); exchange != nil {
+		exchange.Request = body
+	}
 
 	switch target.GetTargetType() {
 	// get request, ignore response and return request and error for handling in list of targets
@@ -110,8 +135,15 @@ func CallTarget(
 			if _, err := Call(ctx, target.GetEndpoint(), target.GetTimeout(), info, signingKey, client); err != nil {
 				logging.WithFields("target", target.GetTargetID()).OnError(err).Info(err)
 			}
-		}(context.WithoutCancel(ctx), target, body)
+		}(withExchange(context.WithoutCancel(ctx), nil), target, body)
 		return nil, nil
+	// call the method of the target service matching the execution, return response and error
+	case target_domain.TargetTypeGRPC:
+		clientCertificate, err := target.GetClientCertificate(alg)
+		if err != nil {
+			return nil, zerrors.ThrowInternal(err, "EXEC-Gr7kq1", "Errors.Internal")
+		}
+		return CallGRPC(ctx, target, body, clientCertificate, client)
 	default:
 		return nil, zerrors.ThrowInternal(nil, "EXEC-auqnansr2m", "Errors.Execution.Unknown")
 	}
@@ -253,6 +285,7 @@ func Call(ctx context.Context, url string, timeout time.Duration, body []byte, s
 		return nil, err
 	}
 	defer resp.Body.Close()
+	recordResponse(ctx, resp)
 
 	return HandleResponse(resp)
 }
@@ -284,6 +284,72 @@ func Test_CallTarget(t *testing.T) {
 				body: []byte("{\"content\":\"request2\"}"),
 			},
 		},
+		{
+			"request response, filter matches, ok",
+			args{
+				ctx:  context.Background(),
+				info: requestContextInfo1,
+				server: &callTestServer{
+					timeout:     time.Second,
+					method:      http.MethodPost,
+					expectBody:  validateJSONPayload([]byte("{\"request\":{\"content\":\"request1\"}}")),
+					respondBody: []byte("{\"content\":\"request2\"}"),
+					statusCode:  http.StatusOK,
+				},
+				target: target_domain.Target{
+					TargetType: target_domain.TargetTypeCall,
+					Timeout:    time.Minute,
+					Filter:     `payload.request.content == "request1"`,
+				},
+			},
+			res{
+				body: []byte("{\"content\":\"request2\"}"),
+			},
+		},
+		{
+			"request response, filter does not match, not called",
+			args{
+				ctx:  context.Background(),
+				info: requestContextInfo1,
+				server: &callTestServer{
+					timeout:     time.Second,
+					method:      http.MethodPost,
+					expectBody:  validateJSONPayload([]byte("{\"request\":{\"content\":\"request1\"}}")),
+					respondBody: []byte("{\"content\":\"request2\"}"),
+					statusCode:  http.StatusOK,
+				},
+				target: target_domain.Target{
+					TargetType: target_domain.TargetTypeCall,
+					Timeout:    time.Minute,
+					Filter:     `payload.request.content == "other"`,
+				},
+			},
+			res{
+				body: nil,
+			},
+		},
+		{
+			"request response, filter fails, not called",
+			args{
+				ctx:  context.Background(),
+				info: requestContextInfo1,
+				server: &callTestServer{
+					timeout:     time.Second,
+					method:      http.MethodPost,
+					expectBody:  validateJSONPayload([]byte("{\"request\":{\"content\":\"request1\"}}")),
+					respondBody: []byte("{\"content\":\"request2\"}"),
+					statusCode:  http.StatusOK,
+				},
+				target: target_domain.Target{
+					TargetType: target_domain.TargetTypeCall,
+					Timeout:    time.Minute,
+					Filter:     `payload.missing.content == "request1"`,
+				},
+			},
+			res{
+				body: nil,
+			},
+		},
 		{
 			"request response, signed, ok",
 			args{
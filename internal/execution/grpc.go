@@ -0,0 +1,397 @@
+package execution
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	zhttp "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/domain"
+	target_domain "github.com/zitadel/zitadel/internal/execution/target"
+	"github.com/zitadel/zitadel/internal/repository/execution"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	action "github.com/zitadel/zitadel/pkg/grpc/action/v2"
+	"github.com/zitadel/zitadel/pkg/grpc/action/v2/actionconnect"
+)
+
+// CallGRPC calls the method of the [actionconnect.ActionTargetServiceClient] matching the type of the execution.
+// The body is the same JSON as sent to REST targets. The messages of request and response executions are passed typed,
+// the payload of function executions is passed as struct.
+// The returned message or payload is converted back to JSON, so it can be handled the same way as the body returned by a REST call.
+func CallGRPC(ctx context.Context, target target_domain.Target, body, clientCertificate []byte, client *http.Client) (_ []byte, err error) {
+	ctx, cancel := context.WithTimeout(ctx, target.GetTimeout())
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() {
+		cancel()
+		span.EndWithError(err)
+	}()
+
+	httpClient, err := grpcHTTPClient(client, target, clientCertificate)
+	if err != nil {
+		return nil, err
+	}
+	if target.GetTargetID() == "" {
+		defer httpClient.CloseIdleConnections()
+	}
+	service := actionconnect.NewActionTargetServiceClient(httpClient, target.GetEndpoint(), connect.WithGRPC())
+
+	executionType, _, _ := strings.Cut(target.GetExecutionID(), "/")
+	switch executionType {
+	case domain.ExecutionTypeRequest.String():
+		info, method, err := grpcContextInfoFromJSON(body)
+		if err != nil {
+			return nil, err
+		}
+		request, err := anyFromJSON(method.Input(), info.Request)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := service.Request(ctx, connect.NewRequest(&action.RequestHookRequest{
+			ExecutionId: target.GetExecutionID(),
+			FullMethod:  info.FullMethod,
+			InstanceId:  info.InstanceID,
+			OrgId:       info.OrgID,
+			ProjectId:   info.ProjectID,
+			UserId:      info.UserID,
+			Request:     request,
+			Headers:     headersToPb(info.Headers),
+		}))
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		return anyToJSON(method.Input(), resp.Msg.GetRequest())
+	case domain.ExecutionTypeResponse.String():
+		info, method, err := grpcContextInfoFromJSON(body)
+		if err != nil {
+			return nil, err
+		}
+		request, err := anyFromJSON(method.Input(), info.Request)
+		if err != nil {
+			return nil, err
+		}
+		response, err := anyFromJSON(method.Output(), info.Response)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := service.Response(ctx, connect.NewRequest(&action.ResponseHookRequest{
+			ExecutionId: target.GetExecutionID(),
+			FullMethod:  info.FullMethod,
+			InstanceId:  info.InstanceID,
+			OrgId:       info.OrgID,
+			ProjectId:   info.ProjectID,
+			UserId:      info.UserID,
+			Request:     request,
+			Response:    response,
+			Headers:     headersToPb(info.Headers),
+		}))
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		return anyToJSON(method.Output(), resp.Msg.GetResponse())
+	case domain.ExecutionTypeFunction.String():
+		payload, err := structFromJSON(body)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := service.Function(ctx, connect.NewRequest(&action.FunctionHookRequest{
+			ExecutionId: target.GetExecutionID(),
+			Payload:     payload,
+		}))
+		if err != nil {
+			return nil, grpcError(err)
+		}
+		return structToJSON(resp.Msg.GetPayload())
+	case domain.ExecutionTypeEvent.String():
+		req, err := eventHookRequest(target.GetExecutionID(), body)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = service.Event(ctx, connect.NewRequest(req)); err != nil {
+			return nil, grpcError(err)
+		}
+		return nil, nil
+	default:
+		return nil, zerrors.ThrowInternal(nil, "EXEC-Gr7kq2", "Errors.Execution.Unknown")
+	}
+}
+
+// grpcContextInfo is the body of request and response executions.
+// The messages are kept as JSON, as their types are resolved by the called method.
+type grpcContextInfo struct {
+	FullMethod string          `json:"fullMethod,omitempty"`
+	InstanceID string          `json:"instanceID,omitempty"`
+	OrgID      string          `json:"orgID,omitempty"`
+	ProjectID  string          `json:"projectID,omitempty"`
+	UserID     string          `json:"userID,omitempty"`
+	Request    json.RawMessage `json:"request,omitempty"`
+	Response   json.RawMessage `json:"response,omitempty"`
+	Headers    http.Header     `json:"headers,omitempty"`
+}
+
+// grpcContextInfoFromJSON parses the body and resolves the called method from the registered services.
+func grpcContextInfoFromJSON(body []byte) (*grpcContextInfo, protoreflect.MethodDescriptor, error) {
+	info := new(grpcContextInfo)
+	if err := json.Unmarshal(body, info); err != nil {
+		return nil, nil, zerrors.ThrowInternal(err, "EXEC-Gr7kr8", "Errors.Internal")
+	}
+	serviceName, methodName, _ := strings.Cut(strings.TrimPrefix(info.FullMethod, "/"), "/")
+	descriptor, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(serviceName))
+	if err != nil {
+		return nil, nil, zerrors.ThrowInternalf(err, "EXEC-Gr7kr9", "unknown method %s", info.FullMethod)
+	}
+	service, ok := descriptor.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, nil, zerrors.ThrowInternalf(nil, "EXEC-Gr7ks1", "unknown method %s", info.FullMethod)
+	}
+	method := service.Methods().ByName(protoreflect.Name(methodName))
+	if method == nil {
+		return nil, nil, zerrors.ThrowInternalf(nil, "EXEC-Gr7ks2", "unknown method %s", info.FullMethod)
+	}
+	return info, method, nil
+}
+
+// anyFromJSON converts the JSON message into an [anypb.Any] of the message type.
+// An empty or `null` message results in an empty message.
+func anyFromJSON(descriptor protoreflect.MessageDescriptor, data []byte) (*anypb.Any, error) {
+	messageType, err := protoregistry.GlobalTypes.FindMessageByName(descriptor.FullName())
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "EXEC-Gr7ks3", "Errors.Internal")
+	}
+	message := messageType.New().Interface()
+	if len(data) > 0 && string(data) != "null" {
+		if err := protojson.Unmarshal(data, message); err != nil {
+			return nil, zerrors.ThrowInternal(err, "EXEC-Gr7ks4", "Errors.Internal")
+		}
+	}
+	payload, err := anypb.New(message)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "EXEC-Gr7ks5", "Errors.Internal")
+	}
+	return payload, nil
+}
+
+// anyToJSON converts the message returned by the target into JSON.
+// No returned message results in no body, which leaves the context unchanged.
+// A message of another type than expected is handled as failed execution.
+func anyToJSON(descriptor protoreflect.MessageDescriptor, payload *anypb.Any) ([]byte, error) {
+	if payload == nil {
+		return nil, nil
+	}
+	if payload.MessageName() != descriptor.FullName() {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "EXEC-Gr7ks6", "Errors.Execution.Failed")
+	}
+	message, err := payload.UnmarshalNew()
+	if err != nil {
+		return nil, zerrors.ThrowPreconditionFailed(err, "EXEC-Gr7ks7", "Errors.Execution.Failed")
+	}
+	data, err := protojson.Marshal(message)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "EXEC-Gr7ks8", "Errors.Internal")
+	}
+	return data, nil
+}
+
+func headersToPb(headers http.Header) map[string]*action.HeaderValues {
+	if len(headers) == 0 {
+		return nil
+	}
+	pbHeaders := make(map[string]*action.HeaderValues, len(headers))
+	for key, values := range headers {
+		pbHeaders[key] = &action.HeaderValues{Values: values}
+	}
+	return pbHeaders
+}
+
+func eventHookRequest(executionID string, body []byte) (*action.EventHookRequest, error) {
+	event := new(execution.ContextInfoEvent)
+	if err := json.Unmarshal(body, event); err != nil {
+		return nil, zerrors.ThrowInternal(err, "EXEC-Gr7kq3", "Errors.Internal")
+	}
+	req := &action.EventHookRequest{
+		ExecutionId:   executionID,
+		AggregateId:   event.AggregateID,
+		AggregateType: event.AggregateType,
+		ResourceOwner: event.ResourceOwner,
+		InstanceId:    event.InstanceID,
+		Version:       event.Version,
+		Sequence:      event.Sequence,
+		EventType:     event.EventType,
+		UserId:        event.UserID,
+	}
+	if createdAt, err := time.Parse(time.RFC3339Nano, event.CreatedAt); err == nil {
+		req.CreatedAt = timestamppb.New(createdAt)
+	}
+	if len(event.EventPayload) > 0 {
+		payload, err := structFromJSON(event.EventPayload)
+		if err != nil {
+			return nil, err
+		}
+		req.EventPayload = payload
+	}
+	return req, nil
+}
+
+// structFromJSON converts the JSON payload into a [structpb.Struct].
+// An empty or `null` payload results in an empty struct.
+func structFromJSON(data []byte) (*structpb.Struct, error) {
+	payload := new(structpb.Struct)
+	if len(data) == 0 || string(data) == "null" {
+		return payload, nil
+	}
+	if err := protojson.Unmarshal(data, payload); err != nil {
+		return nil, zerrors.ThrowInternal(err, "EXEC-Gr7kq4", "Errors.Internal")
+	}
+	return payload, nil
+}
+
+// structToJSON converts the payload returned by the target into JSON.
+// No returned payload results in no body, which leaves the context unchanged.
+func structToJSON(payload *structpb.Struct) ([]byte, error) {
+	if payload == nil {
+		return nil, nil
+	}
+	data, err := protojson.Marshal(payload)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "EXEC-Gr7kq5", "Errors.Internal")
+	}
+	return data, nil
+}
+
+// grpcError forwards client errors returned by the target, the same way as REST targets can forward 4xx status codes.
+// All other errors are handled as failed execution.
+func grpcError(err error) error {
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) {
+		return zerrors.ThrowPreconditionFailed(err, "EXEC-Gr7kq6", "Errors.Execution.Failed")
+	}
+	switch connectErr.Code() {
+	case connect.CodeInvalidArgument:
+		return zerrors.ThrowInvalidArgument(err, "EXEC-Gr7kq7", connectErr.Message())
+	case connect.CodeFailedPrecondition:
+		return zerrors.ThrowPreconditionFailed(err, "EXEC-Gr7kq8", connectErr.Message())
+	case connect.CodeNotFound:
+		return zerrors.ThrowNotFound(err, "EXEC-Gr7kq9", connectErr.Message())
+	case connect.CodeAlreadyExists:
+		return zerrors.ThrowAlreadyExists(err, "EXEC-Gr7kr1", connectErr.Message())
+	case connect.CodePermissionDenied:
+		return zerrors.ThrowPermissionDenied(err, "EXEC-Gr7kr2", connectErr.Message())
+	case connect.CodeUnauthenticated:
+		return zerrors.ThrowUnauthenticated(err, "EXEC-Gr7kr3", connectErr.Message())
+	case connect.CodeDeadlineExceeded:
+		return zerrors.ThrowDeadlineExceeded(err, "EXEC-Gr7kr4", "Errors.Execution.Failed")
+	default:
+		return zerrors.ThrowPreconditionFailed(err, "EXEC-Gr7kr5", "Errors.Execution.Failed")
+	}
+}
+
+type grpcClientKey struct {
+	client   *http.Client
+	targetID string
+}
+
+// grpcClient is the client for a revision of a target.
+type grpcClient struct {
+	sequence    uint64
+	certificate [sha256.Size]byte
+	client      *http.Client
+}
+
+// grpcClients caches the client per base client and target, so connections to the targets are reused.
+// The client is replaced if the target changed. Targets without ID, e.g. the tested ones, are not cached.
+var grpcClients sync.Map
+
+// grpcHTTPClient returns a copy of the client, which speaks HTTP/2 (also unencrypted for http endpoints)
+// and presents the client certificate, if set.
+// The settings of the base client, e.g. the deny list and response size limits, are kept.
+func grpcHTTPClient(client *http.Client, target target_domain.Target, clientCertificate []byte) (*http.Client, error) {
+	if target.GetTargetID() == "" {
+		return newGRPCHTTPClient(client, clientCertificate)
+	}
+	key := grpcClientKey{client: client, targetID: target.GetTargetID()}
+	revision := &grpcClient{sequence: target.GetSequence()}
+	if len(clientCertificate) > 0 {
+		revision.certificate = sha256.Sum256(clientCertificate)
+	}
+	if cached, ok := grpcClients.Load(key); ok {
+		cached := cached.(*grpcClient)
+		if cached.sequence == revision.sequence && cached.certificate == revision.certificate {
+			return cached.client, nil
+		}
+	}
+
+	var err error
+	revision.client, err = newGRPCHTTPClient(client, clientCertificate)
+	if err != nil {
+		return nil, err
+	}
+	if previous, loaded := grpcClients.Swap(key, revision); loaded {
+		previous.(*grpcClient).client.CloseIdleConnections()
+	}
+	return revision.client, nil
+}
+
+func newGRPCHTTPClient(client *http.Client, clientCertificate []byte) (*http.Client, error) {
+	var certificate *tls.Certificate
+	if len(clientCertificate) > 0 {
+		// the certificate and the private key are stored together, the PEM blocks are picked by type
+		cert, err := tls.X509KeyPair(clientCertificate, clientCertificate)
+		if err != nil {
+			return nil, zerrors.ThrowInternal(err, "EXEC-Gr7kr6", "Errors.Target.InvalidClientCertificate")
+		}
+		certificate = &cert
+	}
+	transport, err := grpcTransport(client.Transport, certificate)
+	if err != nil {
+		return nil, err
+	}
+	grpcClient := *client
+	grpcClient.Transport = transport
+	return &grpcClient, nil
+}
+
+// grpcTransport returns a copy of the transport with HTTP/2 and the client certificate enabled.
+// Other transports than [http.Transport] are not supported, as neither can be set on them.
+func grpcTransport(roundTripper http.RoundTripper, certificate *tls.Certificate) (http.RoundTripper, error) {
+	switch transport := roundTripper.(type) {
+	case nil:
+		return grpcTransport(http.DefaultTransport, certificate)
+	case *zhttp.MaxBytesRoundTripper:
+		underlying, err := grpcTransport(transport.Underlying, certificate)
+		if err != nil {
+			return nil, err
+		}
+		return &zhttp.MaxBytesRoundTripper{
+			Underlying: underlying,
+			MaxBytes:   transport.MaxBytes,
+		}, nil
+	case *http.Transport:
+		transport = transport.Clone()
+		transport.Protocols = new(http.Protocols)
+		transport.Protocols.SetHTTP2(true)
+		transport.Protocols.SetUnencryptedHTTP2(true)
+		if certificate != nil {
+			if transport.TLSClientConfig == nil {
+				transport.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+			}
+			transport.TLSClientConfig.Certificates = []tls.Certificate{*certificate}
+		}
+		return transport, nil
+	default:
+		return nil, zerrors.ThrowInternalf(nil, "EXEC-Gr7kr7", "transport %T is not supported for gRPC targets", roundTripper)
+	}
+}
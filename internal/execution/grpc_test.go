@@ -0,0 +1,311 @@
+package execution_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/server/middleware"
+	"github.com/zitadel/zitadel/internal/execution"
+	target_domain "github.com/zitadel/zitadel/internal/execution/target"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	action "github.com/zitadel/zitadel/pkg/grpc/action/v2"
+	"github.com/zitadel/zitadel/pkg/grpc/action/v2/actionconnect"
+)
+
+type testTargetService struct {
+	actionconnect.UnimplementedActionTargetServiceHandler
+
+	err          error
+	returned     proto.Message
+	request      *action.RequestHookRequest
+	response     *action.ResponseHookRequest
+	event        *action.EventHookRequest
+	peerCertName string
+}
+
+func (s *testTargetService) Request(_ context.Context, req *connect.Request[action.RequestHookRequest]) (*connect.Response[action.RequestHookResponse], error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.request = req.Msg
+	if s.returned == nil {
+		return connect.NewResponse(&action.RequestHookResponse{}), nil
+	}
+	returned, err := anypb.New(s.returned)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&action.RequestHookResponse{Request: returned}), nil
+}
+
+func (s *testTargetService) Response(_ context.Context, req *connect.Request[action.ResponseHookRequest]) (*connect.Response[action.ResponseHookResponse], error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.response = req.Msg
+	if s.returned == nil {
+		return connect.NewResponse(&action.ResponseHookResponse{}), nil
+	}
+	returned, err := anypb.New(s.returned)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&action.ResponseHookResponse{Response: returned}), nil
+}
+
+func (s *testTargetService) Function(context.Context, *connect.Request[action.FunctionHookRequest]) (*connect.Response[action.FunctionHookResponse], error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return connect.NewResponse(&action.FunctionHookResponse{}), nil
+}
+
+func (s *testTargetService) Event(_ context.Context, req *connect.Request[action.EventHookRequest]) (*connect.Response[action.EventHookResponse], error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.event = req.Msg
+	return connect.NewResponse(&action.EventHookResponse{}), nil
+}
+
+func testGRPCServer(t *testing.T, service *testTargetService) *httptest.Server {
+	_, handler := actionconnect.NewActionTargetServiceHandler(service)
+	server := httptest.NewUnstartedServer(handler)
+	server.Config.Protocols = new(http.Protocols)
+	server.Config.Protocols.SetUnencryptedHTTP2(true)
+	server.Start()
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestCallTarget_grpc(t *testing.T) {
+	requestInfo := &middleware.ContextInfoRequest{
+		FullMethod: "/zitadel.action.v2.ActionService/GetTarget",
+		InstanceID: "instance1",
+		UserID:     "user1",
+		Request:    middleware.Message{Message: &action.GetTargetRequest{Id: "target1"}},
+		Headers:    http.Header{"X-Test": []string{"value"}},
+	}
+	responseInfo := &middleware.ContextInfoResponse{
+		FullMethod: "/zitadel.action.v2.ActionService/GetTarget",
+		Request:    middleware.Message{Message: &action.GetTargetRequest{Id: "target1"}},
+		Response:   middleware.Message{Message: &action.GetTargetResponse{Target: &action.Target{Name: "target1"}}},
+	}
+
+	tests := []struct {
+		name        string
+		executionID string
+		info        execution.ContextInfoRequest
+		returned    proto.Message
+		serviceErr  error
+		wantBody    []byte
+		wantErr     func(error) bool
+		check       func(*testing.T, *testTargetService)
+	}{
+		{
+			name:        "request, typed and changed",
+			executionID: "request/zitadel.action.v2.ActionService/GetTarget",
+			info:        requestInfo,
+			returned:    &action.GetTargetRequest{Id: "target2"},
+			wantBody:    []byte(`{"id":"target2"}`),
+			check: func(t *testing.T, s *testTargetService) {
+				require.NotNil(t, s.request)
+				assert.Equal(t, "request/zitadel.action.v2.ActionService/GetTarget", s.request.GetExecutionId())
+				assert.Equal(t, "/zitadel.action.v2.ActionService/GetTarget", s.request.GetFullMethod())
+				assert.Equal(t, "instance1", s.request.GetInstanceId())
+				assert.Equal(t, "user1", s.request.GetUserId())
+				assert.Equal(t, []string{"value"}, s.request.GetHeaders()["X-Test"].GetValues())
+				request := new(action.GetTargetRequest)
+				require.NoError(t, s.request.GetRequest().UnmarshalTo(request))
+				assert.Equal(t, "target1", request.GetId())
+			},
+		},
+		{
+			name:        "request, unchanged",
+			executionID: "request/zitadel.action.v2.ActionService/GetTarget",
+			info:        requestInfo,
+		},
+		{
+			name:        "request, other type returned",
+			executionID: "request/zitadel.action.v2.ActionService/GetTarget",
+			info:        requestInfo,
+			returned:    &action.GetTargetResponse{},
+			wantErr:     zerrors.IsPreconditionFailed,
+		},
+		{
+			name:        "request, unknown method",
+			executionID: "request/zitadel.session.v2.SessionService/SetSession",
+			info:        requestContextInfo1,
+			wantErr:     zerrors.IsInternal,
+		},
+		{
+			name:        "request, error forwarded",
+			executionID: "request/zitadel.action.v2.ActionService/GetTarget",
+			info:        requestInfo,
+			serviceErr:  connect.NewError(connect.CodePermissionDenied, errors.New("not allowed")),
+			wantErr:     zerrors.IsPermissionDenied,
+		},
+		{
+			name:        "request, internal error",
+			executionID: "request/zitadel.action.v2.ActionService/GetTarget",
+			info:        requestInfo,
+			serviceErr:  connect.NewError(connect.CodeInternal, errors.New("failed")),
+			wantErr:     zerrors.IsPreconditionFailed,
+		},
+		{
+			name:        "response, typed and changed",
+			executionID: "response/zitadel.action.v2.ActionService/GetTarget",
+			info:        responseInfo,
+			returned:    &action.GetTargetResponse{Target: &action.Target{Name: "target2"}},
+			wantBody:    []byte(`{"target":{"name":"target2"}}`),
+			check: func(t *testing.T, s *testTargetService) {
+				require.NotNil(t, s.response)
+				request := new(action.GetTargetRequest)
+				require.NoError(t, s.response.GetRequest().UnmarshalTo(request))
+				assert.Equal(t, "target1", request.GetId())
+				response := new(action.GetTargetResponse)
+				require.NoError(t, s.response.GetResponse().UnmarshalTo(response))
+				assert.Equal(t, "target1", response.GetTarget().GetName())
+			},
+		},
+		{
+			name:        "event, typed request",
+			executionID: "event/user.human.added",
+			info: &testContextInfoBody{body: []byte(`{"aggregateID":"user1","aggregateType":"user","resourceOwner":"org1","instanceID":"instance1",` +
+				`"sequence":2,"event_type":"user.human.added","created_at":"2025-01-01T00:00:00Z","userID":"creator","event_payload":{"userName":"user"}}`)},
+			check: func(t *testing.T, s *testTargetService) {
+				require.NotNil(t, s.event)
+				assert.Equal(t, "user1", s.event.GetAggregateId())
+				assert.Equal(t, "user", s.event.GetAggregateType())
+				assert.Equal(t, "org1", s.event.GetResourceOwner())
+				assert.Equal(t, "instance1", s.event.GetInstanceId())
+				assert.Equal(t, uint64(2), s.event.GetSequence())
+				assert.Equal(t, "user.human.added", s.event.GetEventType())
+				assert.Equal(t, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), s.event.GetCreatedAt().AsTime())
+				assert.Equal(t, "creator", s.event.GetUserId())
+				assert.Equal(t, "user", s.event.GetEventPayload().GetFields()["userName"].GetStringValue())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &testTargetService{err: tt.serviceErr, returned: tt.returned}
+			server := testGRPCServer(t, service)
+
+			body, err := execution.CallTarget(context.Background(), target_domain.Target{
+				ExecutionID: tt.executionID,
+				TargetType:  target_domain.TargetTypeGRPC,
+				Endpoint:    server.URL,
+				Timeout:     time.Minute,
+			}, tt.info, nil, nil, nil, http.DefaultClient)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				assert.True(t, tt.wantErr(err))
+				return
+			}
+			require.NoError(t, err)
+			if tt.wantBody != nil {
+				assert.JSONEq(t, string(tt.wantBody), string(body))
+			}
+			if tt.check != nil {
+				tt.check(t, service)
+			}
+		})
+	}
+}
+
+func TestCallGRPC_clientCertificate(t *testing.T) {
+	service := &testTargetService{}
+	_, handler := actionconnect.NewActionTargetServiceHandler(service)
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) > 0 {
+			service.peerCertName = r.TLS.PeerCertificates[0].Subject.CommonName
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	server.EnableHTTP2 = true
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	target := target_domain.Target{
+		ExecutionID: "function/preuserinfo",
+		TargetID:    "target1",
+		TargetType:  target_domain.TargetTypeGRPC,
+		Endpoint:    server.URL,
+		Timeout:     time.Minute,
+		Sequence:    1,
+	}
+	_, err := execution.CallGRPC(context.Background(), target, nil, testClientCertificate(t, "zitadel"), server.Client())
+	require.NoError(t, err)
+	assert.Equal(t, "zitadel", service.peerCertName)
+
+	// the client of the changed target presents the new certificate
+	target.Sequence = 2
+	_, err = execution.CallGRPC(context.Background(), target, nil, testClientCertificate(t, "zitadel2"), server.Client())
+	require.NoError(t, err)
+	assert.Equal(t, "zitadel2", service.peerCertName)
+}
+
+type testRoundTripper struct{}
+
+func (testRoundTripper) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, errors.New("not implemented")
+}
+
+func TestCallGRPC_unsupportedTransport(t *testing.T) {
+	_, err := execution.CallGRPC(context.Background(), target_domain.Target{
+		ExecutionID: "function/preuserinfo",
+		TargetType:  target_domain.TargetTypeGRPC,
+		Endpoint:    "http://localhost",
+		Timeout:     time.Minute,
+	}, nil, nil, &http.Client{Transport: testRoundTripper{}})
+	require.Error(t, err)
+	assert.True(t, zerrors.IsInternal(err))
+}
+
+type testContextInfoBody struct {
+	body []byte
+}
+
+func (c *testContextInfoBody) GetHTTPRequestBody() []byte {
+	return c.body
+}
+
+// testClientCertificate returns a self-signed certificate and its private key PEM encoded.
+func testClientCertificate(t *testing.T, commonName string) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	privateKey, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	keyPair := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate})
+	return append(keyPair, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateKey})...)
+}
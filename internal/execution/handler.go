@@ -0,0 +1,64 @@
+package execution
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/queue"
+	exec_repo "github.com/zitadel/zitadel/internal/repository/execution"
+	"github.com/zitadel/zitadel/internal/repository/target"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	RedrivesProjectionTable = "projections.execution_redrives"
+)
+
+// redriveHandler enqueues the request of a failed delivery, when its re-drive was requested.
+type redriveHandler struct {
+	queue Queue
+}
+
+func NewRedriveHandler(
+	ctx context.Context,
+	config handler.Config,
+	queue Queue,
+) *handler.Handler {
+	return handler.NewHandler(ctx, &config, &redriveHandler{
+		queue: queue,
+	})
+}
+
+func (*redriveHandler) Name() string {
+	return RedrivesProjectionTable
+}
+
+func (h *redriveHandler) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: target.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  target.DeliveryRedriveRequestedEventType,
+					Reduce: h.reduceRedriveRequested,
+				},
+			},
+		},
+	}
+}
+
+func (h *redriveHandler) reduceRedriveRequested(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*target.DeliveryRedriveRequestedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "EXEC-Rd7vQ1", "reduce.wrong.event.type %s", target.DeliveryRedriveRequestedEventType)
+	}
+	return handler.NewStatement(e, func(ctx context.Context, ex handler.Executer, projectionName string) error {
+		request := *e.Request
+		request.DeliveryID = e.DeliveryID
+		return h.queue.Insert(ctx,
+			&request,
+			queue.WithQueueName(exec_repo.QueueName),
+		)
+	}), nil
+}
@@ -5,8 +5,10 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/queue"
 )
 
@@ -16,14 +18,25 @@ var (
 
 func Register(
 	ctx context.Context,
+	redriveHandlerCustomConfig projection.CustomConfig,
 	workerConfig WorkerConfig,
+	commands *command.Commands,
 	httpClient *http.Client,
 	queue *queue.Queue,
 	targetEncAlg crypto.EncryptionAlgorithm,
 	activeSigningKey GetActiveSigningWebKey,
 ) {
 	queue.ShouldStart()
-	queue.AddWorkers(ctx, NewWorker(workerConfig, targetEncAlg, activeSigningKey, time.Now, httpClient))
+
+	// make sure the slice does not contain old values
+	projections = nil
+
+	projections = append(projections, NewRedriveHandler(
+		ctx,
+		projection.ApplyCustomConfig(redriveHandlerCustomConfig),
+		queue,
+	))
+	queue.AddWorkers(ctx, NewWorker(workerConfig, commands, queue, targetEncAlg, activeSigningKey, time.Now, httpClient))
 }
 
 func Start(ctx context.Context) {
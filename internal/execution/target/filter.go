@@ -0,0 +1,113 @@
+package target
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	lru "github.com/hashicorp/golang-lru/v2"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	filterMaxLength = 1000
+	// filterCostLimit bounds the operations of a single evaluation, e.g. comprehensions over large lists.
+	filterCostLimit = 100_000
+	filterTimeout   = 100 * time.Millisecond
+	// filterCacheSize is the amount of compiled filters kept in memory.
+	filterCacheSize = 1000
+)
+
+var (
+	filterEnv      *cel.Env
+	filterPrograms *lru.Cache[Filter, cel.Program]
+)
+
+func init() {
+	var err error
+	filterEnv, err = cel.NewEnv(
+		cel.Variable("payload", cel.MapType(cel.StringType, cel.DynType)),
+	)
+	logging.OnError(err).Fatal("unable to create execution filter environment")
+	filterPrograms, err = lru.New[Filter, cel.Program](filterCacheSize)
+	logging.OnError(err).Fatal("unable to create execution filter cache")
+}
+
+// Filter is an optional expression of an execution, which is evaluated against the payload before the targets are called.
+// The expression is written in the Common Expression Language (CEL),
+// the fields of the payload are accessible through the variable payload, e.g. `payload.orgID == "123"`.
+// The targets are only called if the expression evaluates to true.
+type Filter string
+
+// Validate checks that the filter is a valid expression evaluating to a boolean.
+func (f Filter) Validate() error {
+	if f == "" {
+		return nil
+	}
+	_, err := f.program()
+	return err
+}
+
+// Matches evaluates the filter against the JSON payload, an empty filter matches every payload.
+// Filters which can not be evaluated, e.g. because of a missing field or an exceeded cost limit, do not match.
+func (f Filter) Matches(ctx context.Context, payload []byte) bool {
+	if f == "" {
+		return true
+	}
+	program, err := f.program()
+	if err != nil {
+		logging.WithFields("filter", f).WithError(err).Warn("execution filter invalid")
+		return false
+	}
+	fields := make(map[string]any)
+	if len(payload) > 0 {
+		if err := json.Unmarshal(payload, &fields); err != nil {
+			logging.WithFields("filter", f).WithError(err).Warn("execution filter payload invalid")
+			return false
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, filterTimeout)
+	defer cancel()
+	value, _, err := program.ContextEval(ctx, map[string]any{"payload": fields})
+	if err != nil {
+		logging.WithFields("filter", f).WithError(err).Warn("execution filter could not be evaluated")
+		return false
+	}
+	match, ok := value.Value().(bool)
+	if !ok {
+		logging.WithFields("filter", f).Warn("execution filter did not evaluate to a boolean")
+		return false
+	}
+	return match
+}
+
+// program returns the compiled filter, which is only compiled once per expression.
+func (f Filter) program() (cel.Program, error) {
+	if program, ok := filterPrograms.Get(f); ok {
+		return program, nil
+	}
+	if len(f) > filterMaxLength {
+		return nil, zerrors.ThrowInvalidArgument(nil, "EXEC-Fl7tr1", "Errors.Execution.FilterInvalid")
+	}
+	ast, issues := filterEnv.Compile(string(f))
+	if issues.Err() != nil {
+		return nil, zerrors.ThrowInvalidArgument(issues.Err(), "EXEC-Fl7tr2", "Errors.Execution.FilterInvalid")
+	}
+	// fields of the payload are dynamic, so their type is only known during the evaluation
+	if !ast.OutputType().IsExactType(cel.BoolType) && !ast.OutputType().IsExactType(cel.DynType) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "EXEC-Fl7tr3", "Errors.Execution.FilterNotBoolean")
+	}
+	program, err := filterEnv.Program(ast,
+		cel.CostLimit(filterCostLimit),
+		cel.InterruptCheckFrequency(100),
+	)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "EXEC-Fl7tr4", "Errors.Execution.FilterInvalid")
+	}
+	filterPrograms.Add(f, program)
+	return program, nil
+}
@@ -0,0 +1,143 @@
+package target
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestFilter_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  Filter
+		wantErr func(error) bool
+	}{
+		{
+			name:   "empty",
+			filter: "",
+		},
+		{
+			name:   "expression",
+			filter: `payload.orgID == "org" && payload.userID != ""`,
+		},
+		{
+			name:   "expression with comment",
+			filter: `payload.orgID == "org" // only org`,
+		},
+		{
+			name:    "syntax error",
+			filter:  `payload.orgID ==`,
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name:   "macro",
+			filter: `has(payload.orgID) && payload.orgID.startsWith("org")`,
+		},
+		{
+			name:    "statement",
+			filter:  `var a = 1`,
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name:    "not boolean",
+			filter:  `"org"`,
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name:    "too long",
+			filter:  Filter(strings.Repeat("a", filterMaxLength+1)),
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.filter.Validate()
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, tt.wantErr(err), err)
+		})
+	}
+}
+
+func TestFilter_Matches(t *testing.T) {
+	payload := []byte(`{"orgID":"org","userID":"user","event_payload":{"userName":"user@example.com"}}`)
+	tests := []struct {
+		name    string
+		filter  Filter
+		payload []byte
+		want    bool
+	}{
+		{
+			name:    "empty filter, match",
+			filter:  "",
+			payload: payload,
+			want:    true,
+		},
+		{
+			name:    "equal, match",
+			filter:  `payload.orgID == "org"`,
+			payload: payload,
+			want:    true,
+		},
+		{
+			name:    "not equal, no match",
+			filter:  `payload.orgID == "other"`,
+			payload: payload,
+			want:    false,
+		},
+		{
+			name:    "nested field, match",
+			filter:  `payload.event_payload.userName.endsWith("@example.com")`,
+			payload: payload,
+			want:    true,
+		},
+		{
+			name:    "has field, no match",
+			filter:  `has(payload.clientID) && payload.clientID == "client"`,
+			payload: payload,
+			want:    false,
+		},
+		{
+			name:    "missing field, no match",
+			filter:  `payload.clientID == "client"`,
+			payload: payload,
+			want:    false,
+		},
+		{
+			name:    "empty payload, no match",
+			filter:  `payload.orgID == "org"`,
+			payload: nil,
+			want:    false,
+		},
+		{
+			name:    "not boolean, no match",
+			filter:  `payload.orgID`,
+			payload: payload,
+			want:    false,
+		},
+		{
+			name:    "invalid filter, no match",
+			filter:  `payload.orgID ==`,
+			payload: payload,
+			want:    false,
+		},
+		{
+			name:    "cost limit exceeded, no match",
+			filter:  `[1, 2, 3, 4, 5, 6, 7, 8, 9, 10].all(a, [1, 2, 3, 4, 5, 6, 7, 8, 9, 10].all(b, [1, 2, 3, 4, 5, 6, 7, 8, 9, 10].all(c, [1, 2, 3, 4, 5, 6, 7, 8, 9, 10].all(d, [1, 2, 3, 4, 5, 6, 7, 8, 9, 10].all(e, a+b+c+d+e > 0)))))`,
+			payload: payload,
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.filter.Matches(context.Background(), tt.payload)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -12,6 +12,7 @@ const (
 	TargetTypeWebhook TargetType = iota
 	TargetTypeCall
 	TargetTypeAsync
+	TargetTypeGRPC
 )
 
 type PayloadType uint
@@ -23,6 +24,41 @@ const (
 	PayloadTypeJWE
 )
 
+// SupportedByGRPC reports whether the payload can be sent to a gRPC target.
+// gRPC targets receive typed messages, signed or encrypted tokens are not supported.
+func (p PayloadType) SupportedByGRPC() bool {
+	return p == PayloadTypeUnspecified || p == PayloadTypeJSON
+}
+
+// MaxRetryBackoff is the upper limit of the delay between two retries.
+// It also applies to retry policies without a MaxBackoff.
+const MaxRetryBackoff = 24 * time.Hour
+
+// RetryPolicy defines if and when failed calls of event executions are retried.
+// Calls still failing after the last retry are stored as failed deliveries, which can be re-driven.
+type RetryPolicy struct {
+	// MaxRetries is the amount of retries after the first failed call.
+	MaxRetries uint8 `json:"max_retries,omitempty"`
+	// InitialBackoff is the delay before the first retry, the delay is doubled for every further retry.
+	InitialBackoff time.Duration `json:"initial_backoff,omitempty"`
+	// MaxBackoff limits the delay between two retries, [MaxRetryBackoff] is applied if not set.
+	MaxBackoff time.Duration `json:"max_backoff,omitempty"`
+}
+
+// Backoff returns the delay before the given retry, starting with 1 for the first retry.
+// The delay is doubled only until it reaches the limit, so it can't overflow.
+func (p *RetryPolicy) Backoff(retry uint8) time.Duration {
+	maxBackoff := p.MaxBackoff
+	if maxBackoff <= 0 || maxBackoff > MaxRetryBackoff {
+		maxBackoff = MaxRetryBackoff
+	}
+	backoff := p.InitialBackoff
+	for i := uint8(1); i < retry && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, maxBackoff)
+}
+
 type Target struct {
 	ExecutionID      string              `json:"execution_id,omitempty"`
 	TargetID         string              `json:"target_id,omitempty"`
@@ -34,6 +70,13 @@ type Target struct {
 	PayloadType      PayloadType         `json:"payload_type,omitempty"`
 	EncryptionKey    []byte              `json:"encryption_key,omitempty"`
 	EncryptionKeyID  string              `json:"encryption_key_id,omitempty"`
+	// ClientCertificate contains the PEM encoded certificate and private key used for mTLS to gRPC targets.
+	ClientCertificate *crypto.CryptoValue `json:"client_certificate,omitempty"`
+	RetryPolicy       *RetryPolicy        `json:"retry_policy,omitempty"`
+	// Filter is the expression of the execution, which has to match the payload to call the target.
+	Filter Filter `json:"filter,omitempty"`
+	// Sequence is the revision of the target, it changes with every change of the target.
+	Sequence uint64 `json:"sequence,omitempty"`
 }
 
 func (e *Target) GetExecutionID() string {
@@ -42,6 +85,9 @@ func (e *Target) GetExecutionID() string {
 func (e *Target) GetTargetID() string {
 	return e.TargetID
 }
+func (e *Target) GetSequence() uint64 {
+	return e.Sequence
+}
 func (e *Target) IsInterruptOnError() bool {
 	return e.InterruptOnError
 }
@@ -70,3 +116,18 @@ func (e *Target) GetEncryptionKey() []byte {
 func (e *Target) GetEncryptionKeyID() string {
 	return e.EncryptionKeyID
 }
+
+func (e *Target) GetRetryPolicy() *RetryPolicy {
+	return e.RetryPolicy
+}
+
+func (e *Target) GetFilter() Filter {
+	return e.Filter
+}
+
+func (e *Target) GetClientCertificate(alg crypto.EncryptionAlgorithm) ([]byte, error) {
+	if e.ClientCertificate == nil {
+		return nil, nil
+	}
+	return crypto.Decrypt(e.ClientCertificate, alg)
+}
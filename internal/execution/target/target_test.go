@@ -0,0 +1,65 @@
+package target
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy RetryPolicy
+		retry  uint8
+		want   time.Duration
+	}{
+		{
+			name:   "first retry",
+			policy: RetryPolicy{MaxRetries: 3, InitialBackoff: time.Second, MaxBackoff: time.Minute},
+			retry:  1,
+			want:   time.Second,
+		},
+		{
+			name:   "doubled",
+			policy: RetryPolicy{MaxRetries: 3, InitialBackoff: time.Second, MaxBackoff: time.Minute},
+			retry:  3,
+			want:   4 * time.Second,
+		},
+		{
+			name:   "limited by max backoff",
+			policy: RetryPolicy{MaxRetries: 10, InitialBackoff: time.Second, MaxBackoff: time.Minute},
+			retry:  10,
+			want:   time.Minute,
+		},
+		{
+			name:   "max backoff not set, limited to upper limit",
+			policy: RetryPolicy{MaxRetries: 20, InitialBackoff: time.Hour},
+			retry:  20,
+			want:   MaxRetryBackoff,
+		},
+		{
+			name:   "max backoff above upper limit",
+			policy: RetryPolicy{MaxRetries: 20, InitialBackoff: time.Hour, MaxBackoff: 48 * time.Hour},
+			retry:  20,
+			want:   MaxRetryBackoff,
+		},
+		{
+			name:   "max retries, no overflow",
+			policy: RetryPolicy{MaxRetries: 255, InitialBackoff: 24 * time.Hour},
+			retry:  255,
+			want:   MaxRetryBackoff,
+		},
+		{
+			name:   "max retries, small initial backoff, no overflow",
+			policy: RetryPolicy{MaxRetries: 255, InitialBackoff: time.Nanosecond},
+			retry:  255,
+			want:   MaxRetryBackoff,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.policy.Backoff(tt.retry))
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package execution
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/oidc/sign"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	target_domain "github.com/zitadel/zitadel/internal/execution/target"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// TestTarget calls the target with the payload and returns the whole exchange.
+// Calls of async targets are awaited, so the response can be returned.
+// The call is not recorded in the logstore.
+func TestTarget(
+	ctx context.Context,
+	target target_domain.Target,
+	info ContextInfoRequest,
+	alg crypto.EncryptionAlgorithm,
+	activeSigningKey GetActiveSigningWebKey,
+	client *http.Client,
+) *Exchange {
+	if target.TargetType == target_domain.TargetTypeAsync {
+		target.TargetType = target_domain.TargetTypeCall
+	}
+	exchange := newExchange()
+	resp, err := callTarget(withExchange(ctx, exchange), target, info, alg, sign.GetSignerOnce(activeSigningKey), &sync.Map{}, client)
+	exchange.finish(target, resp, err)
+	return exchange
+}
+
+type testContextInfo []byte
+
+func (t testContextInfo) GetHTTPRequestBody() []byte {
+	return t
+}
+
+// TestContextInfo returns a synthetic payload for the execution, shaped like the payload sent in a real execution.
+// The fields of the passed payload are added to the synthetic payload and override existing fields.
+func TestContextInfo(ctx context.Context, executionID string, payload map[string]any) (ContextInfoRequest, error) {
+	executionType, condition, _ := strings.Cut(executionID, "/")
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	ctxData := authz.GetCtxData(ctx)
+
+	info := make(map[string]any)
+	switch executionType {
+	case domain.ExecutionTypeRequest.String():
+		info["fullMethod"] = "/" + condition
+		info["instanceID"] = instanceID
+		info["orgID"] = ctxData.OrgID
+		info["userID"] = ctxData.UserID
+		info["request"] = map[string]any{}
+	case domain.ExecutionTypeResponse.String():
+		info["fullMethod"] = "/" + condition
+		info["instanceID"] = instanceID
+		info["orgID"] = ctxData.OrgID
+		info["userID"] = ctxData.UserID
+		info["request"] = map[string]any{}
+		info["response"] = map[string]any{}
+	case domain.ExecutionTypeFunction.String():
+		info["function"] = condition
+	case domain.ExecutionTypeEvent.String():
+		info["aggregateID"] = instanceID
+		info["instanceID"] = instanceID
+		info["resourceOwner"] = instanceID
+		info["sequence"] = 1
+		info["event_type"] = condition
+		info["created_at"] = time.Now().Format(time.RFC3339Nano)
+		info["userID"] = ctxData.UserID
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "EXEC-Ts7tP1", "Errors.Execution.Unknown")
+	}
+	for key, value := range payload {
+		info[key] = value
+	}
+	body, err := json.Marshal(info)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "EXEC-Ts7tP2", "Errors.Internal")
+	}
+	return testContextInfo(body), nil
+}
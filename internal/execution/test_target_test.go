@@ -0,0 +1,101 @@
+package execution
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestTestContextInfo(t *testing.T) {
+	type args struct {
+		executionID string
+		payload     map[string]any
+	}
+	type res struct {
+		fields map[string]any
+		err    func(error) bool
+	}
+	tests := []struct {
+		name string
+		args args
+		res  res
+	}{
+		{
+			"unknown execution type, error",
+			args{
+				executionID: "unknown/condition",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"request, ok",
+			args{
+				executionID: "request/zitadel.session.v2.SessionService/SetSession",
+			},
+			res{
+				fields: map[string]any{
+					"fullMethod": "/zitadel.session.v2.SessionService/SetSession",
+					"instanceID": "instance",
+					"request":    map[string]any{},
+				},
+			},
+		},
+		{
+			"function, ok",
+			args{
+				executionID: "function/preuserinfo",
+			},
+			res{
+				fields: map[string]any{
+					"function": "preuserinfo",
+				},
+			},
+		},
+		{
+			"event with payload, overridden",
+			args{
+				executionID: "event/user.human.added",
+				payload: map[string]any{
+					"aggregateID": "user1",
+					"event_payload": map[string]any{
+						"userName": "username",
+					},
+				},
+			},
+			res{
+				fields: map[string]any{
+					"aggregateID": "user1",
+					"instanceID":  "instance",
+					"event_type":  "user.human.added",
+					"event_payload": map[string]any{
+						"userName": "username",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := authz.WithInstanceID(context.Background(), "instance")
+			info, err := TestContextInfo(ctx, tt.args.executionID, tt.args.payload)
+			if tt.res.err != nil {
+				assert.True(t, tt.res.err(err))
+				return
+			}
+			require.NoError(t, err)
+			body := make(map[string]any)
+			require.NoError(t, json.Unmarshal(info.GetHTTPRequestBody(), &body))
+			for key, value := range tt.res.fields {
+				assert.Equal(t, value, body[key], key)
+			}
+		})
+	}
+}
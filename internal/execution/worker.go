@@ -6,20 +6,38 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/riverqueue/river"
+	"github.com/zitadel/logging"
 
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/oidc/sign"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/denylist"
 	target_domain "github.com/zitadel/zitadel/internal/execution/target"
+	"github.com/zitadel/zitadel/internal/queue"
 	exec_repo "github.com/zitadel/zitadel/internal/repository/execution"
+	target_repo "github.com/zitadel/zitadel/internal/repository/target"
 )
 
+type Commands interface {
+	TargetDeliveryFailed(ctx context.Context, request *exec_repo.Request, targetID, errorMessage string, attempts uint8) error
+	TargetDeliverySucceeded(ctx context.Context, deliveryID, instanceID string) error
+}
+
+type Queue interface {
+	Insert(ctx context.Context, args river.JobArgs, opts ...queue.InsertOpt) error
+}
+
 type Worker struct {
 	river.WorkerDefaults[*exec_repo.Request]
 
 	config     WorkerConfig
+	commands   Commands
+	queue      Queue
 	httpClient *http.Client
 	now        NowFunc
 
@@ -37,6 +55,7 @@ func (w *Worker) Timeout(*river.Job[*exec_repo.Request]) time.Duration {
 // Work implements [river.Worker].
 func (w *Worker) Work(ctx context.Context, job *river.Job[*exec_repo.Request]) error {
 	ctx = ContextWithExecuter(ctx, job.Args.Aggregate)
+	ctx = authz.WithInstanceID(ctx, job.Args.Aggregate.InstanceID)
 
 	// if the event is too old, we can directly return as it will be removed anyway
 	if job.CreatedAt.Add(w.config.MaxTtl).Before(w.now()) {
@@ -49,14 +68,88 @@ func (w *Worker) Work(ctx context.Context, job *river.Job[*exec_repo.Request]) e
 		return river.JobCancel(fmt.Errorf("unable to unmarshal targets because %w", err))
 	}
 
-	_, err = CallTargets(ctx, targets, exec_repo.ContextInfoFromRequest(job.Args), w.targetEncAlg, w.activeSigningKey, w.httpClient)
-	if err != nil {
-		// If there is an error returned from the targets, it means that the execution was interrupted
-		return river.JobCancel(fmt.Errorf("interruption during call of targets because %w", err))
+	info := exec_repo.ContextInfoFromRequest(job.Args)
+	// We make sure the signer and its key are only fetched once per job.
+	signerOnce := sign.GetSignerOnce(w.activeSigningKey)
+	encrypters := &sync.Map{}
+	for i, target := range targets {
+		// the response of event executions is irrelevant, but the call is awaited so failed calls can be retried
+		if target.TargetType == target_domain.TargetTypeAsync {
+			target.TargetType = target_domain.TargetTypeCall
+		}
+		_, err := CallTarget(ctx, target, info, w.targetEncAlg, signerOnce, encrypters, w.httpClient)
+		if err == nil {
+			if err := w.delivered(ctx, job.Args); err != nil {
+				return err
+			}
+			continue
+		}
+		logging.WithFields("instanceID", job.Args.Aggregate.InstanceID, "target", target.GetTargetID()).WithError(err).Info("error calling target")
+		// the following targets are only called after the interrupting target succeeded
+		failedTargets := targets[i : i+1]
+		if target.IsInterruptOnError() {
+			failedTargets = targets[i:]
+		}
+		retried, failErr := w.failed(ctx, job.Args, failedTargets, err)
+		if failErr != nil {
+			return failErr
+		}
+		if target.IsInterruptOnError() {
+			if retried {
+				return nil
+			}
+			// If there is an error returned from the targets, it means that the execution was interrupted
+			return river.JobCancel(fmt.Errorf("interruption during call of targets because %w", err))
+		}
 	}
 	return nil
 }
 
+// failed schedules a retry of the failed targets if the retry policy of the first target allows it.
+// Otherwise, the first target is stored as failed delivery.
+// Failed deliveries of the events about failed deliveries are not stored, so a failing target cannot create a loop.
+func (w *Worker) failed(ctx context.Context, request *exec_repo.Request, targets []target_domain.Target, callErr error) (retried bool, err error) {
+	target := targets[0]
+	if policy := target.GetRetryPolicy(); policy != nil && request.Attempt < policy.MaxRetries {
+		retry, err := request.WithTargets(targets)
+		if err != nil {
+			return false, river.JobCancel(fmt.Errorf("unable to marshal targets because %w", err))
+		}
+		retry.Attempt++
+		return true, w.queue.Insert(ctx,
+			retry,
+			queue.WithQueueName(exec_repo.QueueName),
+			queue.WithScheduledAt(w.now().Add(policy.Backoff(retry.Attempt))),
+		)
+	}
+	if strings.HasPrefix(string(request.EventType), string(target_repo.DeliveryEventTypePrefix)) {
+		return false, nil
+	}
+	failed, err := request.WithTargets(targets[:1])
+	if err != nil {
+		return false, river.JobCancel(fmt.Errorf("unable to marshal targets because %w", err))
+	}
+	return false, w.commands.TargetDeliveryFailed(
+		ctx,
+		failed,
+		target.GetTargetID(),
+		callErr.Error(),
+		request.Attempt+1,
+	)
+}
+
+// delivered marks a re-driven delivery as delivered.
+func (w *Worker) delivered(ctx context.Context, request *exec_repo.Request) error {
+	if request.DeliveryID == "" {
+		return nil
+	}
+	return w.commands.TargetDeliverySucceeded(
+		ctx,
+		request.DeliveryID,
+		request.Aggregate.InstanceID,
+	)
+}
+
 // NowFunc makes [time.Now] mockable
 type NowFunc func() time.Time
 
@@ -69,6 +162,8 @@ type WorkerConfig struct {
 
 func NewWorker(
 	config WorkerConfig,
+	commands Commands,
+	queue Queue,
 	targetEncAlg crypto.EncryptionAlgorithm,
 	activeSigningKey GetActiveSigningWebKey,
 	now NowFunc,
@@ -76,6 +171,8 @@ func NewWorker(
 ) *Worker {
 	return &Worker{
 		config:           config,
+		commands:         commands,
+		queue:            queue,
 		httpClient:       httpClient,
 		now:              now,
 		targetEncAlg:     targetEncAlg,
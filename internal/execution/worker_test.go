@@ -17,8 +17,10 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/execution"
 	target_domain "github.com/zitadel/zitadel/internal/execution/target"
+	"github.com/zitadel/zitadel/internal/queue"
 	"github.com/zitadel/zitadel/internal/repository/action"
 	exec_repo "github.com/zitadel/zitadel/internal/repository/execution"
+	target_repo "github.com/zitadel/zitadel/internal/repository/target"
 	"github.com/zitadel/zitadel/internal/repository/user"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -33,6 +35,38 @@ type wantWorker struct {
 	targets        []target
 	sendStatusCode int
 	err            assert.ErrorAssertionFunc
+	retries        []*exec_repo.Request
+	failed         []failedDelivery
+	delivered      []string
+}
+
+type failedDelivery struct {
+	targetID string
+	attempts uint8
+}
+
+type mockCommands struct {
+	failed    []failedDelivery
+	delivered []string
+}
+
+func (m *mockCommands) TargetDeliveryFailed(_ context.Context, _ *exec_repo.Request, targetID, _ string, attempts uint8) error {
+	m.failed = append(m.failed, failedDelivery{targetID: targetID, attempts: attempts})
+	return nil
+}
+
+func (m *mockCommands) TargetDeliverySucceeded(_ context.Context, deliveryID, _ string) error {
+	m.delivered = append(m.delivered, deliveryID)
+	return nil
+}
+
+type mockQueue struct {
+	inserted []*exec_repo.Request
+}
+
+func (m *mockQueue) Insert(_ context.Context, args river.JobArgs, _ ...queue.InsertOpt) error {
+	m.inserted = append(m.inserted, args.(*exec_repo.Request))
+	return nil
 }
 
 type target target_domain.Target
@@ -51,13 +85,15 @@ func (t *target) validate(expectedBody []byte) func(*testing.T, []byte) bool {
 	}
 }
 
-func newExecutionWorker(f fieldsWorker) *execution.Worker {
+func newExecutionWorker(f fieldsWorker, commands execution.Commands, queue execution.Queue) *execution.Worker {
 	return execution.NewWorker(
 		execution.WorkerConfig{
 			Workers:             1,
 			TransactionDuration: 5 * time.Second,
 			MaxTtl:              5 * time.Minute,
 		},
+		commands,
+		queue,
 		nil,
 		mockGetActiveSigningWebKey,
 		f.now,
@@ -212,6 +248,149 @@ func Test_handleEventExecution(t *testing.T) {
 						err: func(tt assert.TestingT, err error, i ...interface{}) bool {
 							return errors.Is(err, zerrors.ThrowPreconditionFailed(nil, "EXEC-dra6yamk98", "Errors.Execution.Failed"))
 						},
+						failed: []failedDelivery{{targetID: "targetID", attempts: 1}},
+					}
+			},
+		},
+		{
+			"single, failed 400, retry",
+			func() (fieldsWorker, argsWorker, wantWorker) {
+				return fieldsWorker{
+						now: testNow,
+					},
+					argsWorker{
+						job: &river.Job[*exec_repo.Request]{
+							JobRow: &rivertype.JobRow{
+								CreatedAt: time.Now(),
+							},
+							Args: &exec_repo.Request{
+								Aggregate: &eventstore.Aggregate{
+									InstanceID:    instanceID,
+									Type:          action.AggregateType,
+									Version:       action.AggregateVersion,
+									ID:            eventID,
+									ResourceOwner: orgID,
+								},
+								Sequence:  1,
+								CreatedAt: time.Now().UTC(),
+								EventType: action.AddedEventType,
+								UserID:    userID,
+								EventData: []byte(eventData),
+							},
+						},
+					},
+					wantWorker{
+						targets:        mockRetryTargets(2, target_domain.PayloadTypeJSON),
+						sendStatusCode: http.StatusBadRequest,
+						retries:        []*exec_repo.Request{{Attempt: 1}},
+					}
+			},
+		},
+		{
+			"single, failed 400, retries exhausted",
+			func() (fieldsWorker, argsWorker, wantWorker) {
+				return fieldsWorker{
+						now: testNow,
+					},
+					argsWorker{
+						job: &river.Job[*exec_repo.Request]{
+							JobRow: &rivertype.JobRow{
+								CreatedAt: time.Now(),
+							},
+							Args: &exec_repo.Request{
+								Aggregate: &eventstore.Aggregate{
+									InstanceID:    instanceID,
+									Type:          action.AggregateType,
+									Version:       action.AggregateVersion,
+									ID:            eventID,
+									ResourceOwner: orgID,
+								},
+								Sequence:  1,
+								CreatedAt: time.Now().UTC(),
+								EventType: action.AddedEventType,
+								UserID:    userID,
+								EventData: []byte(eventData),
+								Attempt:   2,
+							},
+						},
+					},
+					wantWorker{
+						targets:        mockRetryTargets(2, target_domain.PayloadTypeJSON),
+						sendStatusCode: http.StatusBadRequest,
+						err: func(tt assert.TestingT, err error, i ...interface{}) bool {
+							return errors.Is(err, new(river.JobCancelError))
+						},
+						failed: []failedDelivery{{targetID: "targetID", attempts: 3}},
+					}
+			},
+		},
+		{
+			"single, failed 400, delivery event not stored",
+			func() (fieldsWorker, argsWorker, wantWorker) {
+				return fieldsWorker{
+						now: testNow,
+					},
+					argsWorker{
+						job: &river.Job[*exec_repo.Request]{
+							JobRow: &rivertype.JobRow{
+								CreatedAt: time.Now(),
+							},
+							Args: &exec_repo.Request{
+								Aggregate: &eventstore.Aggregate{
+									InstanceID:    instanceID,
+									Type:          target_repo.AggregateType,
+									Version:       target_repo.AggregateVersion,
+									ID:            eventID,
+									ResourceOwner: instanceID,
+								},
+								Sequence:  1,
+								CreatedAt: time.Now().UTC(),
+								EventType: target_repo.DeliveryFailedEventType,
+								EventData: []byte(eventData),
+							},
+						},
+					},
+					wantWorker{
+						targets:        mockTargets(target_domain.PayloadTypeJSON),
+						sendStatusCode: http.StatusBadRequest,
+						err: func(tt assert.TestingT, err error, i ...interface{}) bool {
+							return errors.Is(err, new(river.JobCancelError))
+						},
+					}
+			},
+		},
+		{
+			"redrive, delivered",
+			func() (fieldsWorker, argsWorker, wantWorker) {
+				return fieldsWorker{
+						now: testNow,
+					},
+					argsWorker{
+						job: &river.Job[*exec_repo.Request]{
+							JobRow: &rivertype.JobRow{
+								CreatedAt: time.Now(),
+							},
+							Args: &exec_repo.Request{
+								Aggregate: &eventstore.Aggregate{
+									InstanceID:    instanceID,
+									Type:          action.AggregateType,
+									Version:       action.AggregateVersion,
+									ID:            eventID,
+									ResourceOwner: orgID,
+								},
+								Sequence:   1,
+								CreatedAt:  time.Now().UTC(),
+								EventType:  action.AddedEventType,
+								UserID:     userID,
+								EventData:  []byte(eventData),
+								DeliveryID: "deliveryID",
+							},
+						},
+					},
+					wantWorker{
+						targets:        mockTargets(target_domain.PayloadTypeJSON),
+						sendStatusCode: http.StatusOK,
+						delivered:      []string{"deliveryID"},
 					}
 			},
 		},
@@ -276,11 +455,20 @@ func Test_handleEventExecution(t *testing.T) {
 			require.NoError(t, err)
 			a.job.Args.TargetsData = data
 
-			err = newExecutionWorker(f).Work(
+			commands := new(mockCommands)
+			queue := new(mockQueue)
+			err = newExecutionWorker(f, commands, queue).Work(
 				authz.WithInstanceID(context.Background(), instanceID),
 				a.job,
 			)
 
+			assert.Equal(t, w.failed, commands.failed)
+			assert.Equal(t, w.delivered, commands.delivered)
+			require.Len(t, queue.inserted, len(w.retries))
+			for i, retry := range w.retries {
+				assert.Equal(t, retry.Attempt, queue.inserted[i].Attempt)
+			}
+
 			if w.err != nil {
 				assert.Error(t, err)
 				return
@@ -311,6 +499,17 @@ func mockTarget(payloadType target_domain.PayloadType) target {
 	}
 }
 
+func mockRetryTargets(maxRetries uint8, payloadTypes ...target_domain.PayloadType) []target {
+	targets := mockTargets(payloadTypes...)
+	for i := range targets {
+		targets[i].RetryPolicy = &target_domain.RetryPolicy{
+			MaxRetries:     maxRetries,
+			InitialBackoff: time.Second,
+		}
+	}
+	return targets
+}
+
 func mockTargets(payloadTypes ...target_domain.PayloadType) []target {
 	targets := make([]target, len(payloadTypes))
 	for i, payloadType := range payloadTypes {
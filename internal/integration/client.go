@@ -1256,6 +1256,12 @@ func (i *Instance) CreateTarget(ctx context.Context, t *testing.T, name, endpoin
 		req.TargetType = &action.CreateTargetRequest_RestAsync{
 			RestAsync: &action.RESTAsync{},
 		}
+	case target_domain.TargetTypeGRPC:
+		req.TargetType = &action.CreateTargetRequest_Grpc{
+			Grpc: &action.GRPCTarget{
+				InterruptOnError: interrupt,
+			},
+		}
 	}
 	target, err := i.Client.ActionV2.CreateTarget(ctx, req)
 	require.NoError(t, err)
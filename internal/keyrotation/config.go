@@ -0,0 +1,10 @@
+package keyrotation
+
+type Config struct {
+	// Enabled schedules the re-encryption of the secrets.
+	Enabled bool
+	// Interval is the cron expression defining when the secrets are re-encrypted.
+	Interval string
+	// MaxAttempts is the maximum number of attempts for a failed re-encryption.
+	MaxAttempts uint8
+}
@@ -0,0 +1,114 @@
+// Package keyrotation re-encrypts the secrets stored in the eventstore with the current encryption keys.
+// After an encryption key was rotated (a new key ID is configured as EncryptionKeyID and the previous one is kept in the DecryptionKeyIDs),
+// the job pushes an event with the re-encrypted values for every secret still encrypted with a previous key.
+// As soon as no secret is encrypted with the previous key anymore, it can be retired using `zitadel keys retire`.
+package keyrotation
+
+import (
+	"context"
+	"errors"
+
+	"github.com/riverqueue/river"
+	"github.com/robfig/cron/v3"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/queue"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	QueueName = "encryption_key_rotation"
+	// SystemUserID is the editor of the pushed events.
+	SystemUserID = "SYSTEM"
+)
+
+var _ river.Worker[*ReEncryptSecrets] = (*Worker)(nil)
+
+// ReEncryptSecrets are the arguments of the periodic re-encryption job.
+type ReEncryptSecrets struct{}
+
+func (*ReEncryptSecrets) Kind() string {
+	return "encryption_key_rotation"
+}
+
+type Worker struct {
+	river.WorkerDefaults[*ReEncryptSecrets]
+
+	commands Commands
+	queries  Queries
+}
+
+type Commands interface {
+	ReEncryptSMTPConfigSecrets(ctx context.Context, instanceID string) (int, error)
+}
+
+type Queries interface {
+	SearchInstances(ctx context.Context, queries *query.InstanceSearchQueries) (*query.Instances, error)
+}
+
+// Register implements the [queue.Worker] interface.
+func (w *Worker) Register(workers *river.Workers, queues map[string]river.QueueConfig) {
+	river.AddWorker[*ReEncryptSecrets](workers, w)
+	queues[QueueName] = river.QueueConfig{
+		MaxWorkers: 1,
+	}
+}
+
+// Work implements the [river.Worker] interface.
+// The secrets of all instances are re-encrypted, an instance failing doesn't prevent the others from being re-encrypted.
+func (w *Worker) Work(ctx context.Context, _ *river.Job[*ReEncryptSecrets]) error {
+	instances, err := w.queries.SearchInstances(ctx, &query.InstanceSearchQueries{})
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, instance := range instances.Instances {
+		instanceCtx := authz.SetCtxData(authz.WithInstanceID(ctx, instance.ID), authz.CtxData{UserID: SystemUserID, OrgID: instance.ID})
+		reEncrypted, err := w.commands.ReEncryptSMTPConfigSecrets(instanceCtx, instance.ID)
+		if err != nil {
+			logging.WithFields("instanceID", instance.ID).WithError(err).Warn("unable to re-encrypt smtp secrets")
+			errs = append(errs, err)
+			continue
+		}
+		if reEncrypted > 0 {
+			logging.WithFields("instanceID", instance.ID, "configs", reEncrypted).Info("smtp secrets re-encrypted")
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func Register(
+	ctx context.Context,
+	q *queue.Queue,
+	commands Commands,
+	queries Queries,
+	config *Config,
+) {
+	if config == nil || !config.Enabled {
+		return
+	}
+	q.AddWorkers(ctx, &Worker{
+		commands: commands,
+		queries:  queries,
+	})
+}
+
+func Start(ctx context.Context, config *Config, q *queue.Queue) error {
+	if config == nil || !config.Enabled {
+		return nil
+	}
+	schedule, err := cron.ParseStandard(config.Interval)
+	if err != nil {
+		return zerrors.ThrowInvalidArgument(err, "KEYRO-Vn3sw", "invalid interval")
+	}
+	q.AddPeriodicJob(
+		ctx,
+		schedule,
+		&ReEncryptSecrets{},
+		queue.WithQueueName(QueueName),
+		queue.WithMaxAttempts(config.MaxAttempts),
+	)
+	return nil
+}
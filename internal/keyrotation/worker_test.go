@@ -0,0 +1,93 @@
+package keyrotation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/riverqueue/river"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type queriesFunc func(ctx context.Context, queries *query.InstanceSearchQueries) (*query.Instances, error)
+
+func (f queriesFunc) SearchInstances(ctx context.Context, queries *query.InstanceSearchQueries) (*query.Instances, error) {
+	return f(ctx, queries)
+}
+
+type commands struct {
+	errs   map[string]error
+	called []string
+}
+
+func (c *commands) ReEncryptSMTPConfigSecrets(ctx context.Context, instanceID string) (int, error) {
+	if authz.GetInstance(ctx).InstanceID() != instanceID || authz.GetCtxData(ctx).UserID != SystemUserID {
+		return 0, zerrors.ThrowInternal(nil, "TEST", "wrong context")
+	}
+	c.called = append(c.called, instanceID)
+	if err := c.errs[instanceID]; err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+func instances(ids ...string) queriesFunc {
+	return func(context.Context, *query.InstanceSearchQueries) (*query.Instances, error) {
+		instances := &query.Instances{Instances: make([]*query.Instance, len(ids))}
+		for i, id := range ids {
+			instances.Instances[i] = &query.Instance{ID: id}
+		}
+		return instances, nil
+	}
+}
+
+func TestWorker_Work(t *testing.T) {
+	errReEncrypt := zerrors.ThrowInvalidArgument(nil, "TEST", "key not available")
+	tests := []struct {
+		name       string
+		queries    Queries
+		errs       map[string]error
+		wantCalled []string
+		wantErr    error
+	}{
+		{
+			name: "search instances fails, error",
+			queries: queriesFunc(func(context.Context, *query.InstanceSearchQueries) (*query.Instances, error) {
+				return nil, zerrors.ThrowInternal(nil, "TEST", "db error")
+			}),
+			wantErr: zerrors.ThrowInternal(nil, "TEST", "db error"),
+		},
+		{
+			name:       "all instances re-encrypted",
+			queries:    instances("instance1", "instance2"),
+			wantCalled: []string{"instance1", "instance2"},
+		},
+		{
+			name:       "instance fails, others re-encrypted",
+			queries:    instances("instance1", "instance2"),
+			errs:       map[string]error{"instance1": errReEncrypt},
+			wantCalled: []string{"instance1", "instance2"},
+			wantErr:    errReEncrypt,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commands := &commands{errs: tt.errs}
+			w := &Worker{
+				commands: commands,
+				queries:  tt.queries,
+			}
+			err := w.Work(context.Background(), &river.Job[*ReEncryptSecrets]{})
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantCalled, commands.called)
+		})
+	}
+}
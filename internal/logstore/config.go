@@ -3,6 +3,7 @@ package logstore
 type Configs struct {
 	Access    *Config
 	Execution *Config
+	Target    *TargetConfig
 }
 
 type Config struct {
@@ -12,3 +13,12 @@ type Config struct {
 type StdConfig struct {
 	Enabled bool
 }
+
+type TargetConfig struct {
+	Stdout   *StdConfig
+	Database *DatabaseConfig
+}
+
+type DatabaseConfig struct {
+	EmitterConfig `mapstructure:",squash"`
+}
@@ -5,11 +5,17 @@ import (
 	"time"
 
 	"github.com/benbjohnson/clock"
+	"github.com/zitadel/logging"
 )
 
 type EmitterConfig struct {
-	Enabled  bool
-	Debounce *DebouncerConfig
+	Enabled bool
+	// Keep defines how long the records are kept, 0 keeps them forever.
+	// It only applies to emitters implementing [LogCleanupper].
+	Keep time.Duration
+	// CleanupInterval defines how often the records older than Keep are removed.
+	CleanupInterval time.Duration
+	Debounce        *DebouncerConfig
 }
 
 type emitter[T LogRecord[T]] struct {
@@ -61,9 +67,32 @@ func NewEmitter[T LogRecord[T]](ctx context.Context, clock clock.Clock, cfg *Emi
 	if cfg.Debounce != nil && (cfg.Debounce.MinFrequency > 0 || cfg.Debounce.MaxBulkSize > 0) {
 		svc.debouncer = newDebouncer[T](ctx, *cfg.Debounce, clock, newStorageBulkSink(svc.emitter))
 	}
+
+	if cfg.Keep > 0 && cfg.CleanupInterval > 0 {
+		if cleanupper, ok := logger.(LogCleanupper[T]); ok {
+			go svc.startCleanup(cleanupper, cfg.CleanupInterval, cfg.Keep)
+		}
+	}
 	return svc, nil
 }
 
+// startCleanup periodically removes the records older than keep until the context of the emitter is done,
+// so emitting the records doesn't have to.
+func (s *emitter[T]) startCleanup(cleanupper LogCleanupper[T], interval, keep time.Duration) {
+	ticker := s.clock.Ticker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			if err := cleanupper.Cleanup(s.ctx, keep); err != nil {
+				logging.WithError(err).Error("unable to clean up logs")
+			}
+		}
+	}
+}
+
 func (s *emitter[T]) Emit(ctx context.Context, record T) (err error) {
 	if !s.enabled {
 		return nil
@@ -0,0 +1,97 @@
+// The library github.com/benbjohnson/clock fails when race is enabled
+// https://github.com/benbjohnson/clock/issues/44
+//go:build !race
+
+package logstore
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testRecord struct{}
+
+func (r *testRecord) Normalize() *testRecord { return r }
+
+type testCleanupper struct {
+	mux      sync.Mutex
+	emitted  int
+	cleanups []time.Duration
+}
+
+func (c *testCleanupper) Emit(_ context.Context, bulk []*testRecord) error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.emitted += len(bulk)
+	return nil
+}
+
+func (c *testCleanupper) Cleanup(_ context.Context, keep time.Duration) error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.cleanups = append(c.cleanups, keep)
+	return nil
+}
+
+func (c *testCleanupper) calls() (emitted int, cleanups []time.Duration) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return c.emitted, append([]time.Duration(nil), c.cleanups...)
+}
+
+func TestEmitter_cleanup(t *testing.T) {
+	tests := []struct {
+		name         string
+		cfg          *EmitterConfig
+		wantCleanups []time.Duration
+	}{
+		{
+			name:         "periodic cleanup",
+			cfg:          &EmitterConfig{Enabled: true, Keep: time.Hour, CleanupInterval: time.Minute},
+			wantCleanups: []time.Duration{time.Hour, time.Hour},
+		},
+		{
+			name: "keep forever",
+			cfg:  &EmitterConfig{Enabled: true, CleanupInterval: time.Minute},
+		},
+		{
+			name: "no cleanup interval",
+			cfg:  &EmitterConfig{Enabled: true, Keep: time.Hour},
+		},
+		{
+			name: "disabled",
+			cfg:  &EmitterConfig{Keep: time.Hour, CleanupInterval: time.Minute},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			mock := clock.NewMock()
+			storage := new(testCleanupper)
+			e, err := NewEmitter[*testRecord](ctx, mock, tt.cfg, storage)
+			require.NoError(t, err)
+			// wait for the cleanup to register its ticker
+			time.Sleep(10 * time.Millisecond)
+
+			require.NoError(t, e.Emit(ctx, &testRecord{}))
+			_, cleanups := storage.calls()
+			assert.Empty(t, cleanups, "emit must not clean up")
+
+			mock.Add(time.Minute)
+			mock.Add(time.Minute)
+
+			emitted, cleanups := storage.calls()
+			if tt.cfg.Enabled {
+				assert.Equal(t, 1, emitted)
+			}
+			assert.Equal(t, tt.wantCleanups, cleanups)
+		})
+	}
+}
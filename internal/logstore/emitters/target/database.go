@@ -0,0 +1,96 @@
+package target
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/logstore"
+	"github.com/zitadel/zitadel/internal/logstore/record"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+const (
+	Table = "logstore.target_calls"
+
+	LogDateCol      = "log_date"
+	InstanceIDCol   = "instance_id"
+	TargetIDCol     = "target_id"
+	ExecutionIDCol  = "execution_id"
+	StatusCodeCol   = "status_code"
+	TookCol         = "took"
+	ResponseBodyCol = "response_body"
+	ErrorCol        = "error"
+	InterruptedCol  = "interrupted"
+)
+
+var _ logstore.LogCleanupper[*record.TargetCallLog] = (*databaseLogStorage)(nil)
+
+type databaseLogStorage struct {
+	dbClient *database.DB
+}
+
+// NewDatabaseLogStorage stores the calls of targets, so they can be queried per target.
+// The calls are removed periodically by the emitter, see [logstore.EmitterConfig].
+func NewDatabaseLogStorage(dbClient *database.DB) *databaseLogStorage {
+	return &databaseLogStorage{dbClient: dbClient}
+}
+
+func (l *databaseLogStorage) Emit(ctx context.Context, bulk []*record.TargetCallLog) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if len(bulk) == 0 {
+		return nil
+	}
+	stmt := sq.Insert(Table).
+		Columns(
+			LogDateCol,
+			InstanceIDCol,
+			TargetIDCol,
+			ExecutionIDCol,
+			StatusCodeCol,
+			TookCol,
+			ResponseBodyCol,
+			ErrorCol,
+			InterruptedCol,
+		).
+		PlaceholderFormat(sq.Dollar)
+	for _, r := range bulk {
+		stmt = stmt.Values(
+			r.LogDate,
+			r.InstanceID,
+			r.TargetID,
+			r.ExecutionID,
+			r.StatusCode,
+			r.Took,
+			r.ResponseBody,
+			r.Error,
+			r.Interrupted,
+		)
+	}
+	query, args, err := stmt.ToSql()
+	if err != nil {
+		return err
+	}
+	_, err = l.dbClient.ExecContext(ctx, query, args...)
+	return err
+}
+
+// Cleanup removes the calls older than keep.
+func (l *databaseLogStorage) Cleanup(ctx context.Context, keep time.Duration) error {
+	if keep <= 0 {
+		return nil
+	}
+	query, args, err := sq.Delete(Table).
+		Where(sq.Lt{LogDateCol: time.Now().Add(-keep)}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	_, err = l.dbClient.ExecContext(ctx, query, args...)
+	return err
+}
@@ -0,0 +1,28 @@
+package record
+
+import (
+	"time"
+)
+
+const maxTargetResponseBodyLength = 2000
+
+// TargetCallLog is the record of a single call of an action v2 target.
+type TargetCallLog struct {
+	LogDate     time.Time     `json:"logDate"`
+	InstanceID  string        `json:"instanceId"`
+	TargetID    string        `json:"targetId"`
+	ExecutionID string        `json:"executionId"`
+	StatusCode  int           `json:"statusCode,omitempty"`
+	Took        time.Duration `json:"took"`
+	// ResponseBody is truncated, so a large response does not bloat the log.
+	ResponseBody string `json:"responseBody,omitempty"`
+	Error        string `json:"error,omitempty"`
+	// Interrupted is true if the call failed and the target interrupts the execution on errors.
+	Interrupted bool `json:"interrupted"`
+}
+
+func (t TargetCallLog) Normalize() *TargetCallLog {
+	t.ResponseBody = cutString(t.ResponseBody, maxTargetResponseBodyLength)
+	t.Error = cutString(t.Error, maxTargetResponseBodyLength)
+	return &t
+}
@@ -16,6 +16,15 @@ type LogoutRequest struct {
 	OIDCSessionID        string
 	ClientID             string
 	BackChannelLogoutURI string
+	// SAML Single Logout participants using the SOAP binding
+	SAMLSessionID      string
+	EntityID           string
+	Issuer             string
+	NameID             string
+	NameIDFormat       string
+	SessionIndex       string
+	SignatureAlgorithm string
+	SingleLogoutURL    string
 }
 
 func (l *LogoutRequest) Kind() string {
@@ -1,6 +1,9 @@
 package email
 
 import (
+	"github.com/zitadel/zitadel/internal/notification/channels/mailgun"
+	"github.com/zitadel/zitadel/internal/notification/channels/sendgrid"
+	"github.com/zitadel/zitadel/internal/notification/channels/ses"
 	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
 	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
 )
@@ -9,6 +12,14 @@ type Config struct {
 	ProviderConfig *Provider
 	SMTPConfig     *smtp.Config
 	WebhookConfig  *webhook.Config
+	SESConfig      *ses.Config
+	SendGridConfig *sendgrid.Config
+	MailgunConfig  *mailgun.Config
+}
+
+// DeliversOverAPI returns true if the emails are sent over the HTTP API of a provider.
+func (c *Config) DeliversOverAPI() bool {
+	return c.SESConfig != nil || c.SendGridConfig != nil || c.MailgunConfig != nil
 }
 
 type Provider struct {
@@ -0,0 +1,94 @@
+package mailgun
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// apiUser is the fixed user name for the basic authentication with the API key
+const apiUser = "api"
+
+type response struct {
+	ID      string `json:"id"`
+	Message string `json:"message"`
+}
+
+func InitChannel(ctx context.Context, config Config) channels.NotificationChannel {
+	logging.Debug("successfully initialized mailgun email channel")
+
+	return channels.HandleMessageFunc(func(message channels.Message) error {
+		msg, ok := message.(*messages.Email)
+		if !ok {
+			return zerrors.ThrowInternal(nil, "MAILG-Rb5n1", "message is not email")
+		}
+		if msg.Content == "" || msg.Subject == "" || len(msg.Recipients) == 0 {
+			return zerrors.ThrowInternal(nil, "MAILG-Rb5n2", "Errors.SMTP.RequiredAttributes")
+		}
+		form := url.Values{
+			"from":    {(&mail.Address{Name: config.SenderName, Address: config.SenderAddress}).String()},
+			"to":      msg.Recipients,
+			"subject": {msg.Subject},
+		}
+		if len(msg.CC) > 0 {
+			form["cc"] = msg.CC
+		}
+		if len(msg.BCC) > 0 {
+			form["bcc"] = msg.BCC
+		}
+		if config.ReplyToAddress != "" {
+			form.Set("h:Reply-To", config.ReplyToAddress)
+		}
+		if msg.IsHTML() {
+			form.Set("html", msg.Content)
+		} else {
+			form.Set("text", msg.Content)
+		}
+
+		requestCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		req, err := http.NewRequestWithContext(requestCtx, http.MethodPost, config.endpoint(), strings.NewReader(form.Encode()))
+		if err != nil {
+			return zerrors.ThrowInternal(err, "MAILG-Rb5n3", "could not create request")
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.SetBasicAuth(apiUser, config.APIKey)
+
+		resp, err := config.Client.Do(req)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "MAILG-Rb5n4", "could not send email")
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			var result response
+			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+				return zerrors.ThrowInternal(err, "MAILG-Rb5n5", "could not parse response")
+			}
+			// the id is returned in angle brackets, the events of mailgun reference it without
+			msg.DeliveryID = strings.Trim(result.ID, "<>")
+			logging.WithFields("message_id", msg.DeliveryID).Debug("email sent")
+			return nil
+		}
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		err = zerrors.ThrowInternal(fmt.Errorf("mailgun returned %s: %s", resp.Status, respBody), "MAILG-Rb5n6", "could not send email")
+		// In case of any client error (4xx), except throttling, we should not retry sending the email
+		// as the request or the API key are invalid.
+		if resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
+			logging.WithFields("status", resp.StatusCode).Warn("mailgun send email error")
+			return channels.NewCancelError(err)
+		}
+		return err
+	})
+}
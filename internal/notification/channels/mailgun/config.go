@@ -0,0 +1,30 @@
+package mailgun
+
+import (
+	"net/http"
+)
+
+// RegionEU is the region of the EU infrastructure of Mailgun, all other regions use the US infrastructure.
+const RegionEU = "eu"
+
+type Config struct {
+	APIKey         string
+	Domain         string
+	Region         string
+	SenderAddress  string
+	SenderName     string
+	ReplyToAddress string
+	Client         *http.Client
+}
+
+func (c *Config) IsValid() bool {
+	return c.APIKey != "" && c.Domain != "" && c.SenderAddress != ""
+}
+
+func (c *Config) endpoint() string {
+	host := "https://api.mailgun.net"
+	if c.Region == RegionEU {
+		host = "https://api.eu.mailgun.net"
+	}
+	return host + "/v3/" + c.Domain + "/messages"
+}
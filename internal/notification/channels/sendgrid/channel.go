@@ -0,0 +1,122 @@
+package sendgrid
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	endpoint = "https://api.sendgrid.com/v3/mail/send"
+	// messageIDHeader is returned by SendGrid and is part of the sg_message_id of later events
+	messageIDHeader = "X-Message-Id"
+)
+
+type address struct {
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+}
+
+type personalization struct {
+	To  []address `json:"to"`
+	CC  []address `json:"cc,omitempty"`
+	BCC []address `json:"bcc,omitempty"`
+}
+
+type mailContent struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type sendRequest struct {
+	Personalizations []personalization `json:"personalizations"`
+	From             address           `json:"from"`
+	ReplyTo          *address          `json:"reply_to,omitempty"`
+	Subject          string            `json:"subject"`
+	Content          []mailContent     `json:"content"`
+}
+
+func InitChannel(ctx context.Context, config Config) channels.NotificationChannel {
+	logging.Debug("successfully initialized sendgrid email channel")
+
+	return channels.HandleMessageFunc(func(message channels.Message) error {
+		msg, ok := message.(*messages.Email)
+		if !ok {
+			return zerrors.ThrowInternal(nil, "SENDG-Tz8w1", "message is not email")
+		}
+		if msg.Content == "" || msg.Subject == "" || len(msg.Recipients) == 0 {
+			return zerrors.ThrowInternal(nil, "SENDG-Tz8w2", "Errors.SMTP.RequiredAttributes")
+		}
+		request := &sendRequest{
+			Personalizations: []personalization{{
+				To:  addresses(msg.Recipients),
+				CC:  addresses(msg.CC),
+				BCC: addresses(msg.BCC),
+			}},
+			From:    address{Email: config.SenderAddress, Name: config.SenderName},
+			Subject: msg.Subject,
+		}
+		if config.ReplyToAddress != "" {
+			request.ReplyTo = &address{Email: config.ReplyToAddress}
+		}
+		contentType := "text/plain"
+		if msg.IsHTML() {
+			contentType = "text/html"
+		}
+		request.Content = []mailContent{{Type: contentType, Value: msg.Content}}
+		payload, err := json.Marshal(request)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "SENDG-Tz8w3", "could not marshal request")
+		}
+
+		requestCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		req, err := http.NewRequestWithContext(requestCtx, http.MethodPost, endpoint, bytes.NewReader(payload))
+		if err != nil {
+			return zerrors.ThrowInternal(err, "SENDG-Tz8w4", "could not create request")
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+config.APIKey)
+
+		resp, err := config.Client.Do(req)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "SENDG-Tz8w5", "could not send email")
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			msg.DeliveryID = resp.Header.Get(messageIDHeader)
+			logging.WithFields("message_id", msg.DeliveryID).Debug("email sent")
+			return nil
+		}
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		err = zerrors.ThrowInternal(fmt.Errorf("sendgrid returned %s: %s", resp.Status, respBody), "SENDG-Tz8w6", "could not send email")
+		// In case of any client error (4xx), except throttling, we should not retry sending the email
+		// as the request or the API key are invalid.
+		if resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
+			logging.WithFields("status", resp.StatusCode).Warn("sendgrid send email error")
+			return channels.NewCancelError(err)
+		}
+		return err
+	})
+}
+
+func addresses(emails []string) []address {
+	if len(emails) == 0 {
+		return nil
+	}
+	list := make([]address, len(emails))
+	for i, email := range emails {
+		list[i] = address{Email: email}
+	}
+	return list
+}
@@ -0,0 +1,17 @@
+package sendgrid
+
+import (
+	"net/http"
+)
+
+type Config struct {
+	APIKey         string
+	SenderAddress  string
+	SenderName     string
+	ReplyToAddress string
+	Client         *http.Client
+}
+
+func (c *Config) IsValid() bool {
+	return c.APIKey != "" && c.SenderAddress != ""
+}
@@ -0,0 +1,123 @@
+package ses
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/mail"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/channels/sigv4"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	service = "ses"
+	charset = "UTF-8"
+)
+
+type content struct {
+	Data    string `json:"Data"`
+	Charset string `json:"Charset"`
+}
+
+type body struct {
+	HTML *content `json:"Html,omitempty"`
+	Text *content `json:"Text,omitempty"`
+}
+
+type sendEmailRequest struct {
+	FromEmailAddress string   `json:"FromEmailAddress"`
+	ReplyToAddresses []string `json:"ReplyToAddresses,omitempty"`
+	Destination      struct {
+		ToAddresses  []string `json:"ToAddresses"`
+		CcAddresses  []string `json:"CcAddresses,omitempty"`
+		BccAddresses []string `json:"BccAddresses,omitempty"`
+	} `json:"Destination"`
+	Content struct {
+		Simple struct {
+			Subject content `json:"Subject"`
+			Body    body    `json:"Body"`
+		} `json:"Simple"`
+	} `json:"Content"`
+}
+
+type sendEmailResponse struct {
+	MessageID string `json:"MessageId"`
+}
+
+func InitChannel(ctx context.Context, config Config) channels.NotificationChannel {
+	logging.Debug("successfully initialized amazon ses email channel")
+
+	return channels.HandleMessageFunc(func(message channels.Message) error {
+		msg, ok := message.(*messages.Email)
+		if !ok {
+			return zerrors.ThrowInternal(nil, "SES-Hm3k1", "message is not email")
+		}
+		if msg.Content == "" || msg.Subject == "" || len(msg.Recipients) == 0 {
+			return zerrors.ThrowInternal(nil, "SES-Hm3k2", "Errors.SMTP.RequiredAttributes")
+		}
+		request := new(sendEmailRequest)
+		request.FromEmailAddress = (&mail.Address{Name: config.SenderName, Address: config.SenderAddress}).String()
+		if config.ReplyToAddress != "" {
+			request.ReplyToAddresses = []string{config.ReplyToAddress}
+		}
+		request.Destination.ToAddresses = msg.Recipients
+		request.Destination.CcAddresses = msg.CC
+		request.Destination.BccAddresses = msg.BCC
+		request.Content.Simple.Subject = content{Data: msg.Subject, Charset: charset}
+		if msg.IsHTML() {
+			request.Content.Simple.Body.HTML = &content{Data: msg.Content, Charset: charset}
+		} else {
+			request.Content.Simple.Body.Text = &content{Data: msg.Content, Charset: charset}
+		}
+		payload, err := json.Marshal(request)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "SES-Hm3k3", "could not marshal request")
+		}
+
+		endpoint, err := config.endpoint()
+		if err != nil {
+			return err
+		}
+		requestCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		req, err := http.NewRequestWithContext(requestCtx, http.MethodPost, endpoint, bytes.NewReader(payload))
+		if err != nil {
+			return zerrors.ThrowInternal(err, "SES-Hm3k4", "could not create request")
+		}
+		req.Header.Set("Content-Type", "application/json")
+		sigv4.SignRequest(req, payload, service, config.Region, config.AccessKeyID, config.SecretAccessKey, time.Now())
+
+		resp, err := config.Client.Do(req)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "SES-Hm3k5", "could not send email")
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			var result sendEmailResponse
+			if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+				return zerrors.ThrowInternal(err, "SES-Hm3k6", "could not parse response")
+			}
+			msg.DeliveryID = result.MessageID
+			logging.WithFields("message_id", result.MessageID).Debug("email sent")
+			return nil
+		}
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		err = zerrors.ThrowInternal(fmt.Errorf("amazon ses returned %s: %s", resp.Status, respBody), "SES-Hm3k7", "could not send email")
+		// In case of any client error (4xx), except throttling, we should not retry sending the email
+		// as the request or the credentials are invalid.
+		if resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
+			logging.WithFields("status", resp.StatusCode).Warn("amazon ses send email error")
+			return channels.NewCancelError(err)
+		}
+		return err
+	})
+}
@@ -0,0 +1,29 @@
+package ses
+
+import (
+	"net/http"
+
+	"github.com/zitadel/zitadel/internal/notification/channels/sigv4"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type Config struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	Region          string
+	SenderAddress   string
+	SenderName      string
+	ReplyToAddress  string
+	Client          *http.Client
+}
+
+func (c *Config) IsValid() bool {
+	return c.AccessKeyID != "" && c.SecretAccessKey != "" && sigv4.IsValidRegion(c.Region) && c.SenderAddress != ""
+}
+
+func (c *Config) endpoint() (string, error) {
+	if !sigv4.IsValidRegion(c.Region) {
+		return "", zerrors.ThrowInvalidArgument(nil, "SES-Rg4n1", "Errors.Invalid.Argument")
+	}
+	return "https://email." + c.Region + ".amazonaws.com/v2/email/outbound-emails", nil
+}
@@ -0,0 +1,108 @@
+// Package sigv4 signs requests to AWS APIs with the Signature Version 4.
+package sigv4
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	signingAlgorithm = "AWS4-HMAC-SHA256"
+	amzDateFormat    = "20060102T150405Z"
+	shortDateFormat  = "20060102"
+)
+
+// regionRegex matches AWS region codes like eu-central-1 or us-gov-west-1.
+var regionRegex = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`)
+
+// IsValidRegion checks that the region is an AWS region code,
+// as it is used as part of the endpoint host.
+func IsValidRegion(region string) bool {
+	return regionRegex.MatchString(region)
+}
+
+// SignRequest adds the AWS Signature Version 4 authorization header to the request.
+// All headers set on the request before are signed.
+func SignRequest(req *http.Request, payload []byte, service, region, accessKeyID, secretAccessKey string, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format(amzDateFormat)
+	shortDate := now.Format(shortDateFormat)
+	req.Header.Set("X-Amz-Date", amzDate)
+
+	signedHeaders, canonicalHeaders := canonicalHeaders(req)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		canonicalURI(req),
+		req.URL.Query().Encode(),
+		canonicalHeaders,
+		signedHeaders,
+		hashHex(payload),
+	}, "\n")
+
+	scope := strings.Join([]string{shortDate, region, service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{
+		signingAlgorithm,
+		amzDate,
+		scope,
+		hashHex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+secretAccessKey), shortDate)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", signingAlgorithm+
+		" Credential="+accessKeyID+"/"+scope+
+		", SignedHeaders="+signedHeaders+
+		", Signature="+signature,
+	)
+}
+
+func canonicalURI(req *http.Request) string {
+	if path := req.URL.EscapedPath(); path != "" {
+		return path
+	}
+	return "/"
+}
+
+func canonicalHeaders(req *http.Request) (signed, canonical string) {
+	headers := map[string]string{
+		"host": req.URL.Host,
+	}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var builder strings.Builder
+	for _, name := range names {
+		builder.WriteString(name)
+		builder.WriteByte(':')
+		builder.WriteString(headers[name])
+		builder.WriteByte('\n')
+	}
+	return strings.Join(names, ";"), builder.String()
+}
+
+func hashHex(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
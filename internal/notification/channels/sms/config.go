@@ -1,7 +1,9 @@
 package sms
 
 import (
+	"github.com/zitadel/zitadel/internal/notification/channels/sns"
 	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/vonage"
 	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
 )
 
@@ -9,6 +11,8 @@ type Config struct {
 	ProviderConfig *Provider
 	TwilioConfig   *twilio.Config
 	WebhookConfig  *webhook.Config
+	VonageConfig   *vonage.Config
+	SNSConfig      *sns.Config
 }
 
 type Provider struct {
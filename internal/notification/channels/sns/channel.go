@@ -0,0 +1,90 @@
+package sns
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/channels/sigv4"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	service    = "sns"
+	apiVersion = "2010-03-31"
+)
+
+func InitChannel(ctx context.Context, config Config) channels.NotificationChannel {
+	logging.Debug("successfully initialized aws sns sms channel")
+
+	return channels.HandleMessageFunc(func(message channels.Message) error {
+		msg, ok := message.(*messages.SMS)
+		if !ok {
+			return zerrors.ThrowInternal(nil, "SNS-Pq7x1", "message is not SMS")
+		}
+		content, err := msg.GetContent()
+		if err != nil {
+			return err
+		}
+		form := url.Values{
+			"Action":                         {"Publish"},
+			"Version":                        {apiVersion},
+			"PhoneNumber":                    {msg.RecipientPhoneNumber},
+			"Message":                        {content},
+			"MessageAttributes.entry.1.Name": {"AWS.SNS.SMS.SMSType"},
+			"MessageAttributes.entry.1.Value.DataType":    {"String"},
+			"MessageAttributes.entry.1.Value.StringValue": {"Transactional"},
+		}
+		if config.SenderID != "" {
+			form.Set("MessageAttributes.entry.2.Name", "AWS.SNS.SMS.SenderID")
+			form.Set("MessageAttributes.entry.2.Value.DataType", "String")
+			form.Set("MessageAttributes.entry.2.Value.StringValue", config.SenderID)
+		}
+		payload := []byte(form.Encode())
+
+		endpoint, err := config.endpoint()
+		if err != nil {
+			return err
+		}
+		requestCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		req, err := http.NewRequestWithContext(requestCtx, http.MethodPost, endpoint, bytes.NewReader(payload))
+		if err != nil {
+			return zerrors.ThrowInternal(err, "SNS-Pq7x2", "could not create request")
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+		sigv4.SignRequest(req, payload, service, config.Region, config.AccessKeyID, config.SecretAccessKey, time.Now())
+
+		resp, err := config.Client.Do(req)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "SNS-Pq7x3", "could not send message")
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			logging.WithFields("instanceID", msg.InstanceID, "jobID", msg.JobID).Debug("sms sent")
+			return nil
+		}
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		err = zerrors.ThrowInternal(fmt.Errorf("aws sns returned %s: %s", resp.Status, body), "SNS-Pq7x4", "could not send message")
+		// In case of any client error (4xx), except throttling, we should not retry sending the message
+		// as the request or the credentials are invalid.
+		if resp.StatusCode >= 400 && resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
+			logging.WithFields(
+				"status", resp.StatusCode,
+				"instanceID", msg.InstanceID,
+				"jobID", msg.JobID,
+				"userID", msg.UserID,
+			).Warn("aws sns publish error")
+			return channels.NewCancelError(err)
+		}
+		return err
+	})
+}
@@ -0,0 +1,27 @@
+package sns
+
+import (
+	"net/http"
+
+	"github.com/zitadel/zitadel/internal/notification/channels/sigv4"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type Config struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	Region          string
+	SenderID        string
+	Client          *http.Client
+}
+
+func (c *Config) IsValid() bool {
+	return c.AccessKeyID != "" && c.SecretAccessKey != "" && sigv4.IsValidRegion(c.Region)
+}
+
+func (c *Config) endpoint() (string, error) {
+	if !sigv4.IsValidRegion(c.Region) {
+		return "", zerrors.ThrowInvalidArgument(nil, "SNS-Rg4n1", "Errors.Invalid.Argument")
+	}
+	return "https://sns." + c.Region + ".amazonaws.com/", nil
+}
@@ -0,0 +1,96 @@
+package vonage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/notification/channels"
+	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// statusThrottled is returned by Vonage if the request was rate limited and can be retried
+const statusThrottled = "1"
+
+type response struct {
+	Messages []struct {
+		MessageID string `json:"message-id"`
+		Status    string `json:"status"`
+		ErrorText string `json:"error-text"`
+	} `json:"messages"`
+}
+
+func InitChannel(ctx context.Context, config Config) channels.NotificationChannel {
+	logging.Debug("successfully initialized vonage sms channel")
+
+	return channels.HandleMessageFunc(func(message channels.Message) error {
+		msg, ok := message.(*messages.SMS)
+		if !ok {
+			return zerrors.ThrowInternal(nil, "VONAG-Kq2m1", "message is not SMS")
+		}
+		content, err := msg.GetContent()
+		if err != nil {
+			return err
+		}
+		sender := msg.SenderPhoneNumber
+		if sender == "" {
+			sender = config.SenderNumber
+		}
+		form := url.Values{
+			"api_key":    {config.APIKey},
+			"api_secret": {config.APISecret},
+			"from":       {strings.TrimPrefix(sender, "+")},
+			"to":         {strings.TrimPrefix(msg.RecipientPhoneNumber, "+")},
+			"text":       {content},
+		}
+
+		requestCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		req, err := http.NewRequestWithContext(requestCtx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+		if err != nil {
+			return zerrors.ThrowInternal(err, "VONAG-Kq2m2", "could not create request")
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		resp, err := config.Client.Do(req)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "VONAG-Kq2m3", "could not send message")
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return zerrors.ThrowUnknown(fmt.Errorf("vonage returned %s", resp.Status), "VONAG-Kq2m4", "could not send message")
+		}
+		var result response
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			return zerrors.ThrowInternal(err, "VONAG-Kq2m5", "could not parse response")
+		}
+		for _, m := range result.Messages {
+			if m.Status == "0" {
+				logging.WithFields("message_id", m.MessageID).Debug("sms sent")
+				continue
+			}
+			err := zerrors.ThrowInternal(fmt.Errorf("status %s: %s", m.Status, m.ErrorText), "VONAG-Kq2m6", "could not send message")
+			// all errors except throttling are caused by the request or the account,
+			// so retrying would not succeed
+			if m.Status != statusThrottled {
+				logging.WithFields(
+					"error", m.ErrorText,
+					"status", m.Status,
+					"instanceID", msg.InstanceID,
+					"jobID", msg.JobID,
+					"userID", msg.UserID,
+				).Warn("vonage send message error")
+				return channels.NewCancelError(err)
+			}
+			return err
+		}
+		return nil
+	})
+}
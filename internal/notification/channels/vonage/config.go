@@ -0,0 +1,18 @@
+package vonage
+
+import (
+	"net/http"
+)
+
+const endpoint = "https://rest.nexmo.com/sms/json"
+
+type Config struct {
+	APIKey       string
+	APISecret    string
+	SenderNumber string
+	Client       *http.Client
+}
+
+func (c *Config) IsValid() bool {
+	return c.APIKey != "" && c.APISecret != "" && c.SenderNumber != ""
+}
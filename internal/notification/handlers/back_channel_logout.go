@@ -5,6 +5,7 @@ import (
 	"slices"
 
 	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/api/saml/slo"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/notification/backchannel"
@@ -123,6 +124,8 @@ type backChannelLogoutSession struct {
 
 	// sessions contain a map of oidc session IDs and their corresponding clientID
 	sessions []backChannelLogoutOIDCSessions
+	// samlSessions contain the SAML sessions, which are notified using the SOAP binding
+	samlSessions []backChannelLogoutSAMLSession
 }
 
 type LogoutTokenMessage struct {
@@ -137,6 +140,18 @@ type backChannelLogoutOIDCSessions struct {
 	BackChannelLogoutURI string
 }
 
+type backChannelLogoutSAMLSession struct {
+	SAMLSessionID      string
+	UserID             string
+	EntityID           string
+	Issuer             string
+	NameID             string
+	NameIDFormat       string
+	SessionIndex       string
+	SignatureAlgorithm string
+	SingleLogoutURL    string
+}
+
 func (b *backChannelLogoutSession) Reduce() error {
 	return nil
 }
@@ -156,6 +171,26 @@ func (b *backChannelLogoutSession) AppendEvents(events ...eventstore.Event) {
 			b.sessions = slices.DeleteFunc(b.sessions, func(session backChannelLogoutOIDCSessions) bool {
 				return session.OIDCSessionID == e.OIDCSessionID
 			})
+		case *sessionlogout.SAMLLogoutRegisteredEvent:
+			// participants using a front channel binding are notified through the user agent
+			if e.Binding != slo.SOAPBinding {
+				continue
+			}
+			b.samlSessions = append(b.samlSessions, backChannelLogoutSAMLSession{
+				SAMLSessionID:      e.SAMLSessionID,
+				UserID:             e.UserID,
+				EntityID:           e.EntityID,
+				Issuer:             e.Issuer,
+				NameID:             e.NameID,
+				NameIDFormat:       e.NameIDFormat,
+				SessionIndex:       e.SessionIndex,
+				SignatureAlgorithm: e.SignatureAlgorithm,
+				SingleLogoutURL:    e.SingleLogoutURL,
+			})
+		case *sessionlogout.SAMLLogoutSentEvent:
+			b.samlSessions = slices.DeleteFunc(b.samlSessions, func(session backChannelLogoutSAMLSession) bool {
+				return session.SAMLSessionID == e.SAMLSessionID
+			})
 		}
 	}
 }
@@ -167,6 +202,8 @@ func (b *backChannelLogoutSession) Query() *eventstore.SearchQueryBuilder {
 		AggregateIDs(b.sessionID).
 		EventTypes(
 			sessionlogout.BackChannelLogoutRegisteredType,
-			sessionlogout.BackChannelLogoutSentType).
+			sessionlogout.BackChannelLogoutSentType,
+			sessionlogout.SAMLLogoutRegisteredType,
+			sessionlogout.SAMLLogoutSentType).
 		Builder()
 }
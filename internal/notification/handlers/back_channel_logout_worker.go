@@ -7,10 +7,14 @@ import (
 	"time"
 
 	"github.com/riverqueue/river"
+	dsig "github.com/russellhaering/goxmldsig"
 	"github.com/zitadel/oidc/v3/pkg/crypto"
 	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/saml/pkg/provider"
 
 	"github.com/zitadel/zitadel/internal/api/oidc/sign"
+	"github.com/zitadel/zitadel/internal/api/saml/slo"
+	zcrypto "github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/id"
 	"github.com/zitadel/zitadel/internal/notification/backchannel"
@@ -18,6 +22,7 @@ import (
 	"github.com/zitadel/zitadel/internal/notification/channels/set"
 	"github.com/zitadel/zitadel/internal/notification/types"
 	"github.com/zitadel/zitadel/internal/queue"
+	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 type BackChannelLogoutWorker struct {
@@ -54,10 +59,14 @@ func (w *BackChannelLogoutWorker) Work(ctx context.Context, job *river.Job[*back
 		return river.JobCancel(errors.New("back channel logout notification is too old"))
 	}
 
-	if job.Args.OIDCSessionID == "" {
+	switch {
+	case job.Args.SAMLSessionID != "":
+		return w.sendSAMLLogoutRequest(ctx, job.Args)
+	case job.Args.OIDCSessionID != "":
+		return w.sendLogoutRequest(ctx, job.Args)
+	default:
 		return w.createNotificationJobs(ctx, job.Args)
 	}
-	return w.sendLogoutRequest(ctx, job.Args)
 }
 
 func (w *BackChannelLogoutWorker) createNotificationJobs(ctx context.Context, request *backchannel.LogoutRequest) error {
@@ -90,6 +99,29 @@ func (w *BackChannelLogoutWorker) createNotificationJobs(ctx context.Context, re
 			return err
 		}
 	}
+	for _, samlSession := range sessions.samlSessions {
+		logoutRequest := &backchannel.LogoutRequest{
+			Aggregate:           request.Aggregate,
+			SessionID:           request.SessionID,
+			TriggeredAtOrigin:   request.TriggeredAtOrigin,
+			TriggeringEventType: request.TriggeringEventType,
+			UserID:              samlSession.UserID,
+			SAMLSessionID:       samlSession.SAMLSessionID,
+			EntityID:            samlSession.EntityID,
+			Issuer:              samlSession.Issuer,
+			NameID:              samlSession.NameID,
+			NameIDFormat:        samlSession.NameIDFormat,
+			SessionIndex:        samlSession.SessionIndex,
+			SignatureAlgorithm:  samlSession.SignatureAlgorithm,
+			SingleLogoutURL:     samlSession.SingleLogoutURL,
+		}
+		err = w.queue.Insert(ctx, logoutRequest,
+			queue.WithQueueName(backchannel.QueueName),
+			queue.WithMaxAttempts(w.config.MaxAttempts))
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -106,6 +138,63 @@ func (w *BackChannelLogoutWorker) sendLogoutRequest(ctx context.Context, request
 	return w.commands.BackChannelLogoutSent(ctx, request.SessionID, request.OIDCSessionID, request.Aggregate.InstanceID)
 }
 
+// sendSAMLLogoutRequest sends a signed LogoutRequest to the SingleLogoutService of the service provider
+// using the SOAP binding.
+func (w *BackChannelLogoutWorker) sendSAMLLogoutRequest(ctx context.Context, request *backchannel.LogoutRequest) error {
+	signer, err := w.samlSigner(ctx, request.SignatureAlgorithm)
+	if err != nil {
+		return err
+	}
+	logoutRequest := &slo.Request{
+		ID:           provider.NewID(),
+		Issuer:       request.Issuer,
+		Destination:  request.SingleLogoutURL,
+		NameID:       request.NameID,
+		NameIDFormat: request.NameIDFormat,
+		SessionIndex: request.SessionIndex,
+	}
+	data, err := logoutRequest.SignedXML(signer, w.now())
+	if err != nil {
+		return err
+	}
+	if err = slo.SendSOAP(ctx, w.httpClient, request.SingleLogoutURL, data); err != nil {
+		return err
+	}
+	return w.commands.SAMLLogoutSent(ctx, request.SessionID, request.SAMLSessionID, request.Aggregate.InstanceID)
+}
+
+// samlSigner returns the active SAML response signing key of the instance.
+func (w *BackChannelLogoutWorker) samlSigner(ctx context.Context, signatureAlgorithm string) (*slo.Signer, error) {
+	certs, err := w.queries.ActiveCertificates(ctx, w.now(), zcrypto.KeyUsageSAMLResponseSinging)
+	if err != nil {
+		return nil, err
+	}
+	if len(certs.Certificates) == 0 {
+		return nil, zerrors.ThrowInternal(nil, "HANDL-Ox7qe", "no saml response signing key")
+	}
+	certificate := certs.Certificates[len(certs.Certificates)-1]
+	keyData, err := zcrypto.Decrypt(certificate.Key(), w.queries.SAMLKeyCrypto)
+	if err != nil {
+		return nil, err
+	}
+	key, err := zcrypto.BytesToPrivateKey(keyData)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := zcrypto.BytesToCertificate(certificate.Certificate())
+	if err != nil {
+		return nil, err
+	}
+	if signatureAlgorithm == "" {
+		signatureAlgorithm = dsig.RSASHA256SignatureMethod
+	}
+	return &slo.Signer{
+		Certificate: cert,
+		Key:         key,
+		Algorithm:   signatureAlgorithm,
+	}, nil
+}
+
 func (w *BackChannelLogoutWorker) logoutToken(ctx context.Context, request *backchannel.LogoutRequest, getSigner sign.SignerFunc) (string, error) {
 	token := oidc.NewLogoutTokenClaims(
 		request.TriggeredAtOrigin,
@@ -219,6 +219,94 @@ func Test_backChannelLogoutWorker_reduceNotificationRequested(t *testing.T) {
 				err: nil,
 			},
 		},
+		{
+			name: "create jobs for saml sessions with soap binding",
+			fields: fields{
+				es: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							sessionlogout.NewSAMLLogoutRegisteredEvent(
+								context.Background(),
+								sessionLogoutAgg,
+								"saml-session-id1",
+								"user-id",
+								"entity-id1",
+								"issuer",
+								"name-id",
+								"urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified",
+								"session-index1",
+								"http://www.w3.org/2001/04/xmldsig-more#rsa-sha256",
+								"https://sp1.example.com/slo",
+								"urn:oasis:names:tc:SAML:2.0:bindings:SOAP",
+							),
+						),
+						eventFromEventPusher(
+							sessionlogout.NewSAMLLogoutRegisteredEvent(
+								context.Background(),
+								sessionLogoutAgg,
+								"saml-session-id2",
+								"user-id",
+								"entity-id2",
+								"issuer",
+								"name-id",
+								"urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified",
+								"session-index2",
+								"http://www.w3.org/2001/04/xmldsig-more#rsa-sha256",
+								"https://sp2.example.com/slo",
+								"urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect",
+							),
+						),
+					),
+				),
+				queue: func(ctrl *gomock.Controller) Queue {
+					q := mock.NewMockQueue(ctrl)
+					q.EXPECT().Insert(gomock.Any(),
+						&backchannel.LogoutRequest{
+							Aggregate:          sessionLogoutAgg,
+							SessionID:          sessionID,
+							UserID:             "user-id",
+							SAMLSessionID:      "saml-session-id1",
+							EntityID:           "entity-id1",
+							Issuer:             "issuer",
+							NameID:             "name-id",
+							NameIDFormat:       "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified",
+							SessionIndex:       "session-index1",
+							SignatureAlgorithm: "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256",
+							SingleLogoutURL:    "https://sp1.example.com/slo",
+						},
+						gomock.AssignableToTypeOf(reflect.TypeOf(queue.WithQueueName(backchannel.QueueName))),
+						gomock.AssignableToTypeOf(reflect.TypeOf(queue.WithMaxAttempts(1))),
+					).Return(nil)
+					return q
+				},
+				commands: func(ctrl *gomock.Controller) Commands {
+					c := mock.NewMockCommands(ctrl)
+					return c
+				},
+				queries: func(ctrl *gomock.Controller) Queries {
+					q := mock.NewMockQueries(ctrl)
+					return q
+				},
+				channel: func(ctrl *gomock.Controller) channels.NotificationChannel {
+					c := channel_mock.NewMockNotificationChannel(ctrl)
+					return c
+				},
+			},
+			args: args{
+				job: &river.Job[*backchannel.LogoutRequest]{
+					JobRow: &rivertype.JobRow{
+						CreatedAt: testNow,
+					},
+					Args: &backchannel.LogoutRequest{
+						Aggregate: sessionLogoutAgg,
+						SessionID: sessionID,
+					},
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
 		{
 			name: "send logout request",
 			fields: fields{
@@ -440,6 +528,7 @@ func newBackChannelLogoutWorker(queries Queries, commands Commands, es *eventsto
 			nil,
 			nil,
 			nil,
+			nil,
 		),
 		eventstore: es,
 		queue:      queue,
@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
+	_ "github.com/zitadel/zitadel/internal/notification/statik"
+	"github.com/zitadel/zitadel/internal/notification/types"
+	"github.com/zitadel/zitadel/internal/repository/cibarequest"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	CIBANotificationsProjectionTable = "projections.notifications_ciba"
+)
+
+// cibaNotifier notifies clients using the ping mode of the backchannel authentication (OpenID CIBA)
+// on their client notification endpoint, once the user approved or denied the request.
+type cibaNotifier struct {
+	queries  *NotificationQueries
+	channels types.ChannelChains
+}
+
+func NewCIBANotifier(
+	ctx context.Context,
+	handlerCfg handler.Config,
+	queries *NotificationQueries,
+	channels types.ChannelChains,
+) *handler.Handler {
+	return handler.NewHandler(ctx, &handlerCfg, &cibaNotifier{
+		queries:  queries,
+		channels: channels,
+	})
+}
+
+func (*cibaNotifier) Name() string {
+	return CIBANotificationsProjectionTable
+}
+
+func (n *cibaNotifier) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{{
+		Aggregate: cibarequest.AggregateType,
+		EventReducers: []handler.EventReducer{
+			{
+				Event:  cibarequest.ApprovedEventType,
+				Reduce: n.reduceCIBARequestHandled,
+			},
+			{
+				Event:  cibarequest.CanceledEventType,
+				Reduce: n.reduceCIBARequestHandled,
+			},
+		},
+	}}
+}
+
+func (n *cibaNotifier) reduceCIBARequestHandled(event eventstore.Event) (*handler.Statement, error) {
+	switch event.(type) {
+	case *cibarequest.ApprovedEvent, *cibarequest.CanceledEvent:
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ciu1p", "reduce.wrong.event.type %s", event.Type())
+	}
+	return handler.NewStatement(event, func(ctx context.Context, _ handler.Executer, _ string) error {
+		request := command.NewCIBARequestWriteModel(event.Aggregate().ID, event.Aggregate().ResourceOwner)
+		if err := n.queries.es.FilterToQueryReducer(ctx, request); err != nil {
+			return err
+		}
+		// clients using the poll mode did not register a notification endpoint
+		if request.NotificationURI == "" || request.NotificationToken == nil {
+			return nil
+		}
+		token, err := crypto.DecryptString(request.NotificationToken, n.queries.UserDataCrypto)
+		if err != nil {
+			return err
+		}
+		return types.SendJSON(
+			ctx,
+			webhook.Config{
+				CallURL: request.NotificationURI,
+				Method:  http.MethodPost,
+				Headers: http.Header{"Authorization": {"Bearer " + token}},
+				Client:  n.queries.httpClient,
+			},
+			n.channels,
+			&struct {
+				AuthReqID string `json:"auth_req_id"`
+			}{
+				AuthReqID: request.AggregateID,
+			},
+			event.Type(),
+		).WithoutTemplate()
+	}), nil
+}
@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 
+	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/repository/milestone"
 	"github.com/zitadel/zitadel/internal/repository/quota"
@@ -10,19 +11,21 @@ import (
 
 //go:generate mockgen -typed -package mock -destination ./mock/commands.mock.go . Commands
 type Commands interface {
-	HumanInitCodeSent(ctx context.Context, orgID, userID string) error
-	HumanEmailVerificationCodeSent(ctx context.Context, orgID, userID string) error
+	HumanInitCodeSent(ctx context.Context, orgID, userID string, generatorInfo *senders.CodeGeneratorInfo) error
+	HumanEmailVerificationCodeSent(ctx context.Context, orgID, userID string, generatorInfo *senders.CodeGeneratorInfo) error
 	PasswordCodeSent(ctx context.Context, orgID, userID string, generatorInfo *senders.CodeGeneratorInfo) error
 	HumanOTPSMSCodeSent(ctx context.Context, userID, resourceOwner string, generatorInfo *senders.CodeGeneratorInfo) error
-	HumanOTPEmailCodeSent(ctx context.Context, userID, resourceOwner string) error
+	HumanOTPEmailCodeSent(ctx context.Context, userID, resourceOwner string, generatorInfo *senders.CodeGeneratorInfo) error
 	OTPSMSSent(ctx context.Context, sessionID, resourceOwner string, generatorInfo *senders.CodeGeneratorInfo) error
-	OTPEmailSent(ctx context.Context, sessionID, resourceOwner string) error
+	OTPEmailSent(ctx context.Context, sessionID, resourceOwner string, generatorInfo *senders.CodeGeneratorInfo) error
 	UserDomainClaimedSent(ctx context.Context, orgID, userID string) error
 	HumanPasswordlessInitCodeSent(ctx context.Context, userID, resourceOwner, codeID string) error
 	PasswordChangeSent(ctx context.Context, orgID, userID string) error
 	HumanPhoneVerificationCodeSent(ctx context.Context, orgID, userID string, generatorInfo *senders.CodeGeneratorInfo) error
-	InviteCodeSent(ctx context.Context, orgID, userID string) error
+	InviteCodeSent(ctx context.Context, orgID, userID string, generatorInfo *senders.CodeGeneratorInfo) error
 	UsageNotificationSent(ctx context.Context, dueEvent *quota.NotificationDueEvent) error
 	MilestonePushed(ctx context.Context, instanceID string, msType milestone.Type, endpoints []string) error
 	BackChannelLogoutSent(ctx context.Context, id, oidcSessionID, instanceID string) (err error)
+	SAMLLogoutSent(ctx context.Context, id, samlSessionID, instanceID string) (err error)
+	NotificationAttempted(ctx context.Context, attempt *command.NotificationAttempt) error
 }
@@ -6,7 +6,11 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/notification/channels/email"
+	"github.com/zitadel/zitadel/internal/notification/channels/mailgun"
+	"github.com/zitadel/zitadel/internal/notification/channels/sendgrid"
+	"github.com/zitadel/zitadel/internal/notification/channels/ses"
 	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
 	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
 	"github.com/zitadel/zitadel/internal/query"
@@ -40,9 +44,58 @@ func (n *NotificationQueries) GetActiveEmailConfig(ctx context.Context) (*email.
 			},
 		}, nil
 	}
+
+	if config.APIConfig != nil {
+		return emailAPIToEmailConfig(config.APIConfig, provider, n.SMTPPasswordCrypto, n.httpClient)
+	}
 	return nil, zerrors.ThrowNotFound(err, "QUERY-KPQleOckOV", "Errors.SMTPConfig.NotFound")
 }
 
+func emailAPIToEmailConfig(qa *query.EmailAPI, provider *email.Provider, keyCrypto crypto.EncryptionAlgorithm, client *http.Client) (*email.Config, error) {
+	apiKey, err := crypto.DecryptString(qa.APIKey, keyCrypto)
+	if err != nil {
+		return nil, err
+	}
+	config := &email.Config{
+		ProviderConfig: provider,
+	}
+	switch qa.Provider {
+	case domain.EmailAPIProviderTypeSES:
+		config.SESConfig = &ses.Config{
+			AccessKeyID:     qa.AccessKeyID,
+			SecretAccessKey: apiKey,
+			Region:          qa.Region,
+			SenderAddress:   qa.SenderAddress,
+			SenderName:      qa.SenderName,
+			ReplyToAddress:  qa.ReplyToAddress,
+			Client:          client,
+		}
+	case domain.EmailAPIProviderTypeSendGrid:
+		config.SendGridConfig = &sendgrid.Config{
+			APIKey:         apiKey,
+			SenderAddress:  qa.SenderAddress,
+			SenderName:     qa.SenderName,
+			ReplyToAddress: qa.ReplyToAddress,
+			Client:         client,
+		}
+	case domain.EmailAPIProviderTypeMailgun:
+		config.MailgunConfig = &mailgun.Config{
+			APIKey:         apiKey,
+			Domain:         qa.Domain,
+			Region:         qa.Region,
+			SenderAddress:  qa.SenderAddress,
+			SenderName:     qa.SenderName,
+			ReplyToAddress: qa.ReplyToAddress,
+			Client:         client,
+		}
+	case domain.EmailAPIProviderTypeUnspecified:
+		return nil, zerrors.ThrowNotFound(nil, "HANDLER-Em4ls", "Errors.SMTPConfig.NotFound")
+	default:
+		return nil, zerrors.ThrowNotFound(nil, "HANDLER-Em4ls", "Errors.SMTPConfig.NotFound")
+	}
+	return config, nil
+}
+
 func smtpToEmailConfig(qs *query.SMTP, provider *email.Provider, passCrypto crypto.EncryptionAlgorithm) (*email.Config, error) {
 	config := &email.Config{
 		ProviderConfig: provider,
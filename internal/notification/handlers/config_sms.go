@@ -7,7 +7,9 @@ import (
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/notification/channels/sms"
+	"github.com/zitadel/zitadel/internal/notification/channels/sns"
 	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/vonage"
 	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -54,5 +56,43 @@ func (n *NotificationQueries) GetActiveSMSConfig(ctx context.Context) (*sms.Conf
 		}, nil
 	}
 
+	if config.VonageConfig != nil {
+		if config.VonageConfig.APISecret == nil {
+			return nil, zerrors.ThrowNotFound(nil, "HANDLER-Vn4ls", "Errors.SMSConfig.NotFound")
+		}
+		apiSecret, err := crypto.DecryptString(config.VonageConfig.APISecret, n.SMSTokenCrypto)
+		if err != nil {
+			return nil, err
+		}
+		return &sms.Config{
+			ProviderConfig: provider,
+			VonageConfig: &vonage.Config{
+				APIKey:       config.VonageConfig.APIKey,
+				APISecret:    apiSecret,
+				SenderNumber: config.VonageConfig.SenderNumber,
+				Client:       n.httpClient,
+			},
+		}, nil
+	}
+	if config.SNSConfig != nil {
+		if config.SNSConfig.SecretAccessKey == nil {
+			return nil, zerrors.ThrowNotFound(nil, "HANDLER-Sn4ls", "Errors.SMSConfig.NotFound")
+		}
+		secretAccessKey, err := crypto.DecryptString(config.SNSConfig.SecretAccessKey, n.SMSTokenCrypto)
+		if err != nil {
+			return nil, err
+		}
+		return &sms.Config{
+			ProviderConfig: provider,
+			SNSConfig: &sns.Config{
+				AccessKeyID:     config.SNSConfig.AccessKeyID,
+				SecretAccessKey: secretAccessKey,
+				Region:          config.SNSConfig.Region,
+				SenderID:        config.SNSConfig.SenderID,
+				Client:          n.httpClient,
+			},
+		}, nil
+	}
+
 	return nil, zerrors.ThrowNotFound(nil, "HANDLER-8nfow", "Errors.SMS.Twilio.NotFound")
 }
@@ -12,9 +12,11 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	jose "github.com/go-jose/go-jose/v4"
 	authz "github.com/zitadel/zitadel/internal/api/authz"
+	crypto "github.com/zitadel/zitadel/internal/crypto"
 	domain "github.com/zitadel/zitadel/internal/domain"
 	query "github.com/zitadel/zitadel/internal/query"
 	gomock "go.uber.org/mock/gomock"
@@ -45,6 +47,45 @@ func (m *MockQueries) EXPECT() *MockQueriesMockRecorder {
 	return m.recorder
 }
 
+// ActiveCertificates mocks base method.
+func (m *MockQueries) ActiveCertificates(ctx context.Context, t time.Time, usage crypto.KeyUsage) (*query.Certificates, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActiveCertificates", ctx, t, usage)
+	ret0, _ := ret[0].(*query.Certificates)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActiveCertificates indicates an expected call of ActiveCertificates.
+func (mr *MockQueriesMockRecorder) ActiveCertificates(ctx, t, usage any) *MockQueriesActiveCertificatesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActiveCertificates", reflect.TypeOf((*MockQueries)(nil).ActiveCertificates), ctx, t, usage)
+	return &MockQueriesActiveCertificatesCall{Call: call}
+}
+
+// MockQueriesActiveCertificatesCall wrap *gomock.Call
+type MockQueriesActiveCertificatesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockQueriesActiveCertificatesCall) Return(certs *query.Certificates, err error) *MockQueriesActiveCertificatesCall {
+	c.Call = c.Call.Return(certs, err)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockQueriesActiveCertificatesCall) Do(f func(context.Context, time.Time, crypto.KeyUsage) (*query.Certificates, error)) *MockQueriesActiveCertificatesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockQueriesActiveCertificatesCall) DoAndReturn(f func(context.Context, time.Time, crypto.KeyUsage) (*query.Certificates, error)) *MockQueriesActiveCertificatesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ActiveInstances mocks base method.
 func (m *MockQueries) ActiveInstances() []string {
 	m.ctrl.T.Helper()
@@ -12,6 +12,7 @@ import (
 	"github.com/zitadel/logging"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
@@ -47,6 +48,13 @@ func (w *NotificationWorker) Timeout(*river.Job[*notification.Request]) time.Dur
 func (w *NotificationWorker) Work(ctx context.Context, job *river.Job[*notification.Request]) error {
 	ctx = ContextWithNotifier(ctx, job.Args.Aggregate)
 
+	generatorInfo := new(senders.CodeGeneratorInfo)
+	err := w.work(ctx, job, generatorInfo)
+	w.recordAttempt(ctx, job, generatorInfo, err)
+	return err
+}
+
+func (w *NotificationWorker) work(ctx context.Context, job *river.Job[*notification.Request], generatorInfo *senders.CodeGeneratorInfo) error {
 	// if the notification is too old, we can directly cancel
 	if job.CreatedAt.Add(w.config.MaxTtl).Before(w.now()) {
 		return river.JobCancel(errors.New("notification is too old"))
@@ -67,7 +75,7 @@ func (w *NotificationWorker) Work(ctx context.Context, job *river.Job[*notificat
 		job.Args.Args.Domain = notifyUser.LastEmail[index+1:]
 	}
 
-	err = w.sendNotificationQueue(ctx, job.Args, strconv.Itoa(int(job.ID)), notifyUser)
+	err = w.sendNotificationQueue(ctx, job.Args, strconv.Itoa(int(job.ID)), notifyUser, generatorInfo)
 	if err == nil {
 		return nil
 	}
@@ -78,6 +86,59 @@ func (w *NotificationWorker) Work(ctx context.Context, job *river.Job[*notificat
 	return err
 }
 
+// recordAttempt stores the result of the attempt for the notification history.
+// A failure to store it must not lead to a retry of the notification, so it is only logged.
+func (w *NotificationWorker) recordAttempt(ctx context.Context, job *river.Job[*notification.Request], generatorInfo *senders.CodeGeneratorInfo, err error) {
+	request := job.Args
+	ctx = authz.WithInstanceID(ctx, request.Aggregate.InstanceID)
+	attempt := &command.NotificationAttempt{
+		ID:                  strconv.Itoa(int(job.ID)),
+		InstanceID:          request.Aggregate.InstanceID,
+		ResourceOwner:       request.UserResourceOwner,
+		UserID:              request.UserID,
+		TriggeringEventType: request.EventType,
+		MessageType:         request.MessageType,
+		NotificationType:    request.NotificationType,
+		ProviderID:          w.providerID(ctx, request.NotificationType, generatorInfo),
+		Attempt:             job.Attempt,
+		DeliveryID:          generatorInfo.DeliveryID,
+	}
+	if err != nil {
+		attempt.Error = err.Error()
+		attempt.Final = job.Attempt >= job.MaxAttempts
+		// store the cause without the prefix of the cancel error
+		var cancelErr *river.JobCancelError
+		if errors.As(err, &cancelErr) {
+			attempt.Final = true
+			if cause := cancelErr.Unwrap(); cause != nil {
+				attempt.Error = cause.Error()
+			}
+		}
+	}
+	err = w.commands.NotificationAttempted(ctx, attempt)
+	logging.WithFields("instanceID", request.Aggregate.InstanceID, "notification", attempt.ID).
+		OnError(err).Error("could not store notification attempt")
+}
+
+// providerID returns the id of the provider, which sent the notification.
+// If the sender did not return it, the id of the active provider is used.
+func (w *NotificationWorker) providerID(ctx context.Context, notificationType domain.NotificationType, generatorInfo *senders.CodeGeneratorInfo) string {
+	if id := generatorInfo.GetID(); id != "" {
+		return id
+	}
+	switch notificationType {
+	case domain.NotificationTypeEmail:
+		if _, config, err := w.channels.Email(ctx); err == nil && config != nil && config.ProviderConfig != nil {
+			return config.ProviderConfig.ID
+		}
+	case domain.NotificationTypeSms:
+		if _, config, err := w.channels.SMS(ctx); err == nil && config != nil && config.ProviderConfig != nil {
+			return config.ProviderConfig.ID
+		}
+	}
+	return ""
+}
+
 type WorkerConfig struct {
 	LegacyEnabled       bool
 	Workers             uint8
@@ -124,7 +185,7 @@ func (w *NotificationWorker) Register(workers *river.Workers, queues map[string]
 	}
 }
 
-func (w *NotificationWorker) sendNotificationQueue(ctx context.Context, request *notification.Request, jobID string, notifyUser *query.NotifyUser) error {
+func (w *NotificationWorker) sendNotificationQueue(ctx context.Context, request *notification.Request, jobID string, notifyUser *query.NotifyUser, generatorInfo *senders.CodeGeneratorInfo) error {
 	// check early that a "sent" handler exists, otherwise we can cancel early
 	sentHandler, ok := sentHandlers[request.EventType]
 	if !ok {
@@ -155,7 +216,6 @@ func (w *NotificationWorker) sendNotificationQueue(ctx context.Context, request
 		return err
 	}
 
-	generatorInfo := new(senders.CodeGeneratorInfo)
 	var notify types.Notify
 	switch request.NotificationType {
 	case domain.NotificationTypeEmail:
@@ -163,7 +223,7 @@ func (w *NotificationWorker) sendNotificationQueue(ctx context.Context, request
 		if err != nil {
 			return err
 		}
-		notify = types.SendEmail(ctx, w.channels, string(template.Template), translator, notifyUser, colors, request.EventType)
+		notify = types.SendEmail(ctx, w.channels, string(template.Template), translator, notifyUser, colors, request.EventType, generatorInfo)
 	case domain.NotificationTypeSms:
 		notify = types.SendSMS(ctx, w.channels, translator, notifyUser, colors, request.EventType, request.Aggregate.InstanceID, jobID, generatorInfo)
 	}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/ui/login"
+	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	es_repo_mock "github.com/zitadel/zitadel/internal/eventstore/repository/mock"
@@ -50,6 +51,18 @@ func Test_userNotifier_reduceNotificationRequested(t *testing.T) {
 			name: "too old",
 			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fieldsWorker, a argsWorker, w wantWorker) {
 				codeAlg, code := cryptoValue(t, ctrl, "testcode")
+				commands.EXPECT().NotificationAttempted(gomock.Any(), &command.NotificationAttempt{
+					ID:                  "0",
+					InstanceID:          instanceID,
+					ResourceOwner:       orgID,
+					UserID:              userID,
+					TriggeringEventType: user.HumanInviteCodeAddedType,
+					MessageType:         domain.InviteUserMessageType,
+					NotificationType:    domain.NotificationTypeEmail,
+					ProviderID:          "emailProviderID",
+					Error:               "notification is too old",
+					Final:               true,
+				}).Return(nil)
 				return fieldsWorker{
 						queries:  queries,
 						commands: commands,
@@ -108,7 +121,17 @@ func Test_userNotifier_reduceNotificationRequested(t *testing.T) {
 				}
 				codeAlg, code := cryptoValue(t, ctrl, "testcode")
 				expectTemplateWithNotifyUserQueries(queries, givenTemplate)
-				commands.EXPECT().InviteCodeSent(gomock.Any(), orgID, userID).Return(nil)
+				commands.EXPECT().InviteCodeSent(gomock.Any(), orgID, userID, &senders.CodeGeneratorInfo{}).Return(nil)
+				commands.EXPECT().NotificationAttempted(gomock.Any(), &command.NotificationAttempt{
+					ID:                  "0",
+					InstanceID:          instanceID,
+					ResourceOwner:       orgID,
+					UserID:              userID,
+					TriggeringEventType: user.HumanInviteCodeAddedType,
+					MessageType:         domain.InviteUserMessageType,
+					NotificationType:    domain.NotificationTypeEmail,
+					ProviderID:          "emailProviderID",
+				}).Return(nil)
 				return fieldsWorker{
 						queries:  queries,
 						commands: commands,
@@ -172,6 +195,16 @@ func Test_userNotifier_reduceNotificationRequested(t *testing.T) {
 					ID:             smsProviderID,
 					VerificationID: verificationID,
 				}).Return(nil)
+				commands.EXPECT().NotificationAttempted(gomock.Any(), &command.NotificationAttempt{
+					ID:                  "1",
+					InstanceID:          instanceID,
+					ResourceOwner:       orgID,
+					UserID:              userID,
+					TriggeringEventType: session.OTPSMSChallengedType,
+					MessageType:         domain.VerifySMSOTPMessageType,
+					NotificationType:    domain.NotificationTypeSms,
+					ProviderID:          smsProviderID,
+				}).Return(nil)
 				return fieldsWorker{
 						queries:  queries,
 						commands: commands,
@@ -228,6 +261,16 @@ func Test_userNotifier_reduceNotificationRequested(t *testing.T) {
 				}
 				expectTemplateWithNotifyUserQueries(queries, givenTemplate)
 				commands.EXPECT().UserDomainClaimedSent(gomock.Any(), orgID, userID).Return(nil)
+				commands.EXPECT().NotificationAttempted(gomock.Any(), &command.NotificationAttempt{
+					ID:                  "0",
+					InstanceID:          instanceID,
+					ResourceOwner:       orgID,
+					UserID:              userID,
+					TriggeringEventType: user.UserDomainClaimedType,
+					MessageType:         domain.DomainClaimedMessageType,
+					NotificationType:    domain.NotificationTypeEmail,
+					ProviderID:          "emailProviderID",
+				}).Return(nil)
 				return fieldsWorker{
 						queries:  queries,
 						commands: commands,
@@ -285,6 +328,18 @@ func Test_userNotifier_reduceNotificationRequested(t *testing.T) {
 				}
 				codeAlg, code := cryptoValue(t, ctrl, "testcode")
 				expectTemplateWithNotifyUserQueries(queries, givenTemplate)
+				commands.EXPECT().NotificationAttempted(gomock.Any(), &command.NotificationAttempt{
+					ID:                  "1",
+					InstanceID:          instanceID,
+					ResourceOwner:       orgID,
+					UserID:              userID,
+					TriggeringEventType: user.HumanInviteCodeAddedType,
+					MessageType:         domain.InviteUserMessageType,
+					NotificationType:    domain.NotificationTypeEmail,
+					ProviderID:          "emailProviderID",
+					Attempt:             1,
+					Error:               sendError.Error(),
+				}).Return(nil)
 				return fieldsWorker{
 						queries:  queries,
 						commands: commands,
@@ -298,8 +353,10 @@ func Test_userNotifier_reduceNotificationRequested(t *testing.T) {
 					argsWorker{
 						job: &river.Job[*notification.Request]{
 							JobRow: &rivertype.JobRow{
-								ID:        1,
-								CreatedAt: time.Now(),
+								ID:          1,
+								CreatedAt:   time.Now(),
+								Attempt:     1,
+								MaxAttempts: 3,
 							},
 							Args: &notification.Request{
 								Aggregate: &eventstore.Aggregate{
@@ -328,6 +385,77 @@ func Test_userNotifier_reduceNotificationRequested(t *testing.T) {
 					w
 			},
 		},
+		{
+			name: "email undeliverable, cancel",
+			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fieldsWorker, a argsWorker, w wantWorker) {
+				givenTemplate := "{{.LogoURL}}"
+				w.err = func(tt assert.TestingT, err error, i ...interface{}) bool {
+					return errors.Is(err, new(river.JobCancelError))
+				}
+
+				codeAlg, code := cryptoValue(t, ctrl, "testcode")
+				queries.EXPECT().GetNotifyUserByID(gomock.Any(), gomock.Any(), gomock.Any()).Return(&query.NotifyUser{
+					ID:                 userID,
+					ResourceOwner:      orgID,
+					LastEmail:          lastEmail,
+					VerifiedEmail:      verifiedEmail,
+					PreferredLoginName: preferredLoginName,
+					UndeliverableEmail: lastEmail,
+				}, nil)
+				expectTemplateQueries(queries, givenTemplate)
+				commands.EXPECT().NotificationAttempted(gomock.Any(), &command.NotificationAttempt{
+					ID:                  "0",
+					InstanceID:          instanceID,
+					ResourceOwner:       orgID,
+					UserID:              userID,
+					TriggeringEventType: user.HumanInviteCodeAddedType,
+					MessageType:         domain.InviteUserMessageType,
+					NotificationType:    domain.NotificationTypeEmail,
+					ProviderID:          "emailProviderID",
+					Error:               "ID=MAIL-Ud3nf8 Message=Errors.User.Email.Undeliverable",
+					Final:               true,
+				}).Return(nil)
+				return fieldsWorker{
+						queries:  queries,
+						commands: commands,
+						es: eventstore.NewEventstore(&eventstore.Config{
+							Querier: es_repo_mock.NewRepo(t).MockQuerier,
+						}),
+						userDataCrypto: codeAlg,
+						now:            testNow,
+					},
+					argsWorker{
+						job: &river.Job[*notification.Request]{
+							JobRow: &rivertype.JobRow{
+								CreatedAt: time.Now(),
+							},
+							Args: &notification.Request{
+								Aggregate: &eventstore.Aggregate{
+									InstanceID:    instanceID,
+									ID:            userID,
+									ResourceOwner: orgID,
+								},
+								UserID:                        userID,
+								UserResourceOwner:             orgID,
+								TriggeredAtOrigin:             eventOrigin,
+								EventType:                     user.HumanInviteCodeAddedType,
+								MessageType:                   domain.InviteUserMessageType,
+								NotificationType:              domain.NotificationTypeEmail,
+								URLTemplate:                   fmt.Sprintf("%s/ui/login/user/invite?userID=%s&loginname={{.LoginName}}&code={{.Code}}&orgID=%s&authRequestID=%s", eventOrigin, userID, orgID, authRequestID),
+								CodeExpiry:                    1 * time.Hour,
+								Code:                          code,
+								UnverifiedNotificationChannel: true,
+								IsOTP:                         false,
+								RequiresPreviousDomain:        false,
+								Args: &domain.NotificationArguments{
+									ApplicationName: "APP",
+								},
+							},
+						},
+					},
+					w
+			},
+		},
 		{
 			name: "send failed (max attempts), cancel",
 			test: func(ctrl *gomock.Controller, queries *mock.MockQueries, commands *mock.MockCommands) (f fieldsWorker, a argsWorker, w wantWorker) {
@@ -346,6 +474,19 @@ func Test_userNotifier_reduceNotificationRequested(t *testing.T) {
 
 				codeAlg, code := cryptoValue(t, ctrl, "testcode")
 				expectTemplateWithNotifyUserQueries(queries, givenTemplate)
+				commands.EXPECT().NotificationAttempted(gomock.Any(), &command.NotificationAttempt{
+					ID:                  "0",
+					InstanceID:          instanceID,
+					ResourceOwner:       orgID,
+					UserID:              userID,
+					TriggeringEventType: user.HumanInviteCodeAddedType,
+					MessageType:         domain.InviteUserMessageType,
+					NotificationType:    domain.NotificationTypeEmail,
+					ProviderID:          "emailProviderID",
+					Attempt:             3,
+					Error:               sendError.Error(),
+					Final:               true,
+				}).Return(nil)
 				return fieldsWorker{
 						queries:  queries,
 						commands: commands,
@@ -359,7 +500,9 @@ func Test_userNotifier_reduceNotificationRequested(t *testing.T) {
 					argsWorker{
 						job: &river.Job[*notification.Request]{
 							JobRow: &rivertype.JobRow{
-								CreatedAt: time.Now(),
+								CreatedAt:   time.Now(),
+								Attempt:     3,
+								MaxAttempts: 3,
 							},
 							Args: &notification.Request{
 								Aggregate: &eventstore.Aggregate{
@@ -434,6 +577,7 @@ func newNotificationWorker(t *testing.T, ctrl *gomock.Controller, queries *mock.
 			smtpAlg,
 			f.SMSTokenCrypto,
 			nil,
+			nil,
 		),
 		channels: &notificationChannels{
 			Chain: *senders.ChainChannels(channel),
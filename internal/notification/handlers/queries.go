@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"github.com/go-jose/go-jose/v4"
 	"golang.org/x/text/language"
@@ -31,6 +32,7 @@ type Queries interface {
 	GetInstanceRestrictions(ctx context.Context) (restrictions query.Restrictions, err error)
 	InstanceByID(ctx context.Context, id string) (instance authz.Instance, err error)
 	GetActiveSigningWebKey(ctx context.Context) (*jose.JSONWebKey, error)
+	ActiveCertificates(ctx context.Context, t time.Time, usage crypto.KeyUsage) (certs *query.Certificates, err error)
 
 	ActiveInstances() []string
 }
@@ -45,6 +47,7 @@ type NotificationQueries struct {
 	UserDataCrypto     crypto.EncryptionAlgorithm
 	SMTPPasswordCrypto crypto.EncryptionAlgorithm
 	SMSTokenCrypto     crypto.EncryptionAlgorithm
+	SAMLKeyCrypto      crypto.EncryptionAlgorithm
 	httpClient         *http.Client
 }
 
@@ -58,6 +61,7 @@ func NewNotificationQueries(
 	userDataCrypto crypto.EncryptionAlgorithm,
 	smtpPasswordCrypto crypto.EncryptionAlgorithm,
 	smsTokenCrypto crypto.EncryptionAlgorithm,
+	samlKeyCrypto crypto.EncryptionAlgorithm,
 	httpClient *http.Client,
 ) *NotificationQueries {
 	return &NotificationQueries{
@@ -70,6 +74,7 @@ func NewNotificationQueries(
 		UserDataCrypto:     userDataCrypto,
 		SMTPPasswordCrypto: smtpPasswordCrypto,
 		SMSTokenCrypto:     smsTokenCrypto,
+		SAMLKeyCrypto:      samlKeyCrypto,
 		httpClient:         httpClient,
 	}
 }
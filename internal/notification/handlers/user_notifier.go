@@ -23,13 +23,13 @@ import (
 
 func init() {
 	RegisterSentHandler(user.HumanInitialCodeAddedType,
-		func(ctx context.Context, commands Commands, id, orgID string, _ *senders.CodeGeneratorInfo, args map[string]any) error {
-			return commands.HumanInitCodeSent(ctx, orgID, id)
+		func(ctx context.Context, commands Commands, id, orgID string, generatorInfo *senders.CodeGeneratorInfo, args map[string]any) error {
+			return commands.HumanInitCodeSent(ctx, orgID, id, generatorInfo)
 		},
 	)
 	RegisterSentHandler(user.HumanEmailCodeAddedType,
 		func(ctx context.Context, commands Commands, id, orgID string, generatorInfo *senders.CodeGeneratorInfo, args map[string]any) error {
-			return commands.HumanEmailVerificationCodeSent(ctx, orgID, id)
+			return commands.HumanEmailVerificationCodeSent(ctx, orgID, id, generatorInfo)
 		},
 	)
 	RegisterSentHandler(user.HumanPasswordCodeAddedType,
@@ -49,12 +49,12 @@ func init() {
 	)
 	RegisterSentHandler(user.HumanOTPEmailCodeAddedType,
 		func(ctx context.Context, commands Commands, id, orgID string, generatorInfo *senders.CodeGeneratorInfo, args map[string]any) error {
-			return commands.HumanOTPEmailCodeSent(ctx, id, orgID)
+			return commands.HumanOTPEmailCodeSent(ctx, id, orgID, generatorInfo)
 		},
 	)
 	RegisterSentHandler(session.OTPEmailChallengedType,
 		func(ctx context.Context, commands Commands, id, orgID string, generatorInfo *senders.CodeGeneratorInfo, args map[string]any) error {
-			return commands.OTPEmailSent(ctx, id, orgID)
+			return commands.OTPEmailSent(ctx, id, orgID, generatorInfo)
 		},
 	)
 	RegisterSentHandler(user.UserDomainClaimedType,
@@ -78,8 +78,8 @@ func init() {
 		},
 	)
 	RegisterSentHandler(user.HumanInviteCodeAddedType,
-		func(ctx context.Context, commands Commands, id, orgID string, _ *senders.CodeGeneratorInfo, args map[string]any) error {
-			return commands.InviteCodeSent(ctx, orgID, id)
+		func(ctx context.Context, commands Commands, id, orgID string, generatorInfo *senders.CodeGeneratorInfo, args map[string]any) error {
+			return commands.InviteCodeSent(ctx, orgID, id, generatorInfo)
 		},
 	)
 }
@@ -48,7 +48,7 @@ func Test_userNotifierLegacy_reduceInitCodeAdded(t *testing.T) {
 			}
 			codeAlg, code := cryptoValue(t, ctrl, "testcode")
 			expectTemplateWithNotifyUserQueries(queries, givenTemplate)
-			commands.EXPECT().HumanInitCodeSent(gomock.Any(), orgID, userID).Return(nil)
+			commands.EXPECT().HumanInitCodeSent(gomock.Any(), orgID, userID, &senders.CodeGeneratorInfo{}).Return(nil)
 			return fields{
 					queries:  queries,
 					commands: commands,
@@ -89,7 +89,7 @@ func Test_userNotifierLegacy_reduceInitCodeAdded(t *testing.T) {
 				}},
 			}, nil)
 			expectTemplateWithNotifyUserQueries(queries, givenTemplate)
-			commands.EXPECT().HumanInitCodeSent(gomock.Any(), orgID, userID).Return(nil)
+			commands.EXPECT().HumanInitCodeSent(gomock.Any(), orgID, userID, &senders.CodeGeneratorInfo{}).Return(nil)
 			return fields{
 					queries:  queries,
 					commands: commands,
@@ -124,7 +124,7 @@ func Test_userNotifierLegacy_reduceInitCodeAdded(t *testing.T) {
 			}
 			codeAlg, code := cryptoValue(t, ctrl, testCode)
 			expectTemplateWithNotifyUserQueries(queries, givenTemplate)
-			commands.EXPECT().HumanInitCodeSent(gomock.Any(), orgID, userID).Return(nil)
+			commands.EXPECT().HumanInitCodeSent(gomock.Any(), orgID, userID, &senders.CodeGeneratorInfo{}).Return(nil)
 			return fields{
 					queries:  queries,
 					commands: commands,
@@ -166,7 +166,7 @@ func Test_userNotifierLegacy_reduceInitCodeAdded(t *testing.T) {
 				}},
 			}, nil)
 			expectTemplateWithNotifyUserQueries(queries, givenTemplate)
-			commands.EXPECT().HumanInitCodeSent(gomock.Any(), orgID, userID).Return(nil)
+			commands.EXPECT().HumanInitCodeSent(gomock.Any(), orgID, userID, &senders.CodeGeneratorInfo{}).Return(nil)
 			return fields{
 					queries:  queries,
 					commands: commands,
@@ -207,7 +207,7 @@ func Test_userNotifierLegacy_reduceInitCodeAdded(t *testing.T) {
 				}},
 			}, nil)
 			expectTemplateWithNotifyUserQueries(queries, givenTemplate)
-			commands.EXPECT().HumanInitCodeSent(gomock.Any(), orgID, userID).Return(nil)
+			commands.EXPECT().HumanInitCodeSent(gomock.Any(), orgID, userID, &senders.CodeGeneratorInfo{}).Return(nil)
 			return fields{
 					queries:  queries,
 					commands: commands,
@@ -313,7 +313,7 @@ func Test_userNotifierLegacy_reduceEmailCodeAdded(t *testing.T) {
 			}
 			codeAlg, code := cryptoValue(t, ctrl, "testcode")
 			expectTemplateWithNotifyUserQueries(queries, givenTemplate)
-			commands.EXPECT().HumanEmailVerificationCodeSent(gomock.Any(), orgID, userID).Return(nil)
+			commands.EXPECT().HumanEmailVerificationCodeSent(gomock.Any(), orgID, userID, &senders.CodeGeneratorInfo{}).Return(nil)
 			return fields{
 					queries:  queries,
 					commands: commands,
@@ -356,7 +356,7 @@ func Test_userNotifierLegacy_reduceEmailCodeAdded(t *testing.T) {
 				}},
 			}, nil)
 			expectTemplateWithNotifyUserQueries(queries, givenTemplate)
-			commands.EXPECT().HumanEmailVerificationCodeSent(gomock.Any(), orgID, userID).Return(nil)
+			commands.EXPECT().HumanEmailVerificationCodeSent(gomock.Any(), orgID, userID, &senders.CodeGeneratorInfo{}).Return(nil)
 			return fields{
 					queries:  queries,
 					commands: commands,
@@ -393,7 +393,7 @@ func Test_userNotifierLegacy_reduceEmailCodeAdded(t *testing.T) {
 			}
 			codeAlg, code := cryptoValue(t, ctrl, testCode)
 			expectTemplateWithNotifyUserQueries(queries, givenTemplate)
-			commands.EXPECT().HumanEmailVerificationCodeSent(gomock.Any(), orgID, userID).Return(nil)
+			commands.EXPECT().HumanEmailVerificationCodeSent(gomock.Any(), orgID, userID, &senders.CodeGeneratorInfo{}).Return(nil)
 			return fields{
 					queries:  queries,
 					commands: commands,
@@ -438,7 +438,7 @@ func Test_userNotifierLegacy_reduceEmailCodeAdded(t *testing.T) {
 				}},
 			}, nil)
 			expectTemplateWithNotifyUserQueries(queries, givenTemplate)
-			commands.EXPECT().HumanEmailVerificationCodeSent(gomock.Any(), orgID, userID).Return(nil)
+			commands.EXPECT().HumanEmailVerificationCodeSent(gomock.Any(), orgID, userID, &senders.CodeGeneratorInfo{}).Return(nil)
 			return fields{
 					queries:  queries,
 					commands: commands,
@@ -481,7 +481,7 @@ func Test_userNotifierLegacy_reduceEmailCodeAdded(t *testing.T) {
 				}},
 			}, nil)
 			expectTemplateWithNotifyUserQueries(queries, givenTemplate)
-			commands.EXPECT().HumanEmailVerificationCodeSent(gomock.Any(), orgID, userID).Return(nil)
+			commands.EXPECT().HumanEmailVerificationCodeSent(gomock.Any(), orgID, userID, &senders.CodeGeneratorInfo{}).Return(nil)
 			return fields{
 					queries:  queries,
 					commands: commands,
@@ -520,7 +520,7 @@ func Test_userNotifierLegacy_reduceEmailCodeAdded(t *testing.T) {
 			}
 			codeAlg, code := cryptoValue(t, ctrl, testCode)
 			expectTemplateWithNotifyUserQueries(queries, givenTemplate)
-			commands.EXPECT().HumanEmailVerificationCodeSent(gomock.Any(), orgID, userID).Return(nil)
+			commands.EXPECT().HumanEmailVerificationCodeSent(gomock.Any(), orgID, userID, &senders.CodeGeneratorInfo{}).Return(nil)
 			return fields{
 					queries:  queries,
 					commands: commands,
@@ -1526,7 +1526,7 @@ func Test_userNotifierLegacy_reduceOTPEmailChallenged(t *testing.T) {
 			codeAlg, code := cryptoValue(t, ctrl, "testcode")
 			expectTemplateWithNotifyUserQueries(queries, givenTemplate)
 			queries.EXPECT().SessionByID(gomock.Any(), gomock.Any(), userID, gomock.Any(), nil).Return(&query.Session{}, nil)
-			commands.EXPECT().OTPEmailSent(gomock.Any(), userID, orgID).Return(nil)
+			commands.EXPECT().OTPEmailSent(gomock.Any(), userID, orgID, &senders.CodeGeneratorInfo{}).Return(nil)
 			return fields{
 					queries:  queries,
 					commands: commands,
@@ -1570,7 +1570,7 @@ func Test_userNotifierLegacy_reduceOTPEmailChallenged(t *testing.T) {
 					IsPrimary: true,
 				}},
 			}, nil)
-			commands.EXPECT().OTPEmailSent(gomock.Any(), userID, orgID).Return(nil)
+			commands.EXPECT().OTPEmailSent(gomock.Any(), userID, orgID, &senders.CodeGeneratorInfo{}).Return(nil)
 			return fields{
 					queries:  queries,
 					commands: commands,
@@ -1608,7 +1608,7 @@ func Test_userNotifierLegacy_reduceOTPEmailChallenged(t *testing.T) {
 			codeAlg, code := cryptoValue(t, ctrl, testCode)
 			expectTemplateWithNotifyUserQueries(queries, givenTemplate)
 			queries.EXPECT().SessionByID(gomock.Any(), gomock.Any(), userID, gomock.Any(), nil).Return(&query.Session{}, nil)
-			commands.EXPECT().OTPEmailSent(gomock.Any(), userID, orgID).Return(nil)
+			commands.EXPECT().OTPEmailSent(gomock.Any(), userID, orgID, &senders.CodeGeneratorInfo{}).Return(nil)
 			return fields{
 					queries:  queries,
 					commands: commands,
@@ -1654,7 +1654,7 @@ func Test_userNotifierLegacy_reduceOTPEmailChallenged(t *testing.T) {
 			}, nil)
 			expectTemplateWithNotifyUserQueries(queries, givenTemplate)
 			queries.EXPECT().SessionByID(gomock.Any(), gomock.Any(), userID, gomock.Any(), nil).Return(&query.Session{}, nil)
-			commands.EXPECT().OTPEmailSent(gomock.Any(), userID, orgID).Return(nil)
+			commands.EXPECT().OTPEmailSent(gomock.Any(), userID, orgID, &senders.CodeGeneratorInfo{}).Return(nil)
 			return fields{
 					queries:  queries,
 					commands: commands,
@@ -1692,7 +1692,7 @@ func Test_userNotifierLegacy_reduceOTPEmailChallenged(t *testing.T) {
 			codeAlg, code := cryptoValue(t, ctrl, testCode)
 			expectTemplateWithNotifyUserQueries(queries, givenTemplate)
 			queries.EXPECT().SessionByID(gomock.Any(), gomock.Any(), userID, gomock.Any(), nil).Return(&query.Session{}, nil)
-			commands.EXPECT().OTPEmailSent(gomock.Any(), userID, orgID).Return(nil)
+			commands.EXPECT().OTPEmailSent(gomock.Any(), userID, orgID, &senders.CodeGeneratorInfo{}).Return(nil)
 			return fields{
 					queries:  queries,
 					commands: commands,
@@ -1973,6 +1973,7 @@ func newUserNotifierLegacy(t *testing.T, ctrl *gomock.Controller, queries *mock.
 			smtpAlg,
 			f.SMSTokenCrypto,
 			nil,
+			nil,
 		),
 		otpEmailTmpl: func(origin *url.URL) string {
 			return origin.String() + defaultOTPEmailTemplate
@@ -28,6 +28,10 @@ type Email struct {
 	Subject             string
 	Content             string
 	TriggeringEventType eventstore.EventType
+
+	// DeliveryID is set by the sender, if the provider returns an ID for the delivery.
+	// It allows correlating later events of the provider, e.g. bounces.
+	DeliveryID string
 }
 
 func (msg *Email) GetContent() (string, error) {
@@ -61,6 +65,11 @@ func (msg *Email) GetContent() (string, error) {
 	return message, nil
 }
 
+// IsHTML returns true if the content is an HTML document and not plain text.
+func (msg *Email) IsHTML() bool {
+	return isHTML(msg.Content)
+}
+
 func (msg *Email) GetTriggeringEventType() eventstore.EventType {
 	return msg.TriggeringEventType
 }
@@ -27,7 +27,7 @@ var (
 
 func Register(
 	ctx context.Context,
-	userHandlerCustomConfig, quotaHandlerCustomConfig, telemetryHandlerCustomConfig, backChannelLogoutHandlerCustomConfig projection.CustomConfig,
+	userHandlerCustomConfig, quotaHandlerCustomConfig, telemetryHandlerCustomConfig, backChannelLogoutHandlerCustomConfig, cibaHandlerCustomConfig projection.CustomConfig,
 	notificationWorkerConfig handlers.WorkerConfig,
 	backChannelLogoutWorkerConfig *handlers.BackChannelLogoutWorkerConfig,
 	telemetryCfg handlers.TelemetryPusherConfig,
@@ -39,7 +39,7 @@ func Register(
 	es *eventstore.Eventstore,
 	otpEmailTmpl func(origin *url.URL) string,
 	fileSystemPath string,
-	userEncryption, smtpEncryption, smsEncryption crypto.EncryptionAlgorithm,
+	userEncryption, smtpEncryption, smsEncryption, samlKeyEncryption crypto.EncryptionAlgorithm,
 	queue *queue.Queue,
 	httpClient *http.Client,
 ) {
@@ -50,7 +50,7 @@ func Register(
 	// make sure the slice does not contain old values
 	projections = nil
 
-	q := handlers.NewNotificationQueries(queries, es, externalDomain, externalPort, externalSecure, fileSystemPath, userEncryption, smtpEncryption, smsEncryption, httpClient)
+	q := handlers.NewNotificationQueries(queries, es, externalDomain, externalPort, externalSecure, fileSystemPath, userEncryption, smtpEncryption, smsEncryption, samlKeyEncryption, httpClient)
 	c := newChannels(q)
 	projections = append(projections, handlers.NewUserNotifier(ctx, projection.ApplyCustomConfig(userHandlerCustomConfig), commands, q, c, otpEmailTmpl, notificationWorkerConfig, queue))
 	projections = append(projections, handlers.NewQuotaNotifier(ctx, projection.ApplyCustomConfig(quotaHandlerCustomConfig), commands, q, c))
@@ -71,6 +71,7 @@ func Register(
 		id.SonyFlakeGenerator(),
 		httpClient,
 	))
+	projections = append(projections, handlers.NewCIBANotifier(ctx, projection.ApplyCustomConfig(cibaHandlerCustomConfig), q, c))
 	if telemetryCfg.Enabled {
 		projections = append(projections, handlers.NewTelemetryPusher(ctx, telemetryCfg, projection.ApplyCustomConfig(telemetryHandlerCustomConfig), commands, q, c))
 	}
@@ -7,6 +7,7 @@ type CodeGenerator interface {
 type CodeGeneratorInfo struct {
 	ID             string `json:"id,omitempty"`
 	VerificationID string `json:"verificationId,omitempty"`
+	DeliveryID     string `json:"deliveryId,omitempty"`
 }
 
 func (c *CodeGeneratorInfo) GetID() string {
@@ -22,3 +23,10 @@ func (c *CodeGeneratorInfo) GetVerificationID() string {
 	}
 	return c.VerificationID
 }
+
+func (c *CodeGeneratorInfo) GetDeliveryID() string {
+	if c == nil {
+		return ""
+	}
+	return c.DeliveryID
+}
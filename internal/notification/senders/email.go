@@ -11,11 +11,19 @@ import (
 	"github.com/zitadel/zitadel/internal/notification/channels/fs"
 	"github.com/zitadel/zitadel/internal/notification/channels/instrumenting"
 	"github.com/zitadel/zitadel/internal/notification/channels/log"
+	"github.com/zitadel/zitadel/internal/notification/channels/mailgun"
+	"github.com/zitadel/zitadel/internal/notification/channels/sendgrid"
+	"github.com/zitadel/zitadel/internal/notification/channels/ses"
 	"github.com/zitadel/zitadel/internal/notification/channels/smtp"
 	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
 )
 
-const smtpSpanName = "smtp.NotificationChannel"
+const (
+	smtpSpanName     = "smtp.NotificationChannel"
+	sesSpanName      = "ses.NotificationChannel"
+	sendGridSpanName = "sendgrid.NotificationChannel"
+	mailgunSpanName  = "mailgun.NotificationChannel"
+)
 
 func EmailChannels(
 	ctx context.Context,
@@ -44,6 +52,42 @@ func EmailChannels(
 			)
 		}
 	}
+	if emailConfig.SESConfig != nil {
+		channels = append(
+			channels,
+			instrumenting.Wrap(
+				ctx,
+				ses.InitChannel(ctx, *emailConfig.SESConfig),
+				sesSpanName,
+				successMetricName,
+				failureMetricName,
+			),
+		)
+	}
+	if emailConfig.SendGridConfig != nil {
+		channels = append(
+			channels,
+			instrumenting.Wrap(
+				ctx,
+				sendgrid.InitChannel(ctx, *emailConfig.SendGridConfig),
+				sendGridSpanName,
+				successMetricName,
+				failureMetricName,
+			),
+		)
+	}
+	if emailConfig.MailgunConfig != nil {
+		channels = append(
+			channels,
+			instrumenting.Wrap(
+				ctx,
+				mailgun.InitChannel(ctx, *emailConfig.MailgunConfig),
+				mailgunSpanName,
+				successMetricName,
+				failureMetricName,
+			),
+		)
+	}
 	if emailConfig.WebhookConfig != nil {
 		webhookChannel, err := webhook.InitChannel(ctx, *emailConfig.WebhookConfig)
 		logging.WithFields(
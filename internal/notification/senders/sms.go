@@ -11,11 +11,17 @@ import (
 	"github.com/zitadel/zitadel/internal/notification/channels/instrumenting"
 	"github.com/zitadel/zitadel/internal/notification/channels/log"
 	"github.com/zitadel/zitadel/internal/notification/channels/sms"
+	"github.com/zitadel/zitadel/internal/notification/channels/sns"
 	"github.com/zitadel/zitadel/internal/notification/channels/twilio"
+	"github.com/zitadel/zitadel/internal/notification/channels/vonage"
 	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
 )
 
-const twilioSpanName = "twilio.NotificationChannel"
+const (
+	twilioSpanName = "twilio.NotificationChannel"
+	vonageSpanName = "vonage.NotificationChannel"
+	snsSpanName    = "sns.NotificationChannel"
+)
 
 func SMSChannels(
 	ctx context.Context,
@@ -38,6 +44,30 @@ func SMSChannels(
 			),
 		)
 	}
+	if smsConfig.VonageConfig != nil {
+		channels = append(
+			channels,
+			instrumenting.Wrap(
+				ctx,
+				vonage.InitChannel(ctx, *smsConfig.VonageConfig),
+				vonageSpanName,
+				successMetricName,
+				failureMetricName,
+			),
+		)
+	}
+	if smsConfig.SNSConfig != nil {
+		channels = append(
+			channels,
+			instrumenting.Wrap(
+				ctx,
+				sns.InitChannel(ctx, *smsConfig.SNSConfig),
+				snsSpanName,
+				successMetricName,
+				failureMetricName,
+			),
+		)
+	}
 	if smsConfig.WebhookConfig != nil {
 		webhookChannel, err := webhook.InitChannel(ctx, *smsConfig.WebhookConfig)
 		logging.WithFields(
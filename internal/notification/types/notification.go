@@ -40,6 +40,7 @@ func SendEmail(
 	user *query.NotifyUser,
 	colors *query.LabelPolicy,
 	triggeringEventType eventstore.EventType,
+	generatorInfo *senders.CodeGeneratorInfo,
 ) Notify {
 	return func(
 		urlTmpl string,
@@ -67,6 +68,7 @@ func SendEmail(
 			args,
 			allowUnverifiedNotificationChannel,
 			triggeringEventType,
+			generatorInfo,
 		)
 	}
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore"
 	zchannels "github.com/zitadel/zitadel/internal/notification/channels"
 	"github.com/zitadel/zitadel/internal/notification/messages"
+	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/notification/templates"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -24,6 +25,7 @@ func generateEmail(
 	args map[string]interface{},
 	lastEmail bool,
 	triggeringEventType eventstore.EventType,
+	generatorInfo *senders.CodeGeneratorInfo,
 ) error {
 	emailChannels, config, err := channels.Email(ctx)
 	logging.OnError(err).Error("could not create email channel")
@@ -36,14 +38,29 @@ func generateEmail(
 	if lastEmail {
 		recipient = user.LastEmail
 	}
-	if config.SMTPConfig != nil {
+	// don't send emails to addresses, which were reported as undeliverable by the provider,
+	// to protect the reputation of the sender
+	if user.UndeliverableEmail != "" && strings.EqualFold(user.UndeliverableEmail, recipient) {
+		return zchannels.NewCancelError(
+			zerrors.ThrowPreconditionFailed(nil, "MAIL-Ud3nf8", "Errors.User.Email.Undeliverable"),
+		)
+	}
+	if config.SMTPConfig != nil || config.DeliversOverAPI() {
 		message := &messages.Email{
 			Recipients:          []string{recipient},
 			Subject:             data.Subject,
 			Content:             html.UnescapeString(template),
 			TriggeringEventType: triggeringEventType,
 		}
-		return emailChannels.HandleMessage(message)
+		err = emailChannels.HandleMessage(message)
+		if err != nil {
+			return err
+		}
+		if message.DeliveryID != "" && generatorInfo != nil {
+			generatorInfo.ID = config.ProviderConfig.ID
+			generatorInfo.DeliveryID = message.DeliveryID
+		}
+		return nil
 	}
 	if config.WebhookConfig != nil {
 		caseArgs := make(map[string]interface{}, len(args))
@@ -68,6 +68,17 @@ func generateSms(
 		}
 		return nil
 	}
+	if config.VonageConfig != nil || config.SNSConfig != nil {
+		// the sender is taken from the provider configuration
+		return smsChannels.HandleMessage(&messages.SMS{
+			RecipientPhoneNumber: recipient,
+			Content:              data.Text,
+			TriggeringEventType:  triggeringEventType,
+			InstanceID:           instanceID,
+			JobID:                jobID,
+			UserID:               user.ID,
+		})
+	}
 	if config.WebhookConfig != nil {
 		caseArgs := make(map[string]interface{}, len(args))
 		for k, v := range args {
@@ -0,0 +1,14 @@
+package provisioning
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const ProvisionerUserID = "PROVISIONING"
+
+func ContextWithProvisioner(ctx context.Context, aggregate *eventstore.Aggregate) context.Context {
+	return authz.WithInstanceID(authz.SetCtxData(ctx, authz.CtxData{UserID: ProvisionerUserID, OrgID: aggregate.ResourceOwner}), aggregate.InstanceID)
+}
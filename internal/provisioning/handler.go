@@ -0,0 +1,165 @@
+package provisioning
+
+import (
+	"context"
+
+	"github.com/riverqueue/river"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/queue"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/provisioning"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+)
+
+const (
+	ProvisioningRequestsProjectionTable = "projections.provisioning_requests"
+)
+
+type Queue interface {
+	Insert(ctx context.Context, args river.JobArgs, opts ...queue.InsertOpt) error
+}
+
+// requestHandler creates a [Request] for every event,
+// which might change the state of a user in a downstream application.
+type requestHandler struct {
+	queries     Queries
+	queue       Queue
+	maxAttempts uint8
+}
+
+func NewRequestHandler(
+	ctx context.Context,
+	config handler.Config,
+	queries Queries,
+	queue Queue,
+	maxAttempts uint8,
+) *handler.Handler {
+	return handler.NewHandler(ctx, &config, &requestHandler{
+		queries:     queries,
+		queue:       queue,
+		maxAttempts: maxAttempts,
+	})
+}
+
+func (*requestHandler) Name() string {
+	return ProvisioningRequestsProjectionTable
+}
+
+func (h *requestHandler) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: h.eventReducers(h.reduceUserEvent,
+				user.UserV1AddedType,
+				user.UserV1RegisteredType,
+				user.HumanAddedType,
+				user.HumanRegisteredType,
+				user.HumanProfileChangedType,
+				user.HumanEmailChangedType,
+				user.HumanPhoneChangedType,
+				user.HumanPhoneRemovedType,
+				user.UserUserNameChangedType,
+				user.UserDeactivatedType,
+				user.UserReactivatedType,
+				user.UserLockedType,
+				user.UserUnlockedType,
+				user.UserRemovedType,
+			),
+		},
+		{
+			Aggregate: usergrant.AggregateType,
+			EventReducers: h.eventReducers(h.reduceUserGrantEvent,
+				usergrant.UserGrantAddedType,
+				usergrant.UserGrantRemovedType,
+				usergrant.UserGrantCascadeRemovedType,
+				usergrant.UserGrantDeactivatedType,
+				usergrant.UserGrantReactivatedType,
+			),
+		},
+		{
+			Aggregate: group.AggregateType,
+			EventReducers: h.eventReducers(h.reduceGroupEvent,
+				group.GroupAddedEventType,
+				group.GroupChangedEventType,
+				group.GroupRemovedEventType,
+				group.GroupUsersAddedEventType,
+				group.GroupUsersRemovedEventType,
+			),
+		},
+		{
+			Aggregate: provisioning.AggregateType,
+			EventReducers: h.eventReducers(h.reduceTargetEvent,
+				provisioning.AddedEventType,
+				provisioning.ResyncRequestedEventType,
+			),
+		},
+	}
+}
+
+func (h *requestHandler) eventReducers(reduce handler.Reduce, eventTypes ...eventstore.EventType) []handler.EventReducer {
+	reducers := make([]handler.EventReducer, len(eventTypes))
+	for i, eventType := range eventTypes {
+		reducers[i] = handler.EventReducer{
+			Event:  eventType,
+			Reduce: reduce,
+		}
+	}
+	return reducers
+}
+
+func (h *requestHandler) reduceUserEvent(event eventstore.Event) (*handler.Statement, error) {
+	return h.newRequestStatement(event, &Request{
+		Aggregate:           event.Aggregate(),
+		TriggeringEventType: event.Type(),
+		UserID:              event.Aggregate().ID,
+	}), nil
+}
+
+func (h *requestHandler) reduceUserGrantEvent(event eventstore.Event) (*handler.Statement, error) {
+	return h.newRequestStatement(event, &Request{
+		Aggregate:           event.Aggregate(),
+		TriggeringEventType: event.Type(),
+		UserGrantID:         event.Aggregate().ID,
+	}), nil
+}
+
+func (h *requestHandler) reduceGroupEvent(event eventstore.Event) (*handler.Statement, error) {
+	return h.newRequestStatement(event, &Request{
+		Aggregate:           event.Aggregate(),
+		TriggeringEventType: event.Type(),
+		GroupID:             event.Aggregate().ID,
+	}), nil
+}
+
+func (h *requestHandler) reduceTargetEvent(event eventstore.Event) (*handler.Statement, error) {
+	return handler.NewStatement(event, func(ctx context.Context, ex handler.Executer, projectionName string) error {
+		return h.insert(ctx, &Request{
+			Aggregate:           event.Aggregate(),
+			TriggeringEventType: event.Type(),
+			TargetID:            event.Aggregate().ID,
+		})
+	}), nil
+}
+
+func (h *requestHandler) newRequestStatement(event eventstore.Event, request *Request) *handler.Statement {
+	return handler.NewStatement(event, func(ctx context.Context, ex handler.Executer, projectionName string) error {
+		// most instances do not provision to downstream applications,
+		// so requests are only created if there is any target to reconcile.
+		hasTargets, err := h.queries.HasProvisioningTargets(ctx)
+		if err != nil || !hasTargets {
+			return err
+		}
+		return h.insert(ctx, request)
+	})
+}
+
+func (h *requestHandler) insert(ctx context.Context, request *Request) error {
+	return h.queue.Insert(ctx,
+		request,
+		queue.WithQueueName(QueueName),
+		queue.WithMaxAttempts(h.maxAttempts),
+	)
+}
@@ -0,0 +1,49 @@
+package provisioning
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/queue"
+)
+
+var (
+	projections []*handler.Handler
+)
+
+func Register(
+	ctx context.Context,
+	requestHandlerCustomConfig projection.CustomConfig,
+	workerConfig WorkerConfig,
+	commands *command.Commands,
+	queries *query.Queries,
+	es *eventstore.Eventstore,
+	queue *queue.Queue,
+	httpClient *http.Client,
+) {
+	queue.ShouldStart()
+
+	// make sure the slice does not contain old values
+	projections = nil
+
+	projections = append(projections, NewRequestHandler(
+		ctx,
+		projection.ApplyCustomConfig(requestHandlerCustomConfig),
+		queries,
+		queue,
+		workerConfig.MaxAttempts,
+	))
+	queue.AddWorkers(ctx, NewWorker(workerConfig, queries, commands, es, queue, httpClient, time.Now))
+}
+
+func Start(ctx context.Context) {
+	for _, projection := range projections {
+		projection.Start(ctx)
+	}
+}
@@ -0,0 +1,28 @@
+package provisioning
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+const (
+	QueueName = "provisioning"
+)
+
+// Request triggers the reconciliation of identities to the provisioning targets.
+// Depending on the set fields the worker either
+//   - reconciles a single user (UserID),
+//   - resolves the user and project of a user grant and reconciles the user (UserGrantID),
+//   - reconciles a group and its members (GroupID) or
+//   - creates a request for every user granted on the project of the target,
+//     every user of the target no longer granted and every group of the organization of the target (only TargetID).
+type Request struct {
+	Aggregate           *eventstore.Aggregate `json:"aggregate"`
+	TriggeringEventType eventstore.EventType  `json:"triggeringEventType"`
+	UserID              string                `json:"userID,omitempty"`
+	UserGrantID         string                `json:"userGrantID,omitempty"`
+	GroupID             string                `json:"groupID,omitempty"`
+	// TargetID limits the reconciliation to a single target.
+	TargetID string `json:"targetID,omitempty"`
+}
+
+func (r *Request) Kind() string {
+	return "provisioning_request"
+}
@@ -0,0 +1,206 @@
+package provisioning
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	scimUserSchema    = "urn:ietf:params:scim:schemas:core:2.0:User"
+	scimGroupSchema   = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	scimContentType   = "application/scim+json"
+	scimUsersPath     = "/Users"
+	scimGroupsPath    = "/Groups"
+	scimListPageSize  = 100
+	maxErrorBodyBytes = 1024
+)
+
+type scimUser struct {
+	Schemas      []string     `json:"schemas"`
+	ID           string       `json:"id,omitempty"`
+	ExternalID   string       `json:"externalId"`
+	UserName     string       `json:"userName"`
+	Name         *scimName    `json:"name,omitempty"`
+	DisplayName  string       `json:"displayName,omitempty"`
+	Emails       []*scimValue `json:"emails,omitempty"`
+	PhoneNumbers []*scimValue `json:"phoneNumbers,omitempty"`
+	Active       bool         `json:"active"`
+}
+
+type scimName struct {
+	Formatted  string `json:"formatted,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+}
+
+type scimValue struct {
+	Value   string `json:"value"`
+	Primary bool   `json:"primary"`
+}
+
+type scimGroup struct {
+	Schemas     []string          `json:"schemas"`
+	ID          string            `json:"id,omitempty"`
+	ExternalID  string            `json:"externalId"`
+	DisplayName string            `json:"displayName"`
+	Members     []*scimGroupValue `json:"members"`
+}
+
+type scimGroupValue struct {
+	// Value is the id of the user in the service provider.
+	Value string `json:"value"`
+}
+
+// scimListResponse is the response of a list request.
+// Only the id and externalId of the listed resources are used, so it is used for users and groups.
+type scimListResponse struct {
+	TotalResults int         `json:"totalResults"`
+	Resources    []*scimUser `json:"Resources"`
+}
+
+// scimClient provisions users and groups to a downstream SCIM v2 service provider.
+// Resources are correlated by their externalId, which is set to the ID of the ZITADEL user or group.
+type scimClient struct {
+	httpClient *http.Client
+	endpoint   string
+	token      string
+}
+
+func newSCIMClient(httpClient *http.Client, endpoint, token string) *scimClient {
+	return &scimClient{
+		httpClient: httpClient,
+		endpoint:   strings.TrimSuffix(endpoint, "/"),
+		token:      token,
+	}
+}
+
+// upsertUser creates the user if no user with the same externalId exists, otherwise the user is replaced.
+func (c *scimClient) upsertUser(ctx context.Context, user *scimUser) error {
+	return c.upsert(ctx, scimUsersPath, user.ExternalID, user)
+}
+
+// deleteUser deletes the user with the externalId, if it exists.
+func (c *scimClient) deleteUser(ctx context.Context, externalID string) error {
+	return c.delete(ctx, scimUsersPath, externalID)
+}
+
+func (c *scimClient) findUserID(ctx context.Context, externalID string) (string, error) {
+	return c.findID(ctx, scimUsersPath, externalID)
+}
+
+// listUserExternalIDs returns the externalIds of all users of the service provider.
+// Users without an externalId are not managed by ZITADEL and therefore ignored.
+func (c *scimClient) listUserExternalIDs(ctx context.Context) ([]string, error) {
+	externalIDs := make([]string, 0, scimListPageSize)
+	// the startIndex of SCIM lists is 1-based
+	for startIndex := 1; ; startIndex += scimListPageSize {
+		query := url.Values{
+			"attributes": []string{"externalId"},
+			"startIndex": []string{strconv.Itoa(startIndex)},
+			"count":      []string{strconv.Itoa(scimListPageSize)},
+		}
+		list := new(scimListResponse)
+		if err := c.do(ctx, http.MethodGet, scimUsersPath+"?"+query.Encode(), nil, list); err != nil {
+			return nil, err
+		}
+		for _, user := range list.Resources {
+			if user.ExternalID != "" {
+				externalIDs = append(externalIDs, user.ExternalID)
+			}
+		}
+		if len(list.Resources) == 0 || startIndex+len(list.Resources) > list.TotalResults {
+			return externalIDs, nil
+		}
+	}
+}
+
+// upsertGroup creates the group if no group with the same externalId exists, otherwise the group is replaced.
+func (c *scimClient) upsertGroup(ctx context.Context, group *scimGroup) error {
+	return c.upsert(ctx, scimGroupsPath, group.ExternalID, group)
+}
+
+// deleteGroup deletes the group with the externalId, if it exists.
+func (c *scimClient) deleteGroup(ctx context.Context, externalID string) error {
+	return c.delete(ctx, scimGroupsPath, externalID)
+}
+
+func (c *scimClient) upsert(ctx context.Context, path, externalID string, resource any) error {
+	id, err := c.findID(ctx, path, externalID)
+	if err != nil {
+		return err
+	}
+	if id == "" {
+		return c.do(ctx, http.MethodPost, path, resource, nil)
+	}
+	return c.do(ctx, http.MethodPut, path+"/"+url.PathEscape(id), resource, nil)
+}
+
+func (c *scimClient) delete(ctx context.Context, path, externalID string) error {
+	id, err := c.findID(ctx, path, externalID)
+	if err != nil || id == "" {
+		return err
+	}
+	return c.do(ctx, http.MethodDelete, path+"/"+url.PathEscape(id), nil, nil)
+}
+
+func (c *scimClient) findID(ctx context.Context, path, externalID string) (string, error) {
+	query := url.Values{
+		"filter": []string{fmt.Sprintf("externalId eq %q", externalID)},
+	}
+	list := new(scimListResponse)
+	if err := c.do(ctx, http.MethodGet, path+"?"+query.Encode(), nil, list); err != nil {
+		return "", err
+	}
+	if len(list.Resources) == 0 {
+		return "", nil
+	}
+	return list.Resources[0].ID, nil
+}
+
+func (c *scimClient) do(ctx context.Context, method, path string, body, response any) error {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.endpoint+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", scimContentType)
+	if body != nil {
+		req.Header.Set("Content-Type", scimContentType)
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// a resource deleted in the meantime is already in the desired state
+	if method == http.MethodDelete && resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		errBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
+		return fmt.Errorf("scim %s %s failed with status %d: %s", method, path, resp.StatusCode, errBody)
+	}
+	if response == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(response)
+}
@@ -0,0 +1,507 @@
+package provisioning
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/queue"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type Queries interface {
+	HasProvisioningTargets(ctx context.Context) (bool, error)
+	ProvisioningTargetsWithToken(ctx context.Context, queries ...query.SearchQuery) ([]*query.ProvisioningTarget, error)
+	GetUserByID(ctx context.Context, shouldTriggerBulk bool, userID string) (*query.User, error)
+	UserGrants(ctx context.Context, queries *query.UserGrantsQueries, shouldTriggerBulk bool, permissionCheck domain.PermissionCheck) (*query.UserGrants, error)
+	SearchGroups(ctx context.Context, queries *query.GroupSearchQuery, permissionCheck domain.PermissionCheck) (*query.Groups, error)
+	SearchGroupUsers(ctx context.Context, queries *query.GroupUsersSearchQuery, permissionCheck domain.PermissionCheck) (*query.GroupUsers, error)
+}
+
+type Commands interface {
+	ProvisioningTargetSyncSucceeded(ctx context.Context, id, resourceOwner string) error
+	ProvisioningTargetSyncFailed(ctx context.Context, id, resourceOwner, userID, errorMessage string) error
+}
+
+type Filter interface {
+	FilterToQueryReducer(ctx context.Context, reducer eventstore.QueryReducer) error
+}
+
+type Worker struct {
+	river.WorkerDefaults[*Request]
+
+	config     WorkerConfig
+	queries    Queries
+	commands   Commands
+	eventstore Filter
+	queue      Queue
+	httpClient *http.Client
+	now        NowFunc
+}
+
+// NowFunc makes [time.Now] mockable
+type NowFunc func() time.Time
+
+type WorkerConfig struct {
+	Workers             uint8
+	TransactionDuration time.Duration
+	MaxAttempts         uint8
+	MaxTtl              time.Duration
+}
+
+func NewWorker(
+	config WorkerConfig,
+	queries Queries,
+	commands Commands,
+	eventstore Filter,
+	queue Queue,
+	httpClient *http.Client,
+	now NowFunc,
+) *Worker {
+	return &Worker{
+		config:     config,
+		queries:    queries,
+		commands:   commands,
+		eventstore: eventstore,
+		queue:      queue,
+		httpClient: httpClient,
+		now:        now,
+	}
+}
+
+var _ river.Worker[*Request] = (*Worker)(nil)
+
+func (w *Worker) Register(workers *river.Workers, queues map[string]river.QueueConfig) {
+	river.AddWorker(workers, w)
+	queues[QueueName] = river.QueueConfig{
+		MaxWorkers: int(w.config.Workers),
+	}
+}
+
+// Timeout implements the Timeout-function of [river.Worker].
+// Maximum time a job can run before the context gets canceled.
+func (w *Worker) Timeout(*river.Job[*Request]) time.Duration {
+	return w.config.TransactionDuration
+}
+
+// Work implements [river.Worker].
+func (w *Worker) Work(ctx context.Context, job *river.Job[*Request]) error {
+	ctx = ContextWithProvisioner(ctx, job.Args.Aggregate)
+
+	// if the request is too old, a resync of the target is needed anyway
+	if job.CreatedAt.Add(w.config.MaxTtl).Before(w.now()) {
+		return river.JobCancel(errors.New("provisioning request is too old"))
+	}
+
+	switch {
+	case job.Args.UserGrantID != "":
+		grant := &userGrant{id: job.Args.UserGrantID}
+		if err := w.eventstore.FilterToQueryReducer(ctx, grant); err != nil {
+			return err
+		}
+		if grant.userID == "" {
+			return river.JobCancel(errors.New("user grant not found"))
+		}
+		if err := w.reconcileUser(ctx, job, grant.userID, grant.projectID, ""); err != nil {
+			return err
+		}
+		// the members of the groups of the user provisioned to the targets changed
+		return w.createGroupRequests(ctx, job.Args, grant.userID)
+	case job.Args.UserID != "":
+		return w.reconcileUser(ctx, job, job.Args.UserID, "", job.Args.TargetID)
+	case job.Args.GroupID != "":
+		return w.reconcileGroup(ctx, job, job.Args.GroupID, job.Args.TargetID)
+	default:
+		return w.createResyncRequests(ctx, job.Args)
+	}
+}
+
+// createResyncRequests creates a request for
+//   - every user granted on the project of the target,
+//   - every user of the target, which is no longer granted, so it is deprovisioned and
+//   - every group of the organization of the target.
+//
+// The users of the target are expected to be managed by ZITADEL:
+// every user with an externalId, which is not granted, is deleted.
+func (w *Worker) createResyncRequests(ctx context.Context, request *Request) error {
+	targets, err := w.targets(ctx, request.TargetID, nil)
+	if err != nil || len(targets) == 0 {
+		return err
+	}
+	target := targets[0]
+	projectQuery, err := query.NewUserGrantProjectIDSearchQuery(target.ProjectID)
+	if err != nil {
+		return err
+	}
+	grants, err := w.queries.UserGrants(ctx, &query.UserGrantsQueries{Queries: []query.SearchQuery{projectQuery}}, true, nil)
+	if err != nil {
+		return err
+	}
+	provisionedUserIDs, err := newSCIMClient(w.httpClient, target.Endpoint, target.Token).listUserExternalIDs(ctx)
+	if err != nil {
+		return err
+	}
+	userIDs := make([]string, 0, len(grants.UserGrants)+len(provisionedUserIDs))
+	for _, grant := range grants.UserGrants {
+		userIDs = append(userIDs, grant.UserID)
+	}
+	userIDs = append(userIDs, provisionedUserIDs...)
+	slices.Sort(userIDs)
+	for _, userID := range slices.Compact(userIDs) {
+		if err = w.insert(ctx, request, &Request{UserID: userID, TargetID: request.TargetID}); err != nil {
+			return err
+		}
+	}
+
+	groupIDs, err := w.organizationGroupIDs(ctx, target.ResourceOwner)
+	if err != nil {
+		return err
+	}
+	for _, groupID := range groupIDs {
+		if err = w.insert(ctx, request, &Request{GroupID: groupID, TargetID: request.TargetID}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createGroupRequests creates a request for every group of the user.
+func (w *Worker) createGroupRequests(ctx context.Context, request *Request, userID string) error {
+	userQuery, err := query.NewGroupUserIDSearchQuery(userID)
+	if err != nil {
+		return err
+	}
+	groups, err := w.queries.SearchGroups(ctx, &query.GroupSearchQuery{Queries: []query.SearchQuery{userQuery}}, nil)
+	if err != nil {
+		return err
+	}
+	for _, group := range groups.Groups {
+		if err = w.insert(ctx, request, &Request{GroupID: group.ID}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insert enqueues the request with the aggregate and triggering event of the origin request.
+func (w *Worker) insert(ctx context.Context, origin, request *Request) error {
+	request.Aggregate = origin.Aggregate
+	request.TriggeringEventType = origin.TriggeringEventType
+	return w.queue.Insert(ctx,
+		request,
+		queue.WithQueueName(QueueName),
+		queue.WithMaxAttempts(w.config.MaxAttempts),
+	)
+}
+
+// reconcileUser brings the user into the desired state on every affected target:
+//   - users granted on the project of the target are created or updated, inactive users are deactivated
+//   - users without a grant on the project or removed users are deleted
+//
+// If projectID is set, the targets of the project are reconciled as well,
+// as the user might no longer be granted on it.
+func (w *Worker) reconcileUser(ctx context.Context, job *river.Job[*Request], userID, projectID, targetID string) error {
+	user, err := w.queries.GetUserByID(ctx, true, userID)
+	if zerrors.IsNotFound(err) {
+		user, err = nil, nil
+	}
+	if err != nil {
+		return err
+	}
+	if user != nil && user.Type != domain.UserTypeHuman {
+		return nil
+	}
+	grantedProjectIDs, err := w.grantedProjectIDs(ctx, user)
+	if err != nil {
+		return err
+	}
+
+	var projectIDs []string
+	if targetID == "" {
+		projectIDs = slices.Clone(grantedProjectIDs)
+		if projectID != "" && !slices.Contains(projectIDs, projectID) {
+			projectIDs = append(projectIDs, projectID)
+		}
+		// users without any grant are not provisioned.
+		// The grants of a removed user are not known anymore, therefore all targets are reconciled.
+		if len(projectIDs) == 0 && user != nil {
+			return nil
+		}
+	}
+	targets, err := w.targets(ctx, targetID, projectIDs)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, target := range targets {
+		client := newSCIMClient(w.httpClient, target.Endpoint, target.Token)
+		if user != nil && slices.Contains(grantedProjectIDs, target.ProjectID) {
+			err = client.upsertUser(ctx, userToSCIM(user))
+		} else {
+			err = client.deleteUser(ctx, userID)
+		}
+		errs = append(errs, w.recordSyncResult(ctx, job, target, userID, err))
+	}
+	return errors.Join(errs...)
+}
+
+// reconcileGroup brings the group into the desired state on every target of the organization of the group:
+//   - groups are created or updated with their members, which are granted on the project of the target
+//   - removed groups are deleted
+//
+// Members, which are not provisioned to the target yet, are added by the next reconciliation of the group.
+func (w *Worker) reconcileGroup(ctx context.Context, job *river.Job[*Request], groupID, targetID string) error {
+	group, err := w.group(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	var memberIDs []string
+	organizationID := job.Args.Aggregate.ResourceOwner
+	if group != nil {
+		organizationID = group.ResourceOwner
+		memberIDs, err = w.groupMemberIDs(ctx, groupID)
+		if err != nil {
+			return err
+		}
+	}
+	targets, err := w.organizationTargets(ctx, targetID, organizationID)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, target := range targets {
+		client := newSCIMClient(w.httpClient, target.Endpoint, target.Token)
+		if group != nil {
+			err = w.upsertGroup(ctx, client, target, group, memberIDs)
+		} else {
+			err = client.deleteGroup(ctx, groupID)
+		}
+		errs = append(errs, w.recordSyncResult(ctx, job, target, "", err))
+	}
+	return errors.Join(errs...)
+}
+
+func (w *Worker) upsertGroup(ctx context.Context, client *scimClient, target *query.ProvisioningTarget, group *query.Group, memberIDs []string) error {
+	grantedIDs, err := w.grantedUserIDs(ctx, target.ProjectID, memberIDs)
+	if err != nil {
+		return err
+	}
+	members := make([]*scimGroupValue, 0, len(grantedIDs))
+	for _, userID := range grantedIDs {
+		id, err := client.findUserID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if id != "" {
+			members = append(members, &scimGroupValue{Value: id})
+		}
+	}
+	return client.upsertGroup(ctx, &scimGroup{
+		Schemas:     []string{scimGroupSchema},
+		ExternalID:  group.ID,
+		DisplayName: group.Name,
+		Members:     members,
+	})
+}
+
+// recordSyncResult updates the status of the target.
+// Failures are only recorded after the last attempt, as the request is retried before.
+// The original error is returned to retry the request.
+func (w *Worker) recordSyncResult(ctx context.Context, job *river.Job[*Request], target *query.ProvisioningTarget, userID string, syncErr error) error {
+	if syncErr == nil {
+		err := w.commands.ProvisioningTargetSyncSucceeded(ctx, target.ID, target.ResourceOwner)
+		logging.OnError(err).WithField("target", target.ID).Warn("unable to record provisioning success")
+		return nil
+	}
+	if job.Attempt >= job.MaxAttempts {
+		err := w.commands.ProvisioningTargetSyncFailed(ctx, target.ID, target.ResourceOwner, userID, syncErr.Error())
+		logging.OnError(err).WithField("target", target.ID).Warn("unable to record provisioning failure")
+	}
+	return syncErr
+}
+
+func (w *Worker) grantedProjectIDs(ctx context.Context, user *query.User) ([]string, error) {
+	if user == nil {
+		return nil, nil
+	}
+	userQuery, err := query.NewUserGrantUserIDSearchQuery(user.ID)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := w.queries.UserGrants(ctx, &query.UserGrantsQueries{Queries: []query.SearchQuery{userQuery}}, true, nil)
+	if err != nil {
+		return nil, err
+	}
+	projectIDs := make([]string, 0, len(grants.UserGrants))
+	for _, grant := range grants.UserGrants {
+		if grant.State == domain.UserGrantStateActive && !slices.Contains(projectIDs, grant.ProjectID) {
+			projectIDs = append(projectIDs, grant.ProjectID)
+		}
+	}
+	return projectIDs, nil
+}
+
+// grantedUserIDs returns the users, which have an active grant on the project.
+func (w *Worker) grantedUserIDs(ctx context.Context, projectID string, userIDs []string) ([]string, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	projectQuery, err := query.NewUserGrantProjectIDSearchQuery(projectID)
+	if err != nil {
+		return nil, err
+	}
+	userQuery, err := query.NewUserGrantInUserIDsSearchQuery(userIDs)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := w.queries.UserGrants(ctx, &query.UserGrantsQueries{Queries: []query.SearchQuery{projectQuery, userQuery}}, true, nil)
+	if err != nil {
+		return nil, err
+	}
+	grantedIDs := make([]string, 0, len(grants.UserGrants))
+	for _, grant := range grants.UserGrants {
+		if grant.State == domain.UserGrantStateActive && !slices.Contains(grantedIDs, grant.UserID) {
+			grantedIDs = append(grantedIDs, grant.UserID)
+		}
+	}
+	return grantedIDs, nil
+}
+
+// group returns nil if the group does not exist (anymore).
+func (w *Worker) group(ctx context.Context, groupID string) (*query.Group, error) {
+	idQuery, err := query.NewGroupIDsSearchQuery([]string{groupID})
+	if err != nil {
+		return nil, err
+	}
+	groups, err := w.queries.SearchGroups(ctx, &query.GroupSearchQuery{Queries: []query.SearchQuery{idQuery}}, nil)
+	if err != nil || len(groups.Groups) == 0 {
+		return nil, err
+	}
+	return groups.Groups[0], nil
+}
+
+func (w *Worker) groupMemberIDs(ctx context.Context, groupID string) ([]string, error) {
+	groupQuery, err := query.NewGroupUsersGroupIDsSearchQuery([]string{groupID})
+	if err != nil {
+		return nil, err
+	}
+	groupUsers, err := w.queries.SearchGroupUsers(ctx, &query.GroupUsersSearchQuery{Queries: []query.SearchQuery{groupQuery}}, nil)
+	if err != nil {
+		return nil, err
+	}
+	userIDs := make([]string, len(groupUsers.GroupUsers))
+	for i, groupUser := range groupUsers.GroupUsers {
+		userIDs[i] = groupUser.UserID
+	}
+	return userIDs, nil
+}
+
+func (w *Worker) organizationGroupIDs(ctx context.Context, organizationID string) ([]string, error) {
+	orgQuery, err := query.NewGroupOrganizationIdSearchQuery(organizationID)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := w.queries.SearchGroups(ctx, &query.GroupSearchQuery{Queries: []query.SearchQuery{orgQuery}}, nil)
+	if err != nil {
+		return nil, err
+	}
+	groupIDs := make([]string, len(groups.Groups))
+	for i, group := range groups.Groups {
+		groupIDs[i] = group.ID
+	}
+	return groupIDs, nil
+}
+
+// organizationTargets returns the target with the id if set, otherwise all targets of the organization.
+func (w *Worker) organizationTargets(ctx context.Context, targetID, organizationID string) ([]*query.ProvisioningTarget, error) {
+	if targetID != "" {
+		return w.targets(ctx, targetID, nil)
+	}
+	orgQuery, err := query.NewProvisioningTargetResourceOwnerSearchQuery(organizationID)
+	if err != nil {
+		return nil, err
+	}
+	return w.queries.ProvisioningTargetsWithToken(ctx, orgQuery)
+}
+
+func (w *Worker) targets(ctx context.Context, targetID string, projectIDs []string) ([]*query.ProvisioningTarget, error) {
+	var queries []query.SearchQuery
+	if targetID != "" {
+		idQuery, err := query.NewProvisioningTargetIDSearchQuery(targetID)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, idQuery)
+	}
+	if len(projectIDs) > 0 {
+		projectQuery, err := query.NewProvisioningTargetProjectIDsSearchQuery(projectIDs)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, projectQuery)
+	}
+	return w.queries.ProvisioningTargetsWithToken(ctx, queries...)
+}
+
+func userToSCIM(user *query.User) *scimUser {
+	scim := &scimUser{
+		Schemas:     []string{scimUserSchema},
+		ExternalID:  user.ID,
+		UserName:    user.PreferredLoginName,
+		DisplayName: user.Human.DisplayName,
+		Name: &scimName{
+			Formatted:  user.Human.DisplayName,
+			FamilyName: user.Human.LastName,
+			GivenName:  user.Human.FirstName,
+		},
+		Active: user.State.IsEnabled(),
+	}
+	if user.Human.Email != "" {
+		scim.Emails = []*scimValue{{Value: string(user.Human.Email), Primary: true}}
+	}
+	if user.Human.Phone != "" {
+		scim.PhoneNumbers = []*scimValue{{Value: string(user.Human.Phone), Primary: true}}
+	}
+	return scim
+}
+
+// userGrant resolves the user and project of a user grant.
+// The grant is read from the eventstore, as it might already be removed from the projections.
+type userGrant struct {
+	id        string
+	userID    string
+	projectID string
+}
+
+func (g *userGrant) Reduce() error {
+	return nil
+}
+
+func (g *userGrant) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		if e, ok := event.(*usergrant.UserGrantAddedEvent); ok {
+			g.userID = e.UserID
+			g.projectID = e.ProjectID
+		}
+	}
+}
+
+func (g *userGrant) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(usergrant.AggregateType).
+		AggregateIDs(g.id).
+		EventTypes(usergrant.UserGrantAddedType).
+		Builder()
+}
@@ -0,0 +1,362 @@
+package provisioning
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/queue"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type testQueries struct {
+	user       *query.User
+	grants     []*query.UserGrant
+	targets    []*query.ProvisioningTarget
+	groups     []*query.Group
+	groupUsers []*query.GroupUser
+}
+
+func (q *testQueries) HasProvisioningTargets(context.Context) (bool, error) {
+	return len(q.targets) > 0, nil
+}
+
+func (q *testQueries) ProvisioningTargetsWithToken(context.Context, ...query.SearchQuery) ([]*query.ProvisioningTarget, error) {
+	return q.targets, nil
+}
+
+func (q *testQueries) GetUserByID(context.Context, bool, string) (*query.User, error) {
+	if q.user == nil {
+		return nil, zerrors.ThrowNotFound(nil, "TEST-Pv1", "Errors.User.NotFound")
+	}
+	return q.user, nil
+}
+
+func (q *testQueries) UserGrants(context.Context, *query.UserGrantsQueries, bool, domain.PermissionCheck) (*query.UserGrants, error) {
+	return &query.UserGrants{UserGrants: q.grants}, nil
+}
+
+func (q *testQueries) SearchGroups(context.Context, *query.GroupSearchQuery, domain.PermissionCheck) (*query.Groups, error) {
+	return &query.Groups{Groups: q.groups}, nil
+}
+
+func (q *testQueries) SearchGroupUsers(context.Context, *query.GroupUsersSearchQuery, domain.PermissionCheck) (*query.GroupUsers, error) {
+	return &query.GroupUsers{GroupUsers: q.groupUsers}, nil
+}
+
+type testCommands struct {
+	succeeded []string
+	failed    []string
+}
+
+func (c *testCommands) ProvisioningTargetSyncSucceeded(_ context.Context, id, _ string) error {
+	c.succeeded = append(c.succeeded, id)
+	return nil
+}
+
+func (c *testCommands) ProvisioningTargetSyncFailed(_ context.Context, id, _, _, _ string) error {
+	c.failed = append(c.failed, id)
+	return nil
+}
+
+type testQueue struct {
+	requests []*Request
+}
+
+func (q *testQueue) Insert(_ context.Context, args river.JobArgs, _ ...queue.InsertOpt) error {
+	q.requests = append(q.requests, args.(*Request))
+	return nil
+}
+
+// testSCIMServer is a minimal scim service provider recording the received requests.
+type testSCIMServer struct {
+	mu            sync.Mutex
+	existingID    string
+	externalIDs   []string
+	failingStatus int
+	requests      []string
+	users         []*scimUser
+	groups        []*scimGroup
+}
+
+func (s *testSCIMServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+	if s.failingStatus != 0 {
+		w.WriteHeader(s.failingStatus)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		list := new(scimListResponse)
+		if r.URL.Query().Has("attributes") {
+			for _, externalID := range s.externalIDs {
+				list.Resources = append(list.Resources, &scimUser{ExternalID: externalID})
+			}
+			list.TotalResults = len(list.Resources)
+		} else if s.existingID != "" {
+			list.Resources = []*scimUser{{ID: s.existingID}}
+		}
+		_ = json.NewEncoder(w).Encode(list)
+	case http.MethodPost, http.MethodPut:
+		if strings.Contains(r.URL.Path, scimGroupsPath) {
+			group := new(scimGroup)
+			_ = json.NewDecoder(r.Body).Decode(group)
+			s.groups = append(s.groups, group)
+		} else {
+			user := new(scimUser)
+			_ = json.NewDecoder(r.Body).Decode(user)
+			s.users = append(s.users, user)
+		}
+		w.WriteHeader(http.StatusOK)
+	case http.MethodDelete:
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func testUser(state domain.UserState) *query.User {
+	return &query.User{
+		ID:                 "user1",
+		State:              state,
+		Type:               domain.UserTypeHuman,
+		PreferredLoginName: "user1@example.com",
+		Human: &query.Human{
+			FirstName:   "Jane",
+			LastName:    "Doe",
+			DisplayName: "Jane Doe",
+			Email:       "jane@example.com",
+		},
+	}
+}
+
+func testJob(request *Request, attempt int) *river.Job[*Request] {
+	return &river.Job[*Request]{
+		JobRow: &rivertype.JobRow{
+			CreatedAt:   time.Now(),
+			Attempt:     attempt,
+			MaxAttempts: 3,
+		},
+		Args: request,
+	}
+}
+
+func TestWorker_Work(t *testing.T) {
+	aggregate := &eventstore.Aggregate{ID: "user1", ResourceOwner: "org1", InstanceID: "instance1"}
+	tests := []struct {
+		name          string
+		queries       *testQueries
+		server        *testSCIMServer
+		job           *river.Job[*Request]
+		wantRequests  []string
+		wantActive    []bool
+		wantMembers   []string
+		wantSucceeded []string
+		wantFailed    []string
+		wantErr       bool
+	}{
+		{
+			name: "granted user, created",
+			queries: &testQueries{
+				user:   testUser(domain.UserStateActive),
+				grants: []*query.UserGrant{{UserID: "user1", ProjectID: "project1", State: domain.UserGrantStateActive}},
+			},
+			server:        &testSCIMServer{},
+			job:           testJob(&Request{Aggregate: aggregate, UserID: "user1"}, 1),
+			wantRequests:  []string{"GET /scim/v2/Users", "POST /scim/v2/Users"},
+			wantActive:    []bool{true},
+			wantSucceeded: []string{"target1"},
+		},
+		{
+			name: "deactivated user, replaced as inactive",
+			queries: &testQueries{
+				user:   testUser(domain.UserStateInactive),
+				grants: []*query.UserGrant{{UserID: "user1", ProjectID: "project1", State: domain.UserGrantStateActive}},
+			},
+			server:        &testSCIMServer{existingID: "remote1"},
+			job:           testJob(&Request{Aggregate: aggregate, UserID: "user1"}, 1),
+			wantRequests:  []string{"GET /scim/v2/Users", "PUT /scim/v2/Users/remote1"},
+			wantActive:    []bool{false},
+			wantSucceeded: []string{"target1"},
+		},
+		{
+			name: "user without grants, nothing to do",
+			queries: &testQueries{
+				user: testUser(domain.UserStateActive),
+			},
+			server: &testSCIMServer{existingID: "remote1"},
+			job:    testJob(&Request{Aggregate: aggregate, UserID: "user1"}, 1),
+		},
+		{
+			name:          "removed user, deleted",
+			queries:       &testQueries{},
+			server:        &testSCIMServer{existingID: "remote1"},
+			job:           testJob(&Request{Aggregate: aggregate, UserID: "user1"}, 1),
+			wantRequests:  []string{"GET /scim/v2/Users", "DELETE /scim/v2/Users/remote1"},
+			wantSucceeded: []string{"target1"},
+		},
+		{
+			name: "failure before last attempt, retried",
+			queries: &testQueries{
+				user:   testUser(domain.UserStateActive),
+				grants: []*query.UserGrant{{UserID: "user1", ProjectID: "project1", State: domain.UserGrantStateActive}},
+			},
+			server:       &testSCIMServer{failingStatus: http.StatusServiceUnavailable},
+			job:          testJob(&Request{Aggregate: aggregate, UserID: "user1"}, 1),
+			wantRequests: []string{"GET /scim/v2/Users"},
+			wantErr:      true,
+		},
+		{
+			name: "failure on last attempt, recorded",
+			queries: &testQueries{
+				user:   testUser(domain.UserStateActive),
+				grants: []*query.UserGrant{{UserID: "user1", ProjectID: "project1", State: domain.UserGrantStateActive}},
+			},
+			server:       &testSCIMServer{failingStatus: http.StatusServiceUnavailable},
+			job:          testJob(&Request{Aggregate: aggregate, UserID: "user1"}, 3),
+			wantRequests: []string{"GET /scim/v2/Users"},
+			wantFailed:   []string{"target1"},
+			wantErr:      true,
+		},
+		{
+			name: "group, replaced with granted members",
+			queries: &testQueries{
+				groups:     []*query.Group{{ID: "group1", Name: "group", ResourceOwner: "org1"}},
+				groupUsers: []*query.GroupUser{{GroupID: "group1", UserID: "user1"}, {GroupID: "group1", UserID: "user2"}},
+				grants:     []*query.UserGrant{{UserID: "user1", ProjectID: "project1", State: domain.UserGrantStateActive}},
+			},
+			server:        &testSCIMServer{existingID: "remote1"},
+			job:           testJob(&Request{Aggregate: aggregate, GroupID: "group1"}, 1),
+			wantRequests:  []string{"GET /scim/v2/Users", "GET /scim/v2/Groups", "PUT /scim/v2/Groups/remote1"},
+			wantMembers:   []string{"remote1"},
+			wantSucceeded: []string{"target1"},
+		},
+		{
+			name:          "removed group, deleted",
+			queries:       &testQueries{},
+			server:        &testSCIMServer{existingID: "remote1"},
+			job:           testJob(&Request{Aggregate: aggregate, GroupID: "group1"}, 1),
+			wantRequests:  []string{"GET /scim/v2/Groups", "DELETE /scim/v2/Groups/remote1"},
+			wantSucceeded: []string{"target1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(tt.server)
+			defer server.Close()
+			tt.queries.targets = []*query.ProvisioningTarget{{
+				ObjectDetails: domain.ObjectDetails{ID: "target1", ResourceOwner: "org1"},
+				ProjectID:     "project1",
+				Endpoint:      server.URL + "/scim/v2/",
+				Token:         "token",
+			}}
+			commands := new(testCommands)
+			w := NewWorker(
+				WorkerConfig{Workers: 1, TransactionDuration: time.Minute, MaxAttempts: 3, MaxTtl: time.Hour},
+				tt.queries,
+				commands,
+				nil,
+				nil,
+				server.Client(),
+				time.Now,
+			)
+
+			err := w.Work(context.Background(), tt.job)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantRequests, tt.server.requests)
+			active := make([]bool, len(tt.server.users))
+			for i, user := range tt.server.users {
+				assert.Equal(t, "user1", user.ExternalID)
+				active[i] = user.Active
+			}
+			if tt.wantActive == nil {
+				tt.wantActive = []bool{}
+			}
+			assert.Equal(t, tt.wantActive, active)
+			var members []string
+			for _, group := range tt.server.groups {
+				assert.Equal(t, "group1", group.ExternalID)
+				for _, member := range group.Members {
+					members = append(members, member.Value)
+				}
+			}
+			assert.Equal(t, tt.wantMembers, members)
+			assert.Equal(t, tt.wantSucceeded, commands.succeeded)
+			assert.Equal(t, tt.wantFailed, commands.failed)
+		})
+	}
+}
+
+func TestWorker_Work_resync(t *testing.T) {
+	server := httptest.NewServer(&testSCIMServer{externalIDs: []string{"user1", "user2"}})
+	defer server.Close()
+	queries := &testQueries{
+		targets: []*query.ProvisioningTarget{{
+			ObjectDetails: domain.ObjectDetails{ID: "target1", ResourceOwner: "org1"},
+			ProjectID:     "project1",
+			Endpoint:      server.URL + "/scim/v2/",
+		}},
+		grants: []*query.UserGrant{{UserID: "user3", ProjectID: "project1"}, {UserID: "user1", ProjectID: "project1"}},
+		groups: []*query.Group{{ID: "group1", ResourceOwner: "org1"}},
+	}
+	q := new(testQueue)
+	w := NewWorker(WorkerConfig{MaxAttempts: 3, MaxTtl: time.Hour}, queries, nil, nil, q, server.Client(), time.Now)
+	aggregate := &eventstore.Aggregate{ID: "target1", ResourceOwner: "org1", InstanceID: "instance1"}
+
+	err := w.Work(context.Background(), testJob(&Request{Aggregate: aggregate, TargetID: "target1"}, 1))
+	require.NoError(t, err)
+	assert.Equal(t, []*Request{
+		{Aggregate: aggregate, UserID: "user1", TargetID: "target1"},
+		{Aggregate: aggregate, UserID: "user2", TargetID: "target1"},
+		{Aggregate: aggregate, UserID: "user3", TargetID: "target1"},
+		{Aggregate: aggregate, GroupID: "group1", TargetID: "target1"},
+	}, q.requests)
+}
+
+func TestWorker_Work_tooOld(t *testing.T) {
+	w := NewWorker(WorkerConfig{MaxTtl: time.Minute}, nil, nil, nil, nil, nil, time.Now)
+	job := testJob(&Request{Aggregate: &eventstore.Aggregate{}, UserID: "user1"}, 1)
+	job.CreatedAt = time.Now().Add(-time.Hour)
+
+	err := w.Work(context.Background(), job)
+	assert.True(t, errors.Is(err, new(river.JobCancelError)))
+}
+
+func Test_scimClient_upsertUser_authorization(t *testing.T) {
+	var authorizations []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		if r.Method == http.MethodGet {
+			assert.Equal(t, `externalId eq "user1"`, r.URL.Query().Get("filter"))
+			_, _ = w.Write([]byte(`{"totalResults":0,"Resources":[]}`))
+			return
+		}
+		assert.True(t, strings.HasPrefix(r.Header.Get("Content-Type"), scimContentType))
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	client := newSCIMClient(server.Client(), server.URL, "secret")
+	err := client.upsertUser(context.Background(), &scimUser{ExternalID: "user1"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Bearer secret", "Bearer secret"}, authorizations)
+}
@@ -36,8 +36,14 @@ type OIDCSessionAccessTokenReadModel struct {
 	AccessTokenExpiration time.Time
 	PreferredLanguage     *language.Tag
 	UserAgent             *domain.UserAgent
+	AuthorizationDetails  domain.AuthorizationDetails
 	Reason                domain.TokenReason
 	Actor                 *domain.TokenActor
+	DPoPJKT               string
+	CertThumbprint        string
+
+	// grantedAuthorizationDetails of the session, which might be narrowed down for the access token
+	grantedAuthorizationDetails domain.AuthorizationDetails
 }
 
 func newOIDCSessionAccessTokenReadModel(id string) *OIDCSessionAccessTokenReadModel {
@@ -89,6 +95,8 @@ func (wm *OIDCSessionAccessTokenReadModel) reduceAdded(e *oidcsession.AddedEvent
 	wm.Nonce = e.Nonce
 	wm.PreferredLanguage = e.PreferredLanguage
 	wm.UserAgent = e.UserAgent
+	wm.grantedAuthorizationDetails = e.AuthorizationDetails
+	wm.AuthorizationDetails = e.AuthorizationDetails
 	wm.State = domain.OIDCSessionStateActive
 }
 
@@ -98,11 +106,19 @@ func (wm *OIDCSessionAccessTokenReadModel) reduceAccessTokenAdded(e *oidcsession
 	wm.AccessTokenExpiration = e.CreationDate().Add(e.Lifetime)
 	wm.Reason = e.Reason
 	wm.Actor = e.Actor
+	wm.DPoPJKT = e.DPoPJKT
+	wm.CertThumbprint = e.CertThumbprint
+	wm.AuthorizationDetails = wm.grantedAuthorizationDetails
+	if len(e.AuthorizationDetails) > 0 {
+		wm.AuthorizationDetails = e.AuthorizationDetails
+	}
 }
 
 func (wm *OIDCSessionAccessTokenReadModel) reduceTokenRevoked(e eventstore.Event) {
 	wm.AccessTokenID = ""
 	wm.AccessTokenExpiration = e.CreatedAt()
+	wm.DPoPJKT = ""
+	wm.CertThumbprint = ""
 }
 
 // ActiveAccessTokenByToken will check if the token is active by retrieving the OIDCSession events from the eventstore.
@@ -122,7 +138,7 @@ func (q *Queries) ActiveAccessTokenByToken(ctx context.Context, token string) (m
 	if !model.AccessTokenExpiration.After(time.Now()) {
 		return nil, zerrors.ThrowUnauthenticated(nil, "QUERY-SAF3rf", "Errors.OIDCSession.Token.Expired")
 	}
-	if err = q.checkSessionNotTerminatedAfter(ctx, model.SessionID, model.UserID, model.UserResourceOwner, model.Position, model.UserAgent.GetFingerprintID()); err != nil {
+	if err = q.checkSessionNotTerminatedAfter(ctx, model.SessionID, model.UserID, model.UserResourceOwner, model.ClientID, model.Position, model.UserAgent.GetFingerprintID()); err != nil {
 		return nil, err
 	}
 	return model, nil
@@ -142,9 +158,9 @@ func (q *Queries) accessTokenByOIDCSessionAndTokenID(ctx context.Context, oidcSe
 	return model, nil
 }
 
-// checkSessionNotTerminatedAfter checks if a [session.TerminateType] event (or user / org events leading to a session termination)
-// occurred after a certain time and will return an error if so.
-func (q *Queries) checkSessionNotTerminatedAfter(ctx context.Context, sessionID, userID, resourceOwner string, position decimal.Decimal, fingerprintID string) (err error) {
+// checkSessionNotTerminatedAfter checks if a [session.TerminateType] event (or user / org events leading to a session termination,
+// including the revocation of the user's consent for the client) occurred after a certain time and will return an error if so.
+func (q *Queries) checkSessionNotTerminatedAfter(ctx context.Context, sessionID, userID, resourceOwner, clientID string, position decimal.Decimal, fingerprintID string) (err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
@@ -156,6 +172,7 @@ func (q *Queries) checkSessionNotTerminatedAfter(ctx context.Context, sessionID,
 		position:      position,
 		userID:        userID,
 		resourceOwner: resourceOwner,
+		clientID:      clientID,
 		fingerPrintID: fingerprintID,
 	}
 	err = q.eventstore.FilterToQueryReducer(ctx, model)
@@ -174,6 +191,7 @@ type sessionTerminatedModel struct {
 	sessionID     string
 	userID        string
 	resourceOwner string
+	clientID      string
 	fingerPrintID string
 
 	events     int
@@ -224,6 +242,18 @@ func (s *sessionTerminatedModel) Query() *eventstore.SearchQueryBuilder {
 				PositionAfter(s.position).
 				Builder()
 		}
+		if s.clientID != "" {
+			// tokens issued to the client are no longer valid once the user revoked the consent
+			builder = builder.AddQuery().
+				AggregateTypes(user.AggregateType).
+				AggregateIDs(s.userID).
+				EventTypes(
+					user.ConsentRevokedType,
+				).
+				EventData(map[string]interface{}{"clientId": s.clientID}).
+				PositionAfter(s.position).
+				Builder()
+		}
 	}
 	if s.resourceOwner != "" {
 		builder = builder.AddQuery().
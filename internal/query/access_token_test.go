@@ -59,11 +59,12 @@ func TestQueries_ActiveAccessTokenByToken(t *testing.T) {
 							oidcsession.NewAddedEvent(context.Background(), &oidcsession.NewAggregate("V2_oidcSessionID", "org1").Aggregate,
 								"userID", "org1", "sessionID", "clientID", []string{"audience"}, []string{"openid"},
 								[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword}, time.Now(), "nonce", &language.English, nil,
+								nil,
 							),
 						),
 						eventFromEventPusherWithCreationDateNow(
 							oidcsession.NewAccessTokenAddedEvent(context.Background(), &oidcsession.NewAggregate("V2_oidcSessionID", "org1").Aggregate,
-								"at_accessTokenID", []string{"openid"}, time.Hour, domain.TokenReasonAuthRequest, nil),
+								"at_accessTokenID", []string{"openid"}, time.Hour, domain.TokenReasonAuthRequest, nil, "", "", nil),
 						),
 					),
 					expectFilter(), // no session/user/org termination after token
@@ -83,11 +84,12 @@ func TestQueries_ActiveAccessTokenByToken(t *testing.T) {
 							oidcsession.NewAddedEvent(context.Background(), &oidcsession.NewAggregate("V2_oidcSessionID", "org1").Aggregate,
 								"userID", "org1", "sessionID", "clientID", []string{"audience"}, []string{"openid"},
 								[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword}, time.Now(), "nonce", &language.English, nil,
+								nil,
 							),
 						),
 						eventFromEventPusherWithCreationDateNow(
 							oidcsession.NewAccessTokenAddedEvent(context.Background(), &oidcsession.NewAggregate("V2_oidcSessionID", "org1").Aggregate,
-								"at_accessTokenID", []string{"openid"}, time.Hour, domain.TokenReasonAuthRequest, nil),
+								"at_accessTokenID", []string{"openid"}, time.Hour, domain.TokenReasonAuthRequest, nil, "", "", nil),
 						),
 					),
 					expectFilter(
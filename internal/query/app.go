@@ -43,31 +43,37 @@ type App struct {
 }
 
 type OIDCApp struct {
-	RedirectURIs                  database.TextArray[string]
-	ResponseTypes                 database.NumberArray[domain.OIDCResponseType]
-	GrantTypes                    database.NumberArray[domain.OIDCGrantType]
-	AppType                       domain.OIDCApplicationType
-	ClientID                      string
-	AuthMethodType                domain.OIDCAuthMethodType
-	PostLogoutRedirectURIs        database.TextArray[string]
-	Version                       domain.OIDCVersion
-	ComplianceProblems            database.TextArray[string]
-	IsDevMode                     bool
-	AccessTokenType               domain.OIDCTokenType
-	AssertAccessTokenRole         bool
-	AssertIDTokenRole             bool
-	AssertIDTokenUserinfo         bool
-	ClockSkew                     time.Duration
-	AdditionalOrigins             database.TextArray[string]
-	AllowedOrigins                database.TextArray[string]
-	SkipNativeAppSuccessPage      bool
-	BackChannelLogoutURI          string
-	LoginVersion                  domain.LoginVersion
-	LoginBaseURI                  *string
-	IOSTeamID                     string
-	IOSBundleID                   string
-	AndroidPackageName            string
-	AndroidSHA256CertFingerprints database.TextArray[string]
+	RedirectURIs                     database.TextArray[string]
+	ResponseTypes                    database.NumberArray[domain.OIDCResponseType]
+	GrantTypes                       database.NumberArray[domain.OIDCGrantType]
+	AppType                          domain.OIDCApplicationType
+	ClientID                         string
+	AuthMethodType                   domain.OIDCAuthMethodType
+	PostLogoutRedirectURIs           database.TextArray[string]
+	Version                          domain.OIDCVersion
+	ComplianceProblems               database.TextArray[string]
+	IsDevMode                        bool
+	AccessTokenType                  domain.OIDCTokenType
+	AssertAccessTokenRole            bool
+	AssertIDTokenRole                bool
+	AssertIDTokenUserinfo            bool
+	ClockSkew                        time.Duration
+	AdditionalOrigins                database.TextArray[string]
+	AllowedOrigins                   database.TextArray[string]
+	SkipNativeAppSuccessPage         bool
+	BackChannelLogoutURI             string
+	LoginVersion                     domain.LoginVersion
+	LoginBaseURI                     *string
+	IOSTeamID                        string
+	IOSBundleID                      string
+	AndroidPackageName               string
+	AndroidSHA256CertFingerprints    database.TextArray[string]
+	DPoPRequired                     bool
+	PARRequired                      bool
+	TLSClientAuthSubjectDN           string
+	TLSClientAuthSAN                 string
+	BackChannelClientNotificationURI string
+	ConsentRequired                  bool
 }
 
 type SAMLApp struct {
@@ -76,11 +82,20 @@ type SAMLApp struct {
 	EntityID     string
 	LoginVersion domain.LoginVersion
 	LoginBaseURI *string
+
+	IDPInitiatedEnabled    bool
+	IDPInitiatedRelayState string
+	EncryptAssertion       bool
+	NameIDFormat           domain.SAMLNameIDFormat
+	NameIDSource           domain.SAMLNameIDSource
+	SignatureAlgorithm     domain.SAMLSignatureAlgorithm
 }
 
 type APIApp struct {
-	ClientID       string
-	AuthMethodType domain.APIAuthMethodType
+	ClientID               string
+	AuthMethodType         domain.APIAuthMethodType
+	TLSClientAuthSubjectDN string
+	TLSClientAuthSAN       string
 }
 
 type AppSearchQueries struct {
@@ -172,6 +187,30 @@ var (
 		name:  projection.AppSAMLConfigColumnLoginBaseURI,
 		table: appSAMLConfigsTable,
 	}
+	AppSAMLConfigColumnIDPInitiatedEnabled = Column{
+		name:  projection.AppSAMLConfigColumnIDPInitiatedEnabled,
+		table: appSAMLConfigsTable,
+	}
+	AppSAMLConfigColumnIDPInitiatedRelayState = Column{
+		name:  projection.AppSAMLConfigColumnIDPInitiatedRelayState,
+		table: appSAMLConfigsTable,
+	}
+	AppSAMLConfigColumnEncryptAssertion = Column{
+		name:  projection.AppSAMLConfigColumnEncryptAssertion,
+		table: appSAMLConfigsTable,
+	}
+	AppSAMLConfigColumnNameIDFormat = Column{
+		name:  projection.AppSAMLConfigColumnNameIDFormat,
+		table: appSAMLConfigsTable,
+	}
+	AppSAMLConfigColumnNameIDSource = Column{
+		name:  projection.AppSAMLConfigColumnNameIDSource,
+		table: appSAMLConfigsTable,
+	}
+	AppSAMLConfigColumnSignatureAlgorithm = Column{
+		name:  projection.AppSAMLConfigColumnSignatureAlgorithm,
+		table: appSAMLConfigsTable,
+	}
 )
 
 var (
@@ -191,6 +230,14 @@ var (
 		name:  projection.AppAPIConfigColumnAuthMethod,
 		table: appAPIConfigsTable,
 	}
+	AppAPIConfigColumnTLSClientAuthSubjectDN = Column{
+		name:  projection.AppAPIConfigColumnTLSClientAuthSubjectDN,
+		table: appAPIConfigsTable,
+	}
+	AppAPIConfigColumnTLSClientAuthSAN = Column{
+		name:  projection.AppAPIConfigColumnTLSClientAuthSAN,
+		table: appAPIConfigsTable,
+	}
 )
 
 var (
@@ -298,6 +345,30 @@ var (
 		name:  projection.AppOIDCConfigColumnAndroidSHA256CertFingerprints,
 		table: appOIDCConfigsTable,
 	}
+	AppOIDCConfigColumnDPoPRequired = Column{
+		name:  projection.AppOIDCConfigColumnDPoPRequired,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnPARRequired = Column{
+		name:  projection.AppOIDCConfigColumnPARRequired,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnTLSClientAuthSubjectDN = Column{
+		name:  projection.AppOIDCConfigColumnTLSClientAuthSubjectDN,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnTLSClientAuthSAN = Column{
+		name:  projection.AppOIDCConfigColumnTLSClientAuthSAN,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnBackChannelClientNotificationURI = Column{
+		name:  projection.AppOIDCConfigColumnBackChannelClientNotificationURI,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnConsentRequired = Column{
+		name:  projection.AppOIDCConfigColumnConsentRequired,
+		table: appOIDCConfigsTable,
+	}
 )
 
 func (q *Queries) AppByProjectAndAppID(ctx context.Context, shouldTriggerBulk bool, projectID, appID string) (app *App, err error) {
@@ -724,6 +795,8 @@ func prepareAppQuery(activeOnly bool) (sq.SelectBuilder, func(*sql.Row) (*App, e
 		AppAPIConfigColumnAppID.identifier(),
 		AppAPIConfigColumnClientID.identifier(),
 		AppAPIConfigColumnAuthMethod.identifier(),
+		AppAPIConfigColumnTLSClientAuthSubjectDN.identifier(),
+		AppAPIConfigColumnTLSClientAuthSAN.identifier(),
 
 		AppOIDCConfigColumnAppID.identifier(),
 		AppOIDCConfigColumnVersion.identifier(),
@@ -749,6 +822,12 @@ func prepareAppQuery(activeOnly bool) (sq.SelectBuilder, func(*sql.Row) (*App, e
 		AppOIDCConfigColumnIOSBundleID.identifier(),
 		AppOIDCConfigColumnAndroidPackageName.identifier(),
 		AppOIDCConfigColumnAndroidSHA256CertFingerprints.identifier(),
+		AppOIDCConfigColumnDPoPRequired.identifier(),
+		AppOIDCConfigColumnPARRequired.identifier(),
+		AppOIDCConfigColumnTLSClientAuthSubjectDN.identifier(),
+		AppOIDCConfigColumnTLSClientAuthSAN.identifier(),
+		AppOIDCConfigColumnBackChannelClientNotificationURI.identifier(),
+		AppOIDCConfigColumnConsentRequired.identifier(),
 
 		AppSAMLConfigColumnAppID.identifier(),
 		AppSAMLConfigColumnEntityID.identifier(),
@@ -756,6 +835,12 @@ func prepareAppQuery(activeOnly bool) (sq.SelectBuilder, func(*sql.Row) (*App, e
 		AppSAMLConfigColumnMetadataURL.identifier(),
 		AppSAMLConfigColumnLoginVersion.identifier(),
 		AppSAMLConfigColumnLoginBaseURI.identifier(),
+		AppSAMLConfigColumnIDPInitiatedEnabled.identifier(),
+		AppSAMLConfigColumnIDPInitiatedRelayState.identifier(),
+		AppSAMLConfigColumnEncryptAssertion.identifier(),
+		AppSAMLConfigColumnNameIDFormat.identifier(),
+		AppSAMLConfigColumnNameIDSource.identifier(),
+		AppSAMLConfigColumnSignatureAlgorithm.identifier(),
 	).From(appsTable.identifier()).
 		PlaceholderFormat(sq.Dollar)
 
@@ -797,6 +882,8 @@ func scanApp(row *sql.Row) (*App, error) {
 		&apiConfig.appID,
 		&apiConfig.clientID,
 		&apiConfig.authMethod,
+		&apiConfig.tlsClientAuthSubjectDN,
+		&apiConfig.tlsClientAuthSAN,
 
 		&oidcConfig.appID,
 		&oidcConfig.version,
@@ -822,6 +909,12 @@ func scanApp(row *sql.Row) (*App, error) {
 		&oidcConfig.iosBundleID,
 		&oidcConfig.androidPackageName,
 		&oidcConfig.androidSHA256CertFingerprints,
+		&oidcConfig.dpopRequired,
+		&oidcConfig.parRequired,
+		&oidcConfig.tlsClientAuthSubjectDN,
+		&oidcConfig.tlsClientAuthSAN,
+		&oidcConfig.backChannelClientNotificationURI,
+		&oidcConfig.consentRequired,
 
 		&samlConfig.appID,
 		&samlConfig.entityID,
@@ -829,6 +922,12 @@ func scanApp(row *sql.Row) (*App, error) {
 		&samlConfig.metadataURL,
 		&samlConfig.loginVersion,
 		&samlConfig.loginBaseURI,
+		&samlConfig.idpInitiatedEnabled,
+		&samlConfig.idpInitiatedRelayState,
+		&samlConfig.encryptAssertion,
+		&samlConfig.nameIDFormat,
+		&samlConfig.nameIDSource,
+		&samlConfig.signatureAlgorithm,
 	)
 
 	if err != nil {
@@ -880,6 +979,12 @@ func prepareOIDCAppQuery() (sq.SelectBuilder, func(*sql.Row) (*App, error)) {
 			AppOIDCConfigColumnIOSBundleID.identifier(),
 			AppOIDCConfigColumnAndroidPackageName.identifier(),
 			AppOIDCConfigColumnAndroidSHA256CertFingerprints.identifier(),
+			AppOIDCConfigColumnDPoPRequired.identifier(),
+			AppOIDCConfigColumnPARRequired.identifier(),
+			AppOIDCConfigColumnTLSClientAuthSubjectDN.identifier(),
+			AppOIDCConfigColumnTLSClientAuthSAN.identifier(),
+			AppOIDCConfigColumnBackChannelClientNotificationURI.identifier(),
+			AppOIDCConfigColumnConsentRequired.identifier(),
 		).From(appsTable.identifier()).
 			Join(join(AppOIDCConfigColumnAppID, AppColumnID)).
 			PlaceholderFormat(sq.Dollar), func(row *sql.Row) (*App, error) {
@@ -923,6 +1028,12 @@ func prepareOIDCAppQuery() (sq.SelectBuilder, func(*sql.Row) (*App, error)) {
 				&oidcConfig.iosBundleID,
 				&oidcConfig.androidPackageName,
 				&oidcConfig.androidSHA256CertFingerprints,
+				&oidcConfig.dpopRequired,
+				&oidcConfig.parRequired,
+				&oidcConfig.tlsClientAuthSubjectDN,
+				&oidcConfig.tlsClientAuthSAN,
+				&oidcConfig.backChannelClientNotificationURI,
+				&oidcConfig.consentRequired,
 			)
 
 			if err != nil {
@@ -1019,6 +1130,8 @@ func prepareAppsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Apps, error)) {
 			AppAPIConfigColumnAppID.identifier(),
 			AppAPIConfigColumnClientID.identifier(),
 			AppAPIConfigColumnAuthMethod.identifier(),
+			AppAPIConfigColumnTLSClientAuthSubjectDN.identifier(),
+			AppAPIConfigColumnTLSClientAuthSAN.identifier(),
 
 			AppOIDCConfigColumnAppID.identifier(),
 			AppOIDCConfigColumnVersion.identifier(),
@@ -1044,6 +1157,12 @@ func prepareAppsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Apps, error)) {
 			AppOIDCConfigColumnIOSBundleID.identifier(),
 			AppOIDCConfigColumnAndroidPackageName.identifier(),
 			AppOIDCConfigColumnAndroidSHA256CertFingerprints.identifier(),
+			AppOIDCConfigColumnDPoPRequired.identifier(),
+			AppOIDCConfigColumnPARRequired.identifier(),
+			AppOIDCConfigColumnTLSClientAuthSubjectDN.identifier(),
+			AppOIDCConfigColumnTLSClientAuthSAN.identifier(),
+			AppOIDCConfigColumnBackChannelClientNotificationURI.identifier(),
+			AppOIDCConfigColumnConsentRequired.identifier(),
 
 			AppSAMLConfigColumnAppID.identifier(),
 			AppSAMLConfigColumnEntityID.identifier(),
@@ -1051,6 +1170,12 @@ func prepareAppsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Apps, error)) {
 			AppSAMLConfigColumnMetadataURL.identifier(),
 			AppSAMLConfigColumnLoginVersion.identifier(),
 			AppSAMLConfigColumnLoginBaseURI.identifier(),
+			AppSAMLConfigColumnIDPInitiatedEnabled.identifier(),
+			AppSAMLConfigColumnIDPInitiatedRelayState.identifier(),
+			AppSAMLConfigColumnEncryptAssertion.identifier(),
+			AppSAMLConfigColumnNameIDFormat.identifier(),
+			AppSAMLConfigColumnNameIDSource.identifier(),
+			AppSAMLConfigColumnSignatureAlgorithm.identifier(),
 			countColumn.identifier(),
 		).From(appsTable.identifier()).
 			LeftJoin(join(AppAPIConfigColumnAppID, AppColumnID)).
@@ -1080,6 +1205,8 @@ func prepareAppsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Apps, error)) {
 					&apiConfig.appID,
 					&apiConfig.clientID,
 					&apiConfig.authMethod,
+					&apiConfig.tlsClientAuthSubjectDN,
+					&apiConfig.tlsClientAuthSAN,
 
 					&oidcConfig.appID,
 					&oidcConfig.version,
@@ -1105,6 +1232,12 @@ func prepareAppsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Apps, error)) {
 					&oidcConfig.iosBundleID,
 					&oidcConfig.androidPackageName,
 					&oidcConfig.androidSHA256CertFingerprints,
+					&oidcConfig.dpopRequired,
+					&oidcConfig.parRequired,
+					&oidcConfig.tlsClientAuthSubjectDN,
+					&oidcConfig.tlsClientAuthSAN,
+					&oidcConfig.backChannelClientNotificationURI,
+					&oidcConfig.consentRequired,
 
 					&samlConfig.appID,
 					&samlConfig.entityID,
@@ -1112,6 +1245,12 @@ func prepareAppsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Apps, error)) {
 					&samlConfig.metadataURL,
 					&samlConfig.loginVersion,
 					&samlConfig.loginBaseURI,
+					&samlConfig.idpInitiatedEnabled,
+					&samlConfig.idpInitiatedRelayState,
+					&samlConfig.encryptAssertion,
+					&samlConfig.nameIDFormat,
+					&samlConfig.nameIDSource,
+					&samlConfig.signatureAlgorithm,
 
 					&apps.Count,
 				)
@@ -1192,30 +1331,36 @@ func prepareLoginVersionBySAMLAppID() (sq.SelectBuilder, func(*sql.Row) (domain.
 }
 
 type sqlOIDCConfig struct {
-	appID                         sql.NullString
-	version                       sql.NullInt32
-	clientID                      sql.NullString
-	redirectUris                  database.TextArray[string]
-	applicationType               sql.NullInt16
-	authMethodType                sql.NullInt16
-	postLogoutRedirectUris        database.TextArray[string]
-	devMode                       sql.NullBool
-	accessTokenType               sql.NullInt16
-	accessTokenRoleAssertion      sql.NullBool
-	iDTokenRoleAssertion          sql.NullBool
-	iDTokenUserinfoAssertion      sql.NullBool
-	clockSkew                     sql.NullInt64
-	additionalOrigins             database.TextArray[string]
-	responseTypes                 database.NumberArray[domain.OIDCResponseType]
-	grantTypes                    database.NumberArray[domain.OIDCGrantType]
-	skipNativeAppSuccessPage      sql.NullBool
-	backChannelLogoutURI          sql.NullString
-	loginVersion                  sql.NullInt16
-	loginBaseURI                  sql.NullString
-	iosTeamID                     sql.NullString
-	iosBundleID                   sql.NullString
-	androidPackageName            sql.NullString
-	androidSHA256CertFingerprints database.TextArray[string]
+	appID                            sql.NullString
+	version                          sql.NullInt32
+	clientID                         sql.NullString
+	redirectUris                     database.TextArray[string]
+	applicationType                  sql.NullInt16
+	authMethodType                   sql.NullInt16
+	postLogoutRedirectUris           database.TextArray[string]
+	devMode                          sql.NullBool
+	accessTokenType                  sql.NullInt16
+	accessTokenRoleAssertion         sql.NullBool
+	iDTokenRoleAssertion             sql.NullBool
+	iDTokenUserinfoAssertion         sql.NullBool
+	clockSkew                        sql.NullInt64
+	additionalOrigins                database.TextArray[string]
+	responseTypes                    database.NumberArray[domain.OIDCResponseType]
+	grantTypes                       database.NumberArray[domain.OIDCGrantType]
+	skipNativeAppSuccessPage         sql.NullBool
+	backChannelLogoutURI             sql.NullString
+	loginVersion                     sql.NullInt16
+	loginBaseURI                     sql.NullString
+	iosTeamID                        sql.NullString
+	iosBundleID                      sql.NullString
+	androidPackageName               sql.NullString
+	androidSHA256CertFingerprints    database.TextArray[string]
+	dpopRequired                     sql.NullBool
+	parRequired                      sql.NullBool
+	tlsClientAuthSubjectDN           sql.NullString
+	tlsClientAuthSAN                 sql.NullString
+	backChannelClientNotificationURI sql.NullString
+	consentRequired                  sql.NullBool
 }
 
 func (c sqlOIDCConfig) set(app *App) {
@@ -1223,28 +1368,34 @@ func (c sqlOIDCConfig) set(app *App) {
 		return
 	}
 	app.OIDCConfig = &OIDCApp{
-		Version:                       domain.OIDCVersion(c.version.Int32),
-		ClientID:                      c.clientID.String,
-		RedirectURIs:                  c.redirectUris,
-		AppType:                       domain.OIDCApplicationType(c.applicationType.Int16),
-		AuthMethodType:                domain.OIDCAuthMethodType(c.authMethodType.Int16),
-		PostLogoutRedirectURIs:        c.postLogoutRedirectUris,
-		IsDevMode:                     c.devMode.Bool,
-		AccessTokenType:               domain.OIDCTokenType(c.accessTokenType.Int16),
-		AssertAccessTokenRole:         c.accessTokenRoleAssertion.Bool,
-		AssertIDTokenRole:             c.iDTokenRoleAssertion.Bool,
-		AssertIDTokenUserinfo:         c.iDTokenUserinfoAssertion.Bool,
-		ClockSkew:                     time.Duration(c.clockSkew.Int64),
-		AdditionalOrigins:             c.additionalOrigins,
-		ResponseTypes:                 c.responseTypes,
-		GrantTypes:                    c.grantTypes,
-		SkipNativeAppSuccessPage:      c.skipNativeAppSuccessPage.Bool,
-		BackChannelLogoutURI:          c.backChannelLogoutURI.String,
-		LoginVersion:                  domain.LoginVersion(c.loginVersion.Int16),
-		IOSTeamID:                     c.iosTeamID.String,
-		IOSBundleID:                   c.iosBundleID.String,
-		AndroidPackageName:            c.androidPackageName.String,
-		AndroidSHA256CertFingerprints: c.androidSHA256CertFingerprints,
+		Version:                          domain.OIDCVersion(c.version.Int32),
+		ClientID:                         c.clientID.String,
+		RedirectURIs:                     c.redirectUris,
+		AppType:                          domain.OIDCApplicationType(c.applicationType.Int16),
+		AuthMethodType:                   domain.OIDCAuthMethodType(c.authMethodType.Int16),
+		PostLogoutRedirectURIs:           c.postLogoutRedirectUris,
+		IsDevMode:                        c.devMode.Bool,
+		AccessTokenType:                  domain.OIDCTokenType(c.accessTokenType.Int16),
+		AssertAccessTokenRole:            c.accessTokenRoleAssertion.Bool,
+		AssertIDTokenRole:                c.iDTokenRoleAssertion.Bool,
+		AssertIDTokenUserinfo:            c.iDTokenUserinfoAssertion.Bool,
+		ClockSkew:                        time.Duration(c.clockSkew.Int64),
+		AdditionalOrigins:                c.additionalOrigins,
+		ResponseTypes:                    c.responseTypes,
+		GrantTypes:                       c.grantTypes,
+		SkipNativeAppSuccessPage:         c.skipNativeAppSuccessPage.Bool,
+		BackChannelLogoutURI:             c.backChannelLogoutURI.String,
+		LoginVersion:                     domain.LoginVersion(c.loginVersion.Int16),
+		IOSTeamID:                        c.iosTeamID.String,
+		IOSBundleID:                      c.iosBundleID.String,
+		AndroidPackageName:               c.androidPackageName.String,
+		AndroidSHA256CertFingerprints:    c.androidSHA256CertFingerprints,
+		DPoPRequired:                     c.dpopRequired.Bool,
+		PARRequired:                      c.parRequired.Bool,
+		TLSClientAuthSubjectDN:           c.tlsClientAuthSubjectDN.String,
+		TLSClientAuthSAN:                 c.tlsClientAuthSAN.String,
+		BackChannelClientNotificationURI: c.backChannelClientNotificationURI.String,
+		ConsentRequired:                  c.consentRequired.Bool,
 	}
 	if c.loginBaseURI.Valid {
 		app.OIDCConfig.LoginBaseURI = &c.loginBaseURI.String
@@ -1264,6 +1415,13 @@ type sqlSAMLConfig struct {
 	metadata     []byte
 	loginVersion sql.NullInt16
 	loginBaseURI sql.NullString
+
+	idpInitiatedEnabled    sql.NullBool
+	idpInitiatedRelayState sql.NullString
+	encryptAssertion       sql.NullBool
+	nameIDFormat           sql.NullInt16
+	nameIDSource           sql.NullInt16
+	signatureAlgorithm     sql.NullInt16
 }
 
 func (c sqlSAMLConfig) set(app *App) {
@@ -1275,6 +1433,13 @@ func (c sqlSAMLConfig) set(app *App) {
 		MetadataURL:  c.metadataURL.String,
 		Metadata:     c.metadata,
 		LoginVersion: domain.LoginVersion(c.loginVersion.Int16),
+
+		IDPInitiatedEnabled:    c.idpInitiatedEnabled.Bool,
+		IDPInitiatedRelayState: c.idpInitiatedRelayState.String,
+		EncryptAssertion:       c.encryptAssertion.Bool,
+		NameIDFormat:           domain.SAMLNameIDFormat(c.nameIDFormat.Int16),
+		NameIDSource:           domain.SAMLNameIDSource(c.nameIDSource.Int16),
+		SignatureAlgorithm:     domain.SAMLSignatureAlgorithm(c.signatureAlgorithm.Int16),
 	}
 	if c.loginBaseURI.Valid {
 		app.SAMLConfig.LoginBaseURI = &c.loginBaseURI.String
@@ -1282,9 +1447,11 @@ func (c sqlSAMLConfig) set(app *App) {
 }
 
 type sqlAPIConfig struct {
-	appID      sql.NullString
-	clientID   sql.NullString
-	authMethod sql.NullInt16
+	appID                  sql.NullString
+	clientID               sql.NullString
+	authMethod             sql.NullInt16
+	tlsClientAuthSubjectDN sql.NullString
+	tlsClientAuthSAN       sql.NullString
 }
 
 func (c sqlAPIConfig) set(app *App) {
@@ -1292,7 +1459,9 @@ func (c sqlAPIConfig) set(app *App) {
 		return
 	}
 	app.APIConfig = &APIApp{
-		ClientID:       c.clientID.String,
-		AuthMethodType: domain.APIAuthMethodType(c.authMethod.Int16),
+		ClientID:               c.clientID.String,
+		AuthMethodType:         domain.APIAuthMethodType(c.authMethod.Int16),
+		TLSClientAuthSubjectDN: c.tlsClientAuthSubjectDN.String,
+		TLSClientAuthSAN:       c.tlsClientAuthSAN.String,
 	}
 }
@@ -30,6 +30,8 @@ var (
 		` projections.apps7_api_configs.app_id,` +
 		` projections.apps7_api_configs.client_id,` +
 		` projections.apps7_api_configs.auth_method,` +
+		` projections.apps7_api_configs.tls_client_auth_subject_dn,` +
+		` projections.apps7_api_configs.tls_client_auth_san,` +
 		// oidc config
 		` projections.apps7_oidc_configs.app_id,` +
 		` projections.apps7_oidc_configs.version,` +
@@ -55,13 +57,25 @@ var (
 		` projections.apps7_oidc_configs.ios_bundle_id,` +
 		` projections.apps7_oidc_configs.android_package_name,` +
 		` projections.apps7_oidc_configs.android_sha256_cert_fingerprints,` +
+		` projections.apps7_oidc_configs.dpop_required,` +
+		` projections.apps7_oidc_configs.par_required,` +
+		` projections.apps7_oidc_configs.tls_client_auth_subject_dn,` +
+		` projections.apps7_oidc_configs.tls_client_auth_san,` +
+		` projections.apps7_oidc_configs.back_channel_client_notification_uri,` +
+		` projections.apps7_oidc_configs.consent_required,` +
 		//saml config
 		` projections.apps7_saml_configs.app_id,` +
 		` projections.apps7_saml_configs.entity_id,` +
 		` projections.apps7_saml_configs.metadata,` +
 		` projections.apps7_saml_configs.metadata_url,` +
 		` projections.apps7_saml_configs.login_version,` +
-		` projections.apps7_saml_configs.login_base_uri` +
+		` projections.apps7_saml_configs.login_base_uri,` +
+		` projections.apps7_saml_configs.idp_initiated_enabled,` +
+		` projections.apps7_saml_configs.idp_initiated_relay_state,` +
+		` projections.apps7_saml_configs.encrypt_assertion,` +
+		` projections.apps7_saml_configs.name_id_format,` +
+		` projections.apps7_saml_configs.name_id_source,` +
+		` projections.apps7_saml_configs.signature_algorithm` +
 		` FROM projections.apps7` +
 		` LEFT JOIN projections.apps7_api_configs ON projections.apps7.id = projections.apps7_api_configs.app_id AND projections.apps7.instance_id = projections.apps7_api_configs.instance_id` +
 		` LEFT JOIN projections.apps7_oidc_configs ON projections.apps7.id = projections.apps7_oidc_configs.app_id AND projections.apps7.instance_id = projections.apps7_oidc_configs.instance_id` +
@@ -82,6 +96,8 @@ var (
 		` projections.apps7_api_configs.app_id,` +
 		` projections.apps7_api_configs.client_id,` +
 		` projections.apps7_api_configs.auth_method,` +
+		` projections.apps7_api_configs.tls_client_auth_subject_dn,` +
+		` projections.apps7_api_configs.tls_client_auth_san,` +
 		// oidc config
 		` projections.apps7_oidc_configs.app_id,` +
 		` projections.apps7_oidc_configs.version,` +
@@ -107,6 +123,12 @@ var (
 		` projections.apps7_oidc_configs.ios_bundle_id,` +
 		` projections.apps7_oidc_configs.android_package_name,` +
 		` projections.apps7_oidc_configs.android_sha256_cert_fingerprints,` +
+		` projections.apps7_oidc_configs.dpop_required,` +
+		` projections.apps7_oidc_configs.par_required,` +
+		` projections.apps7_oidc_configs.tls_client_auth_subject_dn,` +
+		` projections.apps7_oidc_configs.tls_client_auth_san,` +
+		` projections.apps7_oidc_configs.back_channel_client_notification_uri,` +
+		` projections.apps7_oidc_configs.consent_required,` +
 		//saml config
 		` projections.apps7_saml_configs.app_id,` +
 		` projections.apps7_saml_configs.entity_id,` +
@@ -114,6 +136,12 @@ var (
 		` projections.apps7_saml_configs.metadata_url,` +
 		` projections.apps7_saml_configs.login_version,` +
 		` projections.apps7_saml_configs.login_base_uri,` +
+		` projections.apps7_saml_configs.idp_initiated_enabled,` +
+		` projections.apps7_saml_configs.idp_initiated_relay_state,` +
+		` projections.apps7_saml_configs.encrypt_assertion,` +
+		` projections.apps7_saml_configs.name_id_format,` +
+		` projections.apps7_saml_configs.name_id_source,` +
+		` projections.apps7_saml_configs.signature_algorithm,` +
 		` COUNT(*) OVER ()` +
 		` FROM projections.apps7` +
 		` LEFT JOIN projections.apps7_api_configs ON projections.apps7.id = projections.apps7_api_configs.app_id AND projections.apps7.instance_id = projections.apps7_api_configs.instance_id` +
@@ -159,6 +187,8 @@ var (
 		"app_id",
 		"client_id",
 		"auth_method",
+		"tls_client_auth_subject_dn",
+		"tls_client_auth_san",
 		// oidc config
 		"app_id",
 		"version",
@@ -184,6 +214,12 @@ var (
 		"ios_bundle_id",
 		"android_package_name",
 		"android_sha256_cert_fingerprints",
+		"dpop_required",
+		"par_required",
+		"tls_client_auth_subject_dn",
+		"tls_client_auth_san",
+		"back_channel_client_notification_uri",
+		"consent_required",
 		//saml config
 		"app_id",
 		"entity_id",
@@ -191,6 +227,12 @@ var (
 		"metadata_url",
 		"login_version",
 		"login_base_uri",
+		"idp_initiated_enabled",
+		"idp_initiated_relay_state",
+		"encrypt_assertion",
+		"name_id_format",
+		"name_id_source",
+		"signature_algorithm",
 	}
 	appsCols = append(appCols, "count")
 )
@@ -239,6 +281,8 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							nil,
 							nil,
@@ -264,6 +308,12 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -271,6 +321,12 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -314,6 +370,8 @@ func Test_AppsPrepare(t *testing.T) {
 							"app-id",
 							"api-client-id",
 							domain.APIAuthMethodTypePrivateKeyJWT,
+							nil,
+							nil,
 							// oidc config
 							nil,
 							nil,
@@ -339,6 +397,12 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -346,6 +410,12 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -392,6 +462,8 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							nil,
 							nil,
@@ -417,6 +489,12 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							"app-id",
 							"https://test.com/saml/metadata",
@@ -424,6 +502,12 @@ func Test_AppsPrepare(t *testing.T) {
 							"https://test.com/saml/metadata",
 							domain.LoginVersionUnspecified,
 							nil,
+							false,
+							"",
+							false,
+							domain.SAMLNameIDFormatEmailAddress,
+							domain.SAMLNameIDSourceLoginName,
+							domain.SAMLSignatureAlgorithmUnspecified,
 						},
 					},
 				),
@@ -443,9 +527,10 @@ func Test_AppsPrepare(t *testing.T) {
 						Name:          "app-name",
 						ProjectID:     "project-id",
 						SAMLConfig: &SAMLApp{
-							Metadata:    []byte("<?xml version=\"1.0\"?>\n<md:EntityDescriptor xmlns:md=\"urn:oasis:names:tc:SAML:2.0:metadata\"\n                     validUntil=\"2022-08-26T14:08:16Z\"\n                     cacheDuration=\"PT604800S\"\n                     entityID=\"https://test.com/saml/metadata\">\n    <md:SPSSODescriptor AuthnRequestsSigned=\"false\" WantAssertionsSigned=\"false\" protocolSupportEnumeration=\"urn:oasis:names:tc:SAML:2.0:protocol\">\n        <md:NameIDFormat>urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified</md:NameIDFormat>\n        <md:AssertionConsumerService Binding=\"urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST\"\n                                     Location=\"https://test.com/saml/acs\"\n                                     index=\"1\" />\n        \n    </md:SPSSODescriptor>\n</md:EntityDescriptor>"),
-							MetadataURL: "https://test.com/saml/metadata",
-							EntityID:    "https://test.com/saml/metadata",
+							Metadata:     []byte("<?xml version=\"1.0\"?>\n<md:EntityDescriptor xmlns:md=\"urn:oasis:names:tc:SAML:2.0:metadata\"\n                     validUntil=\"2022-08-26T14:08:16Z\"\n                     cacheDuration=\"PT604800S\"\n                     entityID=\"https://test.com/saml/metadata\">\n    <md:SPSSODescriptor AuthnRequestsSigned=\"false\" WantAssertionsSigned=\"false\" protocolSupportEnumeration=\"urn:oasis:names:tc:SAML:2.0:protocol\">\n        <md:NameIDFormat>urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified</md:NameIDFormat>\n        <md:AssertionConsumerService Binding=\"urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST\"\n                                     Location=\"https://test.com/saml/acs\"\n                                     index=\"1\" />\n        \n    </md:SPSSODescriptor>\n</md:EntityDescriptor>"),
+							MetadataURL:  "https://test.com/saml/metadata",
+							EntityID:     "https://test.com/saml/metadata",
+							NameIDFormat: domain.SAMLNameIDFormatEmailAddress,
 						},
 					},
 				},
@@ -472,6 +557,8 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -497,6 +584,12 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							false,
+							false,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -504,6 +597,12 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -570,6 +669,8 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -595,6 +696,12 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							false,
+							false,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -602,6 +709,12 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -668,6 +781,8 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -693,6 +808,12 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							false,
+							false,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -700,6 +821,12 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -766,6 +893,8 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -791,6 +920,12 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							false,
+							false,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -798,6 +933,12 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -864,6 +1005,8 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -889,6 +1032,12 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							false,
+							false,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -896,6 +1045,12 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -962,6 +1117,8 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -987,6 +1144,12 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							false,
+							false,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -994,6 +1157,12 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -1060,6 +1229,8 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							"oidc-app-id",
 							domain.OIDCVersionV1,
@@ -1085,6 +1256,12 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							false,
+							false,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1092,6 +1269,12 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"api-app-id",
@@ -1106,6 +1289,8 @@ func Test_AppsPrepare(t *testing.T) {
 							"api-app-id",
 							"api-client-id",
 							domain.APIAuthMethodTypePrivateKeyJWT,
+							nil,
+							nil,
 							// oidc config
 							nil,
 							nil,
@@ -1131,6 +1316,12 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1138,6 +1329,12 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"saml-app-id",
@@ -1152,6 +1349,8 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							nil,
 							nil,
@@ -1177,6 +1376,12 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							"saml-app-id",
 							"https://test.com/saml/metadata",
@@ -1184,6 +1389,12 @@ func Test_AppsPrepare(t *testing.T) {
 							"https://test.com/saml/metadata",
 							domain.LoginVersion2,
 							"https://login.ch/",
+							false,
+							"",
+							false,
+							domain.SAMLNameIDFormatEmailAddress,
+							domain.SAMLNameIDSourceLoginName,
+							domain.SAMLSignatureAlgorithmUnspecified,
 						},
 					},
 				),
@@ -1255,6 +1466,7 @@ func Test_AppsPrepare(t *testing.T) {
 							EntityID:     "https://test.com/saml/metadata",
 							LoginVersion: domain.LoginVersion2,
 							LoginBaseURI: gu.Ptr("https://login.ch/"),
+							NameIDFormat: domain.SAMLNameIDFormatEmailAddress,
 						},
 					},
 				},
@@ -1341,6 +1553,8 @@ func Test_AppPrepare(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
 						// oidc config
 						nil,
 						nil,
@@ -1366,6 +1580,12 @@ func Test_AppPrepare(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 						// saml config
 						nil,
 						nil,
@@ -1373,6 +1593,12 @@ func Test_AppPrepare(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -1410,6 +1636,8 @@ func Test_AppPrepare(t *testing.T) {
 							"app-id",
 							"api-client-id",
 							domain.APIAuthMethodTypePrivateKeyJWT,
+							nil,
+							nil,
 							// oidc config
 							nil,
 							nil,
@@ -1435,6 +1663,12 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1442,6 +1676,12 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -1484,6 +1724,8 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -1509,6 +1751,12 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							false,
+							false,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1516,6 +1764,12 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -1577,6 +1831,8 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -1602,6 +1858,12 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							false,
+							false,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1609,6 +1871,12 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -1670,6 +1938,8 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							nil,
 							nil,
@@ -1695,6 +1965,12 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							"app-id",
 							"https://test.com/saml/metadata",
@@ -1702,6 +1978,12 @@ func Test_AppPrepare(t *testing.T) {
 							"https://test.com/saml/metadata",
 							domain.LoginVersionUnspecified,
 							nil,
+							false,
+							"",
+							false,
+							domain.SAMLNameIDFormatEmailAddress,
+							domain.SAMLNameIDSourceLoginName,
+							domain.SAMLSignatureAlgorithmUnspecified,
 						},
 					},
 				),
@@ -1721,6 +2003,7 @@ func Test_AppPrepare(t *testing.T) {
 					EntityID:     "https://test.com/saml/metadata",
 					LoginVersion: domain.LoginVersionUnspecified,
 					LoginBaseURI: nil,
+					NameIDFormat: domain.SAMLNameIDFormatEmailAddress,
 				},
 			},
 		},
@@ -1747,6 +2030,8 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -1772,6 +2057,12 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							false,
+							false,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1779,6 +2070,12 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -1840,6 +2137,8 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -1865,6 +2164,12 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							false,
+							false,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1872,6 +2177,12 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -1933,6 +2244,8 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -1958,6 +2271,12 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							false,
+							false,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1965,6 +2284,12 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -2026,6 +2351,8 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -2051,6 +2378,12 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							false,
+							false,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -2058,6 +2391,12 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
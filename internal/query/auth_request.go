@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	_ "embed"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -30,6 +31,9 @@ type AuthRequest struct {
 	LoginHint    *string
 	MaxAge       *time.Duration
 	HintUserID   *string
+	// AuthorizationDetails requested by the client (RFC 9396)
+	AuthorizationDetails domain.AuthorizationDetails
+	ConsentRequired      bool
 }
 
 func (a *AuthRequest) checkLoginClient(ctx context.Context, permissionCheck domain.PermissionCheck) error {
@@ -57,6 +61,8 @@ func (q *Queries) AuthRequestByID(ctx context.Context, shouldTriggerBulk bool, i
 		scope   database.TextArray[string]
 		prompt  database.NumberArray[domain.Prompt]
 		locales database.TextArray[string]
+
+		authorizationDetails []byte
 	)
 
 	dst := new(AuthRequest)
@@ -65,7 +71,8 @@ func (q *Queries) AuthRequestByID(ctx context.Context, shouldTriggerBulk bool, i
 		func(row *sql.Row) error {
 			return row.Scan(
 				&dst.ID, &dst.CreationDate, &dst.LoginClient, &dst.ClientID, &scope, &dst.RedirectURI,
-				&prompt, &locales, &dst.LoginHint, &dst.MaxAge, &dst.HintUserID,
+				&prompt, &locales, &dst.LoginHint, &dst.MaxAge, &dst.HintUserID, &authorizationDetails,
+				&dst.ConsentRequired,
 			)
 		},
 		authRequestByIDQuery,
@@ -81,6 +88,11 @@ func (q *Queries) AuthRequestByID(ctx context.Context, shouldTriggerBulk bool, i
 	dst.Scope = scope
 	dst.Prompt = prompt
 	dst.UiLocales = locales
+	if len(authorizationDetails) > 0 {
+		if err = json.Unmarshal(authorizationDetails, &dst.AuthorizationDetails); err != nil {
+			return nil, zerrors.ThrowInternal(err, "QUERY-Rar9u", "Errors.Internal")
+		}
+	}
 
 	if checkLoginClient {
 		if err = dst.checkLoginClient(ctx, q.checkPermission); err != nil {
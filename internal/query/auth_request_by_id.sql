@@ -9,7 +9,9 @@ select
     ui_locales,
     login_hint,
     max_age,
-    hint_user_id
+    hint_user_id,
+    authorization_details,
+    consent_required
 from projections.auth_requests
 where id = $1 and instance_id = $2
 limit 1;
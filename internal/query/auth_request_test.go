@@ -35,6 +35,8 @@ func TestQueries_AuthRequestByID(t *testing.T) {
 		projection.AuthRequestColumnLoginHint,
 		projection.AuthRequestColumnMaxAge,
 		projection.AuthRequestColumnHintUserID,
+		projection.AuthRequestColumnAuthorizationDetails,
+		projection.AuthRequestColumnConsentRequired,
 	}
 	type args struct {
 		shouldTriggerBulk bool
@@ -68,6 +70,8 @@ func TestQueries_AuthRequestByID(t *testing.T) {
 				"me@example.com",
 				int64(time.Minute),
 				"userID",
+				[]byte(`[{"type":"payment_initiation","amount":"200.00"}]`),
+				true,
 			}, "123", "instanceID"),
 			want: &AuthRequest{
 				ID:           "id",
@@ -81,6 +85,10 @@ func TestQueries_AuthRequestByID(t *testing.T) {
 				LoginHint:    gu.Ptr("me@example.com"),
 				MaxAge:       gu.Ptr(time.Minute),
 				HintUserID:   gu.Ptr("userID"),
+				AuthorizationDetails: domain.AuthorizationDetails{
+					{"type": "payment_initiation", "amount": "200.00"},
+				},
+				ConsentRequired: true,
 			},
 		},
 		{
@@ -102,6 +110,8 @@ func TestQueries_AuthRequestByID(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				false,
 			}, "123", "instanceID"),
 			want: &AuthRequest{
 				ID:           "id",
@@ -154,6 +164,8 @@ func TestQueries_AuthRequestByID(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				false,
 			}, "123", "instanceID"),
 			permissionCheck: func(ctx context.Context, permission, orgID, resourceID string) (err error) {
 				return zerrors.ThrowPermissionDenied(nil, "id", "not permitted")
@@ -179,6 +191,8 @@ func TestQueries_AuthRequestByID(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				false,
 			}, "123", "instanceID"),
 			permissionCheck: func(ctx context.Context, permission, orgID, resourceID string) (err error) {
 				return nil
@@ -0,0 +1,91 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/cibarequest"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// CIBARequestReadModel is the current state of a backchannel authentication request (OpenID CIBA).
+type CIBARequestReadModel struct {
+	eventstore.ReadModel
+
+	ClientID          string
+	UserID            string
+	UserOrgID         string
+	Scopes            []string
+	Audience          []string
+	BindingMessage    string
+	Expires           time.Time
+	NotificationURI   string
+	NotificationToken *crypto.CryptoValue
+	State             domain.CIBARequestState
+}
+
+func newCIBARequestReadModel(id string) *CIBARequestReadModel {
+	return &CIBARequestReadModel{
+		ReadModel: eventstore.ReadModel{
+			AggregateID: id,
+		},
+	}
+}
+
+func (rm *CIBARequestReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *cibarequest.AddedEvent:
+			rm.ClientID = e.ClientID
+			rm.UserID = e.UserID
+			rm.UserOrgID = e.UserOrgID
+			rm.Scopes = e.Scopes
+			rm.Audience = e.Audience
+			rm.BindingMessage = e.BindingMessage
+			rm.Expires = e.Expires
+			rm.NotificationURI = e.NotificationURI
+			rm.NotificationToken = e.NotificationToken
+			rm.State = domain.CIBARequestStateInitiated
+		case *cibarequest.ApprovedEvent:
+			rm.State = domain.CIBARequestStateApproved
+		case *cibarequest.CanceledEvent:
+			rm.State = e.Reason.State()
+		case *cibarequest.DoneEvent:
+			rm.State = domain.CIBARequestStateDone
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *CIBARequestReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(cibarequest.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			cibarequest.AddedEventType,
+			cibarequest.ApprovedEventType,
+			cibarequest.CanceledEventType,
+			cibarequest.DoneEventType,
+		).
+		Builder()
+}
+
+// CIBARequestByID returns the backchannel authentication request by its ID from the eventstore.
+func (q *Queries) CIBARequestByID(ctx context.Context, id string) (model *CIBARequestReadModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	model = newCIBARequestReadModel(id)
+	if err = q.eventstore.FilterToQueryReducer(ctx, model); err != nil {
+		return nil, err
+	}
+	if !model.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "QUERY-Ciu6n", "Errors.CIBARequest.NotFound")
+	}
+	return model, nil
+}
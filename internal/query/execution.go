@@ -39,6 +39,10 @@ var (
 		name:  projection.ExecutionInstanceIDCol,
 		table: executionTable,
 	}
+	ExecutionColumnFilter = Column{
+		name:  projection.ExecutionFilterCol,
+		table: executionTable,
+	}
 	executionTargetsTable = table{
 		name:          projection.ExecutionTable + "_" + projection.ExecutionTargetSuffix,
 		instanceIDCol: projection.ExecutionTargetInstanceIDCol,
@@ -76,6 +80,7 @@ type Execution struct {
 	domain.ObjectDetails
 
 	Targets []*exec.Target
+	Filter  string
 }
 
 type ExecutionSearchQueries struct {
@@ -154,6 +159,7 @@ func prepareExecutionQuery() (sq.SelectBuilder, func(row *sql.Row) (*Execution,
 			ExecutionColumnID.identifier(),
 			ExecutionColumnCreationDate.identifier(),
 			ExecutionColumnChangeDate.identifier(),
+			ExecutionColumnFilter.identifier(),
 			executionTargetsListCol.identifier(),
 		).From(executionTable.identifier()).
 			Join("(" + executionTargetsQuery + ") AS " + executionTargetsTableAlias.alias + " ON " +
@@ -170,6 +176,7 @@ func prepareExecutionsQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*Executio
 			ExecutionColumnID.identifier(),
 			ExecutionColumnCreationDate.identifier(),
 			ExecutionColumnChangeDate.identifier(),
+			ExecutionColumnFilter.identifier(),
 			executionTargetsListCol.identifier(),
 			countColumn.identifier(),
 		).From(executionTable.identifier()).
@@ -190,12 +197,14 @@ type executionTarget struct {
 func scanExecution(row *sql.Row) (*Execution, error) {
 	execution := new(Execution)
 	targets := make([]byte, 0)
+	var filter sql.NullString
 
 	err := row.Scan(
 		&execution.ResourceOwner,
 		&execution.ID,
 		&execution.CreationDate,
 		&execution.EventDate,
+		&filter,
 		&targets,
 	)
 	if err != nil {
@@ -210,6 +219,7 @@ func scanExecution(row *sql.Row) (*Execution, error) {
 		return nil, err
 	}
 
+	execution.Filter = filter.String
 	execution.Targets = make([]*exec.Target, len(executionTargets))
 	for i := range executionTargets {
 		if executionTargets[i].Target != "" {
@@ -251,12 +261,14 @@ func scanExecutions(rows *sql.Rows) (*Executions, error) {
 	for rows.Next() {
 		execution := new(Execution)
 		targets := make([]byte, 0)
+		var filter sql.NullString
 
 		err := rows.Scan(
 			&execution.ResourceOwner,
 			&execution.ID,
 			&execution.CreationDate,
 			&execution.EventDate,
+			&filter,
 			&targets,
 			&count,
 		)
@@ -267,6 +279,7 @@ func scanExecutions(rows *sql.Rows) (*Executions, error) {
 			return nil, zerrors.ThrowInternal(err, "QUERY-tyw2ydsj84", "Errors.Internal")
 		}
 
+		execution.Filter = filter.String
 		execution.Targets, err = executionTargetsUnmarshal(targets)
 		if err != nil {
 			return nil, err
@@ -7,7 +7,7 @@ SELECT et.instance_id,
                        'target', et.target_id
                )
        ) as targets
-FROM projections.executions1_targets AS et
+FROM projections.executions2_targets AS et
          INNER JOIN projections.targets2 AS t
                     ON et.instance_id = t.instance_id
                         AND et.target_id IS NOT NULL
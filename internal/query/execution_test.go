@@ -14,51 +14,55 @@ import (
 )
 
 var (
-	prepareExecutionsStmt = `SELECT projections.executions1.instance_id,` +
-		` projections.executions1.id,` +
-		` projections.executions1.creation_date,` +
-		` projections.executions1.change_date,` +
+	prepareExecutionsStmt = `SELECT projections.executions2.instance_id,` +
+		` projections.executions2.id,` +
+		` projections.executions2.creation_date,` +
+		` projections.executions2.change_date,` +
+		` projections.executions2.filter,` +
 		` execution_targets.targets,` +
 		` COUNT(*) OVER ()` +
-		` FROM projections.executions1` +
+		` FROM projections.executions2` +
 		` JOIN (` +
 		`SELECT et.instance_id, et.execution_id, JSONB_AGG( JSON_BUILD_OBJECT( 'position', et.position, 'include', et.include, 'target', et.target_id ) ) as targets` +
-		` FROM projections.executions1_targets AS et` +
+		` FROM projections.executions2_targets AS et` +
 		` INNER JOIN projections.targets2 AS t ON et.instance_id = t.instance_id AND et.target_id IS NOT NULL AND et.target_id = t.id` +
 		` GROUP BY et.instance_id, et.execution_id` +
 		`)` +
 		` AS execution_targets` +
-		` ON execution_targets.instance_id = projections.executions1.instance_id` +
-		` AND execution_targets.execution_id = projections.executions1.id`
+		` ON execution_targets.instance_id = projections.executions2.instance_id` +
+		` AND execution_targets.execution_id = projections.executions2.id`
 	prepareExecutionsCols = []string{
 		"instance_id",
 		"id",
 		"creation_date",
 		"change_date",
+		"filter",
 		"targets",
 		"count",
 	}
 
-	prepareExecutionStmt = `SELECT projections.executions1.instance_id,` +
-		` projections.executions1.id,` +
-		` projections.executions1.creation_date,` +
-		` projections.executions1.change_date,` +
+	prepareExecutionStmt = `SELECT projections.executions2.instance_id,` +
+		` projections.executions2.id,` +
+		` projections.executions2.creation_date,` +
+		` projections.executions2.change_date,` +
+		` projections.executions2.filter,` +
 		` execution_targets.targets` +
-		` FROM projections.executions1` +
+		` FROM projections.executions2` +
 		` JOIN (` +
 		`SELECT et.instance_id, et.execution_id, JSONB_AGG( JSON_BUILD_OBJECT( 'position', et.position, 'include', et.include, 'target', et.target_id ) ) as targets` +
-		` FROM projections.executions1_targets AS et` +
+		` FROM projections.executions2_targets AS et` +
 		` INNER JOIN projections.targets2 AS t ON et.instance_id = t.instance_id AND et.target_id IS NOT NULL AND et.target_id = t.id` +
 		` GROUP BY et.instance_id, et.execution_id` +
 		`)` +
 		` AS execution_targets` +
-		` ON execution_targets.instance_id = projections.executions1.instance_id` +
-		` AND execution_targets.execution_id = projections.executions1.id`
+		` ON execution_targets.instance_id = projections.executions2.instance_id` +
+		` AND execution_targets.execution_id = projections.executions2.id`
 	prepareExecutionCols = []string{
 		"instance_id",
 		"id",
 		"creation_date",
 		"change_date",
+		"filter",
 		"targets",
 	}
 )
@@ -99,6 +103,7 @@ func Test_ExecutionPrepares(t *testing.T) {
 							"id",
 							testNow,
 							testNow,
+							nil,
 							[]byte(`[{"position" : 1, "target" : "target"}, {"position" : 2, "include" : "include"}]`),
 						},
 					},
@@ -137,6 +142,7 @@ func Test_ExecutionPrepares(t *testing.T) {
 							"id-1",
 							testNow,
 							testNow,
+							nil,
 							[]byte(`[{"position" : 1, "target" : "target"}, {"position" : 2, "include" : "include"}]`),
 						},
 						{
@@ -144,6 +150,7 @@ func Test_ExecutionPrepares(t *testing.T) {
 							"id-2",
 							testNow,
 							testNow,
+							nil,
 							[]byte(`[{"position" : 2, "target" : "target"}, {"position" : 1, "include" : "include"}]`),
 						},
 					},
@@ -194,6 +201,7 @@ func Test_ExecutionPrepares(t *testing.T) {
 							"id-1",
 							testNow,
 							testNow,
+							nil,
 							[]byte(`[{"position" : 1, "target" : "target"}, {"position" : 3, "include" : "include"}]`),
 						},
 						{
@@ -201,6 +209,7 @@ func Test_ExecutionPrepares(t *testing.T) {
 							"id-2",
 							testNow,
 							testNow,
+							nil,
 							[]byte(`[{"position" : 2, "target" : "target"}, {"position" : 1, "include" : "include"}]`),
 						},
 					},
@@ -285,6 +294,7 @@ func Test_ExecutionPrepares(t *testing.T) {
 						"id",
 						testNow,
 						testNow,
+						`payload.orgID == "org"`,
 						[]byte(`[{"position" : 1, "target" : "target"}, {"position" : 2, "include" : "include"}]`),
 					},
 				),
@@ -300,6 +310,7 @@ func Test_ExecutionPrepares(t *testing.T) {
 					{Type: domain.ExecutionTargetTypeTarget, Target: "target"},
 					{Type: domain.ExecutionTargetTypeInclude, Target: "include"},
 				},
+				Filter: `payload.orgID == "org"`,
 			},
 		},
 		{
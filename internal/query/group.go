@@ -129,6 +129,27 @@ func NewGroupOrganizationIdSearchQuery(id string) (SearchQuery, error) {
 	return NewTextQuery(GroupColumnResourceOwner, id, TextEquals)
 }
 
+// NewGroupUserIDSearchQuery returns a query matching the groups the user is a member of
+func NewGroupUserIDSearchQuery(userID string) (SearchQuery, error) {
+	// linking queries for the subselect
+	instanceQuery, err := NewColumnComparisonQuery(GroupUsersColumnInstanceID, GroupColumnInstanceID, ColumnEquals)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDQuery, err := NewTextQuery(GroupUsersColumnUserID, userID, TextEquals)
+	if err != nil {
+		return nil, err
+	}
+
+	subSelect, err := NewSubSelect(GroupUsersColumnGroupID, []SearchQuery{instanceQuery, userIDQuery})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewListQuery(GroupColumnID, subSelect, ListIn)
+}
+
 func groupCheckPermission(ctx context.Context, resourceOwner, groupID string, permissionCheck domain.PermissionCheck) error {
 	return permissionCheck(ctx, domain.PermissionGroupRead, resourceOwner, groupID)
 }
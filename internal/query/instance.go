@@ -22,6 +22,7 @@ import (
 	target_domain "github.com/zitadel/zitadel/internal/execution/target"
 	"github.com/zitadel/zitadel/internal/feature"
 	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/ratelimit"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -470,6 +471,8 @@ type authzInstance struct {
 	DCR                    dcr                        `json:"dcr,omitempty"`
 	IsBlocked              *bool                      `json:"is_blocked,omitempty"`
 	LogRetention           *time.Duration             `json:"log_retention,omitempty"`
+	Limits                 *ratelimit.Limits          `json:"rate_limits,omitempty"`
+	Horizon                *time.Duration             `json:"archive_horizon,omitempty"`
 	Feature                feature.Features           `json:"feature,omitempty"`
 	ExternalDomains        database.TextArray[string] `json:"external_domains,omitempty"`
 	TrustedDomains         database.TextArray[string] `json:"trusted_domains,omitempty"`
@@ -543,6 +546,14 @@ func (i *authzInstance) AuditLogRetention() *time.Duration {
 	return i.LogRetention
 }
 
+func (i *authzInstance) RateLimits() *ratelimit.Limits {
+	return i.Limits
+}
+
+func (i *authzInstance) ArchiveHorizon() *time.Duration {
+	return i.Horizon
+}
+
 func (i *authzInstance) Features() feature.Features {
 	return i.Feature
 }
@@ -587,6 +598,8 @@ func scanAuthzInstance() (*authzInstance, func(row *sql.Row) error) {
 			allowUnauthDCR        sql.NullBool
 			auditLogRetention     database.NullDuration
 			block                 sql.NullBool
+			rateLimits            []byte
+			archiveHorizon        database.NullDuration
 			features              []byte
 			executionTargetsBytes []byte
 			allowedLanguages      database.TextArray[string]
@@ -605,6 +618,8 @@ func scanAuthzInstance() (*authzInstance, func(row *sql.Row) error) {
 			&allowUnauthDCR,
 			&auditLogRetention,
 			&block,
+			&rateLimits,
+			&archiveHorizon,
 			&features,
 			&instance.ExternalDomains,
 			&instance.TrustedDomains,
@@ -624,6 +639,15 @@ func scanAuthzInstance() (*authzInstance, func(row *sql.Row) error) {
 		if block.Valid {
 			instance.IsBlocked = &block.Bool
 		}
+		if len(rateLimits) > 0 {
+			instance.Limits = new(ratelimit.Limits)
+			if err = json.Unmarshal(rateLimits, instance.Limits); err != nil {
+				return zerrors.ThrowInternal(err, "QUERY-Ra7lm", "Errors.Internal")
+			}
+		}
+		if archiveHorizon.Valid {
+			instance.Horizon = &archiveHorizon.Duration
+		}
 		instance.CSP.EnableIframeEmbedding = enableIframeEmbedding.Bool
 		instance.Impersonation = enableImpersonation.Bool
 		instance.DCR.Enabled = enableDCR.Bool
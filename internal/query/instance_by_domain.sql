@@ -31,6 +31,7 @@ with domain as (
 		select e.instance_id, json_build_object(
 			'execution_id', et.execution_id,
 			'target_id', t.id,
+			'sequence', t.sequence,
 			'target_type', t.target_type,
 			'endpoint', t.endpoint,
 			'timeout', t.timeout,
@@ -38,12 +39,15 @@ with domain as (
 			'signing_key', t.signing_key,
 			'payload_type', t.payload_type,
             'encryption_key', encode(k.public_key, 'base64'),
-            'encryption_key_id', k.id
+            'encryption_key_id', k.id,
+            'client_certificate', t.client_certificate,
+            'retry_policy', t.retry_policy,
+            'filter', e.filter
 		) as execution_targets
 		from domain d
-		join projections.executions1 e
+		join projections.executions2 e
 			on d.instance_id = e.instance_id
-		join projections.executions1_targets et
+		join projections.executions2_targets et
 			on e.instance_id = et.instance_id
 			and e.id = et.execution_id
 		join projections.targets2 t
@@ -72,6 +76,8 @@ select
 	s.allow_unauthenticated_dynamic_client_registration,
     l.audit_log_retention,
     l.block,
+    l.rate_limits,
+    l.archive_horizon,
 	f.features,
 	ed.domains as external_domains,
 	td.domains as trusted_domains,
@@ -8,6 +8,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
@@ -41,6 +42,36 @@ type IntrospectionClient struct {
 	ResourceOwner        string
 	ProjectRoleAssertion bool
 	PublicKeys           database.Map[[]byte]
+	// AuthMethodType is either a [domain.APIAuthMethodType] or a [domain.OIDCAuthMethodType],
+	// depending on the AppType.
+	AuthMethodType         int16
+	TLSClientAuthSubjectDN string
+	TLSClientAuthSAN       string
+}
+
+// UsesTLSClientAuth reports whether the client authenticates by a certificate issued by a trusted CA (RFC 8705, section 2.1).
+func (c *IntrospectionClient) UsesTLSClientAuth() bool {
+	switch c.AppType {
+	case AppTypeAPI:
+		return domain.APIAuthMethodType(c.AuthMethodType) == domain.APIAuthMethodTypeTLSClientAuth
+	case AppTypeOIDC:
+		return domain.OIDCAuthMethodType(c.AuthMethodType) == domain.OIDCAuthMethodTypeTLSClientAuth
+	default:
+		return false
+	}
+}
+
+// UsesSelfSignedTLSClientAuth reports whether the client authenticates by a self-signed certificate
+// of one of its registered keys (RFC 8705, section 2.2).
+func (c *IntrospectionClient) UsesSelfSignedTLSClientAuth() bool {
+	switch c.AppType {
+	case AppTypeAPI:
+		return domain.APIAuthMethodType(c.AuthMethodType) == domain.APIAuthMethodTypeSelfSignedTLSClientAuth
+	case AppTypeOIDC:
+		return domain.OIDCAuthMethodType(c.AuthMethodType) == domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth
+	default:
+		return false
+	}
 }
 
 //go:embed introspection_client_by_id.sql
@@ -65,6 +96,9 @@ func (q *Queries) ActiveIntrospectionClientByID(ctx context.Context, clientID st
 			&client.ResourceOwner,
 			&client.ProjectRoleAssertion,
 			&client.PublicKeys,
+			&client.AuthMethodType,
+			&client.TLSClientAuthSubjectDN,
+			&client.TLSClientAuthSAN,
 		)
 	},
 		introspectionClientByIDQuery,
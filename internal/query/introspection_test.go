@@ -12,6 +12,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
 )
 
 func TestQueries_ActiveIntrospectionClientByID(t *testing.T) {
@@ -50,8 +51,8 @@ func TestQueries_ActiveIntrospectionClientByID(t *testing.T) {
 				getKeys:  false,
 			},
 			mock: mockQuery(expQuery,
-				[]string{"app_id", "client_id", "client_secret", "app_type", "project_id", "resource_owner", "project_role_assertion", "public_keys"},
-				[]driver.Value{"appID", "clientID", "secret", "oidc", "projectID", "orgID", true, nil},
+				[]string{"app_id", "client_id", "client_secret", "app_type", "project_id", "resource_owner", "project_role_assertion", "public_keys", "auth_method_type", "tls_client_auth_subject_dn", "tls_client_auth_san"},
+				[]driver.Value{"appID", "clientID", "secret", "oidc", "projectID", "orgID", true, nil, int16(domain.OIDCAuthMethodTypeBasic), "", ""},
 				"instanceID", "clientID", false),
 			want: &IntrospectionClient{
 				AppID:                "appID",
@@ -71,8 +72,8 @@ func TestQueries_ActiveIntrospectionClientByID(t *testing.T) {
 				getKeys:  true,
 			},
 			mock: mockQuery(expQuery,
-				[]string{"app_id", "client_id", "client_secret", "app_type", "project_id", "resource_owner", "project_role_assertion", "public_keys"},
-				[]driver.Value{"appID", "clientID", "", "oidc", "projectID", "orgID", true, encPubkeys},
+				[]string{"app_id", "client_id", "client_secret", "app_type", "project_id", "resource_owner", "project_role_assertion", "public_keys", "auth_method_type", "tls_client_auth_subject_dn", "tls_client_auth_san"},
+				[]driver.Value{"appID", "clientID", "", "oidc", "projectID", "orgID", true, encPubkeys, int16(domain.OIDCAuthMethodTypePrivateKeyJWT), "", ""},
 				"instanceID", "clientID", true),
 			want: &IntrospectionClient{
 				AppID:                "appID",
@@ -83,6 +84,31 @@ func TestQueries_ActiveIntrospectionClientByID(t *testing.T) {
 				ResourceOwner:        "orgID",
 				ProjectRoleAssertion: true,
 				PublicKeys:           pubkeys,
+				AuthMethodType:       int16(domain.OIDCAuthMethodTypePrivateKeyJWT),
+			},
+		},
+		{
+			name: "success, tls client auth",
+			args: args{
+				clientID: "clientID",
+				getKeys:  false,
+			},
+			mock: mockQuery(expQuery,
+				[]string{"app_id", "client_id", "client_secret", "app_type", "project_id", "resource_owner", "project_role_assertion", "public_keys", "auth_method_type", "tls_client_auth_subject_dn", "tls_client_auth_san"},
+				[]driver.Value{"appID", "clientID", "", "api", "projectID", "orgID", false, nil, int16(domain.APIAuthMethodTypeTLSClientAuth), "CN=client", "client.example.com"},
+				"instanceID", "clientID", false),
+			want: &IntrospectionClient{
+				AppID:                  "appID",
+				ClientID:               "clientID",
+				HashedSecret:           "",
+				AppType:                AppTypeAPI,
+				ProjectID:              "projectID",
+				ResourceOwner:          "orgID",
+				ProjectRoleAssertion:   false,
+				PublicKeys:             nil,
+				AuthMethodType:         int16(domain.APIAuthMethodTypeTLSClientAuth),
+				TLSClientAuthSubjectDN: "CN=client",
+				TLSClientAuthSAN:       "client.example.com",
 			},
 		},
 	}
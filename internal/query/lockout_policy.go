@@ -10,6 +10,7 @@ import (
 	"github.com/zitadel/logging"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/query/projection"
@@ -28,6 +29,8 @@ type LockoutPolicy struct {
 	MaxPasswordAttempts uint64
 	MaxOTPAttempts      uint64
 	ShowFailures        bool
+	LockoutDuration     database.Duration
+	FailedAttemptDelay  database.Duration
 
 	IsDefault bool
 }
@@ -73,6 +76,14 @@ var (
 		name:  projection.LockoutPolicyMaxOTPAttemptsCol,
 		table: lockoutTable,
 	}
+	LockoutColLockoutDuration = Column{
+		name:  projection.LockoutPolicyLockoutDurationCol,
+		table: lockoutTable,
+	}
+	LockoutColFailedAttemptDelay = Column{
+		name:  projection.LockoutPolicyFailedAttemptDelayCol,
+		table: lockoutTable,
+	}
 	LockoutColIsDefault = Column{
 		name:  projection.LockoutPolicyIsDefaultCol,
 		table: lockoutTable,
@@ -151,6 +162,8 @@ func prepareLockoutPolicyQuery() (sq.SelectBuilder, func(*sql.Row) (*LockoutPoli
 			LockoutColShowFailures.identifier(),
 			LockoutColMaxPasswordAttempts.identifier(),
 			LockoutColMaxOTPAttempts.identifier(),
+			LockoutColLockoutDuration.identifier(),
+			LockoutColFailedAttemptDelay.identifier(),
 			LockoutColIsDefault.identifier(),
 			LockoutColState.identifier(),
 		).
@@ -167,6 +180,8 @@ func prepareLockoutPolicyQuery() (sq.SelectBuilder, func(*sql.Row) (*LockoutPoli
 				&policy.ShowFailures,
 				&policy.MaxPasswordAttempts,
 				&policy.MaxOTPAttempts,
+				&policy.LockoutDuration,
+				&policy.FailedAttemptDelay,
 				&policy.IsDefault,
 				&policy.State,
 			)
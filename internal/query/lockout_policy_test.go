@@ -7,7 +7,9 @@ import (
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -21,6 +23,8 @@ var (
 		` projections.lockout_policies3.show_failure,` +
 		` projections.lockout_policies3.max_password_attempts,` +
 		` projections.lockout_policies3.max_otp_attempts,` +
+		` projections.lockout_policies3.lockout_duration,` +
+		` projections.lockout_policies3.failed_attempt_delay,` +
 		` projections.lockout_policies3.is_default,` +
 		` projections.lockout_policies3.state` +
 		` FROM projections.lockout_policies3`
@@ -34,6 +38,8 @@ var (
 		"show_failure",
 		"max_password_attempts",
 		"max_otp_attempts",
+		"lockout_duration",
+		"failed_attempt_delay",
 		"is_default",
 		"state",
 	}
@@ -84,6 +90,8 @@ func Test_LockoutPolicyPrepares(t *testing.T) {
 						true,
 						20,
 						20,
+						15 * time.Minute,
+						time.Second,
 						true,
 						domain.PolicyStateActive,
 					},
@@ -99,6 +107,8 @@ func Test_LockoutPolicyPrepares(t *testing.T) {
 				ShowFailures:        true,
 				MaxPasswordAttempts: 20,
 				MaxOTPAttempts:      20,
+				LockoutDuration:     database.Duration(15 * time.Minute),
+				FailedAttemptDelay:  database.Duration(time.Second),
 				IsDefault:           true,
 			},
 		},
@@ -0,0 +1,214 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	notificationHistoryTable = table{
+		name:          projection.NotificationHistoryTable,
+		instanceIDCol: projection.NotificationHistoryInstanceIDCol,
+	}
+	NotificationHistoryColumnID = Column{
+		name:  projection.NotificationHistoryIDCol,
+		table: notificationHistoryTable,
+	}
+	NotificationHistoryColumnCreationDate = Column{
+		name:  projection.NotificationHistoryCreationDateCol,
+		table: notificationHistoryTable,
+	}
+	NotificationHistoryColumnChangeDate = Column{
+		name:  projection.NotificationHistoryChangeDateCol,
+		table: notificationHistoryTable,
+	}
+	NotificationHistoryColumnInstanceID = Column{
+		name:  projection.NotificationHistoryInstanceIDCol,
+		table: notificationHistoryTable,
+	}
+	NotificationHistoryColumnResourceOwner = Column{
+		name:  projection.NotificationHistoryResourceOwnerCol,
+		table: notificationHistoryTable,
+	}
+	NotificationHistoryColumnSequence = Column{
+		name:  projection.NotificationHistorySequenceCol,
+		table: notificationHistoryTable,
+	}
+	NotificationHistoryColumnUserID = Column{
+		name:  projection.NotificationHistoryUserIDCol,
+		table: notificationHistoryTable,
+	}
+	NotificationHistoryColumnTriggeringEventType = Column{
+		name:  projection.NotificationHistoryTriggeringEventTypeCol,
+		table: notificationHistoryTable,
+	}
+	NotificationHistoryColumnMessageType = Column{
+		name:  projection.NotificationHistoryMessageTypeCol,
+		table: notificationHistoryTable,
+	}
+	NotificationHistoryColumnNotificationType = Column{
+		name:  projection.NotificationHistoryNotificationTypeCol,
+		table: notificationHistoryTable,
+	}
+	NotificationHistoryColumnProviderID = Column{
+		name:  projection.NotificationHistoryProviderIDCol,
+		table: notificationHistoryTable,
+	}
+	NotificationHistoryColumnDeliveryID = Column{
+		name:  projection.NotificationHistoryDeliveryIDCol,
+		table: notificationHistoryTable,
+	}
+	NotificationHistoryColumnState = Column{
+		name:  projection.NotificationHistoryStateCol,
+		table: notificationHistoryTable,
+	}
+	NotificationHistoryColumnAttempts = Column{
+		name:  projection.NotificationHistoryAttemptsCol,
+		table: notificationHistoryTable,
+	}
+	NotificationHistoryColumnLastError = Column{
+		name:  projection.NotificationHistoryLastErrorCol,
+		table: notificationHistoryTable,
+	}
+)
+
+type NotificationHistory struct {
+	SearchResponse
+	Notifications []*SentNotification
+}
+
+func (n *NotificationHistory) SetState(s *State) {
+	n.State = s
+}
+
+// SentNotification is a notification sent to a user by the notification worker,
+// including the result of its last attempt.
+type SentNotification struct {
+	domain.ObjectDetails
+
+	UserID              string
+	TriggeringEventType string
+	MessageType         string
+	NotificationType    domain.NotificationType
+	ProviderID          string
+	DeliveryID          string
+	State               domain.NotificationDeliveryState
+	Attempts            int
+	LastError           string
+}
+
+type NotificationHistorySearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *NotificationHistorySearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+// SearchUserNotificationHistory returns the notifications sent to the user.
+// The permission is checked against the owner of the user, it's not required to read the own history.
+func (q *Queries) SearchUserNotificationHistory(ctx context.Context, userID string, queries *NotificationHistorySearchQueries, permissionCheck domain.PermissionCheck) (_ *NotificationHistory, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "QUERY-Nh2st0", "Errors.IDMissing")
+	}
+	eq := sq.Eq{
+		NotificationHistoryColumnUserID.identifier():     userID,
+		NotificationHistoryColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareNotificationHistoryQuery()
+	history, err := genericRowsQueryWithState(ctx, q.client, notificationHistoryTable, combineToWhereStmt(query, queries.toQuery, eq), scan)
+	if err != nil {
+		return nil, err
+	}
+	// all notifications belong to the same user, so a single check is enough
+	if permissionCheck != nil && len(history.Notifications) > 0 {
+		if err := userCheckPermission(ctx, history.Notifications[0].ResourceOwner, userID, permissionCheck); err != nil {
+			return nil, err
+		}
+	}
+	return history, nil
+}
+
+func NewNotificationHistoryNotificationTypeSearchQuery(value domain.NotificationType) (SearchQuery, error) {
+	return NewNumberQuery(NotificationHistoryColumnNotificationType, int(value), NumberEquals)
+}
+
+func NewNotificationHistoryStateSearchQuery(value domain.NotificationDeliveryState) (SearchQuery, error) {
+	return NewNumberQuery(NotificationHistoryColumnState, int(value), NumberEquals)
+}
+
+func prepareNotificationHistoryQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*NotificationHistory, error)) {
+	return sq.Select(
+			NotificationHistoryColumnID.identifier(),
+			NotificationHistoryColumnCreationDate.identifier(),
+			NotificationHistoryColumnChangeDate.identifier(),
+			NotificationHistoryColumnResourceOwner.identifier(),
+			NotificationHistoryColumnSequence.identifier(),
+			NotificationHistoryColumnUserID.identifier(),
+			NotificationHistoryColumnTriggeringEventType.identifier(),
+			NotificationHistoryColumnMessageType.identifier(),
+			NotificationHistoryColumnNotificationType.identifier(),
+			NotificationHistoryColumnProviderID.identifier(),
+			NotificationHistoryColumnDeliveryID.identifier(),
+			NotificationHistoryColumnState.identifier(),
+			NotificationHistoryColumnAttempts.identifier(),
+			NotificationHistoryColumnLastError.identifier(),
+			countColumn.identifier(),
+		).From(notificationHistoryTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*NotificationHistory, error) {
+			notifications := make([]*SentNotification, 0)
+			var count uint64
+			for rows.Next() {
+				notification := new(SentNotification)
+				err := rows.Scan(
+					&notification.ID,
+					&notification.CreationDate,
+					&notification.EventDate,
+					&notification.ResourceOwner,
+					&notification.Sequence,
+					&notification.UserID,
+					&notification.TriggeringEventType,
+					&notification.MessageType,
+					&notification.NotificationType,
+					&notification.ProviderID,
+					&notification.DeliveryID,
+					&notification.State,
+					&notification.Attempts,
+					&notification.LastError,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				notifications = append(notifications, notification)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Nh3st0", "Errors.Query.CloseRows")
+			}
+
+			return &NotificationHistory{
+				Notifications: notifications,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
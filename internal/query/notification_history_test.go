@@ -0,0 +1,182 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+var (
+	prepareNotificationHistoryStmt = `SELECT projections.notification_history.id,` +
+		` projections.notification_history.creation_date,` +
+		` projections.notification_history.change_date,` +
+		` projections.notification_history.resource_owner,` +
+		` projections.notification_history.sequence,` +
+		` projections.notification_history.user_id,` +
+		` projections.notification_history.triggering_event_type,` +
+		` projections.notification_history.message_type,` +
+		` projections.notification_history.notification_type,` +
+		` projections.notification_history.provider_id,` +
+		` projections.notification_history.delivery_id,` +
+		` projections.notification_history.state,` +
+		` projections.notification_history.attempts,` +
+		` projections.notification_history.last_error,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.notification_history`
+	prepareNotificationHistoryCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"user_id",
+		"triggering_event_type",
+		"message_type",
+		"notification_type",
+		"provider_id",
+		"delivery_id",
+		"state",
+		"attempts",
+		"last_error",
+		"count",
+	}
+)
+
+func Test_NotificationHistoryPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareNotificationHistoryQuery no result",
+			prepare: prepareNotificationHistoryQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareNotificationHistoryStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &NotificationHistory{Notifications: []*SentNotification{}},
+		},
+		{
+			name:    "prepareNotificationHistoryQuery multiple results",
+			prepare: prepareNotificationHistoryQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareNotificationHistoryStmt),
+					prepareNotificationHistoryCols,
+					[][]driver.Value{
+						{
+							"id-1",
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211109),
+							"user-id",
+							"user.human.invite.code.added",
+							domain.InviteUserMessageType,
+							domain.NotificationTypeEmail,
+							"provider-id",
+							"delivery-id",
+							domain.NotificationDeliveryStateDelivered,
+							2,
+							"send error",
+						},
+						{
+							"id-2",
+							testNow,
+							testNow,
+							"ro",
+							uint64(20211110),
+							"user-id",
+							"session.otp.sms.challenged",
+							domain.VerifySMSOTPMessageType,
+							domain.NotificationTypeSms,
+							"provider-id",
+							"",
+							domain.NotificationDeliveryStateFailed,
+							3,
+							"send error",
+						},
+					},
+				),
+			},
+			object: &NotificationHistory{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				Notifications: []*SentNotification{
+					{
+						ObjectDetails: domain.ObjectDetails{
+							ID:            "id-1",
+							EventDate:     testNow,
+							CreationDate:  testNow,
+							ResourceOwner: "ro",
+							Sequence:      20211109,
+						},
+						UserID:              "user-id",
+						TriggeringEventType: "user.human.invite.code.added",
+						MessageType:         domain.InviteUserMessageType,
+						NotificationType:    domain.NotificationTypeEmail,
+						ProviderID:          "provider-id",
+						DeliveryID:          "delivery-id",
+						State:               domain.NotificationDeliveryStateDelivered,
+						Attempts:            2,
+						LastError:           "send error",
+					},
+					{
+						ObjectDetails: domain.ObjectDetails{
+							ID:            "id-2",
+							EventDate:     testNow,
+							CreationDate:  testNow,
+							ResourceOwner: "ro",
+							Sequence:      20211110,
+						},
+						UserID:              "user-id",
+						TriggeringEventType: "session.otp.sms.challenged",
+						MessageType:         domain.VerifySMSOTPMessageType,
+						NotificationType:    domain.NotificationTypeSms,
+						ProviderID:          "provider-id",
+						State:               domain.NotificationDeliveryStateFailed,
+						Attempts:            3,
+						LastError:           "send error",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareNotificationHistoryQuery sql err",
+			prepare: prepareNotificationHistoryQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareNotificationHistoryStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*NotificationHistory)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
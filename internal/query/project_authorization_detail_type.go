@@ -0,0 +1,67 @@
+package query
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+// ProjectAuthorizationDetailTypesReadModel contains the types of authorization details (RFC 9396)
+// registered on a project.
+type ProjectAuthorizationDetailTypesReadModel struct {
+	eventstore.ReadModel
+
+	Types []string
+}
+
+func newProjectAuthorizationDetailTypesReadModel(projectID string) *ProjectAuthorizationDetailTypesReadModel {
+	return &ProjectAuthorizationDetailTypesReadModel{
+		ReadModel: eventstore.ReadModel{
+			AggregateID: projectID,
+		},
+	}
+}
+
+func (rm *ProjectAuthorizationDetailTypesReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *project.AuthorizationDetailTypeAddedEvent:
+			rm.Types = append(rm.Types, e.DetailType)
+		case *project.AuthorizationDetailTypeRemovedEvent:
+			rm.Types = slices.DeleteFunc(rm.Types, func(detailType string) bool {
+				return detailType == e.DetailType
+			})
+		case *project.ProjectRemovedEvent:
+			rm.Types = nil
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *ProjectAuthorizationDetailTypesReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			project.AuthorizationDetailTypeAddedEventType,
+			project.AuthorizationDetailTypeRemovedEventType,
+			project.ProjectRemovedType,
+		).
+		Builder()
+}
+
+// ProjectAuthorizationDetailTypes returns the types of authorization details registered on the project.
+func (q *Queries) ProjectAuthorizationDetailTypes(ctx context.Context, projectID string) (types []string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	model := newProjectAuthorizationDetailTypesReadModel(projectID)
+	if err = q.eventstore.FilterToQueryReducer(ctx, model); err != nil {
+		return nil, err
+	}
+	return model.Types, nil
+}
@@ -31,50 +31,64 @@ const (
 	AppColumnState         = "state"
 	AppColumnSequence      = "sequence"
 
-	appAPITableSuffix              = "api_configs"
-	AppAPIConfigColumnAppID        = "app_id"
-	AppAPIConfigColumnInstanceID   = "instance_id"
-	AppAPIConfigColumnClientID     = "client_id"
-	AppAPIConfigColumnClientSecret = "client_secret"
-	AppAPIConfigColumnAuthMethod   = "auth_method"
-
-	appOIDCTableSuffix                               = "oidc_configs"
-	AppOIDCConfigColumnAppID                         = "app_id"
-	AppOIDCConfigColumnInstanceID                    = "instance_id"
-	AppOIDCConfigColumnVersion                       = "version"
-	AppOIDCConfigColumnClientID                      = "client_id"
-	AppOIDCConfigColumnClientSecret                  = "client_secret"
-	AppOIDCConfigColumnRedirectUris                  = "redirect_uris"
-	AppOIDCConfigColumnResponseTypes                 = "response_types"
-	AppOIDCConfigColumnGrantTypes                    = "grant_types"
-	AppOIDCConfigColumnApplicationType               = "application_type"
-	AppOIDCConfigColumnAuthMethodType                = "auth_method_type"
-	AppOIDCConfigColumnPostLogoutRedirectUris        = "post_logout_redirect_uris"
-	AppOIDCConfigColumnDevMode                       = "is_dev_mode"
-	AppOIDCConfigColumnAccessTokenType               = "access_token_type"
-	AppOIDCConfigColumnAccessTokenRoleAssertion      = "access_token_role_assertion"
-	AppOIDCConfigColumnIDTokenRoleAssertion          = "id_token_role_assertion"
-	AppOIDCConfigColumnIDTokenUserinfoAssertion      = "id_token_userinfo_assertion"
-	AppOIDCConfigColumnClockSkew                     = "clock_skew"
-	AppOIDCConfigColumnAdditionalOrigins             = "additional_origins"
-	AppOIDCConfigColumnSkipNativeAppSuccessPage      = "skip_native_app_success_page"
-	AppOIDCConfigColumnBackChannelLogoutURI          = "back_channel_logout_uri"
-	AppOIDCConfigColumnLoginVersion                  = "login_version"
-	AppOIDCConfigColumnLoginBaseURI                  = "login_base_uri"
-	AppOIDCConfigColumnIOSTeamID                     = "ios_team_id"
-	AppOIDCConfigColumnIOSBundleID                   = "ios_bundle_id"
-	AppOIDCConfigColumnAndroidPackageName            = "android_package_name"
-	AppOIDCConfigColumnAndroidSHA256CertFingerprints = "android_sha256_cert_fingerprints"
-	AppOIDCConfigColumnRegistrationToken             = "registration_token"
-
-	appSAMLTableSuffix              = "saml_configs"
-	AppSAMLConfigColumnAppID        = "app_id"
-	AppSAMLConfigColumnInstanceID   = "instance_id"
-	AppSAMLConfigColumnEntityID     = "entity_id"
-	AppSAMLConfigColumnMetadata     = "metadata"
-	AppSAMLConfigColumnMetadataURL  = "metadata_url"
-	AppSAMLConfigColumnLoginVersion = "login_version"
-	AppSAMLConfigColumnLoginBaseURI = "login_base_uri"
+	appAPITableSuffix                        = "api_configs"
+	AppAPIConfigColumnAppID                  = "app_id"
+	AppAPIConfigColumnInstanceID             = "instance_id"
+	AppAPIConfigColumnClientID               = "client_id"
+	AppAPIConfigColumnClientSecret           = "client_secret"
+	AppAPIConfigColumnAuthMethod             = "auth_method"
+	AppAPIConfigColumnTLSClientAuthSubjectDN = "tls_client_auth_subject_dn"
+	AppAPIConfigColumnTLSClientAuthSAN       = "tls_client_auth_san"
+
+	appOIDCTableSuffix                                  = "oidc_configs"
+	AppOIDCConfigColumnAppID                            = "app_id"
+	AppOIDCConfigColumnInstanceID                       = "instance_id"
+	AppOIDCConfigColumnVersion                          = "version"
+	AppOIDCConfigColumnClientID                         = "client_id"
+	AppOIDCConfigColumnClientSecret                     = "client_secret"
+	AppOIDCConfigColumnRedirectUris                     = "redirect_uris"
+	AppOIDCConfigColumnResponseTypes                    = "response_types"
+	AppOIDCConfigColumnGrantTypes                       = "grant_types"
+	AppOIDCConfigColumnApplicationType                  = "application_type"
+	AppOIDCConfigColumnAuthMethodType                   = "auth_method_type"
+	AppOIDCConfigColumnPostLogoutRedirectUris           = "post_logout_redirect_uris"
+	AppOIDCConfigColumnDevMode                          = "is_dev_mode"
+	AppOIDCConfigColumnAccessTokenType                  = "access_token_type"
+	AppOIDCConfigColumnAccessTokenRoleAssertion         = "access_token_role_assertion"
+	AppOIDCConfigColumnIDTokenRoleAssertion             = "id_token_role_assertion"
+	AppOIDCConfigColumnIDTokenUserinfoAssertion         = "id_token_userinfo_assertion"
+	AppOIDCConfigColumnClockSkew                        = "clock_skew"
+	AppOIDCConfigColumnAdditionalOrigins                = "additional_origins"
+	AppOIDCConfigColumnSkipNativeAppSuccessPage         = "skip_native_app_success_page"
+	AppOIDCConfigColumnBackChannelLogoutURI             = "back_channel_logout_uri"
+	AppOIDCConfigColumnLoginVersion                     = "login_version"
+	AppOIDCConfigColumnLoginBaseURI                     = "login_base_uri"
+	AppOIDCConfigColumnIOSTeamID                        = "ios_team_id"
+	AppOIDCConfigColumnIOSBundleID                      = "ios_bundle_id"
+	AppOIDCConfigColumnAndroidPackageName               = "android_package_name"
+	AppOIDCConfigColumnAndroidSHA256CertFingerprints    = "android_sha256_cert_fingerprints"
+	AppOIDCConfigColumnDPoPRequired                     = "dpop_required"
+	AppOIDCConfigColumnPARRequired                      = "par_required"
+	AppOIDCConfigColumnTLSClientAuthSubjectDN           = "tls_client_auth_subject_dn"
+	AppOIDCConfigColumnTLSClientAuthSAN                 = "tls_client_auth_san"
+	AppOIDCConfigColumnBackChannelClientNotificationURI = "back_channel_client_notification_uri"
+	AppOIDCConfigColumnConsentRequired                  = "consent_required"
+	AppOIDCConfigColumnRegistrationToken                = "registration_token"
+
+	appSAMLTableSuffix                        = "saml_configs"
+	AppSAMLConfigColumnAppID                  = "app_id"
+	AppSAMLConfigColumnInstanceID             = "instance_id"
+	AppSAMLConfigColumnEntityID               = "entity_id"
+	AppSAMLConfigColumnMetadata               = "metadata"
+	AppSAMLConfigColumnMetadataURL            = "metadata_url"
+	AppSAMLConfigColumnLoginVersion           = "login_version"
+	AppSAMLConfigColumnLoginBaseURI           = "login_base_uri"
+	AppSAMLConfigColumnIDPInitiatedEnabled    = "idp_initiated_enabled"
+	AppSAMLConfigColumnIDPInitiatedRelayState = "idp_initiated_relay_state"
+	AppSAMLConfigColumnEncryptAssertion       = "encrypt_assertion"
+	AppSAMLConfigColumnNameIDFormat           = "name_id_format"
+	AppSAMLConfigColumnNameIDSource           = "name_id_source"
+	AppSAMLConfigColumnSignatureAlgorithm     = "signature_algorithm"
 )
 
 type appProjection struct{}
@@ -109,6 +123,8 @@ func (*appProjection) Init() *old_handler.Check {
 			handler.NewColumn(AppAPIConfigColumnClientID, handler.ColumnTypeText),
 			handler.NewColumn(AppAPIConfigColumnClientSecret, handler.ColumnTypeText, handler.Nullable()),
 			handler.NewColumn(AppAPIConfigColumnAuthMethod, handler.ColumnTypeEnum),
+			handler.NewColumn(AppAPIConfigColumnTLSClientAuthSubjectDN, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AppAPIConfigColumnTLSClientAuthSAN, handler.ColumnTypeText, handler.Default("")),
 		},
 			handler.NewPrimaryKey(AppAPIConfigColumnInstanceID, AppAPIConfigColumnAppID),
 			appAPITableSuffix,
@@ -142,6 +158,12 @@ func (*appProjection) Init() *old_handler.Check {
 			handler.NewColumn(AppOIDCConfigColumnIOSBundleID, handler.ColumnTypeText, handler.Nullable()),
 			handler.NewColumn(AppOIDCConfigColumnAndroidPackageName, handler.ColumnTypeText, handler.Nullable()),
 			handler.NewColumn(AppOIDCConfigColumnAndroidSHA256CertFingerprints, handler.ColumnTypeTextArray, handler.Nullable()),
+			handler.NewColumn(AppOIDCConfigColumnDPoPRequired, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(AppOIDCConfigColumnPARRequired, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(AppOIDCConfigColumnTLSClientAuthSubjectDN, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AppOIDCConfigColumnTLSClientAuthSAN, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AppOIDCConfigColumnBackChannelClientNotificationURI, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AppOIDCConfigColumnConsentRequired, handler.ColumnTypeBool, handler.Default(false)),
 			handler.NewColumn(AppOIDCConfigColumnRegistrationToken, handler.ColumnTypeText, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(AppOIDCConfigColumnInstanceID, AppOIDCConfigColumnAppID),
@@ -157,6 +179,12 @@ func (*appProjection) Init() *old_handler.Check {
 			handler.NewColumn(AppSAMLConfigColumnMetadataURL, handler.ColumnTypeText),
 			handler.NewColumn(AppSAMLConfigColumnLoginVersion, handler.ColumnTypeEnum, handler.Nullable()),
 			handler.NewColumn(AppSAMLConfigColumnLoginBaseURI, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(AppSAMLConfigColumnIDPInitiatedEnabled, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(AppSAMLConfigColumnIDPInitiatedRelayState, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AppSAMLConfigColumnEncryptAssertion, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(AppSAMLConfigColumnNameIDFormat, handler.ColumnTypeEnum, handler.Default(domain.SAMLNameIDFormatEmailAddress)),
+			handler.NewColumn(AppSAMLConfigColumnNameIDSource, handler.ColumnTypeEnum, handler.Default(domain.SAMLNameIDSourceLoginName)),
+			handler.NewColumn(AppSAMLConfigColumnSignatureAlgorithm, handler.ColumnTypeEnum, handler.Default(domain.SAMLSignatureAlgorithmUnspecified)),
 		},
 			handler.NewPrimaryKey(AppSAMLConfigColumnInstanceID, AppSAMLConfigColumnAppID),
 			appSAMLTableSuffix,
@@ -385,6 +413,8 @@ func (p *appProjection) reduceAPIConfigAdded(event eventstore.Event) (*handler.S
 				handler.NewCol(AppAPIConfigColumnClientID, e.ClientID),
 				handler.NewCol(AppAPIConfigColumnClientSecret, crypto.SecretOrEncodedHash(e.ClientSecret, e.HashedSecret)),
 				handler.NewCol(AppAPIConfigColumnAuthMethod, e.AuthMethodType),
+				handler.NewCol(AppAPIConfigColumnTLSClientAuthSubjectDN, e.TLSClientAuthSubjectDN),
+				handler.NewCol(AppAPIConfigColumnTLSClientAuthSAN, e.TLSClientAuthSAN),
 			},
 			handler.WithTableSuffix(appAPITableSuffix),
 		),
@@ -406,10 +436,16 @@ func (p *appProjection) reduceAPIConfigChanged(event eventstore.Event) (*handler
 	if !ok {
 		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-vnZKi", "reduce.wrong.event.type %s", project.APIConfigChangedType)
 	}
-	cols := make([]handler.Column, 0, 2)
+	cols := make([]handler.Column, 0, 4)
 	if e.AuthMethodType != nil {
 		cols = append(cols, handler.NewCol(AppAPIConfigColumnAuthMethod, *e.AuthMethodType))
 	}
+	if e.TLSClientAuthSubjectDN != nil {
+		cols = append(cols, handler.NewCol(AppAPIConfigColumnTLSClientAuthSubjectDN, *e.TLSClientAuthSubjectDN))
+	}
+	if e.TLSClientAuthSAN != nil {
+		cols = append(cols, handler.NewCol(AppAPIConfigColumnTLSClientAuthSAN, *e.TLSClientAuthSAN))
+	}
 	if len(cols) == 0 {
 		return handler.NewNoOpStatement(e), nil
 	}
@@ -531,6 +567,12 @@ func (p *appProjection) reduceOIDCConfigAdded(event eventstore.Event) (*handler.
 				handler.NewCol(AppOIDCConfigColumnIOSBundleID, e.IOSBundleID),
 				handler.NewCol(AppOIDCConfigColumnAndroidPackageName, e.AndroidPackageName),
 				handler.NewCol(AppOIDCConfigColumnAndroidSHA256CertFingerprints, database.TextArray[string](e.AndroidSHA256CertFingerprints)),
+				handler.NewCol(AppOIDCConfigColumnDPoPRequired, e.DPoPRequired),
+				handler.NewCol(AppOIDCConfigColumnPARRequired, e.PARRequired),
+				handler.NewCol(AppOIDCConfigColumnTLSClientAuthSubjectDN, e.TLSClientAuthSubjectDN),
+				handler.NewCol(AppOIDCConfigColumnTLSClientAuthSAN, e.TLSClientAuthSAN),
+				handler.NewCol(AppOIDCConfigColumnBackChannelClientNotificationURI, e.BackChannelClientNotificationURI),
+				handler.NewCol(AppOIDCConfigColumnConsentRequired, e.ConsentRequired),
 			},
 			handler.WithTableSuffix(appOIDCTableSuffix),
 		),
@@ -620,6 +662,24 @@ func (p *appProjection) reduceOIDCConfigChanged(event eventstore.Event) (*handle
 	if e.AndroidSHA256CertFingerprints != nil {
 		cols = append(cols, handler.NewCol(AppOIDCConfigColumnAndroidSHA256CertFingerprints, database.TextArray[string](*e.AndroidSHA256CertFingerprints)))
 	}
+	if e.DPoPRequired != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnDPoPRequired, *e.DPoPRequired))
+	}
+	if e.PARRequired != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnPARRequired, *e.PARRequired))
+	}
+	if e.TLSClientAuthSubjectDN != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnTLSClientAuthSubjectDN, *e.TLSClientAuthSubjectDN))
+	}
+	if e.TLSClientAuthSAN != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnTLSClientAuthSAN, *e.TLSClientAuthSAN))
+	}
+	if e.BackChannelClientNotificationURI != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnBackChannelClientNotificationURI, *e.BackChannelClientNotificationURI))
+	}
+	if e.ConsentRequired != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnConsentRequired, *e.ConsentRequired))
+	}
 
 	if len(cols) == 0 {
 		return handler.NewNoOpStatement(e), nil
@@ -758,6 +818,10 @@ func (p *appProjection) reduceSAMLConfigAdded(event eventstore.Event) (*handler.
 	if !ok {
 		return nil, zerrors.ThrowInvalidArgument(nil, "HANDL-GMHU1", "reduce.wrong.event.type")
 	}
+	nameIDFormat := domain.SAMLNameIDFormatEmailAddress
+	if e.NameIDFormat != nil {
+		nameIDFormat = *e.NameIDFormat
+	}
 	return handler.NewMultiStatement(
 		e,
 		handler.AddCreateStatement(
@@ -769,6 +833,12 @@ func (p *appProjection) reduceSAMLConfigAdded(event eventstore.Event) (*handler.
 				handler.NewCol(AppSAMLConfigColumnMetadataURL, e.MetadataURL),
 				handler.NewCol(AppSAMLConfigColumnLoginVersion, e.LoginVersion),
 				handler.NewCol(AppSAMLConfigColumnLoginBaseURI, e.LoginBaseURI),
+				handler.NewCol(AppSAMLConfigColumnIDPInitiatedEnabled, e.IDPInitiatedEnabled),
+				handler.NewCol(AppSAMLConfigColumnIDPInitiatedRelayState, e.IDPInitiatedRelayState),
+				handler.NewCol(AppSAMLConfigColumnEncryptAssertion, e.EncryptAssertion),
+				handler.NewCol(AppSAMLConfigColumnNameIDFormat, nameIDFormat),
+				handler.NewCol(AppSAMLConfigColumnNameIDSource, e.NameIDSource),
+				handler.NewCol(AppSAMLConfigColumnSignatureAlgorithm, e.SignatureAlgorithm),
 			},
 			handler.WithTableSuffix(appSAMLTableSuffix),
 		),
@@ -791,7 +861,7 @@ func (p *appProjection) reduceSAMLConfigChanged(event eventstore.Event) (*handle
 		return nil, zerrors.ThrowInvalidArgument(nil, "HANDL-GMHU2", "reduce.wrong.event.type")
 	}
 
-	cols := make([]handler.Column, 0, 3)
+	cols := make([]handler.Column, 0, 11)
 	if e.Metadata != nil {
 		cols = append(cols, handler.NewCol(AppSAMLConfigColumnMetadata, e.Metadata))
 	}
@@ -807,6 +877,24 @@ func (p *appProjection) reduceSAMLConfigChanged(event eventstore.Event) (*handle
 	if e.LoginBaseURI != nil {
 		cols = append(cols, handler.NewCol(AppSAMLConfigColumnLoginBaseURI, *e.LoginBaseURI))
 	}
+	if e.IDPInitiatedEnabled != nil {
+		cols = append(cols, handler.NewCol(AppSAMLConfigColumnIDPInitiatedEnabled, *e.IDPInitiatedEnabled))
+	}
+	if e.IDPInitiatedRelayState != nil {
+		cols = append(cols, handler.NewCol(AppSAMLConfigColumnIDPInitiatedRelayState, *e.IDPInitiatedRelayState))
+	}
+	if e.EncryptAssertion != nil {
+		cols = append(cols, handler.NewCol(AppSAMLConfigColumnEncryptAssertion, *e.EncryptAssertion))
+	}
+	if e.NameIDFormat != nil {
+		cols = append(cols, handler.NewCol(AppSAMLConfigColumnNameIDFormat, *e.NameIDFormat))
+	}
+	if e.NameIDSource != nil {
+		cols = append(cols, handler.NewCol(AppSAMLConfigColumnNameIDSource, *e.NameIDSource))
+	}
+	if e.SignatureAlgorithm != nil {
+		cols = append(cols, handler.NewCol(AppSAMLConfigColumnSignatureAlgorithm, *e.SignatureAlgorithm))
+	}
 
 	if len(cols) == 0 {
 		return handler.NewNoOpStatement(e), nil
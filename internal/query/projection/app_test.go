@@ -285,13 +285,15 @@ func TestAppProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.apps7_api_configs (app_id, instance_id, client_id, client_secret, auth_method) VALUES ($1, $2, $3, $4, $5)",
+							expectedStmt: "INSERT INTO projections.apps7_api_configs (app_id, instance_id, client_id, client_secret, auth_method, tls_client_auth_subject_dn, tls_client_auth_san) VALUES ($1, $2, $3, $4, $5, $6, $7)",
 							expectedArgs: []interface{}{
 								"app-id",
 								"instance-id",
 								"client-id",
 								"secret",
 								domain.APIAuthMethodTypePrivateKeyJWT,
+								"",
+								"",
 							},
 						},
 						{
@@ -329,13 +331,15 @@ func TestAppProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.apps7_api_configs (app_id, instance_id, client_id, client_secret, auth_method) VALUES ($1, $2, $3, $4, $5)",
+							expectedStmt: "INSERT INTO projections.apps7_api_configs (app_id, instance_id, client_id, client_secret, auth_method, tls_client_auth_subject_dn, tls_client_auth_san) VALUES ($1, $2, $3, $4, $5, $6, $7)",
 							expectedArgs: []interface{}{
 								"app-id",
 								"instance-id",
 								"client-id",
 								"secret",
 								domain.APIAuthMethodTypePrivateKeyJWT,
+								"",
+								"",
 							},
 						},
 						{
@@ -413,6 +417,106 @@ func TestAppProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "project reduceSAMLConfigAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.SAMLConfigAddedType,
+						project.AggregateType,
+						[]byte(`{
+		            "appId": "app-id",
+					"entityId": "https://test.com/saml/metadata",
+					"metadata": "bWV0YWRhdGE=",
+					"idpInitiatedEnabled": true,
+					"idpInitiatedRelayState": "https://test.com/home",
+					"encryptAssertion": true,
+					"nameIdSource": 2,
+					"signatureAlgorithm": 3
+				}`),
+					), project.SAMLConfigAddedEventMapper),
+			},
+			reduce: (&appProjection{}).reduceSAMLConfigAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("project"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.apps7_saml_configs (app_id, instance_id, entity_id, metadata, metadata_url, login_version, login_base_uri, idp_initiated_enabled, idp_initiated_relay_state, encrypt_assertion, name_id_format, name_id_source, signature_algorithm) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)",
+							expectedArgs: []interface{}{
+								"app-id",
+								"instance-id",
+								"https://test.com/saml/metadata",
+								[]byte("metadata"),
+								"",
+								domain.LoginVersionUnspecified,
+								"",
+								true,
+								"https://test.com/home",
+								true,
+								domain.SAMLNameIDFormatEmailAddress,
+								domain.SAMLNameIDSourceUserID,
+								domain.SAMLSignatureAlgorithmRSASHA512,
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.apps7 SET (change_date, sequence) = ($1, $2) WHERE (id = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"app-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "project reduceSAMLConfigChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.SAMLConfigChangedType,
+						project.AggregateType,
+						[]byte(`{
+		            "appId": "app-id",
+					"encryptAssertion": false,
+					"nameIdFormat": 2,
+					"nameIdSource": 1
+				}`),
+					), project.SAMLConfigChangedEventMapper),
+			},
+			reduce: (&appProjection{}).reduceSAMLConfigChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("project"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.apps7_saml_configs SET (encrypt_assertion, name_id_format, name_id_source) = ($1, $2, $3) WHERE (app_id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								false,
+								domain.SAMLNameIDFormatPersistent,
+								domain.SAMLNameIDSourceEmail,
+								"app-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.apps7 SET (change_date, sequence) = ($1, $2) WHERE (id = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"app-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "project reduceAPIConfigSecretChanged, v1 secret",
 			args: args{
@@ -572,7 +676,7 @@ func TestAppProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.apps7_oidc_configs (app_id, instance_id, version, client_id, client_secret, redirect_uris, response_types, grant_types, application_type, auth_method_type, post_logout_redirect_uris, is_dev_mode, access_token_type, access_token_role_assertion, id_token_role_assertion, id_token_userinfo_assertion, clock_skew, additional_origins, skip_native_app_success_page, back_channel_logout_uri, login_version, login_base_uri, ios_team_id, ios_bundle_id, android_package_name, android_sha256_cert_fingerprints) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26)",
+							expectedStmt: "INSERT INTO projections.apps7_oidc_configs (app_id, instance_id, version, client_id, client_secret, redirect_uris, response_types, grant_types, application_type, auth_method_type, post_logout_redirect_uris, is_dev_mode, access_token_type, access_token_role_assertion, id_token_role_assertion, id_token_userinfo_assertion, clock_skew, additional_origins, skip_native_app_success_page, back_channel_logout_uri, login_version, login_base_uri, ios_team_id, ios_bundle_id, android_package_name, android_sha256_cert_fingerprints, dpop_required, par_required, tls_client_auth_subject_dn, tls_client_auth_san, back_channel_client_notification_uri, consent_required) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32)",
 							expectedArgs: []interface{}{
 								"app-id",
 								"instance-id",
@@ -600,6 +704,12 @@ func TestAppProjection_reduces(t *testing.T) {
 								"",
 								"",
 								database.TextArray[string](nil),
+								false,
+								false,
+								"",
+								"",
+								"",
+								false,
 							},
 						},
 						{
@@ -654,7 +764,7 @@ func TestAppProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.apps7_oidc_configs (app_id, instance_id, version, client_id, client_secret, redirect_uris, response_types, grant_types, application_type, auth_method_type, post_logout_redirect_uris, is_dev_mode, access_token_type, access_token_role_assertion, id_token_role_assertion, id_token_userinfo_assertion, clock_skew, additional_origins, skip_native_app_success_page, back_channel_logout_uri, login_version, login_base_uri, ios_team_id, ios_bundle_id, android_package_name, android_sha256_cert_fingerprints) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26)",
+							expectedStmt: "INSERT INTO projections.apps7_oidc_configs (app_id, instance_id, version, client_id, client_secret, redirect_uris, response_types, grant_types, application_type, auth_method_type, post_logout_redirect_uris, is_dev_mode, access_token_type, access_token_role_assertion, id_token_role_assertion, id_token_userinfo_assertion, clock_skew, additional_origins, skip_native_app_success_page, back_channel_logout_uri, login_version, login_base_uri, ios_team_id, ios_bundle_id, android_package_name, android_sha256_cert_fingerprints, dpop_required, par_required, tls_client_auth_subject_dn, tls_client_auth_san, back_channel_client_notification_uri, consent_required) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32)",
 							expectedArgs: []interface{}{
 								"app-id",
 								"instance-id",
@@ -682,6 +792,12 @@ func TestAppProjection_reduces(t *testing.T) {
 								"",
 								"",
 								database.TextArray[string](nil),
+								false,
+								false,
+								"",
+								"",
+								"",
+								false,
 							},
 						},
 						{
@@ -242,7 +242,7 @@ func (p *authNKeyProjection) reduceAuthNKeyEnabledChanged(event eventstore.Event
 			return handler.NewNoOpStatement(event), nil
 		}
 		appID = e.AppID
-		enabled = *e.AuthMethodType == domain.APIAuthMethodTypePrivateKeyJWT
+		enabled = e.AuthMethodType.UsesKeys()
 		changeDate = e.CreationDate()
 		sequence = e.Sequence()
 	case *project.OIDCConfigChangedEvent:
@@ -250,7 +250,7 @@ func (p *authNKeyProjection) reduceAuthNKeyEnabledChanged(event eventstore.Event
 			return handler.NewNoOpStatement(event), nil
 		}
 		appID = e.AppID
-		enabled = *e.AuthMethodType == domain.OIDCAuthMethodTypePrivateKeyJWT
+		enabled = e.AuthMethodType.UsesKeys()
 		changeDate = e.CreationDate()
 		sequence = e.Sequence()
 	default:
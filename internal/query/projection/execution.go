@@ -13,12 +13,13 @@ import (
 )
 
 const (
-	ExecutionTable           = "projections.executions1"
+	ExecutionTable           = "projections.executions2"
 	ExecutionIDCol           = "id"
 	ExecutionCreationDateCol = "creation_date"
 	ExecutionChangeDateCol   = "change_date"
 	ExecutionInstanceIDCol   = "instance_id"
 	ExecutionSequenceCol     = "sequence"
+	ExecutionFilterCol       = "filter"
 
 	ExecutionTargetSuffix         = "targets"
 	ExecutionTargetExecutionIDCol = "execution_id"
@@ -46,6 +47,7 @@ func (*executionProjection) Init() *old_handler.Check {
 			handler.NewColumn(ExecutionChangeDateCol, handler.ColumnTypeTimestamp),
 			handler.NewColumn(ExecutionSequenceCol, handler.ColumnTypeInt64),
 			handler.NewColumn(ExecutionInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(ExecutionFilterCol, handler.ColumnTypeText, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(ExecutionInstanceIDCol, ExecutionIDCol),
 		),
@@ -118,6 +120,7 @@ func (p *executionProjection) reduceExecutionSet(event eventstore.Event) (*handl
 				handler.NewCol(ExecutionCreationDateCol, handler.OnlySetValueOnInsert(ExecutionTable, e.CreationDate())),
 				handler.NewCol(ExecutionChangeDateCol, e.CreationDate()),
 				handler.NewCol(ExecutionSequenceCol, e.Sequence()),
+				handler.NewCol(ExecutionFilterCol, e.Filter),
 			},
 		),
 		// cleanup execution targets to re-insert them
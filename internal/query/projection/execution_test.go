@@ -28,7 +28,7 @@ func TestExecutionProjection_reduces(t *testing.T) {
 					testEvent(
 						exec.SetEventV2Type,
 						exec.AggregateType,
-						[]byte(`{"targets": [{"type":2,"target":"target"},{"type":1,"target":"include"}]}`),
+						[]byte(`{"targets": [{"type":2,"target":"target"},{"type":1,"target":"include"}], "filter": "payload.orgID == \"org\""}`),
 					),
 					eventstore.GenericEventMapper[exec.SetEventV2],
 				),
@@ -40,24 +40,25 @@ func TestExecutionProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.executions1 (instance_id, id, creation_date, change_date, sequence) VALUES ($1, $2, $3, $4, $5) ON CONFLICT (instance_id, id) DO UPDATE SET (creation_date, change_date, sequence) = (projections.executions1.creation_date, EXCLUDED.change_date, EXCLUDED.sequence)",
+							expectedStmt: "INSERT INTO projections.executions2 (instance_id, id, creation_date, change_date, sequence, filter) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT (instance_id, id) DO UPDATE SET (creation_date, change_date, sequence, filter) = (projections.executions2.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.filter)",
 							expectedArgs: []interface{}{
 								"instance-id",
 								"agg-id",
 								anyArg{},
 								anyArg{},
 								uint64(15),
+								"payload.orgID == \"org\"",
 							},
 						},
 						{
-							expectedStmt: "DELETE FROM projections.executions1_targets WHERE (instance_id = $1) AND (execution_id = $2)",
+							expectedStmt: "DELETE FROM projections.executions2_targets WHERE (instance_id = $1) AND (execution_id = $2)",
 							expectedArgs: []interface{}{
 								"instance-id",
 								"agg-id",
 							},
 						},
 						{
-							expectedStmt: "INSERT INTO projections.executions1_targets (instance_id, execution_id, position, include, target_id) VALUES ($1, $2, $3, $4, $5)",
+							expectedStmt: "INSERT INTO projections.executions2_targets (instance_id, execution_id, position, include, target_id) VALUES ($1, $2, $3, $4, $5)",
 							expectedArgs: []interface{}{
 								"instance-id",
 								"agg-id",
@@ -67,7 +68,7 @@ func TestExecutionProjection_reduces(t *testing.T) {
 							},
 						},
 						{
-							expectedStmt: "INSERT INTO projections.executions1_targets (instance_id, execution_id, position, include, target_id) VALUES ($1, $2, $3, $4, $5)",
+							expectedStmt: "INSERT INTO projections.executions2_targets (instance_id, execution_id, position, include, target_id) VALUES ($1, $2, $3, $4, $5)",
 							expectedArgs: []interface{}{
 								"instance-id",
 								"agg-id",
@@ -99,7 +100,7 @@ func TestExecutionProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "DELETE FROM projections.executions1_targets WHERE (instance_id = $1) AND (target_id = $2)",
+							expectedStmt: "DELETE FROM projections.executions2_targets WHERE (instance_id = $1) AND (target_id = $2)",
 							expectedArgs: []interface{}{
 								"instance-id",
 								"agg-id",
@@ -128,7 +129,7 @@ func TestExecutionProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "DELETE FROM projections.executions1 WHERE (instance_id = $1) AND (id = $2)",
+							expectedStmt: "DELETE FROM projections.executions2 WHERE (instance_id = $1) AND (id = $2)",
 							expectedArgs: []interface{}{
 								"instance-id",
 								"agg-id",
@@ -157,7 +158,7 @@ func TestExecutionProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "DELETE FROM projections.executions1 WHERE (instance_id = $1)",
+							expectedStmt: "DELETE FROM projections.executions2 WHERE (instance_id = $1)",
 							expectedArgs: []interface{}{
 								"agg-id",
 							},
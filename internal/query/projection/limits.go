@@ -2,12 +2,14 @@ package projection
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/zitadel/zitadel/internal/eventstore"
 	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/repository/instance"
 	"github.com/zitadel/zitadel/internal/repository/limits"
+	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 const (
@@ -22,6 +24,8 @@ const (
 
 	LimitsColumnAuditLogRetention = "audit_log_retention"
 	LimitsColumnBlock             = "block"
+	LimitsColumnRateLimits        = "rate_limits"
+	LimitsColumnArchiveHorizon    = "archive_horizon"
 )
 
 type limitsProjection struct{}
@@ -45,6 +49,8 @@ func (*limitsProjection) Init() *old_handler.Check {
 			handler.NewColumn(LimitsColumnSequence, handler.ColumnTypeInt64),
 			handler.NewColumn(LimitsColumnAuditLogRetention, handler.ColumnTypeInterval, handler.Nullable()),
 			handler.NewColumn(LimitsColumnBlock, handler.ColumnTypeBool, handler.Nullable()),
+			handler.NewColumn(LimitsColumnRateLimits, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(LimitsColumnArchiveHorizon, handler.ColumnTypeInterval, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(LimitsColumnInstanceID, LimitsColumnResourceOwner),
 		),
@@ -101,6 +107,16 @@ func (p *limitsProjection) reduceLimitsSet(event eventstore.Event) (*handler.Sta
 	if e.Block != nil {
 		updateCols = append(updateCols, handler.NewCol(LimitsColumnBlock, *e.Block))
 	}
+	if e.RateLimits != nil {
+		rateLimits, err := json.Marshal(e.RateLimits)
+		if err != nil {
+			return nil, zerrors.ThrowInternal(err, "HANDL-Wq3rb", "unable to marshal rate limits")
+		}
+		updateCols = append(updateCols, handler.NewCol(LimitsColumnRateLimits, rateLimits))
+	}
+	if e.ArchiveHorizon != nil {
+		updateCols = append(updateCols, handler.NewCol(LimitsColumnArchiveHorizon, *e.ArchiveHorizon))
+	}
 	return handler.NewUpsertStatement(e, conflictCols, updateCols), nil
 }
 
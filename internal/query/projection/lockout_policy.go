@@ -27,6 +27,8 @@ const (
 	LockoutPolicyMaxPasswordAttemptsCol = "max_password_attempts"
 	LockoutPolicyMaxOTPAttemptsCol      = "max_otp_attempts"
 	LockoutPolicyShowLockOutFailuresCol = "show_failure"
+	LockoutPolicyLockoutDurationCol     = "lockout_duration"
+	LockoutPolicyFailedAttemptDelayCol  = "failed_attempt_delay"
 )
 
 type lockoutPolicyProjection struct{}
@@ -53,6 +55,8 @@ func (*lockoutPolicyProjection) Init() *old_handler.Check {
 			handler.NewColumn(LockoutPolicyMaxPasswordAttemptsCol, handler.ColumnTypeInt64),
 			handler.NewColumn(LockoutPolicyMaxOTPAttemptsCol, handler.ColumnTypeInt64, handler.Default(0)),
 			handler.NewColumn(LockoutPolicyShowLockOutFailuresCol, handler.ColumnTypeBool),
+			handler.NewColumn(LockoutPolicyLockoutDurationCol, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(LockoutPolicyFailedAttemptDelayCol, handler.ColumnTypeInt64, handler.Default(0)),
 		},
 			handler.NewPrimaryKey(LockoutPolicyInstanceIDCol, LockoutPolicyIDCol),
 		),
@@ -126,6 +130,8 @@ func (p *lockoutPolicyProjection) reduceAdded(event eventstore.Event) (*handler.
 			handler.NewCol(LockoutPolicyMaxPasswordAttemptsCol, policyEvent.MaxPasswordAttempts),
 			handler.NewCol(LockoutPolicyMaxOTPAttemptsCol, policyEvent.MaxOTPAttempts),
 			handler.NewCol(LockoutPolicyShowLockOutFailuresCol, policyEvent.ShowLockOutFailures),
+			handler.NewCol(LockoutPolicyLockoutDurationCol, policyEvent.LockoutDuration),
+			handler.NewCol(LockoutPolicyFailedAttemptDelayCol, policyEvent.FailedAttemptDelay),
 			handler.NewCol(LockoutPolicyIsDefaultCol, isDefault),
 			handler.NewCol(LockoutPolicyResourceOwnerCol, policyEvent.Aggregate().ResourceOwner),
 			handler.NewCol(LockoutPolicyInstanceIDCol, policyEvent.Aggregate().InstanceID),
@@ -155,6 +161,12 @@ func (p *lockoutPolicyProjection) reduceChanged(event eventstore.Event) (*handle
 	if policyEvent.ShowLockOutFailures != nil {
 		cols = append(cols, handler.NewCol(LockoutPolicyShowLockOutFailuresCol, *policyEvent.ShowLockOutFailures))
 	}
+	if policyEvent.LockoutDuration != nil {
+		cols = append(cols, handler.NewCol(LockoutPolicyLockoutDurationCol, *policyEvent.LockoutDuration))
+	}
+	if policyEvent.FailedAttemptDelay != nil {
+		cols = append(cols, handler.NewCol(LockoutPolicyFailedAttemptDelayCol, *policyEvent.FailedAttemptDelay))
+	}
 	return handler.NewUpdateStatement(
 		&policyEvent,
 		cols,
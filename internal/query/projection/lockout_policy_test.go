@@ -2,6 +2,7 @@ package projection
 
 import (
 	"testing"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
@@ -31,7 +32,9 @@ func TestLockoutPolicyProjection_reduces(t *testing.T) {
 						[]byte(`{
 						"maxPasswordAttempts": 10,
 						"maxOTPAttempts": 10,
-						"showLockOutFailures": true
+						"showLockOutFailures": true,
+						"lockoutDuration": 900000000000,
+						"failedAttemptDelay": 1000000000
 }`),
 					), org.LockoutPolicyAddedEventMapper),
 			},
@@ -42,7 +45,7 @@ func TestLockoutPolicyProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.lockout_policies3 (creation_date, change_date, sequence, id, state, max_password_attempts, max_otp_attempts, show_failure, is_default, resource_owner, instance_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)",
+							expectedStmt: "INSERT INTO projections.lockout_policies3 (creation_date, change_date, sequence, id, state, max_password_attempts, max_otp_attempts, show_failure, lockout_duration, failed_attempt_delay, is_default, resource_owner, instance_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								anyArg{},
@@ -52,6 +55,8 @@ func TestLockoutPolicyProjection_reduces(t *testing.T) {
 								uint64(10),
 								uint64(10),
 								true,
+								15 * time.Minute,
+								time.Second,
 								false,
 								"ro-id",
 								"instance-id",
@@ -72,7 +77,9 @@ func TestLockoutPolicyProjection_reduces(t *testing.T) {
 						[]byte(`{
 						"maxPasswordAttempts": 10,
 						"maxOTPAttempts": 10,
-						"showLockOutFailures": true
+						"showLockOutFailures": true,
+						"lockoutDuration": 900000000000,
+						"failedAttemptDelay": 1000000000
 		}`),
 					), org.LockoutPolicyChangedEventMapper),
 			},
@@ -82,13 +89,15 @@ func TestLockoutPolicyProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE projections.lockout_policies3 SET (change_date, sequence, max_password_attempts, max_otp_attempts, show_failure) = ($1, $2, $3, $4, $5) WHERE (id = $6) AND (instance_id = $7)",
+							expectedStmt: "UPDATE projections.lockout_policies3 SET (change_date, sequence, max_password_attempts, max_otp_attempts, show_failure, lockout_duration, failed_attempt_delay) = ($1, $2, $3, $4, $5, $6, $7) WHERE (id = $8) AND (instance_id = $9)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								uint64(15),
 								uint64(10),
 								uint64(10),
 								true,
+								15 * time.Minute,
+								time.Second,
 								"agg-id",
 								"instance-id",
 							},
@@ -171,7 +180,7 @@ func TestLockoutPolicyProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.lockout_policies3 (creation_date, change_date, sequence, id, state, max_password_attempts, max_otp_attempts, show_failure, is_default, resource_owner, instance_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)",
+							expectedStmt: "INSERT INTO projections.lockout_policies3 (creation_date, change_date, sequence, id, state, max_password_attempts, max_otp_attempts, show_failure, lockout_duration, failed_attempt_delay, is_default, resource_owner, instance_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								anyArg{},
@@ -181,6 +190,8 @@ func TestLockoutPolicyProjection_reduces(t *testing.T) {
 								uint64(10),
 								uint64(10),
 								true,
+								time.Duration(0),
+								time.Duration(0),
 								true,
 								"ro-id",
 								"instance-id",
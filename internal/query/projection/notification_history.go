@@ -0,0 +1,195 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+const (
+	NotificationHistoryTable                  = "projections.notification_history"
+	NotificationHistoryIDCol                  = "id"
+	NotificationHistoryCreationDateCol        = "creation_date"
+	NotificationHistoryChangeDateCol          = "change_date"
+	NotificationHistoryInstanceIDCol          = "instance_id"
+	NotificationHistoryResourceOwnerCol       = "resource_owner"
+	NotificationHistorySequenceCol            = "sequence"
+	NotificationHistoryUserIDCol              = "user_id"
+	NotificationHistoryTriggeringEventTypeCol = "triggering_event_type"
+	NotificationHistoryMessageTypeCol         = "message_type"
+	NotificationHistoryNotificationTypeCol    = "notification_type"
+	NotificationHistoryProviderIDCol          = "provider_id"
+	NotificationHistoryDeliveryIDCol          = "delivery_id"
+	NotificationHistoryStateCol               = "state"
+	NotificationHistoryAttemptsCol            = "attempts"
+	NotificationHistoryLastErrorCol           = "last_error"
+)
+
+// notificationHistoryProjection stores a row per notification sent by the notification worker,
+// which is updated with the result of every attempt.
+type notificationHistoryProjection struct{}
+
+func newNotificationHistoryProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(notificationHistoryProjection))
+}
+
+func (*notificationHistoryProjection) Name() string {
+	return NotificationHistoryTable
+}
+
+func (*notificationHistoryProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(NotificationHistoryIDCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationHistoryCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(NotificationHistoryChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(NotificationHistoryInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationHistoryResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationHistorySequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(NotificationHistoryUserIDCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationHistoryTriggeringEventTypeCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationHistoryMessageTypeCol, handler.ColumnTypeText),
+			handler.NewColumn(NotificationHistoryNotificationTypeCol, handler.ColumnTypeEnum),
+			handler.NewColumn(NotificationHistoryProviderIDCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(NotificationHistoryDeliveryIDCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(NotificationHistoryStateCol, handler.ColumnTypeEnum),
+			handler.NewColumn(NotificationHistoryAttemptsCol, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(NotificationHistoryLastErrorCol, handler.ColumnTypeText, handler.Default("")),
+		},
+			handler.NewPrimaryKey(NotificationHistoryInstanceIDCol, NotificationHistoryIDCol),
+			handler.WithIndex(handler.NewIndex("user", []string{NotificationHistoryInstanceIDCol, NotificationHistoryUserIDCol})),
+			handler.WithIndex(handler.NewIndex("resource_owner", []string{NotificationHistoryResourceOwnerCol})),
+		),
+	)
+}
+
+func (p *notificationHistoryProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: notification.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  notification.AttemptSucceededEventType,
+					Reduce: p.reduceAttemptSucceeded,
+				},
+				{
+					Event:  notification.AttemptFailedEventType,
+					Reduce: p.reduceAttemptFailed,
+				},
+			},
+		},
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(NotificationHistoryInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *notificationHistoryProjection) reduceAttemptSucceeded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*notification.AttemptSucceededEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.upsertAttempt(e, &e.Info, domain.NotificationDeliveryStateDelivered,
+		handler.NewCol(NotificationHistoryDeliveryIDCol, e.DeliveryID),
+	), nil
+}
+
+func (p *notificationHistoryProjection) reduceAttemptFailed(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*notification.AttemptFailedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	state := domain.NotificationDeliveryStateRetrying
+	if e.Final {
+		state = domain.NotificationDeliveryStateFailed
+	}
+	return p.upsertAttempt(e, &e.Info, state,
+		handler.NewCol(NotificationHistoryLastErrorCol, e.Error),
+	), nil
+}
+
+// upsertAttempt creates the notification on the first attempt and updates it on every following attempt.
+// The error of a previous attempt is kept if the notification succeeds on a retry.
+func (p *notificationHistoryProjection) upsertAttempt(e eventstore.Event, info *notification.Info, state domain.NotificationDeliveryState, cols ...handler.Column) *handler.Statement {
+	return handler.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(NotificationHistoryInstanceIDCol, nil),
+			handler.NewCol(NotificationHistoryIDCol, nil),
+		},
+		append([]handler.Column{
+			handler.NewCol(NotificationHistoryInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(NotificationHistoryIDCol, e.Aggregate().ID),
+			handler.NewCol(NotificationHistoryCreationDateCol, handler.OnlySetValueOnInsert(NotificationHistoryTable, e.CreatedAt())),
+			handler.NewCol(NotificationHistoryChangeDateCol, e.CreatedAt()),
+			handler.NewCol(NotificationHistoryResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCol(NotificationHistorySequenceCol, e.Sequence()),
+			handler.NewCol(NotificationHistoryUserIDCol, info.UserID),
+			handler.NewCol(NotificationHistoryTriggeringEventTypeCol, info.TriggeringEventType),
+			handler.NewCol(NotificationHistoryMessageTypeCol, info.MessageType),
+			handler.NewCol(NotificationHistoryNotificationTypeCol, info.NotificationType),
+			handler.NewCol(NotificationHistoryProviderIDCol, info.ProviderID),
+			handler.NewCol(NotificationHistoryStateCol, state),
+			handler.NewCol(NotificationHistoryAttemptsCol, info.Attempt),
+		}, cols...),
+	)
+}
+
+func (p *notificationHistoryProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.UserRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(NotificationHistoryInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(NotificationHistoryUserIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *notificationHistoryProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(NotificationHistoryInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(NotificationHistoryResourceOwnerCol, e.Aggregate().ID),
+		},
+	), nil
+}
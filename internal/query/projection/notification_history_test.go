@@ -0,0 +1,243 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/notification"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestNotificationHistoryProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceAttemptSucceeded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						notification.AttemptSucceededEventType,
+						notification.AggregateType,
+						[]byte(`{"userId": "user-id", "triggeringEventType": "user.human.invite.code.added", "messageType": "InviteUser", "notificationType": 0, "providerId": "provider-id", "attempt": 2, "deliveryId": "delivery-id"}`),
+					),
+					eventstore.GenericEventMapper[notification.AttemptSucceededEvent],
+				),
+			},
+			reduce: (&notificationHistoryProjection{}).reduceAttemptSucceeded,
+			want: wantReduce{
+				aggregateType: notification.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.notification_history (instance_id, id, creation_date, change_date, resource_owner, sequence, user_id, triggering_event_type, message_type, notification_type, provider_id, state, attempts, delivery_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14) ON CONFLICT (instance_id, id) DO UPDATE SET (creation_date, change_date, resource_owner, sequence, user_id, triggering_event_type, message_type, notification_type, provider_id, state, attempts, delivery_id) = (projections.notification_history.creation_date, EXCLUDED.change_date, EXCLUDED.resource_owner, EXCLUDED.sequence, EXCLUDED.user_id, EXCLUDED.triggering_event_type, EXCLUDED.message_type, EXCLUDED.notification_type, EXCLUDED.provider_id, EXCLUDED.state, EXCLUDED.attempts, EXCLUDED.delivery_id)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								"ro-id",
+								uint64(15),
+								"user-id",
+								user.HumanInviteCodeAddedType,
+								domain.InviteUserMessageType,
+								domain.NotificationTypeEmail,
+								"provider-id",
+								domain.NotificationDeliveryStateDelivered,
+								2,
+								"delivery-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceAttemptFailed (retry)",
+			args: args{
+				event: getEvent(
+					testEvent(
+						notification.AttemptFailedEventType,
+						notification.AggregateType,
+						[]byte(`{"userId": "user-id", "triggeringEventType": "session.otp.sms.challenged", "messageType": "VerifySMSOTP", "notificationType": 1, "providerId": "provider-id", "attempt": 1, "error": "send error"}`),
+					),
+					eventstore.GenericEventMapper[notification.AttemptFailedEvent],
+				),
+			},
+			reduce: (&notificationHistoryProjection{}).reduceAttemptFailed,
+			want: wantReduce{
+				aggregateType: notification.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.notification_history (instance_id, id, creation_date, change_date, resource_owner, sequence, user_id, triggering_event_type, message_type, notification_type, provider_id, state, attempts, last_error) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14) ON CONFLICT (instance_id, id) DO UPDATE SET (creation_date, change_date, resource_owner, sequence, user_id, triggering_event_type, message_type, notification_type, provider_id, state, attempts, last_error) = (projections.notification_history.creation_date, EXCLUDED.change_date, EXCLUDED.resource_owner, EXCLUDED.sequence, EXCLUDED.user_id, EXCLUDED.triggering_event_type, EXCLUDED.message_type, EXCLUDED.notification_type, EXCLUDED.provider_id, EXCLUDED.state, EXCLUDED.attempts, EXCLUDED.last_error)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								"ro-id",
+								uint64(15),
+								"user-id",
+								eventstore.EventType("session.otp.sms.challenged"),
+								domain.VerifySMSOTPMessageType,
+								domain.NotificationTypeSms,
+								"provider-id",
+								domain.NotificationDeliveryStateRetrying,
+								1,
+								"send error",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceAttemptFailed (final)",
+			args: args{
+				event: getEvent(
+					testEvent(
+						notification.AttemptFailedEventType,
+						notification.AggregateType,
+						[]byte(`{"userId": "user-id", "triggeringEventType": "user.human.invite.code.added", "messageType": "InviteUser", "notificationType": 0, "providerId": "provider-id", "attempt": 3, "error": "send error", "final": true}`),
+					),
+					eventstore.GenericEventMapper[notification.AttemptFailedEvent],
+				),
+			},
+			reduce: (&notificationHistoryProjection{}).reduceAttemptFailed,
+			want: wantReduce{
+				aggregateType: notification.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.notification_history (instance_id, id, creation_date, change_date, resource_owner, sequence, user_id, triggering_event_type, message_type, notification_type, provider_id, state, attempts, last_error) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14) ON CONFLICT (instance_id, id) DO UPDATE SET (creation_date, change_date, resource_owner, sequence, user_id, triggering_event_type, message_type, notification_type, provider_id, state, attempts, last_error) = (projections.notification_history.creation_date, EXCLUDED.change_date, EXCLUDED.resource_owner, EXCLUDED.sequence, EXCLUDED.user_id, EXCLUDED.triggering_event_type, EXCLUDED.message_type, EXCLUDED.notification_type, EXCLUDED.provider_id, EXCLUDED.state, EXCLUDED.attempts, EXCLUDED.last_error)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								"ro-id",
+								uint64(15),
+								"user-id",
+								user.HumanInviteCodeAddedType,
+								domain.InviteUserMessageType,
+								domain.NotificationTypeEmail,
+								"provider-id",
+								domain.NotificationDeliveryStateFailed,
+								3,
+								"send error",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.UserRemovedType,
+						user.AggregateType,
+						nil,
+					), user.UserRemovedEventMapper),
+			},
+			reduce: (&notificationHistoryProjection{}).reduceUserRemoved,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.notification_history WHERE (instance_id = $1) AND (user_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					), org.OrgRemovedEventMapper),
+			},
+			reduce: (&notificationHistoryProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: org.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.notification_history WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(NotificationHistoryInstanceIDCol),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.notification_history WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, NotificationHistoryTable, tt.want)
+		})
+	}
+}
@@ -58,6 +58,7 @@ var (
 	PersonalAccessTokenProjection       *handler.Handler
 	UserGrantProjection                 *handler.Handler
 	UserMetadataProjection              *handler.Handler
+	UserEmailUndeliverableProjection    *handler.Handler
 	UserAuthMethodProjection            *handler.Handler
 	InstanceProjection                  *handler.Handler
 	SecretGeneratorProjection           *handler.Handler
@@ -82,6 +83,8 @@ var (
 	SystemFeatureProjection             *handler.Handler
 	InstanceFeatureProjection           *handler.Handler
 	TargetProjection                    *handler.Handler
+	TargetDeliveryProjection            *handler.Handler
+	NotificationHistoryProjection       *handler.Handler
 	ExecutionProjection                 *handler.Handler
 	UserSchemaProjection                *handler.Handler
 	WebKeyProjection                    *handler.Handler
@@ -99,6 +102,8 @@ var (
 
 	GroupProjection      *handler.Handler
 	GroupUsersProjection *handler.Handler
+
+	ProvisioningTargetProjection *handler.Handler
 )
 
 type projection interface {
@@ -169,6 +174,7 @@ func Create(ctx context.Context, sqlClient *database.DB, es handler.EventStore,
 	PersonalAccessTokenProjection = newPersonalAccessTokenProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["personal_access_tokens"]))
 	UserGrantProjection = newUserGrantProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_grants"]))
 	UserMetadataProjection = newUserMetadataProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_metadata"]))
+	UserEmailUndeliverableProjection = newUserEmailUndeliverableProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_email_undeliverables"]))
 	UserAuthMethodProjection = newUserAuthMethodProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_auth_method"]))
 	InstanceProjection = newInstanceProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["instances"]))
 	SecretGeneratorProjection = newSecretGeneratorProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["secret_generators"]))
@@ -190,6 +196,8 @@ func Create(ctx context.Context, sqlClient *database.DB, es handler.EventStore,
 	SystemFeatureProjection = newSystemFeatureProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["system_features"]))
 	InstanceFeatureProjection = newInstanceFeatureProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["instance_features"]))
 	TargetProjection = newTargetProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["targets"]))
+	TargetDeliveryProjection = newTargetDeliveryProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["target_deliveries"]))
+	NotificationHistoryProjection = newNotificationHistoryProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["notification_history"]))
 	ExecutionProjection = newExecutionProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["executions"]))
 	UserSchemaProjection = newUserSchemaProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_schemas"]))
 	WebKeyProjection = newWebKeyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["web_keys"]))
@@ -207,6 +215,8 @@ func Create(ctx context.Context, sqlClient *database.DB, es handler.EventStore,
 	GroupProjection = newGroupProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["groups"]))
 	GroupUsersProjection = newGroupUsersProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["group_users"]))
 
+	ProvisioningTargetProjection = newProvisioningTargetProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["provisioning_targets"]))
+
 	RelationalTablesProjection = newRelationalTablesProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["relational_tables"]))
 
 	newProjectionsList()
@@ -364,6 +374,7 @@ func newProjectionsList() {
 		PersonalAccessTokenProjection,
 		UserGrantProjection,
 		UserMetadataProjection,
+		UserEmailUndeliverableProjection,
 		UserAuthMethodProjection,
 		InstanceProjection,
 		SecretGeneratorProjection,
@@ -385,6 +396,8 @@ func newProjectionsList() {
 		SystemFeatureProjection,
 		InstanceFeatureProjection,
 		TargetProjection,
+		TargetDeliveryProjection,
+		NotificationHistoryProjection,
 		ExecutionProjection,
 		UserSchemaProjection,
 		WebKeyProjection,
@@ -393,6 +406,7 @@ func newProjectionsList() {
 		OrganizationSettingsProjection,
 		GroupProjection,
 		GroupUsersProjection,
+		ProvisioningTargetProjection,
 
 		RelationalTablesProjection,
 	}
@@ -0,0 +1,277 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/provisioning"
+)
+
+const (
+	ProvisioningTargetTable              = "projections.provisioning_targets"
+	ProvisioningTargetIDCol              = "id"
+	ProvisioningTargetCreationDateCol    = "creation_date"
+	ProvisioningTargetChangeDateCol      = "change_date"
+	ProvisioningTargetResourceOwnerCol   = "resource_owner"
+	ProvisioningTargetInstanceIDCol      = "instance_id"
+	ProvisioningTargetSequenceCol        = "sequence"
+	ProvisioningTargetNameCol            = "name"
+	ProvisioningTargetProjectIDCol       = "project_id"
+	ProvisioningTargetAppIDCol           = "app_id"
+	ProvisioningTargetEndpointCol        = "endpoint"
+	ProvisioningTargetTokenCol           = "token"
+	ProvisioningTargetSyncStatusCol      = "sync_status"
+	ProvisioningTargetSyncDateCol        = "sync_date"
+	ProvisioningTargetLastErrorCol       = "last_error"
+	ProvisioningTargetLastErrorUserIDCol = "last_error_user_id"
+)
+
+type provisioningTargetProjection struct{}
+
+func newProvisioningTargetProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(provisioningTargetProjection))
+}
+
+func (*provisioningTargetProjection) Name() string {
+	return ProvisioningTargetTable
+}
+
+func (*provisioningTargetProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(ProvisioningTargetIDCol, handler.ColumnTypeText),
+			handler.NewColumn(ProvisioningTargetCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(ProvisioningTargetChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(ProvisioningTargetResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(ProvisioningTargetInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(ProvisioningTargetSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(ProvisioningTargetNameCol, handler.ColumnTypeText),
+			handler.NewColumn(ProvisioningTargetProjectIDCol, handler.ColumnTypeText),
+			handler.NewColumn(ProvisioningTargetAppIDCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(ProvisioningTargetEndpointCol, handler.ColumnTypeText),
+			handler.NewColumn(ProvisioningTargetTokenCol, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(ProvisioningTargetSyncStatusCol, handler.ColumnTypeEnum, handler.Default(domain.ProvisioningStatusUnspecified)),
+			handler.NewColumn(ProvisioningTargetSyncDateCol, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(ProvisioningTargetLastErrorCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(ProvisioningTargetLastErrorUserIDCol, handler.ColumnTypeText, handler.Default("")),
+		},
+			handler.NewPrimaryKey(ProvisioningTargetInstanceIDCol, ProvisioningTargetIDCol),
+			handler.WithIndex(handler.NewIndex("project", []string{ProvisioningTargetInstanceIDCol, ProvisioningTargetProjectIDCol})),
+		),
+	)
+}
+
+func (p *provisioningTargetProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: provisioning.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  provisioning.AddedEventType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  provisioning.ChangedEventType,
+					Reduce: p.reduceChanged,
+				},
+				{
+					Event:  provisioning.SyncSucceededEventType,
+					Reduce: p.reduceSyncSucceeded,
+				},
+				{
+					Event:  provisioning.SyncFailedEventType,
+					Reduce: p.reduceSyncFailed,
+				},
+				{
+					Event:  provisioning.RemovedEventType,
+					Reduce: p.reduceRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: project.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  project.ApplicationRemovedType,
+					Reduce: p.reduceApplicationRemoved,
+				},
+				{
+					Event:  project.ProjectRemovedType,
+					Reduce: p.reduceProjectRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(ProvisioningTargetInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *provisioningTargetProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*provisioning.AddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(ProvisioningTargetInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(ProvisioningTargetResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCol(ProvisioningTargetIDCol, e.Aggregate().ID),
+			handler.NewCol(ProvisioningTargetCreationDateCol, handler.OnlySetValueOnInsert(ProvisioningTargetTable, e.CreationDate())),
+			handler.NewCol(ProvisioningTargetChangeDateCol, e.CreationDate()),
+			handler.NewCol(ProvisioningTargetSequenceCol, e.Sequence()),
+			handler.NewCol(ProvisioningTargetNameCol, e.Name),
+			handler.NewCol(ProvisioningTargetProjectIDCol, e.ProjectID),
+			handler.NewCol(ProvisioningTargetAppIDCol, e.AppID),
+			handler.NewCol(ProvisioningTargetEndpointCol, e.Endpoint),
+			handler.NewCol(ProvisioningTargetTokenCol, e.Token),
+		},
+	), nil
+}
+
+func (p *provisioningTargetProjection) reduceChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*provisioning.ChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	values := []handler.Column{
+		handler.NewCol(ProvisioningTargetChangeDateCol, e.CreationDate()),
+		handler.NewCol(ProvisioningTargetSequenceCol, e.Sequence()),
+	}
+	if e.Name != nil {
+		values = append(values, handler.NewCol(ProvisioningTargetNameCol, *e.Name))
+	}
+	if e.Endpoint != nil {
+		values = append(values, handler.NewCol(ProvisioningTargetEndpointCol, *e.Endpoint))
+	}
+	if e.Token != nil {
+		values = append(values, handler.NewCol(ProvisioningTargetTokenCol, e.Token))
+	}
+	return handler.NewUpdateStatement(
+		e,
+		values,
+		[]handler.Condition{
+			handler.NewCond(ProvisioningTargetInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(ProvisioningTargetIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *provisioningTargetProjection) reduceSyncSucceeded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*provisioning.SyncSucceededEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(ProvisioningTargetSequenceCol, e.Sequence()),
+			handler.NewCol(ProvisioningTargetSyncStatusCol, domain.ProvisioningStatusSynced),
+			handler.NewCol(ProvisioningTargetSyncDateCol, e.CreationDate()),
+		},
+		[]handler.Condition{
+			handler.NewCond(ProvisioningTargetInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(ProvisioningTargetIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *provisioningTargetProjection) reduceSyncFailed(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*provisioning.SyncFailedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(ProvisioningTargetSequenceCol, e.Sequence()),
+			handler.NewCol(ProvisioningTargetSyncStatusCol, domain.ProvisioningStatusFailed),
+			handler.NewCol(ProvisioningTargetSyncDateCol, e.CreationDate()),
+			handler.NewCol(ProvisioningTargetLastErrorCol, e.Error),
+			handler.NewCol(ProvisioningTargetLastErrorUserIDCol, e.UserID),
+		},
+		[]handler.Condition{
+			handler.NewCond(ProvisioningTargetInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(ProvisioningTargetIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *provisioningTargetProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*provisioning.RemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(ProvisioningTargetInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(ProvisioningTargetIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *provisioningTargetProjection) reduceApplicationRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.ApplicationRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(ProvisioningTargetInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(ProvisioningTargetProjectIDCol, e.Aggregate().ID),
+			handler.NewCond(ProvisioningTargetAppIDCol, e.AppID),
+		},
+	), nil
+}
+
+func (p *provisioningTargetProjection) reduceProjectRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.ProjectRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(ProvisioningTargetInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(ProvisioningTargetProjectIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *provisioningTargetProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(ProvisioningTargetInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(ProvisioningTargetResourceOwnerCol, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -0,0 +1,169 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/provisioning"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestProvisioningTargetProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						provisioning.AddedEventType,
+						provisioning.AggregateType,
+						[]byte(`{"name": "name", "projectId": "project-id", "appId": "app-id", "endpoint": "https://example.com/scim/v2", "token": { "cryptoType": 0, "algorithm": "aes", "keyId": "key-id" }}`),
+					),
+					eventstore.GenericEventMapper[provisioning.AddedEvent],
+				),
+			},
+			reduce: (&provisioningTargetProjection{}).reduceAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("provisioning_target"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.provisioning_targets (instance_id, resource_owner, id, creation_date, change_date, sequence, name, project_id, app_id, endpoint, token) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"ro-id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"name",
+								"project-id",
+								"app-id",
+								"https://example.com/scim/v2",
+								anyArg{},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSyncFailed",
+			args: args{
+				event: getEvent(
+					testEvent(
+						provisioning.SyncFailedEventType,
+						provisioning.AggregateType,
+						[]byte(`{"userId": "user-id", "error": "unavailable"}`),
+					),
+					eventstore.GenericEventMapper[provisioning.SyncFailedEvent],
+				),
+			},
+			reduce: (&provisioningTargetProjection{}).reduceSyncFailed,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("provisioning_target"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.provisioning_targets SET (sequence, sync_status, sync_date, last_error, last_error_user_id) = ($1, $2, $3, $4, $5) WHERE (instance_id = $6) AND (id = $7)",
+							expectedArgs: []interface{}{
+								uint64(15),
+								domain.ProvisioningStatusFailed,
+								anyArg{},
+								"unavailable",
+								"user-id",
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						provisioning.RemovedEventType,
+						provisioning.AggregateType,
+						[]byte(`{}`),
+					),
+					eventstore.GenericEventMapper[provisioning.RemovedEvent],
+				),
+			},
+			reduce: (&provisioningTargetProjection{}).reduceRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("provisioning_target"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.provisioning_targets WHERE (instance_id = $1) AND (id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceApplicationRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.ApplicationRemovedType,
+						project.AggregateType,
+						[]byte(`{"appId": "app-id"}`),
+					),
+					project.ApplicationRemovedEventMapper,
+				),
+			},
+			reduce: (&provisioningTargetProjection{}).reduceApplicationRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("project"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.provisioning_targets WHERE (instance_id = $1) AND (project_id = $2) AND (app_id = $3)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"app-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, ProvisioningTargetTable, tt.want)
+		})
+	}
+}
@@ -820,11 +820,12 @@ func (p *relationalTablesProjection) reducePasswordComplexityPolicyAdded(event e
 				UpdatedAt:      policyEvent.Creation,
 			},
 			PasswordComplexitySettingsAttributes: domain.PasswordComplexitySettingsAttributes{
-				MinLength:    &policyEvent.MinLength,
-				HasLowercase: &policyEvent.HasLowercase,
-				HasUppercase: &policyEvent.HasUppercase,
-				HasNumber:    &policyEvent.HasNumber,
-				HasSymbol:    &policyEvent.HasSymbol,
+				MinLength:     &policyEvent.MinLength,
+				HasLowercase:  &policyEvent.HasLowercase,
+				HasUppercase:  &policyEvent.HasUppercase,
+				HasNumber:     &policyEvent.HasNumber,
+				HasSymbol:     &policyEvent.HasSymbol,
+				CheckBreached: &policyEvent.CheckBreached,
 			},
 		}
 		return settingsRepo.Set(ctx, v3_sql.SQLTx(tx), &settings)
@@ -858,11 +859,12 @@ func (p *relationalTablesProjection) reducePasswordComplexityPolicyChanged(event
 				UpdatedAt:      policyEvent.Creation,
 			},
 			PasswordComplexitySettingsAttributes: domain.PasswordComplexitySettingsAttributes{
-				MinLength:    policyEvent.MinLength,
-				HasLowercase: policyEvent.HasLowercase,
-				HasUppercase: policyEvent.HasUppercase,
-				HasNumber:    policyEvent.HasNumber,
-				HasSymbol:    policyEvent.HasSymbol,
+				MinLength:     policyEvent.MinLength,
+				HasLowercase:  policyEvent.HasLowercase,
+				HasUppercase:  policyEvent.HasUppercase,
+				HasNumber:     policyEvent.HasNumber,
+				HasSymbol:     policyEvent.HasSymbol,
+				CheckBreached: policyEvent.CheckBreached,
 			},
 		}
 		return settingsRepo.Set(ctx, v3_sql.SQLTx(tx), &settings)
@@ -1027,6 +1029,8 @@ func (p *relationalTablesProjection) reduceLockoutPolicyAdded(event eventstore.E
 				MaxPasswordAttempts: &policyEvent.MaxPasswordAttempts,
 				MaxOTPAttempts:      &policyEvent.MaxOTPAttempts,
 				ShowLockOutFailures: &policyEvent.ShowLockOutFailures,
+				LockoutDuration:     &policyEvent.LockoutDuration,
+				FailedAttemptDelay:  &policyEvent.FailedAttemptDelay,
 			},
 		}
 		return settingsRepo.Set(ctx, v3_sql.SQLTx(tx), &settings)
@@ -1064,6 +1068,8 @@ func (p *relationalTablesProjection) reduceLockoutPolicyChanged(event eventstore
 				MaxPasswordAttempts: policyEvent.MaxPasswordAttempts,
 				MaxOTPAttempts:      policyEvent.MaxOTPAttempts,
 				ShowLockOutFailures: policyEvent.ShowLockOutFailures,
+				LockoutDuration:     policyEvent.LockoutDuration,
+				FailedAttemptDelay:  policyEvent.FailedAttemptDelay,
 			},
 		}
 		return settingsRepo.Set(ctx, v3_sql.SQLTx(tx), &settings)
@@ -14,6 +14,8 @@ const (
 	SMSConfigProjectionTable = "projections.sms_configs3"
 	SMSTwilioTable           = SMSConfigProjectionTable + "_" + smsTwilioTableSuffix
 	SMSHTTPTable             = SMSConfigProjectionTable + "_" + smsHTTPTableSuffix
+	SMSVonageTable           = SMSConfigProjectionTable + "_" + smsVonageTableSuffix
+	SMSSNSTable              = SMSConfigProjectionTable + "_" + smsSNSTableSuffix
 
 	SMSColumnID            = "id"
 	SMSColumnAggregateID   = "aggregate_id"
@@ -38,6 +40,21 @@ const (
 	SMSHTTPColumnInstanceID = "instance_id"
 	SMSHTTPColumnEndpoint   = "endpoint"
 	SMSHTTPColumnSigningKey = "signing_key"
+
+	smsVonageTableSuffix        = "vonage"
+	SMSVonageColumnSMSID        = "sms_id"
+	SMSVonageColumnInstanceID   = "instance_id"
+	SMSVonageColumnAPIKey       = "api_key"
+	SMSVonageColumnAPISecret    = "api_secret"
+	SMSVonageColumnSenderNumber = "sender_number"
+
+	smsSNSTableSuffix           = "sns"
+	SMSSNSColumnSMSID           = "sms_id"
+	SMSSNSColumnInstanceID      = "instance_id"
+	SMSSNSColumnAccessKeyID     = "access_key_id"
+	SMSSNSColumnSecretAccessKey = "secret_access_key"
+	SMSSNSColumnRegion          = "region"
+	SMSSNSColumnSenderID        = "sender_id"
 )
 
 type smsConfigProjection struct{}
@@ -87,6 +104,29 @@ func (*smsConfigProjection) Init() *old_handler.Check {
 			smsHTTPTableSuffix,
 			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
 		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(SMSVonageColumnSMSID, handler.ColumnTypeText),
+			handler.NewColumn(SMSVonageColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(SMSVonageColumnAPIKey, handler.ColumnTypeText),
+			handler.NewColumn(SMSVonageColumnAPISecret, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(SMSVonageColumnSenderNumber, handler.ColumnTypeText),
+		},
+			handler.NewPrimaryKey(SMSVonageColumnInstanceID, SMSVonageColumnSMSID),
+			smsVonageTableSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(SMSSNSColumnSMSID, handler.ColumnTypeText),
+			handler.NewColumn(SMSSNSColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(SMSSNSColumnAccessKeyID, handler.ColumnTypeText),
+			handler.NewColumn(SMSSNSColumnSecretAccessKey, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(SMSSNSColumnRegion, handler.ColumnTypeText),
+			handler.NewColumn(SMSSNSColumnSenderID, handler.ColumnTypeText),
+		},
+			handler.NewPrimaryKey(SMSSNSColumnInstanceID, SMSSNSColumnSMSID),
+			smsSNSTableSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
 	)
 }
 
@@ -115,6 +155,30 @@ func (p *smsConfigProjection) Reducers() []handler.AggregateReducer {
 					Event:  instance.SMSConfigHTTPChangedEventType,
 					Reduce: p.reduceSMSConfigHTTPChanged,
 				},
+				{
+					Event:  instance.SMSConfigVonageAddedEventType,
+					Reduce: p.reduceSMSConfigVonageAdded,
+				},
+				{
+					Event:  instance.SMSConfigVonageChangedEventType,
+					Reduce: p.reduceSMSConfigVonageChanged,
+				},
+				{
+					Event:  instance.SMSConfigVonageSecretChangedEventType,
+					Reduce: p.reduceSMSConfigVonageSecretChanged,
+				},
+				{
+					Event:  instance.SMSConfigSNSAddedEventType,
+					Reduce: p.reduceSMSConfigSNSAdded,
+				},
+				{
+					Event:  instance.SMSConfigSNSChangedEventType,
+					Reduce: p.reduceSMSConfigSNSChanged,
+				},
+				{
+					Event:  instance.SMSConfigSNSSecretChangedEventType,
+					Reduce: p.reduceSMSConfigSNSSecretChanged,
+				},
 				{
 					Event:  instance.SMSConfigTwilioActivatedEventType,
 					Reduce: p.reduceSMSConfigTwilioActivated,
@@ -338,6 +402,226 @@ func (p *smsConfigProjection) reduceSMSConfigHTTPChanged(event eventstore.Event)
 	return handler.NewMultiStatement(e, stmts...), nil
 }
 
+func (p *smsConfigProjection) reduceSMSConfigVonageAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMSConfigVonageAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewMultiStatement(
+		e,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnID, e.ID),
+				handler.NewCol(SMSColumnAggregateID, e.Aggregate().ID),
+				handler.NewCol(SMSColumnCreationDate, e.CreationDate()),
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnResourceOwner, e.Aggregate().ResourceOwner),
+				handler.NewCol(SMSColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMSColumnState, domain.SMSConfigStateInactive),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+				handler.NewCol(SMSColumnDescription, e.Description),
+			},
+		),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSVonageColumnSMSID, e.ID),
+				handler.NewCol(SMSVonageColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMSVonageColumnAPIKey, e.APIKey),
+				handler.NewCol(SMSVonageColumnAPISecret, e.APISecret),
+				handler.NewCol(SMSVonageColumnSenderNumber, e.SenderNumber),
+			},
+			handler.WithTableSuffix(smsVonageTableSuffix),
+		),
+	), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigVonageChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMSConfigVonageChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	stmts := make([]func(eventstore.Event) handler.Exec, 0, 2)
+	columns := []handler.Column{
+		handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+		handler.NewCol(SMSColumnSequence, e.Sequence()),
+	}
+	if e.Description != nil {
+		columns = append(columns, handler.NewCol(SMSColumnDescription, *e.Description))
+	}
+	stmts = append(stmts, handler.AddUpdateStatement(
+		columns,
+		[]handler.Condition{
+			handler.NewCond(SMSColumnID, e.ID),
+			handler.NewCond(SMSColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	))
+
+	vonageColumns := make([]handler.Column, 0, 2)
+	if e.APIKey != nil {
+		vonageColumns = append(vonageColumns, handler.NewCol(SMSVonageColumnAPIKey, *e.APIKey))
+	}
+	if e.SenderNumber != nil {
+		vonageColumns = append(vonageColumns, handler.NewCol(SMSVonageColumnSenderNumber, *e.SenderNumber))
+	}
+	if len(vonageColumns) > 0 {
+		stmts = append(stmts, handler.AddUpdateStatement(
+			vonageColumns,
+			[]handler.Condition{
+				handler.NewCond(SMSVonageColumnSMSID, e.ID),
+				handler.NewCond(SMSVonageColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(smsVonageTableSuffix),
+		))
+	}
+
+	return handler.NewMultiStatement(e, stmts...), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigVonageSecretChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMSConfigVonageSecretChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewMultiStatement(
+		e,
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSVonageColumnAPISecret, e.APISecret),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMSVonageColumnSMSID, e.ID),
+				handler.NewCond(SMSVonageColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(smsVonageTableSuffix),
+		),
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMSColumnID, e.ID),
+				handler.NewCond(SMSColumnInstanceID, e.Aggregate().InstanceID),
+			},
+		),
+	), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigSNSAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMSConfigSNSAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewMultiStatement(
+		e,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnID, e.ID),
+				handler.NewCol(SMSColumnAggregateID, e.Aggregate().ID),
+				handler.NewCol(SMSColumnCreationDate, e.CreationDate()),
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnResourceOwner, e.Aggregate().ResourceOwner),
+				handler.NewCol(SMSColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMSColumnState, domain.SMSConfigStateInactive),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+				handler.NewCol(SMSColumnDescription, e.Description),
+			},
+		),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSSNSColumnSMSID, e.ID),
+				handler.NewCol(SMSSNSColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMSSNSColumnAccessKeyID, e.AccessKeyID),
+				handler.NewCol(SMSSNSColumnSecretAccessKey, e.SecretAccessKey),
+				handler.NewCol(SMSSNSColumnRegion, e.Region),
+				handler.NewCol(SMSSNSColumnSenderID, e.SenderID),
+			},
+			handler.WithTableSuffix(smsSNSTableSuffix),
+		),
+	), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigSNSChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMSConfigSNSChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	stmts := make([]func(eventstore.Event) handler.Exec, 0, 2)
+	columns := []handler.Column{
+		handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+		handler.NewCol(SMSColumnSequence, e.Sequence()),
+	}
+	if e.Description != nil {
+		columns = append(columns, handler.NewCol(SMSColumnDescription, *e.Description))
+	}
+	stmts = append(stmts, handler.AddUpdateStatement(
+		columns,
+		[]handler.Condition{
+			handler.NewCond(SMSColumnID, e.ID),
+			handler.NewCond(SMSColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	))
+
+	snsColumns := make([]handler.Column, 0, 3)
+	if e.AccessKeyID != nil {
+		snsColumns = append(snsColumns, handler.NewCol(SMSSNSColumnAccessKeyID, *e.AccessKeyID))
+	}
+	if e.Region != nil {
+		snsColumns = append(snsColumns, handler.NewCol(SMSSNSColumnRegion, *e.Region))
+	}
+	if e.SenderID != nil {
+		snsColumns = append(snsColumns, handler.NewCol(SMSSNSColumnSenderID, *e.SenderID))
+	}
+	if len(snsColumns) > 0 {
+		stmts = append(stmts, handler.AddUpdateStatement(
+			snsColumns,
+			[]handler.Condition{
+				handler.NewCond(SMSSNSColumnSMSID, e.ID),
+				handler.NewCond(SMSSNSColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(smsSNSTableSuffix),
+		))
+	}
+
+	return handler.NewMultiStatement(e, stmts...), nil
+}
+
+func (p *smsConfigProjection) reduceSMSConfigSNSSecretChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMSConfigSNSSecretChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewMultiStatement(
+		e,
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSSNSColumnSecretAccessKey, e.SecretAccessKey),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMSSNSColumnSMSID, e.ID),
+				handler.NewCond(SMSSNSColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(smsSNSTableSuffix),
+		),
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMSColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMSColumnSequence, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMSColumnID, e.ID),
+				handler.NewCond(SMSColumnInstanceID, e.Aggregate().InstanceID),
+			},
+		),
+	), nil
+}
+
 func (p *smsConfigProjection) reduceSMSConfigTwilioActivated(event eventstore.Event) (*handler.Statement, error) {
 	e, err := assertEvent[*instance.SMSConfigTwilioActivatedEvent](event)
 	if err != nil {
@@ -496,6 +496,242 @@ func TestSMSProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "instance reduceSMSVonageAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.SMSConfigVonageAddedEventType,
+						instance.AggregateType,
+						[]byte(`{
+						"id": "id",
+						"description": "description",
+						"apiKey": "api-key",
+						"apiSecret": { "cryptoType": 0, "algorithm": "RSA-265", "keyId": "key-id" },
+						"senderNumber": "sender-number"
+					}`),
+					), eventstore.GenericEventMapper[instance.SMSConfigVonageAddedEvent]),
+			},
+			reduce: (&smsConfigProjection{}).reduceSMSConfigVonageAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.sms_configs3 (id, aggregate_id, creation_date, change_date, resource_owner, instance_id, state, sequence, description) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+							expectedArgs: []interface{}{
+								"id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								"ro-id",
+								"instance-id",
+								domain.SMSConfigStateInactive,
+								uint64(15),
+								"description",
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.sms_configs3_vonage (sms_id, instance_id, api_key, api_secret, sender_number) VALUES ($1, $2, $3, $4, $5)",
+							expectedArgs: []interface{}{
+								"id",
+								"instance-id",
+								"api-key",
+								anyArg{},
+								"sender-number",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceSMSConfigVonageChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.SMSConfigVonageChangedEventType,
+						instance.AggregateType,
+						[]byte(`{
+						"id": "id",
+						"description": "description",
+						"apiKey": "api-key",
+						"senderNumber": "sender-number"
+					}`),
+					), eventstore.GenericEventMapper[instance.SMSConfigVonageChangedEvent]),
+			},
+			reduce: (&smsConfigProjection{}).reduceSMSConfigVonageChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.sms_configs3 SET (change_date, sequence, description) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"description",
+								"id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.sms_configs3_vonage SET (api_key, sender_number) = ($1, $2) WHERE (sms_id = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
+								"api-key",
+								"sender-number",
+								"id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceSMSConfigVonageSecretChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.SMSConfigVonageSecretChangedEventType,
+						instance.AggregateType,
+						[]byte(`{
+						"id": "id",
+						"apiSecret": {
+							"cryptoType": 0,
+							"algorithm": "RSA-265",
+							"keyId": "key-id",
+							"crypted": "Y3J5cHRlZA=="
+						}
+					}`),
+					), eventstore.GenericEventMapper[instance.SMSConfigVonageSecretChangedEvent]),
+			},
+			reduce: (&smsConfigProjection{}).reduceSMSConfigVonageSecretChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.sms_configs3_vonage SET api_secret = $1 WHERE (sms_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								&crypto.CryptoValue{
+									CryptoType: crypto.TypeEncryption,
+									Algorithm:  "RSA-265",
+									KeyID:      "key-id",
+									Crypted:    []byte("crypted"),
+								},
+								"id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.sms_configs3 SET (change_date, sequence) = ($1, $2) WHERE (id = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceSMSSNSAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.SMSConfigSNSAddedEventType,
+						instance.AggregateType,
+						[]byte(`{
+						"id": "id",
+						"description": "description",
+						"accessKeyId": "access-key-id",
+						"secretAccessKey": { "cryptoType": 0, "algorithm": "RSA-265", "keyId": "key-id" },
+						"region": "eu-central-1",
+						"senderId": "sender-id"
+					}`),
+					), eventstore.GenericEventMapper[instance.SMSConfigSNSAddedEvent]),
+			},
+			reduce: (&smsConfigProjection{}).reduceSMSConfigSNSAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.sms_configs3 (id, aggregate_id, creation_date, change_date, resource_owner, instance_id, state, sequence, description) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+							expectedArgs: []interface{}{
+								"id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								"ro-id",
+								"instance-id",
+								domain.SMSConfigStateInactive,
+								uint64(15),
+								"description",
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.sms_configs3_sns (sms_id, instance_id, access_key_id, secret_access_key, region, sender_id) VALUES ($1, $2, $3, $4, $5, $6)",
+							expectedArgs: []interface{}{
+								"id",
+								"instance-id",
+								"access-key-id",
+								anyArg{},
+								"eu-central-1",
+								"sender-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceSMSConfigSNSChanged, only region",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.SMSConfigSNSChangedEventType,
+						instance.AggregateType,
+						[]byte(`{
+						"id": "id",
+						"region": "us-east-1"
+					}`),
+					), eventstore.GenericEventMapper[instance.SMSConfigSNSChangedEvent]),
+			},
+			reduce: (&smsConfigProjection{}).reduceSMSConfigSNSChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.sms_configs3 SET (change_date, sequence) = ($1, $2) WHERE (id = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.sms_configs3_sns SET region = $1 WHERE (sms_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								"us-east-1",
+								"id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "instance reduceSMSConfigTwilioActivated",
 			args: args{
@@ -14,6 +14,7 @@ const (
 	SMTPConfigProjectionTable = "projections.smtp_configs6"
 	SMTPConfigTable           = SMTPConfigProjectionTable + "_" + smtpConfigSMTPTableSuffix
 	SMTPConfigHTTPTable       = SMTPConfigProjectionTable + "_" + smtpConfigHTTPTableSuffix
+	SMTPConfigAPITable        = SMTPConfigProjectionTable + "_" + smtpConfigAPITableSuffix
 
 	SMTPConfigColumnInstanceID    = "instance_id"
 	SMTPConfigColumnResourceOwner = "resource_owner"
@@ -45,6 +46,18 @@ const (
 	SMTPConfigHTTPColumnID         = "id"
 	SMTPConfigHTTPColumnEndpoint   = "endpoint"
 	SMTPConfigHTTPColumnSigningKey = "signing_key"
+
+	smtpConfigAPITableSuffix          = "api"
+	SMTPConfigAPIColumnInstanceID     = "instance_id"
+	SMTPConfigAPIColumnID             = "id"
+	SMTPConfigAPIColumnProvider       = "provider"
+	SMTPConfigAPIColumnSenderAddress  = "sender_address"
+	SMTPConfigAPIColumnSenderName     = "sender_name"
+	SMTPConfigAPIColumnReplyToAddress = "reply_to_address"
+	SMTPConfigAPIColumnRegion         = "region"
+	SMTPConfigAPIColumnDomain         = "domain"
+	SMTPConfigAPIColumnAccessKeyID    = "access_key_id"
+	SMTPConfigAPIColumnAPIKey         = "api_key"
 )
 
 type smtpConfigProjection struct{}
@@ -101,6 +114,22 @@ func (*smtpConfigProjection) Init() *old_handler.Check {
 			smtpConfigHTTPTableSuffix,
 			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
 		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(SMTPConfigAPIColumnID, handler.ColumnTypeText),
+			handler.NewColumn(SMTPConfigAPIColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(SMTPConfigAPIColumnProvider, handler.ColumnTypeEnum),
+			handler.NewColumn(SMTPConfigAPIColumnSenderAddress, handler.ColumnTypeText),
+			handler.NewColumn(SMTPConfigAPIColumnSenderName, handler.ColumnTypeText),
+			handler.NewColumn(SMTPConfigAPIColumnReplyToAddress, handler.ColumnTypeText),
+			handler.NewColumn(SMTPConfigAPIColumnRegion, handler.ColumnTypeText),
+			handler.NewColumn(SMTPConfigAPIColumnDomain, handler.ColumnTypeText),
+			handler.NewColumn(SMTPConfigAPIColumnAccessKeyID, handler.ColumnTypeText),
+			handler.NewColumn(SMTPConfigAPIColumnAPIKey, handler.ColumnTypeJSONB, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(SMTPConfigAPIColumnInstanceID, SMTPConfigAPIColumnID),
+			smtpConfigAPITableSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
 	)
 }
 
@@ -121,6 +150,10 @@ func (p *smtpConfigProjection) Reducers() []handler.AggregateReducer {
 					Event:  instance.SMTPConfigPasswordChangedEventType,
 					Reduce: p.reduceSMTPConfigPasswordChanged,
 				},
+				{
+					Event:  instance.SMTPConfigSecretsReEncryptedType,
+					Reduce: p.reduceSMTPConfigSecretsReEncrypted,
+				},
 				{
 					Event:  instance.SMTPConfigHTTPAddedEventType,
 					Reduce: p.reduceSMTPConfigHTTPAdded,
@@ -129,6 +162,14 @@ func (p *smtpConfigProjection) Reducers() []handler.AggregateReducer {
 					Event:  instance.SMTPConfigHTTPChangedEventType,
 					Reduce: p.reduceSMTPConfigHTTPChanged,
 				},
+				{
+					Event:  instance.SMTPConfigAPIAddedEventType,
+					Reduce: p.reduceSMTPConfigAPIAdded,
+				},
+				{
+					Event:  instance.SMTPConfigAPIChangedEventType,
+					Reduce: p.reduceSMTPConfigAPIChanged,
+				},
 				{
 					Event:  instance.SMTPConfigActivatedEventType,
 					Reduce: p.reduceSMTPConfigActivated,
@@ -306,6 +347,103 @@ func (p *smtpConfigProjection) reduceSMTPConfigHTTPChanged(event eventstore.Even
 	return handler.NewMultiStatement(e, stmts...), nil
 }
 
+func (p *smtpConfigProjection) reduceSMTPConfigAPIAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMTPConfigAPIAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewMultiStatement(
+		e,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMTPConfigColumnCreationDate, e.CreationDate()),
+				handler.NewCol(SMTPConfigColumnChangeDate, e.CreationDate()),
+				handler.NewCol(SMTPConfigColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMTPConfigColumnResourceOwner, e.Aggregate().ResourceOwner),
+				handler.NewCol(SMTPConfigColumnAggregateID, e.Aggregate().ID),
+				handler.NewCol(SMTPConfigColumnID, e.ID),
+				handler.NewCol(SMTPConfigColumnSequence, e.Sequence()),
+				handler.NewCol(SMTPConfigColumnState, domain.SMTPConfigStateInactive),
+				handler.NewCol(SMTPConfigColumnDescription, e.Description),
+			},
+		),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(SMTPConfigAPIColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(SMTPConfigAPIColumnID, e.ID),
+				handler.NewCol(SMTPConfigAPIColumnProvider, e.Provider),
+				handler.NewCol(SMTPConfigAPIColumnSenderAddress, e.SenderAddress),
+				handler.NewCol(SMTPConfigAPIColumnSenderName, e.SenderName),
+				handler.NewCol(SMTPConfigAPIColumnReplyToAddress, e.ReplyToAddress),
+				handler.NewCol(SMTPConfigAPIColumnRegion, e.Region),
+				handler.NewCol(SMTPConfigAPIColumnDomain, e.Domain),
+				handler.NewCol(SMTPConfigAPIColumnAccessKeyID, e.AccessKeyID),
+				handler.NewCol(SMTPConfigAPIColumnAPIKey, e.APIKey),
+			},
+			handler.WithTableSuffix(smtpConfigAPITableSuffix),
+		),
+	), nil
+}
+
+func (p *smtpConfigProjection) reduceSMTPConfigAPIChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMTPConfigAPIChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	stmts := make([]func(eventstore.Event) handler.Exec, 0, 2)
+	columns := []handler.Column{
+		handler.NewCol(SMTPConfigColumnChangeDate, e.CreationDate()),
+		handler.NewCol(SMTPConfigColumnSequence, e.Sequence()),
+	}
+	if e.Description != nil {
+		columns = append(columns, handler.NewCol(SMTPConfigColumnDescription, *e.Description))
+	}
+	stmts = append(stmts, handler.AddUpdateStatement(
+		columns,
+		[]handler.Condition{
+			handler.NewCond(SMTPConfigColumnID, e.ID),
+			handler.NewCond(SMTPConfigColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	))
+
+	apiColumns := make([]handler.Column, 0, 7)
+	if e.SenderAddress != nil {
+		apiColumns = append(apiColumns, handler.NewCol(SMTPConfigAPIColumnSenderAddress, *e.SenderAddress))
+	}
+	if e.SenderName != nil {
+		apiColumns = append(apiColumns, handler.NewCol(SMTPConfigAPIColumnSenderName, *e.SenderName))
+	}
+	if e.ReplyToAddress != nil {
+		apiColumns = append(apiColumns, handler.NewCol(SMTPConfigAPIColumnReplyToAddress, *e.ReplyToAddress))
+	}
+	if e.Region != nil {
+		apiColumns = append(apiColumns, handler.NewCol(SMTPConfigAPIColumnRegion, *e.Region))
+	}
+	if e.Domain != nil {
+		apiColumns = append(apiColumns, handler.NewCol(SMTPConfigAPIColumnDomain, *e.Domain))
+	}
+	if e.AccessKeyID != nil {
+		apiColumns = append(apiColumns, handler.NewCol(SMTPConfigAPIColumnAccessKeyID, *e.AccessKeyID))
+	}
+	if e.APIKey != nil {
+		apiColumns = append(apiColumns, handler.NewCol(SMTPConfigAPIColumnAPIKey, e.APIKey))
+	}
+	if len(apiColumns) > 0 {
+		stmts = append(stmts, handler.AddUpdateStatement(
+			apiColumns,
+			[]handler.Condition{
+				handler.NewCond(SMTPConfigAPIColumnID, e.ID),
+				handler.NewCond(SMTPConfigAPIColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(smtpConfigAPITableSuffix),
+		))
+	}
+
+	return handler.NewMultiStatement(e, stmts...), nil
+}
+
 func (p *smtpConfigProjection) reduceSMTPConfigChanged(event eventstore.Event) (*handler.Statement, error) {
 	e, err := assertEvent[*instance.SMTPConfigChangedEvent](event)
 	if err != nil {
@@ -417,6 +555,70 @@ func (p *smtpConfigProjection) reduceSMTPConfigPasswordChanged(event eventstore.
 	), nil
 }
 
+func (p *smtpConfigProjection) reduceSMTPConfigSecretsReEncrypted(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.SMTPConfigSecretsReEncryptedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	id := getSMTPConfigID(e.ID, e.Aggregate())
+	stmts := make([]func(eventstore.Event) handler.Exec, 0, 4)
+	stmts = append(stmts, handler.AddUpdateStatement(
+		[]handler.Column{
+			handler.NewCol(SMTPConfigColumnChangeDate, e.CreationDate()),
+			handler.NewCol(SMTPConfigColumnSequence, e.Sequence()),
+		},
+		[]handler.Condition{
+			handler.NewCond(SMTPConfigColumnID, id),
+			handler.NewCond(SMTPConfigColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	))
+
+	smtpColumns := make([]handler.Column, 0, 2)
+	if e.Password != nil {
+		smtpColumns = append(smtpColumns, handler.NewCol(SMTPConfigSMTPColumnPlainAuthPassword, e.Password))
+	}
+	if e.ClientSecret != nil {
+		smtpColumns = append(smtpColumns, handler.NewCol(SMTPConfigSMTPColumnXOAuth2AuthClientCredentialsClientSecret, e.ClientSecret))
+	}
+	if len(smtpColumns) > 0 {
+		stmts = append(stmts, handler.AddUpdateStatement(
+			smtpColumns,
+			[]handler.Condition{
+				handler.NewCond(SMTPConfigSMTPColumnID, id),
+				handler.NewCond(SMTPConfigSMTPColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(smtpConfigSMTPTableSuffix),
+		))
+	}
+	if e.SigningKey != nil {
+		stmts = append(stmts, handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMTPConfigHTTPColumnSigningKey, e.SigningKey),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMTPConfigHTTPColumnID, id),
+				handler.NewCond(SMTPConfigHTTPColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(smtpConfigHTTPTableSuffix),
+		))
+	}
+	if e.APIKey != nil {
+		stmts = append(stmts, handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SMTPConfigAPIColumnAPIKey, e.APIKey),
+			},
+			[]handler.Condition{
+				handler.NewCond(SMTPConfigAPIColumnID, id),
+				handler.NewCond(SMTPConfigAPIColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(smtpConfigAPITableSuffix),
+		))
+	}
+
+	return handler.NewMultiStatement(e, stmts...), nil
+}
+
 func (p *smtpConfigProjection) reduceSMTPConfigActivated(event eventstore.Event) (*handler.Statement, error) {
 	e, err := assertEvent[*instance.SMTPConfigActivatedEvent](event)
 	if err != nil {
@@ -567,6 +567,155 @@ func TestSMTPConfigProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "reduceSMTPConfigAPIAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.SMTPConfigAPIAddedEventType,
+						instance.AggregateType,
+						[]byte(`{
+						"instance_id": "instance-id",
+						"resource_owner": "ro-id",
+						"aggregate_id": "agg-id",
+						"id": "config-id",
+						"description": "test",
+						"provider": 3,
+						"senderAddress": "sender",
+						"senderName": "name",
+						"replyToAddress": "reply-to",
+						"region": "eu",
+						"domain": "mg.domain.ch",
+						"apiKey": { "cryptoType": 0, "algorithm": "RSA-265", "keyId": "key-id" }
+					}`),
+					), eventstore.GenericEventMapper[instance.SMTPConfigAPIAddedEvent]),
+			},
+			reduce: (&smtpConfigProjection{}).reduceSMTPConfigAPIAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.smtp_configs6 (creation_date, change_date, instance_id, resource_owner, aggregate_id, id, sequence, state, description) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								anyArg{},
+								"instance-id",
+								"ro-id",
+								"agg-id",
+								"config-id",
+								uint64(15),
+								domain.SMTPConfigStateInactive,
+								"test",
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.smtp_configs6_api (instance_id, id, provider, sender_address, sender_name, reply_to_address, region, domain, access_key_id, api_key) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"config-id",
+								domain.EmailAPIProviderTypeMailgun,
+								"sender",
+								"name",
+								"reply-to",
+								"eu",
+								"mg.domain.ch",
+								"",
+								anyArg{},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSMTPConfigAPIChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.SMTPConfigAPIChangedEventType,
+						instance.AggregateType,
+						[]byte(`{
+						"instance_id": "instance-id",
+						"resource_owner": "ro-id",
+						"aggregate_id": "agg-id",
+						"id": "config-id",
+						"description": "test",
+						"senderAddress": "sender",
+						"region": "eu-central-1",
+						"accessKeyId": "access-key-id",
+						"apiKey": { "cryptoType": 0, "algorithm": "RSA-265", "keyId": "key-id" }
+					}`),
+					), eventstore.GenericEventMapper[instance.SMTPConfigAPIChangedEvent]),
+			},
+			reduce: (&smtpConfigProjection{}).reduceSMTPConfigAPIChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.smtp_configs6 SET (change_date, sequence, description) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"test",
+								"config-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.smtp_configs6_api SET (sender_address, region, access_key_id, api_key) = ($1, $2, $3, $4) WHERE (id = $5) AND (instance_id = $6)",
+							expectedArgs: []interface{}{
+								"sender",
+								"eu-central-1",
+								"access-key-id",
+								anyArg{},
+								"config-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceSMTPConfigAPIChanged, description",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.SMTPConfigAPIChangedEventType,
+						instance.AggregateType,
+						[]byte(`{
+						"instance_id": "instance-id",
+						"resource_owner": "ro-id",
+						"aggregate_id": "agg-id",
+						"id": "config-id",
+						"description": "test"
+					}`),
+					), eventstore.GenericEventMapper[instance.SMTPConfigAPIChangedEvent]),
+			},
+			reduce: (&smtpConfigProjection{}).reduceSMTPConfigAPIChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.smtp_configs6 SET (change_date, sequence, description) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"test",
+								"config-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "reduceSMTPConfigActivated",
 			args: args{
@@ -770,6 +919,66 @@ func TestSMTPConfigProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "reduceSMTPConfigSecretsReEncrypted",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.SMTPConfigSecretsReEncryptedType,
+						instance.AggregateType,
+						[]byte(`{
+						"instance_id": "instance-id",
+						"resource_owner": "ro-id",
+						"aggregate_id": "agg-id",
+						"id": "config-id",
+						"password": {
+							"cryptoType": 0,
+							"algorithm": "RSA-265",
+							"keyId": "key-id"
+						},
+						"apiKey": {
+							"cryptoType": 0,
+							"algorithm": "RSA-265",
+							"keyId": "key-id"
+						}
+					}`),
+					), eventstore.GenericEventMapper[instance.SMTPConfigSecretsReEncryptedEvent]),
+			},
+			reduce: (&smtpConfigProjection{}).reduceSMTPConfigSecretsReEncrypted,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.smtp_configs6 SET (change_date, sequence) = ($1, $2) WHERE (id = $3) AND (instance_id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"config-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.smtp_configs6_smtp SET password = $1 WHERE (id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								"config-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.smtp_configs6_api SET api_key = $1 WHERE (id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								"config-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "reduceSMTPConfigRemoved",
 			args: args{
@@ -26,6 +26,8 @@ const (
 	TargetInterruptOnErrorCol = "interrupt_on_error"
 	TargetSigningKey          = "signing_key"
 	TargetPayloadType         = "payload_type"
+	TargetClientCertificate   = "client_certificate"
+	TargetRetryPolicy         = "retry_policy"
 )
 
 type targetProjection struct{}
@@ -54,6 +56,8 @@ func (*targetProjection) Init() *old_handler.Check {
 			handler.NewColumn(TargetInterruptOnErrorCol, handler.ColumnTypeBool),
 			handler.NewColumn(TargetSigningKey, handler.ColumnTypeJSONB, handler.Nullable()),
 			handler.NewColumn(TargetPayloadType, handler.ColumnTypeEnum, handler.Default(target_domain.PayloadTypeUnspecified)),
+			handler.NewColumn(TargetClientCertificate, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(TargetRetryPolicy, handler.ColumnTypeJSONB, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(TargetInstanceIDCol, TargetIDCol),
 		),
@@ -112,6 +116,8 @@ func (p *targetProjection) reduceTargetAdded(event eventstore.Event) (*handler.S
 			handler.NewCol(TargetInterruptOnErrorCol, e.InterruptOnError),
 			handler.NewCol(TargetSigningKey, e.SigningKey),
 			handler.NewCol(TargetPayloadType, e.PayloadType),
+			handler.NewCol(TargetClientCertificate, e.ClientCertificate),
+			handler.NewCol(TargetRetryPolicy, e.RetryPolicy),
 		},
 	), nil
 }
@@ -147,6 +153,12 @@ func (p *targetProjection) reduceTargetChanged(event eventstore.Event) (*handler
 	if e.PayloadType != target_domain.PayloadTypeUnspecified {
 		values = append(values, handler.NewCol(TargetPayloadType, e.PayloadType))
 	}
+	if e.ClientCertificate != nil {
+		values = append(values, handler.NewCol(TargetClientCertificate, e.ClientCertificate))
+	}
+	if e.RetryPolicy != nil {
+		values = append(values, handler.NewCol(TargetRetryPolicy, e.RetryPolicy))
+	}
 	return handler.NewUpdateStatement(
 		e,
 		values,
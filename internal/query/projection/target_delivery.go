@@ -0,0 +1,180 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/target"
+)
+
+const (
+	TargetDeliveryTable            = "projections.target_deliveries"
+	TargetDeliveryIDCol            = "id"
+	TargetDeliveryCreationDateCol  = "creation_date"
+	TargetDeliveryChangeDateCol    = "change_date"
+	TargetDeliveryInstanceIDCol    = "instance_id"
+	TargetDeliverySequenceCol      = "sequence"
+	TargetDeliveryTargetIDCol      = "target_id"
+	TargetDeliveryEventTypeCol     = "event_type"
+	TargetDeliveryAggregateTypeCol = "aggregate_type"
+	TargetDeliveryAggregateIDCol   = "aggregate_id"
+	TargetDeliveryRequestCol       = "request"
+	TargetDeliveryLastErrorCol     = "last_error"
+	TargetDeliveryAttemptsCol      = "attempts"
+	TargetDeliveryStateCol         = "state"
+)
+
+type targetDeliveryProjection struct{}
+
+func newTargetDeliveryProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(targetDeliveryProjection))
+}
+
+func (*targetDeliveryProjection) Name() string {
+	return TargetDeliveryTable
+}
+
+func (*targetDeliveryProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(TargetDeliveryIDCol, handler.ColumnTypeText),
+			handler.NewColumn(TargetDeliveryCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(TargetDeliveryChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(TargetDeliveryInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(TargetDeliverySequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(TargetDeliveryTargetIDCol, handler.ColumnTypeText),
+			handler.NewColumn(TargetDeliveryEventTypeCol, handler.ColumnTypeText),
+			handler.NewColumn(TargetDeliveryAggregateTypeCol, handler.ColumnTypeText),
+			handler.NewColumn(TargetDeliveryAggregateIDCol, handler.ColumnTypeText),
+			handler.NewColumn(TargetDeliveryRequestCol, handler.ColumnTypeJSONB),
+			handler.NewColumn(TargetDeliveryLastErrorCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(TargetDeliveryAttemptsCol, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(TargetDeliveryStateCol, handler.ColumnTypeEnum),
+		},
+			handler.NewPrimaryKey(TargetDeliveryInstanceIDCol, TargetDeliveryIDCol),
+			handler.WithIndex(handler.NewIndex("target", []string{TargetDeliveryInstanceIDCol, TargetDeliveryTargetIDCol})),
+		),
+	)
+}
+
+func (p *targetDeliveryProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: target.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  target.DeliveryFailedEventType,
+					Reduce: p.reduceDeliveryFailed,
+				},
+				{
+					Event:  target.DeliveryRedriveRequestedEventType,
+					Reduce: p.reduceDeliveryRedriveRequested,
+				},
+				{
+					Event:  target.DeliverySucceededEventType,
+					Reduce: p.reduceDeliverySucceeded,
+				},
+				{
+					Event:  target.RemovedEventType,
+					Reduce: p.reduceTargetRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(TargetDeliveryInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *targetDeliveryProjection) reduceDeliveryFailed(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*target.DeliveryFailedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	var eventType, aggregateType, aggregateID string
+	if e.Request != nil {
+		eventType = string(e.Request.EventType)
+		if e.Request.Aggregate != nil {
+			aggregateType = string(e.Request.Aggregate.Type)
+			aggregateID = e.Request.Aggregate.ID
+		}
+	}
+	// a failed re-drive updates the existing delivery
+	return handler.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(TargetDeliveryInstanceIDCol, nil),
+			handler.NewCol(TargetDeliveryIDCol, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(TargetDeliveryInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(TargetDeliveryIDCol, e.DeliveryID),
+			handler.NewCol(TargetDeliveryCreationDateCol, handler.OnlySetValueOnInsert(TargetDeliveryTable, e.CreationDate())),
+			handler.NewCol(TargetDeliveryChangeDateCol, e.CreationDate()),
+			handler.NewCol(TargetDeliverySequenceCol, e.Sequence()),
+			handler.NewCol(TargetDeliveryTargetIDCol, e.Aggregate().ID),
+			handler.NewCol(TargetDeliveryEventTypeCol, eventType),
+			handler.NewCol(TargetDeliveryAggregateTypeCol, aggregateType),
+			handler.NewCol(TargetDeliveryAggregateIDCol, aggregateID),
+			handler.NewCol(TargetDeliveryRequestCol, e.Request),
+			handler.NewCol(TargetDeliveryLastErrorCol, e.Error),
+			handler.NewCol(TargetDeliveryAttemptsCol, e.Attempts),
+			handler.NewCol(TargetDeliveryStateCol, domain.TargetDeliveryStateFailed),
+		},
+	), nil
+}
+
+func (p *targetDeliveryProjection) reduceDeliveryRedriveRequested(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*target.DeliveryRedriveRequestedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.updateState(e, e.DeliveryID, domain.TargetDeliveryStateRedriving), nil
+}
+
+func (p *targetDeliveryProjection) reduceDeliverySucceeded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*target.DeliverySucceededEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.updateState(e, e.DeliveryID, domain.TargetDeliveryStateDelivered), nil
+}
+
+func (p *targetDeliveryProjection) updateState(e eventstore.Event, deliveryID string, state domain.TargetDeliveryState) *handler.Statement {
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(TargetDeliveryChangeDateCol, e.CreatedAt()),
+			handler.NewCol(TargetDeliverySequenceCol, e.Sequence()),
+			handler.NewCol(TargetDeliveryStateCol, state),
+		},
+		[]handler.Condition{
+			handler.NewCond(TargetDeliveryInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(TargetDeliveryIDCol, deliveryID),
+		},
+	)
+}
+
+func (p *targetDeliveryProjection) reduceTargetRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*target.RemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(TargetDeliveryInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(TargetDeliveryTargetIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
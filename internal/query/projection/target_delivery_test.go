@@ -0,0 +1,170 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/target"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestTargetDeliveryProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceDeliveryFailed",
+			args: args{
+				event: getEvent(
+					testEvent(
+						target.DeliveryFailedEventType,
+						target.AggregateType,
+						[]byte(`{"deliveryId": "delivery-id", "request": {"aggregate": {"id": "user-id", "type": "user"}, "eventType": "user.human.added"}, "error": "unavailable", "attempts": 3}`),
+					),
+					eventstore.GenericEventMapper[target.DeliveryFailedEvent],
+				),
+			},
+			reduce: (&targetDeliveryProjection{}).reduceDeliveryFailed,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("target"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.target_deliveries (instance_id, id, creation_date, change_date, sequence, target_id, event_type, aggregate_type, aggregate_id, request, last_error, attempts, state) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13) ON CONFLICT (instance_id, id) DO UPDATE SET (creation_date, change_date, sequence, target_id, event_type, aggregate_type, aggregate_id, request, last_error, attempts, state) = (projections.target_deliveries.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.target_id, EXCLUDED.event_type, EXCLUDED.aggregate_type, EXCLUDED.aggregate_id, EXCLUDED.request, EXCLUDED.last_error, EXCLUDED.attempts, EXCLUDED.state)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"delivery-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"agg-id",
+								"user.human.added",
+								"user",
+								"user-id",
+								anyArg{},
+								"unavailable",
+								uint8(3),
+								domain.TargetDeliveryStateFailed,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceDeliveryRedriveRequested",
+			args: args{
+				event: getEvent(
+					testEvent(
+						target.DeliveryRedriveRequestedEventType,
+						target.AggregateType,
+						[]byte(`{"deliveryId": "delivery-id", "request": {"aggregate": {"id": "user-id", "type": "user"}, "eventType": "user.human.added"}}`),
+					),
+					eventstore.GenericEventMapper[target.DeliveryRedriveRequestedEvent],
+				),
+			},
+			reduce: (&targetDeliveryProjection{}).reduceDeliveryRedriveRequested,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("target"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.target_deliveries SET (change_date, sequence, state) = ($1, $2, $3) WHERE (instance_id = $4) AND (id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.TargetDeliveryStateRedriving,
+								"instance-id",
+								"delivery-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceDeliverySucceeded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						target.DeliverySucceededEventType,
+						target.AggregateType,
+						[]byte(`{"deliveryId": "delivery-id"}`),
+					),
+					eventstore.GenericEventMapper[target.DeliverySucceededEvent],
+				),
+			},
+			reduce: (&targetDeliveryProjection{}).reduceDeliverySucceeded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("target"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.target_deliveries SET (change_date, sequence, state) = ($1, $2, $3) WHERE (instance_id = $4) AND (id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								domain.TargetDeliveryStateDelivered,
+								"instance-id",
+								"delivery-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceTargetRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						target.RemovedEventType,
+						target.AggregateType,
+						[]byte(`{"name": "name"}`),
+					),
+					eventstore.GenericEventMapper[target.RemovedEvent],
+				),
+			},
+			reduce: (&targetDeliveryProjection{}).reduceTargetRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("target"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.target_deliveries WHERE (instance_id = $1) AND (target_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, TargetDeliveryTable, tt.want)
+		})
+	}
+}
@@ -30,6 +30,7 @@ const (
 	UserInstanceIDCol    = "instance_id"
 	UserUsernameCol      = "username"
 	UserTypeCol          = "type"
+	UserLockedUntilCol   = "locked_until"
 
 	UserHumanSuffix             = "humans"
 	HumanUserIDCol              = "user_id"
@@ -98,6 +99,7 @@ func (*userProjection) Init() *old_handler.Check {
 			handler.NewColumn(UserInstanceIDCol, handler.ColumnTypeText),
 			handler.NewColumn(UserUsernameCol, handler.ColumnTypeText),
 			handler.NewColumn(UserTypeCol, handler.ColumnTypeEnum),
+			handler.NewColumn(UserLockedUntilCol, handler.ColumnTypeTimestamp, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(UserInstanceIDCol, UserIDCol),
 			handler.WithIndex(handler.NewIndex("username", []string{UserUsernameCol})),
@@ -501,6 +503,7 @@ func (p *userProjection) reduceUserLocked(event eventstore.Event) (*handler.Stat
 		[]handler.Column{
 			handler.NewCol(UserChangeDateCol, e.CreationDate()),
 			handler.NewCol(UserStateCol, domain.UserStateLocked),
+			handler.NewCol(UserLockedUntilCol, e.LockedUntil),
 			handler.NewCol(UserSequenceCol, e.Sequence()),
 		},
 		[]handler.Condition{
@@ -521,6 +524,7 @@ func (p *userProjection) reduceUserUnlocked(event eventstore.Event) (*handler.St
 		[]handler.Column{
 			handler.NewCol(UserChangeDateCol, e.CreationDate()),
 			handler.NewCol(UserStateCol, domain.UserStateActive),
+			handler.NewCol(UserLockedUntilCol, nil),
 			handler.NewCol(UserSequenceCol, e.Sequence()),
 		},
 		[]handler.Condition{
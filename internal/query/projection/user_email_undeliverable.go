@@ -0,0 +1,175 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	UserEmailUndeliverableProjectionTable = "projections.user_email_undeliverables"
+
+	UserEmailUndeliverableColumnUserID        = "user_id"
+	UserEmailUndeliverableColumnInstanceID    = "instance_id"
+	UserEmailUndeliverableColumnResourceOwner = "resource_owner"
+	UserEmailUndeliverableColumnCreationDate  = "creation_date"
+	UserEmailUndeliverableColumnChangeDate    = "change_date"
+	UserEmailUndeliverableColumnSequence      = "sequence"
+	UserEmailUndeliverableColumnEmail         = "email"
+	UserEmailUndeliverableColumnReason        = "reason"
+	UserEmailUndeliverableColumnDescription   = "description"
+	UserEmailUndeliverableColumnProviderID    = "provider_id"
+	UserEmailUndeliverableColumnDeliveryID    = "delivery_id"
+)
+
+// userEmailUndeliverableProjection keeps track of the users, whose current email address
+// was reported as undeliverable by an email provider.
+// The entry is removed as soon as the address is changed or verified again.
+type userEmailUndeliverableProjection struct{}
+
+func newUserEmailUndeliverableProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(userEmailUndeliverableProjection))
+}
+
+func (*userEmailUndeliverableProjection) Name() string {
+	return UserEmailUndeliverableProjectionTable
+}
+
+func (*userEmailUndeliverableProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(UserEmailUndeliverableColumnUserID, handler.ColumnTypeText),
+			handler.NewColumn(UserEmailUndeliverableColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(UserEmailUndeliverableColumnResourceOwner, handler.ColumnTypeText),
+			handler.NewColumn(UserEmailUndeliverableColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(UserEmailUndeliverableColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(UserEmailUndeliverableColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(UserEmailUndeliverableColumnEmail, handler.ColumnTypeText),
+			handler.NewColumn(UserEmailUndeliverableColumnReason, handler.ColumnTypeEnum),
+			handler.NewColumn(UserEmailUndeliverableColumnDescription, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(UserEmailUndeliverableColumnProviderID, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(UserEmailUndeliverableColumnDeliveryID, handler.ColumnTypeText, handler.Default("")),
+		},
+			handler.NewPrimaryKey(UserEmailUndeliverableColumnInstanceID, UserEmailUndeliverableColumnUserID),
+			handler.WithIndex(handler.NewIndex("resource_owner", []string{UserEmailUndeliverableColumnResourceOwner})),
+		),
+	)
+}
+
+func (p *userEmailUndeliverableProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.HumanEmailUndeliverableType,
+					Reduce: p.reduceEmailUndeliverable,
+				},
+				{
+					Event:  user.HumanEmailChangedType,
+					Reduce: p.reduceEmailDeliverable,
+				},
+				{
+					Event:  user.UserV1EmailChangedType,
+					Reduce: p.reduceEmailDeliverable,
+				},
+				{
+					Event:  user.HumanEmailVerifiedType,
+					Reduce: p.reduceEmailDeliverable,
+				},
+				{
+					Event:  user.UserV1EmailVerifiedType,
+					Reduce: p.reduceEmailDeliverable,
+				},
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceEmailDeliverable,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(UserEmailUndeliverableColumnInstanceID),
+				},
+			},
+		},
+	}
+}
+
+func (p *userEmailUndeliverableProjection) reduceEmailUndeliverable(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanEmailUndeliverableEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ue4nd", "reduce.wrong.event.type %s", user.HumanEmailUndeliverableType)
+	}
+	return handler.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(UserEmailUndeliverableColumnInstanceID, nil),
+			handler.NewCol(UserEmailUndeliverableColumnUserID, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(UserEmailUndeliverableColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCol(UserEmailUndeliverableColumnUserID, e.Aggregate().ID),
+			handler.NewCol(UserEmailUndeliverableColumnResourceOwner, e.Aggregate().ResourceOwner),
+			handler.NewCol(UserEmailUndeliverableColumnCreationDate, handler.OnlySetValueOnInsert(UserEmailUndeliverableProjectionTable, e.CreationDate())),
+			handler.NewCol(UserEmailUndeliverableColumnChangeDate, e.CreationDate()),
+			handler.NewCol(UserEmailUndeliverableColumnSequence, e.Sequence()),
+			handler.NewCol(UserEmailUndeliverableColumnEmail, e.EmailAddress),
+			handler.NewCol(UserEmailUndeliverableColumnReason, e.Reason),
+			handler.NewCol(UserEmailUndeliverableColumnDescription, e.Description),
+			handler.NewCol(UserEmailUndeliverableColumnProviderID, e.ProviderID),
+			handler.NewCol(UserEmailUndeliverableColumnDeliveryID, e.DeliveryID),
+		},
+	), nil
+}
+
+func (p *userEmailUndeliverableProjection) reduceEmailDeliverable(event eventstore.Event) (*handler.Statement, error) {
+	switch event.(type) {
+	case *user.HumanEmailChangedEvent,
+		*user.HumanEmailVerifiedEvent,
+		*user.UserRemovedEvent:
+		//ok
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ue5nd", "reduce.wrong.event.type %v", []eventstore.EventType{user.HumanEmailChangedType, user.HumanEmailVerifiedType, user.UserRemovedType})
+	}
+	return handler.NewDeleteStatement(
+		event,
+		[]handler.Condition{
+			handler.NewCond(UserEmailUndeliverableColumnUserID, event.Aggregate().ID),
+			handler.NewCond(UserEmailUndeliverableColumnInstanceID, event.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *userEmailUndeliverableProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*org.OrgRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ue6nd", "reduce.wrong.event.type %s", org.OrgRemovedEventType)
+	}
+
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(UserEmailUndeliverableColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(UserEmailUndeliverableColumnResourceOwner, e.Aggregate().ID),
+		},
+	), nil
+}
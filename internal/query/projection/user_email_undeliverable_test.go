@@ -0,0 +1,209 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestUserEmailUndeliverableProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceEmailUndeliverable",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.HumanEmailUndeliverableType,
+						user.AggregateType,
+						[]byte(`{"email": "email@example.com", "reason": 1, "description": "mailbox does not exist", "providerId": "provider-id", "deliveryId": "delivery-id"}`),
+					), user.HumanEmailUndeliverableEventMapper),
+			},
+			reduce: (&userEmailUndeliverableProjection{}).reduceEmailUndeliverable,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.user_email_undeliverables (instance_id, user_id, resource_owner, creation_date, change_date, sequence, email, reason, description, provider_id, delivery_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) ON CONFLICT (instance_id, user_id) DO UPDATE SET (resource_owner, creation_date, change_date, sequence, email, reason, description, provider_id, delivery_id) = (EXCLUDED.resource_owner, projections.user_email_undeliverables.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.email, EXCLUDED.reason, EXCLUDED.description, EXCLUDED.provider_id, EXCLUDED.delivery_id)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"ro-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								domain.EmailAddress("email@example.com"),
+								domain.EmailUndeliverableReasonBounce,
+								"mailbox does not exist",
+								"provider-id",
+								"delivery-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceEmailDeliverable (email changed)",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.HumanEmailChangedType,
+						user.AggregateType,
+						[]byte(`{"email": "new@example.com"}`),
+					), user.HumanEmailChangedEventMapper),
+			},
+			reduce: (&userEmailUndeliverableProjection{}).reduceEmailDeliverable,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_email_undeliverables WHERE (user_id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceEmailDeliverable (email verified)",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.HumanEmailVerifiedType,
+						user.AggregateType,
+						nil,
+					), user.HumanEmailVerifiedEventMapper),
+			},
+			reduce: (&userEmailUndeliverableProjection{}).reduceEmailDeliverable,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_email_undeliverables WHERE (user_id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceEmailDeliverable (user removed)",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.UserRemovedType,
+						user.AggregateType,
+						nil,
+					), user.UserRemovedEventMapper),
+			},
+			reduce: (&userEmailUndeliverableProjection{}).reduceEmailDeliverable,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_email_undeliverables WHERE (user_id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:   "org reduceOwnerRemoved",
+			reduce: (&userEmailUndeliverableProjection{}).reduceOwnerRemoved,
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					), org.OrgRemovedEventMapper),
+			},
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_email_undeliverables WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					), instance.InstanceRemovedEventMapper),
+			},
+			reduce: reduceInstanceRemovedHelper(UserEmailUndeliverableColumnInstanceID),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_email_undeliverables WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, UserEmailUndeliverableProjectionTable, tt.want)
+		})
+	}
+}
@@ -144,6 +144,7 @@ func (p *relationalTablesProjection) reduceUserLocked(event eventstore.Event) (*
 				v3_sql.SQLTx(tx),
 				repo.PrimaryKeyCondition(e.Agg.InstanceID, e.Aggregate().ID),
 				repo.SetState(domain.UserStateLocked),
+				repo.SetLockedUntil(e.LockedUntil),
 				repo.SetUpdatedAt(e.CreatedAt()),
 			)
 			return err
@@ -183,6 +184,7 @@ func (p *relationalTablesProjection) reduceUserUnlocked(event eventstore.Event)
 			v3_sql.SQLTx(tx),
 			repo.PrimaryKeyCondition(e.Agg.InstanceID, e.Aggregate().ID),
 			repo.SetState(domain.UserStateActive),
+			repo.SetLockedUntil(nil),
 			repo.SetUpdatedAt(e.CreatedAt()),
 		)
 		return err
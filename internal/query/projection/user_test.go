@@ -5,6 +5,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/muhlemmer/gu"
+
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
@@ -578,10 +580,42 @@ func TestUserProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE projections.users14 SET (change_date, state, sequence) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedStmt: "UPDATE projections.users14 SET (change_date, state, locked_until, sequence) = ($1, $2, $3, $4) WHERE (id = $5) AND (instance_id = $6)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								domain.UserStateLocked,
+								(*time.Time)(nil),
+								uint64(15),
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserLocked with expiry",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.UserLockedType,
+						user.AggregateType,
+						[]byte(`{"lockedUntil": "2024-01-01T12:15:00Z"}`),
+					), user.UserLockedEventMapper),
+			},
+			reduce: (&userProjection{}).reduceUserLocked,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.users14 SET (change_date, state, locked_until, sequence) = ($1, $2, $3, $4) WHERE (id = $5) AND (instance_id = $6)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								domain.UserStateLocked,
+								gu.Ptr(time.Date(2024, 1, 1, 12, 15, 0, 0, time.UTC)),
 								uint64(15),
 								"agg-id",
 								"instance-id",
@@ -608,10 +642,11 @@ func TestUserProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE projections.users14 SET (change_date, state, sequence) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedStmt: "UPDATE projections.users14 SET (change_date, state, locked_until, sequence) = ($1, $2, $3, $4) WHERE (id = $5) AND (instance_id = $6)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								domain.UserStateActive,
+								nil,
 								uint64(15),
 								"agg-id",
 								"instance-id",
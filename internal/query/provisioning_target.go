@@ -0,0 +1,360 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	provisioningTargetTable = table{
+		name:          projection.ProvisioningTargetTable,
+		instanceIDCol: projection.ProvisioningTargetInstanceIDCol,
+	}
+	ProvisioningTargetColumnID = Column{
+		name:  projection.ProvisioningTargetIDCol,
+		table: provisioningTargetTable,
+	}
+	ProvisioningTargetColumnCreationDate = Column{
+		name:  projection.ProvisioningTargetCreationDateCol,
+		table: provisioningTargetTable,
+	}
+	ProvisioningTargetColumnChangeDate = Column{
+		name:  projection.ProvisioningTargetChangeDateCol,
+		table: provisioningTargetTable,
+	}
+	ProvisioningTargetColumnResourceOwner = Column{
+		name:  projection.ProvisioningTargetResourceOwnerCol,
+		table: provisioningTargetTable,
+	}
+	ProvisioningTargetColumnInstanceID = Column{
+		name:  projection.ProvisioningTargetInstanceIDCol,
+		table: provisioningTargetTable,
+	}
+	ProvisioningTargetColumnSequence = Column{
+		name:  projection.ProvisioningTargetSequenceCol,
+		table: provisioningTargetTable,
+	}
+	ProvisioningTargetColumnName = Column{
+		name:  projection.ProvisioningTargetNameCol,
+		table: provisioningTargetTable,
+	}
+	ProvisioningTargetColumnProjectID = Column{
+		name:  projection.ProvisioningTargetProjectIDCol,
+		table: provisioningTargetTable,
+	}
+	ProvisioningTargetColumnAppID = Column{
+		name:  projection.ProvisioningTargetAppIDCol,
+		table: provisioningTargetTable,
+	}
+	ProvisioningTargetColumnEndpoint = Column{
+		name:  projection.ProvisioningTargetEndpointCol,
+		table: provisioningTargetTable,
+	}
+	ProvisioningTargetColumnToken = Column{
+		name:  projection.ProvisioningTargetTokenCol,
+		table: provisioningTargetTable,
+	}
+	ProvisioningTargetColumnSyncStatus = Column{
+		name:  projection.ProvisioningTargetSyncStatusCol,
+		table: provisioningTargetTable,
+	}
+	ProvisioningTargetColumnSyncDate = Column{
+		name:  projection.ProvisioningTargetSyncDateCol,
+		table: provisioningTargetTable,
+	}
+	ProvisioningTargetColumnLastError = Column{
+		name:  projection.ProvisioningTargetLastErrorCol,
+		table: provisioningTargetTable,
+	}
+	ProvisioningTargetColumnLastErrorUserID = Column{
+		name:  projection.ProvisioningTargetLastErrorUserIDCol,
+		table: provisioningTargetTable,
+	}
+)
+
+type ProvisioningTargets struct {
+	SearchResponse
+	ProvisioningTargets []*ProvisioningTarget
+}
+
+func (t *ProvisioningTargets) SetState(s *State) {
+	t.State = s
+}
+
+type ProvisioningTarget struct {
+	domain.ObjectDetails
+
+	Name            string
+	ProjectID       string
+	AppID           string
+	Endpoint        string
+	SyncStatus      domain.ProvisioningStatus
+	SyncDate        time.Time
+	LastError       string
+	LastErrorUserID string
+
+	token *crypto.CryptoValue
+	// Token is only set for the provisioning worker and never returned by the API.
+	Token string
+}
+
+func (t *ProvisioningTarget) decryptToken(alg crypto.EncryptionAlgorithm) error {
+	if t.token == nil {
+		return nil
+	}
+	token, err := crypto.DecryptString(t.token, alg)
+	if err != nil {
+		return zerrors.ThrowInternal(err, "QUERY-Pv4tKd", "Errors.Internal")
+	}
+	t.Token = token
+	return nil
+}
+
+type ProvisioningTargetSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *ProvisioningTargetSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func (q *Queries) SearchProvisioningTargets(ctx context.Context, queries *ProvisioningTargetSearchQueries, permissionCheck domain.PermissionCheck) (_ *ProvisioningTargets, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		ProvisioningTargetColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareProvisioningTargetsQuery()
+	targets, err := genericRowsQueryWithState(ctx, q.client, provisioningTargetTable, combineToWhereStmt(query, queries.toQuery, eq), scan)
+	if err != nil {
+		return nil, err
+	}
+	targets.ProvisioningTargets = slices.DeleteFunc(targets.ProvisioningTargets, func(target *ProvisioningTarget) bool {
+		return appCheckPermission(ctx, target.ResourceOwner, target.ProjectID, permissionCheck) != nil
+	})
+	return targets, nil
+}
+
+func (q *Queries) GetProvisioningTargetByIDWithPermission(ctx context.Context, id string, permissionCheck domain.PermissionCheck) (_ *ProvisioningTarget, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		ProvisioningTargetColumnID.identifier():         id,
+		ProvisioningTargetColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareProvisioningTargetQuery()
+	target, err := genericRowQuery(ctx, q.client, query.Where(eq), scan)
+	if err != nil {
+		return nil, err
+	}
+	if err := appCheckPermission(ctx, target.ResourceOwner, target.ProjectID, permissionCheck); err != nil {
+		return nil, err
+	}
+	return target, nil
+}
+
+// ProvisioningTargetsWithToken returns the targets matching the queries including the decrypted tokens.
+// It must only be used by the provisioning worker.
+func (q *Queries) ProvisioningTargetsWithToken(ctx context.Context, queries ...SearchQuery) (_ []*ProvisioningTarget, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareProvisioningTargetsQuery()
+	for _, q := range queries {
+		query = q.toQuery(query)
+	}
+	eq := sq.Eq{
+		ProvisioningTargetColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	targets, err := genericRowsQuery(ctx, q.client, query.Where(eq), scan)
+	if err != nil {
+		return nil, err
+	}
+	for _, target := range targets.ProvisioningTargets {
+		if err := target.decryptToken(q.targetEncryptionAlgorithm); err != nil {
+			return nil, err
+		}
+	}
+	return targets.ProvisioningTargets, nil
+}
+
+// HasProvisioningTargets returns if any provisioning target is configured on the instance.
+// It allows the provisioning handler to skip events of instances without provisioning.
+func (q *Queries) HasProvisioningTargets(ctx context.Context) (_ bool, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query := sq.Select(ProvisioningTargetColumnID.identifier()).
+		From(provisioningTargetTable.identifier()).
+		Where(sq.Eq{
+			ProvisioningTargetColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		}).
+		Limit(1).
+		PlaceholderFormat(sq.Dollar)
+	return genericRowQuery(ctx, q.client, query, func(row *sql.Row) (bool, error) {
+		var id string
+		err := row.Scan(&id)
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		if err != nil {
+			return false, zerrors.ThrowInternal(err, "QUERY-Pv3mQe", "Errors.Internal")
+		}
+		return true, nil
+	})
+}
+
+func NewProvisioningTargetIDSearchQuery(id string) (SearchQuery, error) {
+	return NewTextQuery(ProvisioningTargetColumnID, id, TextEquals)
+}
+
+func NewProvisioningTargetProjectIDsSearchQuery(projectIDs []string) (SearchQuery, error) {
+	list := make([]interface{}, len(projectIDs))
+	for i, projectID := range projectIDs {
+		list[i] = projectID
+	}
+	return NewListQuery(ProvisioningTargetColumnProjectID, list, ListIn)
+}
+
+func NewProvisioningTargetProjectIDSearchQuery(projectID string) (SearchQuery, error) {
+	return NewTextQuery(ProvisioningTargetColumnProjectID, projectID, TextEquals)
+}
+
+func NewProvisioningTargetResourceOwnerSearchQuery(resourceOwner string) (SearchQuery, error) {
+	return NewTextQuery(ProvisioningTargetColumnResourceOwner, resourceOwner, TextEquals)
+}
+
+func NewProvisioningTargetAppIDSearchQuery(appID string) (SearchQuery, error) {
+	return NewTextQuery(ProvisioningTargetColumnAppID, appID, TextEquals)
+}
+
+func NewProvisioningTargetNameSearchQuery(method TextComparison, value string) (SearchQuery, error) {
+	return NewTextQuery(ProvisioningTargetColumnName, value, method)
+}
+
+func prepareProvisioningTargetsQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*ProvisioningTargets, error)) {
+	return sq.Select(
+			ProvisioningTargetColumnID.identifier(),
+			ProvisioningTargetColumnCreationDate.identifier(),
+			ProvisioningTargetColumnChangeDate.identifier(),
+			ProvisioningTargetColumnResourceOwner.identifier(),
+			ProvisioningTargetColumnSequence.identifier(),
+			ProvisioningTargetColumnName.identifier(),
+			ProvisioningTargetColumnProjectID.identifier(),
+			ProvisioningTargetColumnAppID.identifier(),
+			ProvisioningTargetColumnEndpoint.identifier(),
+			ProvisioningTargetColumnToken.identifier(),
+			ProvisioningTargetColumnSyncStatus.identifier(),
+			ProvisioningTargetColumnSyncDate.identifier(),
+			ProvisioningTargetColumnLastError.identifier(),
+			ProvisioningTargetColumnLastErrorUserID.identifier(),
+			countColumn.identifier(),
+		).From(provisioningTargetTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*ProvisioningTargets, error) {
+			targets := make([]*ProvisioningTarget, 0)
+			var count uint64
+			for rows.Next() {
+				target := new(ProvisioningTarget)
+				var syncDate sql.NullTime
+				err := rows.Scan(
+					&target.ID,
+					&target.CreationDate,
+					&target.EventDate,
+					&target.ResourceOwner,
+					&target.Sequence,
+					&target.Name,
+					&target.ProjectID,
+					&target.AppID,
+					&target.Endpoint,
+					&target.token,
+					&target.SyncStatus,
+					&syncDate,
+					&target.LastError,
+					&target.LastErrorUserID,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				target.SyncDate = syncDate.Time
+				targets = append(targets, target)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Pv8wEr", "Errors.Query.CloseRows")
+			}
+
+			return &ProvisioningTargets{
+				ProvisioningTargets: targets,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+func prepareProvisioningTargetQuery() (sq.SelectBuilder, func(row *sql.Row) (*ProvisioningTarget, error)) {
+	return sq.Select(
+			ProvisioningTargetColumnID.identifier(),
+			ProvisioningTargetColumnCreationDate.identifier(),
+			ProvisioningTargetColumnChangeDate.identifier(),
+			ProvisioningTargetColumnResourceOwner.identifier(),
+			ProvisioningTargetColumnSequence.identifier(),
+			ProvisioningTargetColumnName.identifier(),
+			ProvisioningTargetColumnProjectID.identifier(),
+			ProvisioningTargetColumnAppID.identifier(),
+			ProvisioningTargetColumnEndpoint.identifier(),
+			ProvisioningTargetColumnSyncStatus.identifier(),
+			ProvisioningTargetColumnSyncDate.identifier(),
+			ProvisioningTargetColumnLastError.identifier(),
+			ProvisioningTargetColumnLastErrorUserID.identifier(),
+		).From(provisioningTargetTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*ProvisioningTarget, error) {
+			target := new(ProvisioningTarget)
+			var syncDate sql.NullTime
+			err := row.Scan(
+				&target.ID,
+				&target.CreationDate,
+				&target.EventDate,
+				&target.ResourceOwner,
+				&target.Sequence,
+				&target.Name,
+				&target.ProjectID,
+				&target.AppID,
+				&target.Endpoint,
+				&target.SyncStatus,
+				&syncDate,
+				&target.LastError,
+				&target.LastErrorUserID,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Pv1nRt", "Errors.ProvisioningTarget.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Pv6yHu", "Errors.Internal")
+			}
+			target.SyncDate = syncDate.Time
+			return target, nil
+		}
+}
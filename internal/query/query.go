@@ -14,6 +14,7 @@ import (
 	"github.com/zitadel/zitadel/internal/cache/connector"
 	sd "github.com/zitadel/zitadel/internal/config/systemdefaults"
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/crypto/keyprovider"
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
@@ -36,6 +37,8 @@ type Queries struct {
 	smsEncryptionAlgorithm    crypto.EncryptionAlgorithm
 	sessionTokenVerifier      func(ctx context.Context, sessionToken string, sessionID string, tokenID string) (err error)
 	checkPermission           domain.PermissionCheck
+	// externalSigningKey is held by a key provider and replaces the signing keys of the instances
+	externalSigningKey *keyprovider.SigningKey
 
 	DefaultLanguage                     language.Tag
 	mutex                               sync.Mutex
@@ -64,6 +67,7 @@ func StartQueries(
 	systemAPIUsers map[string]*authz.SystemAPIUser,
 	startProjections bool,
 	secretGeneratorDefaults map[domain.SecretGeneratorType]*crypto.GeneratorConfig,
+	externalSigningKey *keyprovider.SigningKey,
 ) (repo *Queries, err error) {
 	repo = &Queries{
 		eventstore:                          es,
@@ -87,6 +91,7 @@ func StartQueries(
 		},
 		defaultAuditLogRetention: defaultAuditLogRetention,
 		defaultSecretGenerators:  secretGeneratorDefaults,
+		externalSigningKey:       externalSigningKey,
 	}
 
 	repo.checkPermission = permissionCheck(repo)
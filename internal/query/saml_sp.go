@@ -24,6 +24,13 @@ type SAMLServiceProvider struct {
 	ProjectRoleAssertion bool                `json:"project_role_assertion,omitempty"`
 	LoginVersion         domain.LoginVersion `json:"login_version,omitempty"`
 	LoginBaseURI         *url.URL            `json:"login_base_uri,omitempty"`
+
+	IDPInitiatedEnabled    bool                          `json:"idp_initiated_enabled,omitempty"`
+	IDPInitiatedRelayState string                        `json:"idp_initiated_relay_state,omitempty"`
+	EncryptAssertion       bool                          `json:"encrypt_assertion,omitempty"`
+	NameIDFormat           domain.SAMLNameIDFormat       `json:"name_id_format,omitempty"`
+	NameIDSource           domain.SAMLNameIDSource       `json:"name_id_source,omitempty"`
+	SignatureAlgorithm     domain.SAMLSignatureAlgorithm `json:"signature_algorithm,omitempty"`
 }
 
 //go:embed saml_sp_by_id.sql
@@ -61,6 +68,9 @@ func scanSAMLServiceProviderByID(row *sql.Row) (*SAMLServiceProvider, error) {
 	var metadata []byte
 	var state, loginVersion sql.NullInt16
 	var loginBaseURI sql.NullString
+	var idpInitiatedEnabled, encryptAssertion sql.NullBool
+	var idpInitiatedRelayState sql.NullString
+	var nameIDFormat, nameIDSource, signatureAlgorithm sql.NullInt16
 
 	err := row.Scan(
 		&instanceID,
@@ -73,6 +83,12 @@ func scanSAMLServiceProviderByID(row *sql.Row) (*SAMLServiceProvider, error) {
 		&projectRoleAssertion,
 		&loginVersion,
 		&loginBaseURI,
+		&idpInitiatedEnabled,
+		&idpInitiatedRelayState,
+		&encryptAssertion,
+		&nameIDFormat,
+		&nameIDSource,
+		&signatureAlgorithm,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -89,6 +105,13 @@ func scanSAMLServiceProviderByID(row *sql.Row) (*SAMLServiceProvider, error) {
 		MetadataURL:          metadataURL.String,
 		ProjectID:            projectID.String,
 		ProjectRoleAssertion: projectRoleAssertion.Bool,
+
+		IDPInitiatedEnabled:    idpInitiatedEnabled.Bool,
+		IDPInitiatedRelayState: idpInitiatedRelayState.String,
+		EncryptAssertion:       encryptAssertion.Bool,
+		NameIDFormat:           domain.SAMLNameIDFormat(nameIDFormat.Int16),
+		NameIDSource:           domain.SAMLNameIDSource(nameIDSource.Int16),
+		SignatureAlgorithm:     domain.SAMLSignatureAlgorithm(signatureAlgorithm.Int16),
 	}
 	if loginVersion.Valid {
 		sp.LoginVersion = domain.LoginVersion(loginVersion.Int16)
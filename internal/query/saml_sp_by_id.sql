@@ -7,7 +7,13 @@ select c.instance_id,
        a.project_id,
        p.project_role_assertion,
        c.login_version,
-       c.login_base_uri
+       c.login_base_uri,
+       c.idp_initiated_enabled,
+       c.idp_initiated_relay_state,
+       c.encrypt_assertion,
+       c.name_id_format,
+       c.name_id_source,
+       c.signature_algorithm
 from projections.apps7_saml_configs c
          join projections.apps7 a
               on a.id = c.app_id and a.instance_id = c.instance_id and a.state = 1
@@ -30,6 +30,12 @@ func TestQueries_ActiveSAMLServiceProviderByID(t *testing.T) {
 		"project_role_assertion",
 		"login_version",
 		"login_base_uri",
+		"idp_initiated_enabled",
+		"idp_initiated_relay_state",
+		"encrypt_assertion",
+		"name_id_format",
+		"name_id_source",
+		"signature_algorithm",
 	}
 
 	tests := []struct {
@@ -61,6 +67,12 @@ func TestQueries_ActiveSAMLServiceProviderByID(t *testing.T) {
 				true,
 				domain.LoginVersionUnspecified,
 				"",
+				false,
+				"",
+				false,
+				domain.SAMLNameIDFormatEmailAddress,
+				domain.SAMLNameIDSourceLoginName,
+				domain.SAMLSignatureAlgorithmUnspecified,
 			}, "instanceID", "entityID"),
 			want: &SAMLServiceProvider{
 				InstanceID:           "230690539048009730",
@@ -71,6 +83,44 @@ func TestQueries_ActiveSAMLServiceProviderByID(t *testing.T) {
 				MetadataURL:          "https://test.com/metadata",
 				ProjectID:            "236645808328409090",
 				ProjectRoleAssertion: true,
+				NameIDFormat:         domain.SAMLNameIDFormatEmailAddress,
+			},
+		},
+		{
+			name: "sp with idp initiated login and encryption",
+			mock: mockQuery(expQuery, cols, []driver.Value{
+				"230690539048009730",
+				"236647088211886082",
+				domain.AppStateActive,
+				"https://test.com/metadata",
+				"metadata",
+				"https://test.com/metadata",
+				"236645808328409090",
+				true,
+				domain.LoginVersionUnspecified,
+				"",
+				true,
+				"https://test.com/home",
+				true,
+				domain.SAMLNameIDFormatPersistent,
+				domain.SAMLNameIDSourceUserID,
+				domain.SAMLSignatureAlgorithmRSASHA512,
+			}, "instanceID", "entityID"),
+			want: &SAMLServiceProvider{
+				InstanceID:             "230690539048009730",
+				AppID:                  "236647088211886082",
+				State:                  domain.AppStateActive,
+				EntityID:               "https://test.com/metadata",
+				Metadata:               []byte("metadata"),
+				MetadataURL:            "https://test.com/metadata",
+				ProjectID:              "236645808328409090",
+				ProjectRoleAssertion:   true,
+				IDPInitiatedEnabled:    true,
+				IDPInitiatedRelayState: "https://test.com/home",
+				EncryptAssertion:       true,
+				NameIDFormat:           domain.SAMLNameIDFormatPersistent,
+				NameIDSource:           domain.SAMLNameIDSourceUserID,
+				SignatureAlgorithm:     domain.SAMLSignatureAlgorithmRSASHA512,
 			},
 		},
 		{
@@ -86,6 +136,12 @@ func TestQueries_ActiveSAMLServiceProviderByID(t *testing.T) {
 				true,
 				domain.LoginVersion2,
 				"https://test.com/login",
+				false,
+				"",
+				false,
+				domain.SAMLNameIDFormatEmailAddress,
+				domain.SAMLNameIDSourceLoginName,
+				domain.SAMLSignatureAlgorithmUnspecified,
 			}, "instanceID", "entityID"),
 			want: &SAMLServiceProvider{
 				InstanceID:           "230690539048009730",
@@ -97,6 +153,7 @@ func TestQueries_ActiveSAMLServiceProviderByID(t *testing.T) {
 				ProjectID:            "236645808328409090",
 				ProjectRoleAssertion: true,
 				LoginVersion:         domain.LoginVersion2,
+				NameIDFormat:         domain.SAMLNameIDFormatEmailAddress,
 				LoginBaseURI: func() *url.URL {
 					ret, _ := url.Parse("https://test.com/login")
 					return ret
@@ -33,6 +33,8 @@ type SMSConfig struct {
 
 	TwilioConfig *Twilio
 	HTTPConfig   *HTTP
+	VonageConfig *Vonage
+	SNSConfig    *SNS
 }
 
 func (h *SMSConfig) decryptSigningKey(alg crypto.EncryptionAlgorithm) error {
@@ -49,6 +51,19 @@ type Twilio struct {
 	VerifyServiceSID string
 }
 
+type Vonage struct {
+	APIKey       string
+	APISecret    *crypto.CryptoValue
+	SenderNumber string
+}
+
+type SNS struct {
+	AccessKeyID     string
+	SecretAccessKey *crypto.CryptoValue
+	Region          string
+	SenderID        string
+}
+
 type HTTP struct {
 	Endpoint   string
 	signingKey *crypto.CryptoValue
@@ -169,6 +184,56 @@ var (
 	}
 )
 
+var (
+	smsVonageTable = table{
+		name:          projection.SMSVonageTable,
+		instanceIDCol: projection.SMSVonageColumnInstanceID,
+	}
+	SMSVonageColumnSMSID = Column{
+		name:  projection.SMSVonageColumnSMSID,
+		table: smsVonageTable,
+	}
+	SMSVonageColumnAPIKey = Column{
+		name:  projection.SMSVonageColumnAPIKey,
+		table: smsVonageTable,
+	}
+	SMSVonageColumnAPISecret = Column{
+		name:  projection.SMSVonageColumnAPISecret,
+		table: smsVonageTable,
+	}
+	SMSVonageColumnSenderNumber = Column{
+		name:  projection.SMSVonageColumnSenderNumber,
+		table: smsVonageTable,
+	}
+)
+
+var (
+	smsSNSTable = table{
+		name:          projection.SMSSNSTable,
+		instanceIDCol: projection.SMSSNSColumnInstanceID,
+	}
+	SMSSNSColumnSMSID = Column{
+		name:  projection.SMSSNSColumnSMSID,
+		table: smsSNSTable,
+	}
+	SMSSNSColumnAccessKeyID = Column{
+		name:  projection.SMSSNSColumnAccessKeyID,
+		table: smsSNSTable,
+	}
+	SMSSNSColumnSecretAccessKey = Column{
+		name:  projection.SMSSNSColumnSecretAccessKey,
+		table: smsSNSTable,
+	}
+	SMSSNSColumnRegion = Column{
+		name:  projection.SMSSNSColumnRegion,
+		table: smsSNSTable,
+	}
+	SMSSNSColumnSenderID = Column{
+		name:  projection.SMSSNSColumnSenderID,
+		table: smsSNSTable,
+	}
+)
+
 func (q *Queries) SMSProviderConfigByID(ctx context.Context, id string) (config *SMSConfig, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -278,15 +343,30 @@ func prepareSMSConfigQuery() (sq.SelectBuilder, func(*sql.Row) (*SMSConfig, erro
 			SMSHTTPColumnSMSID.identifier(),
 			SMSHTTPColumnEndpoint.identifier(),
 			SMSHTTPColumnSigningKey.identifier(),
+
+			SMSVonageColumnSMSID.identifier(),
+			SMSVonageColumnAPIKey.identifier(),
+			SMSVonageColumnAPISecret.identifier(),
+			SMSVonageColumnSenderNumber.identifier(),
+
+			SMSSNSColumnSMSID.identifier(),
+			SMSSNSColumnAccessKeyID.identifier(),
+			SMSSNSColumnSecretAccessKey.identifier(),
+			SMSSNSColumnRegion.identifier(),
+			SMSSNSColumnSenderID.identifier(),
 		).From(smsConfigsTable.identifier()).
 			LeftJoin(join(SMSTwilioColumnSMSID, SMSColumnID)).
 			LeftJoin(join(SMSHTTPColumnSMSID, SMSColumnID)).
+			LeftJoin(join(SMSVonageColumnSMSID, SMSColumnID)).
+			LeftJoin(join(SMSSNSColumnSMSID, SMSColumnID)).
 			PlaceholderFormat(sq.Dollar), func(row *sql.Row) (*SMSConfig, error) {
 			config := new(SMSConfig)
 
 			var (
 				twilioConfig = sqlTwilioConfig{}
 				httpConfig   = sqlHTTPConfig{}
+				vonageConfig = sqlVonageConfig{}
+				snsConfig    = sqlSNSConfig{}
 			)
 
 			err := row.Scan(
@@ -308,6 +388,17 @@ func prepareSMSConfigQuery() (sq.SelectBuilder, func(*sql.Row) (*SMSConfig, erro
 				&httpConfig.id,
 				&httpConfig.endpoint,
 				&httpConfig.signingKey,
+
+				&vonageConfig.smsID,
+				&vonageConfig.apiKey,
+				&vonageConfig.apiSecret,
+				&vonageConfig.senderNumber,
+
+				&snsConfig.smsID,
+				&snsConfig.accessKeyID,
+				&snsConfig.secretAccessKey,
+				&snsConfig.region,
+				&snsConfig.senderID,
 			)
 
 			if err != nil {
@@ -319,6 +410,8 @@ func prepareSMSConfigQuery() (sq.SelectBuilder, func(*sql.Row) (*SMSConfig, erro
 
 			twilioConfig.set(config)
 			httpConfig.setSMS(config)
+			vonageConfig.set(config)
+			snsConfig.set(config)
 
 			return config, nil
 		}
@@ -345,10 +438,23 @@ func prepareSMSConfigsQuery() (sq.SelectBuilder, func(*sql.Rows) (*SMSConfigs, e
 			SMSHTTPColumnEndpoint.identifier(),
 			SMSHTTPColumnSigningKey.identifier(),
 
+			SMSVonageColumnSMSID.identifier(),
+			SMSVonageColumnAPIKey.identifier(),
+			SMSVonageColumnAPISecret.identifier(),
+			SMSVonageColumnSenderNumber.identifier(),
+
+			SMSSNSColumnSMSID.identifier(),
+			SMSSNSColumnAccessKeyID.identifier(),
+			SMSSNSColumnSecretAccessKey.identifier(),
+			SMSSNSColumnRegion.identifier(),
+			SMSSNSColumnSenderID.identifier(),
+
 			countColumn.identifier(),
 		).From(smsConfigsTable.identifier()).
 			LeftJoin(join(SMSTwilioColumnSMSID, SMSColumnID)).
 			LeftJoin(join(SMSHTTPColumnSMSID, SMSColumnID)).
+			LeftJoin(join(SMSVonageColumnSMSID, SMSColumnID)).
+			LeftJoin(join(SMSSNSColumnSMSID, SMSColumnID)).
 			PlaceholderFormat(sq.Dollar), func(row *sql.Rows) (*SMSConfigs, error) {
 			configs := &SMSConfigs{Configs: []*SMSConfig{}}
 
@@ -357,6 +463,8 @@ func prepareSMSConfigsQuery() (sq.SelectBuilder, func(*sql.Rows) (*SMSConfigs, e
 				var (
 					twilioConfig = sqlTwilioConfig{}
 					httpConfig   = sqlHTTPConfig{}
+					vonageConfig = sqlVonageConfig{}
+					snsConfig    = sqlSNSConfig{}
 				)
 
 				err := row.Scan(
@@ -379,6 +487,17 @@ func prepareSMSConfigsQuery() (sq.SelectBuilder, func(*sql.Rows) (*SMSConfigs, e
 					&httpConfig.endpoint,
 					&httpConfig.signingKey,
 
+					&vonageConfig.smsID,
+					&vonageConfig.apiKey,
+					&vonageConfig.apiSecret,
+					&vonageConfig.senderNumber,
+
+					&snsConfig.smsID,
+					&snsConfig.accessKeyID,
+					&snsConfig.secretAccessKey,
+					&snsConfig.region,
+					&snsConfig.senderID,
+
 					&configs.Count,
 				)
 
@@ -388,6 +507,8 @@ func prepareSMSConfigsQuery() (sq.SelectBuilder, func(*sql.Rows) (*SMSConfigs, e
 
 				twilioConfig.set(config)
 				httpConfig.setSMS(config)
+				vonageConfig.set(config)
+				snsConfig.set(config)
 
 				configs.Configs = append(configs.Configs, config)
 			}
@@ -416,6 +537,44 @@ func (c sqlTwilioConfig) set(smsConfig *SMSConfig) {
 	}
 }
 
+type sqlVonageConfig struct {
+	smsID        sql.NullString
+	apiKey       sql.NullString
+	apiSecret    *crypto.CryptoValue
+	senderNumber sql.NullString
+}
+
+func (c sqlVonageConfig) set(smsConfig *SMSConfig) {
+	if !c.smsID.Valid {
+		return
+	}
+	smsConfig.VonageConfig = &Vonage{
+		APIKey:       c.apiKey.String,
+		APISecret:    c.apiSecret,
+		SenderNumber: c.senderNumber.String,
+	}
+}
+
+type sqlSNSConfig struct {
+	smsID           sql.NullString
+	accessKeyID     sql.NullString
+	secretAccessKey *crypto.CryptoValue
+	region          sql.NullString
+	senderID        sql.NullString
+}
+
+func (c sqlSNSConfig) set(smsConfig *SMSConfig) {
+	if !c.smsID.Valid {
+		return
+	}
+	smsConfig.SNSConfig = &SNS{
+		AccessKeyID:     c.accessKeyID.String,
+		SecretAccessKey: c.secretAccessKey,
+		Region:          c.region.String,
+		SenderID:        c.senderID.String,
+	}
+}
+
 type sqlHTTPConfig struct {
 	id         sql.NullString
 	endpoint   sql.NullString
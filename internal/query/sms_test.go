@@ -33,10 +33,25 @@ var (
 		// http config
 		` projections.sms_configs3_http.sms_id,` +
 		` projections.sms_configs3_http.endpoint,` +
-		` projections.sms_configs3_http.signing_key` +
+		` projections.sms_configs3_http.signing_key,` +
+
+		// vonage config
+		` projections.sms_configs3_vonage.sms_id,` +
+		` projections.sms_configs3_vonage.api_key,` +
+		` projections.sms_configs3_vonage.api_secret,` +
+		` projections.sms_configs3_vonage.sender_number,` +
+
+		// sns config
+		` projections.sms_configs3_sns.sms_id,` +
+		` projections.sms_configs3_sns.access_key_id,` +
+		` projections.sms_configs3_sns.secret_access_key,` +
+		` projections.sms_configs3_sns.region,` +
+		` projections.sms_configs3_sns.sender_id` +
 		` FROM projections.sms_configs3` +
 		` LEFT JOIN projections.sms_configs3_twilio ON projections.sms_configs3.id = projections.sms_configs3_twilio.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_twilio.instance_id` +
-		` LEFT JOIN projections.sms_configs3_http ON projections.sms_configs3.id = projections.sms_configs3_http.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_http.instance_id`)
+		` LEFT JOIN projections.sms_configs3_http ON projections.sms_configs3.id = projections.sms_configs3_http.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_http.instance_id` +
+		` LEFT JOIN projections.sms_configs3_vonage ON projections.sms_configs3.id = projections.sms_configs3_vonage.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_vonage.instance_id` +
+		` LEFT JOIN projections.sms_configs3_sns ON projections.sms_configs3.id = projections.sms_configs3_sns.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_sns.instance_id`)
 	expectedSMSConfigsQuery = regexp.QuoteMeta(`SELECT projections.sms_configs3.id,` +
 		` projections.sms_configs3.aggregate_id,` +
 		` projections.sms_configs3.creation_date,` +
@@ -57,10 +72,25 @@ var (
 		` projections.sms_configs3_http.sms_id,` +
 		` projections.sms_configs3_http.endpoint,` +
 		` projections.sms_configs3_http.signing_key,` +
+
+		// vonage config
+		` projections.sms_configs3_vonage.sms_id,` +
+		` projections.sms_configs3_vonage.api_key,` +
+		` projections.sms_configs3_vonage.api_secret,` +
+		` projections.sms_configs3_vonage.sender_number,` +
+
+		// sns config
+		` projections.sms_configs3_sns.sms_id,` +
+		` projections.sms_configs3_sns.access_key_id,` +
+		` projections.sms_configs3_sns.secret_access_key,` +
+		` projections.sms_configs3_sns.region,` +
+		` projections.sms_configs3_sns.sender_id,` +
 		` COUNT(*) OVER ()` +
 		` FROM projections.sms_configs3` +
 		` LEFT JOIN projections.sms_configs3_twilio ON projections.sms_configs3.id = projections.sms_configs3_twilio.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_twilio.instance_id` +
-		` LEFT JOIN projections.sms_configs3_http ON projections.sms_configs3.id = projections.sms_configs3_http.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_http.instance_id`)
+		` LEFT JOIN projections.sms_configs3_http ON projections.sms_configs3.id = projections.sms_configs3_http.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_http.instance_id` +
+		` LEFT JOIN projections.sms_configs3_vonage ON projections.sms_configs3.id = projections.sms_configs3_vonage.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_vonage.instance_id` +
+		` LEFT JOIN projections.sms_configs3_sns ON projections.sms_configs3.id = projections.sms_configs3_sns.sms_id AND projections.sms_configs3.instance_id = projections.sms_configs3_sns.instance_id`)
 
 	smsConfigCols = []string{
 		"id",
@@ -81,6 +111,17 @@ var (
 		"sms_id",
 		"endpoint",
 		"signing_key",
+		// vonage config
+		"sms_id",
+		"api_key",
+		"api_secret",
+		"sender_number",
+		// sns config
+		"sms_id",
+		"access_key_id",
+		"secret_access_key",
+		"region",
+		"sender_id",
 	}
 	smsConfigsCols = append(smsConfigCols, "count")
 )
@@ -135,6 +176,17 @@ func Test_SMSConfigsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// vonage config
+							nil,
+							nil,
+							nil,
+							nil,
+							// sns config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -195,6 +247,17 @@ func Test_SMSConfigsPrepare(t *testing.T) {
 								KeyID:      "encKey",
 								Crypted:    []byte("crypted"),
 							},
+							// vonage config
+							nil,
+							nil,
+							nil,
+							nil,
+							// sns config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -226,6 +289,137 @@ func Test_SMSConfigsPrepare(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "prepareSMSQuery vonage config",
+			prepare: prepareSMSConfigsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					expectedSMSConfigsQuery,
+					smsConfigsCols,
+					[][]driver.Value{
+						{
+							"sms-id",
+							"agg-id",
+							testNow,
+							testNow,
+							"ro",
+							domain.SMSConfigStateInactive,
+							uint64(20211109),
+							"description",
+							// twilio config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// http config
+							nil,
+							nil,
+							nil,
+							// vonage config
+							"sms-id",
+							"api-key",
+							&crypto.CryptoValue{},
+							"sender-number",
+							// sns config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+						},
+					},
+				),
+			},
+			object: &SMSConfigs{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Configs: []*SMSConfig{
+					{
+						ID:            "sms-id",
+						AggregateID:   "agg-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						State:         domain.SMSConfigStateInactive,
+						Sequence:      20211109,
+						Description:   "description",
+						VonageConfig: &Vonage{
+							APIKey:       "api-key",
+							APISecret:    &crypto.CryptoValue{},
+							SenderNumber: "sender-number",
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareSMSQuery sns config",
+			prepare: prepareSMSConfigsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					expectedSMSConfigsQuery,
+					smsConfigsCols,
+					[][]driver.Value{
+						{
+							"sms-id",
+							"agg-id",
+							testNow,
+							testNow,
+							"ro",
+							domain.SMSConfigStateInactive,
+							uint64(20211109),
+							"description",
+							// twilio config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// http config
+							nil,
+							nil,
+							nil,
+							// vonage config
+							nil,
+							nil,
+							nil,
+							nil,
+							// sns config
+							"sms-id",
+							"access-key-id",
+							&crypto.CryptoValue{},
+							"eu-central-1",
+							"sender-id",
+						},
+					},
+				),
+			},
+			object: &SMSConfigs{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				Configs: []*SMSConfig{
+					{
+						ID:            "sms-id",
+						AggregateID:   "agg-id",
+						CreationDate:  testNow,
+						ChangeDate:    testNow,
+						ResourceOwner: "ro",
+						State:         domain.SMSConfigStateInactive,
+						Sequence:      20211109,
+						Description:   "description",
+						SNSConfig: &SNS{
+							AccessKeyID:     "access-key-id",
+							SecretAccessKey: &crypto.CryptoValue{},
+							Region:          "eu-central-1",
+							SenderID:        "sender-id",
+						},
+					},
+				},
+			},
+		},
 		{
 			name:    "prepareSMSConfigsQuery multiple result",
 			prepare: prepareSMSConfigsQuery,
@@ -253,6 +447,17 @@ func Test_SMSConfigsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// vonage config
+							nil,
+							nil,
+							nil,
+							nil,
+							// sns config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"sms-id2",
@@ -273,6 +478,17 @@ func Test_SMSConfigsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// vonage config
+							nil,
+							nil,
+							nil,
+							nil,
+							// sns config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"sms-id3",
@@ -298,6 +514,17 @@ func Test_SMSConfigsPrepare(t *testing.T) {
 								KeyID:      "encKey",
 								Crypted:    []byte("crypted"),
 							},
+							// vonage config
+							nil,
+							nil,
+							nil,
+							nil,
+							// sns config
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -441,6 +668,17 @@ func Test_SMSConfigPrepare(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						// vonage config
+						nil,
+						nil,
+						nil,
+						nil,
+						// sns config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -492,6 +730,17 @@ func Test_SMSConfigPrepare(t *testing.T) {
 							KeyID:      "encKey",
 							Crypted:    []byte("crypted"),
 						},
+						// vonage config
+						nil,
+						nil,
+						nil,
+						nil,
+						// sns config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
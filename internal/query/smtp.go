@@ -142,6 +142,51 @@ var (
 		name:  projection.SMTPConfigHTTPColumnSigningKey,
 		table: smtpConfigsHTTPTable,
 	}
+
+	smtpConfigsAPITable = table{
+		name:          projection.SMTPConfigAPITable,
+		instanceIDCol: projection.SMTPConfigAPIColumnInstanceID,
+	}
+	SMTPConfigAPIColumnInstanceID = Column{
+		name:  projection.SMTPConfigAPIColumnInstanceID,
+		table: smtpConfigsAPITable,
+	}
+	SMTPConfigAPIColumnID = Column{
+		name:  projection.SMTPConfigAPIColumnID,
+		table: smtpConfigsAPITable,
+	}
+	SMTPConfigAPIColumnProvider = Column{
+		name:  projection.SMTPConfigAPIColumnProvider,
+		table: smtpConfigsAPITable,
+	}
+	SMTPConfigAPIColumnSenderAddress = Column{
+		name:  projection.SMTPConfigAPIColumnSenderAddress,
+		table: smtpConfigsAPITable,
+	}
+	SMTPConfigAPIColumnSenderName = Column{
+		name:  projection.SMTPConfigAPIColumnSenderName,
+		table: smtpConfigsAPITable,
+	}
+	SMTPConfigAPIColumnReplyToAddress = Column{
+		name:  projection.SMTPConfigAPIColumnReplyToAddress,
+		table: smtpConfigsAPITable,
+	}
+	SMTPConfigAPIColumnRegion = Column{
+		name:  projection.SMTPConfigAPIColumnRegion,
+		table: smtpConfigsAPITable,
+	}
+	SMTPConfigAPIColumnDomain = Column{
+		name:  projection.SMTPConfigAPIColumnDomain,
+		table: smtpConfigsAPITable,
+	}
+	SMTPConfigAPIColumnAccessKeyID = Column{
+		name:  projection.SMTPConfigAPIColumnAccessKeyID,
+		table: smtpConfigsAPITable,
+	}
+	SMTPConfigAPIColumnAPIKey = Column{
+		name:  projection.SMTPConfigAPIColumnAPIKey,
+		table: smtpConfigsAPITable,
+	}
 )
 
 type SMTPConfig struct {
@@ -155,6 +200,7 @@ type SMTPConfig struct {
 
 	SMTPConfig *SMTP
 	HTTPConfig *HTTP
+	APIConfig  *EmailAPI
 
 	State domain.SMTPConfigState
 }
@@ -177,6 +223,18 @@ type SMTP struct {
 	XOAuth2Auth    *XOAuth2Auth
 }
 
+// EmailAPI is an email provider, which delivers the emails over its HTTP API.
+type EmailAPI struct {
+	Provider       domain.EmailAPIProviderType
+	SenderAddress  string
+	SenderName     string
+	ReplyToAddress string
+	Region         string
+	Domain         string
+	AccessKeyID    string
+	APIKey         *crypto.CryptoValue
+}
+
 type PlainAuth struct {
 	Password *crypto.CryptoValue
 }
@@ -283,16 +341,28 @@ func prepareSMTPConfigQuery() (sq.SelectBuilder, func(*sql.Row) (*SMTPConfig, er
 			SMTPConfigHTTPColumnID.identifier(),
 			SMTPConfigHTTPColumnEndpoint.identifier(),
 			SMTPConfigHTTPColumnSigningKey.identifier(),
+
+			SMTPConfigAPIColumnID.identifier(),
+			SMTPConfigAPIColumnProvider.identifier(),
+			SMTPConfigAPIColumnSenderAddress.identifier(),
+			SMTPConfigAPIColumnSenderName.identifier(),
+			SMTPConfigAPIColumnReplyToAddress.identifier(),
+			SMTPConfigAPIColumnRegion.identifier(),
+			SMTPConfigAPIColumnDomain.identifier(),
+			SMTPConfigAPIColumnAccessKeyID.identifier(),
+			SMTPConfigAPIColumnAPIKey.identifier(),
 		).
 			From(smtpConfigsTable.identifier()).
 			LeftJoin(join(SMTPConfigSMTPColumnID, SMTPConfigColumnID)).
 			LeftJoin(join(SMTPConfigHTTPColumnID, SMTPConfigColumnID)).
+			LeftJoin(join(SMTPConfigAPIColumnID, SMTPConfigColumnID)).
 			PlaceholderFormat(sq.Dollar),
 		func(row *sql.Row) (*SMTPConfig, error) {
 			config := new(SMTPConfig)
 			var (
 				smtpConfig = sqlSmtpConfig{}
 				httpConfig = sqlHTTPConfig{}
+				apiConfig  = sqlEmailAPIConfig{}
 			)
 			err := row.Scan(
 				&config.CreationDate,
@@ -317,6 +387,15 @@ func prepareSMTPConfigQuery() (sq.SelectBuilder, func(*sql.Row) (*SMTPConfig, er
 				&httpConfig.id,
 				&httpConfig.endpoint,
 				&httpConfig.signingKey,
+				&apiConfig.id,
+				&apiConfig.provider,
+				&apiConfig.senderAddress,
+				&apiConfig.senderName,
+				&apiConfig.replyToAddress,
+				&apiConfig.region,
+				&apiConfig.domain,
+				&apiConfig.accessKeyID,
+				&apiConfig.apiKey,
 			)
 			if err != nil {
 				if errors.Is(err, sql.ErrNoRows) {
@@ -327,6 +406,7 @@ func prepareSMTPConfigQuery() (sq.SelectBuilder, func(*sql.Row) (*SMTPConfig, er
 
 			smtpConfig.set(config)
 			httpConfig.setSMTP(config)
+			apiConfig.set(config)
 
 			return config, nil
 		}
@@ -359,10 +439,21 @@ func prepareSMTPConfigsQuery() (sq.SelectBuilder, func(*sql.Rows) (*SMTPConfigs,
 			SMTPConfigHTTPColumnEndpoint.identifier(),
 			SMTPConfigHTTPColumnSigningKey.identifier(),
 
+			SMTPConfigAPIColumnID.identifier(),
+			SMTPConfigAPIColumnProvider.identifier(),
+			SMTPConfigAPIColumnSenderAddress.identifier(),
+			SMTPConfigAPIColumnSenderName.identifier(),
+			SMTPConfigAPIColumnReplyToAddress.identifier(),
+			SMTPConfigAPIColumnRegion.identifier(),
+			SMTPConfigAPIColumnDomain.identifier(),
+			SMTPConfigAPIColumnAccessKeyID.identifier(),
+			SMTPConfigAPIColumnAPIKey.identifier(),
+
 			countColumn.identifier(),
 		).From(smtpConfigsTable.identifier()).
 			LeftJoin(join(SMTPConfigSMTPColumnID, SMTPConfigColumnID)).
 			LeftJoin(join(SMTPConfigHTTPColumnID, SMTPConfigColumnID)).
+			LeftJoin(join(SMTPConfigAPIColumnID, SMTPConfigColumnID)).
 			PlaceholderFormat(sq.Dollar),
 		func(rows *sql.Rows) (*SMTPConfigs, error) {
 			configs := &SMTPConfigs{Configs: []*SMTPConfig{}}
@@ -371,6 +462,7 @@ func prepareSMTPConfigsQuery() (sq.SelectBuilder, func(*sql.Rows) (*SMTPConfigs,
 				var (
 					smtpConfig = sqlSmtpConfig{}
 					httpConfig = sqlHTTPConfig{}
+					apiConfig  = sqlEmailAPIConfig{}
 				)
 				err := rows.Scan(
 					&config.CreationDate,
@@ -395,6 +487,15 @@ func prepareSMTPConfigsQuery() (sq.SelectBuilder, func(*sql.Rows) (*SMTPConfigs,
 					&httpConfig.id,
 					&httpConfig.endpoint,
 					&httpConfig.signingKey,
+					&apiConfig.id,
+					&apiConfig.provider,
+					&apiConfig.senderAddress,
+					&apiConfig.senderName,
+					&apiConfig.replyToAddress,
+					&apiConfig.region,
+					&apiConfig.domain,
+					&apiConfig.accessKeyID,
+					&apiConfig.apiKey,
 					&configs.Count,
 				)
 				if err != nil {
@@ -403,6 +504,7 @@ func prepareSMTPConfigsQuery() (sq.SelectBuilder, func(*sql.Rows) (*SMTPConfigs,
 
 				smtpConfig.set(config)
 				httpConfig.setSMTP(config)
+				apiConfig.set(config)
 
 				configs.Configs = append(configs.Configs, config)
 			}
@@ -502,3 +604,31 @@ func (c sqlHTTPConfig) setSMTP(smtpConfig *SMTPConfig) {
 		signingKey: c.signingKey,
 	}
 }
+
+type sqlEmailAPIConfig struct {
+	id             sql.NullString
+	provider       sql.NullInt32
+	senderAddress  sql.NullString
+	senderName     sql.NullString
+	replyToAddress sql.NullString
+	region         sql.NullString
+	domain         sql.NullString
+	accessKeyID    sql.NullString
+	apiKey         *crypto.CryptoValue
+}
+
+func (c sqlEmailAPIConfig) set(smtpConfig *SMTPConfig) {
+	if !c.id.Valid {
+		return
+	}
+	smtpConfig.APIConfig = &EmailAPI{
+		Provider:       domain.EmailAPIProviderType(c.provider.Int32),
+		SenderAddress:  c.senderAddress.String,
+		SenderName:     c.senderName.String,
+		ReplyToAddress: c.replyToAddress.String,
+		Region:         c.region.String,
+		Domain:         c.domain.String,
+		AccessKeyID:    c.accessKeyID.String,
+		APIKey:         c.apiKey,
+	}
+}
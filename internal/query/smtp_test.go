@@ -35,10 +35,20 @@ var (
 		` projections.smtp_configs6_smtp.xoauth2auth_scope,` +
 		` projections.smtp_configs6_http.id,` +
 		` projections.smtp_configs6_http.endpoint,` +
-		` projections.smtp_configs6_http.signing_key` +
+		` projections.smtp_configs6_http.signing_key,` +
+		` projections.smtp_configs6_api.id,` +
+		` projections.smtp_configs6_api.provider,` +
+		` projections.smtp_configs6_api.sender_address,` +
+		` projections.smtp_configs6_api.sender_name,` +
+		` projections.smtp_configs6_api.reply_to_address,` +
+		` projections.smtp_configs6_api.region,` +
+		` projections.smtp_configs6_api.domain,` +
+		` projections.smtp_configs6_api.access_key_id,` +
+		` projections.smtp_configs6_api.api_key` +
 		` FROM projections.smtp_configs6` +
 		` LEFT JOIN projections.smtp_configs6_smtp ON projections.smtp_configs6.id = projections.smtp_configs6_smtp.id AND projections.smtp_configs6.instance_id = projections.smtp_configs6_smtp.instance_id` +
-		` LEFT JOIN projections.smtp_configs6_http ON projections.smtp_configs6.id = projections.smtp_configs6_http.id AND projections.smtp_configs6.instance_id = projections.smtp_configs6_http.instance_id`
+		` LEFT JOIN projections.smtp_configs6_http ON projections.smtp_configs6.id = projections.smtp_configs6_http.id AND projections.smtp_configs6.instance_id = projections.smtp_configs6_http.instance_id` +
+		` LEFT JOIN projections.smtp_configs6_api ON projections.smtp_configs6.id = projections.smtp_configs6_api.id AND projections.smtp_configs6.instance_id = projections.smtp_configs6_api.instance_id`
 	prepareSMTPConfigsStmt = `SELECT projections.smtp_configs6.creation_date,` +
 		` projections.smtp_configs6.change_date,` +
 		` projections.smtp_configs6.resource_owner,` +
@@ -61,10 +71,20 @@ var (
 		` projections.smtp_configs6_http.id,` +
 		` projections.smtp_configs6_http.endpoint,` +
 		` projections.smtp_configs6_http.signing_key,` +
+		` projections.smtp_configs6_api.id,` +
+		` projections.smtp_configs6_api.provider,` +
+		` projections.smtp_configs6_api.sender_address,` +
+		` projections.smtp_configs6_api.sender_name,` +
+		` projections.smtp_configs6_api.reply_to_address,` +
+		` projections.smtp_configs6_api.region,` +
+		` projections.smtp_configs6_api.domain,` +
+		` projections.smtp_configs6_api.access_key_id,` +
+		` projections.smtp_configs6_api.api_key,` +
 		` COUNT(*) OVER ()` +
 		` FROM projections.smtp_configs6` +
 		` LEFT JOIN projections.smtp_configs6_smtp ON projections.smtp_configs6.id = projections.smtp_configs6_smtp.id AND projections.smtp_configs6.instance_id = projections.smtp_configs6_smtp.instance_id` +
-		` LEFT JOIN projections.smtp_configs6_http ON projections.smtp_configs6.id = projections.smtp_configs6_http.id AND projections.smtp_configs6.instance_id = projections.smtp_configs6_http.instance_id`
+		` LEFT JOIN projections.smtp_configs6_http ON projections.smtp_configs6.id = projections.smtp_configs6_http.id AND projections.smtp_configs6.instance_id = projections.smtp_configs6_http.instance_id` +
+		` LEFT JOIN projections.smtp_configs6_api ON projections.smtp_configs6.id = projections.smtp_configs6_api.id AND projections.smtp_configs6.instance_id = projections.smtp_configs6_api.instance_id`
 
 	prepareSMTPConfigCols = []string{
 		"creation_date",
@@ -89,6 +109,15 @@ var (
 		"id",
 		"endpoint",
 		"signing_key",
+		"id",
+		"provider",
+		"sender_address",
+		"sender_name",
+		"reply_to_address",
+		"region",
+		"domain",
+		"access_key_id",
+		"api_key",
 	}
 	prepareSMTPConfigsCols = append(prepareSMTPConfigCols, "count")
 )
@@ -152,6 +181,15 @@ func Test_SMTPConfigPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -211,6 +249,15 @@ func Test_SMTPConfigPrepares(t *testing.T) {
 							KeyID:      "encKey",
 							Crypted:    []byte("crypted"),
 						},
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -233,6 +280,77 @@ func Test_SMTPConfigPrepares(t *testing.T) {
 				Description: "test",
 			},
 		},
+		{
+			name:    "prepareSMTPConfigQuery found, api",
+			prepare: prepareSMTPConfigQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareSMTPConfigStmt),
+					prepareSMTPConfigCols,
+					[]driver.Value{
+						testNow,
+						testNow,
+						"ro",
+						uint64(20211108),
+						"2232323",
+						domain.SMTPConfigStateActive,
+						"test",
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						"2232323",
+						domain.EmailAPIProviderTypeSES,
+						"sender",
+						"name",
+						"reply-to",
+						"eu-central-1",
+						"",
+						"access-key-id",
+						&crypto.CryptoValue{
+							CryptoType: crypto.TypeEncryption,
+							Algorithm:  "alg",
+							KeyID:      "encKey",
+							Crypted:    []byte("crypted"),
+						},
+					},
+				),
+			},
+			object: &SMTPConfig{
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				ResourceOwner: "ro",
+				Sequence:      20211108,
+				APIConfig: &EmailAPI{
+					Provider:       domain.EmailAPIProviderTypeSES,
+					SenderAddress:  "sender",
+					SenderName:     "name",
+					ReplyToAddress: "reply-to",
+					Region:         "eu-central-1",
+					AccessKeyID:    "access-key-id",
+					APIKey: &crypto.CryptoValue{
+						CryptoType: crypto.TypeEncryption,
+						Algorithm:  "alg",
+						KeyID:      "encKey",
+						Crypted:    []byte("crypted"),
+					},
+				},
+				ID:          "2232323",
+				State:       domain.SMTPConfigStateActive,
+				Description: "test",
+			},
+		},
 		{
 			name:    "prepareSMTPConfigQuery another config found",
 			prepare: prepareSMTPConfigQuery,
@@ -263,6 +381,15 @@ func Test_SMTPConfigPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -317,6 +444,15 @@ func Test_SMTPConfigPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -421,6 +557,15 @@ func Test_SMTPConfigsPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -489,6 +634,15 @@ func Test_SMTPConfigsPrepares(t *testing.T) {
 								KeyID:      "encKey",
 								Crypted:    []byte("crypted"),
 							},
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 						{
 							testNow,
@@ -513,6 +667,15 @@ func Test_SMTPConfigsPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 						{
 							testNow,
@@ -537,6 +700,15 @@ func Test_SMTPConfigsPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
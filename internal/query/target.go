@@ -3,6 +3,7 @@ package query
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -69,6 +70,14 @@ var (
 		name:  projection.TargetPayloadType,
 		table: targetTable,
 	}
+	TargetColumnRetryPolicy = Column{
+		name:  projection.TargetRetryPolicy,
+		table: targetTable,
+	}
+	TargetColumnClientCertificate = Column{
+		name:  projection.TargetClientCertificate,
+		table: targetTable,
+	}
 )
 
 type Targets struct {
@@ -91,6 +100,15 @@ type Target struct {
 	signingKey       *crypto.CryptoValue
 	SigningKey       string
 	PayloadType      target_domain.PayloadType
+	RetryPolicy      *target_domain.RetryPolicy
+}
+
+func (t *Target) unmarshalRetryPolicy(retryPolicy []byte) error {
+	if len(retryPolicy) == 0 {
+		return nil
+	}
+	t.RetryPolicy = new(target_domain.RetryPolicy)
+	return json.Unmarshal(retryPolicy, t.RetryPolicy)
 }
 
 func (t *Target) decryptSigningKey(alg crypto.EncryptionAlgorithm) error {
@@ -151,6 +169,17 @@ func (q *Queries) GetTargetByID(ctx context.Context, id string) (*Target, error)
 	return target, nil
 }
 
+// GetExecutionTargetByID returns the target with the configuration needed to call it,
+// including the active public key for payload encryption.
+func (q *Queries) GetExecutionTargetByID(ctx context.Context, id string) (*target_domain.Target, error) {
+	eq := sq.Eq{
+		TargetColumnID.identifier():         id,
+		TargetColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareExecutionTargetQuery()
+	return genericRowQuery(ctx, q.client, query.Where(eq), scan)
+}
+
 func NewTargetNameSearchQuery(method TextComparison, value string) (SearchQuery, error) {
 	return NewTextQuery(TargetColumnName, value, method)
 }
@@ -172,6 +201,7 @@ func prepareTargetsQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*Targets, er
 			TargetColumnInterruptOnError.identifier(),
 			TargetColumnSigningKey.identifier(),
 			TargetColumnPayloadType.identifier(),
+			TargetColumnRetryPolicy.identifier(),
 			countColumn.identifier(),
 		).From(targetTable.identifier()).
 			PlaceholderFormat(sq.Dollar),
@@ -180,6 +210,7 @@ func prepareTargetsQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*Targets, er
 			var count uint64
 			for rows.Next() {
 				target := new(Target)
+				var retryPolicy []byte
 				err := rows.Scan(
 					&target.ID,
 					&target.CreationDate,
@@ -192,11 +223,15 @@ func prepareTargetsQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*Targets, er
 					&target.InterruptOnError,
 					&target.signingKey,
 					&target.PayloadType,
+					&retryPolicy,
 					&count,
 				)
 				if err != nil {
 					return nil, err
 				}
+				if err := target.unmarshalRetryPolicy(retryPolicy); err != nil {
+					return nil, zerrors.ThrowInternal(err, "QUERY-Rt4pQ1", "Errors.Internal")
+				}
 				targets = append(targets, target)
 			}
 
@@ -226,10 +261,12 @@ func prepareTargetQuery() (sq.SelectBuilder, func(row *sql.Row) (*Target, error)
 			TargetColumnInterruptOnError.identifier(),
 			TargetColumnSigningKey.identifier(),
 			TargetColumnPayloadType.identifier(),
+			TargetColumnRetryPolicy.identifier(),
 		).From(targetTable.identifier()).
 			PlaceholderFormat(sq.Dollar),
 		func(row *sql.Row) (*Target, error) {
 			target := new(Target)
+			var retryPolicy []byte
 			err := row.Scan(
 				&target.ID,
 				&target.CreationDate,
@@ -242,6 +279,7 @@ func prepareTargetQuery() (sq.SelectBuilder, func(row *sql.Row) (*Target, error)
 				&target.InterruptOnError,
 				&target.signingKey,
 				&target.PayloadType,
+				&retryPolicy,
 			)
 			if err != nil {
 				if errors.Is(err, sql.ErrNoRows) {
@@ -249,6 +287,64 @@ func prepareTargetQuery() (sq.SelectBuilder, func(row *sql.Row) (*Target, error)
 				}
 				return nil, zerrors.ThrowInternal(err, "QUERY-5qhc19sc49", "Errors.Internal")
 			}
+			if err := target.unmarshalRetryPolicy(retryPolicy); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Rt4pQ2", "Errors.Internal")
+			}
+			return target, nil
+		}
+}
+
+func prepareExecutionTargetQuery() (sq.SelectBuilder, func(row *sql.Row) (*target_domain.Target, error)) {
+	return sq.Select(
+			TargetColumnID.identifier(),
+			TargetColumnTargetType.identifier(),
+			TargetColumnURL.identifier(),
+			TargetColumnTimeout.identifier(),
+			TargetColumnInterruptOnError.identifier(),
+			TargetColumnSigningKey.identifier(),
+			TargetColumnPayloadType.identifier(),
+			TargetColumnClientCertificate.identifier(),
+			TargetColumnRetryPolicy.identifier(),
+			AuthNKeyColumnPublicKey.identifier(),
+			AuthNKeyColumnID.identifier(),
+		).From(targetTable.identifier()).
+			LeftJoin(join(AuthNKeyColumnObjectID, TargetColumnID) +
+				" AND " + AuthNKeyColumnEnabled.identifier() + " = TRUE" +
+				" AND (" + AuthNKeyColumnExpiration.identifier() + " IS NULL OR " + AuthNKeyColumnExpiration.identifier() + " > now())",
+			).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*target_domain.Target, error) {
+			target := new(target_domain.Target)
+			var (
+				retryPolicy     []byte
+				encryptionKeyID sql.NullString
+			)
+			err := row.Scan(
+				&target.TargetID,
+				&target.TargetType,
+				&target.Endpoint,
+				&target.Timeout,
+				&target.InterruptOnError,
+				&target.SigningKey,
+				&target.PayloadType,
+				&target.ClientCertificate,
+				&retryPolicy,
+				&target.EncryptionKey,
+				&encryptionKeyID,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Tt5eQ1", "Errors.Target.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Tt5eQ2", "Errors.Internal")
+			}
+			target.EncryptionKeyID = encryptionKeyID.String
+			if len(retryPolicy) > 0 {
+				target.RetryPolicy = new(target_domain.RetryPolicy)
+				if err := json.Unmarshal(retryPolicy, target.RetryPolicy); err != nil {
+					return nil, zerrors.ThrowInternal(err, "QUERY-Tt5eQ3", "Errors.Internal")
+				}
+			}
 			return target, nil
 		}
 }
@@ -0,0 +1,157 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	emit_target "github.com/zitadel/zitadel/internal/logstore/emitters/target"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	targetCallTable = table{
+		name:          emit_target.Table,
+		instanceIDCol: emit_target.InstanceIDCol,
+	}
+	TargetCallColumnLogDate = Column{
+		name:  emit_target.LogDateCol,
+		table: targetCallTable,
+	}
+	TargetCallColumnInstanceID = Column{
+		name:  emit_target.InstanceIDCol,
+		table: targetCallTable,
+	}
+	TargetCallColumnTargetID = Column{
+		name:  emit_target.TargetIDCol,
+		table: targetCallTable,
+	}
+	TargetCallColumnExecutionID = Column{
+		name:  emit_target.ExecutionIDCol,
+		table: targetCallTable,
+	}
+	TargetCallColumnStatusCode = Column{
+		name:  emit_target.StatusCodeCol,
+		table: targetCallTable,
+	}
+	TargetCallColumnTook = Column{
+		name:  emit_target.TookCol,
+		table: targetCallTable,
+	}
+	TargetCallColumnResponseBody = Column{
+		name:  emit_target.ResponseBodyCol,
+		table: targetCallTable,
+	}
+	TargetCallColumnError = Column{
+		name:  emit_target.ErrorCol,
+		table: targetCallTable,
+	}
+	TargetCallColumnInterrupted = Column{
+		name:  emit_target.InterruptedCol,
+		table: targetCallTable,
+	}
+)
+
+type TargetCalls struct {
+	SearchResponse
+	TargetCalls []*TargetCall
+}
+
+// TargetCall is a recorded call of a target.
+type TargetCall struct {
+	LogDate      time.Time
+	TargetID     string
+	ExecutionID  string
+	StatusCode   int
+	Took         time.Duration
+	ResponseBody string
+	Error        string
+	Interrupted  bool
+}
+
+type TargetCallSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *TargetCallSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+// SearchTargetCalls returns the recorded calls of a target.
+// The calls are not projected from events, so there is no projection state.
+func (q *Queries) SearchTargetCalls(ctx context.Context, targetID string, queries *TargetCallSearchQueries) (*TargetCalls, error) {
+	eq := sq.Eq{
+		TargetCallColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		TargetCallColumnTargetID.identifier():   targetID,
+	}
+	query, scan := prepareTargetCallsQuery()
+	return genericRowsQuery(ctx, q.client, combineToWhereStmt(query, queries.toQuery, eq), scan)
+}
+
+func NewTargetCallExecutionIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(TargetCallColumnExecutionID, value, TextEquals)
+}
+
+func NewTargetCallInterruptedSearchQuery(value bool) (SearchQuery, error) {
+	return NewBoolQuery(TargetCallColumnInterrupted, value)
+}
+
+func NewTargetCallLogDateSearchQuery(value time.Time, method TimestampComparison) (SearchQuery, error) {
+	return NewTimestampQuery(TargetCallColumnLogDate, value, method)
+}
+
+func prepareTargetCallsQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*TargetCalls, error)) {
+	return sq.Select(
+			TargetCallColumnLogDate.identifier(),
+			TargetCallColumnTargetID.identifier(),
+			TargetCallColumnExecutionID.identifier(),
+			TargetCallColumnStatusCode.identifier(),
+			TargetCallColumnTook.identifier(),
+			TargetCallColumnResponseBody.identifier(),
+			TargetCallColumnError.identifier(),
+			TargetCallColumnInterrupted.identifier(),
+			countColumn.identifier(),
+		).From(targetCallTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*TargetCalls, error) {
+			calls := make([]*TargetCall, 0)
+			var count uint64
+			for rows.Next() {
+				call := new(TargetCall)
+				err := rows.Scan(
+					&call.LogDate,
+					&call.TargetID,
+					&call.ExecutionID,
+					&call.StatusCode,
+					&call.Took,
+					&call.ResponseBody,
+					&call.Error,
+					&call.Interrupted,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				calls = append(calls, call)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Tc5lQ1", "Errors.Query.CloseRows")
+			}
+
+			return &TargetCalls{
+				TargetCalls: calls,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
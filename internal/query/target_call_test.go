@@ -0,0 +1,140 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var (
+	prepareTargetCallsStmt = `SELECT logstore.target_calls.log_date,` +
+		` logstore.target_calls.target_id,` +
+		` logstore.target_calls.execution_id,` +
+		` logstore.target_calls.status_code,` +
+		` logstore.target_calls.took,` +
+		` logstore.target_calls.response_body,` +
+		` logstore.target_calls.error,` +
+		` logstore.target_calls.interrupted,` +
+		` COUNT(*) OVER ()` +
+		` FROM logstore.target_calls`
+	prepareTargetCallsCols = []string{
+		"log_date",
+		"target_id",
+		"execution_id",
+		"status_code",
+		"took",
+		"response_body",
+		"error",
+		"interrupted",
+		"count",
+	}
+)
+
+func Test_TargetCallPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareTargetCallsQuery no result",
+			prepare: prepareTargetCallsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareTargetCallsStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &TargetCalls{TargetCalls: []*TargetCall{}},
+		},
+		{
+			name:    "prepareTargetCallsQuery multiple results",
+			prepare: prepareTargetCallsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareTargetCallsStmt),
+					prepareTargetCallsCols,
+					[][]driver.Value{
+						{
+							testNow,
+							"target-id",
+							"function/preuserinfo",
+							200,
+							int64(time.Second),
+							`{"set_user_metadata":[]}`,
+							"",
+							false,
+						},
+						{
+							testNow,
+							"target-id",
+							"request/zitadel.session.v2.SessionService/CreateSession",
+							500,
+							int64(2 * time.Second),
+							"internal error",
+							"Errors.Execution.Failed",
+							true,
+						},
+					},
+				),
+			},
+			object: &TargetCalls{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				TargetCalls: []*TargetCall{
+					{
+						LogDate:      testNow,
+						TargetID:     "target-id",
+						ExecutionID:  "function/preuserinfo",
+						StatusCode:   200,
+						Took:         time.Second,
+						ResponseBody: `{"set_user_metadata":[]}`,
+					},
+					{
+						LogDate:      testNow,
+						TargetID:     "target-id",
+						ExecutionID:  "request/zitadel.session.v2.SessionService/CreateSession",
+						StatusCode:   500,
+						Took:         2 * time.Second,
+						ResponseBody: "internal error",
+						Error:        "Errors.Execution.Failed",
+						Interrupted:  true,
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareTargetCallsQuery sql err",
+			prepare: prepareTargetCallsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareTargetCallsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*TargetCalls)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
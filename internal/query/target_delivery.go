@@ -0,0 +1,254 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	exec_repo "github.com/zitadel/zitadel/internal/repository/execution"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	targetDeliveryTable = table{
+		name:          projection.TargetDeliveryTable,
+		instanceIDCol: projection.TargetDeliveryInstanceIDCol,
+	}
+	TargetDeliveryColumnID = Column{
+		name:  projection.TargetDeliveryIDCol,
+		table: targetDeliveryTable,
+	}
+	TargetDeliveryColumnCreationDate = Column{
+		name:  projection.TargetDeliveryCreationDateCol,
+		table: targetDeliveryTable,
+	}
+	TargetDeliveryColumnChangeDate = Column{
+		name:  projection.TargetDeliveryChangeDateCol,
+		table: targetDeliveryTable,
+	}
+	TargetDeliveryColumnInstanceID = Column{
+		name:  projection.TargetDeliveryInstanceIDCol,
+		table: targetDeliveryTable,
+	}
+	TargetDeliveryColumnTargetID = Column{
+		name:  projection.TargetDeliveryTargetIDCol,
+		table: targetDeliveryTable,
+	}
+	TargetDeliveryColumnEventType = Column{
+		name:  projection.TargetDeliveryEventTypeCol,
+		table: targetDeliveryTable,
+	}
+	TargetDeliveryColumnAggregateType = Column{
+		name:  projection.TargetDeliveryAggregateTypeCol,
+		table: targetDeliveryTable,
+	}
+	TargetDeliveryColumnAggregateID = Column{
+		name:  projection.TargetDeliveryAggregateIDCol,
+		table: targetDeliveryTable,
+	}
+	TargetDeliveryColumnRequest = Column{
+		name:  projection.TargetDeliveryRequestCol,
+		table: targetDeliveryTable,
+	}
+	TargetDeliveryColumnLastError = Column{
+		name:  projection.TargetDeliveryLastErrorCol,
+		table: targetDeliveryTable,
+	}
+	TargetDeliveryColumnAttempts = Column{
+		name:  projection.TargetDeliveryAttemptsCol,
+		table: targetDeliveryTable,
+	}
+	TargetDeliveryColumnState = Column{
+		name:  projection.TargetDeliveryStateCol,
+		table: targetDeliveryTable,
+	}
+)
+
+type TargetDeliveries struct {
+	SearchResponse
+	TargetDeliveries []*TargetDelivery
+}
+
+func (t *TargetDeliveries) SetState(s *State) {
+	t.State = s
+}
+
+// TargetDelivery is a call of an event execution to a target, which failed after all retries.
+type TargetDelivery struct {
+	domain.ObjectDetails
+
+	TargetID      string
+	EventType     string
+	AggregateType string
+	AggregateID   string
+	Request       *exec_repo.Request
+	LastError     string
+	Attempts      uint8
+	State         domain.TargetDeliveryState
+}
+
+func (t *TargetDelivery) unmarshalRequest(request []byte) error {
+	if len(request) == 0 {
+		return nil
+	}
+	t.Request = new(exec_repo.Request)
+	return json.Unmarshal(request, t.Request)
+}
+
+// Payload returns the body sent to the target, without the configuration of the target.
+func (t *TargetDelivery) Payload() []byte {
+	if t.Request == nil || t.Request.Aggregate == nil {
+		return nil
+	}
+	return exec_repo.ContextInfoFromRequest(t.Request).GetHTTPRequestBody()
+}
+
+type TargetDeliverySearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *TargetDeliverySearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func (q *Queries) SearchTargetDeliveries(ctx context.Context, queries *TargetDeliverySearchQueries) (*TargetDeliveries, error) {
+	eq := sq.Eq{
+		TargetDeliveryColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareTargetDeliveriesQuery()
+	return genericRowsQueryWithState(ctx, q.client, targetDeliveryTable, combineToWhereStmt(query, queries.toQuery, eq), scan)
+}
+
+func (q *Queries) GetTargetDeliveryByID(ctx context.Context, id string) (*TargetDelivery, error) {
+	eq := sq.Eq{
+		TargetDeliveryColumnID.identifier():         id,
+		TargetDeliveryColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareTargetDeliveryQuery()
+	return genericRowQuery(ctx, q.client, query.Where(eq), scan)
+}
+
+func NewTargetDeliveryTargetIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(TargetDeliveryColumnTargetID, value, TextEquals)
+}
+
+func NewTargetDeliveryEventTypeSearchQuery(method TextComparison, value string) (SearchQuery, error) {
+	return NewTextQuery(TargetDeliveryColumnEventType, value, method)
+}
+
+func NewTargetDeliveryStateSearchQuery(value domain.TargetDeliveryState) (SearchQuery, error) {
+	return NewNumberQuery(TargetDeliveryColumnState, int(value), NumberEquals)
+}
+
+func prepareTargetDeliveriesQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*TargetDeliveries, error)) {
+	return sq.Select(
+			TargetDeliveryColumnID.identifier(),
+			TargetDeliveryColumnCreationDate.identifier(),
+			TargetDeliveryColumnChangeDate.identifier(),
+			TargetDeliveryColumnTargetID.identifier(),
+			TargetDeliveryColumnEventType.identifier(),
+			TargetDeliveryColumnAggregateType.identifier(),
+			TargetDeliveryColumnAggregateID.identifier(),
+			TargetDeliveryColumnRequest.identifier(),
+			TargetDeliveryColumnLastError.identifier(),
+			TargetDeliveryColumnAttempts.identifier(),
+			TargetDeliveryColumnState.identifier(),
+			countColumn.identifier(),
+		).From(targetDeliveryTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*TargetDeliveries, error) {
+			deliveries := make([]*TargetDelivery, 0)
+			var count uint64
+			for rows.Next() {
+				delivery := new(TargetDelivery)
+				var request []byte
+				err := rows.Scan(
+					&delivery.ID,
+					&delivery.CreationDate,
+					&delivery.EventDate,
+					&delivery.TargetID,
+					&delivery.EventType,
+					&delivery.AggregateType,
+					&delivery.AggregateID,
+					&request,
+					&delivery.LastError,
+					&delivery.Attempts,
+					&delivery.State,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				if err := delivery.unmarshalRequest(request); err != nil {
+					return nil, zerrors.ThrowInternal(err, "QUERY-Dl4vQ1", "Errors.Internal")
+				}
+				deliveries = append(deliveries, delivery)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Dl4vQ2", "Errors.Query.CloseRows")
+			}
+
+			return &TargetDeliveries{
+				TargetDeliveries: deliveries,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+func prepareTargetDeliveryQuery() (sq.SelectBuilder, func(row *sql.Row) (*TargetDelivery, error)) {
+	return sq.Select(
+			TargetDeliveryColumnID.identifier(),
+			TargetDeliveryColumnCreationDate.identifier(),
+			TargetDeliveryColumnChangeDate.identifier(),
+			TargetDeliveryColumnTargetID.identifier(),
+			TargetDeliveryColumnEventType.identifier(),
+			TargetDeliveryColumnAggregateType.identifier(),
+			TargetDeliveryColumnAggregateID.identifier(),
+			TargetDeliveryColumnRequest.identifier(),
+			TargetDeliveryColumnLastError.identifier(),
+			TargetDeliveryColumnAttempts.identifier(),
+			TargetDeliveryColumnState.identifier(),
+		).From(targetDeliveryTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*TargetDelivery, error) {
+			delivery := new(TargetDelivery)
+			var request []byte
+			err := row.Scan(
+				&delivery.ID,
+				&delivery.CreationDate,
+				&delivery.EventDate,
+				&delivery.TargetID,
+				&delivery.EventType,
+				&delivery.AggregateType,
+				&delivery.AggregateID,
+				&request,
+				&delivery.LastError,
+				&delivery.Attempts,
+				&delivery.State,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Dl4vQ3", "Errors.Target.DeliveryNotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Dl4vQ4", "Errors.Internal")
+			}
+			if err := delivery.unmarshalRequest(request); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Dl4vQ5", "Errors.Internal")
+			}
+			return delivery, nil
+		}
+}
@@ -0,0 +1,227 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	exec_repo "github.com/zitadel/zitadel/internal/repository/execution"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	prepareTargetDeliveriesStmt = `SELECT projections.target_deliveries.id,` +
+		` projections.target_deliveries.creation_date,` +
+		` projections.target_deliveries.change_date,` +
+		` projections.target_deliveries.target_id,` +
+		` projections.target_deliveries.event_type,` +
+		` projections.target_deliveries.aggregate_type,` +
+		` projections.target_deliveries.aggregate_id,` +
+		` projections.target_deliveries.request,` +
+		` projections.target_deliveries.last_error,` +
+		` projections.target_deliveries.attempts,` +
+		` projections.target_deliveries.state,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.target_deliveries`
+	prepareTargetDeliveriesCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"target_id",
+		"event_type",
+		"aggregate_type",
+		"aggregate_id",
+		"request",
+		"last_error",
+		"attempts",
+		"state",
+		"count",
+	}
+
+	prepareTargetDeliveryStmt = `SELECT projections.target_deliveries.id,` +
+		` projections.target_deliveries.creation_date,` +
+		` projections.target_deliveries.change_date,` +
+		` projections.target_deliveries.target_id,` +
+		` projections.target_deliveries.event_type,` +
+		` projections.target_deliveries.aggregate_type,` +
+		` projections.target_deliveries.aggregate_id,` +
+		` projections.target_deliveries.request,` +
+		` projections.target_deliveries.last_error,` +
+		` projections.target_deliveries.attempts,` +
+		` projections.target_deliveries.state` +
+		` FROM projections.target_deliveries`
+	prepareTargetDeliveryCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"target_id",
+		"event_type",
+		"aggregate_type",
+		"aggregate_id",
+		"request",
+		"last_error",
+		"attempts",
+		"state",
+	}
+)
+
+func Test_TargetDeliveryPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareTargetDeliveriesQuery no result",
+			prepare: prepareTargetDeliveriesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareTargetDeliveriesStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &TargetDeliveries{TargetDeliveries: []*TargetDelivery{}},
+		},
+		{
+			name:    "prepareTargetDeliveriesQuery one result",
+			prepare: prepareTargetDeliveriesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareTargetDeliveriesStmt),
+					prepareTargetDeliveriesCols,
+					[][]driver.Value{
+						{
+							"id",
+							testNow,
+							testNow,
+							"target-id",
+							"user.human.added",
+							"user",
+							"user-id",
+							[]byte(`{"eventType":"user.human.added","sequence":1}`),
+							"unavailable",
+							3,
+							domain.TargetDeliveryStateFailed,
+						},
+					},
+				),
+			},
+			object: &TargetDeliveries{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				TargetDeliveries: []*TargetDelivery{
+					{
+						ObjectDetails: domain.ObjectDetails{
+							ID:           "id",
+							EventDate:    testNow,
+							CreationDate: testNow,
+						},
+						TargetID:      "target-id",
+						EventType:     "user.human.added",
+						AggregateType: "user",
+						AggregateID:   "user-id",
+						Request: &exec_repo.Request{
+							EventType: "user.human.added",
+							Sequence:  1,
+						},
+						LastError: "unavailable",
+						Attempts:  3,
+						State:     domain.TargetDeliveryStateFailed,
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareTargetDeliveriesQuery sql err",
+			prepare: prepareTargetDeliveriesQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareTargetDeliveriesStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*TargetDeliveries)(nil),
+		},
+		{
+			name:    "prepareTargetDeliveryQuery no result",
+			prepare: prepareTargetDeliveryQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					regexp.QuoteMeta(prepareTargetDeliveryStmt),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*TargetDelivery)(nil),
+		},
+		{
+			name:    "prepareTargetDeliveryQuery found",
+			prepare: prepareTargetDeliveryQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareTargetDeliveryStmt),
+					prepareTargetDeliveryCols,
+					[]driver.Value{
+						"id",
+						testNow,
+						testNow,
+						"target-id",
+						"user.human.added",
+						"user",
+						"user-id",
+						[]byte(`{"eventType":"user.human.added","sequence":1}`),
+						"unavailable",
+						3,
+						domain.TargetDeliveryStateRedriving,
+					},
+				),
+			},
+			object: &TargetDelivery{
+				ObjectDetails: domain.ObjectDetails{
+					ID:           "id",
+					EventDate:    testNow,
+					CreationDate: testNow,
+				},
+				TargetID:      "target-id",
+				EventType:     "user.human.added",
+				AggregateType: "user",
+				AggregateID:   "user-id",
+				Request: &exec_repo.Request{
+					EventType: "user.human.added",
+					Sequence:  1,
+				},
+				LastError: "unavailable",
+				Attempts:  3,
+				State:     domain.TargetDeliveryStateRedriving,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
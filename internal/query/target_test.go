@@ -27,6 +27,7 @@ var (
 		` projections.targets2.interrupt_on_error,` +
 		` projections.targets2.signing_key,` +
 		` projections.targets2.payload_type,` +
+		` projections.targets2.retry_policy,` +
 		` COUNT(*) OVER ()` +
 		` FROM projections.targets2`
 	prepareTargetsCols = []string{
@@ -41,6 +42,7 @@ var (
 		"interrupt_on_error",
 		"signing_key",
 		"payload_type",
+		"retry_policy",
 		"count",
 	}
 
@@ -54,7 +56,8 @@ var (
 		` projections.targets2.endpoint,` +
 		` projections.targets2.interrupt_on_error,` +
 		` projections.targets2.signing_key,` +
-		` projections.targets2.payload_type` +
+		` projections.targets2.payload_type,` +
+		` projections.targets2.retry_policy` +
 		` FROM projections.targets2`
 	prepareTargetCols = []string{
 		"id",
@@ -68,9 +71,125 @@ var (
 		"interrupt_on_error",
 		"signing_key",
 		"payload_type",
+		"retry_policy",
+	}
+)
+
+var (
+	prepareExecutionTargetStmt = `SELECT projections.targets2.id,` +
+		` projections.targets2.target_type,` +
+		` projections.targets2.endpoint,` +
+		` projections.targets2.timeout,` +
+		` projections.targets2.interrupt_on_error,` +
+		` projections.targets2.signing_key,` +
+		` projections.targets2.payload_type,` +
+		` projections.targets2.client_certificate,` +
+		` projections.targets2.retry_policy,` +
+		` projections.authn_keys2.public_key,` +
+		` projections.authn_keys2.id` +
+		` FROM projections.targets2` +
+		` LEFT JOIN projections.authn_keys2 ON projections.targets2.id = projections.authn_keys2.object_id AND projections.targets2.instance_id = projections.authn_keys2.instance_id` +
+		` AND projections.authn_keys2.enabled = TRUE AND (projections.authn_keys2.expiration IS NULL OR projections.authn_keys2.expiration > now())`
+	prepareExecutionTargetCols = []string{
+		"id",
+		"target_type",
+		"endpoint",
+		"timeout",
+		"interrupt_on_error",
+		"signing_key",
+		"payload_type",
+		"client_certificate",
+		"retry_policy",
+		"public_key",
+		"id",
 	}
 )
 
+func Test_ExecutionTargetPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareExecutionTargetQuery no result",
+			prepare: prepareExecutionTargetQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					regexp.QuoteMeta(prepareExecutionTargetStmt),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*target_domain.Target)(nil),
+		},
+		{
+			name:    "prepareExecutionTargetQuery found",
+			prepare: prepareExecutionTargetQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareExecutionTargetStmt),
+					prepareExecutionTargetCols,
+					[]driver.Value{
+						"id",
+						target_domain.TargetTypeCall,
+						"https://example.com",
+						1 * time.Second,
+						true,
+						&crypto.CryptoValue{
+							CryptoType: crypto.TypeEncryption,
+							Algorithm:  "alg",
+							KeyID:      "encKey",
+							Crypted:    []byte("crypted"),
+						},
+						target_domain.PayloadTypeJWE,
+						nil,
+						[]byte(`{"max_retries":3,"initial_backoff":1000000000}`),
+						[]byte("public-key"),
+						"key-id",
+					},
+				),
+			},
+			object: &target_domain.Target{
+				TargetID:         "id",
+				TargetType:       target_domain.TargetTypeCall,
+				Endpoint:         "https://example.com",
+				Timeout:          1 * time.Second,
+				InterruptOnError: true,
+				SigningKey: &crypto.CryptoValue{
+					CryptoType: crypto.TypeEncryption,
+					Algorithm:  "alg",
+					KeyID:      "encKey",
+					Crypted:    []byte("crypted"),
+				},
+				PayloadType:     target_domain.PayloadTypeJWE,
+				EncryptionKey:   []byte("public-key"),
+				EncryptionKeyID: "key-id",
+				RetryPolicy: &target_domain.RetryPolicy{
+					MaxRetries:     3,
+					InitialBackoff: time.Second,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
+
 func Test_TargetPrepares(t *testing.T) {
 	type want struct {
 		sqlExpectations sqlExpectation
@@ -119,6 +238,7 @@ func Test_TargetPrepares(t *testing.T) {
 								Crypted:    []byte("crypted"),
 							},
 							target_domain.PayloadTypeJSON,
+							[]byte(`{"max_retries":3,"initial_backoff":1000000000}`),
 						},
 					},
 				),
@@ -147,6 +267,10 @@ func Test_TargetPrepares(t *testing.T) {
 							Crypted:    []byte("crypted"),
 						},
 						PayloadType: target_domain.PayloadTypeJSON,
+						RetryPolicy: &target_domain.RetryPolicy{
+							MaxRetries:     3,
+							InitialBackoff: time.Second,
+						},
 					},
 				},
 			},
@@ -176,6 +300,7 @@ func Test_TargetPrepares(t *testing.T) {
 								Crypted:    []byte("crypted"),
 							},
 							target_domain.PayloadTypeJSON,
+							nil,
 						},
 						{
 							"id-2",
@@ -194,6 +319,7 @@ func Test_TargetPrepares(t *testing.T) {
 								Crypted:    []byte("crypted"),
 							},
 							target_domain.PayloadTypeJWT,
+							nil,
 						},
 						{
 							"id-3",
@@ -212,6 +338,7 @@ func Test_TargetPrepares(t *testing.T) {
 								Crypted:    []byte("crypted"),
 							},
 							target_domain.PayloadTypeJWE,
+							nil,
 						},
 					},
 				),
@@ -343,6 +470,7 @@ func Test_TargetPrepares(t *testing.T) {
 							Crypted:    []byte("crypted"),
 						},
 						target_domain.PayloadTypeJSON,
+						nil,
 					},
 				),
 			},
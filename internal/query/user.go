@@ -33,6 +33,7 @@ type User struct {
 	Sequence           uint64                     `json:"sequence,omitempty"`
 	State              domain.UserState           `json:"state,omitempty"`
 	Type               domain.UserType            `json:"type,omitempty"`
+	LockedUntil        *time.Time                 `json:"locked_until,omitempty"`
 	Username           string                     `json:"username,omitempty"`
 	LoginNames         database.TextArray[string] `json:"login_names,omitempty"`
 	PreferredLoginName string                     `json:"preferred_login_name,omitempty"`
@@ -122,6 +123,9 @@ type NotifyUser struct {
 	LastPhone          string
 	VerifiedPhone      string
 	PasswordSet        bool
+	// UndeliverableEmail is set to the email address,
+	// which was reported as undeliverable by the email provider.
+	UndeliverableEmail string
 }
 
 func userPermissionCheckV2(ctx context.Context, query sq.SelectBuilder, enabled bool, filters []SearchQuery) sq.SelectBuilder {
@@ -189,6 +193,10 @@ var (
 		name:  projection.UserTypeCol,
 		table: userTable,
 	}
+	UserLockedUntilCol = Column{
+		name:  projection.UserLockedUntilCol,
+		table: userTable,
+	}
 
 	userLoginNamesTable         = loginNameTable.setAlias("login_names")
 	userLoginNamesUserIDCol     = LoginNameUserIDCol.setTable(userLoginNamesTable)
@@ -1003,6 +1011,8 @@ func scanUser(row *sql.Row) (*User, error) {
 	var count int
 	preferredLoginName := sql.NullString{}
 
+	lockedUntil := sql.NullTime{}
+
 	human, machine := sqlHuman{}, sqlMachine{}
 
 	err := row.Scan(
@@ -1013,6 +1023,7 @@ func scanUser(row *sql.Row) (*User, error) {
 		&u.Sequence,
 		&u.State,
 		&u.Type,
+		&lockedUntil,
 		&u.Username,
 		&u.LoginNames,
 		&preferredLoginName,
@@ -1047,6 +1058,9 @@ func scanUser(row *sql.Row) (*User, error) {
 	}
 
 	u.PreferredLoginName = preferredLoginName.String
+	if lockedUntil.Valid {
+		u.LockedUntil = &lockedUntil.Time
+	}
 
 	if human.humanID.Valid {
 		u.Human = &Human{
@@ -1262,11 +1276,13 @@ func prepareNotifyUserQuery() (sq.SelectBuilder, func(*sql.Row) (*NotifyUser, er
 			NotifyPhoneCol.identifier(),
 			NotifyVerifiedPhoneCol.identifier(),
 			NotifyPasswordSetCol.identifier(),
+			UserEmailUndeliverableEmailCol.identifier(),
 			countColumn.identifier(),
 		).
 			From(userTable.identifier()).
 			LeftJoin(join(HumanUserIDCol, UserIDCol)).
 			LeftJoin(join(NotifyUserIDCol, UserIDCol)).
+			LeftJoin(join(UserEmailUndeliverableUserIDCol, UserIDCol)).
 			JoinClause(joinLoginNames).
 			PlaceholderFormat(sq.Dollar),
 		scanNotifyUser
@@ -1293,6 +1309,7 @@ func scanNotifyUser(row *sql.Row) (*NotifyUser, error) {
 	notifyPhone := sql.NullString{}
 	notifyVerifiedPhone := sql.NullString{}
 	notifyPasswordSet := sql.NullBool{}
+	undeliverableEmail := sql.NullString{}
 
 	err := row.Scan(
 		&u.ID,
@@ -1319,6 +1336,7 @@ func scanNotifyUser(row *sql.Row) (*NotifyUser, error) {
 		&notifyPhone,
 		&notifyVerifiedPhone,
 		&notifyPasswordSet,
+		&undeliverableEmail,
 		&count,
 	)
 
@@ -1351,6 +1369,7 @@ func scanNotifyUser(row *sql.Row) (*NotifyUser, error) {
 	u.LastPhone = notifyPhone.String
 	u.VerifiedPhone = notifyVerifiedPhone.String
 	u.PasswordSet = notifyPasswordSet.Bool
+	u.UndeliverableEmail = undeliverableEmail.String
 
 	return u, nil
 }
@@ -1439,6 +1458,7 @@ func (q *UserSearchQueries) prepareUsersQuery(ctx context.Context, permissionChe
 		UserSequenceCol.identifier(),
 		UserStateCol.identifier(),
 		UserTypeCol.identifier(),
+		UserLockedUntilCol.identifier(),
 		UserUsernameCol.identifier(),
 		userLoginNamesListCol.identifier(),
 		userPreferredLoginNameCol.identifier(),
@@ -1502,6 +1522,7 @@ func (q *UserSearchQueries) prepareUsersQuery(ctx context.Context, permissionChe
 			u := new(User)
 			loginNames := database.TextArray[string]{}
 			preferredLoginName := sql.NullString{}
+			lockedUntil := sql.NullTime{}
 
 			human, machine := sqlHuman{}, sqlMachine{}
 			var orderByValue any
@@ -1514,6 +1535,7 @@ func (q *UserSearchQueries) prepareUsersQuery(ctx context.Context, permissionChe
 				&u.Sequence,
 				&u.State,
 				&u.Type,
+				&lockedUntil,
 				&u.Username,
 				&loginNames,
 				&preferredLoginName,
@@ -1551,6 +1573,9 @@ func (q *UserSearchQueries) prepareUsersQuery(ctx context.Context, permissionChe
 			if preferredLoginName.Valid {
 				u.PreferredLoginName = preferredLoginName.String
 			}
+			if lockedUntil.Valid {
+				u.LockedUntil = &lockedUntil.Time
+			}
 
 			if human.humanID.Valid {
 				u.Human = &Human{
@@ -6,6 +6,7 @@ SELECT
   , u.sequence
   , u.state
   , u.type
+  , u.locked_until
   , u.username
   , login_names.login_names AS login_names
   , login_names.preferred_login_name AS preferred_login_name
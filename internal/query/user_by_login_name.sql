@@ -58,6 +58,7 @@ SELECT
   , u.sequence
   , u.state
   , u.type
+  , u.locked_until
   , u.username
   , (SELECT array_agg(ln.login_name)::TEXT[] login_names FROM login_names ln WHERE fu.id = ln.user_id GROUP BY ln.user_id, ln.instance_id) login_names
   , (SELECT ln.login_name login_names_lower FROM login_names ln WHERE fu.id = ln.user_id AND ln.is_primary IS TRUE) preferred_login_name
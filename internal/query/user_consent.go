@@ -0,0 +1,130 @@
+package query
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+// UserConsent is the consent a user gave to the requested scopes of a client.
+type UserConsent struct {
+	ClientID     string
+	Scope        []string
+	CreationDate time.Time
+	ChangeDate   time.Time
+}
+
+// Covers returns true if the user consented to all of the requested scopes.
+func (c *UserConsent) Covers(scope []string) bool {
+	for _, s := range scope {
+		if !slices.Contains(c.Scope, s) {
+			return false
+		}
+	}
+	return true
+}
+
+// UserConsentsReadModel contains the current consents of a user, keyed by the client.
+type UserConsentsReadModel struct {
+	eventstore.ReadModel
+
+	Consents []*UserConsent
+}
+
+func newUserConsentsReadModel(userID string) *UserConsentsReadModel {
+	return &UserConsentsReadModel{
+		ReadModel: eventstore.ReadModel{
+			AggregateID: userID,
+		},
+	}
+}
+
+func (rm *UserConsentsReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *user.ConsentGrantedEvent:
+			if consent := rm.consent(e.ClientID); consent != nil {
+				consent.Scope = e.Scope
+				consent.ChangeDate = e.CreatedAt()
+				continue
+			}
+			rm.Consents = append(rm.Consents, &UserConsent{
+				ClientID:     e.ClientID,
+				Scope:        e.Scope,
+				CreationDate: e.CreatedAt(),
+				ChangeDate:   e.CreatedAt(),
+			})
+		case *user.ConsentRevokedEvent:
+			rm.Consents = slices.DeleteFunc(rm.Consents, func(consent *UserConsent) bool {
+				return consent.ClientID == e.ClientID
+			})
+		case *user.UserRemovedEvent:
+			rm.Consents = nil
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *UserConsentsReadModel) consent(clientID string) *UserConsent {
+	for _, consent := range rm.Consents {
+		if consent.ClientID == clientID {
+			return consent
+		}
+	}
+	return nil
+}
+
+func (rm *UserConsentsReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			// the added events set the resource owner for the permission check
+			user.HumanAddedType,
+			user.HumanRegisteredType,
+			user.MachineAddedEventType,
+			user.ConsentGrantedType,
+			user.ConsentRevokedType,
+			user.UserRemovedType,
+		).
+		Builder()
+}
+
+func (q *Queries) userConsents(ctx context.Context, userID string) (_ *UserConsentsReadModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	model := newUserConsentsReadModel(userID)
+	if err = q.eventstore.FilterToQueryReducer(ctx, model); err != nil {
+		return nil, err
+	}
+	return model, nil
+}
+
+// UserConsentByClientID returns the consent of the user to the client or nil, if the user did not consent (yet).
+// It is used by the login and therefore does not check any permission.
+func (q *Queries) UserConsentByClientID(ctx context.Context, userID, clientID string) (*UserConsent, error) {
+	model, err := q.userConsents(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return model.consent(clientID), nil
+}
+
+// ListUserConsents returns the consents of the user to the (third-party) clients.
+// Users can always list their own consents, otherwise the permission to read the user is required.
+func (q *Queries) ListUserConsents(ctx context.Context, userID string) ([]*UserConsent, error) {
+	model, err := q.userConsents(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err = userCheckPermission(ctx, model.ResourceOwner, userID, q.checkPermission); err != nil {
+		return nil, err
+	}
+	return model.Consents, nil
+}
@@ -0,0 +1,143 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// EmailUndeliverable describes the last report of an email provider,
+// that the current email address of a user could not be delivered to.
+type EmailUndeliverable struct {
+	UserID        string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	ResourceOwner string
+	Sequence      uint64
+	Email         domain.EmailAddress
+	Reason        domain.EmailUndeliverableReason
+	Description   string
+	ProviderID    string
+	DeliveryID    string
+}
+
+var (
+	userEmailUndeliverableTable = table{
+		name:          projection.UserEmailUndeliverableProjectionTable,
+		instanceIDCol: projection.UserEmailUndeliverableColumnInstanceID,
+	}
+	UserEmailUndeliverableUserIDCol = Column{
+		name:  projection.UserEmailUndeliverableColumnUserID,
+		table: userEmailUndeliverableTable,
+	}
+	UserEmailUndeliverableInstanceIDCol = Column{
+		name:  projection.UserEmailUndeliverableColumnInstanceID,
+		table: userEmailUndeliverableTable,
+	}
+	UserEmailUndeliverableResourceOwnerCol = Column{
+		name:  projection.UserEmailUndeliverableColumnResourceOwner,
+		table: userEmailUndeliverableTable,
+	}
+	UserEmailUndeliverableCreationDateCol = Column{
+		name:  projection.UserEmailUndeliverableColumnCreationDate,
+		table: userEmailUndeliverableTable,
+	}
+	UserEmailUndeliverableChangeDateCol = Column{
+		name:  projection.UserEmailUndeliverableColumnChangeDate,
+		table: userEmailUndeliverableTable,
+	}
+	UserEmailUndeliverableSequenceCol = Column{
+		name:  projection.UserEmailUndeliverableColumnSequence,
+		table: userEmailUndeliverableTable,
+	}
+	UserEmailUndeliverableEmailCol = Column{
+		name:  projection.UserEmailUndeliverableColumnEmail,
+		table: userEmailUndeliverableTable,
+	}
+	UserEmailUndeliverableReasonCol = Column{
+		name:  projection.UserEmailUndeliverableColumnReason,
+		table: userEmailUndeliverableTable,
+	}
+	UserEmailUndeliverableDescriptionCol = Column{
+		name:  projection.UserEmailUndeliverableColumnDescription,
+		table: userEmailUndeliverableTable,
+	}
+	UserEmailUndeliverableProviderIDCol = Column{
+		name:  projection.UserEmailUndeliverableColumnProviderID,
+		table: userEmailUndeliverableTable,
+	}
+	UserEmailUndeliverableDeliveryIDCol = Column{
+		name:  projection.UserEmailUndeliverableColumnDeliveryID,
+		table: userEmailUndeliverableTable,
+	}
+)
+
+// EmailUndeliverableByUserID returns the undeliverable report of the current email address of the user.
+// A NotFound error is returned if the address is deliverable.
+func (q *Queries) EmailUndeliverableByUserID(ctx context.Context, userID string) (undeliverable *EmailUndeliverable, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareEmailUndeliverableQuery()
+	stmt, args, err := query.Where(sq.Eq{
+		UserEmailUndeliverableUserIDCol.identifier():     userID,
+		UserEmailUndeliverableInstanceIDCol.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Ue7nd", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		undeliverable, err = scan(row)
+		return err
+	}, stmt, args...)
+	return undeliverable, err
+}
+
+func prepareEmailUndeliverableQuery() (sq.SelectBuilder, func(*sql.Row) (*EmailUndeliverable, error)) {
+	return sq.Select(
+			UserEmailUndeliverableUserIDCol.identifier(),
+			UserEmailUndeliverableCreationDateCol.identifier(),
+			UserEmailUndeliverableChangeDateCol.identifier(),
+			UserEmailUndeliverableResourceOwnerCol.identifier(),
+			UserEmailUndeliverableSequenceCol.identifier(),
+			UserEmailUndeliverableEmailCol.identifier(),
+			UserEmailUndeliverableReasonCol.identifier(),
+			UserEmailUndeliverableDescriptionCol.identifier(),
+			UserEmailUndeliverableProviderIDCol.identifier(),
+			UserEmailUndeliverableDeliveryIDCol.identifier(),
+		).
+			From(userEmailUndeliverableTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*EmailUndeliverable, error) {
+			u := new(EmailUndeliverable)
+			err := row.Scan(
+				&u.UserID,
+				&u.CreationDate,
+				&u.ChangeDate,
+				&u.ResourceOwner,
+				&u.Sequence,
+				&u.Email,
+				&u.Reason,
+				&u.Description,
+				&u.ProviderID,
+				&u.DeliveryID,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Ue8nd", "Errors.User.Email.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Ue9nd", "Errors.Internal")
+			}
+			return u, nil
+		}
+}
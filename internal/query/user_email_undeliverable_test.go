@@ -0,0 +1,127 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	emailUndeliverableQuery = `SELECT projections.user_email_undeliverables.user_id,` +
+		` projections.user_email_undeliverables.creation_date,` +
+		` projections.user_email_undeliverables.change_date,` +
+		` projections.user_email_undeliverables.resource_owner,` +
+		` projections.user_email_undeliverables.sequence,` +
+		` projections.user_email_undeliverables.email,` +
+		` projections.user_email_undeliverables.reason,` +
+		` projections.user_email_undeliverables.description,` +
+		` projections.user_email_undeliverables.provider_id,` +
+		` projections.user_email_undeliverables.delivery_id` +
+		` FROM projections.user_email_undeliverables`
+	emailUndeliverableCols = []string{
+		"user_id",
+		"creation_date",
+		"change_date",
+		"resource_owner",
+		"sequence",
+		"email",
+		"reason",
+		"description",
+		"provider_id",
+		"delivery_id",
+	}
+)
+
+func Test_EmailUndeliverablePrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareEmailUndeliverableQuery no result",
+			prepare: prepareEmailUndeliverableQuery,
+			want: want{
+				sqlExpectations: mockQueryScanErr(
+					regexp.QuoteMeta(emailUndeliverableQuery),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*EmailUndeliverable)(nil),
+		},
+		{
+			name:    "prepareEmailUndeliverableQuery found",
+			prepare: prepareEmailUndeliverableQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(emailUndeliverableQuery),
+					emailUndeliverableCols,
+					[]driver.Value{
+						"user-id",
+						testNow,
+						testNow,
+						"resource_owner",
+						uint64(20211108),
+						"email@example.com",
+						domain.EmailUndeliverableReasonBounce,
+						"mailbox does not exist",
+						"provider-id",
+						"delivery-id",
+					},
+				),
+			},
+			object: &EmailUndeliverable{
+				UserID:        "user-id",
+				CreationDate:  testNow,
+				ChangeDate:    testNow,
+				ResourceOwner: "resource_owner",
+				Sequence:      20211108,
+				Email:         "email@example.com",
+				Reason:        domain.EmailUndeliverableReasonBounce,
+				Description:   "mailbox does not exist",
+				ProviderID:    "provider-id",
+				DeliveryID:    "delivery-id",
+			},
+		},
+		{
+			name:    "prepareEmailUndeliverableQuery sql err",
+			prepare: prepareEmailUndeliverableQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(emailUndeliverableQuery),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*EmailUndeliverable)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
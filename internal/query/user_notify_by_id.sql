@@ -23,6 +23,7 @@ SELECT
   , n.last_phone
   , n.verified_phone
   , n.password_set
+  , ue.email
   , count(*) OVER ()
 FROM projections.users14 u
 LEFT JOIN
@@ -35,6 +36,11 @@ LEFT JOIN
   ON
     u.id = n.user_id
     AND u.instance_id = n.instance_id
+LEFT JOIN
+  projections.user_email_undeliverables ue
+  ON
+    u.id = ue.user_id
+    AND u.instance_id = ue.instance_id
 LEFT JOIN LATERAL (
     SELECT
         ARRAY_AGG(ln.login_name ORDER BY ln.login_name) AS login_names,
@@ -75,6 +75,7 @@ SELECT
   , n.last_phone
   , n.verified_phone
   , n.password_set
+  , ue.email
   , count(*) OVER ()
 FROM found_users fu
 JOIN
@@ -92,6 +93,11 @@ LEFT JOIN
   ON
     fu.id = n.user_id
     AND fu.instance_id = n.instance_id
+LEFT JOIN
+  projections.user_email_undeliverables ue
+  ON
+    fu.id = ue.user_id
+    AND fu.instance_id = ue.instance_id
 WHERE
   u.instance_id = $4
 ;
\ No newline at end of file
@@ -209,10 +209,12 @@ var (
 		` projections.users14_notifications.last_phone,` +
 		` projections.users14_notifications.verified_phone,` +
 		` projections.users14_notifications.password_set,` +
+		` projections.user_email_undeliverables.email,` +
 		` COUNT(*) OVER ()` +
 		` FROM projections.users14` +
 		` LEFT JOIN projections.users14_humans ON projections.users14.id = projections.users14_humans.user_id AND projections.users14.instance_id = projections.users14_humans.instance_id` +
 		` LEFT JOIN projections.users14_notifications ON projections.users14.id = projections.users14_notifications.user_id AND projections.users14.instance_id = projections.users14_notifications.instance_id` +
+		` LEFT JOIN projections.user_email_undeliverables ON projections.users14.id = projections.user_email_undeliverables.user_id AND projections.users14.instance_id = projections.user_email_undeliverables.instance_id` +
 		` LEFT JOIN LATERAL (SELECT ARRAY_AGG(ln.login_name ORDER BY ln.login_name) AS login_names, MAX(CASE WHEN ln.is_primary THEN ln.login_name ELSE NULL END) AS preferred_login_name FROM projections.login_names3 AS ln WHERE ln.user_id = projections.users14.id AND ln.instance_id = projections.users14.instance_id) AS login_names ON TRUE`
 	notifyUserCols = []string{
 		"id",
@@ -241,6 +243,7 @@ var (
 		"last_phone",
 		"verified_phone",
 		"password_set",
+		"email",
 		"count",
 	}
 	usersQuery = `SELECT *, COUNT(*) OVER () FROM (` +
@@ -251,6 +254,7 @@ var (
 		` projections.users14.sequence,` +
 		` projections.users14.state,` +
 		` projections.users14.type,` +
+		` projections.users14.locked_until,` +
 		` projections.users14.username,` +
 		` login_names.login_names,` +
 		` login_names.preferred_login_name,` +
@@ -289,6 +293,7 @@ var (
 		"sequence",
 		"state",
 		"type",
+		"locked_until",
 		"username",
 		"login_names",
 		"preferred_login_name",
@@ -732,6 +737,7 @@ func Test_UserPrepares(t *testing.T) {
 						"lastPhone",
 						"verifiedPhone",
 						true,
+						"lastEmail",
 						1,
 					},
 				),
@@ -759,6 +765,7 @@ func Test_UserPrepares(t *testing.T) {
 				LastPhone:          "lastPhone",
 				VerifiedPhone:      "verifiedPhone",
 				PasswordSet:        true,
+				UndeliverableEmail: "lastEmail",
 			},
 		},
 		{
@@ -794,6 +801,7 @@ func Test_UserPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						1,
 					},
 				),
@@ -863,6 +871,7 @@ func Test_UserPrepares(t *testing.T) {
 							uint64(20211108),
 							domain.UserStateActive,
 							domain.UserTypeHuman,
+							nil,
 							"username",
 							database.TextArray[string]{"login_name1", "login_name2"},
 							"login_name1",
@@ -948,6 +957,7 @@ func Test_UserPrepares(t *testing.T) {
 							uint64(20211108),
 							domain.UserStateActive,
 							domain.UserTypeHuman,
+							nil,
 							"username",
 							database.TextArray[string]{"login_name1", "login_name2"},
 							"login_name1",
@@ -1038,6 +1048,7 @@ func Test_UserPrepares(t *testing.T) {
 							uint64(20211108),
 							domain.UserStateActive,
 							domain.UserTypeHuman,
+							nil,
 							"username",
 							database.TextArray[string]{"login_name1", "login_name2"},
 							"login_name1",
@@ -1073,6 +1084,7 @@ func Test_UserPrepares(t *testing.T) {
 							uint64(20211108),
 							domain.UserStateActive,
 							domain.UserTypeMachine,
+							nil,
 							"username",
 							database.TextArray[string]{"login_name1", "login_name2"},
 							"login_name1",
@@ -12,6 +12,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/crypto/keyprovider"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -26,11 +27,21 @@ var (
 	webKeyPublicKeysQuery string
 )
 
+// ExternalSigningKey returns the signing key held by the key provider,
+// which replaces the signing keys of the instances. It's nil if none is configured.
+func (q *Queries) ExternalSigningKey() *keyprovider.SigningKey {
+	return q.externalSigningKey
+}
+
 // GetPublicWebKeyByID gets a public key by it's keyID directly from the eventstore.
+// The external signing key, if configured, is returned without querying the eventstore.
 func (q *Queries) GetPublicWebKeyByID(ctx context.Context, keyID string) (webKey *jose.JSONWebKey, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	if q.externalSigningKey != nil && q.externalSigningKey.ID == keyID {
+		return q.externalSigningKey.PublicWebKey(), nil
+	}
 	model := NewWebKeyReadModel(keyID, authz.GetInstance(ctx).InstanceID())
 	if err = q.eventstore.FilterToQueryReducer(ctx, model); err != nil {
 		return nil, err
@@ -43,10 +54,15 @@ func (q *Queries) GetPublicWebKeyByID(ctx context.Context, keyID string) (webKey
 
 // GetActiveSigningWebKey gets the current active signing key from the web_keys projection.
 // The active signing key is eventual consistent.
+// If an external signing key is configured, it is returned instead.
 func (q *Queries) GetActiveSigningWebKey(ctx context.Context) (webKey *jose.JSONWebKey, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	if q.externalSigningKey != nil {
+		return q.externalSigningKey.WebKey(), nil
+	}
+
 	var keyValue *crypto.CryptoValue
 	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
 		return row.Scan(&keyValue)
@@ -122,7 +138,7 @@ func (q *Queries) ListWebKeys(ctx context.Context) (list []WebKeyDetails, err er
 }
 
 // GetWebKeySet gets a JSON Web Key set from the web_keys projection.
-// The set contains all existing public keys for the instance.
+// The set contains all existing public keys for the instance and the external signing key, if configured.
 // The set is eventual consistent.
 func (q *Queries) GetWebKeySet(ctx context.Context) (_ *jose.JSONWebKeySet, err error) {
 	ctx, span := tracing.NewSpan(ctx)
@@ -150,5 +166,8 @@ func (q *Queries) GetWebKeySet(ctx context.Context) (_ *jose.JSONWebKeySet, err
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "QUERY-Eeng7", "Errors.Internal")
 	}
+	if q.externalSigningKey != nil {
+		keys = append(keys, *q.externalSigningKey.PublicWebKey())
+	}
 	return &jose.JSONWebKeySet{Keys: keys}, nil
 }
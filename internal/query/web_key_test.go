@@ -11,6 +11,7 @@ import (
 	"encoding/json"
 	"io"
 	"regexp"
+	"slices"
 	"strconv"
 	"testing"
 	"time"
@@ -22,6 +23,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/crypto/keyprovider"
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
@@ -340,11 +342,16 @@ func TestQueries_GetWebKeySet(t *testing.T) {
 		expectedRows[i] = []driver.Value{pubKeyJSON}
 	}
 
+	externalKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	externalSigningKey := &keyprovider.SigningKey{ID: "external", Algorithm: jose.ES256, Signer: externalKey}
+
 	tests := []struct {
-		name    string
-		mock    sqlExpectation
-		want    *jose.JSONWebKeySet
-		wantErr error
+		name               string
+		externalSigningKey *keyprovider.SigningKey
+		mock               sqlExpectation
+		want               *jose.JSONWebKeySet
+		wantErr            error
 	}{
 		{
 			name:    "internal error",
@@ -361,6 +368,14 @@ func TestQueries_GetWebKeySet(t *testing.T) {
 			mock: mockQueries(expQuery, cols, expectedRows, queryArgs...),
 			want: expectedKeySet,
 		},
+		{
+			name:               "external signing key, ok",
+			externalSigningKey: externalSigningKey,
+			mock:               mockQueries(expQuery, cols, expectedRows, queryArgs...),
+			want: &jose.JSONWebKeySet{
+				Keys: append(slices.Clone(expectedKeySet.Keys), *externalSigningKey.PublicWebKey()),
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -369,6 +384,7 @@ func TestQueries_GetWebKeySet(t *testing.T) {
 					client: &database.DB{
 						DB: db,
 					},
+					externalSigningKey: tt.externalSigningKey,
 				}
 				got, err := q.GetWebKeySet(ctx)
 				require.ErrorIs(t, err, tt.wantErr)
@@ -377,3 +393,22 @@ func TestQueries_GetWebKeySet(t *testing.T) {
 		})
 	}
 }
+
+func TestQueries_externalSigningKey(t *testing.T) {
+	ctx := authz.NewMockContextWithPermissions("instance1", "org1", "user1", nil)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	q := &Queries{
+		externalSigningKey: &keyprovider.SigningKey{ID: "external", Algorithm: jose.ES256, Signer: key},
+	}
+
+	signingKey, err := q.GetActiveSigningWebKey(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "external", signingKey.KeyID)
+	assert.Equal(t, string(jose.ES256), signingKey.Algorithm)
+	assert.Implements(t, (*jose.OpaqueSigner)(nil), signingKey.Key)
+
+	publicKey, err := q.GetPublicWebKeyByID(ctx, "external")
+	require.NoError(t, err)
+	assert.Equal(t, &jose.JSONWebKey{Key: &key.PublicKey, KeyID: "external", Algorithm: string(jose.ES256), Use: "sig"}, publicKey)
+}
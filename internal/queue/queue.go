@@ -3,6 +3,7 @@ package queue
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/riverqueue/river"
 	"github.com/riverqueue/river/riverdriver"
@@ -114,6 +115,13 @@ func WithQueueName(name string) InsertOpt {
 	}
 }
 
+// WithScheduledAt delays the job until the given time.
+func WithScheduledAt(scheduledAt time.Time) InsertOpt {
+	return func(opts *river.InsertOpts) {
+		opts.ScheduledAt = scheduledAt
+	}
+}
+
 func (q *Queue) Insert(ctx context.Context, args river.JobArgs, opts ...InsertOpt) error {
 	_, err := q.client.Insert(ctx, args, applyInsertOpts(opts))
 	return err
@@ -0,0 +1,265 @@
+// Package ratelimit limits the requests to the login, token and API endpoints using token buckets.
+// The buckets are stored in a [cache.Cache], so the limits are shared between the containers
+// if a shared cache connector (postgres or redis) is used.
+package ratelimit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/cache"
+)
+
+// Endpoint groups the requests, which share the same limits.
+type Endpoint string
+
+const (
+	EndpointLogin Endpoint = "login"
+	EndpointToken Endpoint = "token"
+	EndpointAPI   Endpoint = "api"
+)
+
+// KeyType is the property of a request, by which its requests are counted.
+type KeyType string
+
+const (
+	KeyTypeInstance KeyType = "instance"
+	KeyTypeClient   KeyType = "client"
+	KeyTypeIP       KeyType = "ip"
+	KeyTypeUsername KeyType = "username"
+)
+
+// Key identifies the requests counted in the same bucket, e.g. all requests from the same IP.
+type Key struct {
+	Type  KeyType
+	Value string
+}
+
+// Limit describes a token bucket, which is refilled with Requests tokens per Period.
+// The bucket holds up to Burst tokens, if Burst is 0, up to Requests tokens.
+// Each request takes a token, requests are limited while the bucket is empty.
+// A limit without requests or period is disabled.
+type Limit struct {
+	Requests uint32        `json:"requests,omitempty"`
+	Period   time.Duration `json:"period,omitempty"`
+	Burst    uint32        `json:"burst,omitempty"`
+}
+
+func (l Limit) enabled() bool {
+	return l.Requests > 0 && l.Period > 0
+}
+
+func (l Limit) capacity() float64 {
+	if l.Burst > 0 {
+		return float64(l.Burst)
+	}
+	return float64(l.Requests)
+}
+
+// rate returns the tokens added per second.
+func (l Limit) rate() float64 {
+	return float64(l.Requests) / l.Period.Seconds()
+}
+
+// Policy contains the limits of an endpoint per key type.
+type Policy struct {
+	Instance Limit `json:"instance,omitzero"`
+	Client   Limit `json:"client,omitzero"`
+	IP       Limit `json:"ip,omitzero"`
+	Username Limit `json:"username,omitzero"`
+}
+
+func (p Policy) limit(keyType KeyType) Limit {
+	switch keyType {
+	case KeyTypeInstance:
+		return p.Instance
+	case KeyTypeClient:
+		return p.Client
+	case KeyTypeIP:
+		return p.IP
+	case KeyTypeUsername:
+		return p.Username
+	}
+	return Limit{}
+}
+
+// Limits contains the policies of the rate limited endpoints.
+type Limits struct {
+	Login Policy `json:"login,omitzero"`
+	Token Policy `json:"token,omitzero"`
+	API   Policy `json:"api,omitzero"`
+}
+
+func (l *Limits) policy(endpoint Endpoint) Policy {
+	switch endpoint {
+	case EndpointLogin:
+		return l.Login
+	case EndpointToken:
+		return l.Token
+	case EndpointAPI:
+		return l.API
+	}
+	return Policy{}
+}
+
+type Config struct {
+	// Enabled activates the rate limiting.
+	// The buckets are stored in the RateLimits cache, which must be configured as well.
+	Enabled bool
+	// TrustedProxies are the addresses (IPs or CIDRs) of the reverse proxies in front of ZITADEL.
+	// The IP of the client is only read from the X-Forwarded-For header of requests sent by a trusted proxy.
+	TrustedProxies []string
+	// Defaults are the limits of the instances, which did not set their own limits.
+	Defaults Limits
+}
+
+// Instance is the instance the requests are sent to.
+// It is implemented by [authz.Instance].
+type Instance interface {
+	InstanceID() string
+	// RateLimits returns the limits set for the instance or nil to use the defaults.
+	RateLimits() *Limits
+}
+
+// Index is the index of the bucket cache.
+type Index int
+
+const (
+	IndexUnspecified Index = iota
+	IndexKey
+)
+
+// Bucket is the state of a token bucket.
+type Bucket struct {
+	Key     string    `json:"key"`
+	Tokens  float64   `json:"tokens"`
+	Updated time.Time `json:"updated"`
+}
+
+// Keys implements [cache.Entry]
+func (b *Bucket) Keys(i Index) []string {
+	if i == IndexKey {
+		return []string{b.Key}
+	}
+	return nil
+}
+
+// Limiter counts the requests in token buckets and decides whether they are allowed.
+// Reading and writing a bucket is not atomic, concurrent requests might take the same token.
+// This is accepted, as the limits are meant to slow down attackers and not to count exactly.
+type Limiter struct {
+	cache          cache.Cache[Index, string, *Bucket]
+	defaults       Limits
+	trustedProxies http_utils.TrustedProxies
+	now            func() time.Time
+}
+
+// NewLimiter returns the limiter or nil if the rate limiting is disabled.
+// All requests are allowed by a nil limiter.
+func NewLimiter(config *Config, buckets cache.Cache[Index, string, *Bucket]) (*Limiter, error) {
+	if config == nil || !config.Enabled {
+		return nil, nil
+	}
+	trustedProxies, err := http_utils.ParseTrustedProxies(config.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+	return &Limiter{
+		cache:          buckets,
+		defaults:       config.Defaults,
+		trustedProxies: trustedProxies,
+		now:            time.Now,
+	}, nil
+}
+
+// ClientIP returns the IP of the client which sent the request to the remote address.
+// If the remote address is a trusted proxy, the X-Forwarded-For header is read from right to left
+// and the first address, which is not a trusted proxy, is returned.
+// The header is ignored otherwise, as it is set by the client.
+func (l *Limiter) ClientIP(remoteAddr string, forwardedFor []string) string {
+	if l == nil {
+		return ""
+	}
+	ip, ok := http_utils.ParseIP(remoteAddr)
+	if !ok {
+		return ""
+	}
+	hops := strings.Split(strings.Join(forwardedFor, ","), ",")
+	for i := len(hops) - 1; i >= 0 && l.trustedProxies.Contains(ip); i-- {
+		hop, ok := http_utils.ParseIP(strings.TrimSpace(hops[i]))
+		if !ok {
+			break
+		}
+		ip = hop
+	}
+	return ip.String()
+}
+
+// Allow takes a token from the bucket of every key for which the endpoint defines a limit.
+// If one of the buckets is empty, the request is not allowed, no token is taken from any bucket
+// and the duration after which a token is available in all of them is returned.
+// Keys with an empty value are ignored.
+func (l *Limiter) Allow(ctx context.Context, instance Instance, endpoint Endpoint, keys ...Key) (retryAfter time.Duration, allowed bool) {
+	if l == nil {
+		return 0, true
+	}
+	limits := instance.RateLimits()
+	if limits == nil {
+		limits = &l.defaults
+	}
+	policy := limits.policy(endpoint)
+	now := l.now()
+	buckets := make([]*Bucket, 0, len(keys))
+	for _, key := range keys {
+		limit := policy.limit(key.Type)
+		if key.Value == "" || !limit.enabled() {
+			continue
+		}
+		bucket := l.refill(ctx, bucketKey(instance.InstanceID(), endpoint, key), limit, now)
+		if bucket.Tokens < 1 {
+			retryAfter = max(retryAfter, time.Duration(math.Ceil((1-bucket.Tokens)/limit.rate()*float64(time.Second))))
+		}
+		buckets = append(buckets, bucket)
+	}
+	if retryAfter > 0 {
+		return retryAfter, false
+	}
+	for _, bucket := range buckets {
+		bucket.Tokens--
+		l.cache.Set(ctx, bucket)
+	}
+	return 0, true
+}
+
+// refill returns the bucket refilled for the time passed since the last request.
+func (l *Limiter) refill(ctx context.Context, key string, limit Limit, now time.Time) *Bucket {
+	bucket := &Bucket{
+		Key:     key,
+		Tokens:  limit.capacity(),
+		Updated: now,
+	}
+	// the cached bucket is copied, as it might be shared with concurrent requests by the memory cache
+	if cached, ok := l.cache.Get(ctx, IndexKey, key); ok {
+		bucket.Tokens = math.Min(bucket.Tokens, cached.Tokens+now.Sub(cached.Updated).Seconds()*limit.rate())
+	}
+	return bucket
+}
+
+// bucketKey identifies the bucket of the key.
+// The value is hashed, so no usernames or IPs are stored in the cache.
+func bucketKey(instanceID string, endpoint Endpoint, key Key) string {
+	value := sha256.Sum256([]byte(key.Value))
+	return instanceID + ":" + string(endpoint) + ":" + string(key.Type) + ":" + hex.EncodeToString(value[:])
+}
+
+// RetryAfter formats the duration as value of the Retry-After header,
+// which is specified in whole seconds.
+func RetryAfter(retryAfter time.Duration) string {
+	return strconv.FormatInt(int64(math.Ceil(retryAfter.Seconds())), 10)
+}
@@ -0,0 +1,261 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/cache"
+	"github.com/zitadel/zitadel/internal/cache/connector/gomap"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type testInstance struct {
+	id     string
+	limits *Limits
+}
+
+func (i *testInstance) InstanceID() string {
+	return i.id
+}
+
+func (i *testInstance) RateLimits() *Limits {
+	return i.limits
+}
+
+func newTestLimiter(t *testing.T, config *Config, now *time.Time) *Limiter {
+	limiter, err := NewLimiter(config, gomap.NewCache[Index, string, *Bucket](context.Background(), []Index{IndexKey}, cache.Config{}))
+	require.NoError(t, err)
+	if limiter != nil {
+		limiter.now = func() time.Time { return *now }
+	}
+	return limiter
+}
+
+func TestLimiter_Allow(t *testing.T) {
+	defaults := Limits{
+		Login: Policy{
+			IP:       Limit{Requests: 2, Period: time.Minute},
+			Username: Limit{Requests: 1, Period: time.Minute, Burst: 3},
+		},
+	}
+	type request struct {
+		after       time.Duration
+		keys        []Key
+		wantAllowed bool
+		wantRetry   time.Duration
+	}
+	tests := []struct {
+		name     string
+		config   *Config
+		instance *testInstance
+		endpoint Endpoint
+		requests []request
+	}{
+		{
+			name:     "disabled",
+			config:   &Config{Enabled: false, Defaults: defaults},
+			instance: &testInstance{id: "instance"},
+			endpoint: EndpointLogin,
+			requests: []request{
+				{keys: []Key{{Type: KeyTypeIP, Value: "1.2.3.4"}}, wantAllowed: true},
+				{keys: []Key{{Type: KeyTypeIP, Value: "1.2.3.4"}}, wantAllowed: true},
+				{keys: []Key{{Type: KeyTypeIP, Value: "1.2.3.4"}}, wantAllowed: true},
+			},
+		},
+		{
+			name:     "bucket exhausted and refilled",
+			config:   &Config{Enabled: true, Defaults: defaults},
+			instance: &testInstance{id: "instance"},
+			endpoint: EndpointLogin,
+			requests: []request{
+				{keys: []Key{{Type: KeyTypeIP, Value: "1.2.3.4"}}, wantAllowed: true},
+				{keys: []Key{{Type: KeyTypeIP, Value: "1.2.3.4"}}, wantAllowed: true},
+				{keys: []Key{{Type: KeyTypeIP, Value: "1.2.3.4"}}, wantAllowed: false, wantRetry: 30 * time.Second},
+				{keys: []Key{{Type: KeyTypeIP, Value: "5.6.7.8"}}, wantAllowed: true},
+				{after: 30 * time.Second, keys: []Key{{Type: KeyTypeIP, Value: "1.2.3.4"}}, wantAllowed: true},
+				{keys: []Key{{Type: KeyTypeIP, Value: "1.2.3.4"}}, wantAllowed: false, wantRetry: 30 * time.Second},
+			},
+		},
+		{
+			name:     "burst",
+			config:   &Config{Enabled: true, Defaults: defaults},
+			instance: &testInstance{id: "instance"},
+			endpoint: EndpointLogin,
+			requests: []request{
+				{keys: []Key{{Type: KeyTypeUsername, Value: "user"}}, wantAllowed: true},
+				{keys: []Key{{Type: KeyTypeUsername, Value: "user"}}, wantAllowed: true},
+				{keys: []Key{{Type: KeyTypeUsername, Value: "user"}}, wantAllowed: true},
+				{keys: []Key{{Type: KeyTypeUsername, Value: "user"}}, wantAllowed: false, wantRetry: time.Minute},
+			},
+		},
+		{
+			name:     "any key exhausted",
+			config:   &Config{Enabled: true, Defaults: defaults},
+			instance: &testInstance{id: "instance"},
+			endpoint: EndpointLogin,
+			requests: []request{
+				{keys: []Key{{Type: KeyTypeIP, Value: "1.2.3.4"}, {Type: KeyTypeUsername, Value: "user1"}}, wantAllowed: true},
+				{keys: []Key{{Type: KeyTypeIP, Value: "1.2.3.4"}, {Type: KeyTypeUsername, Value: "user2"}}, wantAllowed: true},
+				{keys: []Key{{Type: KeyTypeIP, Value: "1.2.3.4"}, {Type: KeyTypeUsername, Value: "user3"}}, wantAllowed: false, wantRetry: 30 * time.Second},
+			},
+		},
+		{
+			name:     "denied request takes no token",
+			config:   &Config{Enabled: true, Defaults: defaults},
+			instance: &testInstance{id: "instance"},
+			endpoint: EndpointLogin,
+			requests: []request{
+				{keys: []Key{{Type: KeyTypeIP, Value: "1.2.3.4"}}, wantAllowed: true},
+				{keys: []Key{{Type: KeyTypeIP, Value: "1.2.3.4"}}, wantAllowed: true},
+				{keys: []Key{{Type: KeyTypeUsername, Value: "user"}, {Type: KeyTypeIP, Value: "1.2.3.4"}}, wantAllowed: false, wantRetry: 30 * time.Second},
+				{keys: []Key{{Type: KeyTypeUsername, Value: "user"}, {Type: KeyTypeIP, Value: "1.2.3.4"}}, wantAllowed: false, wantRetry: 30 * time.Second},
+				{keys: []Key{{Type: KeyTypeUsername, Value: "user"}}, wantAllowed: true},
+				{keys: []Key{{Type: KeyTypeUsername, Value: "user"}}, wantAllowed: true},
+				{keys: []Key{{Type: KeyTypeUsername, Value: "user"}}, wantAllowed: true},
+				{keys: []Key{{Type: KeyTypeUsername, Value: "user"}, {Type: KeyTypeIP, Value: "1.2.3.4"}}, wantAllowed: false, wantRetry: time.Minute},
+			},
+		},
+		{
+			name:     "no limit for key type and empty keys ignored",
+			config:   &Config{Enabled: true, Defaults: defaults},
+			instance: &testInstance{id: "instance"},
+			endpoint: EndpointLogin,
+			requests: []request{
+				{keys: []Key{{Type: KeyTypeClient, Value: "client"}, {Type: KeyTypeIP}}, wantAllowed: true},
+				{keys: []Key{{Type: KeyTypeClient, Value: "client"}, {Type: KeyTypeIP}}, wantAllowed: true},
+				{keys: []Key{{Type: KeyTypeClient, Value: "client"}, {Type: KeyTypeIP}}, wantAllowed: true},
+			},
+		},
+		{
+			name:   "instance limits",
+			config: &Config{Enabled: true, Defaults: defaults},
+			instance: &testInstance{
+				id: "instance",
+				limits: &Limits{
+					Token: Policy{Client: Limit{Requests: 1, Period: time.Second}},
+				},
+			},
+			endpoint: EndpointToken,
+			requests: []request{
+				{keys: []Key{{Type: KeyTypeClient, Value: "client"}}, wantAllowed: true},
+				{keys: []Key{{Type: KeyTypeClient, Value: "client"}}, wantAllowed: false, wantRetry: time.Second},
+				{after: time.Second, keys: []Key{{Type: KeyTypeClient, Value: "client"}}, wantAllowed: true},
+			},
+		},
+		{
+			name:   "instance limits replace defaults",
+			config: &Config{Enabled: true, Defaults: defaults},
+			instance: &testInstance{
+				id:     "instance",
+				limits: &Limits{},
+			},
+			endpoint: EndpointLogin,
+			requests: []request{
+				{keys: []Key{{Type: KeyTypeIP, Value: "1.2.3.4"}}, wantAllowed: true},
+				{keys: []Key{{Type: KeyTypeIP, Value: "1.2.3.4"}}, wantAllowed: true},
+				{keys: []Key{{Type: KeyTypeIP, Value: "1.2.3.4"}}, wantAllowed: true},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Now()
+			limiter := newTestLimiter(t, tt.config, &now)
+			for i, req := range tt.requests {
+				now = now.Add(req.after)
+				retryAfter, allowed := limiter.Allow(context.Background(), tt.instance, tt.endpoint, req.keys...)
+				assert.Equal(t, req.wantAllowed, allowed, "request %d", i)
+				assert.Equal(t, req.wantRetry, retryAfter, "request %d", i)
+			}
+		})
+	}
+}
+
+func TestNewLimiter_TrustedProxies(t *testing.T) {
+	_, err := NewLimiter(&Config{Enabled: true, TrustedProxies: []string{"10.0.0.0/8", "192.168.1.1", "::1"}}, nil)
+	assert.NoError(t, err)
+	_, err = NewLimiter(&Config{Enabled: true, TrustedProxies: []string{"proxy.local"}}, nil)
+	assert.True(t, zerrors.IsErrorInvalidArgument(err), "got wrong err: %v", err)
+}
+
+func TestLimiter_ClientIP(t *testing.T) {
+	limiter, err := NewLimiter(&Config{Enabled: true, TrustedProxies: []string{"10.0.0.0/8", "192.168.1.1"}}, nil)
+	require.NoError(t, err)
+	tests := []struct {
+		name         string
+		limiter      *Limiter
+		remoteAddr   string
+		forwardedFor []string
+		want         string
+	}{
+		{
+			name:       "disabled",
+			remoteAddr: "1.2.3.4:1234",
+		},
+		{
+			name:         "untrusted remote, header ignored",
+			limiter:      limiter,
+			remoteAddr:   "1.2.3.4:1234",
+			forwardedFor: []string{"5.6.7.8"},
+			want:         "1.2.3.4",
+		},
+		{
+			name:         "trusted proxy",
+			limiter:      limiter,
+			remoteAddr:   "10.1.2.3:1234",
+			forwardedFor: []string{"5.6.7.8"},
+			want:         "5.6.7.8",
+		},
+		{
+			name:         "trusted proxy chain, spoofed entries ignored",
+			limiter:      limiter,
+			remoteAddr:   "10.1.2.3:1234",
+			forwardedFor: []string{"9.9.9.9, 5.6.7.8", "192.168.1.1"},
+			want:         "5.6.7.8",
+		},
+		{
+			name:         "trusted proxy without header",
+			limiter:      limiter,
+			remoteAddr:   "[::ffff:10.1.2.3]:1234",
+			forwardedFor: nil,
+			want:         "10.1.2.3",
+		},
+		{
+			name:         "invalid header entry",
+			limiter:      limiter,
+			remoteAddr:   "10.1.2.3:1234",
+			forwardedFor: []string{"5.6.7.8, unknown"},
+			want:         "10.1.2.3",
+		},
+		{
+			name:       "invalid remote address",
+			limiter:    limiter,
+			remoteAddr: "unknown",
+			want:       "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.limiter.ClientIP(tt.remoteAddr, tt.forwardedFor))
+		})
+	}
+}
+
+func Test_bucketKey(t *testing.T) {
+	key := bucketKey("instance", EndpointLogin, Key{Type: KeyTypeUsername, Value: "user@example.com"})
+	assert.Equal(t, key, bucketKey("instance", EndpointLogin, Key{Type: KeyTypeUsername, Value: "user@example.com"}))
+	assert.NotContains(t, key, "user@example.com")
+	assert.NotEqual(t, key, bucketKey("other", EndpointLogin, Key{Type: KeyTypeUsername, Value: "user@example.com"}))
+	assert.NotEqual(t, key, bucketKey("instance", EndpointToken, Key{Type: KeyTypeUsername, Value: "user@example.com"}))
+	assert.NotEqual(t, key, bucketKey("instance", EndpointLogin, Key{Type: KeyTypeIP, Value: "user@example.com"}))
+}
+
+func TestRetryAfter(t *testing.T) {
+	assert.Equal(t, "1", RetryAfter(time.Millisecond))
+	assert.Equal(t, "30", RetryAfter(30*time.Second))
+	assert.Equal(t, "31", RetryAfter(30*time.Second+time.Nanosecond))
+}
@@ -40,6 +40,10 @@ type AddedEvent struct {
 	NeedRefreshToken bool                      `json:"need_refresh_token,omitempty"`
 	Issuer           string                    `json:"issuer,omitempty"`
 	OrganizationID   string                    `json:"organization_id,omitempty"`
+	// AuthorizationDetails requested by the client (RFC 9396)
+	AuthorizationDetails domain.AuthorizationDetails `json:"authorization_details,omitempty"`
+	// ConsentRequired is set for clients which need the user to consent to the requested scopes
+	ConsentRequired bool `json:"consent_required,omitempty"`
 }
 
 func (e *AddedEvent) Payload() interface{} {
@@ -70,6 +74,8 @@ func NewAddedEvent(ctx context.Context,
 	needRefreshToken bool,
 	issuer,
 	organizationID string,
+	authorizationDetails domain.AuthorizationDetails,
+	consentRequired bool,
 ) *AddedEvent {
 	return &AddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -77,24 +83,26 @@ func NewAddedEvent(ctx context.Context,
 			aggregate,
 			AddedType,
 		),
-		LoginClient:      loginClient,
-		ClientID:         clientID,
-		RedirectURI:      redirectURI,
-		State:            state,
-		Nonce:            nonce,
-		Scope:            scope,
-		Audience:         audience,
-		ResponseType:     responseType,
-		ResponseMode:     responseMode,
-		CodeChallenge:    codeChallenge,
-		Prompt:           prompt,
-		UILocales:        uiLocales,
-		MaxAge:           maxAge,
-		LoginHint:        loginHint,
-		HintUserID:       hintUserID,
-		NeedRefreshToken: needRefreshToken,
-		Issuer:           issuer,
-		OrganizationID:   organizationID,
+		LoginClient:          loginClient,
+		ClientID:             clientID,
+		RedirectURI:          redirectURI,
+		State:                state,
+		Nonce:                nonce,
+		Scope:                scope,
+		Audience:             audience,
+		ResponseType:         responseType,
+		ResponseMode:         responseMode,
+		CodeChallenge:        codeChallenge,
+		Prompt:               prompt,
+		UILocales:            uiLocales,
+		MaxAge:               maxAge,
+		LoginHint:            loginHint,
+		HintUserID:           hintUserID,
+		NeedRefreshToken:     needRefreshToken,
+		Issuer:               issuer,
+		OrganizationID:       organizationID,
+		AuthorizationDetails: authorizationDetails,
+		ConsentRequired:      consentRequired,
 	}
 }
 
@@ -117,6 +125,8 @@ type SessionLinkedEvent struct {
 	UserID      string                      `json:"user_id"`
 	AuthTime    time.Time                   `json:"auth_time"`
 	AuthMethods []domain.UserAuthMethodType `json:"auth_methods"`
+	// AuthorizationDetails approved by the user, if they narrowed down the requested ones (RFC 9396).
+	AuthorizationDetails domain.AuthorizationDetails `json:"authorization_details,omitempty"`
 }
 
 func (e *SessionLinkedEvent) Payload() interface{} {
@@ -133,6 +143,7 @@ func NewSessionLinkedEvent(ctx context.Context,
 	userID string,
 	authTime time.Time,
 	authMethods []domain.UserAuthMethodType,
+	authorizationDetails domain.AuthorizationDetails,
 ) *SessionLinkedEvent {
 	return &SessionLinkedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -140,10 +151,11 @@ func NewSessionLinkedEvent(ctx context.Context,
 			aggregate,
 			SessionLinkedType,
 		),
-		SessionID:   sessionID,
-		UserID:      userID,
-		AuthTime:    authTime,
-		AuthMethods: authMethods,
+		SessionID:            sessionID,
+		UserID:               userID,
+		AuthTime:             authTime,
+		AuthMethods:          authMethods,
+		AuthorizationDetails: authorizationDetails,
 	}
 }
 
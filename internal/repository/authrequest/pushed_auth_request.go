@@ -0,0 +1,97 @@
+package authrequest
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	PushedType     = authRequestEventPrefix + "pushed"
+	PushedUsedType = PushedType + ".used"
+)
+
+// PushedEvent stores the parameters of an authorization request
+// pushed to the pushed authorization request endpoint (RFC 9126).
+type PushedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ClientID   string     `json:"client_id"`
+	Parameters url.Values `json:"parameters,omitempty"`
+	Expires    time.Time  `json:"expires"`
+}
+
+func (e *PushedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PushedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewPushedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	clientID string,
+	parameters url.Values,
+	expires time.Time,
+) *PushedEvent {
+	return &PushedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PushedType,
+		),
+		ClientID:   clientID,
+		Parameters: parameters,
+		Expires:    expires,
+	}
+}
+
+func PushedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	pushed := &PushedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(pushed)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "AUTHR-Pu5hd", "unable to unmarshal pushed auth request")
+	}
+
+	return pushed, nil
+}
+
+// PushedUsedEvent marks a pushed authorization request as used by an authorization request,
+// as its request_uri must only be used once.
+type PushedUsedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *PushedUsedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PushedUsedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewPushedUsedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *PushedUsedEvent {
+	return &PushedUsedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PushedUsedType,
+		),
+	}
+}
+
+func PushedUsedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &PushedUsedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
@@ -38,6 +38,8 @@ type SetEventV2 struct {
 	*eventstore.BaseEvent `json:"-"`
 
 	Targets []*Target `json:"targets"`
+	// Filter is an optional expression, which has to match the payload to call the targets.
+	Filter string `json:"filter,omitempty"`
 }
 
 func (e *SetEventV2) SetBaseEvent(b *eventstore.BaseEvent) {
@@ -68,12 +70,14 @@ func NewSetEventV2(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
 	targets []*Target,
+	filter string,
 ) *SetEventV2 {
 	return &SetEventV2{
 		BaseEvent: eventstore.NewBaseEventForPush(
 			ctx, aggregate, SetEventV2Type,
 		),
 		Targets: targets,
+		Filter:  filter,
 	}
 }
 
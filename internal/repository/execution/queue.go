@@ -20,6 +20,10 @@ type Request struct {
 	UserID      string                `json:"userID"`
 	EventData   []byte                `json:"eventData"`
 	TargetsData []byte                `json:"targetsData"`
+	// Attempt is the number of the retry of the request, 0 for the first call.
+	Attempt uint8 `json:"attempt,omitempty"`
+	// DeliveryID is set if the request is a re-drive of a failed delivery.
+	DeliveryID string `json:"deliveryID,omitempty"`
 }
 
 func NewRequest(e eventstore.Event, targets []target.Target) (*Request, error) {
@@ -45,6 +49,17 @@ func NewRequest(e eventstore.Event, targets []target.Target) (*Request, error) {
 	}, nil
 }
 
+// WithTargets returns a copy of the request, which only calls the passed targets.
+func (e *Request) WithTargets(targets []target.Target) (*Request, error) {
+	targetsData, err := json.Marshal(targets)
+	if err != nil {
+		return nil, err
+	}
+	request := *e
+	request.TargetsData = targetsData
+	return &request, nil
+}
+
 func (e *Request) Kind() string {
 	return "execution_request"
 }
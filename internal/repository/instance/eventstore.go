@@ -17,14 +17,24 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, SMTPConfigActivatedEventType, eventstore.GenericEventMapper[SMTPConfigActivatedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, SMTPConfigDeactivatedEventType, eventstore.GenericEventMapper[SMTPConfigDeactivatedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, SMTPConfigPasswordChangedEventType, eventstore.GenericEventMapper[SMTPConfigPasswordChangedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, SMTPConfigFeedbackKeyGeneratedType, eventstore.GenericEventMapper[SMTPConfigFeedbackKeyGeneratedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, SMTPConfigSecretsReEncryptedType, eventstore.GenericEventMapper[SMTPConfigSecretsReEncryptedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, SMTPConfigHTTPAddedEventType, eventstore.GenericEventMapper[SMTPConfigHTTPAddedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, SMTPConfigHTTPChangedEventType, eventstore.GenericEventMapper[SMTPConfigHTTPChangedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, SMTPConfigAPIAddedEventType, eventstore.GenericEventMapper[SMTPConfigAPIAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, SMTPConfigAPIChangedEventType, eventstore.GenericEventMapper[SMTPConfigAPIChangedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, SMTPConfigRemovedEventType, eventstore.GenericEventMapper[SMTPConfigRemovedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigTwilioAddedEventType, eventstore.GenericEventMapper[SMSConfigTwilioAddedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigTwilioChangedEventType, eventstore.GenericEventMapper[SMSConfigTwilioChangedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigTwilioTokenChangedEventType, eventstore.GenericEventMapper[SMSConfigTwilioTokenChangedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigHTTPAddedEventType, eventstore.GenericEventMapper[SMSConfigHTTPAddedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigHTTPChangedEventType, eventstore.GenericEventMapper[SMSConfigHTTPChangedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigVonageAddedEventType, eventstore.GenericEventMapper[SMSConfigVonageAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigVonageChangedEventType, eventstore.GenericEventMapper[SMSConfigVonageChangedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigVonageSecretChangedEventType, eventstore.GenericEventMapper[SMSConfigVonageSecretChangedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigSNSAddedEventType, eventstore.GenericEventMapper[SMSConfigSNSAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigSNSChangedEventType, eventstore.GenericEventMapper[SMSConfigSNSChangedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigSNSSecretChangedEventType, eventstore.GenericEventMapper[SMSConfigSNSSecretChangedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigTwilioActivatedEventType, eventstore.GenericEventMapper[SMSConfigTwilioActivatedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigTwilioDeactivatedEventType, eventstore.GenericEventMapper[SMSConfigTwilioDeactivatedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, SMSConfigTwilioRemovedEventType, eventstore.GenericEventMapper[SMSConfigTwilioRemovedEvent])
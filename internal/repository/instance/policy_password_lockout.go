@@ -2,6 +2,7 @@ package instance
 
 import (
 	"context"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/policy"
@@ -22,6 +23,8 @@ func NewLockoutPolicyAddedEvent(
 	maxPasswordAttempts,
 	maxOTPAttempts uint64,
 	showLockoutFailure bool,
+	lockoutDuration,
+	failedAttemptDelay time.Duration,
 ) *LockoutPolicyAddedEvent {
 	return &LockoutPolicyAddedEvent{
 		LockoutPolicyAddedEvent: *policy.NewLockoutPolicyAddedEvent(
@@ -31,7 +34,9 @@ func NewLockoutPolicyAddedEvent(
 				LockoutPolicyAddedEventType),
 			maxPasswordAttempts,
 			maxOTPAttempts,
-			showLockoutFailure),
+			showLockoutFailure,
+			lockoutDuration,
+			failedAttemptDelay),
 	}
 }
 
@@ -9,20 +9,28 @@ import (
 )
 
 const (
-	smsConfigPrefix                      = "sms.config"
-	smsConfigTwilioPrefix                = "twilio."
-	smsConfigHTTPPrefix                  = "http."
-	SMSConfigTwilioAddedEventType        = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "added"
-	SMSConfigTwilioChangedEventType      = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "changed"
-	SMSConfigHTTPAddedEventType          = instanceEventTypePrefix + smsConfigPrefix + smsConfigHTTPPrefix + "added"
-	SMSConfigHTTPChangedEventType        = instanceEventTypePrefix + smsConfigPrefix + smsConfigHTTPPrefix + "changed"
-	SMSConfigTwilioTokenChangedEventType = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "token.changed"
-	SMSConfigTwilioActivatedEventType    = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "activated"
-	SMSConfigTwilioDeactivatedEventType  = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "deactivated"
-	SMSConfigTwilioRemovedEventType      = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "removed"
-	SMSConfigActivatedEventType          = instanceEventTypePrefix + smsConfigPrefix + "activated"
-	SMSConfigDeactivatedEventType        = instanceEventTypePrefix + smsConfigPrefix + "deactivated"
-	SMSConfigRemovedEventType            = instanceEventTypePrefix + smsConfigPrefix + "removed"
+	smsConfigPrefix                       = "sms.config"
+	smsConfigTwilioPrefix                 = "twilio."
+	smsConfigHTTPPrefix                   = "http."
+	smsConfigVonagePrefix                 = "vonage."
+	smsConfigSNSPrefix                    = "sns."
+	SMSConfigTwilioAddedEventType         = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "added"
+	SMSConfigTwilioChangedEventType       = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "changed"
+	SMSConfigHTTPAddedEventType           = instanceEventTypePrefix + smsConfigPrefix + smsConfigHTTPPrefix + "added"
+	SMSConfigHTTPChangedEventType         = instanceEventTypePrefix + smsConfigPrefix + smsConfigHTTPPrefix + "changed"
+	SMSConfigVonageAddedEventType         = instanceEventTypePrefix + smsConfigPrefix + smsConfigVonagePrefix + "added"
+	SMSConfigVonageChangedEventType       = instanceEventTypePrefix + smsConfigPrefix + smsConfigVonagePrefix + "changed"
+	SMSConfigVonageSecretChangedEventType = instanceEventTypePrefix + smsConfigPrefix + smsConfigVonagePrefix + "secret.changed"
+	SMSConfigSNSAddedEventType            = instanceEventTypePrefix + smsConfigPrefix + smsConfigSNSPrefix + "added"
+	SMSConfigSNSChangedEventType          = instanceEventTypePrefix + smsConfigPrefix + smsConfigSNSPrefix + "changed"
+	SMSConfigSNSSecretChangedEventType    = instanceEventTypePrefix + smsConfigPrefix + smsConfigSNSPrefix + "secret.changed"
+	SMSConfigTwilioTokenChangedEventType  = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "token.changed"
+	SMSConfigTwilioActivatedEventType     = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "activated"
+	SMSConfigTwilioDeactivatedEventType   = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "deactivated"
+	SMSConfigTwilioRemovedEventType       = instanceEventTypePrefix + smsConfigPrefix + smsConfigTwilioPrefix + "removed"
+	SMSConfigActivatedEventType           = instanceEventTypePrefix + smsConfigPrefix + "activated"
+	SMSConfigDeactivatedEventType         = instanceEventTypePrefix + smsConfigPrefix + "deactivated"
+	SMSConfigRemovedEventType             = instanceEventTypePrefix + smsConfigPrefix + "removed"
 )
 
 type SMSConfigTwilioAddedEvent struct {
@@ -284,6 +292,306 @@ func (e *SMSConfigHTTPChangedEvent) UniqueConstraints() []*eventstore.UniqueCons
 	return nil
 }
 
+type SMSConfigVonageAddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID           string              `json:"id,omitempty"`
+	Description  string              `json:"description,omitempty"`
+	APIKey       string              `json:"apiKey,omitempty"`
+	APISecret    *crypto.CryptoValue `json:"apiSecret,omitempty"`
+	SenderNumber string              `json:"senderNumber,omitempty"`
+}
+
+func NewSMSConfigVonageAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	description,
+	apiKey string,
+	apiSecret *crypto.CryptoValue,
+	senderNumber string,
+) *SMSConfigVonageAddedEvent {
+	return &SMSConfigVonageAddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigVonageAddedEventType,
+		),
+		ID:           id,
+		Description:  description,
+		APIKey:       apiKey,
+		APISecret:    apiSecret,
+		SenderNumber: senderNumber,
+	}
+}
+
+func (e *SMSConfigVonageAddedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *SMSConfigVonageAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigVonageAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+type SMSConfigVonageChangedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID           string  `json:"id,omitempty"`
+	Description  *string `json:"description,omitempty"`
+	APIKey       *string `json:"apiKey,omitempty"`
+	SenderNumber *string `json:"senderNumber,omitempty"`
+}
+
+func NewSMSConfigVonageChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []SMSConfigVonageChanges,
+) (*SMSConfigVonageChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "INST-Vn7gq1", "Errors.NoChangesFound")
+	}
+	changeEvent := &SMSConfigVonageChangedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigVonageChangedEventType,
+		),
+		ID: id,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type SMSConfigVonageChanges func(event *SMSConfigVonageChangedEvent)
+
+func ChangeSMSConfigVonageDescription(description string) func(event *SMSConfigVonageChangedEvent) {
+	return func(e *SMSConfigVonageChangedEvent) {
+		e.Description = &description
+	}
+}
+
+func ChangeSMSConfigVonageAPIKey(apiKey string) func(event *SMSConfigVonageChangedEvent) {
+	return func(e *SMSConfigVonageChangedEvent) {
+		e.APIKey = &apiKey
+	}
+}
+
+func ChangeSMSConfigVonageSenderNumber(senderNumber string) func(event *SMSConfigVonageChangedEvent) {
+	return func(e *SMSConfigVonageChangedEvent) {
+		e.SenderNumber = &senderNumber
+	}
+}
+
+func (e *SMSConfigVonageChangedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *SMSConfigVonageChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigVonageChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+type SMSConfigVonageSecretChangedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID        string              `json:"id,omitempty"`
+	APISecret *crypto.CryptoValue `json:"apiSecret,omitempty"`
+}
+
+func NewSMSConfigVonageSecretChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	apiSecret *crypto.CryptoValue,
+) *SMSConfigVonageSecretChangedEvent {
+	return &SMSConfigVonageSecretChangedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigVonageSecretChangedEventType,
+		),
+		ID:        id,
+		APISecret: apiSecret,
+	}
+}
+
+func (e *SMSConfigVonageSecretChangedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *SMSConfigVonageSecretChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigVonageSecretChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+type SMSConfigSNSAddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID              string              `json:"id,omitempty"`
+	Description     string              `json:"description,omitempty"`
+	AccessKeyID     string              `json:"accessKeyId,omitempty"`
+	SecretAccessKey *crypto.CryptoValue `json:"secretAccessKey,omitempty"`
+	Region          string              `json:"region,omitempty"`
+	SenderID        string              `json:"senderId,omitempty"`
+}
+
+func NewSMSConfigSNSAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	description,
+	accessKeyID string,
+	secretAccessKey *crypto.CryptoValue,
+	region,
+	senderID string,
+) *SMSConfigSNSAddedEvent {
+	return &SMSConfigSNSAddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigSNSAddedEventType,
+		),
+		ID:              id,
+		Description:     description,
+		AccessKeyID:     accessKeyID,
+		SecretAccessKey: secretAccessKey,
+		Region:          region,
+		SenderID:        senderID,
+	}
+}
+
+func (e *SMSConfigSNSAddedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *SMSConfigSNSAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigSNSAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+type SMSConfigSNSChangedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID          string  `json:"id,omitempty"`
+	Description *string `json:"description,omitempty"`
+	AccessKeyID *string `json:"accessKeyId,omitempty"`
+	Region      *string `json:"region,omitempty"`
+	SenderID    *string `json:"senderId,omitempty"`
+}
+
+func NewSMSConfigSNSChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []SMSConfigSNSChanges,
+) (*SMSConfigSNSChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "INST-Sn7gq1", "Errors.NoChangesFound")
+	}
+	changeEvent := &SMSConfigSNSChangedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigSNSChangedEventType,
+		),
+		ID: id,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type SMSConfigSNSChanges func(event *SMSConfigSNSChangedEvent)
+
+func ChangeSMSConfigSNSDescription(description string) func(event *SMSConfigSNSChangedEvent) {
+	return func(e *SMSConfigSNSChangedEvent) {
+		e.Description = &description
+	}
+}
+
+func ChangeSMSConfigSNSAccessKeyID(accessKeyID string) func(event *SMSConfigSNSChangedEvent) {
+	return func(e *SMSConfigSNSChangedEvent) {
+		e.AccessKeyID = &accessKeyID
+	}
+}
+
+func ChangeSMSConfigSNSRegion(region string) func(event *SMSConfigSNSChangedEvent) {
+	return func(e *SMSConfigSNSChangedEvent) {
+		e.Region = &region
+	}
+}
+
+func ChangeSMSConfigSNSSenderID(senderID string) func(event *SMSConfigSNSChangedEvent) {
+	return func(e *SMSConfigSNSChangedEvent) {
+		e.SenderID = &senderID
+	}
+}
+
+func (e *SMSConfigSNSChangedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *SMSConfigSNSChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigSNSChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+type SMSConfigSNSSecretChangedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID              string              `json:"id,omitempty"`
+	SecretAccessKey *crypto.CryptoValue `json:"secretAccessKey,omitempty"`
+}
+
+func NewSMSConfigSNSSecretChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	secretAccessKey *crypto.CryptoValue,
+) *SMSConfigSNSSecretChangedEvent {
+	return &SMSConfigSNSSecretChangedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMSConfigSNSSecretChangedEventType,
+		),
+		ID:              id,
+		SecretAccessKey: secretAccessKey,
+	}
+}
+
+func (e *SMSConfigSNSSecretChangedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *SMSConfigSNSSecretChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMSConfigSNSSecretChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
 type SMSConfigTwilioActivatedEvent struct {
 	*eventstore.BaseEvent `json:"-"`
 	ID                    string `json:"id,omitempty"`
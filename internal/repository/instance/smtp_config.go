@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -11,14 +12,19 @@ import (
 const (
 	smtpConfigPrefix                   = "smtp.config."
 	httpConfigPrefix                   = "http."
+	apiConfigPrefix                    = "api."
 	SMTPConfigAddedEventType           = instanceEventTypePrefix + smtpConfigPrefix + "added"
 	SMTPConfigChangedEventType         = instanceEventTypePrefix + smtpConfigPrefix + "changed"
 	SMTPConfigPasswordChangedEventType = instanceEventTypePrefix + smtpConfigPrefix + "password.changed"
+	SMTPConfigFeedbackKeyGeneratedType = instanceEventTypePrefix + smtpConfigPrefix + "feedback.key.generated"
 	SMTPConfigHTTPAddedEventType       = instanceEventTypePrefix + smtpConfigPrefix + httpConfigPrefix + "added"
 	SMTPConfigHTTPChangedEventType     = instanceEventTypePrefix + smtpConfigPrefix + httpConfigPrefix + "changed"
+	SMTPConfigAPIAddedEventType        = instanceEventTypePrefix + smtpConfigPrefix + apiConfigPrefix + "added"
+	SMTPConfigAPIChangedEventType      = instanceEventTypePrefix + smtpConfigPrefix + apiConfigPrefix + "changed"
 	SMTPConfigRemovedEventType         = instanceEventTypePrefix + smtpConfigPrefix + "removed"
 	SMTPConfigActivatedEventType       = instanceEventTypePrefix + smtpConfigPrefix + "activated"
 	SMTPConfigDeactivatedEventType     = instanceEventTypePrefix + smtpConfigPrefix + "deactivated"
+	SMTPConfigSecretsReEncryptedType   = instanceEventTypePrefix + smtpConfigPrefix + "secrets.reencrypted"
 )
 
 type SMTPConfigAddedEvent struct {
@@ -288,6 +294,93 @@ func (e *SMTPConfigPasswordChangedEvent) UniqueConstraints() []*eventstore.Uniqu
 	return nil
 }
 
+// SMTPConfigFeedbackKeyGeneratedEvent sets the key the mail provider has to present
+// when it reports bounces and complaints to the feedback endpoint.
+type SMTPConfigFeedbackKeyGeneratedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+	ID                    string              `json:"id,omitempty"`
+	FeedbackKey           *crypto.CryptoValue `json:"feedbackKey,omitempty"`
+}
+
+func NewSMTPConfigFeedbackKeyGeneratedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	feedbackKey *crypto.CryptoValue,
+) *SMTPConfigFeedbackKeyGeneratedEvent {
+	return &SMTPConfigFeedbackKeyGeneratedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMTPConfigFeedbackKeyGeneratedType,
+		),
+		ID:          id,
+		FeedbackKey: feedbackKey,
+	}
+}
+
+func (e *SMTPConfigFeedbackKeyGeneratedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *SMTPConfigFeedbackKeyGeneratedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMTPConfigFeedbackKeyGeneratedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+// SMTPConfigSecretsReEncryptedEvent replaces the secrets of the config,
+// which were encrypted with a retiring encryption key, by the values encrypted with the current key.
+// Only the re-encrypted secrets are set, the plain values don't change.
+type SMTPConfigSecretsReEncryptedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+	ID                    string              `json:"id,omitempty"`
+	Password              *crypto.CryptoValue `json:"password,omitempty"`
+	ClientSecret          *crypto.CryptoValue `json:"clientSecret,omitempty"`
+	SigningKey            *crypto.CryptoValue `json:"signingKey,omitempty"`
+	APIKey                *crypto.CryptoValue `json:"apiKey,omitempty"`
+	FeedbackKey           *crypto.CryptoValue `json:"feedbackKey,omitempty"`
+}
+
+func NewSMTPConfigSecretsReEncryptedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	password,
+	clientSecret,
+	signingKey,
+	apiKey,
+	feedbackKey *crypto.CryptoValue,
+) *SMTPConfigSecretsReEncryptedEvent {
+	return &SMTPConfigSecretsReEncryptedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMTPConfigSecretsReEncryptedType,
+		),
+		ID:           id,
+		Password:     password,
+		ClientSecret: clientSecret,
+		SigningKey:   signingKey,
+		APIKey:       apiKey,
+		FeedbackKey:  feedbackKey,
+	}
+}
+
+func (e *SMTPConfigSecretsReEncryptedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *SMTPConfigSecretsReEncryptedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMTPConfigSecretsReEncryptedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
 type SMTPConfigHTTPAddedEvent struct {
 	*eventstore.BaseEvent `json:"-"`
 
@@ -398,6 +491,165 @@ func ChangeSMTPConfigHTTPSigningKey(signingKey *crypto.CryptoValue) func(event *
 	}
 }
 
+// SMTPConfigAPIAddedEvent adds an email provider, which delivers the emails over the HTTP API of the provider.
+// The API key is the secret access key for Amazon SES and the API key for SendGrid and Mailgun.
+type SMTPConfigAPIAddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ID             string                      `json:"id,omitempty"`
+	Description    string                      `json:"description,omitempty"`
+	Provider       domain.EmailAPIProviderType `json:"provider,omitempty"`
+	SenderAddress  string                      `json:"senderAddress,omitempty"`
+	SenderName     string                      `json:"senderName,omitempty"`
+	ReplyToAddress string                      `json:"replyToAddress,omitempty"`
+	Region         string                      `json:"region,omitempty"`
+	Domain         string                      `json:"domain,omitempty"`
+	AccessKeyID    string                      `json:"accessKeyId,omitempty"`
+	APIKey         *crypto.CryptoValue         `json:"apiKey,omitempty"`
+}
+
+func NewSMTPConfigAPIAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id, description string,
+	provider domain.EmailAPIProviderType,
+	senderAddress,
+	senderName,
+	replyToAddress,
+	region,
+	sendingDomain,
+	accessKeyID string,
+	apiKey *crypto.CryptoValue,
+) *SMTPConfigAPIAddedEvent {
+	return &SMTPConfigAPIAddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMTPConfigAPIAddedEventType,
+		),
+		ID:             id,
+		Description:    description,
+		Provider:       provider,
+		SenderAddress:  senderAddress,
+		SenderName:     senderName,
+		ReplyToAddress: replyToAddress,
+		Region:         region,
+		Domain:         sendingDomain,
+		AccessKeyID:    accessKeyID,
+		APIKey:         apiKey,
+	}
+}
+
+func (e *SMTPConfigAPIAddedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *SMTPConfigAPIAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMTPConfigAPIAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+type SMTPConfigAPIChangedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+	ID                    string              `json:"id,omitempty"`
+	Description           *string             `json:"description,omitempty"`
+	SenderAddress         *string             `json:"senderAddress,omitempty"`
+	SenderName            *string             `json:"senderName,omitempty"`
+	ReplyToAddress        *string             `json:"replyToAddress,omitempty"`
+	Region                *string             `json:"region,omitempty"`
+	Domain                *string             `json:"domain,omitempty"`
+	AccessKeyID           *string             `json:"accessKeyId,omitempty"`
+	APIKey                *crypto.CryptoValue `json:"apiKey,omitempty"`
+}
+
+func (e *SMTPConfigAPIChangedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *SMTPConfigAPIChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SMTPConfigAPIChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewSMTPConfigAPIChangeEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []SMTPConfigAPIChanges,
+) (*SMTPConfigAPIChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "INST-Ap9wQf", "Errors.NoChangesFound")
+	}
+	changeEvent := &SMTPConfigAPIChangedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SMTPConfigAPIChangedEventType,
+		),
+		ID: id,
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent, nil
+}
+
+type SMTPConfigAPIChanges func(event *SMTPConfigAPIChangedEvent)
+
+func ChangeSMTPConfigAPIDescription(description string) func(event *SMTPConfigAPIChangedEvent) {
+	return func(e *SMTPConfigAPIChangedEvent) {
+		e.Description = &description
+	}
+}
+
+func ChangeSMTPConfigAPISenderAddress(senderAddress string) func(event *SMTPConfigAPIChangedEvent) {
+	return func(e *SMTPConfigAPIChangedEvent) {
+		e.SenderAddress = &senderAddress
+	}
+}
+
+func ChangeSMTPConfigAPISenderName(senderName string) func(event *SMTPConfigAPIChangedEvent) {
+	return func(e *SMTPConfigAPIChangedEvent) {
+		e.SenderName = &senderName
+	}
+}
+
+func ChangeSMTPConfigAPIReplyToAddress(replyToAddress string) func(event *SMTPConfigAPIChangedEvent) {
+	return func(e *SMTPConfigAPIChangedEvent) {
+		e.ReplyToAddress = &replyToAddress
+	}
+}
+
+func ChangeSMTPConfigAPIRegion(region string) func(event *SMTPConfigAPIChangedEvent) {
+	return func(e *SMTPConfigAPIChangedEvent) {
+		e.Region = &region
+	}
+}
+
+func ChangeSMTPConfigAPIDomain(sendingDomain string) func(event *SMTPConfigAPIChangedEvent) {
+	return func(e *SMTPConfigAPIChangedEvent) {
+		e.Domain = &sendingDomain
+	}
+}
+
+func ChangeSMTPConfigAPIAccessKeyID(accessKeyID string) func(event *SMTPConfigAPIChangedEvent) {
+	return func(e *SMTPConfigAPIChangedEvent) {
+		e.AccessKeyID = &accessKeyID
+	}
+}
+
+func ChangeSMTPConfigAPIKey(apiKey *crypto.CryptoValue) func(event *SMTPConfigAPIChangedEvent) {
+	return func(e *SMTPConfigAPIChangedEvent) {
+		e.APIKey = apiKey
+	}
+}
+
 type SMTPConfigActivatedEvent struct {
 	*eventstore.BaseEvent `json:"-"`
 	ID                    string `json:"id,omitempty"`
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/ratelimit"
 )
 
 const (
@@ -16,8 +17,10 @@ const (
 // SetEvent describes that limits are added or modified and contains only changed properties
 type SetEvent struct {
 	*eventstore.BaseEvent `json:"-"`
-	AuditLogRetention     *time.Duration `json:"auditLogRetention,omitempty"`
-	Block                 *bool          `json:"block,omitempty"`
+	AuditLogRetention     *time.Duration    `json:"auditLogRetention,omitempty"`
+	Block                 *bool             `json:"block,omitempty"`
+	RateLimits            *ratelimit.Limits `json:"rateLimits,omitempty"`
+	ArchiveHorizon        *time.Duration    `json:"archiveHorizon,omitempty"`
 }
 
 func (e *SetEvent) Payload() any {
@@ -59,6 +62,18 @@ func ChangeBlock(block *bool) LimitsChange {
 	}
 }
 
+func ChangeRateLimits(rateLimits *ratelimit.Limits) LimitsChange {
+	return func(e *SetEvent) {
+		e.RateLimits = rateLimits
+	}
+}
+
+func ChangeArchiveHorizon(archiveHorizon *time.Duration) LimitsChange {
+	return func(e *SetEvent) {
+		e.ArchiveHorizon = archiveHorizon
+	}
+}
+
 var SetEventMapper = eventstore.GenericEventMapper[SetEvent]
 
 type ResetEvent struct {
@@ -0,0 +1,20 @@
+package notification
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+const (
+	AggregateType    = "notification"
+	AggregateVersion = "v1"
+)
+
+// NewAggregate returns the aggregate of a single notification.
+// The id is the id of the job, which sends the notification.
+func NewAggregate(id, resourceOwner, instanceID string) *eventstore.Aggregate {
+	return &eventstore.Aggregate{
+		ID:            id,
+		Type:          AggregateType,
+		ResourceOwner: resourceOwner,
+		InstanceID:    instanceID,
+		Version:       AggregateVersion,
+	}
+}
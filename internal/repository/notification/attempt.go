@@ -0,0 +1,98 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	attemptEventTypePrefix    eventstore.EventType = "notification.attempt."
+	AttemptSucceededEventType                      = attemptEventTypePrefix + "succeeded"
+	AttemptFailedEventType                         = attemptEventTypePrefix + "failed"
+)
+
+// Info contains the information about the notification, which is stored with every attempt to send it.
+type Info struct {
+	UserID              string                  `json:"userId,omitempty"`
+	TriggeringEventType eventstore.EventType    `json:"triggeringEventType,omitempty"`
+	MessageType         string                  `json:"messageType,omitempty"`
+	NotificationType    domain.NotificationType `json:"notificationType"`
+	ProviderID          string                  `json:"providerId,omitempty"`
+	Attempt             int                     `json:"attempt,omitempty"`
+}
+
+// AttemptSucceededEvent stores the successful sending of the notification by the provider.
+type AttemptSucceededEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	Info
+
+	DeliveryID string `json:"deliveryId,omitempty"`
+}
+
+func (e *AttemptSucceededEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *AttemptSucceededEvent) Payload() any {
+	return e
+}
+
+func (e *AttemptSucceededEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewAttemptSucceededEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	info Info,
+	deliveryID string,
+) *AttemptSucceededEvent {
+	return &AttemptSucceededEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, AttemptSucceededEventType,
+		),
+		Info:       info,
+		DeliveryID: deliveryID,
+	}
+}
+
+// AttemptFailedEvent stores a failed attempt to send the notification.
+// If Final is set, the notification is not retried anymore.
+type AttemptFailedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	Info
+
+	Error string `json:"error,omitempty"`
+	Final bool   `json:"final,omitempty"`
+}
+
+func (e *AttemptFailedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *AttemptFailedEvent) Payload() any {
+	return e
+}
+
+func (e *AttemptFailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewAttemptFailedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	info Info,
+	err string,
+	final bool,
+) *AttemptFailedEvent {
+	return &AttemptFailedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, AttemptFailedEventType,
+		),
+		Info:  info,
+		Error: err,
+		Final: final,
+	}
+}
@@ -0,0 +1,8 @@
+package notification
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+func init() {
+	eventstore.RegisterFilterEventMapper(AggregateType, AttemptSucceededEventType, eventstore.GenericEventMapper[AttemptSucceededEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, AttemptFailedEventType, eventstore.GenericEventMapper[AttemptFailedEvent])
+}
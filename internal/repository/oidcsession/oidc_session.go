@@ -34,6 +34,8 @@ type AddedEvent struct {
 	Nonce             string                      `json:"nonce,omitempty"`
 	PreferredLanguage *language.Tag               `json:"preferredLanguage,omitempty"`
 	UserAgent         *domain.UserAgent           `json:"userAgent,omitempty"`
+	// AuthorizationDetails granted to the session (RFC 9396)
+	AuthorizationDetails domain.AuthorizationDetails `json:"authorizationDetails,omitempty"`
 }
 
 func (e *AddedEvent) Payload() interface{} {
@@ -61,6 +63,7 @@ func NewAddedEvent(ctx context.Context,
 	nonce string,
 	preferredLanguage *language.Tag,
 	userAgent *domain.UserAgent,
+	authorizationDetails domain.AuthorizationDetails,
 ) *AddedEvent {
 	return &AddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -68,17 +71,18 @@ func NewAddedEvent(ctx context.Context,
 			aggregate,
 			AddedType,
 		),
-		UserID:            userID,
-		UserResourceOwner: userResourceOwner,
-		SessionID:         sessionID,
-		ClientID:          clientID,
-		Audience:          audience,
-		Scope:             scope,
-		AuthMethods:       authMethods,
-		AuthTime:          authTime,
-		Nonce:             nonce,
-		PreferredLanguage: preferredLanguage,
-		UserAgent:         userAgent,
+		UserID:               userID,
+		UserResourceOwner:    userResourceOwner,
+		SessionID:            sessionID,
+		ClientID:             clientID,
+		Audience:             audience,
+		Scope:                scope,
+		AuthMethods:          authMethods,
+		AuthTime:             authTime,
+		Nonce:                nonce,
+		PreferredLanguage:    preferredLanguage,
+		UserAgent:            userAgent,
+		AuthorizationDetails: authorizationDetails,
 	}
 }
 
@@ -90,6 +94,13 @@ type AccessTokenAddedEvent struct {
 	Lifetime time.Duration      `json:"lifetime,omitempty"`
 	Reason   domain.TokenReason `json:"reason,omitempty"`
 	Actor    *domain.TokenActor `json:"actor,omitempty"`
+	// DPoPJKT is the thumbprint of the DPoP key the token is bound to (RFC 9449).
+	DPoPJKT string `json:"dpopJkt,omitempty"`
+	// CertThumbprint is the thumbprint of the client certificate the token is bound to (RFC 8705).
+	CertThumbprint string `json:"certThumbprint,omitempty"`
+	// AuthorizationDetails narrow down the authorization details of the session for this token (RFC 9396, section 6.2).
+	// If empty, the token is granted all authorization details of the session.
+	AuthorizationDetails domain.AuthorizationDetails `json:"authorizationDetails,omitempty"`
 }
 
 func (e *AccessTokenAddedEvent) Payload() interface{} {
@@ -112,6 +123,9 @@ func NewAccessTokenAddedEvent(
 	lifetime time.Duration,
 	reason domain.TokenReason,
 	actor *domain.TokenActor,
+	dpopJKT string,
+	certThumbprint string,
+	authorizationDetails domain.AuthorizationDetails,
 ) *AccessTokenAddedEvent {
 	return &AccessTokenAddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -119,11 +133,14 @@ func NewAccessTokenAddedEvent(
 			aggregate,
 			AccessTokenAddedType,
 		),
-		ID:       id,
-		Scope:    scope,
-		Lifetime: lifetime,
-		Reason:   reason,
-		Actor:    actor,
+		ID:                   id,
+		Scope:                scope,
+		Lifetime:             lifetime,
+		Reason:               reason,
+		Actor:                actor,
+		DPoPJKT:              dpopJKT,
+		CertThumbprint:       certThumbprint,
+		AuthorizationDetails: authorizationDetails,
 	}
 }
 
@@ -162,6 +179,9 @@ type RefreshTokenAddedEvent struct {
 	ID           string        `json:"id"`
 	Lifetime     time.Duration `json:"lifetime"`
 	IdleLifetime time.Duration `json:"idleLifetime"`
+	// DPoPJKT is the thumbprint of the DPoP key the token is bound to (RFC 9449).
+	// Only refresh tokens of public clients are bound.
+	DPoPJKT string `json:"dpopJkt,omitempty"`
 }
 
 func (e *RefreshTokenAddedEvent) Payload() interface{} {
@@ -182,6 +202,7 @@ func NewRefreshTokenAddedEvent(
 	id string,
 	lifetime,
 	idleLifetime time.Duration,
+	dpopJKT string,
 ) *RefreshTokenAddedEvent {
 	return &RefreshTokenAddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -192,6 +213,7 @@ func NewRefreshTokenAddedEvent(
 		ID:           id,
 		Lifetime:     lifetime,
 		IdleLifetime: idleLifetime,
+		DPoPJKT:      dpopJKT,
 	}
 }
 
@@ -2,6 +2,7 @@ package org
 
 import (
 	"context"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/policy"
@@ -23,6 +24,8 @@ func NewLockoutPolicyAddedEvent(
 	maxPasswordAttempts,
 	maxOTPAttempts uint64,
 	showLockoutFailure bool,
+	lockoutDuration,
+	failedAttemptDelay time.Duration,
 ) *LockoutPolicyAddedEvent {
 	return &LockoutPolicyAddedEvent{
 		LockoutPolicyAddedEvent: *policy.NewLockoutPolicyAddedEvent(
@@ -32,7 +35,9 @@ func NewLockoutPolicyAddedEvent(
 				LockoutPolicyAddedEventType),
 			maxPasswordAttempts,
 			maxOTPAttempts,
-			showLockoutFailure),
+			showLockoutFailure,
+			lockoutDuration,
+			failedAttemptDelay),
 	}
 }
 
@@ -19,6 +19,8 @@ type PasswordComplexityPolicyAddedEvent struct {
 	HasUppercase bool   `json:"hasUppercase,omitempty"`
 	HasNumber    bool   `json:"hasNumber,omitempty"`
 	HasSymbol    bool   `json:"hasSymbol,omitempty"`
+	// CheckBreached defines if passwords are checked against known breached passwords.
+	CheckBreached bool `json:"checkBreached,omitempty"`
 }
 
 func (e *PasswordComplexityPolicyAddedEvent) Payload() interface{} {
@@ -35,15 +37,17 @@ func NewPasswordComplexityPolicyAddedEvent(
 	hasLowerCase,
 	hasUpperCase,
 	hasNumber,
-	hasSymbol bool,
+	hasSymbol,
+	checkBreached bool,
 ) *PasswordComplexityPolicyAddedEvent {
 	return &PasswordComplexityPolicyAddedEvent{
-		BaseEvent:    *base,
-		MinLength:    minLength,
-		HasLowercase: hasLowerCase,
-		HasUppercase: hasUpperCase,
-		HasNumber:    hasNumber,
-		HasSymbol:    hasSymbol,
+		BaseEvent:     *base,
+		MinLength:     minLength,
+		HasLowercase:  hasLowerCase,
+		HasUppercase:  hasUpperCase,
+		HasNumber:     hasNumber,
+		HasSymbol:     hasSymbol,
+		CheckBreached: checkBreached,
 	}
 }
 
@@ -68,6 +72,8 @@ type PasswordComplexityPolicyChangedEvent struct {
 	HasUppercase *bool   `json:"hasUppercase,omitempty"`
 	HasNumber    *bool   `json:"hasNumber,omitempty"`
 	HasSymbol    *bool   `json:"hasSymbol,omitempty"`
+	// CheckBreached defines if passwords are checked against known breached passwords.
+	CheckBreached *bool `json:"checkBreached,omitempty"`
 }
 
 func (e *PasswordComplexityPolicyChangedEvent) Payload() interface{} {
@@ -126,6 +132,12 @@ func ChangeHasSymbol(hasSymbol bool) func(*PasswordComplexityPolicyChangedEvent)
 	}
 }
 
+func ChangeCheckBreached(checkBreached bool) func(*PasswordComplexityPolicyChangedEvent) {
+	return func(e *PasswordComplexityPolicyChangedEvent) {
+		e.CheckBreached = &checkBreached
+	}
+}
+
 func PasswordComplexityPolicyChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 	e := &PasswordComplexityPolicyChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
@@ -1,6 +1,8 @@
 package policy
 
 import (
+	"time"
+
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -14,9 +16,11 @@ const (
 type LockoutPolicyAddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	MaxPasswordAttempts uint64 `json:"maxPasswordAttempts,omitempty"`
-	MaxOTPAttempts      uint64 `json:"maxOTPAttempts,omitempty"`
-	ShowLockOutFailures bool   `json:"showLockOutFailures,omitempty"`
+	MaxPasswordAttempts uint64        `json:"maxPasswordAttempts,omitempty"`
+	MaxOTPAttempts      uint64        `json:"maxOTPAttempts,omitempty"`
+	ShowLockOutFailures bool          `json:"showLockOutFailures,omitempty"`
+	LockoutDuration     time.Duration `json:"lockoutDuration,omitempty"`
+	FailedAttemptDelay  time.Duration `json:"failedAttemptDelay,omitempty"`
 }
 
 func (e *LockoutPolicyAddedEvent) Payload() interface{} {
@@ -32,6 +36,8 @@ func NewLockoutPolicyAddedEvent(
 	maxPasswordAttempts,
 	maxOTPAttempts uint64,
 	showLockOutFailures bool,
+	lockoutDuration,
+	failedAttemptDelay time.Duration,
 ) *LockoutPolicyAddedEvent {
 
 	return &LockoutPolicyAddedEvent{
@@ -39,6 +45,8 @@ func NewLockoutPolicyAddedEvent(
 		MaxPasswordAttempts: maxPasswordAttempts,
 		MaxOTPAttempts:      maxOTPAttempts,
 		ShowLockOutFailures: showLockOutFailures,
+		LockoutDuration:     lockoutDuration,
+		FailedAttemptDelay:  failedAttemptDelay,
 	}
 }
 
@@ -58,9 +66,11 @@ func LockoutPolicyAddedEventMapper(event eventstore.Event) (eventstore.Event, er
 type LockoutPolicyChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	MaxPasswordAttempts *uint64 `json:"maxPasswordAttempts,omitempty"`
-	MaxOTPAttempts      *uint64 `json:"maxOTPAttempts,omitempty"`
-	ShowLockOutFailures *bool   `json:"showLockOutFailures,omitempty"`
+	MaxPasswordAttempts *uint64        `json:"maxPasswordAttempts,omitempty"`
+	MaxOTPAttempts      *uint64        `json:"maxOTPAttempts,omitempty"`
+	ShowLockOutFailures *bool          `json:"showLockOutFailures,omitempty"`
+	LockoutDuration     *time.Duration `json:"lockoutDuration,omitempty"`
+	FailedAttemptDelay  *time.Duration `json:"failedAttemptDelay,omitempty"`
 }
 
 func (e *LockoutPolicyChangedEvent) Payload() interface{} {
@@ -107,6 +117,18 @@ func ChangeShowLockOutFailures(showLockOutFailures bool) func(*LockoutPolicyChan
 	}
 }
 
+func ChangeLockoutDuration(lockoutDuration time.Duration) func(*LockoutPolicyChangedEvent) {
+	return func(e *LockoutPolicyChangedEvent) {
+		e.LockoutDuration = &lockoutDuration
+	}
+}
+
+func ChangeFailedAttemptDelay(failedAttemptDelay time.Duration) func(*LockoutPolicyChangedEvent) {
+	return func(e *LockoutPolicyChangedEvent) {
+		e.FailedAttemptDelay = &failedAttemptDelay
+	}
+}
+
 func LockoutPolicyChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 	e := &LockoutPolicyChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
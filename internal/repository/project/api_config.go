@@ -27,7 +27,9 @@ type APIConfigAddedEvent struct {
 	ClientSecret *crypto.CryptoValue `json:"clientSecret,omitempty"`
 	HashedSecret string              `json:"hashedSecret,omitempty"`
 
-	AuthMethodType domain.APIAuthMethodType `json:"authMethodType,omitempty"`
+	AuthMethodType         domain.APIAuthMethodType `json:"authMethodType,omitempty"`
+	TLSClientAuthSubjectDN string                   `json:"tlsClientAuthSubjectDn,omitempty"`
+	TLSClientAuthSAN       string                   `json:"tlsClientAuthSan,omitempty"`
 }
 
 func (e *APIConfigAddedEvent) Payload() interface{} {
@@ -45,6 +47,8 @@ func NewAPIConfigAddedEvent(
 	clientID string,
 	hashedSecret string,
 	authMethodType domain.APIAuthMethodType,
+	tlsClientAuthSubjectDN string,
+	tlsClientAuthSAN string,
 ) *APIConfigAddedEvent {
 	return &APIConfigAddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -52,10 +56,12 @@ func NewAPIConfigAddedEvent(
 			aggregate,
 			APIConfigAddedType,
 		),
-		AppID:          appID,
-		ClientID:       clientID,
-		HashedSecret:   hashedSecret,
-		AuthMethodType: authMethodType,
+		AppID:                  appID,
+		ClientID:               clientID,
+		HashedSecret:           hashedSecret,
+		AuthMethodType:         authMethodType,
+		TLSClientAuthSubjectDN: tlsClientAuthSubjectDN,
+		TLSClientAuthSAN:       tlsClientAuthSAN,
 	}
 }
 
@@ -74,6 +80,12 @@ func (e *APIConfigAddedEvent) Validate(cmd eventstore.Command) bool {
 	if e.AuthMethodType != c.AuthMethodType {
 		return false
 	}
+	if e.TLSClientAuthSubjectDN != c.TLSClientAuthSubjectDN {
+		return false
+	}
+	if e.TLSClientAuthSAN != c.TLSClientAuthSAN {
+		return false
+	}
 
 	return true
 }
@@ -94,8 +106,10 @@ func APIConfigAddedEventMapper(event eventstore.Event) (eventstore.Event, error)
 type APIConfigChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	AppID          string                    `json:"appId"`
-	AuthMethodType *domain.APIAuthMethodType `json:"authMethodType,omitempty"`
+	AppID                  string                    `json:"appId"`
+	AuthMethodType         *domain.APIAuthMethodType `json:"authMethodType,omitempty"`
+	TLSClientAuthSubjectDN *string                   `json:"tlsClientAuthSubjectDn,omitempty"`
+	TLSClientAuthSAN       *string                   `json:"tlsClientAuthSan,omitempty"`
 }
 
 func (e *APIConfigChangedEvent) Payload() interface{} {
@@ -138,6 +152,18 @@ func ChangeAPIAuthMethodType(authMethodType domain.APIAuthMethodType) func(event
 	}
 }
 
+func ChangeAPITLSClientAuthSubjectDN(subjectDN string) func(event *APIConfigChangedEvent) {
+	return func(e *APIConfigChangedEvent) {
+		e.TLSClientAuthSubjectDN = &subjectDN
+	}
+}
+
+func ChangeAPITLSClientAuthSAN(san string) func(event *APIConfigChangedEvent) {
+	return func(e *APIConfigChangedEvent) {
+		e.TLSClientAuthSAN = &san
+	}
+}
+
 func APIConfigChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 	e := &APIConfigChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
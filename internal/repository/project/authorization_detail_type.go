@@ -0,0 +1,96 @@
+package project
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	UniqueAuthorizationDetailType           = "project_authorization_detail_type"
+	authorizationDetailTypeEventTypePrefix  = projectEventTypePrefix + "authorization_detail_type."
+	AuthorizationDetailTypeAddedEventType   = authorizationDetailTypeEventTypePrefix + "added"
+	AuthorizationDetailTypeRemovedEventType = authorizationDetailTypeEventTypePrefix + "removed"
+)
+
+func NewAddAuthorizationDetailTypeUniqueConstraint(detailType, projectID string) *eventstore.UniqueConstraint {
+	return eventstore.NewAddEventUniqueConstraint(
+		UniqueAuthorizationDetailType,
+		fmt.Sprintf("%s:%s", detailType, projectID),
+		"Errors.Project.AuthorizationDetailType.AlreadyExists")
+}
+
+func NewRemoveAuthorizationDetailTypeUniqueConstraint(detailType, projectID string) *eventstore.UniqueConstraint {
+	return eventstore.NewRemoveUniqueConstraint(
+		UniqueAuthorizationDetailType,
+		fmt.Sprintf("%s:%s", detailType, projectID))
+}
+
+// AuthorizationDetailTypeAddedEvent registers a type of authorization details (RFC 9396)
+// which clients of the project's applications are allowed to request.
+type AuthorizationDetailTypeAddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	DetailType string `json:"type"`
+}
+
+func NewAuthorizationDetailTypeAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	detailType string,
+) *AuthorizationDetailTypeAddedEvent {
+	return &AuthorizationDetailTypeAddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			AuthorizationDetailTypeAddedEventType,
+		),
+		DetailType: detailType,
+	}
+}
+
+func (e *AuthorizationDetailTypeAddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *AuthorizationDetailTypeAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *AuthorizationDetailTypeAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewAddAuthorizationDetailTypeUniqueConstraint(e.DetailType, e.Aggregate().ID)}
+}
+
+type AuthorizationDetailTypeRemovedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	DetailType string `json:"type"`
+}
+
+func NewAuthorizationDetailTypeRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	detailType string,
+) *AuthorizationDetailTypeRemovedEvent {
+	return &AuthorizationDetailTypeRemovedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			AuthorizationDetailTypeRemovedEventType,
+		),
+		DetailType: detailType,
+	}
+}
+
+func (e *AuthorizationDetailTypeRemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *AuthorizationDetailTypeRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *AuthorizationDetailTypeRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewRemoveAuthorizationDetailTypeUniqueConstraint(e.DetailType, e.Aggregate().ID)}
+}
@@ -45,4 +45,6 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, ApplicationKeyRemovedEventType, ApplicationKeyRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SAMLConfigAddedType, SAMLConfigAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SAMLConfigChangedType, SAMLConfigChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, AuthorizationDetailTypeAddedEventType, eventstore.GenericEventMapper[AuthorizationDetailTypeAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, AuthorizationDetailTypeRemovedEventType, eventstore.GenericEventMapper[AuthorizationDetailTypeRemovedEvent])
 }
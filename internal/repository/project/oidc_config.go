@@ -32,27 +32,33 @@ type OIDCConfigAddedEvent struct {
 	ClientSecret *crypto.CryptoValue `json:"clientSecret,omitempty"`
 	HashedSecret string              `json:"hashedSecret,omitempty"`
 
-	RedirectUris                  []string                   `json:"redirectUris,omitempty"`
-	ResponseTypes                 []domain.OIDCResponseType  `json:"responseTypes,omitempty"`
-	GrantTypes                    []domain.OIDCGrantType     `json:"grantTypes,omitempty"`
-	ApplicationType               domain.OIDCApplicationType `json:"applicationType,omitempty"`
-	AuthMethodType                domain.OIDCAuthMethodType  `json:"authMethodType,omitempty"`
-	PostLogoutRedirectUris        []string                   `json:"postLogoutRedirectUris,omitempty"`
-	DevMode                       bool                       `json:"devMode,omitempty"`
-	AccessTokenType               domain.OIDCTokenType       `json:"accessTokenType,omitempty"`
-	AccessTokenRoleAssertion      bool                       `json:"accessTokenRoleAssertion,omitempty"`
-	IDTokenRoleAssertion          bool                       `json:"idTokenRoleAssertion,omitempty"`
-	IDTokenUserinfoAssertion      bool                       `json:"idTokenUserinfoAssertion,omitempty"`
-	ClockSkew                     time.Duration              `json:"clockSkew,omitempty"`
-	AdditionalOrigins             []string                   `json:"additionalOrigins,omitempty"`
-	SkipNativeAppSuccessPage      bool                       `json:"skipNativeAppSuccessPage,omitempty"`
-	BackChannelLogoutURI          string                     `json:"backChannelLogoutURI,omitempty"`
-	LoginVersion                  domain.LoginVersion        `json:"loginVersion,omitempty"`
-	LoginBaseURI                  string                     `json:"loginBaseURI,omitempty"`
-	IOSTeamID                     string                     `json:"iosTeamId,omitempty"`
-	IOSBundleID                   string                     `json:"iosBundleId,omitempty"`
-	AndroidPackageName            string                     `json:"androidPackageName,omitempty"`
-	AndroidSHA256CertFingerprints []string                   `json:"androidSha256CertFingerprints,omitempty"`
+	RedirectUris                     []string                   `json:"redirectUris,omitempty"`
+	ResponseTypes                    []domain.OIDCResponseType  `json:"responseTypes,omitempty"`
+	GrantTypes                       []domain.OIDCGrantType     `json:"grantTypes,omitempty"`
+	ApplicationType                  domain.OIDCApplicationType `json:"applicationType,omitempty"`
+	AuthMethodType                   domain.OIDCAuthMethodType  `json:"authMethodType,omitempty"`
+	PostLogoutRedirectUris           []string                   `json:"postLogoutRedirectUris,omitempty"`
+	DevMode                          bool                       `json:"devMode,omitempty"`
+	AccessTokenType                  domain.OIDCTokenType       `json:"accessTokenType,omitempty"`
+	AccessTokenRoleAssertion         bool                       `json:"accessTokenRoleAssertion,omitempty"`
+	IDTokenRoleAssertion             bool                       `json:"idTokenRoleAssertion,omitempty"`
+	IDTokenUserinfoAssertion         bool                       `json:"idTokenUserinfoAssertion,omitempty"`
+	ClockSkew                        time.Duration              `json:"clockSkew,omitempty"`
+	AdditionalOrigins                []string                   `json:"additionalOrigins,omitempty"`
+	SkipNativeAppSuccessPage         bool                       `json:"skipNativeAppSuccessPage,omitempty"`
+	BackChannelLogoutURI             string                     `json:"backChannelLogoutURI,omitempty"`
+	LoginVersion                     domain.LoginVersion        `json:"loginVersion,omitempty"`
+	LoginBaseURI                     string                     `json:"loginBaseURI,omitempty"`
+	IOSTeamID                        string                     `json:"iosTeamId,omitempty"`
+	IOSBundleID                      string                     `json:"iosBundleId,omitempty"`
+	AndroidPackageName               string                     `json:"androidPackageName,omitempty"`
+	AndroidSHA256CertFingerprints    []string                   `json:"androidSha256CertFingerprints,omitempty"`
+	DPoPRequired                     bool                       `json:"dpopRequired,omitempty"`
+	PARRequired                      bool                       `json:"parRequired,omitempty"`
+	TLSClientAuthSubjectDN           string                     `json:"tlsClientAuthSubjectDn,omitempty"`
+	TLSClientAuthSAN                 string                     `json:"tlsClientAuthSan,omitempty"`
+	BackChannelClientNotificationURI string                     `json:"backChannelClientNotificationURI,omitempty"`
+	ConsentRequired                  bool                       `json:"consentRequired,omitempty"`
 }
 
 func (e *OIDCConfigAddedEvent) Payload() interface{} {
@@ -91,6 +97,12 @@ func NewOIDCConfigAddedEvent(
 	iosBundleID string,
 	androidPackageName string,
 	androidSHA256CertFingerprints []string,
+	dpopRequired bool,
+	parRequired bool,
+	tlsClientAuthSubjectDN string,
+	tlsClientAuthSAN string,
+	backChannelClientNotificationURI string,
+	consentRequired bool,
 ) *OIDCConfigAddedEvent {
 	return &OIDCConfigAddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -98,31 +110,37 @@ func NewOIDCConfigAddedEvent(
 			aggregate,
 			OIDCConfigAddedType,
 		),
-		Version:                       version,
-		AppID:                         appID,
-		ClientID:                      clientID,
-		HashedSecret:                  hashedSecret,
-		RedirectUris:                  redirectUris,
-		ResponseTypes:                 responseTypes,
-		GrantTypes:                    grantTypes,
-		ApplicationType:               applicationType,
-		AuthMethodType:                authMethodType,
-		PostLogoutRedirectUris:        postLogoutRedirectUris,
-		DevMode:                       devMode,
-		AccessTokenType:               accessTokenType,
-		AccessTokenRoleAssertion:      accessTokenRoleAssertion,
-		IDTokenRoleAssertion:          idTokenRoleAssertion,
-		IDTokenUserinfoAssertion:      idTokenUserinfoAssertion,
-		ClockSkew:                     clockSkew,
-		AdditionalOrigins:             additionalOrigins,
-		SkipNativeAppSuccessPage:      skipNativeAppSuccessPage,
-		BackChannelLogoutURI:          backChannelLogoutURI,
-		LoginVersion:                  loginVersion,
-		LoginBaseURI:                  loginBaseURI,
-		IOSTeamID:                     iosTeamID,
-		IOSBundleID:                   iosBundleID,
-		AndroidPackageName:            androidPackageName,
-		AndroidSHA256CertFingerprints: androidSHA256CertFingerprints,
+		Version:                          version,
+		AppID:                            appID,
+		ClientID:                         clientID,
+		HashedSecret:                     hashedSecret,
+		RedirectUris:                     redirectUris,
+		ResponseTypes:                    responseTypes,
+		GrantTypes:                       grantTypes,
+		ApplicationType:                  applicationType,
+		AuthMethodType:                   authMethodType,
+		PostLogoutRedirectUris:           postLogoutRedirectUris,
+		DevMode:                          devMode,
+		AccessTokenType:                  accessTokenType,
+		AccessTokenRoleAssertion:         accessTokenRoleAssertion,
+		IDTokenRoleAssertion:             idTokenRoleAssertion,
+		IDTokenUserinfoAssertion:         idTokenUserinfoAssertion,
+		ClockSkew:                        clockSkew,
+		AdditionalOrigins:                additionalOrigins,
+		SkipNativeAppSuccessPage:         skipNativeAppSuccessPage,
+		BackChannelLogoutURI:             backChannelLogoutURI,
+		LoginVersion:                     loginVersion,
+		LoginBaseURI:                     loginBaseURI,
+		IOSTeamID:                        iosTeamID,
+		IOSBundleID:                      iosBundleID,
+		AndroidPackageName:               androidPackageName,
+		AndroidSHA256CertFingerprints:    androidSHA256CertFingerprints,
+		DPoPRequired:                     dpopRequired,
+		PARRequired:                      parRequired,
+		TLSClientAuthSubjectDN:           tlsClientAuthSubjectDN,
+		TLSClientAuthSAN:                 tlsClientAuthSAN,
+		BackChannelClientNotificationURI: backChannelClientNotificationURI,
+		ConsentRequired:                  consentRequired,
 	}
 }
 
@@ -229,6 +247,24 @@ func (e *OIDCConfigAddedEvent) Validate(cmd eventstore.Command) bool {
 	if e.AndroidPackageName != c.AndroidPackageName {
 		return false
 	}
+	if e.DPoPRequired != c.DPoPRequired {
+		return false
+	}
+	if e.PARRequired != c.PARRequired {
+		return false
+	}
+	if e.TLSClientAuthSubjectDN != c.TLSClientAuthSubjectDN {
+		return false
+	}
+	if e.TLSClientAuthSAN != c.TLSClientAuthSAN {
+		return false
+	}
+	if e.BackChannelClientNotificationURI != c.BackChannelClientNotificationURI {
+		return false
+	}
+	if e.ConsentRequired != c.ConsentRequired {
+		return false
+	}
 	return slices.Equal(e.AndroidSHA256CertFingerprints, c.AndroidSHA256CertFingerprints)
 }
 
@@ -248,29 +284,35 @@ func OIDCConfigAddedEventMapper(event eventstore.Event) (eventstore.Event, error
 type OIDCConfigChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	Version                       *domain.OIDCVersion         `json:"oidcVersion,omitempty"`
-	AppID                         string                      `json:"appId"`
-	RedirectUris                  *[]string                   `json:"redirectUris,omitempty"`
-	ResponseTypes                 *[]domain.OIDCResponseType  `json:"responseTypes,omitempty"`
-	GrantTypes                    *[]domain.OIDCGrantType     `json:"grantTypes,omitempty"`
-	ApplicationType               *domain.OIDCApplicationType `json:"applicationType,omitempty"`
-	AuthMethodType                *domain.OIDCAuthMethodType  `json:"authMethodType,omitempty"`
-	PostLogoutRedirectUris        *[]string                   `json:"postLogoutRedirectUris,omitempty"`
-	DevMode                       *bool                       `json:"devMode,omitempty"`
-	AccessTokenType               *domain.OIDCTokenType       `json:"accessTokenType,omitempty"`
-	AccessTokenRoleAssertion      *bool                       `json:"accessTokenRoleAssertion,omitempty"`
-	IDTokenRoleAssertion          *bool                       `json:"idTokenRoleAssertion,omitempty"`
-	IDTokenUserinfoAssertion      *bool                       `json:"idTokenUserinfoAssertion,omitempty"`
-	ClockSkew                     *time.Duration              `json:"clockSkew,omitempty"`
-	AdditionalOrigins             *[]string                   `json:"additionalOrigins,omitempty"`
-	SkipNativeAppSuccessPage      *bool                       `json:"skipNativeAppSuccessPage,omitempty"`
-	BackChannelLogoutURI          *string                     `json:"backChannelLogoutURI,omitempty"`
-	LoginVersion                  *domain.LoginVersion        `json:"loginVersion,omitempty"`
-	LoginBaseURI                  *string                     `json:"loginBaseURI,omitempty"`
-	IOSTeamID                     *string                     `json:"iosTeamId,omitempty"`
-	IOSBundleID                   *string                     `json:"iosBundleId,omitempty"`
-	AndroidPackageName            *string                     `json:"androidPackageName,omitempty"`
-	AndroidSHA256CertFingerprints *[]string                   `json:"androidSha256CertFingerprints,omitempty"`
+	Version                          *domain.OIDCVersion         `json:"oidcVersion,omitempty"`
+	AppID                            string                      `json:"appId"`
+	RedirectUris                     *[]string                   `json:"redirectUris,omitempty"`
+	ResponseTypes                    *[]domain.OIDCResponseType  `json:"responseTypes,omitempty"`
+	GrantTypes                       *[]domain.OIDCGrantType     `json:"grantTypes,omitempty"`
+	ApplicationType                  *domain.OIDCApplicationType `json:"applicationType,omitempty"`
+	AuthMethodType                   *domain.OIDCAuthMethodType  `json:"authMethodType,omitempty"`
+	PostLogoutRedirectUris           *[]string                   `json:"postLogoutRedirectUris,omitempty"`
+	DevMode                          *bool                       `json:"devMode,omitempty"`
+	AccessTokenType                  *domain.OIDCTokenType       `json:"accessTokenType,omitempty"`
+	AccessTokenRoleAssertion         *bool                       `json:"accessTokenRoleAssertion,omitempty"`
+	IDTokenRoleAssertion             *bool                       `json:"idTokenRoleAssertion,omitempty"`
+	IDTokenUserinfoAssertion         *bool                       `json:"idTokenUserinfoAssertion,omitempty"`
+	ClockSkew                        *time.Duration              `json:"clockSkew,omitempty"`
+	AdditionalOrigins                *[]string                   `json:"additionalOrigins,omitempty"`
+	SkipNativeAppSuccessPage         *bool                       `json:"skipNativeAppSuccessPage,omitempty"`
+	BackChannelLogoutURI             *string                     `json:"backChannelLogoutURI,omitempty"`
+	LoginVersion                     *domain.LoginVersion        `json:"loginVersion,omitempty"`
+	LoginBaseURI                     *string                     `json:"loginBaseURI,omitempty"`
+	IOSTeamID                        *string                     `json:"iosTeamId,omitempty"`
+	IOSBundleID                      *string                     `json:"iosBundleId,omitempty"`
+	AndroidPackageName               *string                     `json:"androidPackageName,omitempty"`
+	AndroidSHA256CertFingerprints    *[]string                   `json:"androidSha256CertFingerprints,omitempty"`
+	DPoPRequired                     *bool                       `json:"dpopRequired,omitempty"`
+	PARRequired                      *bool                       `json:"parRequired,omitempty"`
+	TLSClientAuthSubjectDN           *string                     `json:"tlsClientAuthSubjectDn,omitempty"`
+	TLSClientAuthSAN                 *string                     `json:"tlsClientAuthSan,omitempty"`
+	BackChannelClientNotificationURI *string                     `json:"backChannelClientNotificationURI,omitempty"`
+	ConsentRequired                  *bool                       `json:"consentRequired,omitempty"`
 }
 
 func (e *OIDCConfigChangedEvent) Payload() interface{} {
@@ -455,6 +497,42 @@ func ChangeAndroidSHA256CertFingerprints(fingerprints []string) func(event *OIDC
 	}
 }
 
+func ChangeDPoPRequired(dpopRequired bool) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.DPoPRequired = &dpopRequired
+	}
+}
+
+func ChangePARRequired(parRequired bool) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.PARRequired = &parRequired
+	}
+}
+
+func ChangeTLSClientAuthSubjectDN(subjectDN string) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.TLSClientAuthSubjectDN = &subjectDN
+	}
+}
+
+func ChangeTLSClientAuthSAN(san string) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.TLSClientAuthSAN = &san
+	}
+}
+
+func ChangeBackChannelClientNotificationURI(notificationURI string) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.BackChannelClientNotificationURI = &notificationURI
+	}
+}
+
+func ChangeConsentRequired(consentRequired bool) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.ConsentRequired = &consentRequired
+	}
+}
+
 func OIDCConfigChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 	e := &OIDCConfigChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
@@ -23,6 +23,13 @@ type SAMLConfigAddedEvent struct {
 	MetadataURL  string              `json:"metadata_url,omitempty"`
 	LoginVersion domain.LoginVersion `json:"loginVersion,omitempty"`
 	LoginBaseURI string              `json:"loginBaseURI,omitempty"`
+
+	IDPInitiatedEnabled    bool                          `json:"idpInitiatedEnabled,omitempty"`
+	IDPInitiatedRelayState string                        `json:"idpInitiatedRelayState,omitempty"`
+	EncryptAssertion       bool                          `json:"encryptAssertion,omitempty"`
+	NameIDFormat           *domain.SAMLNameIDFormat      `json:"nameIdFormat,omitempty"`
+	NameIDSource           domain.SAMLNameIDSource       `json:"nameIdSource,omitempty"`
+	SignatureAlgorithm     domain.SAMLSignatureAlgorithm `json:"signatureAlgorithm,omitempty"`
 }
 
 func (e *SAMLConfigAddedEvent) Payload() interface{} {
@@ -55,6 +62,12 @@ func NewSAMLConfigAddedEvent(
 	metadataURL string,
 	loginVersion domain.LoginVersion,
 	loginBaseURI string,
+	idpInitiatedEnabled bool,
+	idpInitiatedRelayState string,
+	encryptAssertion bool,
+	nameIDFormat *domain.SAMLNameIDFormat,
+	nameIDSource domain.SAMLNameIDSource,
+	signatureAlgorithm domain.SAMLSignatureAlgorithm,
 ) *SAMLConfigAddedEvent {
 	return &SAMLConfigAddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -68,6 +81,13 @@ func NewSAMLConfigAddedEvent(
 		MetadataURL:  metadataURL,
 		LoginVersion: loginVersion,
 		LoginBaseURI: loginBaseURI,
+
+		IDPInitiatedEnabled:    idpInitiatedEnabled,
+		IDPInitiatedRelayState: idpInitiatedRelayState,
+		EncryptAssertion:       encryptAssertion,
+		NameIDFormat:           nameIDFormat,
+		NameIDSource:           nameIDSource,
+		SignatureAlgorithm:     signatureAlgorithm,
 	}
 }
 
@@ -93,7 +113,15 @@ type SAMLConfigChangedEvent struct {
 	MetadataURL  *string              `json:"metadata_url,omitempty"`
 	LoginVersion *domain.LoginVersion `json:"loginVersion,omitempty"`
 	LoginBaseURI *string              `json:"loginBaseURI,omitempty"`
-	oldEntityID  string
+
+	IDPInitiatedEnabled    *bool                          `json:"idpInitiatedEnabled,omitempty"`
+	IDPInitiatedRelayState *string                        `json:"idpInitiatedRelayState,omitempty"`
+	EncryptAssertion       *bool                          `json:"encryptAssertion,omitempty"`
+	NameIDFormat           *domain.SAMLNameIDFormat       `json:"nameIdFormat,omitempty"`
+	NameIDSource           *domain.SAMLNameIDSource       `json:"nameIdSource,omitempty"`
+	SignatureAlgorithm     *domain.SAMLSignatureAlgorithm `json:"signatureAlgorithm,omitempty"`
+
+	oldEntityID string
 }
 
 func (e *SAMLConfigChangedEvent) Payload() interface{} {
@@ -167,6 +195,42 @@ func ChangeSAMLLoginBaseURI(loginBaseURI string) func(event *SAMLConfigChangedEv
 	}
 }
 
+func ChangeSAMLIDPInitiatedEnabled(enabled bool) func(event *SAMLConfigChangedEvent) {
+	return func(e *SAMLConfigChangedEvent) {
+		e.IDPInitiatedEnabled = &enabled
+	}
+}
+
+func ChangeSAMLIDPInitiatedRelayState(relayState string) func(event *SAMLConfigChangedEvent) {
+	return func(e *SAMLConfigChangedEvent) {
+		e.IDPInitiatedRelayState = &relayState
+	}
+}
+
+func ChangeSAMLEncryptAssertion(encryptAssertion bool) func(event *SAMLConfigChangedEvent) {
+	return func(e *SAMLConfigChangedEvent) {
+		e.EncryptAssertion = &encryptAssertion
+	}
+}
+
+func ChangeSAMLNameIDFormat(nameIDFormat domain.SAMLNameIDFormat) func(event *SAMLConfigChangedEvent) {
+	return func(e *SAMLConfigChangedEvent) {
+		e.NameIDFormat = &nameIDFormat
+	}
+}
+
+func ChangeSAMLNameIDSource(nameIDSource domain.SAMLNameIDSource) func(event *SAMLConfigChangedEvent) {
+	return func(e *SAMLConfigChangedEvent) {
+		e.NameIDSource = &nameIDSource
+	}
+}
+
+func ChangeSAMLSignatureAlgorithm(signatureAlgorithm domain.SAMLSignatureAlgorithm) func(event *SAMLConfigChangedEvent) {
+	return func(e *SAMLConfigChangedEvent) {
+		e.SignatureAlgorithm = &signatureAlgorithm
+	}
+}
+
 func SAMLConfigChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 	e := &SAMLConfigChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
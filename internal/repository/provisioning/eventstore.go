@@ -0,0 +1,12 @@
+package provisioning
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+func init() {
+	eventstore.RegisterFilterEventMapper(AggregateType, AddedEventType, eventstore.GenericEventMapper[AddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, ChangedEventType, eventstore.GenericEventMapper[ChangedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, RemovedEventType, eventstore.GenericEventMapper[RemovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, ResyncRequestedEventType, eventstore.GenericEventMapper[ResyncRequestedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, SyncSucceededEventType, eventstore.GenericEventMapper[SyncSucceededEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, SyncFailedEventType, eventstore.GenericEventMapper[SyncFailedEvent])
+}
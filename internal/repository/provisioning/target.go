@@ -0,0 +1,212 @@
+package provisioning
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	eventTypePrefix          eventstore.EventType = "provisioning_target."
+	AddedEventType                                = eventTypePrefix + "added"
+	ChangedEventType                              = eventTypePrefix + "changed"
+	RemovedEventType                              = eventTypePrefix + "removed"
+	ResyncRequestedEventType                      = eventTypePrefix + "resync.requested"
+	SyncSucceededEventType                        = eventTypePrefix + "sync.succeeded"
+	SyncFailedEventType                           = eventTypePrefix + "sync.failed"
+)
+
+// AddedEvent configures the downstream SCIM endpoint of an application,
+// to which the users granted on the project of the application are provisioned.
+type AddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Name      string              `json:"name"`
+	ProjectID string              `json:"projectId"`
+	AppID     string              `json:"appId,omitempty"`
+	Endpoint  string              `json:"endpoint"`
+	Token     *crypto.CryptoValue `json:"token,omitempty"`
+}
+
+func (e *AddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *AddedEvent) Payload() any {
+	return e
+}
+
+func (e *AddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	name,
+	projectID,
+	appID,
+	endpoint string,
+	token *crypto.CryptoValue,
+) *AddedEvent {
+	return &AddedEvent{
+		*eventstore.NewBaseEventForPush(
+			ctx, aggregate, AddedEventType,
+		),
+		name,
+		projectID,
+		appID,
+		endpoint,
+		token,
+	}
+}
+
+type ChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Name     *string             `json:"name,omitempty"`
+	Endpoint *string             `json:"endpoint,omitempty"`
+	Token    *crypto.CryptoValue `json:"token,omitempty"`
+}
+
+func (e *ChangedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *ChangedEvent) Payload() any {
+	return e
+}
+
+func (e *ChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	changes []Changes,
+) *ChangedEvent {
+	changeEvent := &ChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ChangedEventType,
+		),
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent
+}
+
+type Changes func(event *ChangedEvent)
+
+func ChangeName(name string) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.Name = &name
+	}
+}
+
+func ChangeEndpoint(endpoint string) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.Endpoint = &endpoint
+	}
+}
+
+func ChangeToken(token *crypto.CryptoValue) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.Token = token
+	}
+}
+
+type RemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *RemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *RemovedEvent) Payload() any {
+	return e
+}
+
+func (e *RemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *RemovedEvent {
+	return &RemovedEvent{*eventstore.NewBaseEventForPush(ctx, aggregate, RemovedEventType)}
+}
+
+// ResyncRequestedEvent requests the provisioning of all users granted on the project,
+// regardless of their previous delivery state.
+type ResyncRequestedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *ResyncRequestedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *ResyncRequestedEvent) Payload() any {
+	return e
+}
+
+func (e *ResyncRequestedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewResyncRequestedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *ResyncRequestedEvent {
+	return &ResyncRequestedEvent{*eventstore.NewBaseEventForPush(ctx, aggregate, ResyncRequestedEventType)}
+}
+
+// SyncSucceededEvent is pushed when a delivery to a previously failing target succeeded.
+type SyncSucceededEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *SyncSucceededEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *SyncSucceededEvent) Payload() any {
+	return e
+}
+
+func (e *SyncSucceededEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewSyncSucceededEvent(ctx context.Context, aggregate *eventstore.Aggregate) *SyncSucceededEvent {
+	return &SyncSucceededEvent{*eventstore.NewBaseEventForPush(ctx, aggregate, SyncSucceededEventType)}
+}
+
+// SyncFailedEvent is pushed when a delivery to the target failed after all retries.
+type SyncFailedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID string `json:"userId,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+func (e *SyncFailedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *SyncFailedEvent) Payload() any {
+	return e
+}
+
+func (e *SyncFailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewSyncFailedEvent(ctx context.Context, aggregate *eventstore.Aggregate, userID, errorMessage string) *SyncFailedEvent {
+	return &SyncFailedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(ctx, aggregate, SyncFailedEventType),
+		UserID:    userID,
+		Error:     errorMessage,
+	}
+}
@@ -483,6 +483,8 @@ func NewOTPEmailChallengedEvent(
 
 type OTPEmailSentEvent struct {
 	eventstore.BaseEvent `json:"-"`
+
+	GeneratorInfo *senders.CodeGeneratorInfo `json:"generatorInfo,omitempty"`
 }
 
 func (e *OTPEmailSentEvent) Payload() interface{} {
@@ -500,6 +502,7 @@ func (e *OTPEmailSentEvent) SetBaseEvent(base *eventstore.BaseEvent) {
 func NewOTPEmailSentEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
+	generatorInfo *senders.CodeGeneratorInfo,
 ) *OTPEmailSentEvent {
 	return &OTPEmailSentEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -507,6 +510,7 @@ func NewOTPEmailSentEvent(
 			aggregate,
 			OTPEmailSentType,
 		),
+		GeneratorInfo: generatorInfo,
 	}
 }
 
@@ -11,6 +11,10 @@ const (
 	backChannelEventTypePrefix      = eventTypePrefix + "back_channel."
 	BackChannelLogoutRegisteredType = backChannelEventTypePrefix + "registered"
 	BackChannelLogoutSentType       = backChannelEventTypePrefix + "sent"
+	samlEventTypePrefix             = eventTypePrefix + "saml."
+	SAMLLogoutRegisteredType        = samlEventTypePrefix + "registered"
+	SAMLLogoutInitiatedType         = samlEventTypePrefix + "initiated"
+	SAMLLogoutSentType              = samlEventTypePrefix + "sent"
 )
 
 type BackChannelLogoutRegisteredEvent struct {
@@ -77,3 +81,130 @@ func NewBackChannelLogoutSentEvent(ctx context.Context, aggregate *eventstore.Ag
 		OIDCSessionID: oidcSessionID,
 	}
 }
+
+type SAMLLogoutRegisteredEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	SAMLSessionID      string `json:"saml_session_id"`
+	UserID             string `json:"user_id"`
+	EntityID           string `json:"entity_id"`
+	Issuer             string `json:"issuer"`
+	NameID             string `json:"name_id"`
+	NameIDFormat       string `json:"name_id_format,omitempty"`
+	SessionIndex       string `json:"session_index"`
+	SignatureAlgorithm string `json:"signature_algorithm,omitempty"`
+	SingleLogoutURL    string `json:"single_logout_url"`
+	Binding            string `json:"binding"`
+}
+
+func (e *SAMLLogoutRegisteredEvent) Payload() any {
+	return e
+}
+
+func (e *SAMLLogoutRegisteredEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *SAMLLogoutRegisteredEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func NewSAMLLogoutRegisteredEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	samlSessionID,
+	userID,
+	entityID,
+	issuer,
+	nameID,
+	nameIDFormat,
+	sessionIndex,
+	signatureAlgorithm,
+	singleLogoutURL,
+	binding string,
+) *SAMLLogoutRegisteredEvent {
+	return &SAMLLogoutRegisteredEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SAMLLogoutRegisteredType,
+		),
+		SAMLSessionID:      samlSessionID,
+		UserID:             userID,
+		EntityID:           entityID,
+		Issuer:             issuer,
+		NameID:             nameID,
+		NameIDFormat:       nameIDFormat,
+		SessionIndex:       sessionIndex,
+		SignatureAlgorithm: signatureAlgorithm,
+		SingleLogoutURL:    singleLogoutURL,
+		Binding:            binding,
+	}
+}
+
+type SAMLLogoutInitiatedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	// SAMLSessionID, RequestID and RelayState are empty if the logout was not initiated by a service provider.
+	SAMLSessionID string `json:"saml_session_id"`
+	RequestID     string `json:"request_id"`
+	RelayState    string `json:"relay_state,omitempty"`
+	// PostLogoutRedirectURI is set if the logout was initiated by ZITADEL (e.g. end_session)
+	// and the user agent is sent back to it after all participants were notified.
+	PostLogoutRedirectURI string `json:"post_logout_redirect_uri,omitempty"`
+}
+
+func (e *SAMLLogoutInitiatedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SAMLLogoutInitiatedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *SAMLLogoutInitiatedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewSAMLLogoutInitiatedEvent(ctx context.Context, aggregate *eventstore.Aggregate, samlSessionID, requestID, relayState, postLogoutRedirectURI string) *SAMLLogoutInitiatedEvent {
+	return &SAMLLogoutInitiatedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SAMLLogoutInitiatedType,
+		),
+		SAMLSessionID:         samlSessionID,
+		RequestID:             requestID,
+		RelayState:            relayState,
+		PostLogoutRedirectURI: postLogoutRedirectURI,
+	}
+}
+
+type SAMLLogoutSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	SAMLSessionID string `json:"saml_session_id"`
+}
+
+func (e *SAMLLogoutSentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SAMLLogoutSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *SAMLLogoutSentEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewSAMLLogoutSentEvent(ctx context.Context, aggregate *eventstore.Aggregate, samlSessionID string) *SAMLLogoutSentEvent {
+	return &SAMLLogoutSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SAMLLogoutSentType,
+		),
+		SAMLSessionID: samlSessionID,
+	}
+}
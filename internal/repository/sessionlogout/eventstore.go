@@ -7,9 +7,15 @@ import (
 var (
 	BackChannelLogoutRegisteredEventMapper = eventstore.GenericEventMapper[BackChannelLogoutRegisteredEvent]
 	BackChannelLogoutSentEventMapper       = eventstore.GenericEventMapper[BackChannelLogoutSentEvent]
+	SAMLLogoutRegisteredEventMapper        = eventstore.GenericEventMapper[SAMLLogoutRegisteredEvent]
+	SAMLLogoutInitiatedEventMapper         = eventstore.GenericEventMapper[SAMLLogoutInitiatedEvent]
+	SAMLLogoutSentEventMapper              = eventstore.GenericEventMapper[SAMLLogoutSentEvent]
 )
 
 func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, BackChannelLogoutRegisteredType, BackChannelLogoutRegisteredEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, BackChannelLogoutSentType, BackChannelLogoutSentEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SAMLLogoutRegisteredType, SAMLLogoutRegisteredEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SAMLLogoutInitiatedType, SAMLLogoutInitiatedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, SAMLLogoutSentType, SAMLLogoutSentEventMapper)
 }
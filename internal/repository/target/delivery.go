@@ -0,0 +1,116 @@
+package target
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	exec_repo "github.com/zitadel/zitadel/internal/repository/execution"
+)
+
+const (
+	DeliveryEventTypePrefix           eventstore.EventType = "target.delivery."
+	DeliveryFailedEventType                                = DeliveryEventTypePrefix + "failed"
+	DeliveryRedriveRequestedEventType                      = DeliveryEventTypePrefix + "redrive.requested"
+	DeliverySucceededEventType                             = DeliveryEventTypePrefix + "succeeded"
+)
+
+// DeliveryFailedEvent stores a call of an event execution to the target, which still failed after all retries.
+// The request only contains the target, so it can be re-driven without calling the other targets of the execution again.
+type DeliveryFailedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DeliveryID string             `json:"deliveryId,omitempty"`
+	Request    *exec_repo.Request `json:"request,omitempty"`
+	Error      string             `json:"error,omitempty"`
+	Attempts   uint8              `json:"attempts,omitempty"`
+}
+
+func (e *DeliveryFailedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *DeliveryFailedEvent) Payload() any {
+	return e
+}
+
+func (e *DeliveryFailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDeliveryFailedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deliveryID string,
+	request *exec_repo.Request,
+	err string,
+	attempts uint8,
+) *DeliveryFailedEvent {
+	return &DeliveryFailedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, DeliveryFailedEventType,
+		),
+		DeliveryID: deliveryID,
+		Request:    request,
+		Error:      err,
+		Attempts:   attempts,
+	}
+}
+
+// DeliveryRedriveRequestedEvent requests a new call of a failed delivery.
+// The request contains the current configuration of the target.
+type DeliveryRedriveRequestedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DeliveryID string             `json:"deliveryId,omitempty"`
+	Request    *exec_repo.Request `json:"request,omitempty"`
+}
+
+func (e *DeliveryRedriveRequestedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *DeliveryRedriveRequestedEvent) Payload() any {
+	return e
+}
+
+func (e *DeliveryRedriveRequestedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDeliveryRedriveRequestedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deliveryID string,
+	request *exec_repo.Request,
+) *DeliveryRedriveRequestedEvent {
+	return &DeliveryRedriveRequestedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, DeliveryRedriveRequestedEventType,
+		),
+		DeliveryID: deliveryID,
+		Request:    request,
+	}
+}
+
+// DeliverySucceededEvent marks a re-driven delivery as successfully delivered.
+type DeliverySucceededEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DeliveryID string `json:"deliveryId,omitempty"`
+}
+
+func (e *DeliverySucceededEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *DeliverySucceededEvent) Payload() any {
+	return e
+}
+
+func (e *DeliverySucceededEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDeliverySucceededEvent(ctx context.Context, aggregate *eventstore.Aggregate, deliveryID string) *DeliverySucceededEvent {
+	return &DeliverySucceededEvent{*eventstore.NewBaseEventForPush(ctx, aggregate, DeliverySucceededEventType), deliveryID}
+}
@@ -10,4 +10,7 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, KeyActivatedEventType, eventstore.GenericEventMapper[KeyActivatedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, KeyDeactivatedEventType, eventstore.GenericEventMapper[KeyDeactivatedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, KeyRemovedEventType, eventstore.GenericEventMapper[KeyRemovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, DeliveryFailedEventType, eventstore.GenericEventMapper[DeliveryFailedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, DeliveryRedriveRequestedEventType, eventstore.GenericEventMapper[DeliveryRedriveRequestedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, DeliverySucceededEventType, eventstore.GenericEventMapper[DeliverySucceededEvent])
 }
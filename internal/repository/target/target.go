@@ -26,6 +26,10 @@ type AddedEvent struct {
 	InterruptOnError bool                      `json:"interruptOnError"`
 	SigningKey       *crypto.CryptoValue       `json:"signingKey"`
 	PayloadType      target_domain.PayloadType `json:"payloadType"`
+	// ClientCertificate is the encrypted PEM encoded certificate and private key for mTLS.
+	ClientCertificate *crypto.CryptoValue `json:"clientCertificate,omitempty"`
+	// RetryPolicy defines the retries of failed calls of event executions.
+	RetryPolicy *target_domain.RetryPolicy `json:"retryPolicy,omitempty"`
 }
 
 func (e *AddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
@@ -50,6 +54,8 @@ func NewAddedEvent(
 	interruptOnError bool,
 	signingKey *crypto.CryptoValue,
 	payloadType target_domain.PayloadType,
+	clientCertificate *crypto.CryptoValue,
+	retryPolicy *target_domain.RetryPolicy,
 ) *AddedEvent {
 	return &AddedEvent{
 		*eventstore.NewBaseEventForPush(
@@ -62,6 +68,8 @@ func NewAddedEvent(
 		interruptOnError,
 		signingKey,
 		payloadType,
+		clientCertificate,
+		retryPolicy,
 	}
 }
 
@@ -75,6 +83,10 @@ type ChangedEvent struct {
 	InterruptOnError *bool                     `json:"interruptOnError,omitempty"`
 	SigningKey       *crypto.CryptoValue       `json:"signingKey,omitempty"`
 	PayloadType      target_domain.PayloadType `json:"payloadType,omitempty"`
+	// ClientCertificate is the encrypted PEM encoded certificate and private key for mTLS.
+	ClientCertificate *crypto.CryptoValue `json:"clientCertificate,omitempty"`
+	// RetryPolicy defines the retries of failed calls of event executions.
+	RetryPolicy *target_domain.RetryPolicy `json:"retryPolicy,omitempty"`
 
 	oldName string
 }
@@ -160,6 +172,18 @@ func ChangePayloadType(payloadType target_domain.PayloadType) func(event *Change
 	}
 }
 
+func ChangeClientCertificate(clientCertificate *crypto.CryptoValue) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.ClientCertificate = clientCertificate
+	}
+}
+
+func ChangeRetryPolicy(retryPolicy *target_domain.RetryPolicy) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.RetryPolicy = retryPolicy
+	}
+}
+
 type RemovedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
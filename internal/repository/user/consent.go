@@ -0,0 +1,75 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	consentEventPrefix = userEventTypePrefix + "consent."
+	ConsentGrantedType = consentEventPrefix + "granted"
+	ConsentRevokedType = consentEventPrefix + "revoked"
+)
+
+// ConsentGrantedEvent stores the scopes a user granted to a client on the consent screen.
+// A newer event for the same client replaces the previously granted scopes.
+type ConsentGrantedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ClientID string   `json:"clientId"`
+	Scope    []string `json:"scope,omitempty"`
+}
+
+func (e *ConsentGrantedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *ConsentGrantedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ConsentGrantedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewConsentGrantedEvent(ctx context.Context, aggregate *eventstore.Aggregate, clientID string, scope []string) *ConsentGrantedEvent {
+	return &ConsentGrantedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ConsentGrantedType,
+		),
+		ClientID: clientID,
+		Scope:    scope,
+	}
+}
+
+type ConsentRevokedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ClientID string `json:"clientId"`
+}
+
+func (e *ConsentRevokedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *ConsentRevokedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ConsentRevokedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewConsentRevokedEvent(ctx context.Context, aggregate *eventstore.Aggregate, clientID string) *ConsentRevokedEvent {
+	return &ConsentRevokedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ConsentRevokedType,
+		),
+		ClientID: clientID,
+	}
+}
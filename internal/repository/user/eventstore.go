@@ -75,6 +75,7 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanEmailVerificationFailedType, HumanEmailVerificationFailedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanEmailCodeAddedType, HumanEmailCodeAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanEmailCodeSentType, HumanEmailCodeSentEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanEmailUndeliverableType, HumanEmailUndeliverableEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanPhoneChangedType, HumanPhoneChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanPhoneRemovedType, HumanPhoneRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanPhoneVerifiedType, HumanPhoneVerifiedEventMapper)
@@ -142,4 +143,6 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanInviteCodeSentType, eventstore.GenericEventMapper[HumanInviteCodeSentEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanInviteCheckSucceededType, eventstore.GenericEventMapper[HumanInviteCheckSucceededEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanInviteCheckFailedType, eventstore.GenericEventMapper[HumanInviteCheckFailedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, ConsentGrantedType, eventstore.GenericEventMapper[ConsentGrantedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, ConsentRevokedType, eventstore.GenericEventMapper[ConsentRevokedEvent])
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -307,30 +308,39 @@ func HumanInitialCodeAddedEventMapper(event eventstore.Event) (eventstore.Event,
 
 type HumanInitialCodeSentEvent struct {
 	eventstore.BaseEvent `json:"-"`
+
+	GeneratorInfo *senders.CodeGeneratorInfo `json:"generatorInfo,omitempty"`
 }
 
 func (e *HumanInitialCodeSentEvent) Payload() interface{} {
-	return nil
+	return e
 }
 
 func (e *HumanInitialCodeSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
 	return nil
 }
 
-func NewHumanInitialCodeSentEvent(ctx context.Context, aggregate *eventstore.Aggregate) *HumanInitialCodeSentEvent {
+func NewHumanInitialCodeSentEvent(ctx context.Context, aggregate *eventstore.Aggregate, generatorInfo *senders.CodeGeneratorInfo) *HumanInitialCodeSentEvent {
 	return &HumanInitialCodeSentEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
 			ctx,
 			aggregate,
 			HumanInitialCodeSentType,
 		),
+		GeneratorInfo: generatorInfo,
 	}
 }
 
 func HumanInitialCodeSentEventMapper(event eventstore.Event) (eventstore.Event, error) {
-	return &HumanInitialCodeSentEvent{
+	codeSent := &HumanInitialCodeSentEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
-	}, nil
+	}
+	err := event.Unmarshal(codeSent)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "USER-Gs8lq", "unable to unmarshal human initial code sent")
+	}
+
+	return codeSent, nil
 }
 
 type HumanInitializedCheckSucceededEvent struct {
@@ -444,6 +454,8 @@ func NewHumanInviteCodeAddedEvent(
 
 type HumanInviteCodeSentEvent struct {
 	*eventstore.BaseEvent `json:"-"`
+
+	GeneratorInfo *senders.CodeGeneratorInfo `json:"generatorInfo,omitempty"`
 }
 
 func (e *HumanInviteCodeSentEvent) SetBaseEvent(b *eventstore.BaseEvent) {
@@ -451,20 +463,21 @@ func (e *HumanInviteCodeSentEvent) SetBaseEvent(b *eventstore.BaseEvent) {
 }
 
 func (e *HumanInviteCodeSentEvent) Payload() interface{} {
-	return nil
+	return e
 }
 
 func (e *HumanInviteCodeSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
 	return nil
 }
 
-func NewHumanInviteCodeSentEvent(ctx context.Context, aggregate *eventstore.Aggregate) *HumanInviteCodeSentEvent {
+func NewHumanInviteCodeSentEvent(ctx context.Context, aggregate *eventstore.Aggregate, generatorInfo *senders.CodeGeneratorInfo) *HumanInviteCodeSentEvent {
 	return &HumanInviteCodeSentEvent{
 		BaseEvent: eventstore.NewBaseEventForPush(
 			ctx,
 			aggregate,
 			HumanInviteCodeSentType,
 		),
+		GeneratorInfo: generatorInfo,
 	}
 }
 
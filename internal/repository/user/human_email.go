@@ -8,6 +8,7 @@ import (
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -18,6 +19,7 @@ const (
 	HumanEmailVerificationFailedType = emailEventPrefix + "verification.failed"
 	HumanEmailCodeAddedType          = emailEventPrefix + "code.added"
 	HumanEmailCodeSentType           = emailEventPrefix + "code.sent"
+	HumanEmailUndeliverableType      = emailEventPrefix + "undeliverable"
 )
 
 type HumanEmailChangedEvent struct {
@@ -179,28 +181,92 @@ func HumanEmailCodeAddedEventMapper(event eventstore.Event) (eventstore.Event, e
 
 type HumanEmailCodeSentEvent struct {
 	eventstore.BaseEvent `json:"-"`
+
+	GeneratorInfo *senders.CodeGeneratorInfo `json:"generatorInfo,omitempty"`
 }
 
 func (e *HumanEmailCodeSentEvent) Payload() interface{} {
-	return nil
+	return e
 }
 
 func (e *HumanEmailCodeSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
 	return nil
 }
 
-func NewHumanEmailCodeSentEvent(ctx context.Context, aggregate *eventstore.Aggregate) *HumanEmailCodeSentEvent {
+func NewHumanEmailCodeSentEvent(ctx context.Context, aggregate *eventstore.Aggregate, generatorInfo *senders.CodeGeneratorInfo) *HumanEmailCodeSentEvent {
 	return &HumanEmailCodeSentEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
 			ctx,
 			aggregate,
 			HumanEmailCodeSentType,
 		),
+		GeneratorInfo: generatorInfo,
 	}
 }
 
 func HumanEmailCodeSentEventMapper(event eventstore.Event) (eventstore.Event, error) {
-	return &HumanEmailCodeSentEvent{
+	codeSent := &HumanEmailCodeSentEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
-	}, nil
+	}
+	err := event.Unmarshal(codeSent)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "USER-Hq3ls", "unable to unmarshal human email code sent")
+	}
+
+	return codeSent, nil
+}
+
+type HumanEmailUndeliverableEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	EmailAddress domain.EmailAddress             `json:"email,omitempty"`
+	Reason       domain.EmailUndeliverableReason `json:"reason,omitempty"`
+	Description  string                          `json:"description,omitempty"`
+	// ProviderID is the id of the email provider, which reported the address as undeliverable.
+	ProviderID string `json:"providerId,omitempty"`
+	// DeliveryID is the id of the message assigned by the provider, if it was reported.
+	DeliveryID string `json:"deliveryId,omitempty"`
+}
+
+func (e *HumanEmailUndeliverableEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanEmailUndeliverableEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewHumanEmailUndeliverableEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	emailAddress domain.EmailAddress,
+	reason domain.EmailUndeliverableReason,
+	description,
+	providerID,
+	deliveryID string,
+) *HumanEmailUndeliverableEvent {
+	return &HumanEmailUndeliverableEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanEmailUndeliverableType,
+		),
+		EmailAddress: emailAddress,
+		Reason:       reason,
+		Description:  description,
+		ProviderID:   providerID,
+		DeliveryID:   deliveryID,
+	}
+}
+
+func HumanEmailUndeliverableEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	undeliverable := &HumanEmailUndeliverableEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(undeliverable)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "USER-Ub7ks", "unable to unmarshal human email undeliverable")
+	}
+
+	return undeliverable, nil
 }
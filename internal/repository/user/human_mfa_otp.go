@@ -532,6 +532,7 @@ type HumanOTPEmailCodeSentEvent struct {
 	Code   *crypto.CryptoValue `json:"code,omitempty"`
 	Expiry time.Duration       `json:"expiry,omitempty"`
 	*AuthRequestInfo
+	GeneratorInfo *senders.CodeGeneratorInfo `json:"generatorInfo,omitempty"`
 }
 
 func (e *HumanOTPEmailCodeSentEvent) Payload() interface{} {
@@ -549,6 +550,7 @@ func (e *HumanOTPEmailCodeSentEvent) SetBaseEvent(event *eventstore.BaseEvent) {
 func NewHumanOTPEmailCodeSentEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
+	generatorInfo *senders.CodeGeneratorInfo,
 ) *HumanOTPEmailCodeSentEvent {
 	return &HumanOTPEmailCodeSentEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -556,6 +558,7 @@ func NewHumanOTPEmailCodeSentEvent(
 			aggregate,
 			HumanOTPEmailCodeSentType,
 		),
+		GeneratorInfo: generatorInfo,
 	}
 }
 
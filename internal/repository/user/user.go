@@ -61,30 +61,39 @@ func NewUsernameUniqueConstraints(usernameChanges []string, resourceOwner string
 
 type UserLockedEvent struct {
 	eventstore.BaseEvent `json:"-"`
+
+	// LockedUntil is set if the user is automatically unlocked after the lockout duration of the lockout policy.
+	LockedUntil *time.Time `json:"lockedUntil,omitempty"`
 }
 
 func (e *UserLockedEvent) Payload() interface{} {
-	return nil
+	return e
 }
 
 func (e *UserLockedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
 	return nil
 }
 
-func NewUserLockedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *UserLockedEvent {
+func NewUserLockedEvent(ctx context.Context, aggregate *eventstore.Aggregate, lockedUntil *time.Time) *UserLockedEvent {
 	return &UserLockedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
 			ctx,
 			aggregate,
 			UserLockedType,
 		),
+		LockedUntil: lockedUntil,
 	}
 }
 
 func UserLockedEventMapper(event eventstore.Event) (eventstore.Event, error) {
-	return &UserLockedEvent{
+	lockedEvent := &UserLockedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
-	}, nil
+	}
+	err := event.Unmarshal(lockedEvent)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "USER-4Lck8", "unable to unmarshal user locked")
+	}
+	return lockedEvent, nil
 }
 
 type UserUnlockedEvent struct {
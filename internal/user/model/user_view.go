@@ -16,6 +16,7 @@ type UserView struct {
 	CreationDate       time.Time
 	ChangeDate         time.Time
 	State              UserState
+	LockedUntil        *time.Time
 	Sequence           uint64
 	ResourceOwner      string
 	LastLogin          time.Time
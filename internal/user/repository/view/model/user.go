@@ -47,6 +47,7 @@ type UserView struct {
 	ChangeDate         time.Time                  `json:"-" gorm:"column:change_date"`
 	ResourceOwner      string                     `json:"-" gorm:"column:resource_owner"`
 	State              int32                      `json:"-" gorm:"column:user_state"`
+	LockedUntil        *time.Time                 `json:"-" gorm:"column:locked_until"`
 	LastLogin          time.Time                  `json:"-" gorm:"column:last_login"`
 	LoginNames         database.TextArray[string] `json:"-" gorm:"column:login_names"`
 	PreferredLoginName string                     `json:"-" gorm:"column:preferred_login_name"`
@@ -149,6 +150,7 @@ func UserToModel(user *UserView) *model.UserView {
 		CreationDate:       user.CreationDate,
 		ResourceOwner:      user.ResourceOwner,
 		State:              model.UserState(user.State),
+		LockedUntil:        user.LockedUntil,
 		LastLogin:          user.LastLogin,
 		PreferredLoginName: user.PreferredLoginName,
 		LoginNames:         user.LoginNames,
@@ -311,8 +313,10 @@ func (u *UserView) AppendEvent(event eventstore.Event) (err error) {
 	case user.UserReactivatedType,
 		user.UserUnlockedType:
 		u.State = int32(model.UserStateActive)
+		u.LockedUntil = nil
 	case user.UserLockedType:
 		u.State = int32(model.UserStateLocked)
+		err = u.setLockedUntil(event)
 	case user.UserV1MFAOTPAddedType,
 		user.HumanMFAOTPAddedType:
 		if u.HumanView == nil {
@@ -393,6 +397,16 @@ func (u *UserView) setData(event eventstore.Event) error {
 	return nil
 }
 
+func (u *UserView) setLockedUntil(event eventstore.Event) error {
+	locked := new(user.UserLockedEvent)
+	if err := event.Unmarshal(locked); err != nil {
+		logging.WithError(err).Error("could not unmarshal event data")
+		return zerrors.ThrowInternal(nil, "MODEL-Lck9u", "could not unmarshal data")
+	}
+	u.LockedUntil = locked.LockedUntil
+	return nil
+}
+
 func (u *UserView) setPasswordData(event eventstore.Event) error {
 	password := new(es_model.Password)
 	if err := event.Unmarshal(password); err != nil {
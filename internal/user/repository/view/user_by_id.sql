@@ -25,6 +25,7 @@ SELECT
     , LEAST(u.change_date, au.change_date) AS change_date
     , u.resource_owner
     , u.state AS user_state
+    , u.locked_until
     , au.password_set
     , h.password_change_required
     , au.password_change